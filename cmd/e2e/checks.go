@@ -0,0 +1,237 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// parseHCLFile parses a generated configuration file.
+func parseHCLFile(path string) (*hclsyntax.Body, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is inside the work directory
+	if err != nil {
+		return nil, err
+	}
+	return parseHCL(data, path)
+}
+
+func parseHCL(data []byte, filename string) (*hclsyntax.Body, error) {
+	file, diags := hclsyntax.ParseConfig(data, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("%s does not parse: %s", filename, diags.Error())
+	}
+	return file.Body.(*hclsyntax.Body), nil
+}
+
+// resourceAddresses returns the addresses of the resource blocks in body,
+// e.g. "hyperping_monitor.api", in file order.
+func resourceAddresses(body *hclsyntax.Body) []string {
+	var addrs []string
+	for _, b := range body.Blocks {
+		if b.Type == "resource" && len(b.Labels) == 2 {
+			addrs = append(addrs, b.Labels[0]+"."+b.Labels[1])
+		}
+	}
+	return addrs
+}
+
+// countBlocks returns the number of top-level blocks of blockType in body.
+func countBlocks(body *hclsyntax.Body, blockType string) int {
+	n := 0
+	for _, b := range body.Blocks {
+		if b.Type == blockType {
+			n++
+		}
+	}
+	return n
+}
+
+// countType returns the number of addresses of resourceType.
+func countType(addrs []string, resourceType string) int {
+	n := 0
+	for _, a := range addrs {
+		if strings.HasPrefix(a, resourceType+".") {
+			n++
+		}
+	}
+	return n
+}
+
+// importTarget is one import in a generated import file or script.
+type importTarget struct {
+	Address string
+	ID      string
+}
+
+// importLine matches the imports of the generated files: plain
+// "terraform import" commands, the import_resource calls of the
+// import-generator script, and the guarded commands of the migration
+// scripts. The function bodies that import "$resource_addr" do not match.
+var importLine = regexp.MustCompile(`^\s*(?:if\s+)?(?:terraform import|import_resource)\s+['"]?([A-Za-z0-9_.\-]+)['"]?\s+['"]?([^'"\s]+)['"]?`)
+
+// parseImports returns the import targets in text, skipping comments.
+func parseImports(text string) []importTarget {
+	var targets []importTarget
+	scanner := bufio.NewScanner(strings.NewReader(text))
+	for scanner.Scan() {
+		line := scanner.Text()
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		if m := importLine.FindStringSubmatch(line); m != nil {
+			targets = append(targets, importTarget{Address: m[1], ID: m[2]})
+		}
+	}
+	return targets
+}
+
+// checkImportTargets checks that every import targets a distinct resource
+// block of the configuration.
+func checkImportTargets(imports []importTarget, addrs []string) error {
+	configured := make(map[string]bool, len(addrs))
+	for _, a := range addrs {
+		configured[a] = true
+	}
+	seen := make(map[string]bool, len(imports))
+	var errs []error
+	for _, imp := range imports {
+		if seen[imp.Address] {
+			errs = append(errs, fmt.Errorf("%s is imported twice", imp.Address))
+		}
+		seen[imp.Address] = true
+		if !configured[imp.Address] {
+			errs = append(errs, fmt.Errorf("%s is imported but has no resource block", imp.Address))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// checkSameIDs checks that the imports cover exactly the want IDs.
+func checkSameIDs(imports []importTarget, want []string) error {
+	got := make([]string, 0, len(imports))
+	for _, imp := range imports {
+		got = append(got, imp.ID)
+	}
+	missing, extra := diffSets(want, got)
+	var errs []error
+	if len(missing) > 0 {
+		errs = append(errs, fmt.Errorf("not imported: %s", strings.Join(missing, ", ")))
+	}
+	if len(extra) > 0 {
+		errs = append(errs, fmt.Errorf("unknown IDs imported: %s", strings.Join(extra, ", ")))
+	}
+	return errors.Join(errs...)
+}
+
+// checkSameTargets checks that two import lists hold the same targets.
+func checkSameTargets(a, b []importTarget) error {
+	key := func(t importTarget) string { return t.Address + " " + t.ID }
+	as := make([]string, len(a))
+	for i, t := range a {
+		as[i] = key(t)
+	}
+	bs := make([]string, len(b))
+	for i, t := range b {
+		bs[i] = key(t)
+	}
+	missing, extra := diffSets(as, bs)
+	if len(missing) > 0 || len(extra) > 0 {
+		return fmt.Errorf("imports differ: only in the first [%s], only in the second [%s]",
+			strings.Join(missing, "; "), strings.Join(extra, "; "))
+	}
+	return nil
+}
+
+// diffSets returns the elements of want missing from got, and the elements
+// of got not in want, both sorted.
+func diffSets(want, got []string) (missing, extra []string) {
+	for _, w := range want {
+		if !slices.Contains(got, w) {
+			missing = append(missing, w)
+		}
+	}
+	for _, g := range got {
+		if !slices.Contains(want, g) {
+			extra = append(extra, g)
+		}
+	}
+	sort.Strings(missing)
+	sort.Strings(extra)
+	return missing, extra
+}
+
+// statusPageServiceUUIDs returns the uuid of every service, including the
+// services of groups, in the hyperping_statuspage blocks of body.
+func statusPageServiceUUIDs(body *hclsyntax.Body) ([]string, error) {
+	var uuids []string
+	for _, b := range body.Blocks {
+		if b.Type != "resource" || len(b.Labels) != 2 || b.Labels[0] != "hyperping_statuspage" {
+			continue
+		}
+		attr, ok := b.Body.Attributes["sections"]
+		if !ok {
+			continue
+		}
+		sections, diags := attr.Expr.Value(nil)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("hyperping_statuspage.%s: sections: %s", b.Labels[1], diags.Error())
+		}
+		for it := sections.ElementIterator(); it.Next(); {
+			_, section := it.Element()
+			uuids = appendServiceUUIDs(uuids, attrValue(section, "services"))
+		}
+	}
+	return uuids, nil
+}
+
+func appendServiceUUIDs(uuids []string, services cty.Value) []string {
+	if services.IsNull() || !services.CanIterateElements() {
+		return uuids
+	}
+	for it := services.ElementIterator(); it.Next(); {
+		_, svc := it.Element()
+		if id := attrValue(svc, "uuid"); !id.IsNull() && id.Type() == cty.String {
+			uuids = append(uuids, id.AsString())
+		}
+		uuids = appendServiceUUIDs(uuids, attrValue(svc, "services"))
+	}
+	return uuids
+}
+
+// attrValue returns the attribute name of an object value, or null.
+func attrValue(obj cty.Value, name string) cty.Value {
+	if obj.IsNull() || !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+		return cty.NullVal(cty.DynamicPseudoType)
+	}
+	return obj.GetAttr(name)
+}
+
+// errNoBash reports that shell scripts could not be syntax-checked.
+var errNoBash = errors.New("bash not found")
+
+// checkScriptSyntax runs bash -n on the script at path.
+func checkScriptSyntax(ctx context.Context, path string) error {
+	bash, err := exec.LookPath("bash")
+	if err != nil {
+		return errNoBash
+	}
+	out, err := exec.CommandContext(ctx, bash, "-n", path).CombinedOutput() //nolint:gosec // path is inside the work directory
+	if err != nil {
+		return fmt.Errorf("%s: %s", path, strings.TrimSpace(string(out)))
+	}
+	return nil
+}
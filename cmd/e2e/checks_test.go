@@ -0,0 +1,170 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParseImports(t *testing.T) {
+	text := `#!/bin/bash
+# terraform import hyperping_monitor.commented "mon_x"
+terraform import hyperping_monitor.api "mon_api"
+import_resource() {
+    if terraform import "$resource_addr" "$resource_id"; then
+import_resource "hyperping_healthcheck.backup" "tok_backup"
+if terraform import 'hyperping_monitor.db' 'mon_PLACEHOLDER_42' 2>/dev/null; then
+`
+	want := []importTarget{
+		{Address: "hyperping_monitor.api", ID: "mon_api"},
+		{Address: "hyperping_healthcheck.backup", ID: "tok_backup"},
+		{Address: "hyperping_monitor.db", ID: "mon_PLACEHOLDER_42"},
+	}
+	if got := parseImports(text); !reflect.DeepEqual(got, want) {
+		t.Errorf("parseImports() = %+v, want %+v", got, want)
+	}
+}
+
+func TestCheckImportTargets(t *testing.T) {
+	addrs := []string{"hyperping_monitor.api", "hyperping_monitor.db"}
+
+	if err := checkImportTargets([]importTarget{{Address: "hyperping_monitor.api", ID: "a"}}, addrs); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	err := checkImportTargets([]importTarget{
+		{Address: "hyperping_monitor.api", ID: "a"},
+		{Address: "hyperping_monitor.api", ID: "b"},
+		{Address: "hyperping_monitor.web", ID: "c"},
+	}, addrs)
+	if err == nil || !strings.Contains(err.Error(), "imported twice") || !strings.Contains(err.Error(), "no resource block") {
+		t.Errorf("want duplicate and missing block errors, got %v", err)
+	}
+}
+
+func TestCheckSameIDs(t *testing.T) {
+	imports := []importTarget{{ID: "mon_a"}, {ID: "mon_x"}}
+
+	err := checkSameIDs(imports, []string{"mon_a", "mon_b"})
+	if err == nil || !strings.Contains(err.Error(), "not imported: mon_b") || !strings.Contains(err.Error(), "unknown IDs imported: mon_x") {
+		t.Errorf("got %v", err)
+	}
+	if err := checkSameIDs(imports, []string{"mon_x", "mon_a"}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestCheckSameTargets(t *testing.T) {
+	a := []importTarget{{Address: "hyperping_monitor.api", ID: "mon_api"}}
+	if err := checkSameTargets(a, a); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	b := []importTarget{{Address: "hyperping_monitor.api", ID: "mon_other"}}
+	if err := checkSameTargets(a, b); err == nil {
+		t.Error("expected an error for a different ID")
+	}
+}
+
+func TestStatusPageServiceUUIDs(t *testing.T) {
+	body, err := parseHCL([]byte(`
+resource "hyperping_monitor" "api" {
+  name = "API"
+}
+
+resource "hyperping_statuspage" "public" {
+  name = "Public"
+  sections = [
+    {
+      name = { en = "Platform" }
+      services = [
+        { uuid = "mon_api", name = { en = "API" } },
+        {
+          name     = { en = "Data" }
+          is_group = true
+          services = [
+            { uuid = "mon_db", name = { en = "Database" } },
+          ]
+        },
+      ]
+    },
+  ]
+}
+`), "main.tf")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := statusPageServiceUUIDs(body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"mon_api", "mon_db"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("statusPageServiceUUIDs() = %v, want %v", got, want)
+	}
+	if addrs := resourceAddresses(body); !reflect.DeepEqual(addrs, []string{"hyperping_monitor.api", "hyperping_statuspage.public"}) {
+		t.Errorf("resourceAddresses() = %v", addrs)
+	}
+}
+
+func TestParseHCL_Invalid(t *testing.T) {
+	if _, err := parseHCL([]byte(`resource "x" {`), "broken.tf"); err == nil {
+		t.Error("expected a parse error")
+	}
+}
+
+func TestCheckScriptSyntax(t *testing.T) {
+	dir := t.TempDir()
+	good := filepath.Join(dir, "good.sh")
+	bad := filepath.Join(dir, "bad.sh")
+	if err := os.WriteFile(good, []byte("#!/bin/bash\necho ok\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(bad, []byte("#!/bin/bash\nif true; then\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := checkScriptSyntax(context.Background(), good); errors.Is(err, errNoBash) {
+		t.Skip("bash not installed")
+	} else if err != nil {
+		t.Errorf("good script: %v", err)
+	}
+	if err := checkScriptSyntax(context.Background(), bad); err == nil {
+		t.Error("bad script: expected a syntax error")
+	}
+}
+
+func TestRunStages_StopsAtFirstFailure(t *testing.T) {
+	var ran []string
+	record := func(name string, err error) stage {
+		return stage{name: name, run: func(context.Context, *env) error {
+			ran = append(ran, name)
+			return err
+		}}
+	}
+
+	results := runStages(context.Background(), &env{out: io.Discard}, []stage{
+		record("first", nil),
+		record("skipped", skip("no terraform")),
+		record("broken", errors.New("boom")),
+		record("last", nil),
+	})
+
+	if want := []string{"first", "skipped", "broken"}; !reflect.DeepEqual(ran, want) {
+		t.Errorf("ran %v, want %v", ran, want)
+	}
+	var statuses []string
+	for _, r := range results {
+		statuses = append(statuses, r.status)
+	}
+	if want := []string{statusPass, statusSkip, statusFail, statusNotRun}; !reflect.DeepEqual(statuses, want) {
+		t.Errorf("statuses %v, want %v", statuses, want)
+	}
+}
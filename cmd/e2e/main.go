@@ -0,0 +1,264 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// e2e runs the command line tools and the provider end to end against an
+// in-memory fake of the Hyperping API, and checks the artifacts they produce.
+//
+// It starts the fake seeded from testdata/hyperping.json, builds the
+// provider, import-generator, and migrate-uptimerobot, runs import-generator
+// against the fake and migrate-uptimerobot against testdata/uptimerobot.tfstate,
+// and verifies that the generated HCL parses, that every import targets a
+// resource block, and that the import scripts are valid shell. When terraform
+// is installed it also runs terraform validate on the generated
+// configurations with the freshly built provider, and the acceptance test
+// subset, which run against in-process mock servers.
+//
+// Usage:
+//
+//	go run ./cmd/e2e
+//	go run ./cmd/e2e -keep -work-dir=/tmp/hyperping-e2e
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/fakeapi"
+)
+
+// defaultAcceptanceRun selects the acceptance tests of the resources the
+// tools generate.
+const defaultAcceptanceRun = "^TestAcc(Monitor|Healthcheck|StatusPage|Incident|Maintenance|Outage)Resource_"
+
+var (
+	repoDir       = flag.String("repo", ".", "Repository root to build the provider and tools from")
+	workDir       = flag.String("work-dir", "", "Directory for binaries and generated files (default: a new temporary directory)")
+	keep          = flag.Bool("keep", false, "Keep the work directory after the run")
+	fixtureFile   = flag.String("fixture", "cmd/e2e/testdata/hyperping.json", "Fake API fixture, relative to -repo")
+	stateFile     = flag.String("uptimerobot-state", "cmd/e2e/testdata/uptimerobot.tfstate", "UptimeRobot state for migrate-uptimerobot, relative to -repo")
+	terraformBin  = flag.String("terraform", "terraform", "Terraform binary; the terraform stages are skipped when it is not found")
+	acceptanceRun = flag.String("acceptance-run", defaultAcceptanceRun, "go test -run pattern for the acceptance stage; empty skips the stage")
+	verbose       = flag.Bool("verbose", false, "Stream the output of every command")
+)
+
+// fakeAPIKey is the only key the fake API accepts.
+const fakeAPIKey = "sk_e2e_fake"
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: e2e [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Runs import-generator, migrate-uptimerobot, and the provider against a fake\n")
+		fmt.Fprintf(os.Stderr, "Hyperping API and checks the generated artifacts. Run from the repository root.\n\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+	os.Exit(run())
+}
+
+func run() int {
+	ctx := context.Background()
+
+	repo, err := filepath.Abs(*repoDir)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if _, err := os.Stat(filepath.Join(repo, "go.mod")); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %s is not the repository root (no go.mod)\n", repo)
+		return 1
+	}
+
+	work := *workDir
+	if work == "" {
+		work, err = os.MkdirTemp("", "hyperping-e2e-")
+	} else {
+		err = os.MkdirAll(work, 0o750)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating work directory: %v\n", err)
+		return 1
+	}
+	if !*keep {
+		defer os.RemoveAll(work)
+	}
+
+	fixture, err := fakeapi.LoadFixture(filepath.Join(repo, *fixtureFile))
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fake := fakeapi.NewServer(fakeAPIKey, fixture)
+	defer fake.Close()
+
+	e := &env{
+		repo:          repo,
+		work:          work,
+		bin:           filepath.Join(work, "bin"),
+		fake:          fake,
+		stateFile:     filepath.Join(repo, *stateFile),
+		acceptanceRun: *acceptanceRun,
+		out:           io.Discard,
+	}
+	if *verbose {
+		e.out = os.Stderr
+	}
+	if path, err := exec.LookPath(*terraformBin); err == nil {
+		e.terraform = path
+	}
+
+	fmt.Fprintf(os.Stderr, "Fake Hyperping API at %s\n", fake.URL())
+	fmt.Fprintf(os.Stderr, "Work directory: %s\n\n", work)
+
+	results := runStages(ctx, e, stages)
+	printResults(os.Stdout, results)
+	if *keep {
+		fmt.Printf("\nArtifacts kept in %s\n", work)
+	}
+
+	for _, r := range results {
+		if r.status == statusFail {
+			return 1
+		}
+	}
+	return 0
+}
+
+// env is the state shared by the stages.
+type env struct {
+	repo      string
+	work      string
+	bin       string
+	fake      *fakeapi.Server
+	stateFile string
+	// terraform is the terraform binary, or empty when it is not installed.
+	terraform     string
+	acceptanceRun string
+	// out receives the output of the commands the stages run.
+	out io.Writer
+}
+
+// dir returns a directory under the work directory, creating it.
+func (e *env) dir(name string) (string, error) {
+	dir := filepath.Join(e.work, name)
+	return dir, os.MkdirAll(dir, 0o750)
+}
+
+// command returns a command that runs in dir with extra environment
+// variables. HOME points into the work directory so the tools' checkpoints
+// and logs stay out of the user's home.
+func (e *env) command(ctx context.Context, dir, name string, extraEnv []string, args ...string) *exec.Cmd {
+	cmd := exec.CommandContext(ctx, name, args...) //nolint:gosec // the binaries are built or located by this command
+	cmd.Dir = dir
+	cmd.Env = append(os.Environ(), "HOME="+filepath.Join(e.work, "home"))
+	cmd.Env = append(cmd.Env, extraEnv...)
+	cmd.Stdout = e.out
+	cmd.Stderr = e.out
+	return cmd
+}
+
+// runCommand runs cmd and includes the end of its output in the error when
+// it fails and the output was not already shown.
+func (e *env) runCommand(cmd *exec.Cmd) error {
+	if e.out != io.Discard {
+		return cmd.Run()
+	}
+	var buf strings.Builder
+	cmd.Stdout = &buf
+	cmd.Stderr = &buf
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("%s: %w\n%s", filepath.Base(cmd.Path), err, tail(buf.String(), 20))
+	}
+	return nil
+}
+
+// tail returns the last n lines of s.
+func tail(s string, n int) string {
+	lines := strings.Split(strings.TrimRight(s, "\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// skipError marks a stage that could not run in this environment.
+type skipError struct{ reason string }
+
+func (e skipError) Error() string { return e.reason }
+
+func skip(format string, args ...any) error {
+	return skipError{reason: fmt.Sprintf(format, args...)}
+}
+
+// stage is one step of the run. Stages run in order and the run stops at the
+// first failure, since later stages use the artifacts of earlier ones.
+type stage struct {
+	name string
+	run  func(ctx context.Context, e *env) error
+}
+
+const (
+	statusPass   = "pass"
+	statusFail   = "FAIL"
+	statusSkip   = "skip"
+	statusNotRun = "not run"
+)
+
+type stageResult struct {
+	name     string
+	status   string
+	detail   string
+	duration time.Duration
+}
+
+func runStages(ctx context.Context, e *env, stages []stage) []stageResult {
+	results := make([]stageResult, 0, len(stages))
+	failed := false
+	for _, s := range stages {
+		if failed {
+			results = append(results, stageResult{name: s.name, status: statusNotRun})
+			continue
+		}
+
+		fmt.Fprintf(os.Stderr, "==> %s\n", s.name)
+		start := time.Now()
+		err := s.run(ctx, e)
+		r := stageResult{name: s.name, status: statusPass, duration: time.Since(start)}
+
+		var skipped skipError
+		switch {
+		case errors.As(err, &skipped):
+			r.status = statusSkip
+			r.detail = skipped.reason
+		case err != nil:
+			r.status = statusFail
+			r.detail = err.Error()
+			failed = true
+			fmt.Fprintf(os.Stderr, "%v\n", err)
+		}
+		results = append(results, r)
+	}
+	return results
+}
+
+func printResults(w io.Writer, results []stageResult) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "\nSTAGE\tRESULT\tTIME\tDETAIL")
+	for _, r := range results {
+		detail, _, _ := strings.Cut(r.detail, "\n")
+		duration := ""
+		if r.duration > 0 {
+			duration = r.duration.Round(10 * time.Millisecond).String()
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\n", r.name, r.status, duration, detail)
+	}
+	_ = tw.Flush() //nolint:errcheck // best-effort summary output
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/uptimerobot"
+	"github.com/develeap/terraform-provider-hyperping/pkg/tfstate"
+)
+
+// stages lists the stages in the order they run.
+var stages = []stage{
+	{name: "build", run: buildBinaries},
+	{name: "import-generator", run: runImportGenerator},
+	{name: "migrate-uptimerobot", run: runMigrateUptimeRobot},
+	{name: "terraform validate", run: validateConfigs},
+	{name: "acceptance", run: runAcceptance},
+}
+
+// Directories under the work directory.
+const (
+	importDir  = "import-generator"
+	migrateDir = "migrate-uptimerobot"
+)
+
+// fakeCollections are the fake API collections import-generator imports.
+var fakeCollections = []string{"monitors", "healthchecks", "statuspages", "incidents", "maintenance", "outages"}
+
+// providerBinary is the name Terraform expects for a dev_overrides provider.
+const providerBinary = "terraform-provider-hyperping"
+
+func buildBinaries(ctx context.Context, e *env) error {
+	targets := []struct{ pkg, name string }{
+		{".", providerBinary},
+		{"./cmd/import-generator", "import-generator"},
+		{"./cmd/migrate-uptimerobot", "migrate-uptimerobot"},
+	}
+	for _, t := range targets {
+		cmd := e.command(ctx, e.repo, "go", nil, "build", "-o", filepath.Join(e.bin, t.name), t.pkg)
+		if err := e.runCommand(cmd); err != nil {
+			return fmt.Errorf("building %s: %w", t.pkg, err)
+		}
+	}
+	return nil
+}
+
+// runImportGenerator generates HCL, import commands, and an import script
+// from the fake API and checks them against each other and the fake's
+// content.
+func runImportGenerator(ctx context.Context, e *env) error {
+	dir, err := e.dir(importDir)
+	if err != nil {
+		return err
+	}
+	apiEnv := []string{"HYPERPING_API_KEY=" + fakeAPIKey}
+	outputs := []struct{ format, file string }{
+		{"hcl", "main.tf"},
+		{"import", "imports.txt"},
+		{"script", "import.sh"},
+	}
+	for _, o := range outputs {
+		cmd := e.command(ctx, dir, filepath.Join(e.bin, "import-generator"), apiEnv,
+			"--base-url", e.fake.URL(), "--format", o.format, "--output", o.file)
+		if err := e.runCommand(cmd); err != nil {
+			return fmt.Errorf("--format=%s: %w", o.format, err)
+		}
+	}
+
+	body, err := parseHCLFile(filepath.Join(dir, "main.tf"))
+	if err != nil {
+		return err
+	}
+	addrs := resourceAddresses(body)
+	imports, err := readImports(filepath.Join(dir, "imports.txt"))
+	if err != nil {
+		return err
+	}
+	scriptImports, err := readImports(filepath.Join(dir, "import.sh"))
+	if err != nil {
+		return err
+	}
+
+	var fakeIDs []string
+	for _, name := range fakeCollections {
+		fakeIDs = append(fakeIDs, e.fake.IDs(name)...)
+	}
+	monitorIDs := e.fake.IDs("monitors")
+	serviceIDs, err := statusPageServiceUUIDs(body)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	if len(addrs) != len(fakeIDs) {
+		errs = append(errs, fmt.Errorf("main.tf has %d resource blocks, the fake API has %d resources", len(addrs), len(fakeIDs)))
+	}
+	if err := checkImportTargets(imports, addrs); err != nil {
+		errs = append(errs, fmt.Errorf("imports.txt: %w", err))
+	}
+	if err := checkSameIDs(imports, fakeIDs); err != nil {
+		errs = append(errs, fmt.Errorf("imports.txt: %w", err))
+	}
+	if err := checkSameTargets(imports, scriptImports); err != nil {
+		errs = append(errs, fmt.Errorf("import.sh: %w", err))
+	}
+	for _, id := range serviceIDs {
+		if !slices.Contains(monitorIDs, id) {
+			errs = append(errs, fmt.Errorf("main.tf: status page service references unknown monitor %q", id))
+		}
+	}
+	for _, req := range e.fake.RequestKeys() {
+		if !strings.HasPrefix(req, "GET ") {
+			errs = append(errs, fmt.Errorf("import-generator sent a write request: %s", req))
+		}
+	}
+	if err := checkScriptSyntax(ctx, filepath.Join(dir, "import.sh")); err != nil && !errors.Is(err, errNoBash) {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// runMigrateUptimeRobot converts the UptimeRobot state fixture and checks
+// that every source monitor becomes a resource block, an import, and a
+// removed block.
+func runMigrateUptimeRobot(ctx context.Context, e *env) error {
+	dir, err := e.dir(migrateDir)
+	if err != nil {
+		return err
+	}
+	state, err := tfstate.Load(e.stateFile)
+	if err != nil {
+		return err
+	}
+	sources := len(state.Instances(uptimerobot.MonitorResourceType))
+
+	// The removed blocks belong to the UptimeRobot configuration, so they
+	// are written outside the directory terraform validates.
+	removed := filepath.Join(e.work, "removed.tf")
+	cmd := e.command(ctx, dir, filepath.Join(e.bin, "migrate-uptimerobot"),
+		[]string{"HYPERPING_API_KEY=" + fakeAPIKey},
+		"-from-state", e.stateFile,
+		"-output", "hyperping.tf",
+		"-import-script", "import.sh",
+		"-report", filepath.Join(e.work, "migration-report.json"),
+		"-manual-steps", filepath.Join(e.work, "manual-steps.md"),
+		"-removed-blocks", removed,
+	)
+	cmd.Stdin = strings.NewReader("")
+	if err := e.runCommand(cmd); err != nil {
+		return err
+	}
+
+	body, err := parseHCLFile(filepath.Join(dir, "hyperping.tf"))
+	if err != nil {
+		return err
+	}
+	addrs := resourceAddresses(body)
+	imports, err := readImports(filepath.Join(dir, "import.sh"))
+	if err != nil {
+		return err
+	}
+	removedBody, err := parseHCLFile(removed)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	if n := countType(addrs, "hyperping_monitor") + countType(addrs, "hyperping_healthcheck"); n != sources {
+		errs = append(errs, fmt.Errorf("hyperping.tf has %d monitors and healthchecks, the state has %d source monitors", n, sources))
+	}
+	if len(imports) != len(addrs) {
+		errs = append(errs, fmt.Errorf("import.sh has %d imports for %d resource blocks", len(imports), len(addrs)))
+	}
+	if err := checkImportTargets(imports, addrs); err != nil {
+		errs = append(errs, fmt.Errorf("import.sh: %w", err))
+	}
+	if n := countBlocks(removedBody, "removed"); n != sources {
+		errs = append(errs, fmt.Errorf("removed.tf has %d removed blocks, the state has %d source monitors", n, sources))
+	}
+	if err := checkScriptSyntax(ctx, filepath.Join(dir, "import.sh")); err != nil && !errors.Is(err, errNoBash) {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// requiredProviders pins the provider source for configurations that do not
+// declare it, such as the import-generator output.
+const requiredProviders = `terraform {
+  required_providers {
+    hyperping = {
+      source = "develeap/hyperping"
+    }
+  }
+}
+`
+
+// validateConfigs runs terraform validate on the generated configurations
+// with the built provider installed through dev_overrides.
+func validateConfigs(ctx context.Context, e *env) error {
+	if e.terraform == "" {
+		return skip("terraform not found")
+	}
+
+	cliConfig := filepath.Join(e.work, "terraformrc")
+	overrides := fmt.Sprintf("provider_installation {\n  dev_overrides {\n    \"develeap/hyperping\" = %q\n  }\n  direct {}\n}\n", e.bin)
+	if err := os.WriteFile(cliConfig, []byte(overrides), 0o600); err != nil {
+		return err
+	}
+	providers := filepath.Join(e.work, importDir, "providers.tf")
+	if err := os.WriteFile(providers, []byte(requiredProviders), 0o600); err != nil {
+		return err
+	}
+
+	for _, name := range []string{importDir, migrateDir} {
+		cmd := e.command(ctx, filepath.Join(e.work, name), e.terraform,
+			[]string{"TF_CLI_CONFIG_FILE=" + cliConfig, "HYPERPING_API_KEY=" + fakeAPIKey},
+			"validate", "-no-color")
+		if err := e.runCommand(cmd); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+	return nil
+}
+
+// runAcceptance runs the acceptance test subset. The tests start their own
+// mock API servers, so they need terraform but no credentials.
+func runAcceptance(ctx context.Context, e *env) error {
+	switch {
+	case e.acceptanceRun == "":
+		return skip("-acceptance-run is empty")
+	case e.terraform == "":
+		return skip("terraform not found")
+	}
+	cmd := e.command(ctx, e.repo, "go",
+		[]string{"TF_ACC=1", "TF_ACC_TERRAFORM_PATH=" + e.terraform},
+		"test", "./internal/provider/", "-count=1", "-timeout=30m", "-run", e.acceptanceRun)
+	return e.runCommand(cmd)
+}
+
+// readImports returns the import targets in the file at path. A file
+// without any is an error: every fixture resource must be importable.
+func readImports(path string) ([]importTarget, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is inside the work directory
+	if err != nil {
+		return nil, err
+	}
+	imports := parseImports(string(data))
+	if len(imports) == 0 {
+		return nil, fmt.Errorf("%s has no imports", filepath.Base(path))
+	}
+	return imports, nil
+}
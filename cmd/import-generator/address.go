@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// modulePathPattern matches a module address such as module.monitoring or
+// module.platform.module.monitoring. Instance keys are not supported: the
+// import targets would need one address per module instance.
+var modulePathPattern = regexp.MustCompile(`^module\.[A-Za-z_][A-Za-z0-9_-]*(\.module\.[A-Za-z_][A-Za-z0-9_-]*)*$`)
+
+// validateModulePath checks a --module-path value.
+func validateModulePath(path string) error {
+	if path == "" || modulePathPattern.MatchString(path) {
+		return nil
+	}
+	return fmt.Errorf("--module-path must be a module address such as module.monitoring: %s", path)
+}
+
+// resourceAddress returns the Terraform address of a resource, inside module
+// when it is set.
+func resourceAddress(module, resourceType, name string) string {
+	if module == "" {
+		return resourceType + "." + name
+	}
+	return module + "." + resourceType + "." + name
+}
+
+// address returns the import target address of a generated resource.
+func (g *Generator) address(resourceType, name string) string {
+	return resourceAddress(g.modulePath, resourceType, name)
+}
+
+// Address returns the Terraform address the job imports into.
+func (j ImportJob) Address() string {
+	return resourceAddress(j.Module, j.ResourceType, j.ResourceName)
+}
+
+// Address returns the Terraform address the resource was imported into.
+func (e ImportLogEntry) Address() string {
+	return resourceAddress(e.Module, e.ResourceType, e.ResourceName)
+}
+
+// moduleDir returns the source directory of an installed module, read from
+// the manifest terraform init writes under .terraform in dir.
+func moduleDir(dir, module string) (string, error) {
+	manifest := filepath.Join(dir, ".terraform", "modules", "modules.json")
+	data, err := os.ReadFile(filepath.Clean(manifest)) // #nosec G304 -- reading the operator's Terraform working directory
+	if errors.Is(err, fs.ErrNotExist) {
+		return "", fmt.Errorf("%s is not installed in %s: run terraform init first", module, dir)
+	}
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s: %w", manifest, err)
+	}
+
+	var modules struct {
+		Modules []struct {
+			Key string `json:"Key"`
+			Dir string `json:"Dir"`
+		} `json:"Modules"`
+	}
+	if err := json.Unmarshal(data, &modules); err != nil {
+		return "", fmt.Errorf("failed to parse %s: %w", manifest, err)
+	}
+
+	// The manifest keys module.a.module.b as "a.b".
+	key := strings.ReplaceAll(strings.TrimPrefix(module, "module."), ".module.", ".")
+	for _, m := range modules.Modules {
+		if m.Key == key {
+			if filepath.IsAbs(m.Dir) {
+				return m.Dir, nil
+			}
+			return filepath.Join(dir, m.Dir), nil
+		}
+	}
+	return "", fmt.Errorf("%s is not installed in %s: check the module name or run terraform init", module, dir)
+}
@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestValidateModulePath(t *testing.T) {
+	for _, path := range []string{"", "module.monitoring", "module.platform.module.monitoring", "module.uptime-checks"} {
+		if err := validateModulePath(path); err != nil {
+			t.Errorf("validateModulePath(%q) = %v, want nil", path, err)
+		}
+	}
+	for _, path := range []string{"monitoring", "module.", "module.monitoring.", "module.monitoring[0]", `module.m["a"]`, "module.a.b", "module.a b"} {
+		if err := validateModulePath(path); err == nil {
+			t.Errorf("validateModulePath(%q) = nil, want an error", path)
+		}
+	}
+}
+
+func TestResourceAddress(t *testing.T) {
+	if got := resourceAddress("", "hyperping_monitor", "api"); got != "hyperping_monitor.api" {
+		t.Errorf("root module address = %q", got)
+	}
+	if got := resourceAddress("module.monitoring", "hyperping_monitor", "api"); got != "module.monitoring.hyperping_monitor.api" {
+		t.Errorf("module address = %q", got)
+	}
+
+	job := ImportJob{ResourceType: "hyperping_healthcheck", ResourceName: "cron", Module: "module.monitoring"}
+	log := NewImportLog()
+	log.AddImport(job)
+	if got := log.Resources[0].Address(); got != job.Address() {
+		t.Errorf("import log address = %q, want %q", got, job.Address())
+	}
+}
+
+func TestImportLog_ModuleRoundTrip(t *testing.T) {
+	path := filepath.Join(t.TempDir(), ".import-log")
+	log := NewImportLog()
+	log.AddImport(ImportJob{ResourceType: "hyperping_monitor", ResourceName: "api", ResourceID: "mon_api", Module: "module.monitoring"})
+	log.AddImport(ImportJob{ResourceType: "hyperping_monitor", ResourceName: "web", ResourceID: "mon_web"})
+	if err := log.Save(path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	loaded, err := LoadImportLog(path)
+	if err != nil {
+		t.Fatalf("LoadImportLog: %v", err)
+	}
+	want := []string{"module.monitoring.hyperping_monitor.api", "hyperping_monitor.web"}
+	for i, entry := range loaded.Resources {
+		if got := entry.Address(); got != want[i] {
+			t.Errorf("entry %d address = %q, want %q", i, got, want[i])
+		}
+	}
+}
+
+func TestModuleDir(t *testing.T) {
+	dir := t.TempDir()
+	manifest := filepath.Join(dir, ".terraform", "modules")
+	if err := os.MkdirAll(manifest, 0o750); err != nil {
+		t.Fatal(err)
+	}
+	modules := `{"Modules":[
+  {"Key":"","Source":"","Dir":"."},
+  {"Key":"platform","Source":"./platform","Dir":"platform"},
+  {"Key":"platform.monitoring","Source":"./monitoring","Dir":"platform/monitoring"}
+]}`
+	if err := os.WriteFile(filepath.Join(manifest, "modules.json"), []byte(modules), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := moduleDir(dir, "module.platform.module.monitoring")
+	if err != nil {
+		t.Fatalf("moduleDir: %v", err)
+	}
+	if want := filepath.Join(dir, "platform", "monitoring"); got != want {
+		t.Errorf("moduleDir = %q, want %q", got, want)
+	}
+
+	if _, err := moduleDir(dir, "module.monitoring"); err == nil || !strings.Contains(err.Error(), "not installed") {
+		t.Errorf("unknown module error = %v", err)
+	}
+	if _, err := moduleDir(t.TempDir(), "module.monitoring"); err == nil || !strings.Contains(err.Error(), "terraform init") {
+		t.Errorf("uninitialized directory error = %v", err)
+	}
+}
@@ -52,7 +52,7 @@ func (dd *DriftDetector) DetectDrift(ctx context.Context) (*DriftResult, error)
 	fmt.Println()
 
 	// Run terraform plan with detailed exit code
-	cmd := exec.CommandContext(ctx, "terraform", "plan", "-detailed-exitcode", "-no-color")
+	cmd := terraformCommand(ctx, "plan", "-detailed-exitcode", "-no-color")
 	output, err := cmd.CombinedOutput()
 	result.PlanOutput = string(output)
 
@@ -181,27 +181,11 @@ func PromptToContinueWithDrift() bool {
 	return response == "yes"
 }
 
-// VerifyTerraformInit checks if terraform has been initialized.
-func VerifyTerraformInit() error {
-	cmd := exec.Command("terraform", "version")
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("terraform command not found or not working: %w", err)
-	}
-
-	// Check for .terraform directory
-	cmd = exec.Command("test", "-d", ".terraform")
-	if err := cmd.Run(); err != nil {
-		return fmt.Errorf("terraform not initialized (run 'terraform init')")
-	}
-
-	return nil
-}
-
 // RefreshState runs terraform refresh to update state from remote.
 func RefreshState(ctx context.Context) error {
 	fmt.Println("Refreshing Terraform state...")
 
-	cmd := exec.CommandContext(ctx, "terraform", "refresh")
+	cmd := terraformCommand(ctx, "refresh")
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
@@ -216,7 +200,7 @@ func RefreshState(ctx context.Context) error {
 func ValidateTerraformConfig(ctx context.Context) error {
 	fmt.Println("Validating Terraform configuration...")
 
-	cmd := exec.CommandContext(ctx, "terraform", "validate")
+	cmd := terraformCommand(ctx, "validate")
 	output, err := cmd.CombinedOutput()
 
 	if err != nil {
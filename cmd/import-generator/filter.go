@@ -167,6 +167,24 @@ func (fc *FilterConfig) FilterOutages(outages []hyperping.Outage) []hyperping.Ou
 	return filtered
 }
 
+// FilterItems applies filters to resources of any registered kind.
+func (fc *FilterConfig) FilterItems(resourceType string, items []resourceItem) []resourceItem {
+	if !fc.ShouldIncludeResourceType(resourceType) {
+		return nil
+	}
+	if fc.IsEmpty() {
+		return items
+	}
+
+	filtered := make([]resourceItem, 0, len(items))
+	for _, it := range items {
+		if fc.matchesName(it.name) {
+			filtered = append(filtered, it)
+		}
+	}
+	return filtered
+}
+
 // matchesName returns true if the name matches the filter criteria.
 func (fc *FilterConfig) matchesName(name string) bool {
 	// Check exclude pattern first
@@ -7,10 +7,14 @@ import (
 	"context"
 	"fmt"
 	"regexp"
+	"slices"
+	"strconv"
 	"strings"
 
 	hyperping "github.com/develeap/hyperping-go"
 
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
 	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
@@ -32,6 +36,23 @@ type Generator struct {
 	showProgress    bool
 	continueOnError bool
 	filterConfig    *FilterConfig
+
+	// extractVars lifts values repeated across monitors into variables
+	// (--extract-variables). variables holds them once HCL is generated.
+	extractVars bool
+	variables   *variableSet
+
+	// compatMode adds lifecycle ignore_changes for attributes the API does
+	// not return faithfully (--compat-mode).
+	compatMode compat.Mode
+
+	// modulePath is the module the resources live in (--module-path); it
+	// prefixes every import target address.
+	modulePath string
+
+	// importIdentity writes import blocks with an identity instead of an
+	// import ID (--import-identity).
+	importIdentity bool
 }
 
 // ResourceData holds fetched resource data for generation.
@@ -56,6 +77,10 @@ func (g *Generator) Generate(ctx context.Context, format string) (string, error)
 	switch format {
 	case "import":
 		g.generateImports(&sb, data)
+	case "import-blocks":
+		if err := g.generateImportBlocks(&sb, data); err != nil {
+			return "", err
+		}
 	case "hcl":
 		g.generateHCL(&sb, data)
 	case "both":
@@ -110,14 +135,17 @@ func (g *Generator) fetchResources(ctx context.Context) (*ResourceData, error) {
 
 // buildFetcherMap returns a map from resource key to its fetch entry.
 func (g *Generator) buildFetcherMap() map[string]resourceFetchEntry {
-	return map[string]resourceFetchEntry{
-		"monitors":     {name: "monitors", fetchFn: g.fetchMonitors},
-		"healthchecks": {name: "healthchecks", fetchFn: g.fetchHealthchecks},
-		"statuspages":  {name: "status pages", fetchFn: g.fetchStatusPages},
-		"incidents":    {name: "incidents", fetchFn: g.fetchIncidents},
-		"maintenance":  {name: "maintenance windows", fetchFn: g.fetchMaintenance},
-		"outages":      {name: "outages", fetchFn: g.fetchOutages},
+	fetchers := make(map[string]resourceFetchEntry, len(resourceKinds))
+	for _, kind := range resourceKinds {
+		fetch := kind.fetch
+		fetchers[kind.key] = resourceFetchEntry{
+			name: kind.label,
+			fetchFn: func(ctx context.Context, data *ResourceData, progress *ProgressReporter) error {
+				return fetch(g, ctx, data, progress)
+			},
+		}
 	}
+	return fetchers
 }
 
 func (g *Generator) fetchMonitors(ctx context.Context, data *ResourceData, progress *ProgressReporter) error {
@@ -167,11 +195,71 @@ func (g *Generator) fetchStatusPages(ctx context.Context, data *ResourceData, pr
 	if g.filterConfig != nil {
 		pages = g.filterConfig.FilterStatusPages(pages)
 	}
+	if err := g.resolveServiceMonitorIDs(ctx, pages); err != nil {
+		if !g.continueOnError {
+			return err
+		}
+		progress.Error(err)
+	}
 	data.StatusPages = pages
 	progress.Report(len(pages), "status page(s)")
 	return nil
 }
 
+// resolveServiceMonitorIDs replaces numeric monitor IDs in status page
+// services with monitor UUIDs. Pages edited in the dashboard reference
+// monitors by their v1 numeric ID, which the provider does not accept in
+// configuration. Monitors are only listed when a numeric ID is present; IDs
+// that match no monitor are left as they are.
+func (g *Generator) resolveServiceMonitorIDs(ctx context.Context, pages []hyperping.StatusPage) error {
+	if !slices.ContainsFunc(pages, func(sp hyperping.StatusPage) bool {
+		return slices.ContainsFunc(sp.Sections, func(s hyperping.StatusPageSection) bool {
+			return hasNumericServiceID(s.Services)
+		})
+	}) {
+		return nil
+	}
+
+	monitors, err := g.client.ListMonitors(ctx)
+	if err != nil {
+		return fmt.Errorf("resolving status page monitor IDs: %w", err)
+	}
+	uuids := make(map[string]string, len(monitors))
+	for _, m := range monitors {
+		uuids[strconv.Itoa(m.ID)] = m.UUID
+	}
+	for i := range pages {
+		for j := range pages[i].Sections {
+			resolveServiceIDs(pages[i].Sections[j].Services, uuids)
+		}
+	}
+	return nil
+}
+
+func hasNumericServiceID(services []hyperping.StatusPageService) bool {
+	return slices.ContainsFunc(services, func(svc hyperping.StatusPageService) bool {
+		return isNumericID(svc.UUID) || hasNumericServiceID(svc.Services)
+	})
+}
+
+func resolveServiceIDs(services []hyperping.StatusPageService, uuids map[string]string) {
+	for i := range services {
+		if uuid, ok := uuids[services[i].UUID]; ok && isNumericID(services[i].UUID) {
+			services[i].UUID = uuid
+		}
+		resolveServiceIDs(services[i].Services, uuids)
+	}
+}
+
+// isNumericID reports whether id is a v1 numeric monitor ID.
+func isNumericID(id string) bool {
+	if id == "" {
+		return false
+	}
+	_, err := strconv.Atoi(id)
+	return err == nil
+}
+
 func (g *Generator) fetchIncidents(ctx context.Context, data *ResourceData, progress *ProgressReporter) error {
 	incidents, err := g.client.ListIncidents(ctx)
 	if err != nil {
@@ -228,77 +316,76 @@ func (g *Generator) generateImports(sb *strings.Builder, data *ResourceData) {
 	// is the source of truth for these identifiers, but %q does not escape
 	// bash metacharacters ($, `, ;), so an attacker-influenced UUID-shaped
 	// value would otherwise smuggle command substitution into the script.
-	for _, m := range data.Monitors {
-		name := g.terraformName(m.Name)
-		fmt.Fprintf(sb, "terraform import hyperping_monitor.%s %s\n", name, migrate.QuoteShellUUID(m.UUID))
-	}
-
-	for _, h := range data.Healthchecks {
-		name := g.terraformName(h.Name)
-		fmt.Fprintf(sb, "terraform import hyperping_healthcheck.%s %s\n", name, migrate.QuoteShellUUID(h.UUID))
-	}
-
-	for _, sp := range data.StatusPages {
-		name := g.terraformName(sp.Name)
-		fmt.Fprintf(sb, "terraform import hyperping_statuspage.%s %s\n", name, migrate.QuoteShellUUID(sp.UUID))
-	}
-
-	for _, i := range data.Incidents {
-		name := g.terraformName(i.Title.En)
-		fmt.Fprintf(sb, "terraform import hyperping_incident.%s %s\n", name, migrate.QuoteShellUUID(i.UUID))
+	for _, kind := range resourceKinds {
+		for _, it := range kind.items(data) {
+			addr := g.address(kind.terraformType, g.terraformName(it.name))
+			fmt.Fprintf(sb, "terraform import %s %s\n", addr, migrate.QuoteShellUUID(it.uuid))
+		}
 	}
+}
 
-	for _, m := range data.Maintenance {
-		titleText := m.Title.En
-		if titleText == "" {
-			titleText = m.Name
+// generateImportBlocks writes an import block per resource. With
+// importIdentity the target is addressed by its resource identity, which
+// requires Terraform 1.12 or later:
+//
+//	import {
+//	  to       = hyperping_monitor.api
+//	  identity = { id = "mon_abc123" }
+//	}
+//
+// Every importable type is identified by its UUID alone, so the identity
+// holds the same value the import ID would.
+func (g *Generator) generateImportBlocks(sb *strings.Builder, data *ResourceData) error {
+	f := hclgen.NewFile()
+	root := f.Body()
+
+	for _, kind := range resourceKinds {
+		for _, it := range kind.items(data) {
+			block := root.Block("import")
+			addr := g.address(kind.terraformType, g.terraformName(it.name))
+			if err := block.SetReference("to", addr); err != nil {
+				return fmt.Errorf("import block for %s: %w", addr, err)
+			}
+			if g.importIdentity {
+				block.SetObject("identity", hclgen.Attr{Name: "id", Value: it.uuid})
+			} else {
+				block.SetString("id", it.uuid)
+			}
+			root.Newline()
 		}
-		name := g.terraformName(titleText)
-		fmt.Fprintf(sb, "terraform import hyperping_maintenance.%s %s\n", name, migrate.QuoteShellUUID(m.UUID))
 	}
 
-	for _, o := range data.Outages {
-		name := g.terraformName(o.Monitor.Name)
-		fmt.Fprintf(sb, "terraform import hyperping_outage.%s %s\n", name, migrate.QuoteShellUUID(o.UUID))
-	}
+	sb.Write(f.Bytes())
+	return nil
 }
 
 func (g *Generator) generateHCL(sb *strings.Builder, data *ResourceData) {
-	// Monitors
-	for _, m := range data.Monitors {
-		g.generateMonitorHCL(sb, m)
-		sb.WriteString("\n")
+	if g.extractVars {
+		g.variables = g.extractVariables(data.Monitors)
 	}
 
-	// Healthchecks
-	for _, h := range data.Healthchecks {
-		g.generateHealthcheckHCL(sb, h)
-		sb.WriteString("\n")
-	}
+	f := hclgen.NewFile()
+	root := f.Body()
 
-	// Status Pages
-	for _, sp := range data.StatusPages {
-		g.generateStatusPageHCL(sb, sp)
-		sb.WriteString("\n")
-	}
-
-	// Incidents
-	for _, i := range data.Incidents {
-		g.generateIncidentHCL(sb, i)
-		sb.WriteString("\n")
+	for _, kind := range resourceKinds {
+		for _, it := range kind.items(data) {
+			it.hcl(g, root)
+			root.Newline()
+		}
 	}
 
-	// Maintenance
-	for _, m := range data.Maintenance {
-		g.generateMaintenanceHCL(sb, m)
-		sb.WriteString("\n")
-	}
+	sb.Write(f.Bytes())
+}
 
-	// Outages
-	for _, o := range data.Outages {
-		g.generateOutageHCL(sb, o)
-		sb.WriteString("\n")
+// VariablesHCL returns the variables.tf extracted by the last HCL generation,
+// or "" when --extract-variables is off or no value was repeated.
+func (g *Generator) VariablesHCL() string {
+	if g.variables.empty() {
+		return ""
 	}
+	var sb strings.Builder
+	g.variables.generateVariablesHCL(&sb)
+	return sb.String()
 }
 
 // terraformName converts a resource name to a valid Terraform identifier.
@@ -330,23 +417,3 @@ func (g *Generator) terraformName(name string) string {
 
 	return tfName
 }
-
-// escapeHCL escapes a string for HCL output. Delegates to migrate.EscapeHCL
-// so HCL template-interpolation sequences are neutralized in addition to
-// backslashes/quotes/newlines.
-func escapeHCL(s string) string {
-	return migrate.EscapeHCL(s)
-}
-
-// formatStringList formats a Go string slice as an HCL list, with each item
-// safely quoted via migrate.QuoteHCL (template-interpolation safe).
-func formatStringList(items []string) string {
-	if len(items) == 0 {
-		return "[]"
-	}
-	quoted := make([]string, len(items))
-	for i, item := range items {
-		quoted[i] = migrate.QuoteHCL(item)
-	}
-	return "[" + strings.Join(quoted, ", ") + "]"
-}
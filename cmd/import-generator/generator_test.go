@@ -6,12 +6,30 @@ package main
 import (
 	"context"
 	"errors"
+	"regexp"
 	"strings"
 	"testing"
 
 	hyperping "github.com/develeap/hyperping-go"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
 )
 
+// alignment matches the padding hclwrite inserts to align "=" signs.
+var alignment = regexp.MustCompile(`(\S) {2,}= `)
+
+// renderHCL renders the content written by fn and appends it to sb with
+// alignment padding removed, so assertions do not depend on which attributes
+// share an alignment group.
+func renderHCL(sb *strings.Builder, fn func(*hclgen.Body)) {
+	f := hclgen.NewFile()
+	fn(f.Body())
+	sb.WriteString(alignment.ReplaceAllString(f.String(), "$1 = "))
+}
+
 // mockClient implements APIClient for testing.
 type mockClient struct {
 	monitors     []hyperping.Monitor
@@ -138,7 +156,7 @@ func TestTerraformName(t *testing.T) {
 }
 
 // =============================================================================
-// escapeHCL Tests
+// HCL string escaping Tests
 // =============================================================================
 
 func TestEscapeHCL(t *testing.T) {
@@ -155,18 +173,19 @@ func TestEscapeHCL(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		result := escapeHCL(tc.input)
-		if result != tc.expected {
-			t.Errorf("escapeHCL(%q) = %q, want %q", tc.input, result, tc.expected)
+		var sb strings.Builder
+		renderHCL(&sb, func(b *hclgen.Body) { b.SetString("v", tc.input) })
+		if want := "v = \"" + tc.expected + "\"\n"; sb.String() != want {
+			t.Errorf("SetString(%q) = %q, want %q", tc.input, sb.String(), want)
 		}
 	}
 }
 
 // =============================================================================
-// formatStringList Tests
+// HCL string list Tests
 // =============================================================================
 
-func TestFormatStringList(t *testing.T) {
+func TestStringListHCL(t *testing.T) {
 	tests := []struct {
 		input    []string
 		expected string
@@ -180,9 +199,10 @@ func TestFormatStringList(t *testing.T) {
 	}
 
 	for _, tc := range tests {
-		result := formatStringList(tc.input)
-		if result != tc.expected {
-			t.Errorf("formatStringList(%v) = %q, want %q", tc.input, result, tc.expected)
+		var sb strings.Builder
+		renderHCL(&sb, func(b *hclgen.Body) { b.SetStringList("v", tc.input) })
+		if want := "v = " + tc.expected + "\n"; sb.String() != want {
+			t.Errorf("SetStringList(%v) = %q, want %q", tc.input, sb.String(), want)
 		}
 	}
 }
@@ -545,6 +565,46 @@ func TestGenerateImports_WithPrefix(t *testing.T) {
 	}
 }
 
+func TestGenerateImports_WithModulePath(t *testing.T) {
+	g := &Generator{modulePath: "module.monitoring"}
+	var sb strings.Builder
+
+	data := &ResourceData{
+		Monitors: []hyperping.Monitor{
+			{UUID: "mon_123", Name: "API"},
+		},
+	}
+
+	g.generateImports(&sb, data)
+	result := sb.String()
+
+	if !strings.Contains(result, `terraform import module.monitoring.hyperping_monitor.api "mon_123"`) {
+		t.Errorf("Expected module-qualified address, got: %s", result)
+	}
+}
+
+func TestGenerateImportBlocks_WithModulePath(t *testing.T) {
+	g := &Generator{modulePath: "module.monitoring", importIdentity: true}
+	var sb strings.Builder
+
+	data := &ResourceData{
+		Monitors: []hyperping.Monitor{
+			{UUID: "mon_123", Name: "API"},
+		},
+	}
+
+	if err := g.generateImportBlocks(&sb, data); err != nil {
+		t.Fatalf("generateImportBlocks: %v", err)
+	}
+	result := sb.String()
+
+	for _, want := range []string{"to = module.monitoring.hyperping_monitor.api", "identity = {", `id = "mon_123"`} {
+		if !strings.Contains(result, want) {
+			t.Errorf("Expected %q, got: %s", want, result)
+		}
+	}
+}
+
 // =============================================================================
 // generateMonitorHCL Tests
 // =============================================================================
@@ -562,13 +622,13 @@ func TestGenerateMonitorHCL_Basic(t *testing.T) {
 		Regions:        []string{"virginia"},
 	}
 
-	g.generateMonitorHCL(&sb, monitor)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMonitorHCL(b, monitor) })
 	result := sb.String()
 
 	assertions := []string{
 		`resource "hyperping_monitor" "test_monitor"`,
-		`name     = "Test Monitor"`,
-		`url      = "https://example.com"`,
+		`name = "Test Monitor"`,
+		`url = "https://example.com"`,
 		`protocol = "http"`,
 		`regions = ["virginia"]`,
 	}
@@ -610,7 +670,7 @@ func TestGenerateMonitorHCL_AllOptionalFields(t *testing.T) {
 		RequestBody:      `{"test": true}`,
 	}
 
-	g.generateMonitorHCL(&sb, monitor)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMonitorHCL(b, monitor) })
 	result := sb.String()
 
 	assertions := []string{
@@ -624,7 +684,7 @@ func TestGenerateMonitorHCL_AllOptionalFields(t *testing.T) {
 		`alerts_wait = 5`,
 		`escalation_policy = "esc_123"`,
 		`request_headers = [`,
-		`name  = "Auth"`,
+		`name = "Auth"`,
 		`value = "Bearer token"`,
 		`request_body = "{\"test\": true}"`,
 	}
@@ -651,7 +711,7 @@ func TestGenerateMonitorHCL_NilPointers(t *testing.T) {
 		EscalationPolicy: nil,
 	}
 
-	g.generateMonitorHCL(&sb, monitor)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMonitorHCL(b, monitor) })
 	result := sb.String()
 
 	// Should not contain optional fields when nil
@@ -679,7 +739,7 @@ func TestGenerateMonitorHCL_ZeroPort(t *testing.T) {
 		Port:     &port,
 	}
 
-	g.generateMonitorHCL(&sb, monitor)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMonitorHCL(b, monitor) })
 	result := sb.String()
 
 	// Should not include port = 0
@@ -705,7 +765,7 @@ func TestGenerateHealthcheckHCL_WithCron(t *testing.T) {
 		IsPaused:    true,
 	}
 
-	g.generateHealthcheckHCL(&sb, healthcheck)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateHealthcheckHCL(b, healthcheck) })
 	result := sb.String()
 
 	assertions := []string{
@@ -736,7 +796,7 @@ func TestGenerateHealthcheckHCL_WithPeriod(t *testing.T) {
 		PeriodType:  "minutes",
 	}
 
-	g.generateHealthcheckHCL(&sb, healthcheck)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateHealthcheckHCL(b, healthcheck) })
 	result := sb.String()
 
 	assertions := []string{
@@ -765,7 +825,7 @@ func TestGenerateHealthcheckHCL_Minimal(t *testing.T) {
 		Name: "Simple",
 	}
 
-	g.generateHealthcheckHCL(&sb, healthcheck)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateHealthcheckHCL(b, healthcheck) })
 	result := sb.String()
 
 	if !strings.Contains(result, `resource "hyperping_healthcheck" "simple"`) {
@@ -801,15 +861,15 @@ func TestGenerateStatusPageHCL_Basic(t *testing.T) {
 		},
 	}
 
-	g.generateStatusPageHCL(&sb, statusPage)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateStatusPageHCL(b, statusPage) })
 	result := sb.String()
 
 	assertions := []string{
 		`resource "hyperping_statuspage" "main_status"`,
-		`name             = "Main Status"`,
+		`name = "Main Status"`,
 		`hosted_subdomain = "status"`,
 		`settings = {`,
-		`name      = "Main Status"`,
+		`name = "Main Status"`,
 		`languages = ["en"]`,
 	}
 
@@ -838,7 +898,7 @@ func TestGenerateStatusPageHCL_WithHostname(t *testing.T) {
 		},
 	}
 
-	g.generateStatusPageHCL(&sb, statusPage)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateStatusPageHCL(b, statusPage) })
 	result := sb.String()
 
 	assertions := []string{
@@ -868,15 +928,91 @@ func TestGenerateStatusPageHCL_WithSections(t *testing.T) {
 			Languages: []string{"en"},
 		},
 		Sections: []hyperping.StatusPageSection{
-			{Name: map[string]string{"en": "API"}},
+			{
+				Name: map[string]string{"en": "API", "fr": "API FR"},
+				Services: []hyperping.StatusPageService{
+					{UUID: "mon_web", Name: map[string]string{"en": "Website"}, ShowUptime: true},
+					{
+						Name:    map[string]string{"en": "Backend"},
+						IsGroup: true,
+						Services: []hyperping.StatusPageService{
+							{UUID: "mon_db", Name: map[string]string{"en": "Database"}},
+							{UUID: "117122", Name: map[string]string{"en": "Legacy"}},
+						},
+					},
+				},
+			},
 		},
 	}
 
-	g.generateStatusPageHCL(&sb, statusPage)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateStatusPageHCL(b, statusPage) })
 	result := sb.String()
 
-	if !strings.Contains(result, "# Note: Sections imported") {
-		t.Error("Missing sections note")
+	file, diags := hclsyntax.ParseConfig([]byte(result), "statuspage.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("generated HCL does not parse: %s\n%s", diags.Error(), result)
+	}
+	attrs, diags := file.Body.(*hclsyntax.Body).Blocks[0].Body.JustAttributes()
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+	sections, diags := attrs["sections"].Expr.Value(nil)
+	if diags.HasErrors() {
+		t.Fatal(diags.Error())
+	}
+
+	section := sections.Index(cty.NumberIntVal(0))
+	if got := section.GetAttr("name").AsValueMap(); len(got) != 1 || got["en"].AsString() != "API" {
+		t.Errorf("section name should keep only page languages, got %#v", got)
+	}
+	services := section.GetAttr("services")
+	if got := services.Index(cty.NumberIntVal(0)).GetAttr("uuid").AsString(); got != "mon_web" {
+		t.Errorf("services[0].uuid = %q", got)
+	}
+
+	group := services.Index(cty.NumberIntVal(1))
+	if !group.GetAttr("is_group").True() {
+		t.Error("services[1] should be a group")
+	}
+	if group.Type().HasAttribute("uuid") {
+		t.Error("group entries must not set uuid")
+	}
+	children := group.GetAttr("services")
+	if children.LengthInt() != 2 || children.Index(cty.NumberIntVal(0)).GetAttr("uuid").AsString() != "mon_db" {
+		t.Errorf("unexpected group children: %#v", children)
+	}
+
+	if !strings.Contains(result, "# Monitor ID 117122 could not be resolved to a UUID") {
+		t.Errorf("missing note for unresolved numeric ID:\n%s", result)
+	}
+}
+
+func TestFetchStatusPages_ResolvesNumericServiceIDs(t *testing.T) {
+	client := &mockClient{
+		monitors: []hyperping.Monitor{{ID: 117122, UUID: "mon_db"}},
+		statusPages: []hyperping.StatusPage{{
+			UUID: "sp_1",
+			Sections: []hyperping.StatusPageSection{{
+				Services: []hyperping.StatusPageService{{
+					IsGroup:  true,
+					Services: []hyperping.StatusPageService{{UUID: "117122"}, {UUID: "999"}, {UUID: "mon_api"}},
+				}},
+			}},
+		}},
+	}
+	g := &Generator{client: client}
+	data := &ResourceData{}
+
+	if err := g.fetchStatusPages(context.Background(), data, NewProgressReporter(false)); err != nil {
+		t.Fatal(err)
+	}
+
+	children := data.StatusPages[0].Sections[0].Services[0].Services
+	want := []string{"mon_db", "999", "mon_api"}
+	for i, uuid := range want {
+		if children[i].UUID != uuid {
+			t.Errorf("children[%d].UUID = %q, want %q", i, children[i].UUID, uuid)
+		}
 	}
 }
 
@@ -895,7 +1031,7 @@ func TestGenerateStatusPageHCL_DefaultSettings(t *testing.T) {
 		},
 	}
 
-	g.generateStatusPageHCL(&sb, statusPage)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateStatusPageHCL(b, statusPage) })
 	result := sb.String()
 
 	// Should not include default values
@@ -924,7 +1060,7 @@ func TestGenerateIncidentHCL_Basic(t *testing.T) {
 		Text:  hyperping.LocalizedText{En: "Investigating the issue"},
 	}
 
-	g.generateIncidentHCL(&sb, incident)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateIncidentHCL(b, incident) })
 	result := sb.String()
 
 	assertions := []string{
@@ -953,7 +1089,7 @@ func TestGenerateIncidentHCL_AllFields(t *testing.T) {
 		AffectedComponents: []string{"api", "web"},
 	}
 
-	g.generateIncidentHCL(&sb, incident)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateIncidentHCL(b, incident) })
 	result := sb.String()
 
 	assertions := []string{
@@ -979,7 +1115,7 @@ func TestGenerateIncidentHCL_DefaultType(t *testing.T) {
 		Type:  "incident",
 	}
 
-	g.generateIncidentHCL(&sb, incident)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateIncidentHCL(b, incident) })
 	result := sb.String()
 
 	// Should not include default type
@@ -1006,7 +1142,7 @@ func TestGenerateMaintenanceHCL_Basic(t *testing.T) {
 		EndDate:   &endDate,
 	}
 
-	g.generateMaintenanceHCL(&sb, maintenance)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMaintenanceHCL(b, maintenance) })
 	result := sb.String()
 
 	assertions := []string{
@@ -1014,7 +1150,7 @@ func TestGenerateMaintenanceHCL_Basic(t *testing.T) {
 		`title = "DB Maintenance"`,
 		`text = "Routine maintenance"`,
 		`start_date = "2026-01-20T02:00:00Z"`,
-		`end_date   = "2026-01-20T04:00:00Z"`,
+		`end_date = "2026-01-20T04:00:00Z"`,
 	}
 
 	for _, assertion := range assertions {
@@ -1034,7 +1170,7 @@ func TestGenerateMaintenanceHCL_FallbackToName(t *testing.T) {
 		Title: hyperping.LocalizedText{En: ""},
 	}
 
-	g.generateMaintenanceHCL(&sb, maintenance)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMaintenanceHCL(b, maintenance) })
 	result := sb.String()
 
 	if !strings.Contains(result, `title = "Fallback Name"`) {
@@ -1055,7 +1191,7 @@ func TestGenerateMaintenanceHCL_WithStatusPages(t *testing.T) {
 		StatusPages: []string{"sp_1", "sp_2"},
 	}
 
-	g.generateMaintenanceHCL(&sb, maintenance)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMaintenanceHCL(b, maintenance) })
 	result := sb.String()
 
 	if !strings.Contains(result, `status_pages = ["sp_1", "sp_2"]`) {
@@ -1079,7 +1215,7 @@ func TestGenerateOutageHCL_Basic(t *testing.T) {
 		},
 	}
 
-	g.generateOutageHCL(&sb, outage)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateOutageHCL(b, outage) })
 	result := sb.String()
 
 	assertions := []string{
@@ -1108,7 +1244,7 @@ func TestGenerateOutageHCL_WithDescription(t *testing.T) {
 		},
 	}
 
-	g.generateOutageHCL(&sb, outage)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateOutageHCL(b, outage) })
 	result := sb.String()
 
 	if !strings.Contains(result, `# description = "Connection timeout"`) {
@@ -1262,7 +1398,7 @@ func TestGenerateMonitorHCL_ExpectedStatusCode(t *testing.T) {
 		ExpectedStatusCode: hyperping.FlexibleString("201"),
 	}
 
-	g.generateMonitorHCL(&sb, monitor)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMonitorHCL(b, monitor) })
 	result := sb.String()
 
 	if !strings.Contains(result, `expected_status_code = "201"`) {
@@ -1283,7 +1419,7 @@ func TestGenerateMonitorHCL_EmptyKeyword(t *testing.T) {
 		RequiredKeyword: &emptyKeyword,
 	}
 
-	g.generateMonitorHCL(&sb, monitor)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMonitorHCL(b, monitor) })
 	result := sb.String()
 
 	// Should not include empty required_keyword
@@ -1305,7 +1441,7 @@ func TestGenerateMonitorHCL_EmptyEscalationPolicy(t *testing.T) {
 		EscalationPolicy: &emptyPolicy,
 	}
 
-	g.generateMonitorHCL(&sb, monitor)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMonitorHCL(b, monitor) })
 	result := sb.String()
 
 	// Should not include empty escalation_policy
@@ -1326,7 +1462,7 @@ func TestGenerateMonitorHCL_DefaultHTTPMethod(t *testing.T) {
 		HTTPMethod: "GET",
 	}
 
-	g.generateMonitorHCL(&sb, monitor)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMonitorHCL(b, monitor) })
 	result := sb.String()
 
 	// Should not include default GET method
@@ -1347,7 +1483,7 @@ func TestGenerateMonitorHCL_EmptyHTTPMethod(t *testing.T) {
 		HTTPMethod: "",
 	}
 
-	g.generateMonitorHCL(&sb, monitor)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMonitorHCL(b, monitor) })
 	result := sb.String()
 
 	// Should not include empty http_method
@@ -1375,7 +1511,7 @@ func TestGenerateStatusPageHCL_EmptyHostname(t *testing.T) {
 		},
 	}
 
-	g.generateStatusPageHCL(&sb, statusPage)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateStatusPageHCL(b, statusPage) })
 	result := sb.String()
 
 	// Should not include empty hostname
@@ -1397,7 +1533,7 @@ func TestGenerateStatusPageHCL_EmptyLanguages(t *testing.T) {
 		},
 	}
 
-	g.generateStatusPageHCL(&sb, statusPage)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateStatusPageHCL(b, statusPage) })
 	result := sb.String()
 
 	// Should default to ["en"]
@@ -1420,7 +1556,7 @@ func TestGenerateIncidentHCL_EmptyText(t *testing.T) {
 		Text:  hyperping.LocalizedText{En: ""},
 	}
 
-	g.generateIncidentHCL(&sb, incident)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateIncidentHCL(b, incident) })
 	result := sb.String()
 
 	// Should not include empty text
@@ -1439,7 +1575,7 @@ func TestGenerateIncidentHCL_EmptyType(t *testing.T) {
 		Type:  "",
 	}
 
-	g.generateIncidentHCL(&sb, incident)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateIncidentHCL(b, incident) })
 	result := sb.String()
 
 	// Should not include empty type
@@ -1462,7 +1598,7 @@ func TestGenerateMaintenanceHCL_EmptyText(t *testing.T) {
 		Text:  hyperping.LocalizedText{En: ""},
 	}
 
-	g.generateMaintenanceHCL(&sb, maintenance)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMaintenanceHCL(b, maintenance) })
 	result := sb.String()
 
 	// Should not include empty text
@@ -1482,7 +1618,7 @@ func TestGenerateMaintenanceHCL_NilDates(t *testing.T) {
 		EndDate:   nil,
 	}
 
-	g.generateMaintenanceHCL(&sb, maintenance)
+	renderHCL(&sb, func(b *hclgen.Body) { g.generateMaintenanceHCL(b, maintenance) })
 	result := sb.String()
 
 	// Should not include nil dates
@@ -4,214 +4,238 @@
 package main
 
 import (
-	"fmt"
-	"strings"
-
 	hyperping "github.com/develeap/hyperping-go"
 
-	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
 )
 
-// buildOptionalStringField returns an HCL line for a string field only when
-// the value is non-empty and differs from the given skip value.
-// Returns an empty string when the field should be omitted.
-func buildOptionalStringField(name, value, skipValue string) string {
+// setOptionalString sets a string attribute only when the value is non-empty
+// and differs from the given skip value.
+func setOptionalString(b *hclgen.Body, name, value, skipValue string) {
 	if value == "" || value == skipValue {
-		return ""
+		return
 	}
-	return fmt.Sprintf("  %s = %s\n", name, migrate.QuoteHCL(value))
+	b.SetString(name, value)
 }
 
-// buildOptionalIntField returns an HCL line for an int field only when
-// the value differs from the skip value (typically 0 or a default).
-func buildOptionalIntField(name string, value, skipValue int) string {
+// setOptionalInt sets an int attribute only when the value differs from the
+// skip value (typically 0 or a default).
+func setOptionalInt(b *hclgen.Body, name string, value, skipValue int) {
 	if value == skipValue {
-		return ""
+		return
 	}
-	return fmt.Sprintf("  %s = %d\n", name, value)
+	b.SetInt(name, value)
 }
 
-func (g *Generator) generateMonitorHCL(sb *strings.Builder, m hyperping.Monitor) {
-	name := g.terraformName(m.Name)
-
-	// name is sanitized to identifier-safe characters by terraformName; safe for %q.
-	fmt.Fprintf(sb, "resource \"hyperping_monitor\" %q {\n", name)
-	fmt.Fprintf(sb, "  name     = %s\n", migrate.QuoteHCL(m.Name))
-	fmt.Fprintf(sb, "  url      = %s\n", migrate.QuoteHCL(m.URL))
-	fmt.Fprintf(sb, "  protocol = %s\n", migrate.QuoteHCL(m.Protocol))
+func (g *Generator) generateMonitorHCL(root *hclgen.Body, m hyperping.Monitor) {
+	r := root.Block("resource", "hyperping_monitor", g.terraformName(m.Name))
+	r.SetString("name", m.Name)
+	r.SetString("url", m.URL)
+	r.SetString("protocol", m.Protocol)
 
-	sb.WriteString(buildOptionalStringField("http_method", m.HTTPMethod, "GET"))
-	sb.WriteString(buildOptionalIntField("check_frequency", m.CheckFrequency, 60))
+	setOptionalString(r, "http_method", m.HTTPMethod, "GET")
+	g.variables.setCheckFrequency(r, m.CheckFrequency)
 
 	if len(m.Regions) > 0 {
-		fmt.Fprintf(sb, "  regions = %s\n", formatStringList(m.Regions))
+		g.variables.setRegions(r, m.Regions)
 	}
 
 	if m.Port != nil && *m.Port != 0 {
-		fmt.Fprintf(sb, "  port = %d\n", *m.Port)
+		r.SetInt("port", *m.Port)
 	}
 
 	if !m.FollowRedirects {
-		sb.WriteString("  follow_redirects = false\n")
+		r.SetBool("follow_redirects", false)
 	}
 
-	if m.ExpectedStatusCode.String() != "" && m.ExpectedStatusCode.String() != "200" {
-		fmt.Fprintf(sb, "  expected_status_code = %s\n", migrate.QuoteHCL(m.ExpectedStatusCode.String()))
-	}
+	setOptionalString(r, "expected_status_code", m.ExpectedStatusCode.String(), "200")
 
-	if m.RequiredKeyword != nil && *m.RequiredKeyword != "" {
-		fmt.Fprintf(sb, "  required_keyword = %s\n", migrate.QuoteHCL(*m.RequiredKeyword))
+	if m.RequiredKeyword != nil {
+		setOptionalString(r, "required_keyword", *m.RequiredKeyword, "")
 	}
 
 	if m.Paused {
-		sb.WriteString("  paused = true\n")
+		r.SetBool("paused", true)
 	}
 
-	sb.WriteString(buildOptionalIntField("alerts_wait", m.AlertsWait, 0))
+	setOptionalInt(r, "alerts_wait", m.AlertsWait, 0)
 
-	if m.EscalationPolicy != nil && m.EscalationPolicy.UUID != "" {
-		fmt.Fprintf(sb, "  escalation_policy = %s\n", migrate.QuoteHCL(m.EscalationPolicy.UUID))
+	if m.EscalationPolicy != nil {
+		g.variables.setEscalationPolicy(r, m.EscalationPolicy.UUID)
 	}
 
 	if len(m.RequestHeaders) > 0 {
-		sb.WriteString("  request_headers = [\n")
-		for _, h := range m.RequestHeaders {
-			sb.WriteString("    {\n")
-			fmt.Fprintf(sb, "      name  = %s\n", migrate.QuoteHCL(h.Name))
-			fmt.Fprintf(sb, "      value = %s\n", migrate.QuoteHCL(h.Value))
-			sb.WriteString("    },\n")
+		headers := make([][]hclgen.Attr, len(m.RequestHeaders))
+		for i, h := range m.RequestHeaders {
+			headers[i] = []hclgen.Attr{{Name: "name", Value: h.Name}, {Name: "value", Value: h.Value}}
 		}
-		sb.WriteString("  ]\n")
+		r.SetObjectList("request_headers", headers)
 	}
 
-	sb.WriteString(buildOptionalStringField("request_body", m.RequestBody, ""))
+	setOptionalString(r, "request_body", m.RequestBody, "")
 
-	sb.WriteString("}\n")
+	compat.WriteLifecycle(r, g.compatMode, compat.MonitorFields(r, m.Protocol))
 }
 
-func (g *Generator) generateHealthcheckHCL(sb *strings.Builder, h hyperping.Healthcheck) {
-	name := g.terraformName(h.Name)
-
-	fmt.Fprintf(sb, "resource \"hyperping_healthcheck\" %q {\n", name)
-	fmt.Fprintf(sb, "  name = %s\n", migrate.QuoteHCL(h.Name))
+func (g *Generator) generateHealthcheckHCL(root *hclgen.Body, h hyperping.Healthcheck) {
+	r := root.Block("resource", "hyperping_healthcheck", g.terraformName(h.Name))
+	r.SetString("name", h.Name)
 
 	if h.Cron != "" {
-		fmt.Fprintf(sb, "  cron = %s\n", migrate.QuoteHCL(h.Cron))
-		if h.Timezone != "" {
-			fmt.Fprintf(sb, "  timezone = %s\n", migrate.QuoteHCL(h.Timezone))
-		}
+		r.SetString("cron", h.Cron)
+		setOptionalString(r, "timezone", h.Timezone, "")
 	} else if h.PeriodValue != nil && *h.PeriodValue > 0 {
-		fmt.Fprintf(sb, "  period_value = %d\n", *h.PeriodValue)
-		fmt.Fprintf(sb, "  period_type = %s\n", migrate.QuoteHCL(h.PeriodType))
+		r.SetInt("period_value", *h.PeriodValue)
+		r.SetString("period_type", h.PeriodType)
 	}
 
 	if h.GracePeriod > 0 {
-		fmt.Fprintf(sb, "  grace_period = %d\n", h.GracePeriod)
+		r.SetInt("grace_period", h.GracePeriod)
 	}
 
 	if h.IsPaused {
-		sb.WriteString("  is_paused = true\n")
+		r.SetBool("is_paused", true)
 	}
-
-	sb.WriteString("}\n")
 }
 
-func (g *Generator) generateStatusPageHCL(sb *strings.Builder, sp hyperping.StatusPage) {
-	name := g.terraformName(sp.Name)
+func (g *Generator) generateStatusPageHCL(root *hclgen.Body, sp hyperping.StatusPage) {
+	r := root.Block("resource", "hyperping_statuspage", g.terraformName(sp.Name))
+	r.SetString("name", sp.Name)
+	r.SetString("hosted_subdomain", sp.HostedSubdomain)
 
-	fmt.Fprintf(sb, "resource \"hyperping_statuspage\" %q {\n", name)
-	fmt.Fprintf(sb, "  name             = %s\n", migrate.QuoteHCL(sp.Name))
-	fmt.Fprintf(sb, "  hosted_subdomain = %s\n", migrate.QuoteHCL(sp.HostedSubdomain))
+	if sp.Hostname != nil {
+		setOptionalString(r, "hostname", *sp.Hostname, "")
+	}
 
-	if sp.Hostname != nil && *sp.Hostname != "" {
-		fmt.Fprintf(sb, "  hostname = %s\n", migrate.QuoteHCL(*sp.Hostname))
+	languages := sp.Settings.Languages
+	if len(languages) == 0 {
+		languages = []string{"en"}
 	}
 
 	// Settings block
-	sb.WriteString("\n  settings = {\n")
-	fmt.Fprintf(sb, "    name      = %s\n", migrate.QuoteHCL(sp.Name))
+	r.Newline()
+	r.SetNestedObject("settings", func(settings *hclgen.Body) {
+		settings.SetString("name", sp.Name)
 
-	if len(sp.Settings.Languages) > 0 {
-		fmt.Fprintf(sb, "    languages = %s\n", formatStringList(sp.Settings.Languages))
-	} else {
-		sb.WriteString("    languages = [\"en\"]\n")
-	}
-
-	sb.WriteString(buildOptionalStringField("theme", sp.Settings.Theme, "system"))
-	sb.WriteString(buildOptionalStringField("font", sp.Settings.Font, "Inter"))
-	sb.WriteString(buildOptionalStringField("accent_color", sp.Settings.AccentColor, "#36b27e"))
+		settings.SetStringList("languages", languages)
 
-	sb.WriteString("  }\n")
+		setOptionalString(settings, "theme", sp.Settings.Theme, "system")
+		setOptionalString(settings, "font", sp.Settings.Font, "Inter")
+		setOptionalString(settings, "accent_color", sp.Settings.AccentColor, "#36b27e")
+	})
 
-	// Sections (simplified - just note they exist)
 	if len(sp.Sections) > 0 {
-		sb.WriteString("\n  # Note: Sections imported - review and adjust as needed\n")
-		sb.WriteString("  # sections = [...]\n")
+		r.Newline()
+		generateSectionsHCL(r, sp.Sections, languages)
 	}
 
-	sb.WriteString("}\n")
+	compat.WriteLifecycle(r, g.compatMode, compat.StatusPageFields(sp))
 }
 
-func (g *Generator) generateIncidentHCL(sb *strings.Builder, i hyperping.Incident) {
-	name := g.terraformName(i.Title.En)
+// generateSectionsHCL writes the sections of a status page, including group
+// entries and their nested services, so the imported page plans cleanly.
+// Localized names are limited to the page languages, matching what the
+// provider keeps in state.
+func generateSectionsHCL(r *hclgen.Body, sections []hyperping.StatusPageSection, languages []string) {
+	r.SetNestedObjectList("sections", len(sections), func(i int, section *hclgen.Body) {
+		s := sections[i]
+		setLocalizedMap(section, "name", s.Name, languages)
+		if s.IsSplit {
+			section.SetBool("is_split", true)
+		}
+		if len(s.Services) > 0 {
+			generateServicesHCL(section, s.Services, languages)
+		}
+	})
+}
 
-	fmt.Fprintf(sb, "resource \"hyperping_incident\" %q {\n", name)
-	fmt.Fprintf(sb, "  title = %s\n", migrate.QuoteHCL(i.Title.En))
+// generateServicesHCL writes a services list. Group entries carry is_group and
+// their children instead of a monitor uuid.
+func generateServicesHCL(b *hclgen.Body, services []hyperping.StatusPageService, languages []string) {
+	b.SetNestedObjectList("services", len(services), func(i int, service *hclgen.Body) {
+		svc := services[i]
+		if svc.IsGroup {
+			service.SetBool("is_group", true)
+		} else {
+			if isNumericID(svc.UUID) {
+				service.Comment("Monitor ID %s could not be resolved to a UUID; replace it with the monitor's UUID.", svc.UUID)
+			}
+			setOptionalString(service, "uuid", svc.UUID, "")
+		}
+		setLocalizedMap(service, "name", svc.Name, languages)
+		service.SetBool("show_uptime", svc.ShowUptime)
+		service.SetBool("show_response_times", svc.ShowResponseTimes)
+		setLocalizedMap(service, "description", svc.Description, languages)
+		if svc.IsGroup && len(svc.Services) > 0 {
+			generateServicesHCL(service, svc.Services, languages)
+		}
+	})
+}
+
+// setLocalizedMap sets a language -> text map attribute, keeping only the
+// given languages. Nothing is written when no configured language has text.
+func setLocalizedMap(b *hclgen.Body, name string, values map[string]string, languages []string) {
+	var attrs []hclgen.Attr
+	for _, lang := range languages {
+		if text, ok := values[lang]; ok && text != "" {
+			attrs = append(attrs, hclgen.Attr{Name: lang, Value: text})
+		}
+	}
+	if len(attrs) > 0 {
+		b.SetObject(name, attrs...)
+	}
+}
+
+func (g *Generator) generateIncidentHCL(root *hclgen.Body, i hyperping.Incident) {
+	r := root.Block("resource", "hyperping_incident", g.terraformName(i.Title.En))
+	r.SetString("title", i.Title.En)
 
-	sb.WriteString(buildOptionalStringField("text", i.Text.En, ""))
-	sb.WriteString(buildOptionalStringField("type", i.Type, "incident"))
+	setOptionalString(r, "text", i.Text.En, "")
+	setOptionalString(r, "type", i.Type, "incident")
 
 	if len(i.StatusPages) > 0 {
-		fmt.Fprintf(sb, "  status_pages = %s\n", formatStringList(i.StatusPages))
+		r.SetStringList("status_pages", i.StatusPages)
 	}
 
 	if len(i.AffectedComponents) > 0 {
-		fmt.Fprintf(sb, "  affected_components = %s\n", formatStringList(i.AffectedComponents))
+		r.SetStringList("affected_components", i.AffectedComponents)
 	}
-
-	sb.WriteString("}\n")
 }
 
-func (g *Generator) generateMaintenanceHCL(sb *strings.Builder, m hyperping.Maintenance) {
+func (g *Generator) generateMaintenanceHCL(root *hclgen.Body, m hyperping.Maintenance) {
 	// Use Name if Title is empty
 	titleText := m.Title.En
 	if titleText == "" {
 		titleText = m.Name
 	}
-	name := g.terraformName(titleText)
 
-	fmt.Fprintf(sb, "resource \"hyperping_maintenance\" %q {\n", name)
-	fmt.Fprintf(sb, "  title = %s\n", migrate.QuoteHCL(titleText))
+	r := root.Block("resource", "hyperping_maintenance", g.terraformName(titleText))
+	r.SetString("title", titleText)
 
-	sb.WriteString(buildOptionalStringField("text", m.Text.En, ""))
+	setOptionalString(r, "text", m.Text.En, "")
 
 	if m.StartDate != nil {
-		fmt.Fprintf(sb, "  start_date = %s\n", migrate.QuoteHCL(*m.StartDate))
+		r.SetString("start_date", *m.StartDate)
 	}
 	if m.EndDate != nil {
-		fmt.Fprintf(sb, "  end_date   = %s\n", migrate.QuoteHCL(*m.EndDate))
+		r.SetString("end_date", *m.EndDate)
 	}
 
 	if len(m.StatusPages) > 0 {
-		fmt.Fprintf(sb, "  status_pages = %s\n", formatStringList(m.StatusPages))
+		r.SetStringList("status_pages", m.StatusPages)
 	}
-
-	sb.WriteString("}\n")
 }
 
-func (g *Generator) generateOutageHCL(sb *strings.Builder, o hyperping.Outage) {
-	name := g.terraformName(o.Monitor.Name)
-
-	fmt.Fprintf(sb, "resource \"hyperping_outage\" %q {\n", name)
-	fmt.Fprintf(sb, "  monitor_uuid = %s\n", migrate.QuoteHCL(o.Monitor.UUID))
+func (g *Generator) generateOutageHCL(root *hclgen.Body, o hyperping.Outage) {
+	r := root.Block("resource", "hyperping_outage", g.terraformName(o.Monitor.Name))
+	r.SetString("monitor_uuid", o.Monitor.UUID)
 
 	if o.Description != "" {
-		// Emitted as a comment so any embedded newline is sanitized via EscapeHCL.
-		fmt.Fprintf(sb, "  # description = %s\n", migrate.QuoteHCL(o.Description))
+		// Emitted as a comment; hclgen keeps it on a single line.
+		r.Comment("description = %q", o.Description)
 	}
 
 	// Note: Most outage fields are read-only/computed
-	sb.WriteString("  # Note: Outages are mostly read-only. Review fields after import.\n")
-
-	sb.WriteString("}\n")
+	r.Comment("Note: Outages are mostly read-only. Review fields after import.")
 }
@@ -0,0 +1,176 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "rewrite testdata/*.golden files with current output")
+
+// goldenAssert compares got to the contents of testdata/<name>. With
+// -update-golden, the file is rewritten instead.
+func goldenAssert(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatalf("mkdir testdata: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil { //nolint:gosec // testdata only
+			t.Fatalf("write %s: %v", path, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v (run: go test ./cmd/import-generator -run Golden -update-golden)", path, err)
+	}
+	if got != string(want) {
+		t.Errorf("output does not match %s\nrun -update-golden after intentional changes\n--- got ---\n%s\n--- want ---\n%s", name, got, string(want))
+	}
+}
+
+// goldenResourceData covers every resource type, including values that need
+// escaping, so the snapshot exercises the full HCL surface.
+func goldenResourceData() *ResourceData {
+	port := 5432
+	keyword := "ok"
+	hostname := "status.example.com"
+	start := "2026-03-01T02:00:00Z"
+	end := "2026-03-01T04:00:00Z"
+	period := 5
+
+	return &ResourceData{
+		Monitors: []hyperping.Monitor{
+			{
+				UUID:               "mon_api",
+				Name:               "API ${prod}",
+				URL:                "https://api.example.com/health",
+				Protocol:           "http",
+				HTTPMethod:         "POST",
+				CheckFrequency:     30,
+				Regions:            []string{"london", "virginia"},
+				FollowRedirects:    true,
+				ExpectedStatusCode: hyperping.FlexibleString("2xx"),
+				RequiredKeyword:    &keyword,
+				RequestHeaders:     []hyperping.RequestHeader{{Name: "Authorization", Value: "Bearer \"x\""}},
+				RequestBody:        `{"ping": true}`,
+			},
+			{
+				UUID:            "mon_db",
+				Name:            "Database",
+				URL:             "db.example.com",
+				Protocol:        "port",
+				CheckFrequency:  60,
+				Port:            &port,
+				FollowRedirects: false,
+				Paused:          true,
+			},
+		},
+		Healthchecks: []hyperping.Healthcheck{
+			{UUID: "hc_backup", Name: "Nightly Backup", Cron: "0 2 * * *", Timezone: "UTC", GracePeriod: 600},
+			{UUID: "hc_worker", Name: "Queue Worker", PeriodValue: &period, PeriodType: "minutes", IsPaused: true},
+		},
+		StatusPages: []hyperping.StatusPage{
+			{
+				UUID:            "sp_public",
+				Name:            "Public Status",
+				HostedSubdomain: "acme",
+				Hostname:        &hostname,
+				Settings:        hyperping.StatusPageSettings{Languages: []string{"en", "fr"}, Theme: "dark"},
+				Sections: []hyperping.StatusPageSection{
+					{
+						Name:    map[string]string{"en": "Platform", "fr": "Plateforme", "de": "Plattform"},
+						IsSplit: true,
+						Services: []hyperping.StatusPageService{
+							{UUID: "mon_api", Name: map[string]string{"en": "API"}, ShowUptime: true},
+							{
+								Name:    map[string]string{"en": "Data"},
+								IsGroup: true,
+								Services: []hyperping.StatusPageService{
+									{UUID: "mon_db", Name: map[string]string{"en": "Database"}, ShowUptime: true, ShowResponseTimes: true},
+									{UUID: "mon_cache", Name: map[string]string{"en": "Cache"}},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		Incidents: []hyperping.Incident{
+			{
+				UUID:        "inc_degraded",
+				Title:       hyperping.LocalizedText{En: "Degraded API"},
+				Text:        hyperping.LocalizedText{En: "Investigating\nelevated latency"},
+				Type:        "outage",
+				StatusPages: []string{"sp_public"},
+			},
+		},
+		Maintenance: []hyperping.Maintenance{
+			{UUID: "mw_upgrade", Name: "db-upgrade", StartDate: &start, EndDate: &end, StatusPages: []string{"sp_public"}},
+		},
+		Outages: []hyperping.Outage{
+			{
+				UUID:        "out_db",
+				Monitor:     hyperping.MonitorReference{UUID: "mon_db", Name: "Database"},
+				Description: "connection refused\nresource \"x\" \"y\" {}",
+			},
+		},
+	}
+}
+
+func TestGenerateHCL_Golden(t *testing.T) {
+	g := &Generator{}
+	var sb strings.Builder
+	g.generateHCL(&sb, goldenResourceData())
+
+	got := sb.String()
+	if _, diags := hclsyntax.ParseConfig([]byte(got), "generated.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("generated HCL does not parse: %s\n%s", diags.Error(), got)
+	}
+	goldenAssert(t, "generated.tf.golden", got)
+}
+
+func TestGenerateHCL_CompatModeGolden(t *testing.T) {
+	g := &Generator{compatMode: compat.IgnoreChanges}
+	var sb strings.Builder
+	g.generateHCL(&sb, goldenResourceData())
+
+	got := sb.String()
+	if _, diags := hclsyntax.ParseConfig([]byte(got), "generated.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("generated HCL does not parse: %s\n%s", diags.Error(), got)
+	}
+	goldenAssert(t, "compat_mode.tf.golden", got)
+}
+
+func TestGenerateImportBlocks_Golden(t *testing.T) {
+	for name, g := range map[string]*Generator{
+		"import_blocks.tf.golden":          {},
+		"import_blocks_identity.tf.golden": {importIdentity: true},
+	} {
+		t.Run(name, func(t *testing.T) {
+			var sb strings.Builder
+			if err := g.generateImportBlocks(&sb, goldenResourceData()); err != nil {
+				t.Fatalf("generateImportBlocks: %v", err)
+			}
+
+			got := sb.String()
+			if _, diags := hclsyntax.ParseConfig([]byte(got), "imports.tf", hcl.InitialPos); diags.HasErrors() {
+				t.Fatalf("generated import blocks do not parse: %s\n%s", diags.Error(), got)
+			}
+			goldenAssert(t, name, got)
+		})
+	}
+}
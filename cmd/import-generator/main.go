@@ -19,18 +19,25 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"strings"
 	"time"
 
 	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
 )
 
 var (
 	// Original flags
-	outputFormat    = flag.String("format", "both", "Output format: import, hcl, both, or script")
+	outputFormat    = flag.String("format", "both", "Output format: import, import-blocks, hcl, both, or script")
 	outputFile      = flag.String("output", "", "Output file (default: stdout)")
-	resources       = flag.String("resources", "all", "Resources to import: all, monitors, healthchecks, statuspages, incidents, maintenance, outages")
+	resources       = flag.String("resources", "all", "Resources to import: all, "+strings.Join(resourceKeys(), ", "))
 	prefix          = flag.String("prefix", "", "Prefix for Terraform resource names (e.g., 'prod_')")
+	importIdentity  = flag.Bool("import-identity", false, "Address import blocks by resource identity instead of import ID (requires --format=import-blocks and Terraform 1.12+)")
+	modulePath      = flag.String("module-path", "", "Module address the resources live in (e.g., 'module.monitoring'); prefixes every import target address")
 	baseURL         = flag.String("base-url", "https://api.hyperping.io", "Hyperping API base URL")
 	validate        = flag.Bool("validate", false, "Validate resources without generating output")
 	progress        = flag.Bool("progress", false, "Show progress indicators")
@@ -41,6 +48,7 @@ var (
 	filterExclude = flag.String("filter-exclude", "", "Exclude resources by name (regex pattern)")
 	filterType    = flag.String("filter-type", "", "Filter by resource type (e.g., hyperping_monitor)")
 	dryRun        = flag.Bool("dry-run", false, "Show what would be imported without executing")
+	reportFile    = flag.String("report", "", "Write the import plan as a markdown (.md) or HTML (.html) preview report (requires --dry-run)")
 
 	// Parallel execution flags
 	parallel   = flag.Int("parallel", 5, "Number of concurrent import workers (0=sequential, max=20)")
@@ -51,6 +59,7 @@ var (
 	abortOnDrift    = flag.Bool("abort-on-drift", false, "Abort if drift is detected (requires --detect-drift)")
 	refreshFirst    = flag.Bool("refresh-first", false, "Refresh state before drift detection")
 	postImportCheck = flag.Bool("post-import-check", false, "Verify no drift after import")
+	skipPreflight   = flag.Bool("skip-preflight", false, "Skip checking that every import target has a resource block in the configuration")
 
 	// Checkpoint/resume flags
 	checkpointFile = flag.String("checkpoint-file", ".import-checkpoint", "Path to checkpoint file")
@@ -63,13 +72,26 @@ var (
 	rollbackPlan = flag.Bool("rollback-plan", false, "Show rollback plan without executing")
 
 	// Output flags
-	verbose = flag.Bool("verbose", false, "Enable verbose output")
-	quiet   = flag.Bool("quiet", false, "Minimal output (errors only)")
+	verbose       = flag.Bool("verbose", false, "Enable verbose output")
+	quiet         = flag.Bool("quiet", false, "Minimal output (errors only)")
+	outputDialect = dialect.RegisterFlag(flag.CommandLine)
+	extractVars   = flag.Bool("extract-variables", false, "Lift regions lists, check frequencies, and escalation policies shared by several monitors into variables.tf (requires --format=hcl and --output)")
+	compatMode    = compat.RegisterFlag(flag.CommandLine)
 
 	// Execution mode flag
-	execute = flag.Bool("execute", false, "Execute terraform imports (default: generate commands only)")
+	execute     = flag.Bool("execute", false, "Execute terraform imports (default: generate commands only)")
+	summaryJSON = flag.String("summary-json", "", "Write a machine-readable JSON execution summary to this path (requires --execute)")
+
+	// Terraform working directory flags
+	chdir         = flag.String("chdir", "", "Terraform working directory (passed to terraform as -chdir)")
+	terraformInit = flag.Bool("init", false, "Run 'terraform init -input=false' before importing (requires --execute)")
+	backendConfig stringSliceFlag
 )
 
+func init() {
+	flag.Var(&backendConfig, "backend-config", "Backend configuration passed to terraform init (repeatable, requires --init)")
+}
+
 func main() {
 	flag.Usage = func() {
 		fmt.Fprintf(os.Stderr, "Usage: import-generator [options]\n\n")
@@ -84,12 +106,22 @@ func main() {
 		fmt.Fprintf(os.Stderr, "  import-generator --filter-name=\"PROD-.*\"\n\n")
 		fmt.Fprintf(os.Stderr, "  # Execute parallel import with drift detection\n")
 		fmt.Fprintf(os.Stderr, "  import-generator --execute --parallel=10 --detect-drift\n\n")
+		fmt.Fprintf(os.Stderr, "  # Import in a fresh CI checkout with a remote backend\n")
+		fmt.Fprintf(os.Stderr, "  import-generator --execute --chdir=infra --init --backend-config=backend.hcl\n\n")
 		fmt.Fprintf(os.Stderr, "  # Resume interrupted import\n")
 		fmt.Fprintf(os.Stderr, "  import-generator --execute --resume\n\n")
 		fmt.Fprintf(os.Stderr, "  # Rollback previous import\n")
 		fmt.Fprintf(os.Stderr, "  import-generator --rollback\n\n")
 		fmt.Fprintf(os.Stderr, "  # Dry run to see what would be imported\n")
 		fmt.Fprintf(os.Stderr, "  import-generator --dry-run --filter-type=hyperping_monitor\n\n")
+		fmt.Fprintf(os.Stderr, "  # Write a preview report for change-management approval\n")
+		fmt.Fprintf(os.Stderr, "  import-generator --dry-run --report=preview.md\n\n")
+		fmt.Fprintf(os.Stderr, "  # Write import blocks that address resources by identity (Terraform 1.12+)\n")
+		fmt.Fprintf(os.Stderr, "  import-generator --format=import-blocks --import-identity --output=imports.tf\n\n")
+		fmt.Fprintf(os.Stderr, "  # Generate a Terragrunt unit instead of plain Terraform\n")
+		fmt.Fprintf(os.Stderr, "  import-generator --format=hcl --output=live/hyperping/main.tf --output-dialect=terragrunt\n\n")
+		fmt.Fprintf(os.Stderr, "  # Lift shared regions, frequencies, and escalation policies into variables.tf\n")
+		fmt.Fprintf(os.Stderr, "  import-generator --format=hcl --output=hyperping/main.tf --extract-variables\n\n")
 	}
 	os.Exit(run())
 }
@@ -97,6 +129,11 @@ func main() {
 func run() int {
 	flag.Parse()
 
+	if err := clientenv.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
 	// Handle rollback mode first (doesn't need API key)
 	if *rollback || *rollbackPlan {
 		return runRollback()
@@ -116,7 +153,7 @@ func run() int {
 	}
 
 	// Create client
-	c := hyperping.NewClient(apiKey, hyperping.WithBaseURL(*baseURL))
+	c := clientenv.NewClient(apiKey, hyperping.WithBaseURL(*baseURL))
 
 	// Set timeout based on execution mode
 	timeout := 5 * time.Minute
@@ -137,10 +174,14 @@ func run() int {
 	gen := &Generator{
 		client:          c,
 		prefix:          *prefix,
+		modulePath:      *modulePath,
+		importIdentity:  *importIdentity,
 		resources:       parseResources(*resources),
 		showProgress:    *progress || *execute,
 		continueOnError: *continueOnError,
 		filterConfig:    filterConfig,
+		extractVars:     *extractVars,
+		compatMode:      compat.Mode(*compatMode),
 	}
 
 	// Handle validation mode
@@ -148,6 +189,11 @@ func run() int {
 		return runValidation(ctx, gen)
 	}
 
+	// Handle preview report mode
+	if *reportFile != "" {
+		return runPreviewReport(ctx, gen, filterConfig)
+	}
+
 	// Handle execution mode
 	if *execute {
 		return runExecution(ctx, gen, filterConfig)
@@ -174,7 +220,7 @@ func runValidation(ctx context.Context, gen *Generator) int {
 
 func parseResources(s string) []string {
 	if s == "all" {
-		return []string{"monitors", "healthchecks", "statuspages", "incidents", "maintenance", "outages"}
+		return resourceKeys()
 	}
 	return strings.Split(s, ",")
 }
@@ -200,6 +246,62 @@ func validateFlags() error {
 		return fmt.Errorf("--quiet and --verbose are mutually exclusive")
 	}
 
+	if *terraformInit && !*execute {
+		return fmt.Errorf("--init requires --execute")
+	}
+
+	if len(backendConfig) > 0 && !*terraformInit {
+		return fmt.Errorf("--backend-config requires --init")
+	}
+
+	if *summaryJSON != "" && !*execute {
+		return fmt.Errorf("--summary-json requires --execute")
+	}
+
+	if err := validateModulePath(*modulePath); err != nil {
+		return err
+	}
+
+	if *reportFile != "" {
+		if !*dryRun {
+			return fmt.Errorf("--report requires --dry-run")
+		}
+		if _, err := reportFormat(*reportFile); err != nil {
+			return err
+		}
+	}
+
+	if *chdir != "" {
+		info, err := os.Stat(*chdir)
+		if err != nil || !info.IsDir() {
+			return fmt.Errorf("--chdir directory does not exist: %s", *chdir)
+		}
+	}
+
+	d, err := dialect.ParseDialect(*outputDialect)
+	if err != nil {
+		return fmt.Errorf("--output-dialect: %w", err)
+	}
+	if d != dialect.Terraform && (*outputFormat != "hcl" || *outputFile == "") {
+		return fmt.Errorf("--output-dialect=%s requires --format=hcl and --output", d)
+	}
+
+	if *importIdentity && *outputFormat != "import-blocks" {
+		return fmt.Errorf("--import-identity requires --format=import-blocks")
+	}
+
+	if *extractVars && (*outputFormat != "hcl" || *outputFile == "") {
+		return fmt.Errorf("--extract-variables requires --format=hcl and --output")
+	}
+
+	mode, err := compat.ParseMode(*compatMode)
+	if err != nil {
+		return fmt.Errorf("--compat-mode: %w", err)
+	}
+	if err := mode.CheckDialect(d); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -212,13 +314,37 @@ func runGeneration(ctx context.Context, gen *Generator) int {
 	}
 
 	// Write output
-	if *outputFile != "" {
+	variables := gen.VariablesHCL()
+	if d, _ := dialect.ParseDialect(*outputDialect); d != dialect.Terraform {
+		// Other dialects render the variables with the resources, so
+		// Terragrunt inputs and CDKTF variables pick up the defaults.
+		if variables != "" {
+			output = variables + "\n" + output
+		}
+		paths, err := dialect.WriteConfig(d, []byte(output), *outputFile)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error writing %s output: %v\n", d, err)
+			return 1
+		}
+		for _, path := range paths {
+			fmt.Fprintf(os.Stderr, "Output written to %s\n", path)
+		}
+	} else if *outputFile != "" {
 		if err := os.WriteFile(*outputFile, []byte(output), 0o600); err != nil {
 			fmt.Fprintf(os.Stderr, "Error writing file: %v\n", err)
 			return 1
 		}
 		fmt.Fprintf(os.Stderr, "Output written to %s\n", *outputFile)
 
+		if variables != "" {
+			path := filepath.Join(filepath.Dir(*outputFile), VariablesFile)
+			if err := os.WriteFile(path, []byte(variables), 0o600); err != nil {
+				fmt.Fprintf(os.Stderr, "Error writing variables: %v\n", err)
+				return 1
+			}
+			fmt.Fprintf(os.Stderr, "Variables written to %s\n", path)
+		}
+
 		// Make script executable if format is script
 		if *outputFormat == "script" {
 			if err := os.Chmod(*outputFile, 0o750); err != nil { // #nosec G302 -- generated script needs execute permission
@@ -232,11 +358,55 @@ func runGeneration(ctx context.Context, gen *Generator) int {
 	return 0
 }
 
+// runPreviewReport writes the import plan to --report without importing
+// anything.
+func runPreviewReport(ctx context.Context, gen *Generator, filterConfig *FilterConfig) int {
+	format, err := reportFormat(*reportFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	data, err := gen.fetchResources(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching resources: %v\n", err)
+		return 1
+	}
+
+	report := PreviewReport{
+		GeneratedAt: time.Now(),
+		Entries:     gen.buildPreview(data),
+	}
+	if !filterConfig.IsEmpty() {
+		report.Filters = filterConfig.Summary()
+	}
+
+	var sb strings.Builder
+	if err := writePreviewReport(&sb, format, report); err != nil {
+		fmt.Fprintf(os.Stderr, "Error rendering report: %v\n", err)
+		return 1
+	}
+	if err := os.WriteFile(*reportFile, []byte(sb.String()), 0o600); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing report: %v\n", err)
+		return 1
+	}
+
+	fmt.Fprintf(os.Stderr, "[DRY RUN] Preview of %d resource(s) written to %s. No imports were executed.\n", len(report.Entries), *reportFile)
+	return 0
+}
+
 func runExecution(ctx context.Context, gen *Generator, filterConfig *FilterConfig) int {
 	if !*quiet {
 		printBanner()
 	}
 
+	if !*dryRun {
+		if err := prepareTerraform(ctx); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+	}
+
 	// Resolve checkpoint and optionally resume
 	jobs, code := prepareImportJobs(ctx, gen, filterConfig)
 	if code != 0 {
@@ -257,6 +427,11 @@ func runExecution(ctx context.Context, gen *Generator, filterConfig *FilterConfi
 	}
 
 	summary, err := executeImports(ctx, jobs)
+	if *summaryJSON != "" && summary != nil {
+		if saveErr := NewExecutionSummary(summary, filterConfig.Summary()).Save(*summaryJSON); saveErr != nil {
+			fmt.Fprintf(os.Stderr, "Warning: %v\n", saveErr)
+		}
+	}
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Import failed: %v\n", err)
 		return 1
@@ -285,7 +460,7 @@ func prepareImportJobs(ctx context.Context, gen *Generator, filterConfig *Filter
 		return nil, 1
 	}
 
-	jobs := buildImportJobs(data, gen.prefix, filterConfig)
+	jobs := buildImportJobs(data, gen.prefix, gen.modulePath, filterConfig)
 	if len(jobs) == 0 {
 		fmt.Println("No resources to import")
 		return nil, 0
@@ -299,6 +474,46 @@ func prepareImportJobs(ctx context.Context, gen *Generator, filterConfig *Filter
 		}
 	}
 
+	if !*dryRun && !*skipPreflight {
+		return runPreflightCheck(ctx, gen, data, jobs)
+	}
+	return jobs, 0
+}
+
+// runPreflightCheck stops before any import when a job has no resource block
+// in the configuration, and drops jobs whose address is already in the state.
+func runPreflightCheck(ctx context.Context, gen *Generator, data *ResourceData, jobs []ImportJob) ([]ImportJob, int) {
+	result, err := RunPreflight(ctx, terraformDir(), gen.modulePath, jobs)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Pre-flight check failed: %v\n", err)
+		return nil, 1
+	}
+	if !result.OK() {
+		printPreflightFailure(os.Stderr, result.ConfigDir, result.Missing, gen.missingResourceBlocks(data, result.Missing))
+		return nil, 1
+	}
+
+	if len(result.Managed) > 0 {
+		managed := make(map[string]bool, len(result.Managed))
+		for _, job := range result.Managed {
+			managed[job.ResourceID] = true
+			if *verbose {
+				fmt.Printf("Skipping %s: already in state\n", job.Address())
+			}
+		}
+		pending := jobs[:0]
+		for _, job := range jobs {
+			if !managed[job.ResourceID] {
+				pending = append(pending, job)
+			}
+		}
+		jobs = pending
+		fmt.Printf("%d resource(s) already in state will be skipped\n", len(result.Managed))
+		if len(jobs) == 0 {
+			fmt.Println("All resources already imported")
+			return nil, 0
+		}
+	}
 	return jobs, 0
 }
 
@@ -436,91 +651,20 @@ func runRollback() int {
 	return 0
 }
 
-func buildImportJobs(data *ResourceData, prefix string, filter *FilterConfig) []ImportJob {
-	estimatedCapacity := len(data.Monitors) + len(data.Healthchecks) + len(data.StatusPages) + len(data.Incidents) + len(data.Maintenance) + len(data.Outages)
-	jobs := make([]ImportJob, 0, estimatedCapacity)
-	index := 0
-
-	// Helper to create terraform name
-	terraformName := func(name string) string {
-		gen := &Generator{prefix: prefix}
-		return gen.terraformName(name)
-	}
-
-	// Monitors
-	monitors := filter.FilterMonitors(data.Monitors)
-	for _, m := range monitors {
-		jobs = append(jobs, ImportJob{
-			ResourceType: "hyperping_monitor",
-			ResourceName: terraformName(m.Name),
-			ResourceID:   m.UUID,
-			Index:        index,
-		})
-		index++
-	}
-
-	// Healthchecks
-	healthchecks := filter.FilterHealthchecks(data.Healthchecks)
-	for _, h := range healthchecks {
-		jobs = append(jobs, ImportJob{
-			ResourceType: "hyperping_healthcheck",
-			ResourceName: terraformName(h.Name),
-			ResourceID:   h.UUID,
-			Index:        index,
-		})
-		index++
-	}
-
-	// Status Pages
-	pages := filter.FilterStatusPages(data.StatusPages)
-	for _, sp := range pages {
-		jobs = append(jobs, ImportJob{
-			ResourceType: "hyperping_statuspage",
-			ResourceName: terraformName(sp.Name),
-			ResourceID:   sp.UUID,
-			Index:        index,
-		})
-		index++
-	}
-
-	// Incidents
-	incidents := filter.FilterIncidents(data.Incidents)
-	for _, i := range incidents {
-		jobs = append(jobs, ImportJob{
-			ResourceType: "hyperping_incident",
-			ResourceName: terraformName(i.Title.En),
-			ResourceID:   i.UUID,
-			Index:        index,
-		})
-		index++
-	}
-
-	// Maintenance
-	maintenance := filter.FilterMaintenance(data.Maintenance)
-	for _, m := range maintenance {
-		titleText := m.Title.En
-		if titleText == "" {
-			titleText = m.Name
+func buildImportJobs(data *ResourceData, prefix, modulePath string, filter *FilterConfig) []ImportJob {
+	gen := &Generator{prefix: prefix}
+	var jobs []ImportJob
+
+	for _, kind := range resourceKinds {
+		for _, it := range filter.FilterItems(kind.terraformType, kind.items(data)) {
+			jobs = append(jobs, ImportJob{
+				ResourceType: kind.terraformType,
+				ResourceName: gen.terraformName(it.name),
+				ResourceID:   it.uuid,
+				Index:        len(jobs),
+				Module:       modulePath,
+			})
 		}
-		jobs = append(jobs, ImportJob{
-			ResourceType: "hyperping_maintenance",
-			ResourceName: terraformName(titleText),
-			ResourceID:   m.UUID,
-			Index:        index,
-		})
-		index++
-	}
-
-	// Outages
-	outages := filter.FilterOutages(data.Outages)
-	for _, o := range outages {
-		jobs = append(jobs, ImportJob{
-			ResourceType: "hyperping_outage",
-			ResourceName: terraformName(o.Monitor.Name),
-			ResourceID:   o.UUID,
-			Index:        index,
-		})
-		index++
 	}
 
 	return jobs
@@ -6,7 +6,6 @@ package main
 import (
 	"context"
 	"fmt"
-	"os/exec"
 	"sync"
 	"time"
 )
@@ -22,6 +21,9 @@ type ImportJob struct {
 	ResourceName string
 	ResourceID   string
 	Index        int
+	// Module is the module address the resource lives in (--module-path),
+	// empty for the root module.
+	Module string
 }
 
 // ImportResult holds the result of an import operation.
@@ -32,6 +34,9 @@ type ImportResult struct {
 	Error     error
 	Duration  time.Duration
 	StartTime time.Time
+	// Attempts is the number of times terraform import ran for the job; 0
+	// when the run was cancelled before the job started.
+	Attempts int
 }
 
 // ImportSummary holds aggregate statistics for import operations.
@@ -43,6 +48,7 @@ type ImportSummary struct {
 	TotalDuration time.Duration
 	FailedJobs    []ImportResult
 	WarningJobs   []ImportResult
+	Results       []ImportResult // every job, in completion order
 	StartTime     time.Time
 	EndTime       time.Time
 }
@@ -130,10 +136,11 @@ func (pi *ParallelImporter) Import(ctx context.Context, jobs []ImportJob) (*Impo
 		completed++
 
 		// Update summary
+		summary.Results = append(summary.Results, result)
 		if result.Success {
 			summary.SuccessCount++
 			checkpoint.AddImported(result.Job.ResourceID, result.Job.ResourceType, result.Job.ResourceName)
-			pi.importLog.AddImport(result.Job.ResourceType, result.Job.ResourceName, result.Job.ResourceID)
+			pi.importLog.AddImport(result.Job)
 		} else {
 			summary.FailureCount++
 			summary.FailedJobs = append(summary.FailedJobs, result)
@@ -145,7 +152,7 @@ func (pi *ParallelImporter) Import(ctx context.Context, jobs []ImportJob) (*Impo
 
 		// Progress callback
 		if pi.onProgress != nil {
-			currentResource := fmt.Sprintf("%s.%s", result.Job.ResourceType, result.Job.ResourceName)
+			currentResource := result.Job.Address()
 			pi.onProgress(completed, len(jobs), currentResource)
 		}
 
@@ -198,13 +205,13 @@ func (pi *ParallelImporter) executeImport(ctx context.Context, job ImportJob) Im
 	}
 
 	// Build terraform import command
-	resourceAddress := fmt.Sprintf("%s.%s", job.ResourceType, job.ResourceName)
-	cmd := exec.CommandContext(ctx, "terraform", "import", resourceAddress, job.ResourceID) // #nosec G204 -- args are structured internal data, not user input
+	cmd := terraformCommand(ctx, "import", job.Address(), job.ResourceID)
 
 	// Execute command
 	output, err := cmd.CombinedOutput()
 	result.Output = string(output)
 	result.Duration = time.Since(startTime)
+	result.Attempts = 1
 
 	if err != nil {
 		result.Success = false
@@ -253,13 +260,15 @@ func (si *SequentialImporter) Import(ctx context.Context, jobs []ImportJob) (*Im
 	for i, job := range jobs {
 		select {
 		case <-ctx.Done():
+			summary.EndTime = time.Now()
 			return summary, ctx.Err()
 		default:
 			result := si.executeImport(ctx, job)
 
+			summary.Results = append(summary.Results, result)
 			if result.Success {
 				summary.SuccessCount++
-				si.importLog.AddImport(result.Job.ResourceType, result.Job.ResourceName, result.Job.ResourceID)
+				si.importLog.AddImport(result.Job)
 			} else {
 				summary.FailureCount++
 				summary.FailedJobs = append(summary.FailedJobs, result)
@@ -269,7 +278,7 @@ func (si *SequentialImporter) Import(ctx context.Context, jobs []ImportJob) (*Im
 
 			// Progress callback
 			if si.onProgress != nil {
-				currentResource := fmt.Sprintf("%s.%s", result.Job.ResourceType, result.Job.ResourceName)
+				currentResource := result.Job.Address()
 				si.onProgress(i+1, len(jobs), currentResource)
 			}
 		}
@@ -288,12 +297,12 @@ func (si *SequentialImporter) executeImport(ctx context.Context, job ImportJob)
 		StartTime: startTime,
 	}
 
-	resourceAddress := fmt.Sprintf("%s.%s", job.ResourceType, job.ResourceName)
-	cmd := exec.CommandContext(ctx, "terraform", "import", resourceAddress, job.ResourceID) // #nosec G204 -- args are structured internal data, not user input
+	cmd := terraformCommand(ctx, "import", job.Address(), job.ResourceID)
 
 	output, err := cmd.CombinedOutput()
 	result.Output = string(output)
 	result.Duration = time.Since(startTime)
+	result.Attempts = 1
 
 	if err != nil {
 		result.Success = false
@@ -337,7 +346,7 @@ func (s *ImportSummary) PrintSummary() {
 		fmt.Println("FAILED IMPORTS:")
 		fmt.Println(repeatString("-", 80))
 		for _, job := range s.FailedJobs {
-			fmt.Printf("  %s.%s (ID: %s)\n", job.Job.ResourceType, job.Job.ResourceName, job.Job.ResourceID)
+			fmt.Printf("  %s (ID: %s)\n", job.Job.Address(), job.Job.ResourceID)
 			if job.Error != nil {
 				fmt.Printf("    Error: %v\n", job.Error)
 			}
@@ -349,7 +358,7 @@ func (s *ImportSummary) PrintSummary() {
 		fmt.Println("WARNINGS:")
 		fmt.Println(repeatString("-", 80))
 		for _, job := range s.WarningJobs {
-			fmt.Printf("  %s.%s (ID: %s)\n", job.Job.ResourceType, job.Job.ResourceName, job.Job.ResourceID)
+			fmt.Printf("  %s (ID: %s)\n", job.Job.Address(), job.Job.ResourceID)
 		}
 	}
 
@@ -0,0 +1,183 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+)
+
+// PreflightResult is the outcome of checking the import jobs against the
+// Terraform configuration and state before any import runs.
+type PreflightResult struct {
+	// Missing are jobs whose address has no resource block in the
+	// configuration. terraform import fails for them with "resource address
+	// not found in configuration".
+	Missing []ImportJob
+	// Managed are jobs whose address is already in the state.
+	Managed []ImportJob
+	// ConfigDir is the directory whose resource blocks the jobs were checked
+	// against: the module's source directory with --module-path.
+	ConfigDir string
+}
+
+// OK reports whether every job can be imported.
+func (r *PreflightResult) OK() bool {
+	return len(r.Missing) == 0
+}
+
+// RunPreflight validates the configuration in dir, then checks that every job
+// targets a resource block in it and is not already in the state. The
+// configuration may have changed since the HCL was generated, for example a
+// resource renamed or a file left out of a commit; checking up front reports
+// all such addresses at once instead of failing part way through the run.
+//
+// With a module path the resource blocks are looked up in the module's
+// source directory, as installed by terraform init.
+func RunPreflight(ctx context.Context, dir, modulePath string, jobs []ImportJob) (*PreflightResult, error) {
+	if err := ValidateTerraformConfig(ctx); err != nil {
+		return nil, err
+	}
+
+	configDir := dir
+	if modulePath != "" {
+		var err error
+		if configDir, err = moduleDir(dir, modulePath); err != nil {
+			return nil, err
+		}
+	}
+	configured, err := configuredResourceAddresses(configDir)
+	if err != nil {
+		return nil, err
+	}
+
+	output, err := terraformCommand(ctx, "state", "list").Output()
+	if err != nil {
+		// An empty workspace has no state to list.
+		output = nil
+	}
+	managed := stateAddresses(string(output))
+
+	result := checkImportTargets(jobs, configured, managed)
+	result.ConfigDir = configDir
+	return result, nil
+}
+
+// checkImportTargets sorts jobs into those missing from the configuration and
+// those already in the state. configured holds addresses relative to the
+// module the jobs target; managed holds full state addresses.
+func checkImportTargets(jobs []ImportJob, configured, managed map[string]bool) *PreflightResult {
+	result := &PreflightResult{}
+	for _, job := range jobs {
+		switch {
+		case !configured[job.ResourceType+"."+job.ResourceName]:
+			result.Missing = append(result.Missing, job)
+		case managed[job.Address()]:
+			result.Managed = append(result.Managed, job)
+		}
+	}
+	return result
+}
+
+// configuredResourceAddresses returns the addresses of the resource blocks in
+// the root module in dir. Resources with count or for_each are listed by
+// their base address, which is what the import jobs target.
+func configuredResourceAddresses(dir string) (map[string]bool, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.tf"))
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]bool)
+	for _, path := range files {
+		src, err := os.ReadFile(filepath.Clean(path)) // #nosec G304 -- reading the operator's Terraform configuration
+		if err != nil {
+			return nil, fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		file, diags := hclsyntax.ParseConfig(src, path, hcl.InitialPos)
+		if diags.HasErrors() {
+			return nil, fmt.Errorf("failed to parse %s: %s", path, diags.Error())
+		}
+		body, ok := file.Body.(*hclsyntax.Body)
+		if !ok {
+			continue
+		}
+		for _, block := range body.Blocks {
+			if block.Type == "resource" && len(block.Labels) == 2 {
+				addresses[block.Labels[0]+"."+block.Labels[1]] = true
+			}
+		}
+	}
+	return addresses, nil
+}
+
+// stateAddresses parses the output of terraform state list. Instance keys are
+// dropped, so a resource imported into an indexed address counts as managed.
+func stateAddresses(output string) map[string]bool {
+	addresses := make(map[string]bool)
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		address := strings.TrimSpace(scanner.Text())
+		if i := strings.IndexByte(address, '['); i >= 0 {
+			address = address[:i]
+		}
+		if address != "" {
+			addresses[address] = true
+		}
+	}
+	return addresses
+}
+
+// missingResourceBlocks returns the generated HCL of the resource blocks the
+// missing jobs need, in job order, so the report shows exactly what to add.
+func (g *Generator) missingResourceBlocks(data *ResourceData, missing []ImportJob) string {
+	var sb strings.Builder
+	g.generateHCL(&sb, data)
+	file, diags := hclwrite.ParseConfig([]byte(sb.String()), "generated.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		return ""
+	}
+
+	blocks := make(map[string]*hclwrite.Block)
+	for _, block := range file.Body().Blocks() {
+		labels := block.Labels()
+		if block.Type() == "resource" && len(labels) == 2 {
+			blocks[labels[0]+"."+labels[1]] = block
+		}
+	}
+
+	out := hclwrite.NewEmptyFile()
+	for _, job := range missing {
+		block, ok := blocks[job.ResourceType+"."+job.ResourceName]
+		if !ok {
+			continue
+		}
+		if len(out.Body().Blocks()) > 0 {
+			out.Body().AppendNewline()
+		}
+		out.Body().AppendBlock(block)
+	}
+	return string(out.Bytes())
+}
+
+// printPreflightFailure reports the addresses missing from the configuration
+// and the blocks to add for them.
+func printPreflightFailure(w io.Writer, dir string, missing []ImportJob, blocks string) {
+	fmt.Fprintf(w, "Pre-flight check failed: %d import target(s) have no resource block in %s. No imports were attempted.\n\n", len(missing), dir)
+	for _, job := range missing {
+		fmt.Fprintf(w, "  %s (ID: %s)\n", job.Address(), job.ResourceID)
+	}
+	if blocks != "" {
+		fmt.Fprintf(w, "\nAdd these resource blocks to the configuration, then run again:\n\n%s", blocks)
+	}
+}
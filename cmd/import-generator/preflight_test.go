@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestConfiguredResourceAddresses(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"main.tf": `
+resource "hyperping_monitor" "api" {
+  name = "API"
+}
+
+resource "hyperping_monitor" "web" {
+  count = 2
+  name  = "Web"
+}
+`,
+		"pages.tf": `
+resource "hyperping_statuspage" "status" {
+  name = "Status"
+}
+
+data "hyperping_monitor" "lookup" {
+  id = "mon_abc"
+}
+`,
+		"notes.txt": `resource "hyperping_monitor" "ignored" {}`,
+	}
+	for name, content := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	got, err := configuredResourceAddresses(dir)
+	if err != nil {
+		t.Fatalf("configuredResourceAddresses() error = %v", err)
+	}
+	want := []string{"hyperping_monitor.api", "hyperping_monitor.web", "hyperping_statuspage.status"}
+	if len(got) != len(want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	for _, address := range want {
+		if !got[address] {
+			t.Errorf("missing address %s in %v", address, got)
+		}
+	}
+}
+
+func TestConfiguredResourceAddresses_ParseError(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "main.tf"), []byte(`resource "hyperping_monitor" {`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := configuredResourceAddresses(dir); err == nil {
+		t.Error("expected a parse error")
+	}
+}
+
+func TestStateAddresses(t *testing.T) {
+	got := stateAddresses("hyperping_monitor.api\nhyperping_monitor.web[0]\nhyperping_monitor.web[1]\n\nhyperping_statuspage.status[\"eu\"]\n")
+	for _, address := range []string{"hyperping_monitor.api", "hyperping_monitor.web", "hyperping_statuspage.status"} {
+		if !got[address] {
+			t.Errorf("missing address %s in %v", address, got)
+		}
+	}
+	if len(got) != 3 {
+		t.Errorf("got %d addresses, want 3: %v", len(got), got)
+	}
+}
+
+func TestCheckImportTargets(t *testing.T) {
+	jobs := []ImportJob{
+		{ResourceType: "hyperping_monitor", ResourceName: "api", ResourceID: "mon_api"},
+		{ResourceType: "hyperping_monitor", ResourceName: "renamed", ResourceID: "mon_renamed"},
+		{ResourceType: "hyperping_monitor", ResourceName: "imported", ResourceID: "mon_imported"},
+	}
+	configured := map[string]bool{"hyperping_monitor.api": true, "hyperping_monitor.imported": true}
+	managed := map[string]bool{"hyperping_monitor.imported": true}
+
+	result := checkImportTargets(jobs, configured, managed)
+	if result.OK() {
+		t.Error("expected the check to fail with a missing address")
+	}
+	if len(result.Missing) != 1 || result.Missing[0].ResourceID != "mon_renamed" {
+		t.Errorf("Missing = %+v, want mon_renamed", result.Missing)
+	}
+	if len(result.Managed) != 1 || result.Managed[0].ResourceID != "mon_imported" {
+		t.Errorf("Managed = %+v, want mon_imported", result.Managed)
+	}
+}
+
+func TestCheckImportTargets_ModulePath(t *testing.T) {
+	jobs := []ImportJob{
+		{ResourceType: "hyperping_monitor", ResourceName: "api", ResourceID: "mon_api", Module: "module.monitoring"},
+		{ResourceType: "hyperping_monitor", ResourceName: "web", ResourceID: "mon_web", Module: "module.monitoring"},
+	}
+	// Resource blocks are read from the module directory, so they carry no
+	// module prefix; state addresses do.
+	configured := map[string]bool{"hyperping_monitor.api": true, "hyperping_monitor.web": true}
+	managed := map[string]bool{"module.monitoring.hyperping_monitor.web": true, "hyperping_monitor.api": true}
+
+	result := checkImportTargets(jobs, configured, managed)
+	if !result.OK() {
+		t.Errorf("Missing = %+v, want none", result.Missing)
+	}
+	if len(result.Managed) != 1 || result.Managed[0].ResourceID != "mon_web" {
+		t.Errorf("Managed = %+v, want only mon_web", result.Managed)
+	}
+}
+
+func TestMissingResourceBlocks(t *testing.T) {
+	g := &Generator{}
+	data := &ResourceData{
+		Monitors: []hyperping.Monitor{
+			{UUID: "mon_api", Name: "API", URL: "https://api.example.com", Protocol: "http", FollowRedirects: true},
+			{UUID: "mon_web", Name: "Web", URL: "https://www.example.com", Protocol: "http", FollowRedirects: true},
+		},
+	}
+	missing := []ImportJob{{ResourceType: "hyperping_monitor", ResourceName: "web", ResourceID: "mon_web"}}
+
+	blocks := g.missingResourceBlocks(data, missing)
+	if !strings.Contains(blocks, `resource "hyperping_monitor" "web"`) {
+		t.Errorf("missing block for hyperping_monitor.web\nGot:\n%s", blocks)
+	}
+	if !strings.Contains(blocks, `"https://www.example.com"`) {
+		t.Errorf("block should carry the generated attributes\nGot:\n%s", blocks)
+	}
+	if strings.Contains(blocks, `"api"`) {
+		t.Errorf("block for a configured resource should not be reported\nGot:\n%s", blocks)
+	}
+}
+
+func TestPrintPreflightFailure(t *testing.T) {
+	var sb strings.Builder
+	missing := []ImportJob{{ResourceType: "hyperping_monitor", ResourceName: "web", ResourceID: "mon_web"}}
+	printPreflightFailure(&sb, "infra", missing, "resource \"hyperping_monitor\" \"web\" {\n}\n")
+
+	out := sb.String()
+	for _, want := range []string{
+		"1 import target(s) have no resource block in infra",
+		"No imports were attempted",
+		"hyperping_monitor.web (ID: mon_web)",
+		`resource "hyperping_monitor" "web"`,
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("output missing %q\nGot:\n%s", want, out)
+		}
+	}
+}
@@ -0,0 +1,181 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
+)
+
+// resourceKind describes one importable resource type. Fetching, filtering,
+// import commands, HCL, the import script, the preview report, and the
+// import jobs all iterate resourceKinds, so a new resource type is supported
+// by adding its fetcher, its HCL generator, and one entry here.
+//
+// Only types with a provider resource belong here. Escalation policies,
+// integrations, and on-call schedules are exposed as data sources only and
+// have nothing to import.
+type resourceKind struct {
+	// key is the --resources name, e.g. "statuspages".
+	key string
+	// label names the resources in progress output, e.g. "status pages".
+	label string
+	// section is the heading of the resources in the import script.
+	section string
+	// terraformType is the provider resource type, e.g. "hyperping_statuspage".
+	terraformType string
+
+	fetch func(g *Generator, ctx context.Context, data *ResourceData, progress *ProgressReporter) error
+	items func(data *ResourceData) []resourceItem
+}
+
+// resourceItem is one fetched resource of a resourceKind.
+type resourceItem struct {
+	// name is the source name the Terraform name is derived from and that
+	// --filter-name and --filter-exclude match against.
+	name string
+	// previewName identifies the resource in the preview report when name
+	// alone is ambiguous. Empty means name.
+	previewName string
+	uuid        string
+	hcl         func(g *Generator, root *hclgen.Body)
+}
+
+// displayName returns the name shown for the item in the preview report.
+func (it resourceItem) displayName() string {
+	if it.previewName != "" {
+		return it.previewName
+	}
+	return it.name
+}
+
+// resourceKinds lists every importable resource type in output order.
+var resourceKinds = []resourceKind{
+	{
+		key:           "monitors",
+		label:         "monitors",
+		section:       "Monitors",
+		terraformType: "hyperping_monitor",
+		fetch:         (*Generator).fetchMonitors,
+		items: func(data *ResourceData) []resourceItem {
+			return collectItems(data.Monitors, func(m hyperping.Monitor) resourceItem {
+				return resourceItem{
+					name: m.Name,
+					uuid: m.UUID,
+					hcl:  func(g *Generator, root *hclgen.Body) { g.generateMonitorHCL(root, m) },
+				}
+			})
+		},
+	},
+	{
+		key:           "healthchecks",
+		label:         "healthchecks",
+		section:       "Healthchecks",
+		terraformType: "hyperping_healthcheck",
+		fetch:         (*Generator).fetchHealthchecks,
+		items: func(data *ResourceData) []resourceItem {
+			return collectItems(data.Healthchecks, func(h hyperping.Healthcheck) resourceItem {
+				return resourceItem{
+					name: h.Name,
+					uuid: h.UUID,
+					hcl:  func(g *Generator, root *hclgen.Body) { g.generateHealthcheckHCL(root, h) },
+				}
+			})
+		},
+	},
+	{
+		key:           "statuspages",
+		label:         "status pages",
+		section:       "Status Pages",
+		terraformType: "hyperping_statuspage",
+		fetch:         (*Generator).fetchStatusPages,
+		items: func(data *ResourceData) []resourceItem {
+			return collectItems(data.StatusPages, func(sp hyperping.StatusPage) resourceItem {
+				return resourceItem{
+					name: sp.Name,
+					uuid: sp.UUID,
+					hcl:  func(g *Generator, root *hclgen.Body) { g.generateStatusPageHCL(root, sp) },
+				}
+			})
+		},
+	},
+	{
+		key:           "incidents",
+		label:         "incidents",
+		section:       "Incidents",
+		terraformType: "hyperping_incident",
+		fetch:         (*Generator).fetchIncidents,
+		items: func(data *ResourceData) []resourceItem {
+			return collectItems(data.Incidents, func(i hyperping.Incident) resourceItem {
+				return resourceItem{
+					name: i.Title.En,
+					uuid: i.UUID,
+					hcl:  func(g *Generator, root *hclgen.Body) { g.generateIncidentHCL(root, i) },
+				}
+			})
+		},
+	},
+	{
+		key:           "maintenance",
+		label:         "maintenance windows",
+		section:       "Maintenance Windows",
+		terraformType: "hyperping_maintenance",
+		fetch:         (*Generator).fetchMaintenance,
+		items: func(data *ResourceData) []resourceItem {
+			return collectItems(data.Maintenance, func(m hyperping.Maintenance) resourceItem {
+				return resourceItem{
+					name: maintenanceTitle(m),
+					uuid: m.UUID,
+					hcl:  func(g *Generator, root *hclgen.Body) { g.generateMaintenanceHCL(root, m) },
+				}
+			})
+		},
+	},
+	{
+		key:           "outages",
+		label:         "outages",
+		section:       "Outages",
+		terraformType: "hyperping_outage",
+		fetch:         (*Generator).fetchOutages,
+		items: func(data *ResourceData) []resourceItem {
+			return collectItems(data.Outages, func(o hyperping.Outage) resourceItem {
+				return resourceItem{
+					name:        o.Monitor.Name,
+					previewName: outagePreviewName(o),
+					uuid:        o.UUID,
+					hcl:         func(g *Generator, root *hclgen.Body) { g.generateOutageHCL(root, o) },
+				}
+			})
+		},
+	},
+}
+
+// resourceKeys returns the --resources names of all resource kinds.
+func resourceKeys() []string {
+	keys := make([]string, 0, len(resourceKinds))
+	for _, k := range resourceKinds {
+		keys = append(keys, k.key)
+	}
+	return keys
+}
+
+func collectItems[T any](resources []T, item func(T) resourceItem) []resourceItem {
+	items := make([]resourceItem, 0, len(resources))
+	for _, r := range resources {
+		items = append(items, item(r))
+	}
+	return items
+}
+
+// maintenanceTitle returns the English title of a maintenance window,
+// falling back to its name.
+func maintenanceTitle(m hyperping.Maintenance) string {
+	if m.Title.En != "" {
+		return m.Title.En
+	}
+	return m.Name
+}
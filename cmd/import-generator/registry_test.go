@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestResourceKinds_Unique(t *testing.T) {
+	keys := make(map[string]bool)
+	types := make(map[string]bool)
+	for _, kind := range resourceKinds {
+		if keys[kind.key] {
+			t.Errorf("duplicate resource key %q", kind.key)
+		}
+		if types[kind.terraformType] {
+			t.Errorf("duplicate terraform type %q", kind.terraformType)
+		}
+		keys[kind.key] = true
+		types[kind.terraformType] = true
+
+		if kind.fetch == nil || kind.items == nil {
+			t.Errorf("resource kind %q is missing its fetch or items function", kind.key)
+		}
+	}
+
+	g := &Generator{}
+	if got := len(g.buildFetcherMap()); got != len(resourceKinds) {
+		t.Errorf("buildFetcherMap() has %d entries, want %d", got, len(resourceKinds))
+	}
+}
+
+func TestBuildImportJobs(t *testing.T) {
+	data := &ResourceData{
+		Monitors: []hyperping.Monitor{
+			{UUID: "mon_1", Name: "API"},
+			{UUID: "mon_2", Name: "Web"},
+		},
+		Maintenance: []hyperping.Maintenance{
+			{UUID: "mw_1", Name: "db-upgrade"},
+		},
+		Outages: []hyperping.Outage{
+			{UUID: "out_1", Monitor: hyperping.MonitorReference{Name: "API"}},
+		},
+	}
+
+	filter, err := NewFilterConfig("", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jobs := buildImportJobs(data, "prod_", "", filter)
+
+	want := []string{
+		"hyperping_monitor.prod_api mon_1",
+		"hyperping_monitor.prod_web mon_2",
+		"hyperping_maintenance.prod_db_upgrade mw_1",
+		"hyperping_outage.prod_api out_1",
+	}
+	if len(jobs) != len(want) {
+		t.Fatalf("got %d jobs, want %d", len(jobs), len(want))
+	}
+	for i, job := range jobs {
+		got := job.ResourceType + "." + job.ResourceName + " " + job.ResourceID
+		if got != want[i] {
+			t.Errorf("job %d = %q, want %q", i, got, want[i])
+		}
+		if job.Index != i {
+			t.Errorf("job %d has index %d", i, job.Index)
+		}
+	}
+}
+
+func TestBuildImportJobs_Filtered(t *testing.T) {
+	data := &ResourceData{
+		Monitors: []hyperping.Monitor{
+			{UUID: "mon_1", Name: "prod-api"},
+			{UUID: "mon_2", Name: "staging-api"},
+		},
+		Healthchecks: []hyperping.Healthcheck{
+			{UUID: "tok_1", Name: "prod-cron"},
+		},
+	}
+
+	filter, err := NewFilterConfig("^prod-", "", "hyperping_monitor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	jobs := buildImportJobs(data, "", "", filter)
+
+	if len(jobs) != 1 || jobs[0].ResourceID != "mon_1" {
+		t.Fatalf("got %+v, want only mon_1", jobs)
+	}
+}
+
+func TestGenerateImports_AllKinds(t *testing.T) {
+	g := &Generator{}
+	var sb strings.Builder
+	g.generateImports(&sb, &ResourceData{
+		Monitors:     []hyperping.Monitor{{UUID: "mon_1", Name: "api"}},
+		Healthchecks: []hyperping.Healthcheck{{UUID: "tok_1", Name: "cron"}},
+		StatusPages:  []hyperping.StatusPage{{UUID: "sp_1", Name: "status"}},
+		Incidents:    []hyperping.Incident{{UUID: "inci_1", Title: hyperping.LocalizedText{En: "outage"}}},
+		Maintenance:  []hyperping.Maintenance{{UUID: "mw_1", Title: hyperping.LocalizedText{En: "upgrade"}}},
+		Outages:      []hyperping.Outage{{UUID: "out_1", Monitor: hyperping.MonitorReference{Name: "api"}}},
+	})
+
+	lines := strings.Split(strings.TrimSpace(sb.String()), "\n")
+	if len(lines) != len(resourceKinds) {
+		t.Fatalf("got %d import commands, want one per resource kind:\n%s", len(lines), sb.String())
+	}
+	for i, kind := range resourceKinds {
+		if !strings.HasPrefix(lines[i], "terraform import "+kind.terraformType+".") {
+			t.Errorf("line %d = %q, want a %s import", i, lines[i], kind.terraformType)
+		}
+	}
+}
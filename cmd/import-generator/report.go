@@ -0,0 +1,226 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"html/template"
+	"io"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
+)
+
+// Preview report formats, selected by the --report file extension.
+const (
+	reportFormatMarkdown = "markdown"
+	reportFormatHTML     = "html"
+)
+
+// PreviewEntry is one resource in an import preview report.
+type PreviewEntry struct {
+	ResourceType string
+	Name         string // source name in Hyperping
+	UUID         string
+	Address      string // target Terraform address
+	HCL          string
+}
+
+// PreviewReport is the full import plan rendered by --dry-run --report.
+type PreviewReport struct {
+	GeneratedAt time.Time
+	Filters     string
+	Entries     []PreviewEntry
+}
+
+// reportFormat returns the report format for a --report path.
+func reportFormat(path string) (string, error) {
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".md", ".markdown":
+		return reportFormatMarkdown, nil
+	case ".html", ".htm":
+		return reportFormatHTML, nil
+	default:
+		return "", fmt.Errorf("--report must end in .md or .html: %s", path)
+	}
+}
+
+// buildPreview returns a preview entry for every resource in data, in the
+// same order and with the same addresses as the generated import commands.
+func (g *Generator) buildPreview(data *ResourceData) []PreviewEntry {
+	var entries []PreviewEntry
+	add := func(resourceType, name, uuid, tfName string, gen func(root *hclgen.Body)) {
+		f := hclgen.NewFile()
+		gen(f.Body())
+		entries = append(entries, PreviewEntry{
+			ResourceType: resourceType,
+			Name:         name,
+			UUID:         uuid,
+			Address:      g.address(resourceType, tfName),
+			HCL:          strings.TrimSpace(string(f.Bytes())),
+		})
+	}
+
+	for _, kind := range resourceKinds {
+		for _, it := range kind.items(data) {
+			add(kind.terraformType, it.displayName(), it.uuid, g.terraformName(it.name), func(root *hclgen.Body) {
+				it.hcl(g, root)
+			})
+		}
+	}
+
+	return entries
+}
+
+// outagePreviewName identifies an outage by its monitor and start date, since
+// outages have no name of their own.
+func outagePreviewName(o hyperping.Outage) string {
+	if o.StartDate == "" {
+		return o.Monitor.Name
+	}
+	return o.Monitor.Name + " (" + o.StartDate + ")"
+}
+
+// CountsByType returns the number of entries per resource type, sorted by type.
+func (r PreviewReport) CountsByType() []TypeCount {
+	counts := make(map[string]int)
+	for _, e := range r.Entries {
+		counts[e.ResourceType]++
+	}
+	result := make([]TypeCount, 0, len(counts))
+	for t, n := range counts {
+		result = append(result, TypeCount{Type: t, Count: n})
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Type < result[j].Type })
+	return result
+}
+
+// TypeCount is the number of preview entries of one resource type.
+type TypeCount struct {
+	Type  string
+	Count int
+}
+
+// writePreviewReport renders report to w in the given format.
+func writePreviewReport(w io.Writer, format string, report PreviewReport) error {
+	switch format {
+	case reportFormatMarkdown:
+		return writePreviewMarkdown(w, report)
+	case reportFormatHTML:
+		return previewHTMLTemplate.Execute(w, report)
+	default:
+		return fmt.Errorf("unknown report format: %s", format)
+	}
+}
+
+func writePreviewMarkdown(w io.Writer, report PreviewReport) error {
+	var sb strings.Builder
+
+	sb.WriteString("# Hyperping Import Preview\n\n")
+	fmt.Fprintf(&sb, "Generated: %s  \n", report.GeneratedAt.UTC().Format(time.RFC3339))
+	if report.Filters != "" {
+		fmt.Fprintf(&sb, "Filters: %s  \n", markdownCell(report.Filters))
+	}
+	fmt.Fprintf(&sb, "Total: %d resource(s)\n\n", len(report.Entries))
+	sb.WriteString("No imports have been executed. Review this plan, then run the import with `--execute`.\n\n")
+
+	if len(report.Entries) == 0 {
+		sb.WriteString("No resources match the current filters.\n")
+		_, err := io.WriteString(w, sb.String())
+		return err
+	}
+
+	sb.WriteString("## Summary\n\n")
+	sb.WriteString("| Resource type | Count |\n|---|---|\n")
+	for _, c := range report.CountsByType() {
+		fmt.Fprintf(&sb, "| `%s` | %d |\n", c.Type, c.Count)
+	}
+
+	sb.WriteString("\n## Resources\n\n")
+	sb.WriteString("| # | Name | UUID | Target address |\n|---|---|---|---|\n")
+	for i, e := range report.Entries {
+		fmt.Fprintf(&sb, "| %d | %s | `%s` | `%s` |\n", i+1, markdownCell(e.Name), markdownCell(e.UUID), e.Address)
+	}
+
+	sb.WriteString("\n## Configuration\n")
+	for i, e := range report.Entries {
+		fence := markdownFence(e.HCL)
+		fmt.Fprintf(&sb, "\n### %d. `%s`\n\n", i+1, e.Address)
+		fmt.Fprintf(&sb, "- Name: %s\n- UUID: `%s`\n\n", markdownCell(e.Name), markdownCell(e.UUID))
+		fmt.Fprintf(&sb, "%shcl\n%s\n%s\n", fence, e.HCL, fence)
+	}
+
+	_, err := io.WriteString(w, sb.String())
+	return err
+}
+
+// markdownCell makes text safe for a single markdown table cell: pipes are
+// escaped and line breaks collapsed.
+func markdownCell(s string) string {
+	s = strings.ReplaceAll(s, "|", `\|`)
+	s = strings.ReplaceAll(s, "`", "'")
+	return strings.Join(strings.Fields(s), " ")
+}
+
+// markdownFence returns a code fence longer than any backtick run in s.
+func markdownFence(s string) string {
+	longest, run := 0, 0
+	for _, r := range s {
+		if r == '`' {
+			run++
+			longest = max(longest, run)
+		} else {
+			run = 0
+		}
+	}
+	return strings.Repeat("`", max(3, longest+1))
+}
+
+var previewHTMLTemplate = template.Must(template.New("preview").Funcs(template.FuncMap{
+	"inc": func(i int) int { return i + 1 },
+	"utc": func(t time.Time) string { return t.UTC().Format(time.RFC3339) },
+}).Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<title>Hyperping Import Preview</title>
+<style>
+body { font-family: -apple-system, BlinkMacSystemFont, "Segoe UI", sans-serif; margin: 2rem; color: #1f2328; }
+table { border-collapse: collapse; margin-bottom: 1.5rem; }
+th, td { border: 1px solid #d0d7de; padding: 0.4rem 0.8rem; text-align: left; }
+th { background: #f6f8fa; }
+code, pre { font-family: ui-monospace, SFMono-Regular, Menlo, monospace; }
+pre { background: #f6f8fa; padding: 1rem; overflow-x: auto; }
+</style>
+</head>
+<body>
+<h1>Hyperping Import Preview</h1>
+<p>Generated: {{utc .GeneratedAt}}<br>
+{{if .Filters}}Filters: {{.Filters}}<br>
+{{end}}Total: {{len .Entries}} resource(s)</p>
+<p>No imports have been executed. Review this plan, then run the import with <code>--execute</code>.</p>
+{{if .Entries}}
+<h2>Summary</h2>
+<table>
+<tr><th>Resource type</th><th>Count</th></tr>
+{{range .CountsByType}}<tr><td><code>{{.Type}}</code></td><td>{{.Count}}</td></tr>
+{{end}}</table>
+<h2>Resources</h2>
+<table>
+<tr><th>#</th><th>Name</th><th>UUID</th><th>Target address</th></tr>
+{{range $i, $e := .Entries}}<tr><td>{{inc $i}}</td><td>{{$e.Name}}</td><td><code>{{$e.UUID}}</code></td><td><a href="#r{{inc $i}}"><code>{{$e.Address}}</code></a></td></tr>
+{{end}}</table>
+<h2>Configuration</h2>
+{{range $i, $e := .Entries}}<h3 id="r{{inc $i}}">{{inc $i}}. <code>{{$e.Address}}</code></h3>
+<p>Name: {{$e.Name}}<br>UUID: <code>{{$e.UUID}}</code></p>
+<pre><code>{{$e.HCL}}</code></pre>
+{{end}}{{else}}<p>No resources match the current filters.</p>
+{{end}}</body>
+</html>
+`))
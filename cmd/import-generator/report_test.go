@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func previewTestData() *ResourceData {
+	return &ResourceData{
+		Monitors: []hyperping.Monitor{
+			{UUID: "mon_123", Name: "API | Prod", URL: "https://api.example.com", Protocol: "http"},
+			{UUID: "mon_456", Name: "Web", URL: "https://example.com", Protocol: "http"},
+		},
+		Healthchecks: []hyperping.Healthcheck{
+			{UUID: "tok_789", Name: "<script>alert(1)</script>"},
+		},
+		Outages: []hyperping.Outage{
+			{UUID: "out_1", StartDate: "2026-01-01T00:00:00Z", Monitor: hyperping.MonitorReference{UUID: "mon_123", Name: "API"}},
+		},
+	}
+}
+
+func TestReportFormat(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{"preview.md", reportFormatMarkdown, false},
+		{"out/Preview.MARKDOWN", reportFormatMarkdown, false},
+		{"preview.html", reportFormatHTML, false},
+		{"preview.htm", reportFormatHTML, false},
+		{"preview.txt", "", true},
+		{"preview", "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := reportFormat(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("reportFormat(%q) error = %v, wantErr %v", tt.path, err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("reportFormat(%q) = %q, want %q", tt.path, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildPreview(t *testing.T) {
+	g := &Generator{prefix: "prod_"}
+	entries := g.buildPreview(previewTestData())
+
+	if len(entries) != 4 {
+		t.Fatalf("got %d entries, want 4", len(entries))
+	}
+
+	first := entries[0]
+	if first.Address != "hyperping_monitor.prod_api_prod" || first.UUID != "mon_123" || first.Name != "API | Prod" {
+		t.Errorf("unexpected first entry: %+v", first)
+	}
+	if !strings.HasPrefix(first.HCL, `resource "hyperping_monitor" "prod_api_prod" {`) {
+		t.Errorf("expected monitor HCL block, got:\n%s", first.HCL)
+	}
+
+	outage := entries[3]
+	if outage.Address != "hyperping_outage.prod_api" || outage.Name != "API (2026-01-01T00:00:00Z)" {
+		t.Errorf("unexpected outage entry: %+v", outage)
+	}
+}
+
+func TestWritePreviewReport_Markdown(t *testing.T) {
+	g := &Generator{}
+	report := PreviewReport{
+		GeneratedAt: time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		Filters:     "name=PROD-.*",
+		Entries:     g.buildPreview(previewTestData()),
+	}
+
+	var sb strings.Builder
+	if err := writePreviewReport(&sb, reportFormatMarkdown, report); err != nil {
+		t.Fatal(err)
+	}
+	out := sb.String()
+
+	for _, want := range []string{
+		"Generated: 2026-03-01T12:00:00Z",
+		"Filters: name=PROD-.*",
+		"Total: 4 resource(s)",
+		"| `hyperping_monitor` | 2 |",
+		`| 1 | API \| Prod | ` + "`mon_123` | `hyperping_monitor.api_prod` |",
+		"### 2. `hyperping_monitor.web`",
+		"```hcl\nresource \"hyperping_monitor\" \"web\" {",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown report missing %q\n%s", want, out)
+		}
+	}
+}
+
+func TestWritePreviewReport_MarkdownEmpty(t *testing.T) {
+	var sb strings.Builder
+	if err := writePreviewReport(&sb, reportFormatMarkdown, PreviewReport{}); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(sb.String(), "No resources match the current filters.") || strings.Contains(sb.String(), "## Resources") {
+		t.Errorf("unexpected empty report:\n%s", sb.String())
+	}
+}
+
+func TestWritePreviewReport_HTMLEscapes(t *testing.T) {
+	g := &Generator{}
+	report := PreviewReport{Entries: g.buildPreview(previewTestData())}
+
+	var sb strings.Builder
+	if err := writePreviewReport(&sb, reportFormatHTML, report); err != nil {
+		t.Fatal(err)
+	}
+	out := sb.String()
+
+	if strings.Contains(out, "<script>") {
+		t.Error("HTML report must escape resource names")
+	}
+	for _, want := range []string{
+		"&lt;script&gt;alert(1)&lt;/script&gt;",
+		`<a href="#r1"><code>hyperping_monitor.api_prod</code></a>`,
+		`<h3 id="r4">4. <code>hyperping_outage.api</code></h3>`,
+		"resource &#34;hyperping_monitor&#34; &#34;web&#34; {",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("HTML report missing %q", want)
+		}
+	}
+}
+
+func TestMarkdownFence(t *testing.T) {
+	if got := markdownFence("plain"); got != "```" {
+		t.Errorf("markdownFence(plain) = %q", got)
+	}
+	if got := markdownFence("a ```` b"); got != "`````" {
+		t.Errorf("markdownFence with backticks = %q", got)
+	}
+}
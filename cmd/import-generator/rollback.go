@@ -27,6 +27,9 @@ type ImportLogEntry struct {
 	ResourceName string    `json:"resource_name"`
 	ResourceID   string    `json:"resource_id"`
 	ImportedAt   time.Time `json:"imported_at"`
+	// Module is the module address the resource was imported into, empty
+	// for the root module.
+	Module string `json:"module,omitempty"`
 }
 
 // NewImportLog creates a new import log.
@@ -38,12 +41,13 @@ func NewImportLog() *ImportLog {
 }
 
 // AddImport adds an imported resource to the log.
-func (il *ImportLog) AddImport(resourceType, resourceName, resourceID string) {
+func (il *ImportLog) AddImport(job ImportJob) {
 	il.Resources = append(il.Resources, ImportLogEntry{
-		ResourceType: resourceType,
-		ResourceName: resourceName,
-		ResourceID:   resourceID,
+		ResourceType: job.ResourceType,
+		ResourceName: job.ResourceName,
+		ResourceID:   job.ResourceID,
 		ImportedAt:   time.Now(),
+		Module:       job.Module,
 	})
 }
 
@@ -175,7 +179,7 @@ func (rm *RollbackManager) executeRollback(ctx context.Context, log *ImportLog)
 	// Remove resources in reverse order
 	for i := len(log.Resources) - 1; i >= 0; i-- {
 		entry := log.Resources[i]
-		resourceAddress := fmt.Sprintf("%s.%s", entry.ResourceType, entry.ResourceName)
+		resourceAddress := entry.Address()
 
 		if rm.dryRun {
 			fmt.Printf("[DRY RUN] Would remove: %s\n", resourceAddress)
@@ -184,7 +188,7 @@ func (rm *RollbackManager) executeRollback(ctx context.Context, log *ImportLog)
 		}
 
 		// Execute terraform state rm
-		cmd := exec.CommandContext(ctx, "terraform", "state", "rm", resourceAddress) // #nosec G204 -- args are structured internal data, not user input
+		cmd := terraformCommand(ctx, "state", "rm", resourceAddress)
 		output, err := cmd.CombinedOutput()
 
 		if err != nil {
@@ -245,7 +249,7 @@ func (rm *RollbackManager) ShowRollbackPlan() error {
 	fmt.Printf("Resources that would be removed: %d\n\n", len(log.Resources))
 
 	for _, entry := range log.Resources {
-		resourceAddress := fmt.Sprintf("%s.%s", entry.ResourceType, entry.ResourceName)
+		resourceAddress := entry.Address()
 		fmt.Printf("  - %s (ID: %s, imported at: %s)\n",
 			resourceAddress,
 			entry.ResourceID,
@@ -270,8 +274,9 @@ func (rm *RollbackManager) VerifyRollbackPreconditions() error {
 	}
 
 	// Check if we're in a terraform directory
-	if _, err := os.Stat("terraform.tfstate"); err != nil {
-		if _, err := os.Stat(".terraform"); err != nil {
+	dir := terraformDir()
+	if _, err := os.Stat(filepath.Join(dir, "terraform.tfstate")); err != nil {
+		if _, err := os.Stat(filepath.Join(dir, ".terraform")); err != nil {
 			return fmt.Errorf("not in a Terraform directory (no .terraform or terraform.tfstate found)")
 		}
 	}
@@ -76,66 +76,15 @@ func (g *Generator) generateScript(data *ResourceData) string {
 	// an attacker-influenced UUID-shaped value cannot smuggle command
 	// substitution ($(...), ``...``) or statement chaining (;) into the
 	// generated script.
-	if len(data.Monitors) > 0 {
-		sb.WriteString("# Monitors\n")
-		for _, m := range data.Monitors {
-			name := g.terraformName(m.Name)
-			addr := fmt.Sprintf("hyperping_monitor.%s", name)
-			fmt.Fprintf(&sb, "import_resource %q %s\n", addr, migrate.QuoteShellUUID(m.UUID))
+	for _, kind := range resourceKinds {
+		items := kind.items(data)
+		if len(items) == 0 {
+			continue
 		}
-		sb.WriteString("\n")
-	}
-
-	if len(data.Healthchecks) > 0 {
-		sb.WriteString("# Healthchecks\n")
-		for _, h := range data.Healthchecks {
-			name := g.terraformName(h.Name)
-			addr := fmt.Sprintf("hyperping_healthcheck.%s", name)
-			fmt.Fprintf(&sb, "import_resource %q %s\n", addr, migrate.QuoteShellUUID(h.UUID))
-		}
-		sb.WriteString("\n")
-	}
-
-	if len(data.StatusPages) > 0 {
-		sb.WriteString("# Status Pages\n")
-		for _, sp := range data.StatusPages {
-			name := g.terraformName(sp.Name)
-			addr := fmt.Sprintf("hyperping_statuspage.%s", name)
-			fmt.Fprintf(&sb, "import_resource %q %s\n", addr, migrate.QuoteShellUUID(sp.UUID))
-		}
-		sb.WriteString("\n")
-	}
-
-	if len(data.Incidents) > 0 {
-		sb.WriteString("# Incidents\n")
-		for _, i := range data.Incidents {
-			name := g.terraformName(i.Title.En)
-			addr := fmt.Sprintf("hyperping_incident.%s", name)
-			fmt.Fprintf(&sb, "import_resource %q %s\n", addr, migrate.QuoteShellUUID(i.UUID))
-		}
-		sb.WriteString("\n")
-	}
-
-	if len(data.Maintenance) > 0 {
-		sb.WriteString("# Maintenance Windows\n")
-		for _, m := range data.Maintenance {
-			titleText := m.Title.En
-			if titleText == "" {
-				titleText = m.Name
-			}
-			name := g.terraformName(titleText)
-			addr := fmt.Sprintf("hyperping_maintenance.%s", name)
-			fmt.Fprintf(&sb, "import_resource %q %s\n", addr, migrate.QuoteShellUUID(m.UUID))
-		}
-		sb.WriteString("\n")
-	}
-
-	if len(data.Outages) > 0 {
-		sb.WriteString("# Outages\n")
-		for _, o := range data.Outages {
-			name := g.terraformName(o.Monitor.Name)
-			addr := fmt.Sprintf("hyperping_outage.%s", name)
-			fmt.Fprintf(&sb, "import_resource %q %s\n", addr, migrate.QuoteShellUUID(o.UUID))
+		fmt.Fprintf(&sb, "# %s\n", kind.section)
+		for _, it := range items {
+			addr := g.address(kind.terraformType, g.terraformName(it.name))
+			fmt.Fprintf(&sb, "import_resource %q %s\n", addr, migrate.QuoteShellUUID(it.uuid))
 		}
 		sb.WriteString("\n")
 	}
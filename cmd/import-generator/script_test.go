@@ -245,3 +245,19 @@ func TestGenerateScript_UsageInstructions(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateScript_ModulePath(t *testing.T) {
+	gen := &Generator{modulePath: "module.platform.module.monitoring"}
+
+	data := &ResourceData{
+		Healthchecks: []hyperping.Healthcheck{
+			{UUID: "tok_456", Name: "Nightly Backup"},
+		},
+	}
+	script := gen.generateScript(data)
+
+	want := `import_resource "module.platform.module.monitoring.hyperping_healthcheck.nightly_backup" "tok_456"`
+	if !strings.Contains(script, want) {
+		t.Errorf("Script should import into the module address %q\nGot:\n%s", want, script)
+	}
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Job result statuses in an execution summary.
+const (
+	jobStatusImported = "imported"
+	jobStatusFailed   = "failed"
+)
+
+// ExecutionSummary is the machine-readable result of an --execute run,
+// written by --summary-json. Its timestamp, total_resources, imported_ids,
+// failed_ids and completed fields use the checkpoint format, so the file can
+// also be passed to --checkpoint-file with --resume to run the jobs a
+// cancelled run skipped.
+type ExecutionSummary struct {
+	Timestamp      time.Time          `json:"timestamp"`
+	TotalResources int                `json:"total_resources"`
+	ImportedIDs    []ImportedResource `json:"imported_ids"`
+	FailedIDs      []string           `json:"failed_ids"`
+	Completed      bool               `json:"completed"`
+	FilterSummary  string             `json:"filter_summary,omitempty"`
+
+	StartedAt  time.Time        `json:"started_at"`
+	FinishedAt time.Time        `json:"finished_at"`
+	Stats      SummaryStats     `json:"stats"`
+	Jobs       []JobSummaryJSON `json:"jobs"`
+}
+
+// SummaryStats holds aggregate statistics for an execution summary.
+type SummaryStats struct {
+	Succeeded       int     `json:"succeeded"`
+	Failed          int     `json:"failed"`
+	Skipped         int     `json:"skipped"`
+	SuccessRate     float64 `json:"success_rate"`
+	WallTimeMS      int64   `json:"wall_time_ms"`
+	TotalImportMS   int64   `json:"total_import_ms"`
+	AverageImportMS int64   `json:"average_import_ms"`
+	TotalAttempts   int     `json:"total_attempts"`
+}
+
+// JobSummaryJSON is the result of one import job.
+type JobSummaryJSON struct {
+	Address      string `json:"address"`
+	ResourceType string `json:"resource_type"`
+	ResourceName string `json:"resource_name"`
+	ID           string `json:"id"`
+	Status       string `json:"status"`
+	DurationMS   int64  `json:"duration_ms"`
+	Attempts     int    `json:"attempts"`
+	Error        string `json:"error,omitempty"`
+}
+
+// NewExecutionSummary builds the machine-readable summary of s. Jobs that
+// never reported a result, because the run stopped early, are counted as
+// skipped.
+func NewExecutionSummary(s *ImportSummary, filterSummary string) *ExecutionSummary {
+	es := &ExecutionSummary{
+		Timestamp:      s.EndTime,
+		TotalResources: s.TotalJobs,
+		ImportedIDs:    make([]ImportedResource, 0, s.SuccessCount),
+		FailedIDs:      make([]string, 0, s.FailureCount),
+		FilterSummary:  filterSummary,
+		StartedAt:      s.StartTime,
+		FinishedAt:     s.EndTime,
+		Jobs:           make([]JobSummaryJSON, 0, len(s.Results)),
+	}
+	if es.Timestamp.IsZero() {
+		es.Timestamp = time.Now()
+	}
+
+	for _, result := range s.Results {
+		job := JobSummaryJSON{
+			Address:      result.Job.Address(),
+			ResourceType: result.Job.ResourceType,
+			ResourceName: result.Job.ResourceName,
+			ID:           result.Job.ResourceID,
+			DurationMS:   result.Duration.Milliseconds(),
+			Attempts:     result.Attempts,
+		}
+		if result.Success {
+			job.Status = jobStatusImported
+			es.ImportedIDs = append(es.ImportedIDs, ImportedResource{
+				ID:           result.Job.ResourceID,
+				ResourceType: result.Job.ResourceType,
+				ResourceName: result.Job.ResourceName,
+			})
+			es.Stats.Succeeded++
+		} else {
+			job.Status = jobStatusFailed
+			if result.Error != nil {
+				job.Error = result.Error.Error()
+			}
+			es.FailedIDs = append(es.FailedIDs, result.Job.ResourceID)
+			es.Stats.Failed++
+		}
+		es.Stats.TotalAttempts += result.Attempts
+		es.Stats.TotalImportMS += job.DurationMS
+		es.Jobs = append(es.Jobs, job)
+	}
+
+	es.Stats.Skipped = s.TotalJobs - len(s.Results)
+	es.Completed = es.Stats.Skipped == 0
+	if !s.StartTime.IsZero() && !s.EndTime.IsZero() {
+		es.Stats.WallTimeMS = s.EndTime.Sub(s.StartTime).Milliseconds()
+	}
+	if len(s.Results) > 0 {
+		es.Stats.AverageImportMS = es.Stats.TotalImportMS / int64(len(s.Results))
+	}
+	if s.TotalJobs > 0 {
+		es.Stats.SuccessRate = float64(es.Stats.Succeeded) / float64(s.TotalJobs) * 100
+	}
+
+	return es
+}
+
+// Save writes the summary as indented JSON to filename.
+func (es *ExecutionSummary) Save(filename string) error {
+	data, err := json.MarshalIndent(es, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal execution summary: %w", err)
+	}
+
+	if err := os.WriteFile(filename, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write execution summary: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func testImportSummary() *ImportSummary {
+	start := time.Date(2026, 2, 14, 10, 0, 0, 0, time.UTC)
+	imported := ImportResult{
+		Job:      ImportJob{ResourceType: "hyperping_monitor", ResourceName: "prod_api", ResourceID: "mon_123"},
+		Success:  true,
+		Duration: 1200 * time.Millisecond,
+		Attempts: 1,
+	}
+	failed := ImportResult{
+		Job:      ImportJob{ResourceType: "hyperping_healthcheck", ResourceName: "nightly", ResourceID: "tok_456"},
+		Error:    errors.New("import failed: exit status 1"),
+		Duration: 800 * time.Millisecond,
+		Attempts: 1,
+	}
+	return &ImportSummary{
+		TotalJobs:     3,
+		SuccessCount:  1,
+		FailureCount:  1,
+		TotalDuration: 2 * time.Second,
+		FailedJobs:    []ImportResult{failed},
+		Results:       []ImportResult{imported, failed},
+		StartTime:     start,
+		EndTime:       start.Add(5 * time.Second),
+	}
+}
+
+func TestNewExecutionSummary(t *testing.T) {
+	es := NewExecutionSummary(testImportSummary(), "Name pattern: PROD-.*")
+
+	if len(es.Jobs) != 2 {
+		t.Fatalf("Jobs = %d, want 2", len(es.Jobs))
+	}
+	job := es.Jobs[0]
+	if job.Address != "hyperping_monitor.prod_api" || job.ID != "mon_123" || job.Status != jobStatusImported {
+		t.Errorf("Jobs[0] = %+v", job)
+	}
+	if job.DurationMS != 1200 || job.Attempts != 1 || job.Error != "" {
+		t.Errorf("Jobs[0] duration/attempts/error = %d/%d/%q", job.DurationMS, job.Attempts, job.Error)
+	}
+	if failed := es.Jobs[1]; failed.Status != jobStatusFailed || failed.Error != "import failed: exit status 1" {
+		t.Errorf("Jobs[1] = %+v", failed)
+	}
+
+	want := SummaryStats{
+		Succeeded:       1,
+		Failed:          1,
+		Skipped:         1,
+		SuccessRate:     float64(1) / 3 * 100,
+		WallTimeMS:      5000,
+		TotalImportMS:   2000,
+		AverageImportMS: 1000,
+		TotalAttempts:   2,
+	}
+	if es.Stats != want {
+		t.Errorf("Stats = %+v, want %+v", es.Stats, want)
+	}
+	if es.Completed {
+		t.Error("Completed = true with a skipped job")
+	}
+}
+
+func TestExecutionSummary_ResumableAsCheckpoint(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "summary.json")
+	if err := NewExecutionSummary(testImportSummary(), "Name pattern: PROD-.*").Save(path); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	checkpoint, err := LoadCheckpoint(path)
+	if err != nil {
+		t.Fatalf("LoadCheckpoint: %v", err)
+	}
+	if checkpoint.TotalResources != 3 {
+		t.Errorf("TotalResources = %d, want 3", checkpoint.TotalResources)
+	}
+	if !checkpoint.IsImported("mon_123") || !checkpoint.IsFailed("tok_456") {
+		t.Errorf("checkpoint imported=%v failed=%v", checkpoint.ImportedIDs, checkpoint.FailedIDs)
+	}
+	if checkpoint.FilterSummary != "Name pattern: PROD-.*" {
+		t.Errorf("FilterSummary = %q", checkpoint.FilterSummary)
+	}
+
+	jobs := FilterJobsForResume([]ImportJob{
+		{ResourceType: "hyperping_monitor", ResourceName: "prod_api", ResourceID: "mon_123"},
+		{ResourceType: "hyperping_healthcheck", ResourceName: "nightly", ResourceID: "tok_456"},
+		{ResourceType: "hyperping_monitor", ResourceName: "prod_web", ResourceID: "mon_789"},
+	}, checkpoint)
+	if len(jobs) != 1 || jobs[0].ResourceID != "mon_789" {
+		t.Errorf("resume jobs = %+v, want only the skipped mon_789", jobs)
+	}
+}
+
+func TestExecutionSummary_EmptyListsMarshalAsArrays(t *testing.T) {
+	es := NewExecutionSummary(&ImportSummary{}, "")
+
+	data, err := json.Marshal(es)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var raw map[string]any
+	if err := json.Unmarshal(data, &raw); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	for _, key := range []string{"imported_ids", "failed_ids", "jobs"} {
+		if _, ok := raw[key].([]any); !ok {
+			t.Errorf("%s = %v, want an empty array", key, raw[key])
+		}
+	}
+	if !es.Completed {
+		t.Error("Completed = false for an empty run")
+	}
+}
+
+func TestExecutionSummary_SaveError(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "missing", "summary.json")
+	if err := NewExecutionSummary(testImportSummary(), "").Save(path); err == nil {
+		t.Error("expected an error writing to a missing directory")
+	}
+	if _, err := os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("summary file exists after failed save: %v", err)
+	}
+}
@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+)
+
+// stringSliceFlag is a repeatable string flag (e.g. --backend-config=a --backend-config=b).
+type stringSliceFlag []string
+
+func (s *stringSliceFlag) String() string {
+	return strings.Join(*s, ",")
+}
+
+// Set appends a value for each occurrence of the flag.
+func (s *stringSliceFlag) Set(value string) error {
+	*s = append(*s, value)
+	return nil
+}
+
+// TerraformInitError is returned when terraform init fails, so callers can
+// tell an initialization problem apart from a failed import.
+type TerraformInitError struct {
+	Dir    string
+	Output string
+	Err    error
+}
+
+func (e *TerraformInitError) Error() string {
+	return fmt.Sprintf("terraform init failed in %s (no imports were attempted): %v\nOutput: %s", e.Dir, e.Err, e.Output)
+}
+
+func (e *TerraformInitError) Unwrap() error {
+	return e.Err
+}
+
+// terraformDir returns the directory terraform operates in.
+func terraformDir() string {
+	if *chdir != "" {
+		return *chdir
+	}
+	return "."
+}
+
+// terraformArgs prepends the global -chdir option when --chdir is set.
+func terraformArgs(dir string, args ...string) []string {
+	if dir == "" || dir == "." {
+		return args
+	}
+	return append([]string{"-chdir=" + dir}, args...)
+}
+
+// terraformCommand builds a terraform command that runs in the configured directory.
+func terraformCommand(ctx context.Context, args ...string) *exec.Cmd {
+	return exec.CommandContext(ctx, "terraform", terraformArgs(*chdir, args...)...) // #nosec G204 -- args are structured internal data and operator-supplied flags
+}
+
+// terraformInitArgs builds non-interactive init arguments with backend config passthrough.
+func terraformInitArgs(backendConfigs []string) []string {
+	args := []string{"init", "-input=false", "-no-color"}
+	for _, bc := range backendConfigs {
+		args = append(args, "-backend-config="+bc)
+	}
+	return args
+}
+
+// RunTerraformInit runs terraform init -input=false in the configured directory.
+func RunTerraformInit(ctx context.Context, backendConfigs []string) error {
+	fmt.Printf("Initializing Terraform in %s...\n", terraformDir())
+
+	output, err := terraformCommand(ctx, terraformInitArgs(backendConfigs)...).CombinedOutput()
+	if err != nil {
+		return &TerraformInitError{Dir: terraformDir(), Output: string(output), Err: err}
+	}
+
+	fmt.Println("✓ Terraform initialized")
+	return nil
+}
+
+// prepareTerraform initializes the working directory when --init is set.
+// Otherwise it only warns when the directory does not look initialized, since
+// a pipeline may run init in a later step or in another job.
+func prepareTerraform(ctx context.Context) error {
+	if *terraformInit {
+		return RunTerraformInit(ctx, backendConfig)
+	}
+	if err := VerifyTerraformInit(); err != nil {
+		fmt.Fprintf(os.Stderr, "Warning: %v (continuing)\n", err)
+	}
+	return nil
+}
+
+// VerifyTerraformInit checks if terraform has been initialized.
+func VerifyTerraformInit() error {
+	if _, err := exec.LookPath("terraform"); err != nil {
+		return fmt.Errorf("terraform command not found in PATH")
+	}
+
+	dir := terraformDir()
+	if _, err := os.Stat(filepath.Join(dir, ".terraform")); err != nil {
+		return fmt.Errorf("terraform not initialized in %s (run 'terraform init' or pass --init)", dir)
+	}
+
+	return nil
+}
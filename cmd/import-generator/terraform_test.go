@@ -0,0 +1,113 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"os/exec"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestTerraformArgs(t *testing.T) {
+	tests := []struct {
+		name     string
+		dir      string
+		args     []string
+		expected []string
+	}{
+		{
+			name:     "no chdir",
+			dir:      "",
+			args:     []string{"import", "hyperping_monitor.api", "mon_123"},
+			expected: []string{"import", "hyperping_monitor.api", "mon_123"},
+		},
+		{
+			name:     "current directory",
+			dir:      ".",
+			args:     []string{"plan"},
+			expected: []string{"plan"},
+		},
+		{
+			name:     "chdir prepended before subcommand",
+			dir:      "infra/prod",
+			args:     []string{"state", "rm", "hyperping_monitor.api"},
+			expected: []string{"-chdir=infra/prod", "state", "rm", "hyperping_monitor.api"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := terraformArgs(tt.dir, tt.args...)
+			if !reflect.DeepEqual(got, tt.expected) {
+				t.Errorf("terraformArgs() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
+func TestTerraformInitArgs(t *testing.T) {
+	got := terraformInitArgs([]string{"backend.hcl", "key=prod.tfstate"})
+	expected := []string{"init", "-input=false", "-no-color", "-backend-config=backend.hcl", "-backend-config=key=prod.tfstate"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("terraformInitArgs() = %v, want %v", got, expected)
+	}
+
+	got = terraformInitArgs(nil)
+	expected = []string{"init", "-input=false", "-no-color"}
+	if !reflect.DeepEqual(got, expected) {
+		t.Errorf("terraformInitArgs(nil) = %v, want %v", got, expected)
+	}
+}
+
+func TestStringSliceFlag(t *testing.T) {
+	var s stringSliceFlag
+	for _, v := range []string{"a=1", "b=2"} {
+		if err := s.Set(v); err != nil {
+			t.Fatalf("Set(%q) returned error: %v", v, err)
+		}
+	}
+
+	if len(s) != 2 || s[0] != "a=1" || s[1] != "b=2" {
+		t.Errorf("unexpected values: %v", s)
+	}
+	if s.String() != "a=1,b=2" {
+		t.Errorf("String() = %q, want %q", s.String(), "a=1,b=2")
+	}
+}
+
+func TestTerraformInitError(t *testing.T) {
+	cause := &exec.ExitError{}
+	var err error = &TerraformInitError{Dir: "infra", Output: "Error: Backend initialization required", Err: cause}
+
+	var initErr *TerraformInitError
+	if !errors.As(err, &initErr) {
+		t.Fatal("expected errors.As to match TerraformInitError")
+	}
+	if !errors.Is(err, cause) {
+		t.Error("expected TerraformInitError to unwrap to the underlying error")
+	}
+
+	msg := err.Error()
+	for _, want := range []string{"terraform init failed in infra", "no imports were attempted", "Backend initialization required"} {
+		if !strings.Contains(msg, want) {
+			t.Errorf("error message %q missing %q", msg, want)
+		}
+	}
+}
+
+func TestPrepareTerraformUninitializedWarns(t *testing.T) {
+	prevDir, prevInit := *chdir, *terraformInit
+	t.Cleanup(func() { *chdir, *terraformInit = prevDir, prevInit })
+	*chdir, *terraformInit = t.TempDir(), false
+
+	if err := VerifyTerraformInit(); err == nil {
+		t.Fatal("expected VerifyTerraformInit to report the uninitialized directory")
+	}
+	if err := prepareTerraform(context.Background()); err != nil {
+		t.Errorf("prepareTerraform() = %v, want nil so --execute continues", err)
+	}
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
+)
+
+// VariablesFile is the name of the file --extract-variables writes next to
+// the generated configuration.
+const VariablesFile = "variables.tf"
+
+// extractedVariable is a literal value shared by several monitors, lifted
+// into a Terraform variable by --extract-variables.
+type extractedVariable struct {
+	name        string
+	description string
+	ty          cty.Type
+	value       cty.Value
+	uses        int
+}
+
+// variableSet maps repeated monitor values to the variables that replace
+// them. Values used by a single monitor stay inline.
+type variableSet struct {
+	// vars holds the variables in the order they are declared.
+	vars []*extractedVariable
+
+	regions          map[string]*extractedVariable
+	checkFrequencies map[int]*extractedVariable
+	policies         map[string]*extractedVariable
+}
+
+// regionsKey identifies a regions list. Order is kept because the provider
+// compares the list as written.
+func regionsKey(regions []string) string {
+	return strings.Join(regions, ",")
+}
+
+// extractVariables finds the regions lists, check frequencies, and escalation
+// policy UUIDs that more than one monitor sets, counting only values the HCL
+// would otherwise write out.
+func (g *Generator) extractVariables(monitors []hyperping.Monitor) *variableSet {
+	vs := &variableSet{
+		regions:          make(map[string]*extractedVariable),
+		checkFrequencies: make(map[int]*extractedVariable),
+		policies:         make(map[string]*extractedVariable),
+	}
+
+	var regions, frequencies, policies []*extractedVariable
+	for _, m := range monitors {
+		if len(m.Regions) > 0 {
+			key := regionsKey(m.Regions)
+			v, ok := vs.regions[key]
+			if !ok {
+				v = &extractedVariable{ty: cty.List(cty.String), value: stringList(m.Regions)}
+				vs.regions[key] = v
+				regions = append(regions, v)
+			}
+			v.uses++
+		}
+
+		if m.CheckFrequency != 60 && m.CheckFrequency != 0 {
+			v, ok := vs.checkFrequencies[m.CheckFrequency]
+			if !ok {
+				v = &extractedVariable{
+					name:  g.variableName("check_frequency_" + strconv.Itoa(m.CheckFrequency)),
+					ty:    cty.Number,
+					value: cty.NumberIntVal(int64(m.CheckFrequency)),
+				}
+				vs.checkFrequencies[m.CheckFrequency] = v
+				frequencies = append(frequencies, v)
+			}
+			v.uses++
+		}
+
+		if m.EscalationPolicy != nil && m.EscalationPolicy.UUID != "" {
+			v, ok := vs.policies[m.EscalationPolicy.UUID]
+			if !ok {
+				v = &extractedVariable{ty: cty.String, value: cty.StringVal(m.EscalationPolicy.UUID)}
+				if m.EscalationPolicy.Name != "" {
+					v.description = fmt.Sprintf("UUID of the %q escalation policy", m.EscalationPolicy.Name)
+				}
+				vs.policies[m.EscalationPolicy.UUID] = v
+				policies = append(policies, v)
+			}
+			v.uses++
+		}
+	}
+
+	regions = keepRepeated(regions, vs.regions)
+	for i, v := range regions {
+		v.name = g.variableName("monitor_regions")
+		if len(regions) > 1 {
+			v.name = g.variableName("monitor_regions_" + strconv.Itoa(i+1))
+		}
+		v.description = fmt.Sprintf("Regions checked by %d monitors", v.uses)
+		vs.vars = append(vs.vars, v)
+	}
+
+	for _, v := range keepRepeated(frequencies, vs.checkFrequencies) {
+		v.description = fmt.Sprintf("Check frequency in seconds shared by %d monitors", v.uses)
+		vs.vars = append(vs.vars, v)
+	}
+
+	policies = keepRepeated(policies, vs.policies)
+	for i, v := range policies {
+		v.name = g.variableName("escalation_policy")
+		if len(policies) > 1 {
+			v.name = g.variableName("escalation_policy_" + strconv.Itoa(i+1))
+		}
+		if v.description == "" {
+			v.description = "Escalation policy UUID"
+		}
+		v.description += fmt.Sprintf(", shared by %d monitors", v.uses)
+		vs.vars = append(vs.vars, v)
+	}
+
+	return vs
+}
+
+// keepRepeated returns the variables of vars used more than once and removes
+// the others from index, so their values stay inline.
+func keepRepeated[K comparable](vars []*extractedVariable, index map[K]*extractedVariable) []*extractedVariable {
+	kept := vars[:0]
+	for _, v := range vars {
+		if v.uses > 1 {
+			kept = append(kept, v)
+		}
+	}
+	for k, v := range index {
+		if v.uses < 2 {
+			delete(index, k)
+		}
+	}
+	return kept
+}
+
+// variableName builds a variable name from base, applying --prefix so the
+// variables of separate imports do not collide.
+func (g *Generator) variableName(base string) string {
+	return g.prefix + base
+}
+
+// empty reports whether no value was repeated.
+func (vs *variableSet) empty() bool {
+	return vs == nil || len(vs.vars) == 0
+}
+
+// setRegions writes the regions attribute of a monitor, as a variable
+// reference when the list is shared.
+func (vs *variableSet) setRegions(r *hclgen.Body, regions []string) {
+	if vs != nil {
+		if v, ok := vs.regions[regionsKey(regions)]; ok {
+			_ = r.SetReference("regions", "var."+v.name) //nolint:errcheck // name built from identifiers
+			return
+		}
+	}
+	r.SetStringList("regions", regions)
+}
+
+// setCheckFrequency writes the check_frequency attribute of a monitor, as a
+// variable reference when the value is shared.
+func (vs *variableSet) setCheckFrequency(r *hclgen.Body, frequency int) {
+	if vs != nil {
+		if v, ok := vs.checkFrequencies[frequency]; ok {
+			_ = r.SetReference("check_frequency", "var."+v.name) //nolint:errcheck // name built from identifiers
+			return
+		}
+	}
+	setOptionalInt(r, "check_frequency", frequency, 60)
+}
+
+// setEscalationPolicy writes the escalation_policy attribute of a monitor, as
+// a variable reference when the policy is shared.
+func (vs *variableSet) setEscalationPolicy(r *hclgen.Body, uuid string) {
+	if vs != nil {
+		if v, ok := vs.policies[uuid]; ok {
+			_ = r.SetReference("escalation_policy", "var."+v.name) //nolint:errcheck // name built from identifiers
+			return
+		}
+	}
+	setOptionalString(r, "escalation_policy", uuid, "")
+}
+
+// generateVariablesHCL writes a variable block with a default for every
+// extracted value.
+func (vs *variableSet) generateVariablesHCL(sb *strings.Builder) {
+	f := hclgen.NewFile()
+	root := f.Body()
+	root.Comment("Values shared by several imported monitors.")
+	root.Comment("Override the defaults in terraform.tfvars to change every monitor at once.")
+	root.Newline()
+
+	for i, v := range vs.vars {
+		if i > 0 {
+			root.Newline()
+		}
+		b := root.Block("variable", v.name)
+		b.SetString("description", v.description)
+		b.SetType("type", v.ty)
+		b.SetValue("default", v.value)
+	}
+
+	sb.Write(f.Bytes())
+}
+
+func stringList(values []string) cty.Value {
+	items := make([]cty.Value, len(values))
+	for i, v := range values {
+		items[i] = cty.StringVal(v)
+	}
+	return cty.ListVal(items)
+}
@@ -0,0 +1,131 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"strings"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+)
+
+func extractVariablesData() *ResourceData {
+	oncall := &hyperping.EscalationPolicyRef{UUID: "ep_oncall", Name: "On-call"}
+	return &ResourceData{
+		Monitors: []hyperping.Monitor{
+			{
+				Name: "API", URL: "https://api.example.com", Protocol: "http",
+				CheckFrequency: 30, Regions: []string{"london", "virginia"},
+				FollowRedirects: true, EscalationPolicy: oncall,
+			},
+			{
+				Name: "Web", URL: "https://www.example.com", Protocol: "http",
+				CheckFrequency: 30, Regions: []string{"london", "virginia"},
+				FollowRedirects: true, EscalationPolicy: oncall,
+			},
+			{
+				Name: "Docs", URL: "https://docs.example.com", Protocol: "http",
+				CheckFrequency: 300, Regions: []string{"tokyo"},
+				FollowRedirects: true, EscalationPolicy: &hyperping.EscalationPolicyRef{UUID: "ep_docs"},
+			},
+			{
+				Name: "Status", URL: "https://status.example.com", Protocol: "http",
+				CheckFrequency: 60, Regions: []string{"london", "virginia"},
+				FollowRedirects: true,
+			},
+		},
+	}
+}
+
+func TestExtractVariables_Golden(t *testing.T) {
+	g := &Generator{extractVars: true}
+	var sb strings.Builder
+	g.generateHCL(&sb, extractVariablesData())
+
+	main := sb.String()
+	variables := g.VariablesHCL()
+	combined := variables + "\n" + main
+	if _, diags := hclsyntax.ParseConfig([]byte(combined), "generated.tf", hcl.InitialPos); diags.HasErrors() {
+		t.Fatalf("generated HCL does not parse: %s\n%s", diags.Error(), combined)
+	}
+	goldenAssert(t, "extract_variables.main.tf.golden", main)
+	goldenAssert(t, "extract_variables.variables.tf.golden", variables)
+}
+
+func TestExtractVariables_SingleUseStaysInline(t *testing.T) {
+	g := &Generator{extractVars: true}
+	var sb strings.Builder
+	g.generateHCL(&sb, extractVariablesData())
+	main := strings.Join(strings.Fields(sb.String()), " ")
+
+	for _, want := range []string{
+		`check_frequency = 300`,
+		`regions = ["tokyo"]`,
+		`escalation_policy = "ep_docs"`,
+	} {
+		if !strings.Contains(main, want) {
+			t.Errorf("missing inline value %s\nGot: %s", want, main)
+		}
+	}
+	if strings.Contains(g.VariablesHCL(), "check_frequency_60") {
+		t.Error("the default check frequency should not become a variable")
+	}
+}
+
+func TestExtractVariables_Prefix(t *testing.T) {
+	g := &Generator{extractVars: true, prefix: "prod_"}
+	var sb strings.Builder
+	g.generateHCL(&sb, extractVariablesData())
+
+	if !strings.Contains(strings.Join(strings.Fields(sb.String()), " "), "regions = var.prod_monitor_regions") {
+		t.Errorf("expected prefixed variable reference\nGot: %s", sb.String())
+	}
+	if !strings.Contains(g.VariablesHCL(), `variable "prod_monitor_regions"`) {
+		t.Errorf("expected prefixed variable\nGot: %s", g.VariablesHCL())
+	}
+}
+
+func TestExtractVariables_Disabled(t *testing.T) {
+	g := &Generator{}
+	var sb strings.Builder
+	g.generateHCL(&sb, extractVariablesData())
+
+	if strings.Contains(sb.String(), "var.") {
+		t.Errorf("unexpected variable reference without --extract-variables\nGot: %s", sb.String())
+	}
+	if got := g.VariablesHCL(); got != "" {
+		t.Errorf("VariablesHCL() = %q, want empty", got)
+	}
+}
+
+func TestExtractVariables_MultipleSharedValues(t *testing.T) {
+	data := extractVariablesData()
+	for i := range data.Monitors[:2] {
+		data.Monitors[i].Regions = []string{"frankfurt"}
+		data.Monitors[i].EscalationPolicy = &hyperping.EscalationPolicyRef{UUID: "ep_eu"}
+	}
+	data.Monitors = append(data.Monitors, hyperping.Monitor{
+		Name: "Admin", URL: "https://admin.example.com", Protocol: "http",
+		CheckFrequency: 60, Regions: []string{"london", "virginia"},
+		EscalationPolicy: &hyperping.EscalationPolicyRef{UUID: "ep_docs"},
+	})
+
+	g := &Generator{extractVars: true}
+	var sb strings.Builder
+	g.generateHCL(&sb, data)
+	variables := g.VariablesHCL()
+
+	for _, want := range []string{
+		`variable "monitor_regions_1"`,
+		`variable "monitor_regions_2"`,
+		`variable "escalation_policy_1"`,
+		`variable "escalation_policy_2"`,
+	} {
+		if !strings.Contains(variables, want) {
+			t.Errorf("missing %s\nGot: %s", want, variables)
+		}
+	}
+}
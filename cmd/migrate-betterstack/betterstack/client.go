@@ -8,12 +8,20 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"strconv"
 	"time"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 const (
-	defaultBaseURL = "https://betteruptime.com/api/v2"
-	defaultTimeout = 30 * time.Second
+	defaultBaseURL    = "https://betteruptime.com/api/v2"
+	defaultTimeout    = 30 * time.Second
+	defaultPerPage    = 100
+	defaultMaxRetries = 5
+
+	// maxBackoff caps the wait used when a 429 response has no Retry-After header.
+	maxBackoff = 60 * time.Second
 )
 
 // Client is a Better Stack API hyperping.
@@ -21,17 +29,72 @@ type Client struct {
 	baseURL    string
 	apiToken   string
 	httpClient *http.Client
+	maxRetries int
+	progress   ProgressFunc
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+// Progress describes a step of a paginated fetch.
+type Progress struct {
+	Resource string        // "monitors" or "heartbeats"
+	Page     int           // 1-based page number
+	Fetched  int           // total items fetched so far
+	Wait     time.Duration // non-zero when the request was rate limited and will be retried
+	Attempt  int           // retry attempt number when Wait is set
+}
+
+// ProgressFunc receives progress updates while fetching paginated resources.
+type ProgressFunc func(Progress)
+
+// Option is a functional option for configuring the Client.
+type Option func(*Client)
+
+// WithBaseURL sets the base URL for the Better Stack API.
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.baseURL = url
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// WithMaxRetries sets how many times a rate limited request is retried.
+func WithMaxRetries(n int) Option {
+	return func(c *Client) {
+		c.maxRetries = n
+	}
+}
+
+// WithProgress registers a callback invoked after each page and before each
+// rate limit wait.
+func WithProgress(fn ProgressFunc) Option {
+	return func(c *Client) {
+		c.progress = fn
+	}
 }
 
 // NewClient creates a new Better Stack API hyperping.
-func NewClient(apiToken string) *Client {
-	return &Client{
+func NewClient(apiToken string, options ...Option) *Client {
+	c := &Client{
 		baseURL:  defaultBaseURL,
 		apiToken: apiToken,
 		httpClient: &http.Client{
 			Timeout: defaultTimeout,
 		},
+		maxRetries: defaultMaxRetries,
+		sleep:      sleepContext,
 	}
+
+	for _, opt := range options {
+		opt(c)
+	}
+
+	return c
 }
 
 // Monitor represents a Better Stack monitor.
@@ -57,6 +120,7 @@ type MonitorAttributes struct {
 	MonitorGroupID      int             `json:"monitor_group_id"`
 	Regions             []string        `json:"regions"`
 	Port                int             `json:"port,omitempty"`
+	Tags                migrate.Tags    `json:"tags,omitempty"`
 }
 
 // RequestHeader represents an HTTP request header.
@@ -74,10 +138,11 @@ type Heartbeat struct {
 
 // HeartbeatAttributes contains heartbeat configuration.
 type HeartbeatAttributes struct {
-	Name   string `json:"name"`
-	Period int    `json:"period"`
-	Grace  int    `json:"grace"`
-	Paused bool   `json:"paused"`
+	Name   string       `json:"name"`
+	Period int          `json:"period"`
+	Grace  int          `json:"grace"`
+	Paused bool         `json:"paused"`
+	Tags   migrate.Tags `json:"tags,omitempty"`
 }
 
 // MonitorsResponse is the API response for listing monitors.
@@ -100,60 +165,59 @@ type Pagination struct {
 	Next  string `json:"next"`
 }
 
-// FetchMonitors retrieves all monitors from Better Stack.
+// FetchMonitors retrieves all monitors from Better Stack, following
+// pagination links until the last page.
 func (c *Client) FetchMonitors(ctx context.Context) ([]Monitor, error) {
-	var allMonitors []Monitor
-	page := 1
+	return fetchAll[Monitor](ctx, c, "monitors")
+}
 
-	for {
-		url := fmt.Sprintf("%s/monitors?page=%d&per_page=100", c.baseURL, page)
-		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
-		if err != nil {
-			return nil, fmt.Errorf("creating request: %w", err)
-		}
+// FetchHeartbeats retrieves all heartbeats from Better Stack, following
+// pagination links until the last page.
+func (c *Client) FetchHeartbeats(ctx context.Context) ([]Heartbeat, error) {
+	return fetchAll[Heartbeat](ctx, c, "heartbeats")
+}
 
-		req.Header.Set("Authorization", "Bearer "+c.apiToken)
-		req.Header.Set("Accept", "application/json")
+// listResponse is the common shape of Better Stack list responses.
+type listResponse[T any] struct {
+	Data       []T        `json:"data"`
+	Pagination Pagination `json:"pagination"`
+}
 
-		resp, err := c.httpClient.Do(req) //nolint:gosec // G704: baseURL is operator-configured, not user-tainted input
-		if err != nil {
-			return nil, fmt.Errorf("executing request: %w", err)
-		}
+// fetchAll walks every page of a list endpoint. The next page is taken from
+// pagination.next rather than computed, so pages are not skipped if the API
+// changes the page size.
+func fetchAll[T any](ctx context.Context, c *Client, resource string) ([]T, error) {
+	var all []T
+	next := fmt.Sprintf("%s/%s?per_page=%d", c.baseURL, resource, defaultPerPage)
+	seen := make(map[string]bool)
 
-		if resp.StatusCode != http.StatusOK {
-			_ = resp.Body.Close() //nolint:errcheck // #nosec G104 -- best-effort cleanup before returning error
-			return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+	for page := 1; next != ""; page++ {
+		if seen[next] {
+			return nil, fmt.Errorf("pagination loop detected fetching %s at %s", resource, next)
 		}
+		seen[next] = true
 
-		var result MonitorsResponse
-		err = json.NewDecoder(resp.Body).Decode(&result)
-		_ = resp.Body.Close() //nolint:errcheck // #nosec G104 -- body already consumed, close error not actionable
-		if err != nil {
-			return nil, fmt.Errorf("decoding response: %w", err)
+		var result listResponse[T]
+		if err := c.getJSON(ctx, resource, page, next, &result); err != nil {
+			return nil, err
 		}
 
-		allMonitors = append(allMonitors, result.Data...)
+		all = append(all, result.Data...)
+		c.report(Progress{Resource: resource, Page: page, Fetched: len(all)})
 
-		// Check if there are more pages
-		if result.Pagination.Next == "" {
-			break
-		}
-		page++
+		next = result.Pagination.Next
 	}
 
-	return allMonitors, nil
+	return all, nil
 }
 
-// FetchHeartbeats retrieves all heartbeats from Better Stack.
-func (c *Client) FetchHeartbeats(ctx context.Context) ([]Heartbeat, error) {
-	var allHeartbeats []Heartbeat
-	page := 1
-
-	for {
-		url := fmt.Sprintf("%s/heartbeats?page=%d&per_page=100", c.baseURL, page)
+// getJSON performs a GET request and decodes the JSON body, retrying on
+// HTTP 429 according to the Retry-After header.
+func (c *Client) getJSON(ctx context.Context, resource string, page int, url string, out any) error {
+	for attempt := 0; ; attempt++ {
 		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, http.NoBody)
 		if err != nil {
-			return nil, fmt.Errorf("creating request: %w", err)
+			return fmt.Errorf("creating request: %w", err)
 		}
 
 		req.Header.Set("Authorization", "Bearer "+c.apiToken)
@@ -161,29 +225,74 @@ func (c *Client) FetchHeartbeats(ctx context.Context) ([]Heartbeat, error) {
 
 		resp, err := c.httpClient.Do(req) //nolint:gosec // G704: baseURL is operator-configured, not user-tainted input
 		if err != nil {
-			return nil, fmt.Errorf("executing request: %w", err)
+			return fmt.Errorf("executing request: %w", err)
+		}
+
+		if resp.StatusCode == http.StatusTooManyRequests {
+			_ = resp.Body.Close() //nolint:errcheck // #nosec G104 -- response is discarded before retrying
+			if attempt >= c.maxRetries {
+				return fmt.Errorf("rate limited fetching %s page %d: giving up after %d retries", resource, page, c.maxRetries)
+			}
+
+			wait := retryAfter(resp.Header.Get("Retry-After"), attempt, time.Now())
+			c.report(Progress{Resource: resource, Page: page, Wait: wait, Attempt: attempt + 1})
+			if err := c.sleep(ctx, wait); err != nil {
+				return err
+			}
+			continue
 		}
 
 		if resp.StatusCode != http.StatusOK {
 			_ = resp.Body.Close() //nolint:errcheck // #nosec G104 -- best-effort cleanup before returning error
-			return nil, fmt.Errorf("unexpected status code: %d", resp.StatusCode)
+			return fmt.Errorf("unexpected status code: %d", resp.StatusCode)
 		}
 
-		var result HeartbeatsResponse
-		err = json.NewDecoder(resp.Body).Decode(&result)
+		err = json.NewDecoder(resp.Body).Decode(out)
 		_ = resp.Body.Close() //nolint:errcheck // #nosec G104 -- body already consumed, close error not actionable
 		if err != nil {
-			return nil, fmt.Errorf("decoding response: %w", err)
+			return fmt.Errorf("decoding response: %w", err)
 		}
+		return nil
+	}
+}
 
-		allHeartbeats = append(allHeartbeats, result.Data...)
+func (c *Client) report(p Progress) {
+	if c.progress != nil {
+		c.progress(p)
+	}
+}
 
-		// Check if there are more pages
-		if result.Pagination.Next == "" {
-			break
+// retryAfter returns how long to wait before retrying. It honours
+// Retry-After given in seconds or as an HTTP date, and otherwise falls back
+// to exponential backoff starting at one second.
+func retryAfter(header string, attempt int, now time.Time) time.Duration {
+	if header != "" {
+		if secs, err := strconv.Atoi(header); err == nil && secs >= 0 {
+			return time.Duration(secs) * time.Second
 		}
-		page++
+		if t, err := http.ParseTime(header); err == nil {
+			if d := t.Sub(now); d > 0 {
+				return d
+			}
+			return 0
+		}
+	}
+
+	backoff := time.Second << attempt
+	if backoff <= 0 || backoff > maxBackoff {
+		return maxBackoff
 	}
+	return backoff
+}
+
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
 
-	return allHeartbeats, nil
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
 }
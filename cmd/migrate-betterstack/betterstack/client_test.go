@@ -0,0 +1,192 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package betterstack
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+// noSleep records requested waits without blocking.
+func noSleep(waits *[]time.Duration) func(context.Context, time.Duration) error {
+	return func(_ context.Context, d time.Duration) error {
+		*waits = append(*waits, d)
+		return nil
+	}
+}
+
+func TestFetchMonitors_FollowsPagination(t *testing.T) {
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("Authorization = %q", got)
+		}
+		if r.URL.Path != "/monitors" {
+			t.Errorf("path = %s, want /monitors", r.URL.Path)
+		}
+
+		switch r.URL.Query().Get("page") {
+		case "":
+			fmt.Fprintf(w, `{"data":[{"id":"1"},{"id":"2"}],"pagination":{"next":"%s/monitors?page=2&per_page=100"}}`, srvURL)
+		case "2":
+			fmt.Fprintf(w, `{"data":[{"id":"3"}],"pagination":{"next":"%s/monitors?page=3&per_page=100"}}`, srvURL)
+		case "3":
+			fmt.Fprint(w, `{"data":[{"id":"4"}],"pagination":{"next":null}}`)
+		default:
+			t.Errorf("unexpected page %q", r.URL.Query().Get("page"))
+		}
+	}))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	var progress []Progress
+	c := NewClient("token", WithBaseURL(srv.URL), WithProgress(func(p Progress) {
+		progress = append(progress, p)
+	}))
+
+	monitors, err := c.FetchMonitors(context.Background())
+	if err != nil {
+		t.Fatalf("FetchMonitors() error = %v", err)
+	}
+	if len(monitors) != 4 {
+		t.Fatalf("got %d monitors, want 4", len(monitors))
+	}
+	if monitors[3].ID != "4" {
+		t.Errorf("last monitor ID = %q, want 4", monitors[3].ID)
+	}
+
+	if len(progress) != 3 {
+		t.Fatalf("got %d progress updates, want 3", len(progress))
+	}
+	last := progress[2]
+	if last.Resource != "monitors" || last.Page != 3 || last.Fetched != 4 || last.Wait != 0 {
+		t.Errorf("unexpected final progress: %+v", last)
+	}
+}
+
+func TestFetchHeartbeats_RetriesOnRateLimit(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		if calls == 1 {
+			w.Header().Set("Retry-After", "7")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		fmt.Fprint(w, `{"data":[{"id":"hb1"}],"pagination":{}}`)
+	}))
+	defer srv.Close()
+
+	var waits []time.Duration
+	var progress []Progress
+	c := NewClient("token", WithBaseURL(srv.URL), WithProgress(func(p Progress) {
+		progress = append(progress, p)
+	}))
+	c.sleep = noSleep(&waits)
+
+	heartbeats, err := c.FetchHeartbeats(context.Background())
+	if err != nil {
+		t.Fatalf("FetchHeartbeats() error = %v", err)
+	}
+	if len(heartbeats) != 1 {
+		t.Fatalf("got %d heartbeats, want 1", len(heartbeats))
+	}
+	if len(waits) != 1 || waits[0] != 7*time.Second {
+		t.Errorf("waits = %v, want [7s]", waits)
+	}
+	if len(progress) != 2 || progress[0].Wait != 7*time.Second || progress[0].Attempt != 1 {
+		t.Errorf("unexpected progress: %+v", progress)
+	}
+}
+
+func TestFetchMonitors_GivesUpAfterMaxRetries(t *testing.T) {
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer srv.Close()
+
+	var waits []time.Duration
+	c := NewClient("token", WithBaseURL(srv.URL), WithMaxRetries(2))
+	c.sleep = noSleep(&waits)
+
+	_, err := c.FetchMonitors(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "giving up after 2 retries") {
+		t.Fatalf("expected rate limit error, got %v", err)
+	}
+	if calls != 3 {
+		t.Errorf("calls = %d, want 3", calls)
+	}
+	if len(waits) != 2 || waits[0] != time.Second || waits[1] != 2*time.Second {
+		t.Errorf("waits = %v, want [1s 2s]", waits)
+	}
+}
+
+func TestFetchMonitors_PaginationLoop(t *testing.T) {
+	var srvURL string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, `{"data":[{"id":"1"}],"pagination":{"next":"%s/monitors?page=2"}}`, srvURL)
+	}))
+	defer srv.Close()
+	srvURL = srv.URL
+
+	_, err := NewClient("token", WithBaseURL(srv.URL)).FetchMonitors(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "pagination loop") {
+		t.Fatalf("expected pagination loop error, got %v", err)
+	}
+}
+
+func TestFetchMonitors_UnexpectedStatus(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+	}))
+	defer srv.Close()
+
+	_, err := NewClient("token", WithBaseURL(srv.URL)).FetchMonitors(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "401") {
+		t.Fatalf("expected status code error, got %v", err)
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	now := time.Date(2026, 1, 1, 12, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name    string
+		header  string
+		attempt int
+		want    time.Duration
+	}{
+		{"seconds", "30", 0, 30 * time.Second},
+		{"http date", now.Add(90 * time.Second).Format(http.TimeFormat), 0, 90 * time.Second},
+		{"http date in the past", now.Add(-time.Minute).Format(http.TimeFormat), 0, 0},
+		{"missing header first attempt", "", 0, time.Second},
+		{"missing header backoff", "", 3, 8 * time.Second},
+		{"backoff capped", "", 10, maxBackoff},
+		{"invalid header falls back", "soon", 1, 2 * time.Second},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := retryAfter(tt.header, tt.attempt, now); got != tt.want {
+				t.Errorf("retryAfter(%q, %d) = %v, want %v", tt.header, tt.attempt, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSleepContext_Cancelled(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := sleepContext(ctx, time.Hour); err == nil {
+		t.Error("expected context error")
+	}
+}
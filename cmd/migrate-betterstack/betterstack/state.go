@@ -0,0 +1,74 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package betterstack
+
+import (
+	"github.com/develeap/terraform-provider-hyperping/pkg/tfstate"
+)
+
+// Resource types of the Better Stack Terraform provider (BetterStackHQ/better-uptime).
+const (
+	MonitorResourceType   = "betteruptime_monitor"
+	HeartbeatResourceType = "betteruptime_heartbeat"
+)
+
+// FromState returns the monitors and heartbeats managed in a Terraform state,
+// in the shape the API returns them, together with the state instances they
+// were read from.
+func FromState(s *tfstate.State) ([]Monitor, []Heartbeat, []tfstate.Instance) {
+	monitorInstances := s.Instances(MonitorResourceType)
+	heartbeatInstances := s.Instances(HeartbeatResourceType)
+
+	monitors := make([]Monitor, 0, len(monitorInstances))
+	for _, inst := range monitorInstances {
+		monitors = append(monitors, monitorFromState(inst))
+	}
+
+	heartbeats := make([]Heartbeat, 0, len(heartbeatInstances))
+	for _, inst := range heartbeatInstances {
+		heartbeats = append(heartbeats, Heartbeat{
+			ID:   inst.ID(),
+			Type: "heartbeat",
+			Attributes: HeartbeatAttributes{
+				Name:   inst.String("name"),
+				Period: inst.Int("period"),
+				Grace:  inst.Int("grace"),
+				Paused: inst.Bool("paused"),
+			},
+		})
+	}
+
+	return monitors, heartbeats, append(monitorInstances, heartbeatInstances...)
+}
+
+// monitorFromState maps betteruptime_monitor attributes to a Monitor. The
+// provider names the request method http_method and stores the port as a
+// string.
+func monitorFromState(inst tfstate.Instance) Monitor {
+	var headers []RequestHeader
+	for _, h := range inst.Objects("request_headers") {
+		headers = append(headers, RequestHeader{Name: h.String("name"), Value: h.String("value")})
+	}
+
+	return Monitor{
+		ID:   inst.ID(),
+		Type: "monitor",
+		Attributes: MonitorAttributes{
+			PronouncableName:    inst.String("pronounceable_name"),
+			URL:                 inst.String("url"),
+			MonitorType:         inst.String("monitor_type"),
+			CheckFrequency:      inst.Int("check_frequency"),
+			RequestTimeout:      inst.Int("request_timeout"),
+			RequestMethod:       inst.String("http_method"),
+			RequestHeaders:      headers,
+			RequestBody:         inst.String("request_body"),
+			ExpectedStatusCodes: inst.Ints("expected_status_codes"),
+			FollowRedirects:     inst.Bool("follow_redirects"),
+			Paused:              inst.Bool("paused"),
+			MonitorGroupID:      inst.Int("monitor_group_id"),
+			Regions:             inst.Strings("regions"),
+			Port:                inst.Int("port"),
+		},
+	}
+}
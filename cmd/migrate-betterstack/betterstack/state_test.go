@@ -0,0 +1,79 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package betterstack
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/tfstate"
+)
+
+func TestFromState(t *testing.T) {
+	state, err := tfstate.Parse([]byte(`{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed", "type": "betteruptime_monitor", "name": "api",
+      "instances": [{"attributes": {
+        "id": "101", "url": "https://api.example.com/health", "monitor_type": "status",
+        "pronounceable_name": "API", "check_frequency": 60, "request_timeout": 15,
+        "http_method": "post", "request_headers": [{"id": "1", "name": "X-Env", "value": "prod"}],
+        "expected_status_codes": [200], "follow_redirects": true, "regions": ["us"], "port": "443"
+      }}]
+    },
+    {
+      "mode": "managed", "type": "betteruptime_heartbeat", "name": "backup",
+      "instances": [{"attributes": {"id": "201", "name": "Nightly backup", "period": 86400, "grace": 3600, "paused": true}}]
+    },
+    {
+      "mode": "managed", "type": "betteruptime_status_page", "name": "public",
+      "instances": [{"attributes": {"id": "301"}}]
+    }
+  ]
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	monitors, heartbeats, instances := FromState(state)
+
+	wantMonitor := Monitor{
+		ID:   "101",
+		Type: "monitor",
+		Attributes: MonitorAttributes{
+			PronouncableName:    "API",
+			URL:                 "https://api.example.com/health",
+			MonitorType:         "status",
+			CheckFrequency:      60,
+			RequestTimeout:      15,
+			RequestMethod:       "post",
+			RequestHeaders:      []RequestHeader{{Name: "X-Env", Value: "prod"}},
+			ExpectedStatusCodes: []int{200},
+			FollowRedirects:     true,
+			Regions:             []string{"us"},
+			Port:                443,
+		},
+	}
+	if len(monitors) != 1 || !reflect.DeepEqual(monitors[0], wantMonitor) {
+		t.Errorf("monitors = %+v, want %+v", monitors, wantMonitor)
+	}
+
+	wantHeartbeat := Heartbeat{
+		ID:         "201",
+		Type:       "heartbeat",
+		Attributes: HeartbeatAttributes{Name: "Nightly backup", Period: 86400, Grace: 3600, Paused: true},
+	}
+	if len(heartbeats) != 1 || !reflect.DeepEqual(heartbeats[0], wantHeartbeat) {
+		t.Errorf("heartbeats = %+v, want %+v", heartbeats, wantHeartbeat)
+	}
+
+	var addresses []string
+	for _, inst := range instances {
+		addresses = append(addresses, inst.Address)
+	}
+	if want := []string{"betteruptime_monitor.api", "betteruptime_heartbeat.backup"}; !reflect.DeepEqual(addresses, want) {
+		t.Errorf("instances = %v, want %v (status pages are not migrated)", addresses, want)
+	}
+}
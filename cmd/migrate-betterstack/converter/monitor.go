@@ -5,6 +5,7 @@ package converter
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/betterstack"
 	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
@@ -14,8 +15,12 @@ import (
 type Converter struct {
 	// frequencyMap provides BetterStack-specific overrides where the desired
 	// mapping differs from migrate.MapFrequency's nearest-match behavior.
-	frequencyMap map[int]int
-	protocolMap  map[string]string
+	frequencyMap    map[int]int
+	protocolMap     map[string]string
+	nameTemplate    *migrate.NameTemplate
+	overrides       *migrate.Overrides
+	frequencyPolicy migrate.FrequencyPolicy
+	regions         *migrate.RegionMapper
 }
 
 // New creates a new converter with default mappings.
@@ -32,12 +37,50 @@ func New() *Converter {
 			"keyword":   "http", // Keyword monitors become HTTP with notes
 			"heartbeat": "healthcheck",
 		},
+		frequencyPolicy: migrate.FrequencyNearest,
+		regions:         migrate.NewRegionMapper(nil),
 	}
 }
 
+// WithNameTemplate renders Hyperping names from the source name and tags
+// using tmpl. A nil template keeps source names unchanged.
+func (c *Converter) WithNameTemplate(tmpl *migrate.NameTemplate) *Converter {
+	c.nameTemplate = tmpl
+	return c
+}
+
+// WithOverrides applies the mapping overrides in o, keyed by Better Stack
+// monitor or heartbeat ID. Skipped resources are left out of the conversion.
+func (c *Converter) WithOverrides(o *migrate.Overrides) *Converter {
+	c.overrides = o
+	return c
+}
+
+// WithFrequencyPolicy sets how check frequencies and heartbeat periods that
+// Hyperping does not support are snapped. Under migrate.FrequencyFail such
+// resources are left out of the conversion with an error issue.
+func (c *Converter) WithFrequencyPolicy(p migrate.FrequencyPolicy) *Converter {
+	c.frequencyPolicy = p
+	return c
+}
+
+// WithRegionMap maps Better Stack regions with the mappings in rm ahead of
+// the built-in ones, and uses its defaults for monitors without regions.
+func (c *Converter) WithRegionMap(rm *migrate.RegionMap) *Converter {
+	c.regions.WithRegionMap(rm)
+	return c
+}
+
+// Skipped reports whether the mapping overrides skip the resource with the
+// given Better Stack ID.
+func (c *Converter) Skipped(id string) bool {
+	return c.overrides.Skipped(id)
+}
+
 // ConvertedMonitor represents a monitor converted to Hyperping format.
 type ConvertedMonitor struct {
 	ResourceName       string
+	SourceID           string
 	Name               string
 	URL                string
 	Protocol           string
@@ -50,7 +93,11 @@ type ConvertedMonitor struct {
 	FollowRedirects    bool
 	Paused             bool
 	Port               int
+	Tags               []string
 	Issues             []string
+	// FrequencyAdjustment is set when the Better Stack check frequency was
+	// snapped to a different Hyperping frequency.
+	FrequencyAdjustment *migrate.FrequencyAdjustment
 }
 
 // RequestHeader represents an HTTP request header.
@@ -62,11 +109,16 @@ type RequestHeader struct {
 // ConvertedHealthcheck represents a healthcheck converted to Hyperping format.
 type ConvertedHealthcheck struct {
 	ResourceName string
+	SourceID     string
 	Name         string
 	Period       int
 	Grace        int
 	Paused       bool
+	Tags         []string
 	Issues       []string
+	// FrequencyAdjustment is set when the heartbeat period was snapped to a
+	// different Hyperping period.
+	FrequencyAdjustment *migrate.FrequencyAdjustment
 }
 
 // ConversionIssue represents an issue encountered during conversion.
@@ -84,18 +136,27 @@ func (c *Converter) ConvertMonitors(monitors []betterstack.Monitor) ([]Converted
 	seen := make(map[string]int)
 
 	for _, m := range monitors {
+		if c.Skipped(m.ID) {
+			continue
+		}
 		cm, monitorIssues := c.convertMonitor(m)
+		issues = append(issues, monitorIssues...)
+		if hasError(monitorIssues) {
+			continue
+		}
 		cm.ResourceName = deduplicateResourceName(cm.ResourceName, seen)
 		converted = append(converted, cm)
-		issues = append(issues, monitorIssues...)
 	}
 
 	return converted, issues
 }
 
+// convertMonitor converts one monitor. A monitor that cannot be converted is
+// reported with an error issue.
 func (c *Converter) convertMonitor(m betterstack.Monitor) (ConvertedMonitor, []ConversionIssue) {
 	attrs := m.Attributes
 	resourceName := sanitizeResourceName(attrs.PronouncableName)
+	override, _ := c.overrides.Lookup(m.ID)
 	var issues []ConversionIssue
 
 	// Map protocol
@@ -121,26 +182,54 @@ func (c *Converter) convertMonitor(m betterstack.Monitor) (ConvertedMonitor, []C
 	}
 
 	// Map check frequency
-	frequency := c.mapFrequency(attrs.CheckFrequency)
-	if frequency != attrs.CheckFrequency {
-		issues = append(issues, ConversionIssue{
-			ResourceName: resourceName,
-			ResourceType: "monitor",
-			Severity:     "warning",
-			Message:      fmt.Sprintf("Check frequency %ds rounded to nearest supported value %ds", attrs.CheckFrequency, frequency),
-		})
+	frequency := override.Frequency
+	var adjustment *migrate.FrequencyAdjustment
+	if frequency == 0 {
+		var err error
+		frequency, err = c.snapFrequency(attrs.CheckFrequency)
+		if err != nil {
+			issues = append(issues, ConversionIssue{
+				ResourceName: resourceName,
+				ResourceType: "monitor",
+				Severity:     "error",
+				Message:      err.Error(),
+			})
+			return ConvertedMonitor{}, issues
+		}
+		if frequency != attrs.CheckFrequency {
+			adjustment = c.frequencyAdjustment(m.ID, attrs.PronouncableName, attrs.CheckFrequency, frequency)
+			issues = append(issues, ConversionIssue{
+				ResourceName: resourceName,
+				ResourceType: "monitor",
+				Severity:     "warning",
+				Message:      adjustment.String(),
+			})
+		}
 	}
 
 	// Map regions
-	regions := migrate.MapRegions(attrs.Regions)
-	if len(regions) == 0 {
-		regions = []string{"london", "virginia", "singapore"} // Default regions
-		issues = append(issues, ConversionIssue{
-			ResourceName: resourceName,
-			ResourceType: "monitor",
-			Severity:     "warning",
-			Message:      "No regions specified, using default regions: london, virginia, singapore",
-		})
+	mapping := c.regions.Map(attrs.Regions)
+	regions := mapping.Regions
+	if len(override.Regions) > 0 {
+		regions = override.Regions
+	} else {
+		for _, warning := range mapping.Warnings() {
+			issues = append(issues, ConversionIssue{
+				ResourceName: resourceName,
+				ResourceType: "monitor",
+				Severity:     "warning",
+				Message:      warning,
+			})
+		}
+		if len(regions) == 0 {
+			regions = c.regions.Defaults(migrate.DefaultRegions())
+			issues = append(issues, ConversionIssue{
+				ResourceName: resourceName,
+				ResourceType: "monitor",
+				Severity:     "warning",
+				Message:      "No regions specified, using default regions: " + strings.Join(regions, ", "),
+			})
+		}
 	}
 
 	// Convert headers
@@ -166,6 +255,11 @@ func (c *Converter) convertMonitor(m betterstack.Monitor) (ConvertedMonitor, []C
 		}
 	}
 
+	name, nameIssue := c.renderName(resourceName, "monitor", attrs.PronouncableName, attrs.Tags, override)
+	if nameIssue != nil {
+		issues = append(issues, *nameIssue)
+	}
+
 	// HTTP method
 	method := attrs.RequestMethod
 	if method == "" {
@@ -173,20 +267,23 @@ func (c *Converter) convertMonitor(m betterstack.Monitor) (ConvertedMonitor, []C
 	}
 
 	return ConvertedMonitor{
-		ResourceName:       resourceName,
-		Name:               attrs.PronouncableName,
-		URL:                attrs.URL,
-		Protocol:           protocol,
-		HTTPMethod:         method,
-		CheckFrequency:     frequency,
-		Regions:            regions,
-		RequestHeaders:     headers,
-		RequestBody:        attrs.RequestBody,
-		ExpectedStatusCode: expectedStatus,
-		FollowRedirects:    attrs.FollowRedirects,
-		Paused:             attrs.Paused,
-		Port:               attrs.Port,
-		Issues:             extractIssueMessages(issues),
+		ResourceName:        resourceName,
+		SourceID:            m.ID,
+		Name:                name,
+		URL:                 attrs.URL,
+		Protocol:            protocol,
+		HTTPMethod:          method,
+		CheckFrequency:      frequency,
+		Regions:             regions,
+		RequestHeaders:      headers,
+		RequestBody:         attrs.RequestBody,
+		ExpectedStatusCode:  expectedStatus,
+		FollowRedirects:     attrs.FollowRedirects,
+		Paused:              attrs.Paused,
+		Port:                attrs.Port,
+		Tags:                attrs.Tags,
+		Issues:              extractIssueMessages(issues),
+		FrequencyAdjustment: adjustment,
 	}, issues
 }
 
@@ -197,51 +294,112 @@ func (c *Converter) ConvertHeartbeats(heartbeats []betterstack.Heartbeat) ([]Con
 	seen := make(map[string]int)
 
 	for _, h := range heartbeats {
+		if c.Skipped(h.ID) {
+			continue
+		}
 		ch, heartbeatIssues := c.convertHeartbeat(h)
+		issues = append(issues, heartbeatIssues...)
+		if hasError(heartbeatIssues) {
+			continue
+		}
 		ch.ResourceName = deduplicateResourceName(ch.ResourceName, seen)
 		converted = append(converted, ch)
-		issues = append(issues, heartbeatIssues...)
 	}
 
 	return converted, issues
 }
 
+// convertHeartbeat converts one heartbeat. A heartbeat that cannot be
+// converted is reported with an error issue.
 func (c *Converter) convertHeartbeat(h betterstack.Heartbeat) (ConvertedHealthcheck, []ConversionIssue) {
 	attrs := h.Attributes
 	resourceName := sanitizeResourceName(attrs.Name)
+	override, _ := c.overrides.Lookup(h.ID)
 	var issues []ConversionIssue
 
 	// Map period to supported value
-	period := c.mapFrequency(attrs.Period)
-	if period != attrs.Period {
+	period := override.Frequency
+	var adjustment *migrate.FrequencyAdjustment
+	if period == 0 {
+		var err error
+		period, err = c.snapFrequency(attrs.Period)
+		if err != nil {
+			issues = append(issues, ConversionIssue{
+				ResourceName: resourceName,
+				ResourceType: "healthcheck",
+				Severity:     "error",
+				Message:      err.Error(),
+			})
+			return ConvertedHealthcheck{}, issues
+		}
+		if period != attrs.Period {
+			adjustment = c.frequencyAdjustment(h.ID, attrs.Name, attrs.Period, period)
+			issues = append(issues, ConversionIssue{
+				ResourceName: resourceName,
+				ResourceType: "healthcheck",
+				Severity:     "warning",
+				Message:      fmt.Sprintf("Period adjusted from %ds to %ds (--frequency-policy=%s)", attrs.Period, period, c.frequencyPolicy),
+			})
+		}
+	}
+
+	// Validate grace period
+	if attrs.Grace < 60 {
 		issues = append(issues, ConversionIssue{
 			ResourceName: resourceName,
 			ResourceType: "healthcheck",
 			Severity:     "warning",
-			Message:      fmt.Sprintf("Period %ds rounded to nearest supported value %ds", attrs.Period, period),
+			Message:      fmt.Sprintf("Grace period %ds is less than minimum 60s. Review and adjust manually.", attrs.Grace),
 		})
 	}
 
-	// Validate grace period
-	if attrs.Grace < 60 {
+	if len(override.Regions) > 0 {
 		issues = append(issues, ConversionIssue{
 			ResourceName: resourceName,
 			ResourceType: "healthcheck",
 			Severity:     "warning",
-			Message:      fmt.Sprintf("Grace period %ds is less than minimum 60s. Review and adjust manually.", attrs.Grace),
+			Message:      "Mapping override sets regions, but healthchecks have no regions. Ignoring them.",
 		})
 	}
 
+	name, nameIssue := c.renderName(resourceName, "healthcheck", attrs.Name, attrs.Tags, override)
+	if nameIssue != nil {
+		issues = append(issues, *nameIssue)
+	}
+
 	return ConvertedHealthcheck{
-		ResourceName: resourceName,
-		Name:         attrs.Name,
-		Period:       period,
-		Grace:        attrs.Grace,
-		Paused:       attrs.Paused,
-		Issues:       extractIssueMessages(issues),
+		ResourceName:        resourceName,
+		SourceID:            h.ID,
+		Name:                name,
+		Period:              period,
+		Grace:               attrs.Grace,
+		Paused:              attrs.Paused,
+		Tags:                attrs.Tags,
+		Issues:              extractIssueMessages(issues),
+		FrequencyAdjustment: adjustment,
 	}, issues
 }
 
+// renderName applies the name override, or else the name template. The
+// resource name is always derived from the source name so Terraform addresses
+// stay stable if the template or override changes. A template error keeps the
+// source name and is reported as a warning.
+func (c *Converter) renderName(resourceName, resourceType, sourceName string, tags []string, override migrate.Override) (string, *ConversionIssue) {
+	if override.Name != "" {
+		return override.Name, nil
+	}
+	name, err := c.nameTemplate.Render(sourceName, tags)
+	if err != nil {
+		return name, &ConversionIssue{
+			ResourceName: resourceName,
+			ResourceType: resourceType,
+			Severity:     "warning",
+			Message:      fmt.Sprintf("Name template failed, keeping source name: %v", err),
+		}
+	}
+	return name, nil
+}
+
 func (c *Converter) mapProtocol(bsType string) string {
 	if protocol, ok := c.protocolMap[bsType]; ok {
 		return protocol
@@ -250,7 +408,7 @@ func (c *Converter) mapProtocol(bsType string) string {
 }
 
 func (c *Converter) mapRegions(bsRegions []string) []string {
-	return migrate.MapRegions(bsRegions)
+	return c.regions.Map(bsRegions).Regions
 }
 
 func (c *Converter) mapFrequency(frequency int) int {
@@ -260,6 +418,25 @@ func (c *Converter) mapFrequency(frequency int) int {
 	return migrate.MapFrequency(frequency)
 }
 
+// snapFrequency maps a Better Stack interval under the frequency policy. The
+// Better Stack-specific frequencyMap only refines the nearest policy.
+func (c *Converter) snapFrequency(frequency int) (int, error) {
+	if c.frequencyPolicy == "" || c.frequencyPolicy == migrate.FrequencyNearest {
+		return c.mapFrequency(frequency), nil
+	}
+	return c.frequencyPolicy.Snap(frequency)
+}
+
+func (c *Converter) frequencyAdjustment(sourceID, name string, from, to int) *migrate.FrequencyAdjustment {
+	return &migrate.FrequencyAdjustment{
+		SourceID: sourceID,
+		Name:     name,
+		From:     from,
+		To:       to,
+		Policy:   c.frequencyPolicy,
+	}
+}
+
 func sanitizeResourceName(name string) string {
 	return migrate.SanitizeResourceName(name)
 }
@@ -268,6 +445,17 @@ func deduplicateResourceName(name string, seen map[string]int) string {
 	return migrate.DeduplicateResourceName(name, seen)
 }
 
+// hasError reports whether issues include an error, which leaves the
+// resource out of the conversion.
+func hasError(issues []ConversionIssue) bool {
+	for _, issue := range issues {
+		if issue.Severity == "error" {
+			return true
+		}
+	}
+	return false
+}
+
 func extractIssueMessages(issues []ConversionIssue) []string {
 	var messages []string
 	for _, issue := range issues {
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/betterstack"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 func TestConverter_MapProtocol(t *testing.T) {
@@ -198,6 +199,7 @@ func TestConverter_ConvertMonitor_HTTPMonitor(t *testing.T) {
 	converted, issues := c.convertMonitor(monitor)
 
 	assert.Equal(t, "api_health_check", converted.ResourceName)
+	assert.Equal(t, "mon-123", converted.SourceID)
 	assert.Equal(t, "API Health Check", converted.Name)
 	assert.Equal(t, "https://api.example.com/health", converted.URL)
 	assert.Equal(t, "http", converted.Protocol)
@@ -344,6 +346,7 @@ func TestConverter_ConvertHeartbeat(t *testing.T) {
 	converted, issues := c.convertHeartbeat(heartbeat)
 
 	assert.Equal(t, "daily_backup", converted.ResourceName)
+	assert.Equal(t, "hb-123", converted.SourceID)
 	assert.Equal(t, "Daily Backup", converted.Name)
 	assert.Equal(t, 86400, converted.Period)
 	assert.Equal(t, 300, converted.Grace)
@@ -351,6 +354,51 @@ func TestConverter_ConvertHeartbeat(t *testing.T) {
 	assert.Empty(t, issues)
 }
 
+func TestConverter_NameTemplate(t *testing.T) {
+	tmpl, err := migrate.ParseNameTemplate(`[{{.Tag "env" | upper}}] {{.Name}}`)
+	require.NoError(t, err)
+	c := New().WithNameTemplate(tmpl)
+
+	monitor, issues := c.convertMonitor(betterstack.Monitor{
+		ID: "mon-1",
+		Attributes: betterstack.MonitorAttributes{
+			PronouncableName: "API Health",
+			URL:              "https://api.example.com/health",
+			MonitorType:      "status",
+			CheckFrequency:   60,
+			Regions:          []string{"us-east-1"},
+			Tags:             migrate.Tags{"env:prod", "team:core"},
+		},
+	})
+	assert.Empty(t, issues)
+	assert.Equal(t, "[PROD] API Health", monitor.Name)
+	assert.Equal(t, "api_health", monitor.ResourceName)
+	assert.Equal(t, []string{"env:prod", "team:core"}, monitor.Tags)
+
+	healthcheck, issues := c.convertHeartbeat(betterstack.Heartbeat{
+		ID:         "hb-1",
+		Attributes: betterstack.HeartbeatAttributes{Name: "Nightly Backup", Period: 86400, Grace: 300, Tags: migrate.Tags{"env:staging"}},
+	})
+	assert.Empty(t, issues)
+	assert.Equal(t, "[STAGING] Nightly Backup", healthcheck.Name)
+	assert.Equal(t, "nightly_backup", healthcheck.ResourceName)
+}
+
+func TestConverter_NameTemplate_Error(t *testing.T) {
+	tmpl, err := migrate.ParseNameTemplate(`{{.Owner}} {{.Name}}`)
+	require.NoError(t, err)
+	c := New().WithNameTemplate(tmpl)
+
+	heartbeat, issues := c.convertHeartbeat(betterstack.Heartbeat{
+		ID:         "hb-1",
+		Attributes: betterstack.HeartbeatAttributes{Name: "Nightly Backup", Period: 86400, Grace: 300},
+	})
+	assert.Equal(t, "Nightly Backup", heartbeat.Name)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "warning", issues[0].Severity)
+	assert.Contains(t, issues[0].Message, "Name template failed")
+}
+
 func TestConverter_ConvertHeartbeat_LowGrace(t *testing.T) {
 	c := New()
 	heartbeat := betterstack.Heartbeat{
@@ -448,3 +496,98 @@ func TestConverter_ConvertHeartbeats_Batch(t *testing.T) {
 	assert.Equal(t, "heartbeat_2", converted[1].ResourceName)
 	assert.Empty(t, issues)
 }
+
+func TestConverter_Overrides(t *testing.T) {
+	overrides, err := migrate.ParseOverrides([]byte(`
+overrides:
+  mon-1:
+    name: Checkout API
+    regions: [frankfurt]
+    frequency: 120
+  mon-2:
+    skip: true
+  hb-1:
+    frequency: 3600
+    regions: [london]
+`))
+	require.NoError(t, err)
+	tmpl, err := migrate.ParseNameTemplate(`[{{.Tag "env" | upper}}] {{.Name}}`)
+	require.NoError(t, err)
+	c := New().WithNameTemplate(tmpl).WithOverrides(overrides)
+
+	monitors, issues := c.ConvertMonitors([]betterstack.Monitor{
+		{ID: "mon-1", Attributes: betterstack.MonitorAttributes{PronouncableName: "API Health", MonitorType: "status", CheckFrequency: 45, Tags: migrate.Tags{"env:prod"}}},
+		{ID: "mon-2", Attributes: betterstack.MonitorAttributes{PronouncableName: "Legacy", MonitorType: "status", CheckFrequency: 60}},
+	})
+	require.Len(t, monitors, 1)
+	assert.Empty(t, issues, "overridden frequency and regions need no rounding or default-region warnings")
+	assert.Equal(t, "Checkout API", monitors[0].Name, "override name takes precedence over the template")
+	assert.Equal(t, "api_health", monitors[0].ResourceName)
+	assert.Equal(t, []string{"frankfurt"}, monitors[0].Regions)
+	assert.Equal(t, 120, monitors[0].CheckFrequency)
+	assert.True(t, c.Skipped("mon-2"))
+
+	healthchecks, issues := c.ConvertHeartbeats([]betterstack.Heartbeat{
+		{ID: "hb-1", Attributes: betterstack.HeartbeatAttributes{Name: "Nightly Backup", Period: 86400, Grace: 300}},
+	})
+	require.Len(t, healthchecks, 1)
+	assert.Equal(t, 3600, healthchecks[0].Period)
+	require.Len(t, issues, 1)
+	assert.Contains(t, issues[0].Message, "healthchecks have no regions")
+}
+
+func TestConverter_FrequencyPolicy(t *testing.T) {
+	monitors := []betterstack.Monitor{
+		{ID: "mon-1", Attributes: betterstack.MonitorAttributes{PronouncableName: "API", MonitorType: "status", CheckFrequency: 45, Regions: []string{"eu"}}},
+		{ID: "mon-2", Attributes: betterstack.MonitorAttributes{PronouncableName: "Web", MonitorType: "status", CheckFrequency: 60, Regions: []string{"eu"}}},
+	}
+
+	c := New().WithFrequencyPolicy(migrate.FrequencyRoundDown)
+	converted, issues := c.ConvertMonitors(monitors)
+	require.Len(t, converted, 2)
+	assert.Equal(t, 30, converted[0].CheckFrequency)
+	require.NotNil(t, converted[0].FrequencyAdjustment)
+	assert.Equal(t, migrate.FrequencyAdjustment{SourceID: "mon-1", Name: "API", From: 45, To: 30, Policy: migrate.FrequencyRoundDown}, *converted[0].FrequencyAdjustment)
+	assert.Nil(t, converted[1].FrequencyAdjustment)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "warning", issues[0].Severity)
+
+	c = New().WithFrequencyPolicy(migrate.FrequencyFail)
+	converted, issues = c.ConvertMonitors(monitors)
+	require.Len(t, converted, 1)
+	assert.Equal(t, "mon-2", converted[0].SourceID)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "error", issues[0].Severity)
+	assert.Contains(t, issues[0].Message, "check frequency 45s is not supported")
+
+	healthchecks, issues := c.ConvertHeartbeats([]betterstack.Heartbeat{
+		{ID: "hb-1", Attributes: betterstack.HeartbeatAttributes{Name: "Backup", Period: 7200, Grace: 300}},
+	})
+	assert.Empty(t, healthchecks)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "error", issues[0].Severity)
+}
+
+func TestConverter_RegionMap(t *testing.T) {
+	regionMap, err := migrate.ParseRegionMap([]byte(`
+regions:
+  eu: [amsterdam, paris]
+default: [toronto]
+`))
+	require.NoError(t, err)
+	c := New().WithRegionMap(regionMap)
+
+	monitors, issues := c.ConvertMonitors([]betterstack.Monitor{
+		{ID: "mon-1", Attributes: betterstack.MonitorAttributes{PronouncableName: "API", MonitorType: "status", CheckFrequency: 60, Regions: []string{"eu", "as"}}},
+		{ID: "mon-2", Attributes: betterstack.MonitorAttributes{PronouncableName: "Web", MonitorType: "status", CheckFrequency: 60, Regions: []string{"eu-north-1"}}},
+		{ID: "mon-3", Attributes: betterstack.MonitorAttributes{PronouncableName: "Docs", MonitorType: "status", CheckFrequency: 60}},
+	})
+	require.Len(t, monitors, 3)
+	assert.Equal(t, []string{"amsterdam", "paris", "singapore"}, monitors[0].Regions)
+	assert.Equal(t, []string{"amsterdam"}, monitors[1].Regions, "eu-north-1 maps to the nearest region")
+	assert.Equal(t, []string{"toronto"}, monitors[2].Regions, "monitors without regions get the region map defaults")
+
+	require.Len(t, issues, 2)
+	assert.Contains(t, issues[0].Message, `mapped to nearest region "amsterdam"`)
+	assert.Equal(t, "No regions specified, using default regions: toronto", issues[1].Message)
+}
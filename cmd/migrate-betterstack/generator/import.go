@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/converter"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
 )
 
 // GenerateImportScript generates a bash script for importing resources.
@@ -110,6 +111,30 @@ func (g *Generator) GenerateImportScript(monitors []converter.ConvertedMonitor,
 	return sb.String()
 }
 
+// MappingEntries returns a mapping entry for every converted monitor and
+// healthcheck. UUIDs are left empty: the resources are created by terraform
+// apply, and --verify records them.
+func (g *Generator) MappingEntries(monitors []converter.ConvertedMonitor, healthchecks []converter.ConvertedHealthcheck) []mapping.Entry {
+	entries := make([]mapping.Entry, 0, len(monitors)+len(healthchecks))
+	for _, m := range monitors {
+		entries = append(entries, mapping.Entry{
+			SourceID:     m.SourceID,
+			ResourceType: mapping.ResourceTypeMonitor,
+			Name:         m.Name,
+			Address:      mapping.ResourceTypeMonitor + "." + m.ResourceName,
+		})
+	}
+	for _, h := range healthchecks {
+		entries = append(entries, mapping.Entry{
+			SourceID:     h.SourceID,
+			ResourceType: mapping.ResourceTypeHealthcheck,
+			Name:         h.Name,
+			Address:      mapping.ResourceTypeHealthcheck + "." + h.ResourceName,
+		})
+	}
+	return entries
+}
+
 // GenerateManualSteps generates documentation for manual migration steps.
 func (g *Generator) GenerateManualSteps(monitorIssues, healthcheckIssues []converter.ConversionIssue) string {
 	var sb strings.Builder
@@ -8,175 +8,172 @@ import (
 	"strings"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/converter"
-	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
 )
 
 // Generator generates Terraform HCL and import scripts.
-type Generator struct{}
+type Generator struct {
+	compatMode compat.Mode
+}
 
 // New creates a new generator.
 func New() *Generator {
 	return &Generator{}
 }
 
+// WithCompatMode sets the compatibility mode. With compat.IgnoreChanges,
+// monitors get lifecycle ignore_changes for the attributes the API does not
+// return faithfully.
+func (g *Generator) WithCompatMode(mode compat.Mode) *Generator {
+	g.compatMode = mode
+	return g
+}
+
 // GenerateTerraform generates Terraform HCL configuration.
 func (g *Generator) GenerateTerraform(monitors []converter.ConvertedMonitor, healthchecks []converter.ConvertedHealthcheck) string {
-	var sb strings.Builder
-
-	sb.WriteString("# Auto-generated from Better Stack migration\n")
-	sb.WriteString("# Generated at: " + getCurrentTimestamp() + "\n")
-	sb.WriteString("# Review and customize before applying\n\n")
-
-	sb.WriteString("terraform {\n")
-	sb.WriteString("  required_version = \">= 1.8\"\n\n")
-	sb.WriteString("  required_providers {\n")
-	sb.WriteString("    hyperping = {\n")
-	sb.WriteString("      source  = \"develeap/hyperping\"\n")
-	sb.WriteString("      version = \"~> 1.0\"\n")
-	sb.WriteString("    }\n")
-	sb.WriteString("  }\n")
-	sb.WriteString("}\n\n")
-
-	sb.WriteString("provider \"hyperping\" {\n")
-	sb.WriteString("  # API key from HYPERPING_API_KEY environment variable\n")
-	sb.WriteString("}\n\n")
+	f := hclgen.NewFile()
+	root := f.Body()
+
+	root.Comment("Auto-generated from Better Stack migration")
+	root.Comment("Generated at: %s", getCurrentTimestamp())
+	root.Comment("Review and customize before applying")
+	root.Newline()
+
+	hclgen.AppendProviderConfig(root, ">= 1.8", "API key from HYPERPING_API_KEY environment variable")
+	root.Newline()
 
 	if len(monitors) > 0 {
-		sb.WriteString("# ===== MONITORS =====\n\n")
+		root.Comment("===== MONITORS =====")
+		root.Newline()
 		for _, m := range monitors {
-			sb.WriteString(g.generateMonitorBlock(m))
+			g.generateMonitorBlock(root, m)
 		}
 	}
 
 	if len(healthchecks) > 0 {
-		sb.WriteString("# ===== HEALTHCHECKS =====\n\n")
+		root.Comment("===== HEALTHCHECKS =====")
+		root.Newline()
 		for _, h := range healthchecks {
-			sb.WriteString(g.generateHealthcheckBlock(h))
+			g.generateHealthcheckBlock(root, h)
 		}
 	}
 
-	return sb.String()
+	return f.String()
 }
 
-// monitorOptionalField describes a single optional HCL field for a monitor block.
-type monitorOptionalField struct {
-	name  string
-	value string
-	skip  bool
+// writeSourceReference records the Better Stack resource a block was migrated
+// from. Hyperping monitors have no description field to hold it.
+func writeSourceReference(root *hclgen.Body, kind, id string) {
+	if id != "" {
+		root.Comment("Original Better Stack %s ID: %s", kind, id)
+	}
 }
 
-// buildMonitorOptionalFields collects all optional fields that should be emitted.
-func buildMonitorOptionalFields(m converter.ConvertedMonitor) []monitorOptionalField {
-	return []monitorOptionalField{
-		{name: "protocol", value: quoteString(m.Protocol), skip: m.Protocol == "http"},
-		{name: "http_method", value: quoteString(m.HTTPMethod), skip: m.HTTPMethod == "GET" || m.Protocol != "http"},
-		{name: "expected_status_code", value: quoteString(m.ExpectedStatusCode), skip: m.ExpectedStatusCode == "200"},
-		{name: "follow_redirects", value: "false", skip: m.FollowRedirects},
-		{name: "paused", value: "true", skip: !m.Paused},
-		{name: "port", value: fmt.Sprintf("%d", m.Port), skip: m.Port <= 0 || m.Protocol != "port"},
+// writeSourceTags records the Better Stack tags above a block. Hyperping
+// resources have no tag field, so the comment keeps them visible in review.
+func writeSourceTags(root *hclgen.Body, tags []string) {
+	if len(tags) > 0 {
+		root.Comment("Tags: %s", strings.Join(tags, ", "))
 	}
 }
 
-// writeMonitorMigrationNotes writes any migration issue comments at the top of a block.
-func writeMonitorMigrationNotes(sb *strings.Builder, issues []string) {
+// writeMigrationNotes writes any migration issue comments above a block.
+func writeMigrationNotes(root *hclgen.Body, issues []string) {
 	if len(issues) == 0 {
 		return
 	}
-	sb.WriteString("# MIGRATION NOTES:\n")
+	root.Comment("MIGRATION NOTES:")
 	for _, issue := range issues {
-		fmt.Fprintf(sb, "# - %s\n", issue)
+		root.Comment("- %s", issue)
 	}
 }
 
-// writeMonitorRegions writes the regions block if regions are present.
-func writeMonitorRegions(sb *strings.Builder, regions []string) {
-	if len(regions) == 0 {
-		return
+// setMonitorOptionalFields sets the optional monitor fields that differ from
+// their defaults.
+func setMonitorOptionalFields(r *hclgen.Body, m converter.ConvertedMonitor) {
+	if m.Protocol != "http" {
+		r.SetString("protocol", m.Protocol)
+	}
+	if m.Protocol == "http" && m.HTTPMethod != "GET" {
+		r.SetString("http_method", m.HTTPMethod)
+	}
+	if m.ExpectedStatusCode != "200" {
+		r.SetString("expected_status_code", m.ExpectedStatusCode)
+	}
+	if !m.FollowRedirects {
+		r.SetBool("follow_redirects", false)
 	}
-	sb.WriteString("\n  regions = [\n")
-	for _, region := range regions {
-		fmt.Fprintf(sb, "    %s,\n", quoteString(region))
+	if m.Paused {
+		r.SetBool("paused", true)
+	}
+	if m.Protocol == "port" && m.Port > 0 {
+		r.SetInt("port", m.Port)
 	}
-	sb.WriteString("  ]\n")
 }
 
-// writeMonitorRequestHeaders writes the request_headers block if headers are present.
-func writeMonitorRequestHeaders(sb *strings.Builder, headers []converter.RequestHeader) {
+// setMonitorRequestHeaders sets the request_headers list if headers are present.
+func setMonitorRequestHeaders(r *hclgen.Body, headers []converter.RequestHeader) {
 	if len(headers) == 0 {
 		return
 	}
-	sb.WriteString("\n  request_headers = [\n")
-	for _, header := range headers {
-		sb.WriteString("    {\n")
-		fmt.Fprintf(sb, "      name  = %s\n", quoteString(header.Name))
-		fmt.Fprintf(sb, "      value = %s\n", quoteString(header.Value))
-		sb.WriteString("    },\n")
+	objects := make([][]hclgen.Attr, len(headers))
+	for i, header := range headers {
+		objects[i] = []hclgen.Attr{{Name: "name", Value: header.Name}, {Name: "value", Value: header.Value}}
 	}
-	sb.WriteString("  ]\n")
+	r.Newline()
+	r.SetObjectList("request_headers", objects)
 }
 
-func (g *Generator) generateMonitorBlock(m converter.ConvertedMonitor) string {
-	var sb strings.Builder
-
-	writeMonitorMigrationNotes(&sb, m.Issues)
+func (g *Generator) generateMonitorBlock(root *hclgen.Body, m converter.ConvertedMonitor) {
+	writeSourceReference(root, "Monitor", m.SourceID)
+	writeSourceTags(root, m.Tags)
+	writeMigrationNotes(root, m.Issues)
 
-	fmt.Fprintf(&sb, "resource \"hyperping_monitor\" %q {\n", m.ResourceName)
-	fmt.Fprintf(&sb, "  name                 = %s\n", quoteString(m.Name))
-	fmt.Fprintf(&sb, "  url                  = %s\n", quoteString(m.URL))
+	r := root.Block("resource", "hyperping_monitor", m.ResourceName)
+	r.SetString("name", m.Name)
+	r.SetString("url", m.URL)
+	setMonitorOptionalFields(r, m)
+	r.SetInt("check_frequency", m.CheckFrequency)
 
-	for _, f := range buildMonitorOptionalFields(m) {
-		if !f.skip {
-			fmt.Fprintf(&sb, "  %-20s = %s\n", f.name, f.value)
-		}
+	if len(m.Regions) > 0 {
+		r.Newline()
+		r.SetStringList("regions", m.Regions)
 	}
 
-	fmt.Fprintf(&sb, "  check_frequency      = %d\n", m.CheckFrequency)
-
-	writeMonitorRegions(&sb, m.Regions)
-	writeMonitorRequestHeaders(&sb, m.RequestHeaders)
+	setMonitorRequestHeaders(r, m.RequestHeaders)
 
 	if m.RequestBody != "" {
-		fmt.Fprintf(&sb, "\n  request_body = %s\n", quoteString(m.RequestBody))
+		r.Newline()
+		r.SetString("request_body", m.RequestBody)
 	}
 
-	sb.WriteString("}\n\n")
-	return sb.String()
+	compat.WriteLifecycle(r, g.compatMode, compat.MonitorFields(r, m.Protocol))
+	root.Newline()
 }
 
-func (g *Generator) generateHealthcheckBlock(h converter.ConvertedHealthcheck) string {
-	var sb strings.Builder
-
-	if len(h.Issues) > 0 {
-		sb.WriteString("# MIGRATION NOTES:\n")
-		for _, issue := range h.Issues {
-			fmt.Fprintf(&sb, "# - %s\n", issue)
-		}
-	}
-
-	fmt.Fprintf(&sb, "resource \"hyperping_healthcheck\" %q {\n", h.ResourceName)
-	fmt.Fprintf(&sb, "  name               = %s\n", quoteString(h.Name))
+func (g *Generator) generateHealthcheckBlock(root *hclgen.Body, h converter.ConvertedHealthcheck) {
+	writeSourceReference(root, "Heartbeat", h.SourceID)
+	writeSourceTags(root, h.Tags)
+	writeMigrationNotes(root, h.Issues)
 
-	cronExpr := periodToCron(h.Period)
-	fmt.Fprintf(&sb, "  cron               = %s\n", quoteString(cronExpr))
-	sb.WriteString("  timezone           = \"UTC\"\n")
+	r := root.Block("resource", "hyperping_healthcheck", h.ResourceName)
+	r.SetString("name", h.Name)
+	r.SetString("cron", periodToCron(h.Period))
+	r.SetString("timezone", "UTC")
 
 	gracePeriodMinutes := h.Grace / 60
 	if gracePeriodMinutes < 1 {
 		gracePeriodMinutes = 1
 	}
-	fmt.Fprintf(&sb, "  grace_period_value = %d\n", gracePeriodMinutes)
-	sb.WriteString("  grace_period_type  = \"minutes\"\n")
+	r.SetInt("grace_period_value", gracePeriodMinutes)
+	r.SetString("grace_period_type", "minutes")
 
 	if h.Paused {
-		sb.WriteString("  paused             = true\n")
+		r.SetBool("paused", true)
 	}
 
-	sb.WriteString("}\n\n")
-	return sb.String()
-}
-
-func quoteString(s string) string {
-	return migrate.QuoteHCL(s)
+	root.Newline()
 }
 
 func periodToCron(periodSeconds int) string {
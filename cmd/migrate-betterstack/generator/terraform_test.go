@@ -4,14 +4,34 @@
 package generator
 
 import (
+	"regexp"
 	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/converter"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
 )
 
+// alignment matches the padding hclwrite inserts to align "=" signs.
+var alignment = regexp.MustCompile(`(\S) {2,}= `)
+
+// squashAlignment removes "=" alignment padding so assertions do not depend
+// on which attributes happen to share an alignment group.
+func squashAlignment(s string) string {
+	return alignment.ReplaceAllString(s, "$1 = ")
+}
+
+// renderBlock renders the content written by fn as a standalone file with
+// alignment padding removed.
+func renderBlock(fn func(*hclgen.Body)) string {
+	f := hclgen.NewFile()
+	fn(f.Body())
+	return squashAlignment(f.String())
+}
+
 func TestGenerator_GenerateTerraform(t *testing.T) {
 	g := New()
 
@@ -77,18 +97,18 @@ func TestGenerator_GenerateMonitorBlock(t *testing.T) {
 			},
 			contains: []string{
 				"resource \"hyperping_monitor\" \"test_monitor\"",
-				"name                 = \"Test Monitor\"",
-				"url                  = \"https://example.com\"",
-				"check_frequency      = 60",
+				"name = \"Test Monitor\"",
+				"url = \"https://example.com\"",
+				"check_frequency = 60",
 				"regions = [",
 				"\"london\"",
 			},
 			notContains: []string{
-				"protocol             =",       // Default, should be omitted
-				"http_method          =",       // GET is default
-				"expected_status_code =",       // 200 is default
-				"follow_redirects     = false", // true is default
-				"paused               =",       // false is default
+				"protocol =",               // Default, should be omitted
+				"http_method =",            // GET is default
+				"expected_status_code =",   // 200 is default
+				"follow_redirects = false", // true is default
+				"paused =",                 // false is default
 			},
 		},
 		{
@@ -103,8 +123,8 @@ func TestGenerator_GenerateMonitorBlock(t *testing.T) {
 				Port:           5432,
 			},
 			contains: []string{
-				"protocol             = \"port\"",
-				"port                 = 5432",
+				"protocol = \"port\"",
+				"port = 5432",
 			},
 			notContains: []string{
 				"http_method",
@@ -124,7 +144,7 @@ func TestGenerator_GenerateMonitorBlock(t *testing.T) {
 				ExpectedStatusCode: "201",
 			},
 			contains: []string{
-				"http_method          = \"POST\"",
+				"http_method = \"POST\"",
 				"expected_status_code = \"201\"",
 				"request_body = \"{\\\"test\\\": \\\"data\\\"}\"",
 			},
@@ -145,9 +165,9 @@ func TestGenerator_GenerateMonitorBlock(t *testing.T) {
 			},
 			contains: []string{
 				"request_headers = [",
-				"name  = \"Authorization\"",
+				"name = \"Authorization\"",
 				"value = \"Bearer token\"",
-				"name  = \"X-Custom\"",
+				"name = \"X-Custom\"",
 				"value = \"value\"",
 			},
 		},
@@ -163,7 +183,7 @@ func TestGenerator_GenerateMonitorBlock(t *testing.T) {
 				Paused:         true,
 			},
 			contains: []string{
-				"paused               = true",
+				"paused = true",
 			},
 		},
 		{
@@ -190,7 +210,7 @@ func TestGenerator_GenerateMonitorBlock(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := g.generateMonitorBlock(tt.monitor)
+			result := renderBlock(func(root *hclgen.Body) { g.generateMonitorBlock(root, tt.monitor) })
 
 			for _, s := range tt.contains {
 				assert.Contains(t, result, s, "expected to contain: %s", s)
@@ -222,11 +242,11 @@ func TestGenerator_GenerateHealthcheckBlock(t *testing.T) {
 			},
 			contains: []string{
 				"resource \"hyperping_healthcheck\" \"daily_backup\"",
-				"name               = \"Daily Backup\"",
-				"cron               = \"0 0 * * *\"",
-				"timezone           = \"UTC\"",
+				"name = \"Daily Backup\"",
+				"cron = \"0 0 * * *\"",
+				"timezone = \"UTC\"",
 				"grace_period_value = 5",
-				"grace_period_type  = \"minutes\"",
+				"grace_period_type = \"minutes\"",
 			},
 		},
 		{
@@ -239,7 +259,7 @@ func TestGenerator_GenerateHealthcheckBlock(t *testing.T) {
 				Paused:       false,
 			},
 			contains: []string{
-				"cron               = \"0 * * * *\"",
+				"cron = \"0 * * * *\"",
 				"grace_period_value = 10",
 			},
 		},
@@ -253,7 +273,7 @@ func TestGenerator_GenerateHealthcheckBlock(t *testing.T) {
 				Paused:       true,
 			},
 			contains: []string{
-				"paused             = true",
+				"paused = true",
 			},
 		},
 		{
@@ -276,7 +296,7 @@ func TestGenerator_GenerateHealthcheckBlock(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			result := g.generateHealthcheckBlock(tt.healthcheck)
+			result := renderBlock(func(root *hclgen.Body) { g.generateHealthcheckBlock(root, tt.healthcheck) })
 
 			for _, s := range tt.contains {
 				assert.Contains(t, result, s)
@@ -306,7 +326,7 @@ func TestPeriodToCron(t *testing.T) {
 	}
 }
 
-func TestQuoteString(t *testing.T) {
+func TestStringEscaping(t *testing.T) {
 	tests := []struct {
 		input    string
 		expected string
@@ -342,8 +362,8 @@ func TestQuoteString(t *testing.T) {
 	}
 
 	for _, tt := range tests {
-		result := quoteString(tt.input)
-		assert.Equal(t, tt.expected, result)
+		result := renderBlock(func(root *hclgen.Body) { root.SetString("name", tt.input) })
+		assert.Equal(t, "name = "+tt.expected+"\n", result)
 	}
 }
 
@@ -414,12 +434,12 @@ func TestGenerator_GenerateTerraform_ComplexExample(t *testing.T) {
 		},
 	}
 
-	result := g.GenerateTerraform(monitors, healthchecks)
+	result := squashAlignment(g.GenerateTerraform(monitors, healthchecks))
 
 	// Verify complex monitor
-	assert.Contains(t, result, "http_method          = \"POST\"")
+	assert.Contains(t, result, "http_method = \"POST\"")
 	assert.Contains(t, result, "expected_status_code = \"201\"")
-	assert.Contains(t, result, "follow_redirects     = false")
+	assert.Contains(t, result, "follow_redirects = false")
 	assert.Contains(t, result, "Authorization")
 	assert.Contains(t, result, "request_body")
 
@@ -439,13 +459,52 @@ func TestGenerator_GenerateMonitorBlock_EscapedValues(t *testing.T) {
 		Regions:        []string{"london"},
 	}
 
-	result := g.generateMonitorBlock(monitor)
+	result := renderBlock(func(root *hclgen.Body) { g.generateMonitorBlock(root, monitor) })
 
 	// Check escaping
 	assert.Contains(t, result, "\\\"quotes\\\"")
 	assert.Contains(t, result, "\\\\backslashes")
 }
 
+func TestGenerator_SourceTagsComment(t *testing.T) {
+	g := New()
+
+	monitor := converter.ConvertedMonitor{
+		ResourceName:   "tagged",
+		Name:           "Tagged",
+		URL:            "https://example.com",
+		Protocol:       "http",
+		CheckFrequency: 60,
+		Tags:           []string{"env:prod", "web"},
+	}
+	result := renderBlock(func(root *hclgen.Body) { g.generateMonitorBlock(root, monitor) })
+	assert.Contains(t, result, "# Tags: env:prod, web\n")
+
+	healthcheck := converter.ConvertedHealthcheck{ResourceName: "untagged", Name: "Untagged", Period: 3600, Grace: 60}
+	result = renderBlock(func(root *hclgen.Body) { g.generateHealthcheckBlock(root, healthcheck) })
+	assert.NotContains(t, result, "Tags:")
+}
+
+func TestGenerator_CompatMode(t *testing.T) {
+	monitor := converter.ConvertedMonitor{
+		ResourceName:       "db",
+		Name:               "DB",
+		URL:                "db.example.com",
+		Protocol:           "port",
+		Port:               5432,
+		CheckFrequency:     60,
+		ExpectedStatusCode: "200",
+	}
+
+	result := renderBlock(func(root *hclgen.Body) { New().generateMonitorBlock(root, monitor) })
+	assert.NotContains(t, result, "lifecycle")
+
+	g := New().WithCompatMode(compat.IgnoreChanges)
+	result = renderBlock(func(root *hclgen.Body) { g.generateMonitorBlock(root, monitor) })
+	assert.Contains(t, result, "# follow_redirects: the API returns no value for non-HTTP monitors\n")
+	assert.Contains(t, result, "ignore_changes = [\n      follow_redirects,\n    ]")
+}
+
 func TestGenerator_GenerateTerraform_ValidHCL(t *testing.T) {
 	g := New()
 
@@ -467,3 +526,46 @@ func TestGenerator_GenerateTerraform_ValidHCL(t *testing.T) {
 	assert.NotContains(t, result, "  =  ", "should have proper spacing")
 	assert.Contains(t, result, "\n}\n", "resources should end with newline")
 }
+
+// TestGenerator_GenerateTerraform_Golden pins the full generated file so
+// formatting regressions are caught, not just individual attributes.
+func TestGenerator_GenerateTerraform_Golden(t *testing.T) {
+	g := New()
+
+	monitors := []converter.ConvertedMonitor{
+		{
+			ResourceName:       "api_health",
+			SourceID:           "123456",
+			Name:               "API Health",
+			URL:                "https://api.example.com/health",
+			Protocol:           "http",
+			HTTPMethod:         "POST",
+			CheckFrequency:     300,
+			Regions:            []string{"london", "virginia"},
+			ExpectedStatusCode: "201",
+			FollowRedirects:    true,
+			RequestHeaders: []converter.RequestHeader{
+				{Name: "Authorization", Value: "Bearer ${TOKEN}"},
+			},
+			RequestBody: `{"ping": true}`,
+			Issues:      []string{"Frequency rounded from 240s to 300s"},
+		},
+		{
+			ResourceName:       "database",
+			Name:               "Database",
+			URL:                "db.example.com",
+			Protocol:           "port",
+			CheckFrequency:     60,
+			Regions:            []string{"virginia"},
+			ExpectedStatusCode: "200",
+			FollowRedirects:    true,
+			Port:               5432,
+		},
+	}
+	healthchecks := []converter.ConvertedHealthcheck{
+		{ResourceName: "nightly_backup", SourceID: "98765", Name: "Nightly Backup", Period: 86400, Grace: 600, Paused: true},
+	}
+
+	got := g.GenerateTerraform(monitors, healthchecks)
+	goldenAssert(t, "migrated-resources.tf.golden", got)
+}
@@ -13,7 +13,11 @@ import (
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/converter"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/generator"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/report"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
 	"github.com/develeap/terraform-provider-hyperping/pkg/interactive"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 	"github.com/develeap/terraform-provider-hyperping/pkg/recovery"
 )
 
@@ -254,6 +258,7 @@ func printInteractiveSummary(
 	fmt.Fprintf(os.Stderr, "  %s - Import script\n", config.importScript)
 	fmt.Fprintf(os.Stderr, "  %s - Migration report\n", config.reportFile)
 	fmt.Fprintf(os.Stderr, "  %s - Manual configuration steps\n", config.manualStepsFile)
+	fmt.Fprintf(os.Stderr, "  %s - Source ID mapping\n", *mappingFile)
 	fmt.Fprintf(os.Stderr, "\n")
 
 	if len(monitorIssues) > 0 || len(healthcheckIssues) > 0 {
@@ -335,6 +340,15 @@ func runInteractive(_ *recovery.Logger) int {
 		return code
 	}
 
+	idMap := mapping.New("Better Stack", toolName, "")
+	for _, entry := range gen.MappingEntries(convertedMonitors, convertedHealthchecks) {
+		idMap.Add(entry)
+	}
+	if err := idMap.Write(*mappingFile); err != nil {
+		prompter.PrintError(fmt.Sprintf("Error: %v", err))
+		return 1
+	}
+
 	printInteractiveSummary(config, prompter, convertedMonitors, convertedHealthchecks, monitorIssues, healthcheckIssues)
 	return 0
 }
@@ -364,6 +378,11 @@ func isFlagPassed() bool {
 		{manualStepsFile, "manual-steps.md"},
 		{resumeID, ""},
 		{rollbackID, ""},
+		{nameTemplateFlag, ""},
+		{overridesFlag, ""},
+		{frequencyPolicyFlag, string(migrate.FrequencyNearest)},
+		{outputDialectFlag, string(dialect.Terraform)},
+		{compatModeFlag, string(compat.None)},
 	}
 
 	for _, c := range stringChecks {
@@ -373,7 +392,7 @@ func isFlagPassed() bool {
 	}
 
 	boolChecks := []*bool{
-		dryRun, validateTF, verbose, debug, resume, rollback, rollbackForce, listCheckpointsFlag,
+		dryRun, validateTF, verbose, debug, resume, rollback, rollbackForce, listCheckpointsFlag, loginFlag,
 	}
 
 	for _, b := range boolChecks {
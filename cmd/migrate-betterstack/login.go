@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/betterstack"
+	"github.com/develeap/terraform-provider-hyperping/pkg/credentials"
+)
+
+// betterstackPlatform describes Better Stack for the --login flow.
+var betterstackPlatform = credentials.Platform{
+	Name:     "Better Stack",
+	Account:  "betterstack",
+	TokenURL: "https://betterstack.com/team/api-tokens",
+	Verify: func(ctx context.Context, token string) error {
+		_, err := betterstack.NewClient(token).FetchMonitors(ctx)
+		return err
+	},
+}
+
+// keychain holds source tokens stored by --login. Tests replace it so they
+// never read the developer's real keychain.
+var keychain = credentials.OSKeychain()
+
+// runLogin runs the --login flow and exits without migrating.
+func runLogin() int {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	if _, err := credentials.Login(ctx, betterstackPlatform, credentials.LoginOptions{Keychain: keychain}); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Fprintln(os.Stderr, credentials.StoredTokenHint("betterstack-token", "BETTERSTACK_API_TOKEN"))
+	return 0
+}
@@ -24,6 +24,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"path/filepath"
 	"time"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/betterstack"
@@ -31,8 +32,15 @@ import (
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/generator"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/report"
 	"github.com/develeap/terraform-provider-hyperping/pkg/checkpoint"
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/credentials"
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 	"github.com/develeap/terraform-provider-hyperping/pkg/migrationstate"
 	"github.com/develeap/terraform-provider-hyperping/pkg/recovery"
+	"github.com/develeap/terraform-provider-hyperping/pkg/tfstate"
 )
 
 var (
@@ -53,6 +61,41 @@ var (
 	rollbackForce       = flag.Bool("force", false, "Force rollback without confirmation")
 	listCheckpointsFlag = flag.Bool("list-checkpoints", false, "List available checkpoints")
 	formatJSON          = flag.Bool("format", false, "Output dry-run report as JSON (use with --dry-run)")
+	verifyMode          = flag.Bool("verify", false, "Compare Better Stack monitors with existing Hyperping monitors (run after terraform apply)")
+	verifyReport        = flag.String("verify-report", "verification-report.json", "Output verification report file (use with --verify)")
+	nameTemplateFlag    = flag.String("name-template", "", "Go template for Hyperping names, e.g. '[{{.Tag \"env\"}}] {{.Name}}' (fields: .Name, .Tags)")
+	overridesFlag       = flag.String("overrides", "", "YAML mapping override file: per Better Stack ID, name, regions, frequency, or skip")
+	regionMapFlag       = flag.String("region-map", "", "YAML file mapping Better Stack regions to Hyperping regions, overriding the built-in table and nearest-region fallback")
+	frequencyPolicyFlag = flag.String("frequency-policy", string(migrate.FrequencyNearest), "How to snap intervals Hyperping does not support: nearest, round-up, round-down, or fail")
+	logFlags            = recovery.RegisterLogFlags(flag.CommandLine)
+	checkWriteAccess    = recovery.RegisterWriteCheckFlag(flag.CommandLine)
+	listFlags           = migrationstate.RegisterListFlags(flag.CommandLine, toolName)
+	rollbackFlags       = migrationstate.RegisterRollbackFlags(flag.CommandLine)
+	webhookFlags        = migrationstate.RegisterWebhookFlags(flag.CommandLine)
+	loginFlag           = credentials.RegisterLoginFlag(flag.CommandLine, betterstackPlatform)
+	outputDialectFlag   = dialect.RegisterFlag(flag.CommandLine)
+	compatModeFlag      = compat.RegisterFlag(flag.CommandLine)
+	fromStateFlag       = tfstate.RegisterFlag(flag.CommandLine)
+	removedBlocksFile   = flag.String("removed-blocks", "removed.tf", "Output removed blocks that drop the source resources from state (use with --from-state)")
+	mappingFile         = mapping.RegisterFlag(flag.CommandLine, mapping.DefaultFileName)
+
+	// nameTemplate is parsed from --name-template in run; nil keeps source names.
+	nameTemplate *migrate.NameTemplate
+	// overrides is loaded from --overrides in run; nil applies none.
+	overrides *migrate.Overrides
+	// regionMap is loaded from --region-map in run; nil applies none.
+	regionMap *migrate.RegionMap
+	// frequencyPolicy is parsed from --frequency-policy in run.
+	frequencyPolicy migrate.FrequencyPolicy
+	// outputDialect is parsed from --output-dialect in run.
+	outputDialect dialect.Dialect
+	// compatMode is parsed from --compat-mode in run.
+	compatMode compat.Mode
+	// notifier is built from --webhook-url in run; nil sends no webhooks.
+	notifier *migrationstate.Notifier
+	// stateInstances are the source resources read from --from-state; nil
+	// when resources are fetched from the Better Stack API.
+	stateInstances []tfstate.Instance
 )
 
 func main() {
@@ -72,6 +115,25 @@ func main() {
 		fmt.Fprintf(os.Stderr, "  migrate-betterstack --resume\n\n")
 		fmt.Fprintf(os.Stderr, "  # Rollback migration\n")
 		fmt.Fprintf(os.Stderr, "  migrate-betterstack --rollback --rollback-id=betterstack-20260213-120000\n\n")
+		fmt.Fprintf(os.Stderr, "  # Prefix names with the value of the env:<value> tag\n")
+		fmt.Fprintf(os.Stderr, "  migrate-betterstack --name-template='[{{.Tag \"env\" | upper}}] {{.Name}}'\n\n")
+		fmt.Fprintf(os.Stderr, "  # Correct names, regions, or frequencies per monitor, or skip monitors\n")
+		fmt.Fprintf(os.Stderr, "  migrate-betterstack --overrides=overrides.yaml\n\n")
+		fmt.Fprintf(os.Stderr, "  # Map Better Stack regions to specific Hyperping regions\n")
+		fmt.Fprintf(os.Stderr, "  migrate-betterstack --region-map=regions.yaml\n\n")
+		fmt.Fprintf(os.Stderr, "  # Never check less often than Better Stack did\n")
+		fmt.Fprintf(os.Stderr, "  migrate-betterstack --frequency-policy=round-down\n\n")
+		fmt.Fprintf(os.Stderr, "  # Convert monitors managed by the Better Stack Terraform provider\n")
+		fmt.Fprintf(os.Stderr, "  terraform state pull > betterstack.tfstate\n")
+		fmt.Fprintf(os.Stderr, "  migrate-betterstack --from-state=betterstack.tfstate\n\n")
+		fmt.Fprintf(os.Stderr, "  # Verify migrated monitors after terraform apply\n")
+		fmt.Fprintf(os.Stderr, "  migrate-betterstack --verify\n\n")
+		fmt.Fprintf(os.Stderr, "  # Sign in through the browser and store the Better Stack token in the OS keychain\n")
+		fmt.Fprintf(os.Stderr, "  migrate-betterstack --login\n\n")
+		fmt.Fprintf(os.Stderr, "  # Generate CDK for Terraform (TypeScript) constructs instead of HCL\n")
+		fmt.Fprintf(os.Stderr, "  migrate-betterstack --output-dialect=cdktf-typescript\n\n")
+		fmt.Fprintf(os.Stderr, "  # Report phase transitions to a Slack channel\n")
+		fmt.Fprintf(os.Stderr, "  migrate-betterstack --webhook-url=\"$SLACK_WEBHOOK_URL\"\n\n")
 		fmt.Fprintf(os.Stderr, "  # Debug mode with detailed logging\n")
 		fmt.Fprintf(os.Stderr, "  migrate-betterstack --debug\n\n")
 	}
@@ -83,6 +145,7 @@ type migrationResult struct {
 	tfConfig              string
 	importScriptContent   string
 	manualSteps           string
+	removedBlocks         string
 	migrationReport       *report.Report
 	monitorIssues         []converter.ConversionIssue
 	healthcheckIssues     []converter.ConversionIssue
@@ -91,12 +154,16 @@ type migrationResult struct {
 }
 
 // validateCredentials checks that required API credentials are present and returns
-// the resolved bsToken and hpKey values (from flags or env vars).
+// the resolved bsToken and hpKey values (from flags or env vars, then the
+// Better Stack token stored by --login).
 func validateCredentials() (bsToken, hpKey string, code int) {
 	bsToken = *betterstackToken
 	if bsToken == "" {
 		bsToken = os.Getenv("BETTERSTACK_API_TOKEN")
 	}
+	if bsToken == "" {
+		bsToken = credentials.Lookup(keychain, betterstackPlatform)
+	}
 	hpKey = *hyperpingAPIKey
 	if hpKey == "" {
 		hpKey = os.Getenv("HYPERPING_API_KEY")
@@ -133,14 +200,16 @@ func handleRollbackMode(hpKey string, logger *recovery.Logger) (int, bool) {
 		migrationID = latest.MigrationID
 	}
 
-	return migrationstate.PerformRollback(migrationID, hpKey, *rollbackForce, logger), true
+	opts := rollbackFlags.Options()
+	opts.MappingFile = *mappingFile
+	return migrationstate.PerformRollback(migrationID, hpKey, *rollbackForce, opts, logger), true
 }
 
 // validateSourceCredentials checks that source/dest credentials exist before a full migration.
 func validateSourceCredentials(bsToken, hpKey string) int {
-	if bsToken == "" {
+	if bsToken == "" && *fromStateFlag == "" {
 		fmt.Fprintln(os.Stderr, "Error: Better Stack API token is required")
-		fmt.Fprintln(os.Stderr, "Set --betterstack-token flag or BETTERSTACK_API_TOKEN environment variable")
+		fmt.Fprintln(os.Stderr, "Set --betterstack-token flag or BETTERSTACK_API_TOKEN environment variable, run with --login, or use --from-state")
 		return 1
 	}
 	if hpKey == "" && !*dryRun {
@@ -151,19 +220,27 @@ func validateSourceCredentials(bsToken, hpKey string) int {
 	return 0
 }
 
-// runDryValidation validates API connectivity in dry-run mode.
-func runDryValidation(ctx context.Context, bsToken string, logger *recovery.Logger) int {
+// runDryValidation validates both API keys in dry-run mode and reports them
+// in one summary.
+func runDryValidation(ctx context.Context, bsToken, hpKey string, logger *recovery.Logger) int {
 	logger.Info("Dry run mode: validating API connectivity...")
 	validator := recovery.NewAPIValidator(logger)
 
-	bsValidation := validator.ValidateSourceAPI(ctx, "Better Stack", func(ctx context.Context) error {
-		bsClient := betterstack.NewClient(bsToken)
-		_, err := bsClient.FetchMonitors(ctx)
-		return err
-	})
+	source := recovery.SkippedValidation("Better Stack", "reading --from-state")
+	if *fromStateFlag == "" {
+		source = validator.ValidateSourceAPI(ctx, "Better Stack", func(ctx context.Context) error {
+			bsClient := newBetterStackClient(bsToken, logger)
+			_, err := bsClient.FetchMonitors(ctx)
+			return err
+		})
+	}
+
+	destination := recovery.SkippedValidation(recovery.DestinationServiceName, "no API key")
+	if hpKey != "" {
+		destination = validator.ValidateDestinationAPI(ctx, clientenv.NewClient(hpKey), *checkWriteAccess)
+	}
 
-	if !bsValidation.Valid {
-		fmt.Fprintf(os.Stderr, "Error: %s\n", bsValidation.ErrorMessage)
+	if !recovery.PrintValidationSummary(os.Stderr, source, destination) {
 		return 1
 	}
 
@@ -206,9 +283,21 @@ func resolveOrCreateState(ctx context.Context, totalResources int, logger *recov
 	return state, migID, nil
 }
 
+// newBetterStackClient creates a Better Stack client that reports page
+// progress and rate limit waits through the logger.
+func newBetterStackClient(bsToken string, logger *recovery.Logger) *betterstack.Client {
+	return betterstack.NewClient(bsToken, betterstack.WithProgress(func(p betterstack.Progress) {
+		if p.Wait > 0 {
+			logger.Warn("Better Stack rate limit hit fetching %s page %d, retrying in %s (attempt %d)", p.Resource, p.Page, p.Wait, p.Attempt)
+			return
+		}
+		logger.Debug("Fetched %s page %d (%d so far)", p.Resource, p.Page, p.Fetched)
+	}))
+}
+
 // fetchBetterStackResources fetches monitors and heartbeats from Better Stack.
 func fetchBetterStackResources(ctx context.Context, bsToken string, logger *recovery.Logger) ([]betterstack.Monitor, []betterstack.Heartbeat, error) {
-	bsClient := betterstack.NewClient(bsToken)
+	bsClient := newBetterStackClient(bsToken, logger)
 
 	logger.Info("Fetching Better Stack monitors...")
 	monitors, err := bsClient.FetchMonitors(ctx)
@@ -227,6 +316,25 @@ func fetchBetterStackResources(ctx context.Context, bsToken string, logger *reco
 	return monitors, heartbeats, nil
 }
 
+// loadStateResources reads monitors and heartbeats managed by the Better
+// Stack Terraform provider from the --from-state file.
+func loadStateResources(logger *recovery.Logger) ([]betterstack.Monitor, []betterstack.Heartbeat, error) {
+	logger.Info("Reading Better Stack resources from %s...", *fromStateFlag)
+	state, err := tfstate.Load(*fromStateFlag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	monitors, heartbeats, instances := betterstack.FromState(state)
+	if len(instances) == 0 {
+		return nil, nil, fmt.Errorf("no %s or %s resources found in %s",
+			betterstack.MonitorResourceType, betterstack.HeartbeatResourceType, *fromStateFlag)
+	}
+	stateInstances = instances
+	logger.Info("Found %d monitors and %d heartbeats in state", len(monitors), len(heartbeats))
+	return monitors, heartbeats, nil
+}
+
 // convertResources converts all Better Stack resources to Hyperping format.
 func convertResources(
 	monitors []betterstack.Monitor,
@@ -234,7 +342,9 @@ func convertResources(
 	state *migrationstate.State,
 	logger *recovery.Logger,
 ) ([]converter.ConvertedMonitor, []converter.ConvertedHealthcheck, []converter.ConversionIssue, []converter.ConversionIssue) {
-	conv := converter.New()
+	conv := converter.New().WithNameTemplate(nameTemplate).WithOverrides(overrides).WithFrequencyPolicy(frequencyPolicy).
+		WithRegionMap(regionMap)
+	warnUnknownOverrides(monitors, heartbeats, logger)
 
 	logger.Info("Converting monitors to Hyperping format...")
 	convertedMonitors, monitorIssues := convertMonitorList(monitors, conv, state, logger)
@@ -248,6 +358,21 @@ func convertResources(
 	return convertedMonitors, convertedHealthchecks, monitorIssues, healthcheckIssues
 }
 
+// warnUnknownOverrides warns about mapping overrides whose ID matches no
+// fetched monitor or heartbeat, which usually means a typo.
+func warnUnknownOverrides(monitors []betterstack.Monitor, heartbeats []betterstack.Heartbeat, logger *recovery.Logger) {
+	ids := make([]string, 0, len(monitors)+len(heartbeats))
+	for _, m := range monitors {
+		ids = append(ids, m.ID)
+	}
+	for _, h := range heartbeats {
+		ids = append(ids, h.ID)
+	}
+	for _, id := range overrides.Unknown(ids) {
+		logger.Warn("Mapping override %q matches no Better Stack monitor or heartbeat", id)
+	}
+}
+
 // deduplicateConvertedNames ensures resource names are unique across monitors and healthchecks.
 func deduplicateConvertedNames(monitors []converter.ConvertedMonitor, healthchecks []converter.ConvertedHealthcheck) {
 	seen := make(map[string]int)
@@ -284,6 +409,12 @@ func convertMonitorList(
 			continue
 		}
 
+		if conv.Skipped(monitor.ID) {
+			logger.Info("Skipping monitor %s (skip in mapping overrides)", monitor.ID)
+			state.MarkResourceProcessed(monitorID)
+			continue
+		}
+
 		converted, issues := conv.ConvertMonitors([]betterstack.Monitor{monitor})
 		if len(converted) > 0 {
 			convertedMonitors = append(convertedMonitors, converted...)
@@ -318,6 +449,12 @@ func convertHeartbeatList(
 			continue
 		}
 
+		if conv.Skipped(heartbeat.ID) {
+			logger.Info("Skipping heartbeat %s (skip in mapping overrides)", heartbeat.ID)
+			state.MarkResourceProcessed(heartbeatID)
+			continue
+		}
+
 		converted, issues := conv.ConvertHeartbeats([]betterstack.Heartbeat{heartbeat})
 		if len(converted) > 0 {
 			convertedHealthchecks = append(convertedHealthchecks, converted...)
@@ -344,8 +481,8 @@ func buildMigrationResult(
 	monitorIssues []converter.ConversionIssue,
 	healthcheckIssues []converter.ConversionIssue,
 ) *migrationResult {
-	gen := generator.New()
-	return &migrationResult{
+	gen := generator.New().WithCompatMode(compatMode)
+	result := &migrationResult{
 		tfConfig:              gen.GenerateTerraform(convertedMonitors, convertedHealthchecks),
 		importScriptContent:   gen.GenerateImportScript(convertedMonitors, convertedHealthchecks),
 		manualSteps:           gen.GenerateManualSteps(monitorIssues, healthcheckIssues),
@@ -355,6 +492,10 @@ func buildMigrationResult(
 		convertedMonitors:     convertedMonitors,
 		convertedHealthchecks: convertedHealthchecks,
 	}
+	if len(stateInstances) > 0 {
+		result.removedBlocks = tfstate.RemovedBlocks(stateInstances)
+	}
+	return result
 }
 
 // runDryRunOutput prints dry-run preview and returns the exit code.
@@ -385,8 +526,9 @@ func runDryRunOutput(
 	return 0
 }
 
-// writeOutputFiles writes all generated files to disk.
-func writeOutputFiles(result *migrationResult, logger *recovery.Logger) (int, error) {
+// writeOutputFiles writes all generated files to disk and records them in
+// state for --rollback-files.
+func writeOutputFiles(result *migrationResult, state *migrationstate.State, logger *recovery.Logger) (int, error) {
 	type fileWrite struct {
 		path    string
 		content []byte
@@ -394,11 +536,25 @@ func writeOutputFiles(result *migrationResult, logger *recovery.Logger) (int, er
 	}
 
 	writes := []fileWrite{
-		{*outputFile, []byte(result.tfConfig), *outputFile},
 		{*importScript, []byte(result.importScriptContent), *importScript},
 		{*reportFile, []byte(result.migrationReport.JSON()), *reportFile},
 		{*manualStepsFile, []byte(result.manualSteps), *manualStepsFile},
 	}
+	if result.removedBlocks != "" {
+		writes = append(writes, fileWrite{*removedBlocksFile, []byte(result.removedBlocks), *removedBlocksFile})
+	}
+
+	logger.Debug("Writing %s configuration", outputDialect)
+	paths, err := dialect.WriteConfig(outputDialect, []byte(result.tfConfig), *outputFile)
+	if err != nil {
+		logger.Error("Failed to write configuration: %v", err)
+		fmt.Fprintf(os.Stderr, "Error writing configuration: %v\n", err)
+		return 1, err
+	}
+	for _, path := range paths {
+		logger.Info("Generated %s", path)
+	}
+	state.AddGeneratedFiles(paths...)
 
 	for _, w := range writes {
 		logger.Debug("Writing %s", w.path)
@@ -407,8 +563,22 @@ func writeOutputFiles(result *migrationResult, logger *recovery.Logger) (int, er
 			fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", w.path, err)
 			return 1, err
 		}
+		state.AddGeneratedFiles(w.path)
 		logger.Info("Generated %s", w.logMsg)
 	}
+
+	// The mapping is not recorded for --rollback-files, so it still traces
+	// the resources to their origin after a rollback.
+	idMap := mapping.New("Better Stack", toolName, state.Checkpoint.MigrationID)
+	for _, entry := range generator.New().MappingEntries(result.convertedMonitors, result.convertedHealthchecks) {
+		idMap.Add(entry)
+	}
+	if err := idMap.Write(*mappingFile); err != nil {
+		logger.Error("Failed to write %s: %v", *mappingFile, err)
+		fmt.Fprintf(os.Stderr, "Error writing %s: %v\n", *mappingFile, err)
+		return 1, err
+	}
+	logger.Info("Generated %s", *mappingFile)
 	return 0, nil
 }
 
@@ -424,10 +594,16 @@ func printSuccessSummary(result *migrationResult, state *migrationstate.State, m
 	}
 
 	fmt.Fprintf(os.Stderr, "\nGenerated files:\n")
-	fmt.Fprintf(os.Stderr, "  - %s (Terraform configuration)\n", *outputFile)
+	for _, name := range dialect.FileNames(outputDialect, filepath.Base(*outputFile)) {
+		fmt.Fprintf(os.Stderr, "  - %s (%s configuration)\n", filepath.Join(filepath.Dir(*outputFile), name), outputDialect)
+	}
 	fmt.Fprintf(os.Stderr, "  - %s (import script)\n", *importScript)
 	fmt.Fprintf(os.Stderr, "  - %s (migration report)\n", *reportFile)
 	fmt.Fprintf(os.Stderr, "  - %s (manual steps)\n", *manualStepsFile)
+	fmt.Fprintf(os.Stderr, "  - %s (source ID mapping)\n", *mappingFile)
+	if result.removedBlocks != "" {
+		fmt.Fprintf(os.Stderr, "  - %s (removed blocks for the Better Stack resources)\n", *removedBlocksFile)
+	}
 
 	fmt.Fprintf(os.Stderr, "\nNext steps:\n")
 	fmt.Fprintf(os.Stderr, "  1. Review %s and adjust as needed\n", *outputFile)
@@ -435,6 +611,9 @@ func printSuccessSummary(result *migrationResult, state *migrationstate.State, m
 	fmt.Fprintf(os.Stderr, "  3. Run: terraform init\n")
 	fmt.Fprintf(os.Stderr, "  4. Run: terraform plan\n")
 	fmt.Fprintf(os.Stderr, "  5. Run: terraform apply\n")
+	if result.removedBlocks != "" {
+		fmt.Fprintf(os.Stderr, "  6. Move %s into the Better Stack configuration, delete the migrated resource blocks, and apply\n", *removedBlocksFile)
+	}
 }
 
 // runTerraformValidation optionally validates the written Terraform file.
@@ -461,10 +640,25 @@ func logFatalErr(logger *recovery.Logger, err error) int {
 	return 1
 }
 
-// logDebugPath logs the debug log path when debug mode is active.
+// newLogger creates the run's logger. --verbose and --debug only add debug
+// messages on stderr; a log file is written with --debug-log, or to the
+// default log directory with --debug.
+func newLogger() (*recovery.Logger, error) {
+	opts := logFlags.Options()
+	if *debug && opts.Path == "" {
+		path, err := recovery.DefaultLogPath()
+		if err != nil {
+			return nil, err
+		}
+		opts.Path = path
+	}
+	return recovery.NewLoggerWithOptions(*debug || *verbose, opts)
+}
+
+// logDebugPath logs the debug log path when a log file is written.
 func logDebugPath(logger *recovery.Logger) {
-	if *debug && logger.GetLogPath() != "" {
-		logger.Info("Debug logging enabled, log file: %s", logger.GetLogPath())
+	if logger.GetLogPath() != "" {
+		logger.Info("Debug log file: %s", logger.GetLogPath())
 	}
 }
 
@@ -486,10 +680,12 @@ func runConversionAndOutput(
 		return runDryRunOutput(monitors, heartbeats, result, state)
 	}
 
-	if code, writeErr := writeOutputFiles(result, logger); writeErr != nil {
+	if code, writeErr := writeOutputFiles(result, state, logger); writeErr != nil {
 		state.Finalize(false)
 		return code
 	}
+	state.Notify(migrationstate.PhaseResourcesCreated, fmt.Sprintf("wrote %d monitors and %d healthchecks to %s",
+		len(convertedMonitors), len(convertedHealthchecks), *outputFile))
 
 	hasFailures := state.Checkpoint.Failed > 0
 	state.Finalize(!hasFailures)
@@ -513,7 +709,12 @@ func finalizeMigration(hasFailures bool, state *migrationstate.State, logger *re
 func run() int {
 	flag.Parse()
 
-	logger, err := recovery.NewLogger(*debug || *verbose)
+	if err := clientenv.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	logger, err := newLogger()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: Failed to create logger: %v\n", err)
 		return 1
@@ -522,12 +723,65 @@ func run() int {
 
 	logDebugPath(logger)
 
+	nameTemplate, err = migrate.ParseNameTemplate(*nameTemplateFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	overrides, err = migrate.LoadOverrides(*overridesFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	regionMap, err = migrate.LoadRegionMap(*regionMapFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	frequencyPolicy, err = migrate.ParseFrequencyPolicy(*frequencyPolicyFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	outputDialect, err = dialect.ParseDialect(*outputDialectFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if *validateTF && outputDialect != dialect.Terraform {
+		fmt.Fprintf(os.Stderr, "Error: --validate requires --output-dialect=terraform\n")
+		return 1
+	}
+
+	compatMode, err = compat.ParseMode(*compatModeFlag)
+	if err == nil {
+		err = compatMode.CheckDialect(outputDialect)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	notifier, err = webhookFlags.Notifier()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if *loginFlag {
+		return runLogin()
+	}
+
 	if shouldUseInteractive() {
 		return runInteractive(logger)
 	}
 
 	if *listCheckpointsFlag {
-		return migrationstate.ListCheckpoints(toolName)
+		return migrationstate.ListCheckpoints(listFlags.Options())
 	}
 
 	bsToken, hpKey, _ := validateCredentials()
@@ -544,20 +798,34 @@ func run() int {
 	defer cancel()
 
 	if *dryRun {
-		if code := runDryValidation(ctx, bsToken, logger); code != 0 {
+		if code := runDryValidation(ctx, bsToken, hpKey, logger); code != 0 {
 			return code
 		}
 	}
 
-	monitors, heartbeats, err := fetchBetterStackResources(ctx, bsToken, logger)
+	var monitors []betterstack.Monitor
+	var heartbeats []betterstack.Heartbeat
+	if *fromStateFlag != "" {
+		monitors, heartbeats, err = loadStateResources(logger)
+	} else {
+		monitors, heartbeats, err = fetchBetterStackResources(ctx, bsToken, logger)
+	}
 	if err != nil {
 		return logFatalErr(logger, err)
 	}
 
+	if *verifyMode {
+		return runVerification(ctx, monitors, hpKey, logger)
+	}
+
 	state, migrationID, err := resolveOrCreateState(ctx, len(monitors)+len(heartbeats), logger)
 	if err != nil {
 		return logFatalErr(logger, err)
 	}
+	if !*dryRun {
+		state.SetNotifier(notifier)
+		state.Notify(migrationstate.PhaseStarted, "")
+	}
 
 	return runConversionAndOutput(monitors, heartbeats, state, migrationID, logger)
 }
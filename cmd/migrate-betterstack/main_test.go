@@ -11,6 +11,7 @@ import (
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/betterstack"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/converter"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 func TestSanitizeResourceName(t *testing.T) {
@@ -332,3 +333,56 @@ func TestHeartbeatConversion(t *testing.T) {
 		})
 	}
 }
+
+func TestVerifySources(t *testing.T) {
+	monitors := []betterstack.Monitor{
+		{
+			ID: "1",
+			Attributes: betterstack.MonitorAttributes{
+				PronouncableName:    "API",
+				URL:                 "https://api.example.com",
+				MonitorType:         "status",
+				CheckFrequency:      45,
+				RequestTimeout:      30,
+				ExpectedStatusCodes: []int{200, 201},
+				Regions:             []string{"us", "eu"},
+			},
+		},
+		{
+			ID: "2",
+			Attributes: betterstack.MonitorAttributes{
+				PronouncableName: "Cron",
+				MonitorType:      "heartbeat",
+			},
+		},
+	}
+
+	sources, _ := verifySources(monitors)
+	require.Len(t, sources, 1, "heartbeat monitors should be skipped")
+
+	src := sources[0]
+	assert.Equal(t, "API", src.Name)
+	assert.Equal(t, "http", src.Protocol)
+	assert.Equal(t, 45, src.Frequency, "frequency should be the raw source value")
+	assert.Equal(t, []string{"200", "201"}, src.ExpectedStatusCodes)
+	assert.Equal(t, []string{"virginia", "london"}, src.Regions)
+	assert.Equal(t, 30, src.Timeout)
+}
+
+func TestVerifySources_SkipsMonitorsThatFailToConvert(t *testing.T) {
+	frequencyPolicy = migrate.FrequencyFail
+	t.Cleanup(func() { frequencyPolicy = "" })
+
+	monitors := []betterstack.Monitor{
+		{ID: "1", Attributes: betterstack.MonitorAttributes{PronouncableName: "Odd", URL: "https://odd.example.com", MonitorType: "status", CheckFrequency: 45, Regions: []string{"us"}}},
+		{ID: "2", Attributes: betterstack.MonitorAttributes{PronouncableName: "API", URL: "https://api.example.com", MonitorType: "status", CheckFrequency: 60, Regions: []string{"us"}}},
+	}
+
+	sources, issues := verifySources(monitors)
+	require.Len(t, sources, 1, "the monitor that fails to convert should not be verified")
+	assert.Equal(t, "2", sources[0].ID)
+	assert.Equal(t, "API", sources[0].Name)
+	assert.Equal(t, "https://api.example.com", sources[0].URL)
+	require.Len(t, issues, 1)
+	assert.Equal(t, "error", issues[0].Severity)
+}
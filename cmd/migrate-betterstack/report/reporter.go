@@ -10,6 +10,7 @@ import (
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/betterstack"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/converter"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 // Report contains migration statistics and details.
@@ -18,6 +19,10 @@ type Report struct {
 	Monitors         []MonitorMapping            `json:"monitors"`
 	Healthchecks     []HealthcheckMapping        `json:"healthchecks"`
 	ConversionIssues []converter.ConversionIssue `json:"conversion_issues"`
+	// FrequencyAdjustments lists every check frequency and heartbeat period
+	// that was snapped to a supported Hyperping value.
+	FrequencyAdjustments []migrate.FrequencyAdjustment `json:"frequency_adjustments"`
+	Estimate             *migrate.Estimate             `json:"estimate"`
 }
 
 // Summary contains high-level migration statistics.
@@ -29,6 +34,7 @@ type Summary struct {
 	TotalIssues           int `json:"total_issues"`
 	CriticalIssues        int `json:"critical_issues"`
 	Warnings              int `json:"warnings"`
+	FrequencyAdjustments  int `json:"frequency_adjustments"`
 }
 
 // MonitorMapping maps Better Stack monitor to Hyperping monitor.
@@ -61,33 +67,51 @@ func Generate(
 	healthcheckIssues []converter.ConversionIssue,
 ) *Report {
 	report := &Report{
-		ConversionIssues: append(monitorIssues, healthcheckIssues...),
+		ConversionIssues:     append(monitorIssues, healthcheckIssues...),
+		FrequencyAdjustments: []migrate.FrequencyAdjustment{},
+	}
+
+	// Converted resources are matched to their source by ID, since resources
+	// that fail conversion leave gaps.
+	monitorNames := make(map[string]string, len(bsMonitors))
+	for _, m := range bsMonitors {
+		monitorNames[m.ID] = m.Attributes.PronouncableName
+	}
+	heartbeatNames := make(map[string]string, len(bsHeartbeats))
+	for _, h := range bsHeartbeats {
+		heartbeatNames[h.ID] = h.Attributes.Name
 	}
 
 	// Build monitor mappings
-	for i, m := range convertedMonitors {
+	for _, m := range convertedMonitors {
 		mapping := MonitorMapping{
-			BetterStackID:   bsMonitors[i].ID,
-			BetterStackName: bsMonitors[i].Attributes.PronouncableName,
+			BetterStackID:   m.SourceID,
+			BetterStackName: monitorNames[m.SourceID],
 			HyperpingName:   m.Name,
 			ResourceName:    m.ResourceName,
 			Protocol:        m.Protocol,
 			Issues:          m.Issues,
 		}
 		report.Monitors = append(report.Monitors, mapping)
+		if m.FrequencyAdjustment != nil {
+			report.FrequencyAdjustments = append(report.FrequencyAdjustments, *m.FrequencyAdjustment)
+		}
 	}
 
 	// Build healthcheck mappings
-	for i, h := range convertedHealthchecks {
+	for _, h := range convertedHealthchecks {
 		mapping := HealthcheckMapping{
-			BetterStackID:   bsHeartbeats[i].ID,
-			BetterStackName: bsHeartbeats[i].Attributes.Name,
+			BetterStackID:   h.SourceID,
+			BetterStackName: heartbeatNames[h.SourceID],
 			HyperpingName:   h.Name,
 			ResourceName:    h.ResourceName,
 			Period:          h.Period,
 			Issues:          h.Issues,
 		}
 		report.Healthchecks = append(report.Healthchecks, mapping)
+		if h.FrequencyAdjustment != nil {
+			report.FrequencyAdjustments = append(report.FrequencyAdjustments, *h.FrequencyAdjustment)
+		}
 	}
 
 	// Calculate summary
@@ -97,8 +121,15 @@ func Generate(
 		TotalHeartbeats:       len(bsHeartbeats),
 		ConvertedHealthchecks: len(convertedHealthchecks),
 		TotalIssues:           len(report.ConversionIssues),
+		FrequencyAdjustments:  len(report.FrequencyAdjustments),
 	}
 
+	loads := make([]migrate.MonitorLoad, len(convertedMonitors))
+	for i, m := range convertedMonitors {
+		loads[i] = migrate.MonitorLoad{CheckFrequency: m.CheckFrequency, Regions: len(m.Regions), Paused: m.Paused}
+	}
+	report.Estimate = migrate.NewEstimate(loads, len(convertedHealthchecks))
+
 	// Count critical issues and warnings
 	for _, issue := range report.ConversionIssues {
 		if issue.Severity == "error" {
@@ -130,6 +161,14 @@ func (r *Report) PrintSummary(w io.Writer) {
 		r.Summary.CriticalIssues,
 		r.Summary.Warnings,
 	)
+	if r.Summary.FrequencyAdjustments > 0 {
+		fmt.Fprintf(w, "  Frequencies:  %d adjusted to supported values (see frequency_adjustments)\n", r.Summary.FrequencyAdjustments)
+	}
+
+	if r.Estimate != nil {
+		fmt.Fprintln(w)
+		r.Estimate.WriteText(w)
+	}
 
 	if r.Summary.CriticalIssues > 0 {
 		fmt.Fprintln(w, "\n⚠️  Critical issues found! Review migration-report.json and manual-steps.md")
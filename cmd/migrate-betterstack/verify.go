@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/betterstack"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-betterstack/converter"
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+	"github.com/develeap/terraform-provider-hyperping/pkg/recovery"
+	"github.com/develeap/terraform-provider-hyperping/pkg/verify"
+)
+
+// runVerification compares Better Stack monitors with the monitors that exist
+// in Hyperping and writes a field-by-field equivalence report.
+func runVerification(ctx context.Context, monitors []betterstack.Monitor, hpKey string, logger *recovery.Logger) int {
+	logger.Info("Fetching Hyperping monitors for verification...")
+	destination, err := clientenv.NewClient(hpKey).ListMonitors(ctx)
+	if err != nil {
+		return logFatalErr(logger, fmt.Errorf("error fetching Hyperping monitors: %w", err))
+	}
+
+	idMap, err := mapping.Load(*mappingFile)
+	if err != nil {
+		return logFatalErr(logger, err)
+	}
+
+	sources, issues := verifySources(monitors)
+	for _, issue := range issues {
+		if issue.Severity == "error" {
+			logger.Warn("Not verifying monitor %s: %s", issue.ResourceName, issue.Message)
+		}
+	}
+
+	result := verify.Monitors("Better Stack", verify.ApplyMapping(sources, idMap), destination)
+	result.PrintSummary(os.Stderr)
+
+	if err := result.WriteJSON(*verifyReport); err != nil {
+		return logFatalErr(logger, err)
+	}
+	logger.Info("Verification report written to %s", *verifyReport)
+
+	if recorded := result.RecordUUIDs(idMap); recorded > 0 {
+		if err := idMap.Write(*mappingFile); err != nil {
+			return logFatalErr(logger, err)
+		}
+		logger.Info("Recorded %d Hyperping UUIDs in %s", recorded, *mappingFile)
+	}
+
+	if result.HasProblems() {
+		return 1
+	}
+	return 0
+}
+
+// verifySources builds verification inputs from the raw Better Stack
+// monitors, using the converter only for names and protocol vocabulary.
+// Monitors skipped by the mapping overrides or that fail to convert are not
+// verified; the conversion issues are returned for the caller to report.
+func verifySources(monitors []betterstack.Monitor) ([]verify.Source, []converter.ConversionIssue) {
+	conv := converter.New().WithNameTemplate(nameTemplate).WithOverrides(overrides).WithFrequencyPolicy(frequencyPolicy)
+	regions := migrate.NewRegionMapper(nil).WithRegionMap(regionMap)
+	converted, issues := conv.ConvertMonitors(monitors)
+	byID := make(map[string]converter.ConvertedMonitor, len(converted))
+	for _, cm := range converted {
+		byID[cm.SourceID] = cm
+	}
+
+	sources := make([]verify.Source, 0, len(converted))
+	for _, m := range monitors {
+		cm, ok := byID[m.ID]
+		if !ok || cm.Protocol == "healthcheck" {
+			continue
+		}
+
+		attrs := m.Attributes
+		codes := make([]string, 0, len(attrs.ExpectedStatusCodes))
+		for _, code := range attrs.ExpectedStatusCodes {
+			codes = append(codes, strconv.Itoa(code))
+		}
+
+		sources = append(sources, verify.Source{
+			ID:                  m.ID,
+			Name:                cm.Name,
+			URL:                 attrs.URL,
+			Protocol:            cm.Protocol,
+			Frequency:           attrs.CheckFrequency,
+			Locations:           attrs.Regions,
+			Regions:             regions.Map(attrs.Regions).Regions,
+			ExpectedStatusCodes: codes,
+			Port:                attrs.Port,
+			Timeout:             attrs.RequestTimeout,
+		})
+	}
+	return sources, issues
+}
@@ -0,0 +1,290 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package converter
+
+import (
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/spreadsheet"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+const (
+	// UnsupportedFrequency is the UnsupportedType of a row whose frequency
+	// the frequency policy rejects.
+	UnsupportedFrequency = "frequency"
+	// UnsupportedInvalid is the UnsupportedType of a row with values that
+	// could not be parsed, such as an empty URL or a malformed port.
+	UnsupportedInvalid = "invalid"
+)
+
+// defaultFrequency is the check frequency of rows without one, in seconds.
+const defaultFrequency = 60
+
+// protocolAliases maps the protocol names found in spreadsheets to
+// Hyperping monitor protocols.
+var protocolAliases = map[string]string{
+	"http":    "http",
+	"https":   "http",
+	"web":     "http",
+	"website": "http",
+	"keyword": "http",
+	"port":    "port",
+	"tcp":     "port",
+	"icmp":    "icmp",
+	"ping":    "icmp",
+}
+
+// ConversionResult represents the result of converting a CSV row.
+type ConversionResult struct {
+	Monitor         *hyperping.CreateMonitorRequest
+	Supported       bool
+	UnsupportedType string
+	Skipped         bool // skipped on purpose via the mapping overrides
+	Notes           []string
+	// FrequencyAdjustment is set when the row frequency was snapped to a
+	// different Hyperping check frequency.
+	FrequencyAdjustment *migrate.FrequencyAdjustment
+}
+
+// CheckConverter converts CSV rows to Hyperping monitors.
+type CheckConverter struct {
+	nameTemplate    *migrate.NameTemplate
+	overrides       *migrate.Overrides
+	frequencyPolicy migrate.FrequencyPolicy
+	regions         *migrate.RegionMapper
+}
+
+// NewCheckConverter creates a new CheckConverter. Regions are mapped with
+// the shared aliases, so a row may name Hyperping regions, cloud regions
+// such as us-east-1, or places.
+func NewCheckConverter() *CheckConverter {
+	return &CheckConverter{frequencyPolicy: migrate.FrequencyNearest, regions: migrate.NewRegionMapper(nil)}
+}
+
+// WithNameTemplate renders monitor names from the row name and tags using
+// tmpl. A nil template keeps the row name.
+func (c *CheckConverter) WithNameTemplate(tmpl *migrate.NameTemplate) *CheckConverter {
+	c.nameTemplate = tmpl
+	return c
+}
+
+// WithOverrides applies the mapping overrides in o, keyed by row ID. A
+// skipped row converts to a result with Skipped set and no monitor.
+func (c *CheckConverter) WithOverrides(o *migrate.Overrides) *CheckConverter {
+	c.overrides = o
+	return c
+}
+
+// WithFrequencyPolicy sets how frequencies that Hyperping does not support
+// are snapped. Under migrate.FrequencyFail such rows convert to an
+// unsupported result with UnsupportedType UnsupportedFrequency.
+func (c *CheckConverter) WithFrequencyPolicy(p migrate.FrequencyPolicy) *CheckConverter {
+	c.frequencyPolicy = p
+	return c
+}
+
+// WithRegionMap maps row regions with the mappings in rm ahead of the
+// built-in ones, and uses its defaults for rows whose regions map to none.
+func (c *CheckConverter) WithRegionMap(rm *migrate.RegionMap) *CheckConverter {
+	c.regions.WithRegionMap(rm)
+	return c
+}
+
+// Convert converts a CSV row to a Hyperping monitor.
+func (c *CheckConverter) Convert(check spreadsheet.Check) ConversionResult {
+	result := ConversionResult{
+		Notes: []string{},
+	}
+
+	override, _ := c.overrides.Lookup(check.ID)
+	if override.Skip {
+		result.Skipped = true
+		result.Notes = append(result.Notes, "Skipped: skip in mapping overrides")
+		return result
+	}
+
+	if len(check.Errors) > 0 {
+		result.UnsupportedType = UnsupportedInvalid
+		result.Notes = append(result.Notes, check.Errors...)
+		return result
+	}
+
+	protocol, ok := Protocol(check)
+	if !ok {
+		result.UnsupportedType = check.Protocol
+		result.Notes = append(result.Notes, fmt.Sprintf("Protocol %q is not supported. Use http, port, or icmp", check.Protocol))
+		return result
+	}
+
+	monitor := &hyperping.CreateMonitorRequest{
+		Name:           check.Name,
+		URL:            migrate.EnsureURLScheme(check.URL),
+		Protocol:       protocol,
+		CheckFrequency: defaultFrequency,
+		Regions:        c.Regions(check.Regions),
+		Paused:         check.Paused,
+	}
+	result.Monitor = monitor
+	result.Supported = true
+
+	switch protocol {
+	case "http":
+		monitor.HTTPMethod = "GET"
+		if check.Method != "" {
+			monitor.HTTPMethod = check.Method
+		}
+		monitor.ExpectedStatusCode = "200"
+		if check.ExpectedStatus != "" {
+			monitor.ExpectedStatusCode = check.ExpectedStatus
+		}
+		monitor.FollowRedirects = boolPtr(true)
+		if check.Keyword != "" {
+			keyword := check.Keyword
+			monitor.RequiredKeyword = &keyword
+		}
+	case "port":
+		port := check.Port
+		if port == 0 {
+			port = urlPort(check.URL)
+		}
+		if port == 0 {
+			result.Monitor = nil
+			result.Supported = false
+			result.UnsupportedType = UnsupportedInvalid
+			result.Notes = append(result.Notes, "port monitor has no port: set the port column or a port in the URL")
+			return result
+		}
+		monitor.Port = &port
+		monitor.URL = withoutPort(check.URL)
+	}
+
+	if c.nameTemplate != nil {
+		name, err := c.nameTemplate.Render(check.Name, check.Tags)
+		if err != nil {
+			result.Notes = append(result.Notes, fmt.Sprintf("Name template failed, using row name: %v", err))
+		} else {
+			monitor.Name = name
+		}
+	}
+
+	if override.Frequency == 0 && check.Frequency > 0 {
+		c.snapFrequency(&result, check)
+	}
+
+	if result.Monitor != nil && len(override.Regions) == 0 {
+		result.Notes = append(result.Notes, c.regions.Map(check.Regions).Warnings()...)
+	}
+
+	if result.Monitor != nil {
+		applyOverride(result.Monitor, override)
+	}
+
+	return result
+}
+
+// Protocol returns the Hyperping protocol of a row, and whether it has one.
+// A row without a protocol is an HTTP monitor when its URL has an HTTP
+// scheme or no port, and a port monitor otherwise.
+func Protocol(check spreadsheet.Check) (string, bool) {
+	if check.Protocol != "" {
+		protocol, ok := protocolAliases[check.Protocol]
+		return protocol, ok
+	}
+	if strings.HasPrefix(check.URL, "http://") || strings.HasPrefix(check.URL, "https://") {
+		return "http", true
+	}
+	if check.Port > 0 || urlPort(check.URL) > 0 {
+		return "port", true
+	}
+	return "http", true
+}
+
+// snapFrequency sets the monitor's check frequency from the row frequency
+// under the frequency policy, recording any adjustment. A rejected frequency
+// turns the result into an unsupported one.
+func (c *CheckConverter) snapFrequency(result *ConversionResult, check spreadsheet.Check) {
+	frequency, err := c.frequencyPolicy.Snap(check.Frequency)
+	if err != nil {
+		result.Monitor = nil
+		result.Supported = false
+		result.UnsupportedType = UnsupportedFrequency
+		result.Notes = append(result.Notes, err.Error())
+		return
+	}
+
+	result.Monitor.CheckFrequency = frequency
+	if frequency != check.Frequency {
+		result.FrequencyAdjustment = &migrate.FrequencyAdjustment{
+			SourceID: check.ID,
+			Name:     check.Name,
+			From:     check.Frequency,
+			To:       frequency,
+			Policy:   c.frequencyPolicy,
+		}
+		result.Notes = append(result.Notes, result.FrequencyAdjustment.String())
+	}
+}
+
+// Regions converts row regions to Hyperping regions. Rows without regions,
+// or whose regions map to none, get the --region-map defaults when set, and
+// migrate.DefaultRegions otherwise.
+func (c *CheckConverter) Regions(regions []string) []string {
+	if mapped := c.regions.Map(regions).Regions; len(mapped) > 0 {
+		return mapped
+	}
+	return c.regions.Defaults(migrate.DefaultRegions())
+}
+
+// applyOverride replaces the converted name, regions, and check frequency
+// with those set by the mapping override.
+func applyOverride(monitor *hyperping.CreateMonitorRequest, override migrate.Override) {
+	if override.Name != "" {
+		monitor.Name = override.Name
+	}
+	if len(override.Regions) > 0 {
+		monitor.Regions = override.Regions
+	}
+	if override.Frequency != 0 {
+		monitor.CheckFrequency = override.Frequency
+	}
+}
+
+// urlPort returns the port in a URL or host:port value, or 0 when it has
+// none.
+func urlPort(raw string) int {
+	u, err := url.Parse(migrate.EnsureURLScheme(raw))
+	if err != nil {
+		return 0
+	}
+	port, err := strconv.Atoi(u.Port())
+	if err != nil || port < 1 || port > 65535 {
+		return 0
+	}
+	return port
+}
+
+// withoutPort returns raw with an HTTP scheme and without a port, for port
+// monitors, which take the port separately.
+func withoutPort(raw string) string {
+	u, err := url.Parse(migrate.EnsureURLScheme(raw))
+	if err != nil || u.Port() == "" {
+		return migrate.EnsureURLScheme(raw)
+	}
+	host := u.Hostname()
+	if strings.Contains(host, ":") {
+		host = "[" + host + "]"
+	}
+	u.Host = host
+	return u.String()
+}
+
+func boolPtr(b bool) *bool {
+	return &b
+}
@@ -0,0 +1,200 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package converter
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/spreadsheet"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+func TestConvert_Protocols(t *testing.T) {
+	tests := []struct {
+		name            string
+		check           spreadsheet.Check
+		wantSupported   bool
+		wantProtocol    string
+		wantURL         string
+		wantPort        int
+		wantUnsupported string
+	}{
+		{
+			name:          "http inferred from scheme",
+			check:         spreadsheet.Check{Name: "api", URL: "https://api.example.com/health"},
+			wantSupported: true,
+			wantProtocol:  "http",
+			wantURL:       "https://api.example.com/health",
+		},
+		{
+			name:          "bare host defaults to http",
+			check:         spreadsheet.Check{Name: "site", URL: "www.example.com"},
+			wantSupported: true,
+			wantProtocol:  "http",
+			wantURL:       "https://www.example.com",
+		},
+		{
+			name:          "port inferred from host:port",
+			check:         spreadsheet.Check{Name: "db", URL: "db.example.com:5432"},
+			wantSupported: true,
+			wantProtocol:  "port",
+			wantURL:       "https://db.example.com",
+			wantPort:      5432,
+		},
+		{
+			name:          "tcp with port column",
+			check:         spreadsheet.Check{Name: "redis", URL: "cache.example.com", Protocol: "tcp", Port: 6379},
+			wantSupported: true,
+			wantProtocol:  "port",
+			wantURL:       "https://cache.example.com",
+			wantPort:      6379,
+		},
+		{
+			name:          "ping",
+			check:         spreadsheet.Check{Name: "gw", URL: "gw.example.com", Protocol: "ping"},
+			wantSupported: true,
+			wantProtocol:  "icmp",
+			wantURL:       "https://gw.example.com",
+		},
+		{
+			name:            "port without port",
+			check:           spreadsheet.Check{Name: "svc", URL: "svc.example.com", Protocol: "port"},
+			wantUnsupported: UnsupportedInvalid,
+		},
+		{
+			name:            "dns unsupported",
+			check:           spreadsheet.Check{Name: "zone", URL: "example.com", Protocol: "dns"},
+			wantUnsupported: "dns",
+		},
+		{
+			name:            "row errors",
+			check:           spreadsheet.Check{Name: "bad", Errors: []string{"url is empty"}},
+			wantUnsupported: UnsupportedInvalid,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewCheckConverter().Convert(tt.check)
+			if result.Supported != tt.wantSupported {
+				t.Fatalf("Supported = %v, want %v (notes: %v)", result.Supported, tt.wantSupported, result.Notes)
+			}
+			if !tt.wantSupported {
+				if result.Monitor != nil {
+					t.Errorf("Monitor = %+v, want nil", result.Monitor)
+				}
+				if result.UnsupportedType != tt.wantUnsupported {
+					t.Errorf("UnsupportedType = %q, want %q", result.UnsupportedType, tt.wantUnsupported)
+				}
+				if len(result.Notes) == 0 {
+					t.Error("expected notes explaining why the row was not converted")
+				}
+				return
+			}
+			if result.Monitor.Protocol != tt.wantProtocol {
+				t.Errorf("Protocol = %q, want %q", result.Monitor.Protocol, tt.wantProtocol)
+			}
+			if result.Monitor.URL != tt.wantURL {
+				t.Errorf("URL = %q, want %q", result.Monitor.URL, tt.wantURL)
+			}
+			var port int
+			if result.Monitor.Port != nil {
+				port = *result.Monitor.Port
+			}
+			if port != tt.wantPort {
+				t.Errorf("Port = %d, want %d", port, tt.wantPort)
+			}
+		})
+	}
+}
+
+func TestConvert_HTTPFields(t *testing.T) {
+	check := spreadsheet.Check{
+		Name:           "api",
+		URL:            "https://api.example.com",
+		Method:         "HEAD",
+		ExpectedStatus: "2xx",
+		Keyword:        "ok",
+		Paused:         true,
+		Regions:        []string{"london", "us-east-1"},
+	}
+
+	m := NewCheckConverter().Convert(check).Monitor
+	if m.HTTPMethod != "HEAD" || m.ExpectedStatusCode != "2xx" || !m.Paused {
+		t.Errorf("unexpected monitor %+v", m)
+	}
+	if m.RequiredKeyword == nil || *m.RequiredKeyword != "ok" {
+		t.Errorf("RequiredKeyword = %v, want ok", m.RequiredKeyword)
+	}
+	if want := []string{"london", "virginia"}; !reflect.DeepEqual(m.Regions, want) {
+		t.Errorf("Regions = %v, want %v", m.Regions, want)
+	}
+}
+
+func TestConvert_DefaultRegions(t *testing.T) {
+	m := NewCheckConverter().Convert(spreadsheet.Check{Name: "api", URL: "https://api.example.com"}).Monitor
+	if !reflect.DeepEqual(m.Regions, migrate.DefaultRegions()) {
+		t.Errorf("Regions = %v, want %v", m.Regions, migrate.DefaultRegions())
+	}
+	if m.CheckFrequency != defaultFrequency {
+		t.Errorf("CheckFrequency = %d, want %d", m.CheckFrequency, defaultFrequency)
+	}
+}
+
+func TestConvert_Frequency(t *testing.T) {
+	check := spreadsheet.Check{ID: "7", Name: "api", URL: "https://api.example.com", Frequency: 50}
+
+	result := NewCheckConverter().Convert(check)
+	if result.Monitor.CheckFrequency != 60 {
+		t.Errorf("CheckFrequency = %d, want 60", result.Monitor.CheckFrequency)
+	}
+	if result.FrequencyAdjustment == nil || result.FrequencyAdjustment.SourceID != "7" {
+		t.Errorf("FrequencyAdjustment = %+v, want one for row 7", result.FrequencyAdjustment)
+	}
+
+	result = NewCheckConverter().WithFrequencyPolicy(migrate.FrequencyFail).Convert(check)
+	if result.Supported || result.UnsupportedType != UnsupportedFrequency {
+		t.Errorf("Supported/UnsupportedType = %v/%q, want false/%q", result.Supported, result.UnsupportedType, UnsupportedFrequency)
+	}
+}
+
+func TestConvert_Overrides(t *testing.T) {
+	overrides, err := migrate.ParseOverrides([]byte(`
+overrides:
+  "api":
+    name: Checkout API
+    regions: [frankfurt]
+    frequency: 300
+  "old":
+    skip: true
+`))
+	if err != nil {
+		t.Fatalf("ParseOverrides() error = %v", err)
+	}
+	c := NewCheckConverter().WithOverrides(overrides)
+
+	m := c.Convert(spreadsheet.Check{ID: "api", Name: "api", URL: "https://api.example.com", Frequency: 45}).Monitor
+	if m.Name != "Checkout API" || m.CheckFrequency != 300 || !reflect.DeepEqual(m.Regions, []string{"frankfurt"}) {
+		t.Errorf("override not applied: %+v", m)
+	}
+
+	result := c.Convert(spreadsheet.Check{ID: "old", Name: "old", URL: "https://old.example.com"})
+	if !result.Skipped || result.Monitor != nil {
+		t.Errorf("Skipped/Monitor = %v/%v, want true/nil", result.Skipped, result.Monitor)
+	}
+}
+
+func TestConvert_NameTemplate(t *testing.T) {
+	tmpl, err := migrate.ParseNameTemplate(`[{{.Tag "env" | upper}}] {{.Name}}`)
+	if err != nil {
+		t.Fatalf("ParseNameTemplate() error = %v", err)
+	}
+	check := spreadsheet.Check{Name: "API", URL: "https://api.example.com", Tags: []string{"env:prod"}}
+
+	m := NewCheckConverter().WithNameTemplate(tmpl).Convert(check).Monitor
+	if m.Name != "[PROD] API" {
+		t.Errorf("Name = %q, want %q", m.Name, "[PROD] API")
+	}
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/spreadsheet"
+)
+
+func intPtr(n int) *int { return &n }
+
+func TestGenerateHCL(t *testing.T) {
+	checks := []spreadsheet.Check{
+		{Row: 2, ID: "api", Name: "API", Tags: []string{"env:prod"}},
+		{Row: 3, ID: "db", Name: "DB"},
+		{Row: 4, ID: "zone", Name: "Zone", Protocol: "dns"},
+		{Row: 5, ID: "old", Name: "Old"},
+	}
+	results := []converter.ConversionResult{
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{
+			Name: "API", URL: "https://api.example.com", Protocol: "http", HTTPMethod: "GET",
+			CheckFrequency: 300, Regions: []string{"london"}, ExpectedStatusCode: "200",
+		}},
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{
+			Name: "DB", URL: "https://db.example.com", Protocol: "port", CheckFrequency: 60, Port: intPtr(5432),
+		}, Notes: []string{"frequency adjusted"}},
+		{UnsupportedType: "dns", Notes: []string{`Protocol "dns" is not supported`}},
+		{Skipped: true},
+	}
+
+	hcl := NewTerraformGenerator("").GenerateHCL(checks, results)
+
+	for _, want := range []string{
+		`resource "hyperping_monitor" "api"`,
+		`check_frequency = 300`,
+		`resource "hyperping_monitor" "db"`,
+		`= 5432`,
+		"# Tags: env:prod",
+		"# NOTE: frequency adjusted",
+		"# CSV row 4 (ID: zone)",
+		"# UNSUPPORTED: dns",
+		"# SKIPPED: skip in mapping overrides",
+	} {
+		if !strings.Contains(hcl, want) {
+			t.Errorf("HCL missing %q:\n%s", want, hcl)
+		}
+	}
+	if strings.Contains(hcl, `"zone"`) || strings.Contains(hcl, `"old"`) {
+		t.Errorf("HCL has resources for rows that were not converted:\n%s", hcl)
+	}
+}
+
+func TestGenerateHCL_NeutralizesNewlinesInComments(t *testing.T) {
+	checks := []spreadsheet.Check{{Row: 2, ID: "x", Name: "evil\nresource \"null_resource\" \"pwn\" {}"}}
+	results := []converter.ConversionResult{{UnsupportedType: "dns"}}
+
+	hcl := NewTerraformGenerator("").GenerateHCL(checks, results)
+	for _, line := range strings.Split(hcl, "\n") {
+		if strings.HasPrefix(line, "resource") {
+			t.Errorf("row name escaped its comment: %q\n%s", line, hcl)
+		}
+	}
+}
+
+func TestResourceNames(t *testing.T) {
+	monitor := func(name string) converter.ConversionResult {
+		return converter.ConversionResult{Supported: true, Monitor: &hyperping.CreateMonitorRequest{Name: name}}
+	}
+	results := []converter.ConversionResult{
+		monitor("API Health"),
+		{UnsupportedType: "dns"},
+		monitor("api-health"),
+		monitor("123"),
+	}
+
+	got := ResourceNames("csv_", results)
+	want := []string{"csv_api_health", "", "csv_api_health_2", "csv_123"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ResourceNames() = %v, want %v", got, want)
+	}
+}
+
+// TestGenerateImportScript_RejectsMaliciousInput checks that UUIDs returned
+// by the API and names read from the spreadsheet cannot inject commands into
+// the generated script.
+func TestGenerateImportScript_RejectsMaliciousInput(t *testing.T) {
+	checks := []spreadsheet.Check{
+		{Row: 2, ID: "1", Name: "evil-one"},
+		{Row: 3, ID: "2", Name: "evil-two\nrm -rf $HOME"},
+		{Row: 4, ID: "3", Name: "good"},
+		{Row: 5, ID: "4", Name: "pending"},
+	}
+	results := []converter.ConversionResult{
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{Name: "evil-one"}},
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{Name: "evil-two"}},
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{Name: "good"}},
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{Name: "pending"}},
+	}
+	createdResources := map[string]string{
+		"1": "$(rm -rf $HOME)",
+		"2": "'; rm -rf $HOME; '",
+		"3": "mon_safe_123",
+	}
+
+	out := NewImportGenerator("").GenerateImportScript(checks, results, createdResources)
+
+	for _, f := range []string{"$(rm", "'; rm", "\nrm -rf"} {
+		if strings.Contains(out, f) {
+			t.Errorf("script contains forbidden substring %q\n---\n%s\n---", f, out)
+		}
+	}
+	if !strings.Contains(out, `terraform import hyperping_monitor.good "mon_safe_123"`) {
+		t.Errorf("safe UUID was not imported; script:\n%s", out)
+	}
+	if !strings.Contains(out, "# Skipping CSV row 5") {
+		t.Errorf("uncreated row was not skipped; script:\n%s", out)
+	}
+}
+
+func TestImportAddresses(t *testing.T) {
+	checks := []spreadsheet.Check{
+		{Row: 2, ID: "1", Name: "API"},
+		{Row: 3, ID: "2", Name: "Pending"},
+		{Row: 4, ID: "3", Name: "Unsupported"},
+	}
+	results := []converter.ConversionResult{
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{Name: "API"}},
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{Name: "Pending"}},
+		{Supported: false},
+	}
+	createdResources := map[string]string{"1": "mon_api", "3": "mon_stale"}
+
+	got := NewImportGenerator("prod_").ImportAddresses(checks, results, createdResources)
+	want := map[string]string{"mon_api": "hyperping_monitor.prod_api"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("ImportAddresses() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,100 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/spreadsheet"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+// ImportGenerator generates Terraform import scripts.
+type ImportGenerator struct {
+	prefix string
+}
+
+// NewImportGenerator creates a new ImportGenerator.
+func NewImportGenerator(prefix string) *ImportGenerator {
+	return &ImportGenerator{
+		prefix: prefix,
+	}
+}
+
+// GenerateImportScript generates a shell script that imports the monitors
+// created for each row, keyed by row ID in createdResources.
+func (g *ImportGenerator) GenerateImportScript(checks []spreadsheet.Check, results []converter.ConversionResult, createdResources map[string]string) string {
+	var sb strings.Builder
+
+	sb.WriteString("#!/bin/bash\n")
+	sb.WriteString("# Generated Terraform import script for CSV -> Hyperping migration\n")
+	sb.WriteString("# Run this after applying the Terraform configuration\n\n")
+	sb.WriteString("set -e\n\n")
+
+	sb.WriteString("echo \"Importing Hyperping resources into Terraform state...\"\n")
+	sb.WriteString("echo \"\"\n\n")
+
+	names := ResourceNames(g.prefix, results)
+	importCount := 0
+	for i, check := range checks {
+		if names[i] == "" {
+			continue
+		}
+
+		uuid, ok := createdResources[check.ID]
+		if !ok {
+			fmt.Fprintf(&sb, "# Skipping CSV row %d (not yet created in Hyperping)\n", check.Row)
+			continue
+		}
+
+		fmt.Fprintf(&sb, "# CSV row %d: %s\n", check.Row, migrate.EscapeShell(check.Name))
+		fmt.Fprintf(&sb, "echo \"Importing hyperping_monitor.%s...\"\n", names[i])
+		// UUID flows through migrate.QuoteShellUUID for defense in depth;
+		// %q does not escape bash metacharacters.
+		fmt.Fprintf(&sb, "terraform import hyperping_monitor.%s %s || echo \"Warning: Import failed for %s\"\n", names[i], migrate.QuoteShellUUID(uuid), names[i])
+		sb.WriteString("echo \"\"\n\n")
+		importCount++
+	}
+
+	fmt.Fprintf(&sb, "echo \"Import complete! Imported %d resources.\"\n", importCount)
+	sb.WriteString("echo \"Run 'terraform plan' to verify the state matches your configuration.\"\n")
+
+	return sb.String()
+}
+
+// ImportAddresses returns the Terraform address GenerateImportScript imports
+// each created monitor to, keyed by monitor UUID.
+func (g *ImportGenerator) ImportAddresses(checks []spreadsheet.Check, results []converter.ConversionResult, createdResources map[string]string) map[string]string {
+	names := ResourceNames(g.prefix, results)
+	addresses := make(map[string]string)
+	for i, check := range checks {
+		if uuid, ok := createdResources[check.ID]; ok && names[i] != "" {
+			addresses[uuid] = "hyperping_monitor." + names[i]
+		}
+	}
+	return addresses
+}
+
+// MappingEntries returns a mapping entry for every converted row, with the
+// UUID of the monitor created for it, if any.
+func (g *ImportGenerator) MappingEntries(checks []spreadsheet.Check, results []converter.ConversionResult, createdResources map[string]string) []mapping.Entry {
+	names := ResourceNames(g.prefix, results)
+	var entries []mapping.Entry
+	for i, check := range checks {
+		if names[i] == "" {
+			continue
+		}
+		entries = append(entries, mapping.Entry{
+			SourceID:     check.ID,
+			ResourceType: mapping.ResourceTypeMonitor,
+			Name:         results[i].Monitor.Name,
+			Address:      "hyperping_monitor." + names[i],
+			UUID:         createdResources[check.ID],
+		})
+	}
+	return entries
+}
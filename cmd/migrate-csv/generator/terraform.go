@@ -0,0 +1,128 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/spreadsheet"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+// TerraformGenerator generates Terraform HCL configuration.
+type TerraformGenerator struct {
+	prefix     string
+	compatMode compat.Mode
+}
+
+// NewTerraformGenerator creates a new TerraformGenerator.
+func NewTerraformGenerator(prefix string) *TerraformGenerator {
+	return &TerraformGenerator{
+		prefix: prefix,
+	}
+}
+
+// WithCompatMode sets the compatibility mode. With compat.IgnoreChanges,
+// monitors get lifecycle ignore_changes for the attributes the API does not
+// return faithfully.
+func (g *TerraformGenerator) WithCompatMode(mode compat.Mode) *TerraformGenerator {
+	g.compatMode = mode
+	return g
+}
+
+// GenerateHCL generates Terraform HCL for converted rows.
+func (g *TerraformGenerator) GenerateHCL(checks []spreadsheet.Check, results []converter.ConversionResult) string {
+	f := hclgen.NewFile()
+	root := f.Body()
+
+	root.Comment("Generated from CSV")
+	root.Comment("Review and adjust as needed before applying")
+	root.Newline()
+
+	names := ResourceNames(g.prefix, results)
+	for i, check := range checks {
+		result := results[i]
+
+		root.Comment("CSV row %d (ID: %s)", check.Row, check.ID)
+		root.Comment("Original Name: %s", check.Name)
+		if len(check.Tags) > 0 {
+			root.Comment("Tags: %s", strings.Join(check.Tags, ", "))
+		}
+
+		if result.Skipped {
+			root.Comment("SKIPPED: skip in mapping overrides")
+			root.Newline()
+			continue
+		}
+
+		if !result.Supported || result.Monitor == nil {
+			root.Comment("UNSUPPORTED: %s", result.UnsupportedType)
+			for _, note := range result.Notes {
+				root.Comment("NOTE: %s", note)
+			}
+			root.Newline()
+			continue
+		}
+
+		body := g.generateMonitorHCL(root, names[i], result.Monitor)
+		for _, note := range result.Notes {
+			body.Comment("NOTE: %s", note)
+		}
+		compat.WriteLifecycle(body, g.compatMode, compat.MonitorFields(body, result.Monitor.Protocol))
+		root.Newline()
+	}
+
+	return f.String()
+}
+
+func (g *TerraformGenerator) generateMonitorHCL(root *hclgen.Body, name string, monitor *hyperping.CreateMonitorRequest) *hclgen.Body {
+	r := root.Block("resource", "hyperping_monitor", name)
+	r.SetString("name", monitor.Name)
+	r.SetString("url", monitor.URL)
+	r.SetString("protocol", monitor.Protocol)
+
+	if monitor.HTTPMethod != "" && monitor.HTTPMethod != "GET" {
+		r.SetString("http_method", monitor.HTTPMethod)
+	}
+	if monitor.CheckFrequency != 60 {
+		r.SetInt("check_frequency", monitor.CheckFrequency)
+	}
+	if len(monitor.Regions) > 0 {
+		r.SetStringList("regions", monitor.Regions)
+	}
+	if monitor.Port != nil && *monitor.Port != 0 {
+		r.SetInt("port", *monitor.Port)
+	}
+	if monitor.ExpectedStatusCode != "" && monitor.ExpectedStatusCode != "200" {
+		r.SetString("expected_status_code", monitor.ExpectedStatusCode)
+	}
+	if monitor.RequiredKeyword != nil && *monitor.RequiredKeyword != "" {
+		r.SetString("required_keyword", *monitor.RequiredKeyword)
+	}
+	if monitor.Paused {
+		r.SetBool("paused", true)
+	}
+
+	return r
+}
+
+// ResourceNames returns the Terraform resource name of each converted
+// monitor, derived from its name and made unique, or "" for results without
+// a monitor.
+func ResourceNames(prefix string, results []converter.ConversionResult) []string {
+	names := make([]string, len(results))
+	seen := make(map[string]int)
+	for i, result := range results {
+		if result.Skipped || !result.Supported || result.Monitor == nil {
+			continue
+		}
+		names[i] = migrate.DeduplicateResourceName(migrate.SanitizeResourceName(prefix+result.Monitor.Name), seen)
+	}
+	return names
+}
@@ -0,0 +1,673 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// migrate-csv migrates a monitor inventory kept in a spreadsheet to
+// Hyperping monitors.
+//
+// Usage:
+//
+//	export HYPERPING_API_KEY="sk_your_hyperping_key"
+//	go run ./cmd/migrate-csv --input=monitors.csv --output=./migration-output
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/generator"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/report"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/spreadsheet"
+	"github.com/develeap/terraform-provider-hyperping/pkg/checkpoint"
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrationstate"
+	"github.com/develeap/terraform-provider-hyperping/pkg/recovery"
+)
+
+var (
+	inputFile           = flag.String("input", "", "CSV file to migrate, or - for stdin (required)")
+	columnsFlag         = flag.String("columns", "", "Map fields to CSV headers, e.g. name=Service,url=Endpoint (fields: id, name, url, protocol, frequency, regions, port, method, expected_status, keyword, tags, paused)")
+	delimiterFlag       = flag.String("delimiter", ",", "CSV field delimiter: a single character, or tab")
+	hyperpingAPIKey     = flag.String("hyperping-api-key", "", "Hyperping API key (or set HYPERPING_API_KEY)")
+	outputDir           = flag.String("output", "./csv-migration", "Output directory for generated files")
+	prefix              = flag.String("prefix", "", "Prefix for Terraform resource names")
+	hyperpingBaseURL    = flag.String("hyperping-base-url", "https://api.hyperping.io", "Hyperping API base URL")
+	dryRun              = flag.Bool("dry-run", false, "Generate configs without creating resources in Hyperping")
+	verbose             = flag.Bool("verbose", false, "Verbose output")
+	rollback            = flag.Bool("rollback", false, "Rollback migration (delete Hyperping resources)")
+	rollbackID          = flag.String("rollback-id", "", "Rollback specific migration ID")
+	rollbackForce       = flag.Bool("force", false, "Force rollback without confirmation")
+	listCheckpointsFlag = flag.Bool("list-checkpoints", false, "List available checkpoints")
+	verifyMode          = flag.Bool("verify", false, "Compare CSV rows with existing Hyperping monitors and write verification-report.json")
+	nameTemplateFlag    = flag.String("name-template", "", "Go template for Hyperping names instead of the name column (fields: .Name, .Tags)")
+	overridesFlag       = flag.String("overrides", "", "YAML mapping override file: per row ID, name, regions, frequency, or skip")
+	regionMapFlag       = flag.String("region-map", "", "YAML file mapping the regions column to Hyperping regions, overriding the built-in aliases")
+	frequencyPolicyFlag = flag.String("frequency-policy", string(migrate.FrequencyNearest), "How to snap frequencies Hyperping does not support: nearest, round-up, round-down, or fail")
+	logFlags            = recovery.RegisterLogFlags(flag.CommandLine)
+	checkWriteAccess    = recovery.RegisterWriteCheckFlag(flag.CommandLine)
+	listFlags           = migrationstate.RegisterListFlags(flag.CommandLine, toolName)
+	rollbackFlags       = migrationstate.RegisterRollbackFlags(flag.CommandLine)
+	webhookFlags        = migrationstate.RegisterWebhookFlags(flag.CommandLine)
+	outputDialectFlag   = dialect.RegisterFlag(flag.CommandLine)
+	compatModeFlag      = compat.RegisterFlag(flag.CommandLine)
+	mappingFlag         = mapping.RegisterFlag(flag.CommandLine, "")
+
+	// readOptions is built from --columns and --delimiter in run.
+	readOptions spreadsheet.Options
+	// nameTemplate is parsed from --name-template in run; nil keeps the name column.
+	nameTemplate *migrate.NameTemplate
+	// overrides is loaded from --overrides in run; nil applies none.
+	overrides *migrate.Overrides
+	// regionMap is loaded from --region-map in run; nil applies none.
+	regionMap *migrate.RegionMap
+	// frequencyPolicy is parsed from --frequency-policy in run.
+	frequencyPolicy migrate.FrequencyPolicy
+	// outputDialect is parsed from --output-dialect in run.
+	outputDialect dialect.Dialect
+	// compatMode is parsed from --compat-mode in run.
+	compatMode compat.Mode
+	// notifier is built from --webhook-url in run; nil sends no webhooks.
+	notifier *migrationstate.Notifier
+)
+
+// csvRunner holds resolved configuration for a run.
+type csvRunner struct {
+	hyperpingKey string
+	ctx          context.Context
+	cancel       context.CancelFunc
+	state        *migrationstate.State
+	migrationID  string
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: migrate-csv --input=<file> [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Migrates a monitor inventory kept in a spreadsheet (exported as CSV) to Hyperping monitors.\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nExamples:\n")
+		fmt.Fprintf(os.Stderr, "  # Dry run (generate configs only)\n")
+		fmt.Fprintf(os.Stderr, "  migrate-csv --input=monitors.csv --dry-run --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Full migration\n")
+		fmt.Fprintf(os.Stderr, "  migrate-csv --input=monitors.csv --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Map spreadsheet headers that are not recognized by name\n")
+		fmt.Fprintf(os.Stderr, "  migrate-csv --input=monitors.csv --columns='name=Service,url=Health URL,frequency=Every'\n\n")
+		fmt.Fprintf(os.Stderr, "  # Read a tab-separated export from stdin\n")
+		fmt.Fprintf(os.Stderr, "  migrate-csv --input=- --delimiter=tab < monitors.tsv\n\n")
+		fmt.Fprintf(os.Stderr, "  # Prefix names with the env:<value> tag\n")
+		fmt.Fprintf(os.Stderr, "  migrate-csv --input=monitors.csv --name-template='[{{.Tag \"env\" | upper}}] {{.Name}}'\n\n")
+		fmt.Fprintf(os.Stderr, "  # Correct names, regions, or frequencies per row ID, or skip rows\n")
+		fmt.Fprintf(os.Stderr, "  migrate-csv --input=monitors.csv --overrides=overrides.yaml\n\n")
+		fmt.Fprintf(os.Stderr, "  # Verify migrated monitors\n")
+		fmt.Fprintf(os.Stderr, "  migrate-csv --input=monitors.csv --verify --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Rollback migration\n")
+		fmt.Fprintf(os.Stderr, "  migrate-csv --rollback --rollback-id=csv-20260213-120000\n\n")
+		fmt.Fprintf(os.Stderr, "  # Rollback and also remove the imported state entries and generated files\n")
+		fmt.Fprintf(os.Stderr, "  migrate-csv --rollback --rollback-state-dir=./infra --rollback-files=remove\n\n")
+	}
+
+	os.Exit(run())
+}
+
+func run() int {
+	flag.Parse()
+
+	if err := clientenv.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var err error
+	readOptions.Columns, err = spreadsheet.ParseColumnMap(*columnsFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	readOptions.Delimiter, err = spreadsheet.ParseDelimiter(*delimiterFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	nameTemplate, err = migrate.ParseNameTemplate(*nameTemplateFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	overrides, err = migrate.LoadOverrides(*overridesFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	regionMap, err = migrate.LoadRegionMap(*regionMapFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	frequencyPolicy, err = migrate.ParseFrequencyPolicy(*frequencyPolicyFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	outputDialect, err = dialect.ParseDialect(*outputDialectFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	compatMode, err = compat.ParseMode(*compatModeFlag)
+	if err == nil {
+		err = compatMode.CheckDialect(outputDialect)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	notifier, err = webhookFlags.Notifier()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if *listCheckpointsFlag {
+		return migrationstate.ListCheckpoints(listFlags.Options())
+	}
+
+	if *rollback {
+		return handleRollback()
+	}
+
+	r, exitCode := newCSVRunner()
+	if exitCode != 0 {
+		return exitCode
+	}
+	defer r.cancel()
+
+	if *verifyMode {
+		return r.runVerification()
+	}
+
+	if *dryRun {
+		if exitCode := r.runDryValidation(); exitCode != 0 {
+			return r.fail(exitCode)
+		}
+	}
+
+	checks, results, exitCode := r.readAndConvert()
+	if exitCode != 0 {
+		return r.fail(exitCode)
+	}
+
+	reporter := report.NewReporter()
+	migrationReport := reporter.GenerateReport(checks, results)
+
+	if exitCode := r.writeReports(reporter, migrationReport); exitCode != 0 {
+		return r.fail(exitCode)
+	}
+
+	createdResources := r.createHyperpingResources(checks, results)
+	if r.state != nil && !*dryRun {
+		r.state.Notify(migrationstate.PhaseResourcesCreated, fmt.Sprintf("created %d monitors in Hyperping", len(createdResources)))
+	}
+
+	if exitCode := r.writeImportScript(checks, results, createdResources); exitCode != 0 {
+		return r.fail(exitCode)
+	}
+
+	if exitCode := r.writeMapping(checks, results, createdResources); exitCode != 0 {
+		return r.fail(exitCode)
+	}
+
+	if r.state != nil {
+		hasFailures := r.state.Checkpoint.Failed > 0
+		r.state.Finalize(!hasFailures)
+		if failureReport := r.state.GetFailureReport(); failureReport != "" {
+			fmt.Fprintln(os.Stderr, failureReport)
+		}
+	}
+
+	printRunSummary(migrationReport)
+	return 0
+}
+
+// handleRollback resolves the migration ID and delegates to the shared rollback implementation.
+func handleRollback() int {
+	hpKey := *hyperpingAPIKey
+	if hpKey == "" {
+		hpKey = os.Getenv("HYPERPING_API_KEY")
+	}
+	if hpKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: Hyperping API key is required for rollback")
+		fmt.Fprintln(os.Stderr, "Set --hyperping-api-key flag or HYPERPING_API_KEY environment variable")
+		return 1
+	}
+
+	logger, err := recovery.NewLoggerWithOptions(*verbose, logFlags.Options())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to create logger: %v\n", err)
+		return 1
+	}
+	defer logger.Close()
+
+	migID := *rollbackID
+	if migID == "" {
+		mgr, mgrErr := checkpoint.NewManager()
+		if mgrErr != nil {
+			fmt.Fprintf(os.Stderr, "Error: Failed to create checkpoint manager: %v\n", mgrErr)
+			return 1
+		}
+		latest, latestErr := mgr.FindLatest(toolName)
+		if latestErr != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", latestErr)
+			fmt.Fprintln(os.Stderr, "Use --rollback-id to specify a checkpoint or --list-checkpoints to see available checkpoints")
+			return 1
+		}
+		migID = latest.MigrationID
+	}
+
+	return migrationstate.PerformRollback(migID, hpKey, *rollbackForce, rollbackOptions(), logger)
+}
+
+// newCSVRunner validates flags, resolves the API key, sets up the context, and initialises state.
+func newCSVRunner() (*csvRunner, int) {
+	if *inputFile == "" {
+		fmt.Fprintln(os.Stderr, "Error: --input is required (a CSV file, or - for stdin)")
+		return nil, 1
+	}
+
+	hyperpingKey := *hyperpingAPIKey
+	if hyperpingKey == "" {
+		hyperpingKey = os.Getenv("HYPERPING_API_KEY")
+	}
+
+	if hyperpingKey == "" && (!*dryRun || *verifyMode) {
+		fmt.Fprintln(os.Stderr, "Error: Hyperping API key is required (--hyperping-api-key or HYPERPING_API_KEY)")
+		fmt.Fprintln(os.Stderr, "Hint: Use --dry-run to generate configs without creating resources")
+		return nil, 1
+	}
+
+	if err := os.MkdirAll(*outputDir, 0o700); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating output directory: %v\n", err)
+		return nil, 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+
+	r := &csvRunner{
+		hyperpingKey: hyperpingKey,
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+
+	// Verification is read-only and does not create a checkpoint.
+	if *verifyMode {
+		return r, 0
+	}
+
+	if err := r.initState(); err != nil {
+		cancel()
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return nil, 1
+	}
+
+	return r, 0
+}
+
+// initState creates the migration state that records created monitors for
+// --rollback.
+func (r *csvRunner) initState() error {
+	logger, err := recovery.NewLoggerWithOptions(*verbose, logFlags.Options())
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	migID := checkpoint.GenerateMigrationID(toolName)
+	// totalResources will be updated after reading the CSV; use 0 as placeholder
+	state, stateErr := migrationstate.New(toolName, migID, 0, logger)
+	if stateErr != nil {
+		_ = logger.Close() //nolint:errcheck // #nosec G104 -- best-effort cleanup before returning error
+		return fmt.Errorf("failed to create migration state: %w", stateErr)
+	}
+	r.state = state
+	r.migrationID = migID
+	return nil
+}
+
+// runDryValidation validates the Hyperping API key in dry-run mode. The
+// source is a file, so only the destination has a key to check.
+func (r *csvRunner) runDryValidation() int {
+	source := recovery.SkippedValidation("CSV", "file input, no API")
+
+	destination := recovery.SkippedValidation(recovery.DestinationServiceName, "no API key")
+	if r.hyperpingKey != "" {
+		validator := recovery.NewAPIValidator(r.state.Logger)
+		destination = validator.ValidateDestinationAPI(r.ctx, createHyperpingClient(r.hyperpingKey), *checkWriteAccess)
+	}
+
+	if !recovery.PrintValidationSummary(os.Stderr, source, destination) {
+		return 1
+	}
+	return 0
+}
+
+// readAndConvert reads the CSV rows and converts them to Hyperping format.
+func (r *csvRunner) readAndConvert() ([]spreadsheet.Check, []converter.ConversionResult, int) {
+	checks, err := readChecks()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading CSV: %v\n", err)
+		return nil, nil, 1
+	}
+
+	if r.state != nil {
+		r.state.Checkpoint.TotalResources = len(checks)
+		if !*dryRun {
+			r.state.SetNotifier(notifier)
+			r.state.Notify(migrationstate.PhaseStarted, "")
+		}
+	}
+
+	warnUnknownOverrides(checks)
+
+	log("Converting rows to Hyperping format...")
+	checkConverter := newCheckConverter().WithFrequencyPolicy(frequencyPolicy)
+	results := make([]converter.ConversionResult, len(checks))
+	supportedCount := 0
+	skippedCount := 0
+	for i, check := range checks {
+		results[i] = checkConverter.Convert(check)
+		if results[i].Supported {
+			supportedCount++
+		}
+		if results[i].Skipped {
+			skippedCount++
+		}
+
+		if r.state != nil {
+			rowID := "row-" + check.ID
+			if results[i].Supported || results[i].Skipped {
+				r.state.MarkResourceProcessed(rowID)
+			} else {
+				r.state.MarkResourceFailed(rowID, "row", check.Name, failureReason(results[i]))
+			}
+		}
+	}
+	log(fmt.Sprintf("Converted %d/%d rows (%d unsupported, %d skipped)", supportedCount, len(checks), len(checks)-supportedCount-skippedCount, skippedCount))
+
+	if r.state != nil {
+		r.state.SaveCheckpoint()
+	}
+
+	log("Generating Terraform configuration...")
+	tfGen := generator.NewTerraformGenerator(*prefix).WithCompatMode(compatMode)
+	hclContent := tfGen.GenerateHCL(checks, results)
+
+	hclPath := filepath.Join(*outputDir, "monitors.tf")
+	paths, writeErr := dialect.WriteConfig(outputDialect, []byte(hclContent), hclPath)
+	if writeErr != nil {
+		fmt.Fprintf(os.Stderr, "Error writing Terraform configuration: %v\n", writeErr)
+		return nil, nil, 1
+	}
+	for _, path := range paths {
+		log(fmt.Sprintf("Terraform configuration written to %s", path))
+	}
+	if r.state != nil {
+		r.state.AddGeneratedFiles(paths...)
+	}
+
+	return checks, results, 0
+}
+
+// readChecks reads the rows of the --input file.
+func readChecks() ([]spreadsheet.Check, error) {
+	log(fmt.Sprintf("Reading rows from %s...", *inputFile))
+	checks, err := spreadsheet.ReadFile(*inputFile, readOptions)
+	if err != nil {
+		return nil, err
+	}
+	if len(checks) == 0 {
+		return nil, fmt.Errorf("%s has a header but no rows", *inputFile)
+	}
+	log(fmt.Sprintf("Read %d rows", len(checks)))
+	return checks, nil
+}
+
+// newCheckConverter returns a converter configured from the flags shared by
+// migration and verification.
+func newCheckConverter() *converter.CheckConverter {
+	return converter.NewCheckConverter().WithNameTemplate(nameTemplate).WithOverrides(overrides).WithRegionMap(regionMap)
+}
+
+// failureReason describes why a row was not converted, for the checkpoint.
+func failureReason(result converter.ConversionResult) string {
+	switch result.UnsupportedType {
+	case converter.UnsupportedInvalid:
+		return "invalid row"
+	case converter.UnsupportedFrequency:
+		return "unsupported check frequency"
+	default:
+		return "unsupported protocol"
+	}
+}
+
+// warnUnknownOverrides warns about mapping overrides whose ID matches no
+// row, which usually means a typo.
+func warnUnknownOverrides(checks []spreadsheet.Check) {
+	ids := make([]string, len(checks))
+	for i, check := range checks {
+		ids[i] = check.ID
+	}
+	for _, id := range overrides.Unknown(ids) {
+		fmt.Fprintf(os.Stderr, "Warning: mapping override %q matches no CSV row\n", id)
+	}
+}
+
+// writeReports generates and writes all report files.
+func (r *csvRunner) writeReports(reporter *report.Reporter, migrationReport *report.MigrationReport) int {
+	log("Generating migration report...")
+
+	jsonReport, err := reporter.GenerateJSONReport(migrationReport)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating JSON report: %v\n", err)
+		return 1
+	}
+	jsonPath := filepath.Join(*outputDir, "report.json")
+	if writeErr := os.WriteFile(jsonPath, []byte(jsonReport), 0o600); writeErr != nil {
+		fmt.Fprintf(os.Stderr, "Error writing JSON report: %v\n", writeErr)
+		return 1
+	}
+
+	textReport := reporter.GenerateTextReport(migrationReport)
+	textPath := filepath.Join(*outputDir, "report.txt")
+	if writeErr := os.WriteFile(textPath, []byte(textReport), 0o600); writeErr != nil {
+		fmt.Fprintf(os.Stderr, "Error writing text report: %v\n", writeErr)
+		return 1
+	}
+
+	manualSteps := reporter.GenerateManualStepsMarkdown(migrationReport)
+	manualPath := filepath.Join(*outputDir, "manual-steps.md")
+	if writeErr := os.WriteFile(manualPath, []byte(manualSteps), 0o600); writeErr != nil {
+		fmt.Fprintf(os.Stderr, "Error writing manual steps: %v\n", writeErr)
+		return 1
+	}
+	if r.state != nil {
+		r.state.AddGeneratedFiles(jsonPath, textPath, manualPath)
+	}
+
+	log(fmt.Sprintf("Reports written to %s", *outputDir))
+	return 0
+}
+
+// createHyperpingResources creates monitors in Hyperping (skipped in dry-run
+// mode) and returns their UUIDs keyed by row ID.
+func (r *csvRunner) createHyperpingResources(checks []spreadsheet.Check, results []converter.ConversionResult) map[string]string {
+	createdResources := make(map[string]string)
+	if *dryRun {
+		return createdResources
+	}
+
+	log("Creating monitors in Hyperping...")
+	hyperpingClient := createHyperpingClient(r.hyperpingKey)
+	createdCount := 0
+	errorCount := 0
+
+	for i, check := range checks {
+		result := results[i]
+		if !result.Supported || result.Monitor == nil {
+			continue
+		}
+
+		monitor, err := hyperpingClient.CreateMonitor(r.ctx, *result.Monitor)
+		if err != nil {
+			errorCount++
+			fmt.Fprintf(os.Stderr, "Warning: Failed to create monitor for row %d (%s): %v\n", check.Row, check.Name, err)
+			continue
+		}
+
+		createdResources[check.ID] = monitor.UUID
+		if r.state != nil {
+			r.state.AddHyperpingResource(monitor.UUID, "monitor")
+		}
+		createdCount++
+
+		if *verbose {
+			log(fmt.Sprintf("Created monitor %s for row %d (%s)", monitor.UUID, check.Row, check.Name))
+		}
+	}
+
+	log(fmt.Sprintf("Created %d monitors in Hyperping (%d errors)", createdCount, errorCount))
+	return createdResources
+}
+
+// fail saves the checkpoint as failed, which also reports the failure to the
+// webhook, and returns exitCode.
+func (r *csvRunner) fail(exitCode int) int {
+	if r.state != nil {
+		r.state.Finalize(false)
+	}
+	return exitCode
+}
+
+// writeImportScript generates and writes the import shell script.
+func (r *csvRunner) writeImportScript(checks []spreadsheet.Check, results []converter.ConversionResult, createdResources map[string]string) int {
+	log("Generating import script...")
+	importGen := generator.NewImportGenerator(*prefix)
+	importScriptContent := importGen.GenerateImportScript(checks, results, createdResources)
+
+	importPath := filepath.Join(*outputDir, "import.sh")
+	if writeErr := os.WriteFile(importPath, []byte(importScriptContent), 0o700); writeErr != nil { // #nosec G306 -- import.sh must be executable (0700)
+		fmt.Fprintf(os.Stderr, "Error writing import script: %v\n", writeErr)
+		return 1
+	}
+	if r.state != nil {
+		r.state.AddGeneratedFiles(importPath)
+		for uuid, address := range importGen.ImportAddresses(checks, results, createdResources) {
+			r.state.SetResourceAddress(uuid, address)
+		}
+	}
+
+	log(fmt.Sprintf("Import script written to %s", importPath))
+	return 0
+}
+
+// writeMapping writes the mapping from CSV IDs to Hyperping UUIDs and
+// Terraform addresses. It is not recorded for --rollback-files, so it still
+// traces the resources to their origin after a rollback.
+func (r *csvRunner) writeMapping(checks []spreadsheet.Check, results []converter.ConversionResult, createdResources map[string]string) int {
+	idMap := mapping.New("CSV", toolName, r.migrationID)
+	for _, entry := range generator.NewImportGenerator(*prefix).MappingEntries(checks, results, createdResources) {
+		idMap.Add(entry)
+	}
+
+	path := mappingPath()
+	if err := idMap.Write(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing mapping: %v\n", err)
+		return 1
+	}
+	log(fmt.Sprintf("Mapping written to %s", path))
+	return 0
+}
+
+// mappingPath returns the --mapping file, which defaults to mapping.json in
+// the output directory.
+func mappingPath() string {
+	if *mappingFlag != "" {
+		return *mappingFlag
+	}
+	return filepath.Join(*outputDir, mapping.DefaultFileName)
+}
+
+// rollbackOptions returns the rollback options, including the mapping file
+// of the migration.
+func rollbackOptions() migrationstate.RollbackOptions {
+	opts := rollbackFlags.Options()
+	opts.MappingFile = mappingPath()
+	return opts
+}
+
+// printRunSummary prints the final migration summary and next steps.
+func printRunSummary(migrationReport *report.MigrationReport) {
+	fmt.Println()
+	fmt.Println("=================================================================")
+	fmt.Println("Migration Complete!")
+	fmt.Println("=================================================================")
+	fmt.Println()
+	fmt.Printf("Output directory: %s\n", *outputDir)
+	fmt.Println()
+	fmt.Println("Generated files:")
+	for _, name := range dialect.FileNames(outputDialect, "monitors.tf") {
+		fmt.Printf("  - %s (%s configuration)\n", name, outputDialect)
+	}
+	fmt.Println("  - import.sh (import script)")
+	fmt.Println("  - report.json (JSON report)")
+	fmt.Println("  - report.txt (text report)")
+	fmt.Println("  - manual-steps.md (manual steps)")
+	fmt.Printf("  - %s (source ID mapping)\n", mappingPath())
+	fmt.Println()
+
+	if *dryRun {
+		fmt.Println("DRY RUN: No resources were created in Hyperping")
+		fmt.Println("Review the generated files and run without --dry-run to create resources")
+	} else {
+		fmt.Println("Next steps:")
+		fmt.Println("  1. Review monitors.tf and adjust as needed")
+		fmt.Println("  2. Run 'terraform init' and 'terraform plan'")
+		fmt.Println("  3. Run './import.sh' to import resources into Terraform state")
+		fmt.Println("  4. Review manual-steps.md for rows that were not converted")
+	}
+
+	fmt.Println()
+	fmt.Printf("Summary: %d total rows, %d supported, %d unsupported\n",
+		migrationReport.TotalRows,
+		migrationReport.SupportedRows,
+		migrationReport.UnsupportedRows)
+
+	if len(migrationReport.ManualSteps) > 0 {
+		fmt.Printf("Manual steps required: %d (see manual-steps.md)\n", len(migrationReport.ManualSteps))
+	}
+}
+
+func createHyperpingClient(apiKey string) *hyperping.Client {
+	return clientenv.NewClient(apiKey, hyperping.WithBaseURL(*hyperpingBaseURL))
+}
+
+func log(msg string) {
+	if *verbose {
+		fmt.Fprintf(os.Stderr, "[migrate-csv] %s\n", msg)
+	}
+}
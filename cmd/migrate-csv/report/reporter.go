@@ -0,0 +1,243 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/spreadsheet"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+// MigrationReport contains the complete migration report.
+type MigrationReport struct {
+	Timestamp        time.Time      `json:"timestamp"`
+	TotalRows        int            `json:"total_rows"`
+	SupportedRows    int            `json:"supported_rows"`
+	UnsupportedRows  int            `json:"unsupported_rows"`
+	SkippedRows      int            `json:"skipped_rows"`
+	RowsByProtocol   map[string]int `json:"rows_by_protocol"`
+	UnsupportedTypes map[string]int `json:"unsupported_types"`
+	ManualSteps      []ManualStep   `json:"manual_steps"`
+	Warnings         []string       `json:"warnings"`
+	// FrequencyAdjustments lists every row frequency that was snapped to a
+	// supported Hyperping check frequency.
+	FrequencyAdjustments []migrate.FrequencyAdjustment `json:"frequency_adjustments"`
+	Estimate             *migrate.Estimate             `json:"estimate"`
+}
+
+// ManualStep represents a manual action required.
+type ManualStep struct {
+	Row         int    `json:"row"`
+	ID          string `json:"id"`
+	Name        string `json:"name"`
+	Protocol    string `json:"protocol"`
+	Description string `json:"description"`
+	Action      string `json:"action"`
+}
+
+// Reporter generates migration reports.
+type Reporter struct{}
+
+// NewReporter creates a new Reporter.
+func NewReporter() *Reporter {
+	return &Reporter{}
+}
+
+// GenerateReport generates a comprehensive migration report.
+func (r *Reporter) GenerateReport(checks []spreadsheet.Check, results []converter.ConversionResult) *MigrationReport {
+	report := &MigrationReport{
+		Timestamp:            time.Now(),
+		TotalRows:            len(checks),
+		RowsByProtocol:       make(map[string]int),
+		UnsupportedTypes:     make(map[string]int),
+		ManualSteps:          []ManualStep{},
+		Warnings:             []string{},
+		FrequencyAdjustments: []migrate.FrequencyAdjustment{},
+	}
+
+	var loads []migrate.MonitorLoad
+	for i, check := range checks {
+		result := results[i]
+
+		switch {
+		case result.Skipped:
+			report.SkippedRows++
+		case result.Supported && result.Monitor != nil:
+			report.SupportedRows++
+			report.RowsByProtocol[result.Monitor.Protocol]++
+			loads = append(loads, migrate.MonitorLoad{
+				CheckFrequency: result.Monitor.CheckFrequency,
+				Regions:        len(result.Monitor.Regions),
+				Paused:         result.Monitor.Paused,
+			})
+			if result.FrequencyAdjustment != nil {
+				report.FrequencyAdjustments = append(report.FrequencyAdjustments, *result.FrequencyAdjustment)
+			}
+			for _, note := range result.Notes {
+				report.Warnings = append(report.Warnings, fmt.Sprintf("Row %d (%s): %s", check.Row, check.Name, note))
+			}
+		default:
+			report.UnsupportedRows++
+			report.UnsupportedTypes[result.UnsupportedType]++
+			report.ManualSteps = append(report.ManualSteps, r.generateManualStep(check, result))
+		}
+	}
+	report.Estimate = migrate.NewEstimate(loads, 0)
+
+	return report
+}
+
+func (r *Reporter) generateManualStep(check spreadsheet.Check, result converter.ConversionResult) ManualStep {
+	step := ManualStep{
+		Row:      check.Row,
+		ID:       check.ID,
+		Name:     check.Name,
+		Protocol: check.Protocol,
+	}
+
+	switch result.UnsupportedType {
+	case converter.UnsupportedInvalid:
+		step.Description = "Row has invalid values: " + strings.Join(result.Notes, "; ")
+		step.Action = "Fix the row in the spreadsheet and rerun the migration, " +
+			"or skip it in the --overrides file"
+	case converter.UnsupportedFrequency:
+		step.Description = fmt.Sprintf("Frequency of %d second(s) is not a supported Hyperping frequency (--frequency-policy=fail)", check.Frequency)
+		step.Action = "Option 1: Set a supported frequency for this row in the --overrides file\n" +
+			"Option 2: Rerun with --frequency-policy=round-up, round-down, or nearest"
+	default:
+		step.Description = fmt.Sprintf("Protocol '%s' is not supported by the CSV migration", check.Protocol)
+		step.Action = "Change the protocol to http, port, or icmp, or create the monitor by hand. " +
+			"DNS monitors need a record type: create them as hyperping_monitor resources with protocol = \"dns\"."
+	}
+
+	return step
+}
+
+// GenerateJSONReport generates a JSON report.
+func (r *Reporter) GenerateJSONReport(report *MigrationReport) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling report: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// GenerateTextReport generates a human-readable text report.
+func (r *Reporter) GenerateTextReport(report *MigrationReport) string {
+	var sb strings.Builder
+
+	sb.WriteString("=================================================================\n")
+	sb.WriteString("CSV to Hyperping Migration Report\n")
+	sb.WriteString("=================================================================\n\n")
+
+	fmt.Fprintf(&sb, "Generated: %s\n\n", report.Timestamp.Format(time.RFC3339))
+
+	sb.WriteString("Summary\n")
+	sb.WriteString("-------\n")
+	fmt.Fprintf(&sb, "Total Rows:         %d\n", report.TotalRows)
+	fmt.Fprintf(&sb, "Supported:          %d (%.1f%%)\n", report.SupportedRows, percent(report.SupportedRows, report.TotalRows))
+	fmt.Fprintf(&sb, "Unsupported:        %d (%.1f%%)\n", report.UnsupportedRows, percent(report.UnsupportedRows, report.TotalRows))
+	if report.SkippedRows > 0 {
+		fmt.Fprintf(&sb, "Skipped:            %d (mapping overrides)\n", report.SkippedRows)
+	}
+	if len(report.FrequencyAdjustments) > 0 {
+		fmt.Fprintf(&sb, "Frequency Adjusted: %d\n", len(report.FrequencyAdjustments))
+	}
+	fmt.Fprintf(&sb, "Manual Steps:       %d\n\n", len(report.ManualSteps))
+
+	if report.Estimate != nil {
+		report.Estimate.WriteText(&sb)
+		sb.WriteString("\n")
+	}
+
+	if len(report.RowsByProtocol) > 0 {
+		sb.WriteString("Monitors by Protocol\n")
+		sb.WriteString("--------------------\n")
+		for protocol, count := range report.RowsByProtocol {
+			fmt.Fprintf(&sb, "%-15s %d\n", protocol+":", count)
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(report.FrequencyAdjustments) > 0 {
+		sb.WriteString("Frequency Adjustments\n")
+		sb.WriteString("---------------------\n")
+		for _, a := range report.FrequencyAdjustments {
+			fmt.Fprintf(&sb, "Row ID %s (%s): %ds -> %ds (%s)\n", a.SourceID, a.Name, a.From, a.To, a.Policy)
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(report.Warnings) > 0 {
+		sb.WriteString("Warnings\n")
+		sb.WriteString("--------\n")
+		for i, warning := range report.Warnings {
+			fmt.Fprintf(&sb, "%d. %s\n", i+1, warning)
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(report.ManualSteps) > 0 {
+		sb.WriteString("Manual Steps Required\n")
+		sb.WriteString("=====================\n\n")
+
+		for i, step := range report.ManualSteps {
+			fmt.Fprintf(&sb, "%d. Row %d (ID %s): %s\n", i+1, step.Row, step.ID, step.Name)
+			fmt.Fprintf(&sb, "   Issue: %s\n", step.Description)
+			sb.WriteString("   Action:\n")
+			for _, line := range strings.Split(step.Action, "\n") {
+				fmt.Fprintf(&sb, "   %s\n", line)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("=================================================================\n")
+
+	return sb.String()
+}
+
+// GenerateManualStepsMarkdown generates a markdown file for manual steps.
+func (r *Reporter) GenerateManualStepsMarkdown(report *MigrationReport) string {
+	var sb strings.Builder
+
+	sb.WriteString("# Manual Migration Steps\n\n")
+	fmt.Fprintf(&sb, "Generated: %s\n\n", report.Timestamp.Format(time.RFC1123))
+
+	if len(report.ManualSteps) == 0 {
+		sb.WriteString("No manual steps required. All rows were successfully converted!\n")
+		return sb.String()
+	}
+
+	fmt.Fprintf(&sb, "The following %d row(s) require manual intervention:\n\n", len(report.ManualSteps))
+
+	sb.WriteString("---\n\n")
+
+	for i, step := range report.ManualSteps {
+		fmt.Fprintf(&sb, "## %d. %s (row %d, ID: %s)\n\n", i+1, step.Name, step.Row, step.ID)
+		if step.Protocol != "" {
+			fmt.Fprintf(&sb, "**Protocol:** `%s`\n\n", step.Protocol)
+		}
+		fmt.Fprintf(&sb, "**Issue:** %s\n\n", step.Description)
+		sb.WriteString("**Action Required:**\n\n")
+		sb.WriteString(step.Action)
+		sb.WriteString("\n\n---\n\n")
+	}
+
+	return sb.String()
+}
+
+// percent returns n as a percentage of total, or 0 when total is 0.
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return float64(n) / float64(total) * 100
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"strings"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/spreadsheet"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+func TestGenerateReport(t *testing.T) {
+	checks := []spreadsheet.Check{
+		{Row: 2, ID: "api", Name: "API"},
+		{Row: 3, ID: "bad", Name: "Bad"},
+		{Row: 4, ID: "zone", Name: "Zone", Protocol: "dns"},
+		{Row: 5, ID: "old", Name: "Old"},
+	}
+	results := []converter.ConversionResult{
+		{
+			Supported: true,
+			Monitor:   &hyperping.CreateMonitorRequest{Name: "API", Protocol: "http", CheckFrequency: 60, Regions: []string{"london"}},
+			Notes:     []string{"50s -> 60s"},
+			FrequencyAdjustment: &migrate.FrequencyAdjustment{
+				SourceID: "api", Name: "API", From: 50, To: 60, Policy: migrate.FrequencyNearest,
+			},
+		},
+		{UnsupportedType: converter.UnsupportedInvalid, Notes: []string{"url is empty"}},
+		{UnsupportedType: "dns"},
+		{Skipped: true},
+	}
+
+	r := NewReporter()
+	report := r.GenerateReport(checks, results)
+
+	if report.TotalRows != 4 || report.SupportedRows != 1 || report.UnsupportedRows != 2 || report.SkippedRows != 1 {
+		t.Errorf("counts = %d/%d/%d/%d, want 4/1/2/1",
+			report.TotalRows, report.SupportedRows, report.UnsupportedRows, report.SkippedRows)
+	}
+	if report.RowsByProtocol["http"] != 1 {
+		t.Errorf("RowsByProtocol = %v", report.RowsByProtocol)
+	}
+	if len(report.FrequencyAdjustments) != 1 || len(report.Warnings) != 1 {
+		t.Errorf("FrequencyAdjustments/Warnings = %d/%d, want 1/1", len(report.FrequencyAdjustments), len(report.Warnings))
+	}
+	if len(report.ManualSteps) != 2 {
+		t.Fatalf("got %d manual steps, want 2", len(report.ManualSteps))
+	}
+	if !strings.Contains(report.ManualSteps[0].Description, "url is empty") {
+		t.Errorf("invalid row step = %+v", report.ManualSteps[0])
+	}
+	if !strings.Contains(report.ManualSteps[1].Description, "'dns'") {
+		t.Errorf("unsupported protocol step = %+v", report.ManualSteps[1])
+	}
+
+	text := r.GenerateTextReport(report)
+	for _, want := range []string{"CSV to Hyperping Migration Report", "Skipped:", "Row ID api (API): 50s -> 60s", "Row 3 (ID bad): Bad"} {
+		if !strings.Contains(text, want) {
+			t.Errorf("text report missing %q:\n%s", want, text)
+		}
+	}
+
+	md := r.GenerateManualStepsMarkdown(report)
+	if !strings.Contains(md, "## 2. Zone (row 4, ID: zone)") {
+		t.Errorf("manual steps missing the dns row:\n%s", md)
+	}
+
+	if _, err := r.GenerateJSONReport(report); err != nil {
+		t.Errorf("GenerateJSONReport() error = %v", err)
+	}
+}
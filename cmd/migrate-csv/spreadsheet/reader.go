@@ -0,0 +1,359 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// Package spreadsheet reads monitor definitions from a CSV export of a
+// spreadsheet.
+package spreadsheet
+
+import (
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// Fields a column can be mapped to. Only FieldName and FieldURL are required.
+const (
+	FieldID             = "id"
+	FieldName           = "name"
+	FieldURL            = "url"
+	FieldProtocol       = "protocol"
+	FieldFrequency      = "frequency"
+	FieldRegions        = "regions"
+	FieldPort           = "port"
+	FieldMethod         = "method"
+	FieldExpectedStatus = "expected_status"
+	FieldKeyword        = "keyword"
+	FieldTags           = "tags"
+	FieldPaused         = "paused"
+)
+
+// Fields lists every field, in the order they are documented.
+var Fields = []string{
+	FieldID, FieldName, FieldURL, FieldProtocol, FieldFrequency, FieldRegions,
+	FieldPort, FieldMethod, FieldExpectedStatus, FieldKeyword, FieldTags, FieldPaused,
+}
+
+// headerAliases are the header names, besides the field name itself, that
+// map to a field when --columns does not say otherwise. Headers match
+// case-insensitively, ignoring spaces, dashes, and underscores.
+var headerAliases = map[string][]string{
+	FieldID:             {"check id", "monitor id"},
+	FieldName:           {"check name", "monitor name", "service"},
+	FieldURL:            {"endpoint", "host", "hostname", "target"},
+	FieldProtocol:       {"type", "check type", "monitor type"},
+	FieldFrequency:      {"check frequency", "interval", "check interval"},
+	FieldRegions:        {"locations", "region"},
+	FieldMethod:         {"http method"},
+	FieldExpectedStatus: {"expected status code", "status code"},
+	FieldKeyword:        {"required keyword"},
+	FieldTags:           {"labels"},
+}
+
+// Check is a monitor definition read from one CSV row. Values are taken
+// verbatim from the row, except that Frequency, Port, and Paused are parsed.
+// Problems found parsing the row are collected in Errors.
+type Check struct {
+	Row            int    // line number of the row in the file
+	ID             string // the id column, or the row number when unset
+	Name           string
+	URL            string
+	Protocol       string
+	Frequency      int // seconds; 0 when unset
+	Regions        []string
+	Port           int
+	Method         string
+	ExpectedStatus string
+	Keyword        string
+	Tags           []string
+	Paused         bool
+	Errors         []string
+}
+
+// ColumnMap maps fields to CSV header names.
+type ColumnMap map[string]string
+
+// ParseColumnMap parses a --columns value such as
+// "name=Service,url=Endpoint,frequency=Interval (s)". Fields not listed are
+// matched to headers by name.
+func ParseColumnMap(s string) (ColumnMap, error) {
+	columns := ColumnMap{}
+	if strings.TrimSpace(s) == "" {
+		return columns, nil
+	}
+	for _, pair := range strings.Split(s, ",") {
+		field, header, ok := strings.Cut(pair, "=")
+		field = strings.ToLower(strings.TrimSpace(field))
+		header = strings.TrimSpace(header)
+		if !ok || field == "" || header == "" {
+			return nil, fmt.Errorf("invalid column mapping %q: want field=Header", pair)
+		}
+		if !slices.Contains(Fields, field) {
+			return nil, fmt.Errorf("invalid column mapping %q: unknown field %q (fields: %s)", pair, field, strings.Join(Fields, ", "))
+		}
+		if _, dup := columns[field]; dup {
+			return nil, fmt.Errorf("invalid column mapping: field %q is mapped more than once", field)
+		}
+		columns[field] = header
+	}
+	return columns, nil
+}
+
+// Options control how a CSV file is read.
+type Options struct {
+	// Delimiter separates fields. Zero means a comma.
+	Delimiter rune
+	// Columns maps fields to headers ahead of the built-in header names.
+	Columns ColumnMap
+}
+
+// ReadFile reads checks from the CSV file at path, or from stdin when path
+// is "-".
+func ReadFile(path string, opts Options) ([]Check, error) {
+	if path == "-" {
+		return Read(os.Stdin, opts)
+	}
+	f, err := os.Open(path) // #nosec G304 -- path is supplied by the operator via --input
+	if err != nil {
+		return nil, fmt.Errorf("opening CSV file: %w", err)
+	}
+	defer f.Close()
+
+	checks, err := Read(f, opts)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return checks, nil
+}
+
+// Read reads checks from CSV data whose first row is a header. Blank rows
+// are ignored. A row whose values cannot be parsed is still returned, with
+// the problems in Check.Errors, so one bad row does not stop a migration.
+// Structural problems, such as a missing name or URL column or a duplicate
+// ID, are returned as an error.
+func Read(r io.Reader, opts Options) ([]Check, error) {
+	cr := csv.NewReader(r)
+	if opts.Delimiter != 0 {
+		cr.Comma = opts.Delimiter
+	}
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+
+	header, err := cr.Read()
+	if errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("CSV file is empty")
+	}
+	if err != nil {
+		return nil, fmt.Errorf("reading CSV header: %w", err)
+	}
+
+	index, err := columnIndex(header, opts.Columns)
+	if err != nil {
+		return nil, err
+	}
+
+	var checks []Check
+	rowsByID := make(map[string]int)
+	for {
+		record, err := cr.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("reading CSV: %w", err)
+		}
+		if isBlank(record) {
+			continue
+		}
+
+		line, _ := cr.FieldPos(0)
+		check := parseRow(record, index, line)
+		if first, dup := rowsByID[check.ID]; dup {
+			return nil, fmt.Errorf("duplicate id %q on rows %d and %d", check.ID, first, line)
+		}
+		rowsByID[check.ID] = line
+		checks = append(checks, check)
+	}
+	return checks, nil
+}
+
+// columnIndex returns the column of each field present in header. Fields
+// mapped by columns must exist; the others are matched by name and alias.
+func columnIndex(header []string, columns ColumnMap) (map[string]int, error) {
+	byHeader := make(map[string]int, len(header))
+	for i, h := range header {
+		if i == 0 {
+			h = strings.TrimPrefix(h, "\ufeff") // UTF-8 byte order mark written by spreadsheet exports
+		}
+		key := normalizeHeader(h)
+		if _, dup := byHeader[key]; !dup {
+			byHeader[key] = i
+		}
+	}
+
+	index := make(map[string]int)
+	for _, field := range Fields {
+		if mapped, ok := columns[field]; ok {
+			i, found := byHeader[normalizeHeader(mapped)]
+			if !found {
+				return nil, fmt.Errorf("column %q mapped to %s is not in the header (columns: %s)", mapped, field, strings.Join(header, ", "))
+			}
+			index[field] = i
+			continue
+		}
+		for _, name := range append([]string{field}, headerAliases[field]...) {
+			if i, found := byHeader[normalizeHeader(name)]; found {
+				index[field] = i
+				break
+			}
+		}
+	}
+
+	for _, field := range []string{FieldName, FieldURL} {
+		if _, ok := index[field]; !ok {
+			return nil, fmt.Errorf("no %s column in the header (columns: %s); map one with --columns %s=<header>",
+				field, strings.Join(header, ", "), field)
+		}
+	}
+	return index, nil
+}
+
+// parseRow builds a Check from a record.
+func parseRow(record []string, index map[string]int, line int) Check {
+	value := func(field string) string {
+		i, ok := index[field]
+		if !ok || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	check := Check{
+		Row:            line,
+		ID:             value(FieldID),
+		Name:           value(FieldName),
+		URL:            value(FieldURL),
+		Protocol:       strings.ToLower(value(FieldProtocol)),
+		Regions:        splitList(value(FieldRegions)),
+		Method:         strings.ToUpper(value(FieldMethod)),
+		ExpectedStatus: value(FieldExpectedStatus),
+		Keyword:        value(FieldKeyword),
+		Tags:           splitList(value(FieldTags)),
+	}
+	if check.ID == "" {
+		check.ID = strconv.Itoa(line)
+	}
+	if check.Name == "" {
+		check.Errors = append(check.Errors, "name is empty")
+	}
+	if check.URL == "" {
+		check.Errors = append(check.Errors, "url is empty")
+	}
+
+	if v := value(FieldFrequency); v != "" {
+		frequency, err := ParseFrequency(v)
+		if err != nil {
+			check.Errors = append(check.Errors, err.Error())
+		}
+		check.Frequency = frequency
+	}
+	if v := value(FieldPort); v != "" {
+		port, err := strconv.Atoi(v)
+		if err != nil || port < 1 || port > 65535 {
+			check.Errors = append(check.Errors, fmt.Sprintf("invalid port %q: want 1-65535", v))
+		} else {
+			check.Port = port
+		}
+	}
+	if v := value(FieldPaused); v != "" {
+		paused, err := parseBool(v)
+		if err != nil {
+			check.Errors = append(check.Errors, err.Error())
+		}
+		check.Paused = paused
+	}
+	return check
+}
+
+// ParseFrequency parses a check frequency given in seconds ("60") or as a
+// duration ("5m", "1h30m").
+func ParseFrequency(s string) (int, error) {
+	if seconds, err := strconv.Atoi(s); err == nil && seconds > 0 {
+		return seconds, nil
+	}
+	d, err := time.ParseDuration(s)
+	if err != nil || d < time.Second {
+		return 0, fmt.Errorf("invalid frequency %q: want seconds (60) or a duration (5m)", s)
+	}
+	return int(d / time.Second), nil
+}
+
+// parseBool parses the ways a spreadsheet says yes or no.
+func parseBool(s string) (bool, error) {
+	switch strings.ToLower(s) {
+	case "true", "yes", "y", "1", "x":
+		return true, nil
+	case "false", "no", "n", "0":
+		return false, nil
+	default:
+		return false, fmt.Errorf("invalid paused value %q: want true or false", s)
+	}
+}
+
+// splitList splits a cell holding several values separated by semicolons,
+// pipes, commas, or newlines.
+func splitList(s string) []string {
+	var values []string
+	for _, v := range strings.FieldsFunc(s, func(r rune) bool {
+		return r == ';' || r == '|' || r == ',' || r == '\n'
+	}) {
+		if v = strings.TrimSpace(v); v != "" {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+// normalizeHeader lowercases a header name and drops spaces, dashes, and
+// underscores, so "Check Frequency", "check_frequency", and "check-frequency"
+// match.
+func normalizeHeader(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '_', '\t':
+			return -1
+		}
+		return r
+	}, strings.ToLower(strings.TrimSpace(s)))
+}
+
+// isBlank reports whether every value in record is empty.
+func isBlank(record []string) bool {
+	for _, v := range record {
+		if strings.TrimSpace(v) != "" {
+			return false
+		}
+	}
+	return true
+}
+
+// ParseDelimiter parses a --delimiter value: a single character, or "tab".
+func ParseDelimiter(s string) (rune, error) {
+	if strings.EqualFold(s, "tab") || s == `\t` {
+		return '\t', nil
+	}
+	if utf8.RuneCountInString(s) != 1 {
+		return 0, fmt.Errorf("invalid delimiter %q: want a single character or \"tab\"", s)
+	}
+	r, _ := utf8.DecodeRuneInString(s)
+	if r == '"' || r == '\r' || r == '\n' || r == utf8.RuneError {
+		return 0, fmt.Errorf("invalid delimiter %q", s)
+	}
+	return r, nil
+}
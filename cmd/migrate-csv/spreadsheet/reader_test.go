@@ -0,0 +1,156 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package spreadsheet
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRead_HeaderAliases(t *testing.T) {
+	input := "\ufeffMonitor Name,Endpoint,Type,Check Interval,Locations,Labels,Paused\n" +
+		"API,https://api.example.com/health,https,5m,\"london; virginia\",env:prod|team:core,no\n" +
+		",,,,,,\n" +
+		"DB,db.example.com:5432,tcp,30,,,yes\n"
+
+	checks, err := Read(strings.NewReader(input), Options{})
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(checks) != 2 {
+		t.Fatalf("got %d checks, want 2 (blank row skipped)", len(checks))
+	}
+
+	api := checks[0]
+	if api.Row != 2 || api.ID != "2" {
+		t.Errorf("Row/ID = %d/%q, want 2/\"2\"", api.Row, api.ID)
+	}
+	if api.Name != "API" || api.URL != "https://api.example.com/health" || api.Protocol != "https" {
+		t.Errorf("unexpected check %+v", api)
+	}
+	if api.Frequency != 300 {
+		t.Errorf("Frequency = %d, want 300", api.Frequency)
+	}
+	if want := []string{"london", "virginia"}; !reflect.DeepEqual(api.Regions, want) {
+		t.Errorf("Regions = %v, want %v", api.Regions, want)
+	}
+	if want := []string{"env:prod", "team:core"}; !reflect.DeepEqual(api.Tags, want) {
+		t.Errorf("Tags = %v, want %v", api.Tags, want)
+	}
+
+	db := checks[1]
+	if db.Row != 4 || db.Frequency != 30 || !db.Paused {
+		t.Errorf("unexpected check %+v", db)
+	}
+	if len(db.Errors) != 0 {
+		t.Errorf("Errors = %v, want none", db.Errors)
+	}
+}
+
+func TestRead_ColumnMapAndDelimiter(t *testing.T) {
+	columns, err := ParseColumnMap("name=Service, url=Health URL, id=Ref")
+	if err != nil {
+		t.Fatalf("ParseColumnMap() error = %v", err)
+	}
+	input := "Ref\tService\tHealth URL\tname\n" +
+		"svc-1\tCheckout\thttps://shop.example.com\tignored\n"
+
+	checks, err := Read(strings.NewReader(input), Options{Delimiter: '\t', Columns: columns})
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if len(checks) != 1 {
+		t.Fatalf("got %d checks, want 1", len(checks))
+	}
+	if checks[0].ID != "svc-1" || checks[0].Name != "Checkout" || checks[0].URL != "https://shop.example.com" {
+		t.Errorf("unexpected check %+v", checks[0])
+	}
+}
+
+func TestRead_RowErrors(t *testing.T) {
+	input := "name,url,frequency,port,paused\n" +
+		"a,,often,70000,maybe\n"
+
+	checks, err := Read(strings.NewReader(input), Options{})
+	if err != nil {
+		t.Fatalf("Read() error = %v", err)
+	}
+	if got := len(checks[0].Errors); got != 4 {
+		t.Errorf("got %d errors, want 4: %v", got, checks[0].Errors)
+	}
+}
+
+func TestRead_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		input   string
+		columns ColumnMap
+		wantErr string
+	}{
+		{name: "empty", input: "", wantErr: "empty"},
+		{name: "no url column", input: "name,address\na,b\n", wantErr: "no url column"},
+		{name: "mapped column missing", input: "name,url\na,b\n", columns: ColumnMap{FieldURL: "Endpoint"}, wantErr: `"Endpoint"`},
+		{name: "duplicate id", input: "id,name,url\n1,a,a.example.com\n1,b,b.example.com\n", wantErr: "duplicate id"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Read(strings.NewReader(tt.input), Options{Columns: tt.columns})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Read() error = %v, want containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestParseColumnMap_Invalid(t *testing.T) {
+	for _, s := range []string{"name", "color=Colour", "name=A,name=B", "=Header"} {
+		if _, err := ParseColumnMap(s); err == nil {
+			t.Errorf("ParseColumnMap(%q) error = nil, want error", s)
+		}
+	}
+}
+
+func TestParseFrequency(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    int
+		wantErr bool
+	}{
+		{in: "60", want: 60},
+		{in: "5m", want: 300},
+		{in: "1h30m", want: 5400},
+		{in: "0", wantErr: true},
+		{in: "500ms", wantErr: true},
+		{in: "daily", wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseFrequency(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseFrequency(%q) = %d, %v; want %d, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
+
+func TestParseDelimiter(t *testing.T) {
+	tests := []struct {
+		in      string
+		want    rune
+		wantErr bool
+	}{
+		{in: ",", want: ','},
+		{in: ";", want: ';'},
+		{in: "tab", want: '\t'},
+		{in: `\t`, want: '\t'},
+		{in: "", wantErr: true},
+		{in: ";;", wantErr: true},
+		{in: `"`, wantErr: true},
+	}
+	for _, tt := range tests {
+		got, err := ParseDelimiter(tt.in)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("ParseDelimiter(%q) = %q, %v; want %q, error %v", tt.in, got, err, tt.want, tt.wantErr)
+		}
+	}
+}
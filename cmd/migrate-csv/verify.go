@@ -0,0 +1,110 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-csv/spreadsheet"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/verify"
+)
+
+// runVerification compares the CSV rows with the monitors that exist in
+// Hyperping and writes a field-by-field equivalence report.
+func (r *csvRunner) runVerification() int {
+	checks, err := readChecks()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error reading CSV: %v\n", err)
+		return 1
+	}
+
+	log("Fetching Hyperping monitors for verification...")
+	destination, err := createHyperpingClient(r.hyperpingKey).ListMonitors(r.ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching Hyperping monitors: %v\n", err)
+		return 1
+	}
+
+	idMap, err := mapping.Load(mappingPath())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	result := verify.Monitors("CSV", verify.ApplyMapping(verifySources(checks), idMap), destination)
+	result.PrintSummary(os.Stderr)
+
+	reportPath := filepath.Join(*outputDir, "verification-report.json")
+	if err := result.WriteJSON(reportPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	log(fmt.Sprintf("Verification report written to %s", reportPath))
+
+	if recorded := result.RecordUUIDs(idMap); recorded > 0 {
+		if err := idMap.Write(mappingPath()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		log(fmt.Sprintf("Recorded %d Hyperping UUIDs in %s", recorded, mappingPath()))
+	}
+
+	if result.HasProblems() {
+		return 1
+	}
+	return 0
+}
+
+// verifySources builds verification inputs from the CSV rows, using the
+// converter only for names, URLs, and protocol vocabulary. Rows that were
+// not converted, or skipped by the mapping overrides, are not verified.
+func verifySources(checks []spreadsheet.Check) []verify.Source {
+	checkConverter := newCheckConverter()
+
+	sources := make([]verify.Source, 0, len(checks))
+	for _, check := range checks {
+		result := checkConverter.Convert(check)
+		if !result.Supported || result.Monitor == nil {
+			continue
+		}
+
+		var regions []string
+		if len(check.Regions) > 0 {
+			regions = checkConverter.Regions(check.Regions)
+		}
+
+		var expectedStatusCodes []string
+		if check.ExpectedStatus != "" {
+			expectedStatusCodes = []string{check.ExpectedStatus}
+		}
+
+		// Rows without a frequency asked for the default, so compare with
+		// the converted one.
+		frequency := check.Frequency
+		if frequency == 0 {
+			frequency = result.Monitor.CheckFrequency
+		}
+
+		var port int
+		if result.Monitor.Port != nil {
+			port = *result.Monitor.Port
+		}
+
+		sources = append(sources, verify.Source{
+			ID:                  check.ID,
+			Name:                result.Monitor.Name,
+			URL:                 result.Monitor.URL,
+			Protocol:            result.Monitor.Protocol,
+			Frequency:           frequency,
+			Locations:           check.Regions,
+			Regions:             regions,
+			ExpectedStatusCodes: expectedStatusCodes,
+			Port:                port,
+		})
+	}
+	return sources
+}
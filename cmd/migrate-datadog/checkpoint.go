@@ -0,0 +1,6 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+const toolName = "datadog"
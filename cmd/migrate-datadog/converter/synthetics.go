@@ -0,0 +1,421 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package converter
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+const (
+	// UnsupportedFrequency is the UnsupportedType of a test whose tick_every
+	// the frequency policy rejects.
+	UnsupportedFrequency = "frequency"
+	// UnsupportedVariables is the UnsupportedType of a test whose URL, host,
+	// or port comes from a Datadog variable, which cannot be resolved.
+	UnsupportedVariables = "variables"
+)
+
+// ConversionResult represents the result of converting a Datadog Synthetics
+// test.
+type ConversionResult struct {
+	Monitor         *hyperping.CreateMonitorRequest
+	Supported       bool
+	UnsupportedType string
+	Skipped         bool // skipped on purpose via the mapping overrides
+	Notes           []string
+	// FrequencyAdjustment is set when the test's tick_every was snapped to a
+	// different Hyperping check frequency.
+	FrequencyAdjustment *migrate.FrequencyAdjustment
+}
+
+// TestConverter converts Datadog Synthetics tests to Hyperping monitors.
+type TestConverter struct {
+	nameTemplate    *migrate.NameTemplate
+	overrides       *migrate.Overrides
+	frequencyPolicy migrate.FrequencyPolicy
+	regions         *migrate.RegionMapper
+}
+
+// locationRegions maps Datadog managed locations to the nearest Hyperping
+// region. Other locations are mapped by the region or city they name.
+var locationRegions = map[string][]string{
+	"aws:us-east-1":            {"virginia"},
+	"aws:us-east-2":            {"virginia"},
+	"aws:us-west-1":            {"sanfrancisco"},
+	"aws:us-west-2":            {"sanfrancisco"},
+	"aws:ca-central-1":         {"toronto"},
+	"aws:sa-east-1":            {"saopaulo"},
+	"aws:eu-west-1":            {"london"},
+	"aws:eu-west-2":            {"london"},
+	"aws:eu-west-3":            {"paris"},
+	"aws:eu-central-1":         {"frankfurt"},
+	"aws:eu-south-1":           {"frankfurt"},
+	"aws:eu-north-1":           {"amsterdam"},
+	"aws:me-south-1":           {"bahrain"},
+	"aws:af-south-1":           {"capetown"},
+	"aws:ap-south-1":           {"mumbai"},
+	"aws:ap-southeast-1":       {"singapore"},
+	"aws:ap-southeast-2":       {"sydney"},
+	"aws:ap-southeast-3":       {"singapore"},
+	"aws:ap-northeast-1":       {"tokyo"},
+	"aws:ap-northeast-2":       {"seoul"},
+	"aws:ap-northeast-3":       {"tokyo"},
+	"aws:ap-east-1":            {"seoul"},
+	"azure:eastus":             {"virginia"},
+	"azure:uksouth":            {"london"},
+	"azure:francecentral":      {"paris"},
+	"azure:westeurope":         {"amsterdam"},
+	"azure:southeastasia":      {"singapore"},
+	"azure:japaneast":          {"tokyo"},
+	"gcp:us-east4":             {"virginia"},
+	"gcp:us-west1":             {"sanfrancisco"},
+	"gcp:us-west2":             {"california"},
+	"gcp:europe-west2":         {"london"},
+	"gcp:europe-west3":         {"frankfurt"},
+	"gcp:asia-northeast1":      {"tokyo"},
+	"gcp:australia-southeast1": {"sydney"},
+	"gcp:southamerica-east1":   {"saopaulo"},
+}
+
+// NewTestConverter creates a new TestConverter.
+func NewTestConverter() *TestConverter {
+	return &TestConverter{frequencyPolicy: migrate.FrequencyNearest, regions: migrate.NewRegionMapper(locationRegions)}
+}
+
+// WithNameTemplate renders monitor names from the test name and tags using
+// tmpl. A nil template keeps the test name.
+func (c *TestConverter) WithNameTemplate(tmpl *migrate.NameTemplate) *TestConverter {
+	c.nameTemplate = tmpl
+	return c
+}
+
+// WithOverrides applies the mapping overrides in o, keyed by test public ID.
+// A skipped test converts to a result with Skipped set and no monitor.
+func (c *TestConverter) WithOverrides(o *migrate.Overrides) *TestConverter {
+	c.overrides = o
+	return c
+}
+
+// WithFrequencyPolicy sets how tick_every values that Hyperping does not
+// support are snapped. Under migrate.FrequencyFail such tests convert to an
+// unsupported result with UnsupportedType UnsupportedFrequency.
+func (c *TestConverter) WithFrequencyPolicy(p migrate.FrequencyPolicy) *TestConverter {
+	c.frequencyPolicy = p
+	return c
+}
+
+// WithRegionMap maps locations with the mappings in rm ahead of the built-in
+// ones, and uses its defaults for tests whose locations map to no region.
+func (c *TestConverter) WithRegionMap(rm *migrate.RegionMap) *TestConverter {
+	c.regions.WithRegionMap(rm)
+	return c
+}
+
+// Convert converts a Datadog Synthetics test to a Hyperping monitor.
+func (c *TestConverter) Convert(test datadog.Test) ConversionResult {
+	result := ConversionResult{
+		Notes: []string{},
+	}
+
+	override, _ := c.overrides.Lookup(test.PublicID)
+	if override.Skip {
+		result.Skipped = true
+		result.Notes = append(result.Notes, "Skipped: skip in mapping overrides")
+		return result
+	}
+
+	kind := test.Kind()
+	if (kind == "http" || kind == "tcp" || kind == "icmp" || kind == "dns") && usesVariables(test) {
+		result.UnsupportedType = UnsupportedVariables
+		result.Notes = append(result.Notes, "URL, host, or port is set from a Datadog variable, which cannot be resolved outside Datadog")
+		return result
+	}
+
+	switch kind {
+	case "http":
+		result.Monitor = c.convertHTTPTest(test, &result)
+		result.Supported = true
+	case "tcp":
+		result.Monitor = c.convertTCPTest(test)
+		result.Supported = true
+	case "icmp":
+		result.Monitor = c.convertICMPTest(test)
+		result.Supported = true
+	case "dns":
+		result.Monitor = c.convertDNSTest(test, &result)
+		result.Supported = true
+	case "ssl":
+		result.UnsupportedType = kind
+		result.Notes = append(result.Notes, "SSL tests not supported. Hyperping checks certificates of HTTPS monitors; monitor https://"+test.Config.Request.Host+" instead")
+	case "udp", "websocket", "grpc":
+		result.UnsupportedType = kind
+		result.Notes = append(result.Notes, fmt.Sprintf("%s tests not supported. Consider a TCP or HTTP endpoint of the same service", strings.ToUpper(kind)))
+	case "multi":
+		result.UnsupportedType = kind
+		result.Notes = append(result.Notes, "Multistep API tests not supported. Break into individual HTTP monitors")
+	case "browser", "mobile":
+		result.UnsupportedType = kind
+		result.Notes = append(result.Notes, fmt.Sprintf("%s tests not supported. Run the journey from an external script that pings a Hyperping healthcheck", titleCase(kind)))
+	default:
+		result.UnsupportedType = kind
+		result.Notes = append(result.Notes, fmt.Sprintf("Unknown test type: %s", kind))
+	}
+
+	if result.Monitor != nil && c.nameTemplate != nil {
+		name, err := c.nameTemplate.Render(test.Name, test.Tags)
+		if err != nil {
+			result.Notes = append(result.Notes, fmt.Sprintf("Name template failed, using test name: %v", err))
+		} else {
+			result.Monitor.Name = name
+		}
+	}
+
+	if result.Monitor != nil && override.Frequency == 0 {
+		c.snapFrequency(&result, test)
+	}
+
+	if result.Monitor != nil && len(override.Regions) == 0 {
+		managed, private := splitLocations(test.Locations)
+		result.Notes = append(result.Notes, c.regions.Map(managed).Warnings()...)
+		if len(private) > 0 {
+			result.Notes = append(result.Notes, fmt.Sprintf("Private locations not migrated: %s", strings.Join(private, ", ")))
+		}
+	}
+
+	if result.Monitor != nil {
+		applyOverride(result.Monitor, override)
+	}
+
+	return result
+}
+
+// usesVariables reports whether the test's target comes from a Datadog
+// template variable such as {{ HOST }}. A TCP test without a numeric port
+// takes it from a variable.
+func usesVariables(test datadog.Test) bool {
+	request := test.Config.Request
+	if test.Kind() == "tcp" && request.Port <= 0 {
+		return true
+	}
+	return strings.Contains(request.URL, "{{") || strings.Contains(request.Host, "{{")
+}
+
+// snapFrequency sets the monitor's check frequency from tick_every under the
+// frequency policy, recording any adjustment. A rejected interval turns the
+// result into an unsupported one. A test without tick_every keeps the
+// default frequency.
+func (c *TestConverter) snapFrequency(result *ConversionResult, test datadog.Test) {
+	seconds := test.Options.TickEvery
+	if seconds <= 0 {
+		return
+	}
+	frequency, err := c.frequencyPolicy.Snap(seconds)
+	if err != nil {
+		result.Monitor = nil
+		result.Supported = false
+		result.UnsupportedType = UnsupportedFrequency
+		result.Notes = append(result.Notes, err.Error())
+		return
+	}
+
+	result.Monitor.CheckFrequency = frequency
+	if frequency != seconds {
+		result.FrequencyAdjustment = &migrate.FrequencyAdjustment{
+			SourceID: test.PublicID,
+			Name:     test.Name,
+			From:     seconds,
+			To:       frequency,
+			Policy:   c.frequencyPolicy,
+		}
+		result.Notes = append(result.Notes, result.FrequencyAdjustment.String())
+	}
+}
+
+// applyOverride replaces the converted name, regions, and check frequency
+// with those set by the mapping override.
+func applyOverride(monitor *hyperping.CreateMonitorRequest, override migrate.Override) {
+	if override.Name != "" {
+		monitor.Name = override.Name
+	}
+	if len(override.Regions) > 0 {
+		monitor.Regions = override.Regions
+	}
+	if override.Frequency != 0 {
+		monitor.CheckFrequency = override.Frequency
+	}
+}
+
+func (c *TestConverter) convertHTTPTest(test datadog.Test, result *ConversionResult) *hyperping.CreateMonitorRequest {
+	request := test.Config.Request
+
+	method := strings.ToUpper(request.Method)
+	if method == "" {
+		method = "GET"
+	}
+
+	names := make([]string, 0, len(request.Headers))
+	for name := range request.Headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	headers := make([]hyperping.RequestHeader, 0, len(names))
+	for _, name := range names {
+		headers = append(headers, hyperping.RequestHeader{Name: name, Value: request.Headers[name]})
+	}
+
+	followRedirects := test.Options.FollowRedirects
+	monitor := &hyperping.CreateMonitorRequest{
+		Name:            test.Name,
+		URL:             migrate.EnsureURLScheme(request.URL),
+		Protocol:        "http",
+		HTTPMethod:      method,
+		CheckFrequency:  60,
+		Regions:         c.Regions(test.Locations),
+		RequestHeaders:  headers,
+		FollowRedirects: &followRedirects,
+		Paused:          test.Status == "paused",
+	}
+
+	if request.Body != "" {
+		body := request.Body
+		monitor.RequestBody = &body
+	}
+
+	result.Notes = append(result.Notes, applyAssertions(monitor, test.Config.Assertions)...)
+
+	if test.Options.AcceptSelfSigned {
+		result.Notes = append(result.Notes, "accept_self_signed is not migrated: Hyperping monitors reject invalid certificates")
+	}
+
+	return monitor
+}
+
+// applyAssertions sets the expected status code and required keyword from
+// the first status code "is" and body "contains" assertions, and returns a
+// note for every assertion Hyperping has no equivalent for.
+func applyAssertions(monitor *hyperping.CreateMonitorRequest, assertions []datadog.Assertion) []string {
+	var notes []string
+	for _, a := range assertions {
+		switch {
+		case a.Type == "statusCode" && a.Operator == "is" && monitor.ExpectedStatusCode == "":
+			monitor.ExpectedStatusCode = a.TargetString()
+		case a.Type == "body" && a.Operator == "contains" && monitor.RequiredKeyword == nil:
+			keyword := a.TargetString()
+			monitor.RequiredKeyword = &keyword
+		default:
+			notes = append(notes, fmt.Sprintf("Assertion not migrated: %s", describeAssertion(a)))
+		}
+	}
+	return notes
+}
+
+// describeAssertion renders an assertion as, for example,
+// `responseTime lessThan 1000` or `header content-type is text/html`.
+func describeAssertion(a datadog.Assertion) string {
+	parts := []string{a.Type}
+	if a.Property != "" {
+		parts = append(parts, a.Property)
+	}
+	parts = append(parts, a.Operator)
+	if target := a.TargetString(); target != "" {
+		parts = append(parts, target)
+	}
+	return strings.Join(parts, " ")
+}
+
+func (c *TestConverter) convertTCPTest(test datadog.Test) *hyperping.CreateMonitorRequest {
+	port := int(test.Config.Request.Port)
+
+	return &hyperping.CreateMonitorRequest{
+		Name:           test.Name,
+		URL:            test.Config.Request.Host,
+		Protocol:       "port",
+		CheckFrequency: 60,
+		Regions:        c.Regions(test.Locations),
+		Port:           &port,
+		Paused:         test.Status == "paused",
+	}
+}
+
+func (c *TestConverter) convertICMPTest(test datadog.Test) *hyperping.CreateMonitorRequest {
+	return &hyperping.CreateMonitorRequest{
+		Name:           test.Name,
+		URL:            test.Config.Request.Host,
+		Protocol:       "icmp",
+		CheckFrequency: 60,
+		Regions:        c.Regions(test.Locations),
+		Paused:         test.Status == "paused",
+	}
+}
+
+func (c *TestConverter) convertDNSTest(test datadog.Test, result *ConversionResult) *hyperping.CreateMonitorRequest {
+	monitor := &hyperping.CreateMonitorRequest{
+		Name:           test.Name,
+		URL:            test.Config.Request.Host,
+		Protocol:       "dns",
+		CheckFrequency: 60,
+		Regions:        c.Regions(test.Locations),
+		Paused:         test.Status == "paused",
+	}
+
+	if server := test.Config.Request.DNSServer; server != "" {
+		monitor.DNSNameserver = &server
+	}
+
+	for _, a := range test.Config.Assertions {
+		recordType := strings.ToUpper(a.Property)
+		isRecord := (a.Type == "recordEvery" || a.Type == "recordSome") && slices.Contains(hyperping.AllowedDNSRecordTypes, recordType)
+		switch {
+		case isRecord && monitor.DNSRecordType == nil && a.Operator == "is":
+			monitor.DNSRecordType = &recordType
+			answer := a.TargetString()
+			monitor.DNSExpectedAnswer = &answer
+		default:
+			result.Notes = append(result.Notes, fmt.Sprintf("Assertion not migrated: %s", describeAssertion(a)))
+		}
+	}
+
+	return monitor
+}
+
+// Regions converts Datadog locations to Hyperping regions. Tests whose
+// locations map to no region, such as tests that only run from private
+// locations, get the --region-map defaults when set, and built-in defaults
+// otherwise.
+func (c *TestConverter) Regions(locations []string) []string {
+	managed, _ := splitLocations(locations)
+	if regions := c.regions.Map(managed).Regions; len(regions) > 0 {
+		return regions
+	}
+	return c.regions.Defaults(migrate.DefaultRegions())
+}
+
+// splitLocations separates managed locations from private locations, whose
+// IDs start with pl: and which have no Hyperping equivalent.
+func splitLocations(locations []string) (managed, private []string) {
+	for _, location := range locations {
+		if strings.HasPrefix(location, "pl:") {
+			private = append(private, location)
+		} else {
+			managed = append(managed, location)
+		}
+	}
+	return managed, private
+}
+
+// titleCase capitalizes the first letter of s.
+func titleCase(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,244 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package converter
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+func assertion(typ, operator, property string, target any) datadog.Assertion {
+	raw, _ := json.Marshal(target) //nolint:errcheck // test helper
+	return datadog.Assertion{Type: typ, Operator: operator, Property: property, Target: raw}
+}
+
+func httpTest() datadog.Test {
+	return datadog.Test{
+		PublicID:  "abc-def-ghi",
+		Name:      "Checkout API",
+		Type:      "api",
+		Subtype:   "http",
+		Status:    "live",
+		Tags:      []string{"env:prod", "team:payments"},
+		Locations: []string{"aws:eu-west-2", "aws:us-east-1"},
+		Config: datadog.Config{
+			Request: datadog.Request{
+				Method:  "post",
+				URL:     "https://api.example.com/checkout",
+				Headers: map[string]string{"X-Trace": "1", "Accept": "application/json"},
+				Body:    `{"ping":true}`,
+			},
+			Assertions: []datadog.Assertion{
+				assertion("statusCode", "is", "", 201),
+				assertion("body", "contains", "", "ok"),
+				assertion("responseTime", "lessThan", "", 1000),
+			},
+		},
+		Options: datadog.Options{TickEvery: 300, FollowRedirects: true},
+	}
+}
+
+func TestConvert_HTTP(t *testing.T) {
+	result := NewTestConverter().Convert(httpTest())
+	if !result.Supported || result.Monitor == nil {
+		t.Fatalf("result = %+v, want a supported monitor", result)
+	}
+	m := result.Monitor
+
+	if m.Name != "Checkout API" || m.Protocol != "http" || m.URL != "https://api.example.com/checkout" {
+		t.Errorf("monitor = %+v", m)
+	}
+	if m.HTTPMethod != "POST" {
+		t.Errorf("HTTPMethod = %q, want POST", m.HTTPMethod)
+	}
+	if m.CheckFrequency != 300 {
+		t.Errorf("CheckFrequency = %d, want 300", m.CheckFrequency)
+	}
+	if strings.Join(m.Regions, ",") != "london,virginia" {
+		t.Errorf("Regions = %v, want [london virginia]", m.Regions)
+	}
+	if len(m.RequestHeaders) != 2 || m.RequestHeaders[0].Name != "Accept" {
+		t.Errorf("RequestHeaders = %v, want sorted by name", m.RequestHeaders)
+	}
+	if m.RequestBody == nil || *m.RequestBody != `{"ping":true}` {
+		t.Errorf("RequestBody = %v", m.RequestBody)
+	}
+	if m.ExpectedStatusCode != "201" {
+		t.Errorf("ExpectedStatusCode = %q, want 201", m.ExpectedStatusCode)
+	}
+	if m.RequiredKeyword == nil || *m.RequiredKeyword != "ok" {
+		t.Errorf("RequiredKeyword = %v, want ok", m.RequiredKeyword)
+	}
+	if m.FollowRedirects == nil || !*m.FollowRedirects {
+		t.Errorf("FollowRedirects = %v, want true", m.FollowRedirects)
+	}
+	if len(result.Notes) != 1 || !strings.Contains(result.Notes[0], "responseTime lessThan 1000") {
+		t.Errorf("Notes = %v, want the response time assertion", result.Notes)
+	}
+}
+
+func TestConvert_DispatchByKind(t *testing.T) {
+	tests := []struct {
+		name            string
+		test            datadog.Test
+		wantProtocol    string
+		wantUnsupported string
+	}{
+		{name: "tcp", wantProtocol: "port",
+			test: datadog.Test{Type: "api", Subtype: "tcp", Config: datadog.Config{Request: datadog.Request{Host: "db.example.com", Port: 5432}}}},
+		{name: "icmp", wantProtocol: "icmp",
+			test: datadog.Test{Type: "api", Subtype: "icmp", Config: datadog.Config{Request: datadog.Request{Host: "host.example.com"}}}},
+		{name: "dns", wantProtocol: "dns",
+			test: datadog.Test{Type: "api", Subtype: "dns", Config: datadog.Config{Request: datadog.Request{Host: "example.com"}}}},
+		{name: "api without subtype is http", wantProtocol: "http",
+			test: datadog.Test{Type: "api", Config: datadog.Config{Request: datadog.Request{URL: "https://example.com"}}}},
+		{name: "ssl", wantUnsupported: "ssl",
+			test: datadog.Test{Type: "api", Subtype: "ssl", Config: datadog.Config{Request: datadog.Request{Host: "example.com", Port: 443}}}},
+		{name: "udp", wantUnsupported: "udp", test: datadog.Test{Type: "api", Subtype: "udp"}},
+		{name: "multistep", wantUnsupported: "multi", test: datadog.Test{Type: "api", Subtype: "multi"}},
+		{name: "browser", wantUnsupported: "browser", test: datadog.Test{Type: "browser"}},
+		{name: "mobile", wantUnsupported: "mobile", test: datadog.Test{Type: "mobile"}},
+		{name: "variable URL", wantUnsupported: UnsupportedVariables,
+			test: datadog.Test{Type: "api", Subtype: "http", Config: datadog.Config{Request: datadog.Request{URL: "https://{{ HOST }}/health"}}}},
+		{name: "variable port", wantUnsupported: UnsupportedVariables,
+			test: datadog.Test{Type: "api", Subtype: "tcp", Config: datadog.Config{Request: datadog.Request{Host: "db.example.com"}}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result := NewTestConverter().Convert(tt.test)
+			if tt.wantUnsupported != "" {
+				if result.Supported || result.Monitor != nil || result.UnsupportedType != tt.wantUnsupported {
+					t.Errorf("result = %+v, want unsupported %q", result, tt.wantUnsupported)
+				}
+				if len(result.Notes) == 0 {
+					t.Error("unsupported result has no notes")
+				}
+				return
+			}
+			if !result.Supported || result.Monitor == nil || result.Monitor.Protocol != tt.wantProtocol {
+				t.Errorf("result = %+v, want protocol %q", result, tt.wantProtocol)
+			}
+		})
+	}
+}
+
+func TestConvert_DNSAssertions(t *testing.T) {
+	test := datadog.Test{
+		Type: "api", Subtype: "dns",
+		Config: datadog.Config{
+			Request: datadog.Request{Host: "example.com", DNSServer: "8.8.8.8"},
+			Assertions: []datadog.Assertion{
+				assertion("recordSome", "is", "cname", "www.example.com"),
+				assertion("responseTime", "lessThan", "", 500),
+			},
+		},
+	}
+
+	result := NewTestConverter().Convert(test)
+	m := result.Monitor
+	if m == nil {
+		t.Fatalf("result = %+v, want a monitor", result)
+	}
+	if m.DNSRecordType == nil || *m.DNSRecordType != "CNAME" {
+		t.Errorf("DNSRecordType = %v, want CNAME", m.DNSRecordType)
+	}
+	if m.DNSExpectedAnswer == nil || *m.DNSExpectedAnswer != "www.example.com" {
+		t.Errorf("DNSExpectedAnswer = %v", m.DNSExpectedAnswer)
+	}
+	if m.DNSNameserver == nil || *m.DNSNameserver != "8.8.8.8" {
+		t.Errorf("DNSNameserver = %v", m.DNSNameserver)
+	}
+	if len(result.Notes) != 1 {
+		t.Errorf("Notes = %v, want the response time assertion", result.Notes)
+	}
+}
+
+func TestConvert_PausedAndDefaults(t *testing.T) {
+	test := datadog.Test{Type: "api", Subtype: "icmp", Status: "paused", Config: datadog.Config{Request: datadog.Request{Host: "host.example.com"}}}
+
+	m := NewTestConverter().Convert(test).Monitor
+	if !m.Paused {
+		t.Error("Paused = false for a paused test")
+	}
+	if m.CheckFrequency != 60 {
+		t.Errorf("CheckFrequency = %d, want 60 without tick_every", m.CheckFrequency)
+	}
+	if strings.Join(m.Regions, ",") != strings.Join(migrate.DefaultRegions(), ",") {
+		t.Errorf("Regions = %v, want the default regions", m.Regions)
+	}
+}
+
+func TestConvert_Locations(t *testing.T) {
+	test := httpTest()
+	test.Locations = []string{"aws:eu-central-1", "pl:office-berlin-1a2b3c", "aws:mars-north-1"}
+
+	result := NewTestConverter().Convert(test)
+	if strings.Join(result.Monitor.Regions, ",") != "frankfurt" {
+		t.Errorf("Regions = %v, want [frankfurt]", result.Monitor.Regions)
+	}
+	notes := strings.Join(result.Notes, "\n")
+	if !strings.Contains(notes, "Private locations not migrated: pl:office-berlin-1a2b3c") {
+		t.Errorf("Notes = %v, want the private location", result.Notes)
+	}
+
+	test.Locations = []string{"pl:office-berlin-1a2b3c"}
+	result = NewTestConverter().Convert(test)
+	if strings.Join(result.Monitor.Regions, ",") != strings.Join(migrate.DefaultRegions(), ",") {
+		t.Errorf("Regions = %v, want the defaults for private locations only", result.Monitor.Regions)
+	}
+}
+
+func TestConvert_Frequency(t *testing.T) {
+	test := httpTest()
+	test.Options.TickEvery = 900
+
+	result := NewTestConverter().Convert(test)
+	if result.FrequencyAdjustment == nil || result.FrequencyAdjustment.SourceID != "abc-def-ghi" {
+		t.Fatalf("FrequencyAdjustment = %+v, want one for 900s", result.FrequencyAdjustment)
+	}
+
+	result = NewTestConverter().WithFrequencyPolicy(migrate.FrequencyFail).Convert(test)
+	if result.Supported || result.UnsupportedType != UnsupportedFrequency {
+		t.Errorf("result = %+v, want unsupported frequency under fail", result)
+	}
+}
+
+func TestConvert_OverridesAndNameTemplate(t *testing.T) {
+	overrides, err := migrate.ParseOverrides([]byte(`
+overrides:
+  abc-def-ghi:
+    regions: [tokyo]
+    frequency: 60
+  skip-me-123:
+    skip: true
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl, err := migrate.ParseNameTemplate(`[{{.Tag "env" | upper}}] {{.Name}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewTestConverter().WithOverrides(overrides).WithNameTemplate(tmpl)
+
+	result := c.Convert(httpTest())
+	m := result.Monitor
+	if m.Name != "[PROD] Checkout API" {
+		t.Errorf("Name = %q, want the rendered template", m.Name)
+	}
+	if strings.Join(m.Regions, ",") != "tokyo" || m.CheckFrequency != 60 {
+		t.Errorf("Regions = %v, CheckFrequency = %d, want the override", m.Regions, m.CheckFrequency)
+	}
+
+	skipped := httpTest()
+	skipped.PublicID = "skip-me-123"
+	if result := c.Convert(skipped); !result.Skipped || result.Monitor != nil {
+		t.Errorf("result = %+v, want skipped", result)
+	}
+}
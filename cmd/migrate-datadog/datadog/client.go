@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultSite is the Datadog site used when none is given.
+const DefaultSite = "datadoghq.com"
+
+// pageSize is the number of Synthetics tests requested per page.
+const pageSize = 100
+
+// Client represents a Datadog API client.
+type Client struct {
+	apiKey     string
+	appKey     string
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewClient creates a new Datadog API client. Listing Synthetics tests needs
+// both an API key and an application key.
+func NewClient(apiKey, appKey string, options ...Option) *Client {
+	c := &Client{
+		apiKey:  apiKey,
+		appKey:  appKey,
+		baseURL: SiteURL(DefaultSite),
+		httpClient: &http.Client{
+			Timeout: 30 * time.Second,
+		},
+	}
+
+	for _, opt := range options {
+		opt(c)
+	}
+
+	return c
+}
+
+// Option is a functional option for configuring the Client.
+type Option func(*Client)
+
+// WithBaseURL sets the base URL for the Datadog API.
+func WithBaseURL(baseURL string) Option {
+	return func(c *Client) {
+		c.baseURL = strings.TrimSuffix(baseURL, "/")
+	}
+}
+
+// WithHTTPClient sets a custom HTTP client.
+func WithHTTPClient(client *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = client
+	}
+}
+
+// SiteURL returns the API base URL of a Datadog site such as datadoghq.eu
+// or us5.datadoghq.com.
+func SiteURL(site string) string {
+	return "https://api." + strings.TrimPrefix(site, "app.")
+}
+
+// Test represents a Datadog Synthetics test.
+type Test struct {
+	PublicID  string   `json:"public_id"`
+	Name      string   `json:"name"`
+	Type      string   `json:"type"`              // api, browser, mobile
+	Subtype   string   `json:"subtype,omitempty"` // http, ssl, tcp, dns, icmp, udp, websocket, grpc, multi
+	Status    string   `json:"status"`            // live or paused
+	Tags      []string `json:"tags"`
+	Locations []string `json:"locations"`
+	Message   string   `json:"message,omitempty"`
+	Config    Config   `json:"config"`
+	Options   Options  `json:"options"`
+}
+
+// Config is the request and assertions of a test.
+type Config struct {
+	Request    Request           `json:"request"`
+	Assertions []Assertion       `json:"assertions,omitempty"`
+	Steps      []json.RawMessage `json:"steps,omitempty"` // multistep API and browser tests
+}
+
+// Request is the request a test sends.
+type Request struct {
+	Method    string            `json:"method,omitempty"`
+	URL       string            `json:"url,omitempty"`
+	Host      string            `json:"host,omitempty"`
+	Port      Port              `json:"port,omitempty"`
+	Headers   map[string]string `json:"headers,omitempty"`
+	Body      string            `json:"body,omitempty"`
+	Timeout   float64           `json:"timeout,omitempty"` // seconds
+	DNSServer string            `json:"dnsServer,omitempty"`
+}
+
+// Port is a request port. The API returns it as a number, or as a string
+// for tests that take the port from a variable.
+type Port int
+
+// UnmarshalJSON accepts a number or a numeric string. Other strings, such as
+// "{{ PORT }}", leave the port at zero.
+func (p *Port) UnmarshalJSON(data []byte) error {
+	var n int
+	if err := json.Unmarshal(data, &n); err == nil {
+		*p = Port(n)
+		return nil
+	}
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return fmt.Errorf("port must be a number or a string: %s", data)
+	}
+	n, _ = strconv.Atoi(strings.TrimSpace(s)) //nolint:errcheck // variables leave the port unset
+	*p = Port(n)
+	return nil
+}
+
+// Assertion is a check a test makes on the response.
+type Assertion struct {
+	Type     string          `json:"type"` // statusCode, body, header, responseTime, ...
+	Operator string          `json:"operator"`
+	Property string          `json:"property,omitempty"`
+	Target   json.RawMessage `json:"target,omitempty"`
+}
+
+// TargetString returns the assertion target as text: strings unquoted, and
+// numbers and objects as their JSON.
+func (a Assertion) TargetString() string {
+	var s string
+	if err := json.Unmarshal(a.Target, &s); err == nil {
+		return s
+	}
+	return string(a.Target)
+}
+
+// Options are the scheduling and request options of a test.
+type Options struct {
+	TickEvery          int  `json:"tick_every"` // seconds between runs
+	FollowRedirects    bool `json:"follow_redirects,omitempty"`
+	AcceptSelfSigned   bool `json:"accept_self_signed,omitempty"`
+	MinFailureDuration int  `json:"min_failure_duration,omitempty"` // seconds
+	MinLocationFailed  int  `json:"min_location_failed,omitempty"`
+}
+
+// Kind returns the subtype of an API test, which defaults to http, and the
+// type of browser and mobile tests.
+func (t Test) Kind() string {
+	if t.Type != "api" {
+		return t.Type
+	}
+	if t.Subtype == "" {
+		return "http"
+	}
+	return t.Subtype
+}
+
+// TestsResponse represents the response from the /synthetics/tests endpoint.
+type TestsResponse struct {
+	Tests []Test `json:"tests"`
+}
+
+// ListTests fetches all Synthetics tests, page by page.
+func (c *Client) ListTests(ctx context.Context) ([]Test, error) {
+	var tests []Test
+	for page := 0; ; page++ {
+		query := url.Values{}
+		query.Set("page_size", strconv.Itoa(pageSize))
+		query.Set("page_number", strconv.Itoa(page))
+
+		var response TestsResponse
+		if err := c.get(ctx, "/api/v1/synthetics/tests?"+query.Encode(), &response); err != nil {
+			return nil, err
+		}
+		tests = append(tests, response.Tests...)
+		if len(response.Tests) < pageSize {
+			return tests, nil
+		}
+	}
+}
+
+// get sends an authenticated GET request and decodes the response into out.
+func (c *Client) get(ctx context.Context, path string, out any) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+path, http.NoBody)
+	if err != nil {
+		return fmt.Errorf("creating request: %w", err)
+	}
+
+	req.Header.Set("DD-API-KEY", c.apiKey)
+	req.Header.Set("DD-APPLICATION-KEY", c.appKey)
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req) //nolint:gosec // G704: baseURL is operator-configured, not user-tainted input
+	if err != nil {
+		return fmt.Errorf("executing request: %w", err)
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("reading response: %w", err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("API error (status %d): %s", resp.StatusCode, string(body))
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("parsing response: %w", err)
+	}
+
+	return nil
+}
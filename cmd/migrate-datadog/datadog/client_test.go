@@ -0,0 +1,162 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package datadog
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestSiteURL(t *testing.T) {
+	tests := map[string]string{
+		"datadoghq.com":     "https://api.datadoghq.com",
+		"datadoghq.eu":      "https://api.datadoghq.eu",
+		"us5.datadoghq.com": "https://api.us5.datadoghq.com",
+		"app.datadoghq.com": "https://api.datadoghq.com",
+		"ap1.datadoghq.com": "https://api.ap1.datadoghq.com",
+		"ddog-gov.com":      "https://api.ddog-gov.com",
+		"app.ddog-gov.com":  "https://api.ddog-gov.com",
+		"us3.datadoghq.com": "https://api.us3.datadoghq.com",
+		"app.datadoghq.eu":  "https://api.datadoghq.eu",
+	}
+	for site, want := range tests {
+		if got := SiteURL(site); got != want {
+			t.Errorf("SiteURL(%q) = %q, want %q", site, got, want)
+		}
+	}
+}
+
+func TestListTests_Success(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/v1/synthetics/tests" {
+			t.Errorf("path = %s, want /api/v1/synthetics/tests", r.URL.Path)
+		}
+		if got := r.Header.Get("DD-API-KEY"); got != "api-key" {
+			t.Errorf("DD-API-KEY = %q", got)
+		}
+		if got := r.Header.Get("DD-APPLICATION-KEY"); got != "app-key" {
+			t.Errorf("DD-APPLICATION-KEY = %q", got)
+		}
+		_, _ = w.Write([]byte(`{"tests":[
+			{"public_id":"abc-def-ghi","name":"API","type":"api","subtype":"http","status":"live",
+			 "tags":["env:prod"],"locations":["aws:eu-west-2"],
+			 "config":{"request":{"method":"GET","url":"https://api.example.com/health"},
+			           "assertions":[{"type":"statusCode","operator":"is","target":200}]},
+			 "options":{"tick_every":300}},
+			{"public_id":"jkl-mno-pqr","name":"DB","type":"api","subtype":"tcp","status":"paused",
+			 "config":{"request":{"host":"db.example.com","port":"5432"}},"options":{"tick_every":60}}
+		]}`))
+	}))
+	defer srv.Close()
+
+	tests, err := NewClient("api-key", "app-key", WithBaseURL(srv.URL+"/")).ListTests(context.Background())
+	if err != nil {
+		t.Fatalf("ListTests error = %v", err)
+	}
+	if len(tests) != 2 {
+		t.Fatalf("got %d tests, want 2", len(tests))
+	}
+	if tests[0].PublicID != "abc-def-ghi" || tests[0].Options.TickEvery != 300 {
+		t.Errorf("first test = %+v", tests[0])
+	}
+	if got := tests[0].Config.Assertions[0].TargetString(); got != "200" {
+		t.Errorf("status target = %q, want 200", got)
+	}
+	if tests[1].Config.Request.Port != 5432 {
+		t.Errorf("port = %d, want 5432 from a numeric string", tests[1].Config.Request.Port)
+	}
+}
+
+func TestListTests_Paginates(t *testing.T) {
+	var pages []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		page := r.URL.Query().Get("page_number")
+		pages = append(pages, page)
+		if got := r.URL.Query().Get("page_size"); got != fmt.Sprint(pageSize) {
+			t.Errorf("page_size = %q, want %d", got, pageSize)
+		}
+
+		count := pageSize
+		if page == "1" {
+			count = 3
+		}
+		response := TestsResponse{Tests: make([]Test, count)}
+		for i := range response.Tests {
+			response.Tests[i] = Test{PublicID: fmt.Sprintf("p%s-%d", page, i), Type: "api"}
+		}
+		_ = json.NewEncoder(w).Encode(response)
+	}))
+	defer srv.Close()
+
+	tests, err := NewClient("k", "a", WithBaseURL(srv.URL)).ListTests(context.Background())
+	if err != nil {
+		t.Fatalf("ListTests error = %v", err)
+	}
+	if len(tests) != pageSize+3 {
+		t.Errorf("got %d tests, want %d", len(tests), pageSize+3)
+	}
+	if strings.Join(pages, ",") != "0,1" {
+		t.Errorf("pages requested = %v, want [0 1]", pages)
+	}
+}
+
+func TestListTests_APIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte(`{"errors":["Forbidden"]}`))
+	}))
+	defer srv.Close()
+
+	_, err := NewClient("k", "", WithBaseURL(srv.URL)).ListTests(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "status 403") {
+		t.Errorf("ListTests error = %v, want a status 403 error", err)
+	}
+}
+
+func TestPort_UnmarshalJSON(t *testing.T) {
+	tests := map[string]Port{
+		`443`:            443,
+		`"8080"`:         8080,
+		`"{{ PORT }}"`:   0,
+		`" 22 "`:         22,
+		`"not-a-number"`: 0,
+	}
+	for input, want := range tests {
+		var p Port
+		if err := json.Unmarshal([]byte(input), &p); err != nil {
+			t.Errorf("Unmarshal(%s) error = %v", input, err)
+			continue
+		}
+		if p != want {
+			t.Errorf("Unmarshal(%s) = %d, want %d", input, p, want)
+		}
+	}
+
+	var p Port
+	if err := json.Unmarshal([]byte(`true`), &p); err == nil {
+		t.Error("Unmarshal(true) succeeded, want an error")
+	}
+}
+
+func TestTest_Kind(t *testing.T) {
+	tests := []struct {
+		test Test
+		want string
+	}{
+		{Test{Type: "api", Subtype: "tcp"}, "tcp"},
+		{Test{Type: "api"}, "http"},
+		{Test{Type: "browser"}, "browser"},
+		{Test{Type: "mobile", Subtype: "ignored"}, "mobile"},
+	}
+	for _, tt := range tests {
+		if got := tt.test.Kind(); got != tt.want {
+			t.Errorf("Kind(%+v) = %q, want %q", tt.test, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+// ImportGenerator generates Terraform import scripts.
+type ImportGenerator struct {
+	prefix string
+}
+
+// NewImportGenerator creates a new ImportGenerator.
+func NewImportGenerator(prefix string) *ImportGenerator {
+	return &ImportGenerator{
+		prefix: prefix,
+	}
+}
+
+// GenerateImportScript generates a shell script that imports the monitors
+// created for the tests, keyed by test public ID in createdResources.
+func (g *ImportGenerator) GenerateImportScript(tests []datadog.Test, results []converter.ConversionResult, createdResources map[string]string) string {
+	var sb strings.Builder
+
+	sb.WriteString("#!/bin/bash\n")
+	sb.WriteString("# Generated Terraform import script for Datadog -> Hyperping migration\n")
+	sb.WriteString("# Run this after applying the Terraform configuration\n\n")
+	sb.WriteString("set -e\n\n")
+
+	sb.WriteString("echo \"Importing Hyperping resources into Terraform state...\"\n")
+	sb.WriteString("echo \"\"\n\n")
+
+	importCount := 0
+	names := ResourceNames(g.prefix, results)
+	for i, test := range tests {
+		if names[i] == "" {
+			continue
+		}
+
+		uuid, ok := createdResources[test.PublicID]
+		if !ok {
+			fmt.Fprintf(&sb, "# Skipping Datadog Test %s (not yet created in Hyperping)\n", test.PublicID)
+			continue
+		}
+
+		fmt.Fprintf(&sb, "# Datadog Test %s: %s\n", test.PublicID, migrate.EscapeShell(test.Name))
+		fmt.Fprintf(&sb, "echo \"Importing hyperping_monitor.%s...\"\n", names[i])
+		// UUID flows through migrate.QuoteShellUUID for defense in depth;
+		// %q does not escape bash metacharacters.
+		fmt.Fprintf(&sb, "terraform import hyperping_monitor.%s %s || echo \"Warning: Import failed for %s\"\n", names[i], migrate.QuoteShellUUID(uuid), names[i])
+		sb.WriteString("echo \"\"\n\n")
+		importCount++
+	}
+
+	fmt.Fprintf(&sb, "echo \"Import complete! Imported %d resources.\"\n", importCount)
+	sb.WriteString("echo \"Run 'terraform plan' to verify the state matches your configuration.\"\n")
+
+	return sb.String()
+}
+
+// ImportAddresses returns the Terraform address GenerateImportScript imports
+// each created monitor to, keyed by monitor UUID.
+func (g *ImportGenerator) ImportAddresses(tests []datadog.Test, results []converter.ConversionResult, createdResources map[string]string) map[string]string {
+	addresses := make(map[string]string)
+	names := ResourceNames(g.prefix, results)
+	for i, test := range tests {
+		uuid, ok := createdResources[test.PublicID]
+		if ok && names[i] != "" {
+			addresses[uuid] = "hyperping_monitor." + names[i]
+		}
+	}
+	return addresses
+}
+
+// MappingEntries returns a mapping entry for every converted test, with the
+// UUID of the monitor created for it, if any.
+func (g *ImportGenerator) MappingEntries(tests []datadog.Test, results []converter.ConversionResult, createdResources map[string]string) []mapping.Entry {
+	var entries []mapping.Entry
+	names := ResourceNames(g.prefix, results)
+	for i, test := range tests {
+		if names[i] == "" {
+			continue
+		}
+		entries = append(entries, mapping.Entry{
+			SourceID:     test.PublicID,
+			ResourceType: mapping.ResourceTypeMonitor,
+			Name:         results[i].Monitor.Name,
+			Address:      "hyperping_monitor." + names[i],
+			UUID:         createdResources[test.PublicID],
+		})
+	}
+	return entries
+}
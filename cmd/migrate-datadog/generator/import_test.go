@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+)
+
+func TestGenerateImportScript_Golden(t *testing.T) {
+	tests, results := fixture()
+	created := map[string]string{
+		"aaa-111-aaa": "mon_checkout",
+		"bbb-222-bbb": "mon_postgres",
+		"ddd-444-ddd": "mon_checkout_2",
+	}
+	goldenAssert(t, "import.sh.golden", NewImportGenerator("dd_").GenerateImportScript(tests, results, created))
+}
+
+func TestImportAddressesAndMapping(t *testing.T) {
+	tests, results := fixture()
+	created := map[string]string{"aaa-111-aaa": "mon_checkout", "ddd-444-ddd": "mon_checkout_2"}
+	g := NewImportGenerator("")
+
+	addresses := g.ImportAddresses(tests, results, created)
+	if len(addresses) != 2 || addresses["mon_checkout_2"] != "hyperping_monitor.checkout_api_2" {
+		t.Errorf("ImportAddresses() = %v", addresses)
+	}
+
+	entries := g.MappingEntries(tests, results, created)
+	if len(entries) != 4 {
+		t.Fatalf("MappingEntries() = %d entries, want one per converted test", len(entries))
+	}
+	first := entries[0]
+	if first.SourceID != "aaa-111-aaa" || first.UUID != "mon_checkout" || first.ResourceType != mapping.ResourceTypeMonitor ||
+		first.Address != "hyperping_monitor.checkout_api" {
+		t.Errorf("first entry = %+v", first)
+	}
+	if entries[1].UUID != "" {
+		t.Errorf("entry for a monitor not created has UUID %q", entries[1].UUID)
+	}
+}
+
+func TestGenerateImportScript_Escapes(t *testing.T) {
+	tests := []datadog.Test{
+		{PublicID: "aaa-111-aaa", Name: "evil\nrm -rf $HOME", Type: "api"},
+		{PublicID: "bbb-222-bbb", Name: "evil-uuid", Type: "api"},
+	}
+	results := []converter.ConversionResult{
+		{Supported: true, Monitor: monitor("evil")},
+		{Supported: true, Monitor: monitor("evil-uuid")},
+	}
+	created := map[string]string{"aaa-111-aaa": "mon_ok", "bbb-222-bbb": "$(rm -rf $HOME)"}
+
+	out := NewImportGenerator("").GenerateImportScript(tests, results, created)
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(line, "rm ") {
+			t.Errorf("test name broke out of its comment: %q", line)
+		}
+	}
+	if strings.Contains(out, "$(rm") {
+		t.Errorf("script contains the unquoted UUID payload:\n%s", out)
+	}
+}
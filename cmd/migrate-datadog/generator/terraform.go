@@ -0,0 +1,152 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+// TerraformGenerator generates Terraform HCL configuration.
+type TerraformGenerator struct {
+	prefix     string
+	compatMode compat.Mode
+}
+
+// NewTerraformGenerator creates a new TerraformGenerator.
+func NewTerraformGenerator(prefix string) *TerraformGenerator {
+	return &TerraformGenerator{
+		prefix: prefix,
+	}
+}
+
+// WithCompatMode sets the compatibility mode. With compat.IgnoreChanges,
+// monitors get lifecycle ignore_changes for the attributes the API does not
+// return faithfully.
+func (g *TerraformGenerator) WithCompatMode(mode compat.Mode) *TerraformGenerator {
+	g.compatMode = mode
+	return g
+}
+
+// GenerateHCL generates Terraform HCL for converted monitors.
+func (g *TerraformGenerator) GenerateHCL(tests []datadog.Test, results []converter.ConversionResult) string {
+	f := hclgen.NewFile()
+	root := f.Body()
+
+	root.Comment("Generated from Datadog Synthetics tests")
+	root.Comment("Review and adjust as needed before applying")
+	root.Newline()
+
+	names := ResourceNames(g.prefix, results)
+	for i, test := range tests {
+		result := results[i]
+
+		root.Comment("Datadog Test ID: %s", test.PublicID)
+		root.Comment("Original Name: %s", test.Name)
+		root.Comment("Type: %s", test.Kind())
+
+		if len(test.Tags) > 0 {
+			root.Comment("Tags: %s", strings.Join(test.Tags, ", "))
+		}
+
+		if result.Skipped {
+			root.Comment("SKIPPED: skip in mapping overrides")
+			root.Newline()
+			continue
+		}
+
+		if !result.Supported || result.Monitor == nil {
+			root.Comment("UNSUPPORTED: %s", result.UnsupportedType)
+			for _, note := range result.Notes {
+				root.Comment("NOTE: %s", note)
+			}
+			root.Newline()
+			continue
+		}
+
+		body := g.generateMonitorHCL(root, names[i], result.Monitor)
+		for _, note := range result.Notes {
+			body.Comment("NOTE: %s", note)
+		}
+		compat.WriteLifecycle(body, g.compatMode, compat.MonitorFields(body, result.Monitor.Protocol))
+
+		root.Newline()
+	}
+
+	return f.String()
+}
+
+func (g *TerraformGenerator) generateMonitorHCL(root *hclgen.Body, name string, monitor *hyperping.CreateMonitorRequest) *hclgen.Body {
+	r := root.Block("resource", "hyperping_monitor", name)
+	r.SetString("name", monitor.Name)
+	r.SetString("url", monitor.URL)
+	r.SetString("protocol", monitor.Protocol)
+
+	if monitor.HTTPMethod != "" && monitor.HTTPMethod != "GET" {
+		r.SetString("http_method", monitor.HTTPMethod)
+	}
+	if monitor.CheckFrequency != 60 {
+		r.SetInt("check_frequency", monitor.CheckFrequency)
+	}
+	if len(monitor.Regions) > 0 {
+		r.SetStringList("regions", monitor.Regions)
+	}
+	if monitor.Port != nil && *monitor.Port != 0 {
+		r.SetInt("port", *monitor.Port)
+	}
+	if monitor.Protocol == "http" && monitor.FollowRedirects != nil && !*monitor.FollowRedirects {
+		r.SetBool("follow_redirects", false)
+	}
+	if monitor.ExpectedStatusCode != "" && monitor.ExpectedStatusCode != "2xx" {
+		r.SetString("expected_status_code", monitor.ExpectedStatusCode)
+	}
+	setOptionalString(r, "required_keyword", monitor.RequiredKeyword)
+	if len(monitor.RequestHeaders) > 0 {
+		headers := make([][]hclgen.Attr, len(monitor.RequestHeaders))
+		for i, h := range monitor.RequestHeaders {
+			headers[i] = []hclgen.Attr{{Name: "name", Value: h.Name}, {Name: "value", Value: h.Value}}
+		}
+		r.SetObjectList("request_headers", headers)
+	}
+	setOptionalString(r, "request_body", monitor.RequestBody)
+	setOptionalString(r, "dns_record_type", monitor.DNSRecordType)
+	setOptionalString(r, "dns_nameserver", monitor.DNSNameserver)
+	setOptionalString(r, "dns_expected_answer", monitor.DNSExpectedAnswer)
+	if monitor.Paused {
+		r.SetBool("paused", true)
+	}
+
+	return r
+}
+
+// setOptionalString sets the attribute when value is set and not empty.
+func setOptionalString(r *hclgen.Body, name string, value *string) {
+	if value == nil || *value == "" {
+		return
+	}
+	r.SetString(name, *value)
+}
+
+// ResourceNames returns the Terraform resource name of each converted
+// monitor, indexed like results, and "" for tests without a monitor. Names
+// are derived from the monitor name, prefixed, and made unique with a
+// numeric suffix.
+func ResourceNames(prefix string, results []converter.ConversionResult) []string {
+	names := make([]string, len(results))
+	seen := make(map[string]int)
+	for i, result := range results {
+		if !result.Supported || result.Monitor == nil {
+			continue
+		}
+		names[i] = migrate.DeduplicateResourceName(prefix+migrate.SanitizeResourceName(result.Monitor.Name), seen)
+	}
+	return names
+}
@@ -0,0 +1,67 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package generator
+
+import (
+	"strings"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+)
+
+func intPtr(n int) *int       { return &n }
+func boolPtr(b bool) *bool    { return &b }
+func strPtr(s string) *string { return &s }
+func monitor(name string) *hyperping.CreateMonitorRequest {
+	return &hyperping.CreateMonitorRequest{Name: name, URL: "https://example.com", Protocol: "http", CheckFrequency: 60}
+}
+
+// fixture returns tests covering every kind of generated block: HTTP, TCP,
+// DNS, a duplicate name, an unsupported test, and a skipped one.
+func fixture() ([]datadog.Test, []converter.ConversionResult) {
+	tests := []datadog.Test{
+		{PublicID: "aaa-111-aaa", Name: "Checkout API", Type: "api", Subtype: "http", Tags: []string{"env:prod", "team:payments"}},
+		{PublicID: "bbb-222-bbb", Name: "Postgres", Type: "api", Subtype: "tcp"},
+		{PublicID: "ccc-333-ccc", Name: "Apex DNS", Type: "api", Subtype: "dns"},
+		{PublicID: "ddd-444-ddd", Name: "Checkout API", Type: "api", Subtype: "http"},
+		{PublicID: "eee-555-eee", Name: "Login journey", Type: "browser"},
+		{PublicID: "fff-666-fff", Name: "Legacy", Type: "api", Subtype: "http"},
+	}
+	results := []converter.ConversionResult{
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{
+			Name: "Checkout API", URL: "https://api.example.com/checkout", Protocol: "http", HTTPMethod: "POST",
+			CheckFrequency: 300, Regions: []string{"london", "virginia"}, FollowRedirects: boolPtr(false),
+			ExpectedStatusCode: "201", RequiredKeyword: strPtr("ok"), RequestBody: strPtr(`{"ping":true}`),
+			RequestHeaders: []hyperping.RequestHeader{{Name: "Accept", Value: "application/json"}},
+		}, Notes: []string{"Assertion not migrated: responseTime lessThan 1000"}},
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{
+			Name: "Postgres", URL: "db.example.com", Protocol: "port", CheckFrequency: 60, Regions: []string{"frankfurt"}, Port: intPtr(5432), Paused: true,
+		}},
+		{Supported: true, Monitor: &hyperping.CreateMonitorRequest{
+			Name: "Apex DNS", URL: "example.com", Protocol: "dns", CheckFrequency: 600, Regions: []string{"london"},
+			DNSRecordType: strPtr("A"), DNSNameserver: strPtr("8.8.8.8"), DNSExpectedAnswer: strPtr("93.184.216.34"),
+		}},
+		{Supported: true, Monitor: monitor("Checkout API")},
+		{UnsupportedType: "browser", Notes: []string{"Browser tests not supported"}},
+		{Skipped: true},
+	}
+	return tests, results
+}
+
+func TestGenerateHCL_Golden(t *testing.T) {
+	tests, results := fixture()
+	goldenAssert(t, "monitors.tf.golden", NewTerraformGenerator("dd_").GenerateHCL(tests, results))
+}
+
+func TestResourceNames(t *testing.T) {
+	_, results := fixture()
+	got := ResourceNames("dd_", results)
+	want := []string{"dd_checkout_api", "dd_postgres", "dd_apex_dns", "dd_checkout_api_2", "", ""}
+	if strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("ResourceNames() = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,730 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// migrate-datadog migrates Datadog Synthetics tests to Hyperping monitors.
+//
+// Usage:
+//
+//	export DD_API_KEY="your_datadog_api_key"
+//	export DD_APP_KEY="your_datadog_application_key"
+//	export HYPERPING_API_KEY="sk_your_hyperping_key"
+//	go run ./cmd/migrate-datadog --output=./migration-output
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/generator"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/report"
+	"github.com/develeap/terraform-provider-hyperping/pkg/checkpoint"
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrationstate"
+	"github.com/develeap/terraform-provider-hyperping/pkg/recovery"
+)
+
+var (
+	datadogAPIKey       = flag.String("datadog-api-key", "", "Datadog API key (or set DD_API_KEY)")
+	datadogAppKey       = flag.String("datadog-app-key", "", "Datadog application key (or set DD_APP_KEY)")
+	datadogSite         = flag.String("datadog-site", "", "Datadog site, e.g. datadoghq.eu or us5.datadoghq.com (or set DD_SITE; default datadoghq.com)")
+	hyperpingAPIKey     = flag.String("hyperping-api-key", "", "Hyperping API key (or set HYPERPING_API_KEY)")
+	outputDir           = flag.String("output", "./datadog-migration", "Output directory for generated files")
+	prefix              = flag.String("prefix", "", "Prefix for Terraform resource names")
+	datadogBaseURL      = flag.String("datadog-base-url", "", "Datadog API base URL, overriding --datadog-site (optional)")
+	hyperpingBaseURL    = flag.String("hyperping-base-url", "https://api.hyperping.io", "Hyperping API base URL")
+	dryRun              = flag.Bool("dry-run", false, "Generate configs without creating resources in Hyperping")
+	verbose             = flag.Bool("verbose", false, "Verbose output")
+	resume              = flag.Bool("resume", false, "Resume from last checkpoint")
+	resumeID            = flag.String("resume-id", "", "Resume from specific checkpoint ID")
+	rollback            = flag.Bool("rollback", false, "Rollback migration (delete Hyperping resources)")
+	rollbackID          = flag.String("rollback-id", "", "Rollback specific migration ID")
+	rollbackForce       = flag.Bool("force", false, "Force rollback without confirmation")
+	listCheckpointsFlag = flag.Bool("list-checkpoints", false, "List available checkpoints")
+	verifyMode          = flag.Bool("verify", false, "Compare Datadog tests with existing Hyperping monitors and write the verification report")
+	verifyReport        = flag.String("verify-report", "verification-report.json", "Output verification report file (use with --verify); relative paths are in the output directory")
+	nameTemplateFlag    = flag.String("name-template", "", "Go template for Hyperping names instead of the Datadog test name (fields: .Name, .Tags)")
+	overridesFlag       = flag.String("overrides", "", "YAML mapping override file: per Datadog test public ID, name, regions, frequency, or skip")
+	regionMapFlag       = flag.String("region-map", "", "YAML file mapping Datadog locations to Hyperping regions, overriding the built-in table and nearest-region fallback")
+	frequencyPolicyFlag = flag.String("frequency-policy", string(migrate.FrequencyNearest), "How to snap tick_every values Hyperping does not support: nearest, round-up, round-down, or fail")
+	logFlags            = recovery.RegisterLogFlags(flag.CommandLine)
+	checkWriteAccess    = recovery.RegisterWriteCheckFlag(flag.CommandLine)
+	listFlags           = migrationstate.RegisterListFlags(flag.CommandLine, toolName)
+	rollbackFlags       = migrationstate.RegisterRollbackFlags(flag.CommandLine)
+	webhookFlags        = migrationstate.RegisterWebhookFlags(flag.CommandLine)
+	outputDialectFlag   = dialect.RegisterFlag(flag.CommandLine)
+	compatModeFlag      = compat.RegisterFlag(flag.CommandLine)
+	mappingFlag         = mapping.RegisterFlag(flag.CommandLine, "")
+
+	// nameTemplate is parsed from --name-template in run; nil keeps the test name.
+	nameTemplate *migrate.NameTemplate
+	// overrides is loaded from --overrides in run; nil applies none.
+	overrides *migrate.Overrides
+	// regionMap is loaded from --region-map in run; nil applies none.
+	regionMap *migrate.RegionMap
+	// frequencyPolicy is parsed from --frequency-policy in run.
+	frequencyPolicy migrate.FrequencyPolicy
+	// outputDialect is parsed from --output-dialect in run.
+	outputDialect dialect.Dialect
+	// compatMode is parsed from --compat-mode in run.
+	compatMode compat.Mode
+	// notifier is built from --webhook-url in run; nil sends no webhooks.
+	notifier *migrationstate.Notifier
+)
+
+// datadogRunner holds resolved configuration for a run.
+type datadogRunner struct {
+	apiKey       string
+	appKey       string
+	hyperpingKey string
+	ctx          context.Context
+	cancel       context.CancelFunc
+	state        *migrationstate.State
+	migrationID  string
+}
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: migrate-datadog [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Migrates Datadog Synthetics tests to Hyperping monitors.\n\n")
+		fmt.Fprintf(os.Stderr, "Options:\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nExamples:\n")
+		fmt.Fprintf(os.Stderr, "  # Dry run (generate configs only)\n")
+		fmt.Fprintf(os.Stderr, "  migrate-datadog --dry-run --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Full migration\n")
+		fmt.Fprintf(os.Stderr, "  migrate-datadog --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Datadog EU site\n")
+		fmt.Fprintf(os.Stderr, "  migrate-datadog --datadog-site=datadoghq.eu --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # With resource name prefix\n")
+		fmt.Fprintf(os.Stderr, "  migrate-datadog --prefix=dd_ --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Prefix names with the env:<value> tag\n")
+		fmt.Fprintf(os.Stderr, "  migrate-datadog --name-template='[{{.Tag \"env\" | upper}}] {{.Name}}'\n\n")
+		fmt.Fprintf(os.Stderr, "  # Correct names, regions, or frequencies per test, or skip tests\n")
+		fmt.Fprintf(os.Stderr, "  migrate-datadog --overrides=overrides.yaml --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Map Datadog locations to specific Hyperping regions\n")
+		fmt.Fprintf(os.Stderr, "  migrate-datadog --region-map=regions.yaml --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Resume from last checkpoint\n")
+		fmt.Fprintf(os.Stderr, "  migrate-datadog --resume\n\n")
+		fmt.Fprintf(os.Stderr, "  # Verify migrated monitors\n")
+		fmt.Fprintf(os.Stderr, "  migrate-datadog --verify --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Rollback migration\n")
+		fmt.Fprintf(os.Stderr, "  migrate-datadog --rollback --rollback-id=datadog-20260213-120000\n\n")
+	}
+
+	os.Exit(run())
+}
+
+func run() int {
+	flag.Parse()
+
+	if err := clientenv.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var err error
+	nameTemplate, err = migrate.ParseNameTemplate(*nameTemplateFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	overrides, err = migrate.LoadOverrides(*overridesFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	regionMap, err = migrate.LoadRegionMap(*regionMapFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	frequencyPolicy, err = migrate.ParseFrequencyPolicy(*frequencyPolicyFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	outputDialect, err = dialect.ParseDialect(*outputDialectFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	compatMode, err = compat.ParseMode(*compatModeFlag)
+	if err == nil {
+		err = compatMode.CheckDialect(outputDialect)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	notifier, err = webhookFlags.Notifier()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if *listCheckpointsFlag {
+		return migrationstate.ListCheckpoints(listFlags.Options())
+	}
+
+	if *rollback {
+		return handleRollback()
+	}
+
+	r, exitCode := newDatadogRunner()
+	if exitCode != 0 {
+		return exitCode
+	}
+	defer r.cancel()
+
+	if *verifyMode {
+		return r.runVerification()
+	}
+
+	if *dryRun {
+		if exitCode := r.runDryValidation(); exitCode != 0 {
+			return r.fail(exitCode)
+		}
+	}
+
+	tests, results, exitCode := r.fetchAndConvert()
+	if exitCode != 0 {
+		return exitCode
+	}
+
+	reporter := report.NewReporter()
+	migrationReport := reporter.GenerateReport(tests, results)
+
+	if exitCode := r.writeReports(reporter, migrationReport); exitCode != 0 {
+		return r.fail(exitCode)
+	}
+
+	createdResources := r.createHyperpingResources(tests, results)
+	if r.state != nil && !*dryRun {
+		r.state.Notify(migrationstate.PhaseResourcesCreated, fmt.Sprintf("created %d monitors in Hyperping", len(createdResources)))
+	}
+
+	if exitCode := r.writeImportScript(tests, results, createdResources); exitCode != 0 {
+		return r.fail(exitCode)
+	}
+
+	if exitCode := r.writeMapping(tests, results, createdResources); exitCode != 0 {
+		return r.fail(exitCode)
+	}
+
+	if r.state != nil {
+		hasFailures := r.state.Checkpoint.Failed > 0
+		r.state.Finalize(!hasFailures)
+		if failureReport := r.state.GetFailureReport(); failureReport != "" {
+			fmt.Fprintln(os.Stderr, failureReport)
+		}
+	}
+
+	printRunSummary(migrationReport)
+	return 0
+}
+
+// handleRollback resolves the migration ID and delegates to the shared rollback implementation.
+func handleRollback() int {
+	hpKey := *hyperpingAPIKey
+	if hpKey == "" {
+		hpKey = os.Getenv("HYPERPING_API_KEY")
+	}
+	if hpKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: Hyperping API key is required for rollback")
+		fmt.Fprintln(os.Stderr, "Set --hyperping-api-key flag or HYPERPING_API_KEY environment variable")
+		return 1
+	}
+
+	logger, err := recovery.NewLoggerWithOptions(*verbose, logFlags.Options())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to create logger: %v\n", err)
+		return 1
+	}
+	defer logger.Close()
+
+	migID := *rollbackID
+	if migID == "" {
+		mgr, mgrErr := checkpoint.NewManager()
+		if mgrErr != nil {
+			fmt.Fprintf(os.Stderr, "Error: Failed to create checkpoint manager: %v\n", mgrErr)
+			return 1
+		}
+		latest, latestErr := mgr.FindLatest(toolName)
+		if latestErr != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", latestErr)
+			fmt.Fprintln(os.Stderr, "Use --rollback-id to specify a checkpoint or --list-checkpoints to see available checkpoints")
+			return 1
+		}
+		migID = latest.MigrationID
+	}
+
+	return migrationstate.PerformRollback(migID, hpKey, *rollbackForce, rollbackOptions(), logger)
+}
+
+// resolveKey returns flagValue, or the first non-empty environment variable
+// in envVars.
+func resolveKey(flagValue string, envVars ...string) string {
+	if flagValue != "" {
+		return flagValue
+	}
+	for _, name := range envVars {
+		if value := os.Getenv(name); value != "" {
+			return value
+		}
+	}
+	return ""
+}
+
+// datadogAPIBaseURL returns --datadog-base-url, or the API URL of the
+// Datadog site from --datadog-site or DD_SITE.
+func datadogAPIBaseURL() string {
+	if *datadogBaseURL != "" {
+		return *datadogBaseURL
+	}
+	site := resolveKey(*datadogSite, "DD_SITE")
+	if site == "" {
+		site = datadog.DefaultSite
+	}
+	return datadog.SiteURL(site)
+}
+
+// newDatadogRunner validates flags, resolves API keys, sets up the context, and initialises state.
+func newDatadogRunner() (*datadogRunner, int) {
+	apiKey := resolveKey(*datadogAPIKey, "DD_API_KEY", "DATADOG_API_KEY")
+	appKey := resolveKey(*datadogAppKey, "DD_APP_KEY", "DD_APPLICATION_KEY", "DATADOG_APP_KEY")
+	hyperpingKey := resolveKey(*hyperpingAPIKey, "HYPERPING_API_KEY")
+
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: Datadog API key is required (--datadog-api-key or DD_API_KEY)")
+		return nil, 1
+	}
+
+	if appKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: Datadog application key is required (--datadog-app-key or DD_APP_KEY)")
+		return nil, 1
+	}
+
+	if hyperpingKey == "" && !*dryRun {
+		fmt.Fprintln(os.Stderr, "Error: Hyperping API key is required (--hyperping-api-key or HYPERPING_API_KEY)")
+		fmt.Fprintln(os.Stderr, "Hint: Use --dry-run to generate configs without creating resources")
+		return nil, 1
+	}
+
+	if err := os.MkdirAll(*outputDir, 0o700); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creating output directory: %v\n", err)
+		return nil, 1
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+
+	r := &datadogRunner{
+		apiKey:       apiKey,
+		appKey:       appKey,
+		hyperpingKey: hyperpingKey,
+		ctx:          ctx,
+		cancel:       cancel,
+	}
+
+	// Verification is read-only and does not create a checkpoint.
+	if *verifyMode {
+		return r, 0
+	}
+
+	if err := r.initState(); err != nil {
+		cancel()
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return nil, 1
+	}
+
+	return r, 0
+}
+
+// initState initialises or resumes migration state.
+func (r *datadogRunner) initState() error {
+	logger, err := recovery.NewLoggerWithOptions(*verbose, logFlags.Options())
+	if err != nil {
+		return fmt.Errorf("failed to create logger: %w", err)
+	}
+
+	migID := *resumeID
+	if *resume || migID != "" {
+		if migID == "" {
+			mgr, mgrErr := checkpoint.NewManager()
+			if mgrErr != nil {
+				_ = logger.Close() //nolint:errcheck // #nosec G104 -- best-effort cleanup before returning error
+				return fmt.Errorf("failed to create checkpoint manager: %w", mgrErr)
+			}
+			latest, latestErr := mgr.FindLatest(toolName)
+			if latestErr != nil {
+				_ = logger.Close() //nolint:errcheck // #nosec G104 -- best-effort cleanup before returning error
+				return fmt.Errorf("no checkpoint found to resume from")
+			}
+			migID = latest.MigrationID
+		}
+		state, stateErr := migrationstate.Resume(migID, logger)
+		if stateErr != nil {
+			_ = logger.Close() //nolint:errcheck // #nosec G104 -- best-effort cleanup before returning error
+			return fmt.Errorf("failed to resume from checkpoint: %w", stateErr)
+		}
+		r.state = state
+		r.migrationID = migID
+		return nil
+	}
+
+	migID = checkpoint.GenerateMigrationID(toolName)
+	// totalResources will be updated after fetch; use 0 as placeholder
+	state, stateErr := migrationstate.New(toolName, migID, 0, logger)
+	if stateErr != nil {
+		_ = logger.Close() //nolint:errcheck // #nosec G104 -- best-effort cleanup before returning error
+		return fmt.Errorf("failed to create migration state: %w", stateErr)
+	}
+	r.state = state
+	r.migrationID = migID
+	return nil
+}
+
+// runDryValidation validates the Datadog and Hyperping API keys in dry-run
+// mode and reports both in one summary.
+func (r *datadogRunner) runDryValidation() int {
+	validator := recovery.NewAPIValidator(r.state.Logger)
+
+	source := validator.ValidateSourceAPI(r.ctx, "Datadog", func(ctx context.Context) error {
+		_, err := r.datadogClient().ListTests(ctx)
+		return err
+	})
+
+	destination := recovery.SkippedValidation(recovery.DestinationServiceName, "no API key")
+	if r.hyperpingKey != "" {
+		destination = validator.ValidateDestinationAPI(r.ctx, createHyperpingClient(r.hyperpingKey), *checkWriteAccess)
+	}
+
+	if !recovery.PrintValidationSummary(os.Stderr, source, destination) {
+		return 1
+	}
+	return 0
+}
+
+// fetchAndConvert fetches Datadog Synthetics tests and converts them to Hyperping format.
+func (r *datadogRunner) fetchAndConvert() ([]datadog.Test, []converter.ConversionResult, int) {
+	log("Fetching Datadog Synthetics tests...")
+	tests, err := r.datadogClient().ListTests(r.ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching Datadog tests: %v\n", err)
+		return nil, nil, 1
+	}
+	log(fmt.Sprintf("Fetched %d tests from Datadog", len(tests)))
+
+	if r.state != nil {
+		r.state.Checkpoint.TotalResources = len(tests)
+		if !*dryRun {
+			r.state.SetNotifier(notifier)
+			r.state.Notify(migrationstate.PhaseStarted, "")
+		}
+	}
+
+	warnUnknownOverrides(tests)
+
+	log("Converting tests to Hyperping format...")
+	testConverter := newTestConverter().WithFrequencyPolicy(frequencyPolicy)
+	results := make([]converter.ConversionResult, len(tests))
+	supportedCount := 0
+	skippedCount := 0
+	for i, test := range tests {
+		testID := "test-" + test.PublicID
+		if r.state != nil && r.state.IsProcessed(testID) {
+			log(fmt.Sprintf("Skipping already processed test: %s", testID))
+			results[i] = testConverter.Convert(test)
+			if results[i].Supported {
+				supportedCount++
+			}
+			continue
+		}
+
+		results[i] = testConverter.Convert(test)
+		if results[i].Supported {
+			supportedCount++
+		}
+		if results[i].Skipped {
+			skippedCount++
+		}
+
+		if r.state != nil {
+			if results[i].Supported || results[i].Skipped {
+				r.state.MarkResourceProcessed(testID)
+			} else {
+				reason := "unsupported test type"
+				switch results[i].UnsupportedType {
+				case converter.UnsupportedFrequency:
+					reason = "unsupported test frequency"
+				case converter.UnsupportedVariables:
+					reason = "test uses variables"
+				}
+				r.state.MarkResourceFailed(testID, "test", test.Name, reason)
+			}
+		}
+	}
+	log(fmt.Sprintf("Converted %d/%d tests (%d unsupported, %d skipped)", supportedCount, len(tests), len(tests)-supportedCount-skippedCount, skippedCount))
+
+	if r.state != nil {
+		r.state.SaveCheckpoint()
+	}
+
+	log("Generating Terraform configuration...")
+	tfGen := generator.NewTerraformGenerator(*prefix).WithCompatMode(compatMode)
+	hclContent := tfGen.GenerateHCL(tests, results)
+
+	hclPath := filepath.Join(*outputDir, "monitors.tf")
+	paths, writeErr := dialect.WriteConfig(outputDialect, []byte(hclContent), hclPath)
+	if writeErr != nil {
+		fmt.Fprintf(os.Stderr, "Error writing Terraform configuration: %v\n", writeErr)
+		return nil, nil, 1
+	}
+	for _, path := range paths {
+		log(fmt.Sprintf("Terraform configuration written to %s", path))
+	}
+	if r.state != nil {
+		r.state.AddGeneratedFiles(paths...)
+	}
+
+	return tests, results, 0
+}
+
+// newTestConverter returns a converter with the name template, overrides,
+// and region map from the flags.
+func newTestConverter() *converter.TestConverter {
+	return converter.NewTestConverter().WithNameTemplate(nameTemplate).WithOverrides(overrides).WithRegionMap(regionMap)
+}
+
+// warnUnknownOverrides warns about mapping overrides whose ID matches no
+// fetched test, which usually means a typo.
+func warnUnknownOverrides(tests []datadog.Test) {
+	ids := make([]string, len(tests))
+	for i, test := range tests {
+		ids[i] = test.PublicID
+	}
+	for _, id := range overrides.Unknown(ids) {
+		fmt.Fprintf(os.Stderr, "Warning: mapping override %q matches no Datadog test\n", id)
+	}
+}
+
+// writeReports generates and writes all report files.
+func (r *datadogRunner) writeReports(reporter *report.Reporter, migrationReport *report.MigrationReport) int {
+	log("Generating migration report...")
+
+	jsonReport, err := reporter.GenerateJSONReport(migrationReport)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error generating JSON report: %v\n", err)
+		return 1
+	}
+	jsonPath := filepath.Join(*outputDir, "report.json")
+	if writeErr := os.WriteFile(jsonPath, []byte(jsonReport), 0o600); writeErr != nil {
+		fmt.Fprintf(os.Stderr, "Error writing JSON report: %v\n", writeErr)
+		return 1
+	}
+
+	textReport := reporter.GenerateTextReport(migrationReport)
+	textPath := filepath.Join(*outputDir, "report.txt")
+	if writeErr := os.WriteFile(textPath, []byte(textReport), 0o600); writeErr != nil {
+		fmt.Fprintf(os.Stderr, "Error writing text report: %v\n", writeErr)
+		return 1
+	}
+
+	manualSteps := reporter.GenerateManualStepsMarkdown(migrationReport)
+	manualPath := filepath.Join(*outputDir, "manual-steps.md")
+	if writeErr := os.WriteFile(manualPath, []byte(manualSteps), 0o600); writeErr != nil {
+		fmt.Fprintf(os.Stderr, "Error writing manual steps: %v\n", writeErr)
+		return 1
+	}
+	if r.state != nil {
+		r.state.AddGeneratedFiles(jsonPath, textPath, manualPath)
+	}
+
+	log(fmt.Sprintf("Reports written to %s", *outputDir))
+	return 0
+}
+
+// createHyperpingResources creates monitors in Hyperping (skipped in dry-run
+// mode) and returns their UUIDs keyed by test public ID.
+func (r *datadogRunner) createHyperpingResources(tests []datadog.Test, results []converter.ConversionResult) map[string]string {
+	createdResources := make(map[string]string)
+	if *dryRun {
+		return createdResources
+	}
+
+	log("Creating monitors in Hyperping...")
+	hyperpingClient := createHyperpingClient(r.hyperpingKey)
+	createdCount := 0
+	errorCount := 0
+
+	for i, test := range tests {
+		result := results[i]
+		if !result.Supported || result.Monitor == nil {
+			continue
+		}
+
+		monitor, err := hyperpingClient.CreateMonitor(r.ctx, *result.Monitor)
+		if err != nil {
+			errorCount++
+			fmt.Fprintf(os.Stderr, "Warning: Failed to create monitor for test %s (%s): %v\n", test.PublicID, test.Name, err)
+			continue
+		}
+
+		createdResources[test.PublicID] = monitor.UUID
+		if r.state != nil {
+			r.state.AddHyperpingResource(monitor.UUID, "monitor")
+		}
+		createdCount++
+
+		if *verbose {
+			log(fmt.Sprintf("Created monitor %s for test %s (%s)", monitor.UUID, test.PublicID, test.Name))
+		}
+	}
+
+	log(fmt.Sprintf("Created %d monitors in Hyperping (%d errors)", createdCount, errorCount))
+	return createdResources
+}
+
+// fail saves the checkpoint as failed, which also reports the failure to the
+// webhook, and returns exitCode.
+func (r *datadogRunner) fail(exitCode int) int {
+	if r.state != nil {
+		r.state.Finalize(false)
+	}
+	return exitCode
+}
+
+// writeImportScript generates and writes the import shell script.
+func (r *datadogRunner) writeImportScript(tests []datadog.Test, results []converter.ConversionResult, createdResources map[string]string) int {
+	log("Generating import script...")
+	importGen := generator.NewImportGenerator(*prefix)
+	importScriptContent := importGen.GenerateImportScript(tests, results, createdResources)
+
+	importPath := filepath.Join(*outputDir, "import.sh")
+	if writeErr := os.WriteFile(importPath, []byte(importScriptContent), 0o700); writeErr != nil { // #nosec G306 -- import.sh must be executable (0700)
+		fmt.Fprintf(os.Stderr, "Error writing import script: %v\n", writeErr)
+		return 1
+	}
+	if r.state != nil {
+		r.state.AddGeneratedFiles(importPath)
+		for uuid, address := range importGen.ImportAddresses(tests, results, createdResources) {
+			r.state.SetResourceAddress(uuid, address)
+		}
+	}
+
+	log(fmt.Sprintf("Import script written to %s", importPath))
+	return 0
+}
+
+// writeMapping writes the mapping from Datadog test public IDs to Hyperping
+// UUIDs and Terraform addresses. It is not recorded for --rollback-files, so
+// it still traces the resources to their origin after a rollback.
+func (r *datadogRunner) writeMapping(tests []datadog.Test, results []converter.ConversionResult, createdResources map[string]string) int {
+	idMap := mapping.New("Datadog", toolName, r.migrationID)
+	for _, entry := range generator.NewImportGenerator(*prefix).MappingEntries(tests, results, createdResources) {
+		idMap.Add(entry)
+	}
+
+	path := mappingPath()
+	if err := idMap.Write(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing mapping: %v\n", err)
+		return 1
+	}
+	log(fmt.Sprintf("Mapping written to %s", path))
+	return 0
+}
+
+// mappingPath returns the --mapping file, which defaults to mapping.json in
+// the output directory.
+func mappingPath() string {
+	if *mappingFlag != "" {
+		return *mappingFlag
+	}
+	return filepath.Join(*outputDir, mapping.DefaultFileName)
+}
+
+// rollbackOptions returns the rollback options, including the mapping file
+// of the migration.
+func rollbackOptions() migrationstate.RollbackOptions {
+	opts := rollbackFlags.Options()
+	opts.MappingFile = mappingPath()
+	return opts
+}
+
+// printRunSummary prints the final migration summary and next steps.
+func printRunSummary(migrationReport *report.MigrationReport) {
+	importPath := filepath.Join(*outputDir, "import.sh")
+	jsonPath := filepath.Join(*outputDir, "report.json")
+	textPath := filepath.Join(*outputDir, "report.txt")
+	manualPath := filepath.Join(*outputDir, "manual-steps.md")
+
+	fmt.Println()
+	fmt.Println("=================================================================")
+	fmt.Println("Migration Complete!")
+	fmt.Println("=================================================================")
+	fmt.Println()
+	fmt.Printf("Output directory: %s\n", *outputDir)
+	fmt.Println()
+	fmt.Println("Generated files:")
+	for _, name := range dialect.FileNames(outputDialect, "monitors.tf") {
+		fmt.Printf("  - %s (%s configuration)\n", name, outputDialect)
+	}
+	fmt.Printf("  - %s (import script)\n", filepath.Base(importPath))
+	fmt.Printf("  - %s (JSON report)\n", filepath.Base(jsonPath))
+	fmt.Printf("  - %s (text report)\n", filepath.Base(textPath))
+	fmt.Printf("  - %s (manual steps)\n", filepath.Base(manualPath))
+	fmt.Printf("  - %s (source ID mapping)\n", mappingPath())
+	fmt.Println()
+
+	if *dryRun {
+		fmt.Println("DRY RUN: No resources were created in Hyperping")
+		fmt.Println("Review the generated files and run without --dry-run to create resources")
+	} else {
+		fmt.Println("Next steps:")
+		fmt.Println("  1. Review monitors.tf and adjust as needed")
+		fmt.Println("  2. Run 'terraform init' and 'terraform plan'")
+		fmt.Println("  3. Run './import.sh' to import resources into Terraform state")
+		fmt.Println("  4. Review manual-steps.md for unsupported tests")
+	}
+
+	fmt.Println()
+	fmt.Printf("Summary: %d total tests, %d supported, %d unsupported\n",
+		migrationReport.TotalTests,
+		migrationReport.SupportedTests,
+		migrationReport.UnsupportedTests)
+
+	if len(migrationReport.ManualSteps) > 0 {
+		fmt.Printf("Manual steps required: %d (see manual-steps.md)\n", len(migrationReport.ManualSteps))
+	}
+}
+
+func (r *datadogRunner) datadogClient() *datadog.Client {
+	return datadog.NewClient(r.apiKey, r.appKey, datadog.WithBaseURL(datadogAPIBaseURL()))
+}
+
+func createHyperpingClient(apiKey string) *hyperping.Client {
+	return clientenv.NewClient(apiKey, hyperping.WithBaseURL(*hyperpingBaseURL))
+}
+
+func log(msg string) {
+	if *verbose {
+		fmt.Fprintf(os.Stderr, "[migrate-datadog] %s\n", msg)
+	}
+}
@@ -0,0 +1,86 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+)
+
+func TestResolveKey(t *testing.T) {
+	t.Setenv("DD_API_KEY", "")
+	t.Setenv("DATADOG_API_KEY", "from-fallback")
+
+	if got := resolveKey("from-flag", "DD_API_KEY", "DATADOG_API_KEY"); got != "from-flag" {
+		t.Errorf("resolveKey() = %q, want the flag value", got)
+	}
+	if got := resolveKey("", "DD_API_KEY", "DATADOG_API_KEY"); got != "from-fallback" {
+		t.Errorf("resolveKey() = %q, want the first set variable", got)
+	}
+
+	t.Setenv("DD_API_KEY", "from-primary")
+	if got := resolveKey("", "DD_API_KEY", "DATADOG_API_KEY"); got != "from-primary" {
+		t.Errorf("resolveKey() = %q, want DD_API_KEY first", got)
+	}
+}
+
+func TestDatadogAPIBaseURL(t *testing.T) {
+	setFlag := func(t *testing.T, flag *string, value string) {
+		t.Helper()
+		previous := *flag
+		*flag = value
+		t.Cleanup(func() { *flag = previous })
+	}
+
+	t.Setenv("DD_SITE", "")
+	if got := datadogAPIBaseURL(); got != "https://api.datadoghq.com" {
+		t.Errorf("default = %q", got)
+	}
+
+	t.Setenv("DD_SITE", "datadoghq.eu")
+	if got := datadogAPIBaseURL(); got != "https://api.datadoghq.eu" {
+		t.Errorf("DD_SITE = %q", got)
+	}
+
+	setFlag(t, datadogSite, "us5.datadoghq.com")
+	if got := datadogAPIBaseURL(); got != "https://api.us5.datadoghq.com" {
+		t.Errorf("--datadog-site = %q", got)
+	}
+
+	setFlag(t, datadogBaseURL, "http://localhost:8080")
+	if got := datadogAPIBaseURL(); got != "http://localhost:8080" {
+		t.Errorf("--datadog-base-url = %q", got)
+	}
+}
+
+func TestVerifySources(t *testing.T) {
+	status, _ := json.Marshal(200) //nolint:errcheck // constant input
+	tests := []datadog.Test{
+		{
+			PublicID: "abc-def-ghi", Name: "API", Type: "api", Subtype: "http",
+			Locations: []string{"aws:eu-west-2"},
+			Config: datadog.Config{
+				Request:    datadog.Request{URL: "https://api.example.com", Timeout: 30},
+				Assertions: []datadog.Assertion{{Type: "statusCode", Operator: "is", Target: status}},
+			},
+			Options: datadog.Options{TickEvery: 300},
+		},
+		{PublicID: "jkl-mno-pqr", Name: "Login", Type: "browser"},
+	}
+
+	sources := verifySources(tests)
+	if len(sources) != 1 {
+		t.Fatalf("verifySources() = %d sources, want only the supported test", len(sources))
+	}
+	src := sources[0]
+	if src.ID != "abc-def-ghi" || src.Frequency != 300 || src.Timeout != 30 {
+		t.Errorf("source = %+v", src)
+	}
+	if strings.Join(src.Regions, ",") != "london" || strings.Join(src.ExpectedStatusCodes, ",") != "200" {
+		t.Errorf("Regions = %v, ExpectedStatusCodes = %v", src.Regions, src.ExpectedStatusCodes)
+	}
+}
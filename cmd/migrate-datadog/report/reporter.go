@@ -0,0 +1,283 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+// MigrationReport contains the complete migration report.
+type MigrationReport struct {
+	Timestamp        time.Time      `json:"timestamp"`
+	TotalTests       int            `json:"total_tests"`
+	SupportedTests   int            `json:"supported_tests"`
+	UnsupportedTests int            `json:"unsupported_tests"`
+	SkippedTests     int            `json:"skipped_tests"`
+	TestsByType      map[string]int `json:"tests_by_type"`
+	UnsupportedTypes map[string]int `json:"unsupported_types"`
+	ManualSteps      []ManualStep   `json:"manual_steps"`
+	Warnings         []string       `json:"warnings"`
+	// FrequencyAdjustments lists every test tick_every that was snapped to
+	// a supported Hyperping check frequency.
+	FrequencyAdjustments []migrate.FrequencyAdjustment `json:"frequency_adjustments"`
+	Estimate             *migrate.Estimate             `json:"estimate"`
+}
+
+// ManualStep represents a manual action required.
+type ManualStep struct {
+	TestID      string `json:"test_id"`
+	TestName    string `json:"test_name"`
+	TestType    string `json:"test_type"`
+	Description string `json:"description"`
+	Action      string `json:"action"`
+}
+
+// Reporter generates migration reports.
+type Reporter struct{}
+
+// NewReporter creates a new Reporter.
+func NewReporter() *Reporter {
+	return &Reporter{}
+}
+
+// GenerateReport generates a comprehensive migration report.
+func (r *Reporter) GenerateReport(tests []datadog.Test, results []converter.ConversionResult) *MigrationReport {
+	report := &MigrationReport{
+		Timestamp:            time.Now(),
+		TotalTests:           len(tests),
+		TestsByType:          make(map[string]int),
+		UnsupportedTypes:     make(map[string]int),
+		ManualSteps:          []ManualStep{},
+		Warnings:             []string{},
+		FrequencyAdjustments: []migrate.FrequencyAdjustment{},
+	}
+
+	var loads []migrate.MonitorLoad
+	for i, test := range tests {
+		result := results[i]
+		if result.Monitor != nil && !result.Skipped {
+			loads = append(loads, migrate.MonitorLoad{
+				CheckFrequency: result.Monitor.CheckFrequency,
+				Regions:        len(result.Monitor.Regions),
+				Paused:         result.Monitor.Paused,
+			})
+		}
+
+		if result.FrequencyAdjustment != nil && !result.Skipped {
+			report.FrequencyAdjustments = append(report.FrequencyAdjustments, *result.FrequencyAdjustment)
+		}
+
+		// Count by type
+		report.TestsByType[test.Kind()]++
+
+		if result.Skipped {
+			report.SkippedTests++
+		} else if result.Supported {
+			report.SupportedTests++
+
+			// Add warnings for special handling
+			for _, note := range result.Notes {
+				report.Warnings = append(report.Warnings, fmt.Sprintf("Test %s (%s): %s", test.PublicID, test.Name, note))
+			}
+		} else {
+			report.UnsupportedTests++
+			report.UnsupportedTypes[result.UnsupportedType]++
+
+			// Add manual step
+			step := r.generateManualStep(test, result)
+			report.ManualSteps = append(report.ManualSteps, step)
+		}
+	}
+	report.Estimate = migrate.NewEstimate(loads, 0)
+
+	return report
+}
+
+func (r *Reporter) generateManualStep(test datadog.Test, result converter.ConversionResult) ManualStep {
+	step := ManualStep{
+		TestID:   test.PublicID,
+		TestName: test.Name,
+		TestType: test.Kind(),
+	}
+
+	switch result.UnsupportedType {
+	case converter.UnsupportedFrequency:
+		step.Description = fmt.Sprintf("Test tick_every of %ds is not a supported Hyperping frequency (--frequency-policy=fail)", test.Options.TickEvery)
+		step.Action = "Option 1: Set a supported frequency for this test in the --overrides file\n" +
+			"Option 2: Rerun with --frequency-policy=round-up, round-down, or nearest"
+
+	case converter.UnsupportedVariables:
+		step.Description = "Test request uses Datadog global or local variables that cannot be resolved at migration time"
+		step.Action = "Option 1: Replace the variables with literal values in Datadog and rerun the migration\n" +
+			"Option 2: Create the Hyperping monitor manually with the resolved URL, host, and port"
+
+	case "ssl":
+		step.Description = "SSL certificate tests are not a separate monitor type in Hyperping"
+		step.Action = "Create an HTTP monitor for the host over https://.\n" +
+			"Hyperping tracks certificate expiry on HTTPS monitors (ssl_expiration)."
+
+	case "udp", "websocket", "grpc":
+		step.Description = fmt.Sprintf("%s tests are not supported by Hyperping", strings.ToUpper(result.UnsupportedType))
+		step.Action = "Option 1: Use an HTTP or TCP port monitor if the service exposes one\n" +
+			"Option 2: Monitor the application that uses the service instead\n" +
+			"Option 3: Use an external monitoring tool with a webhook to a Hyperping healthcheck"
+
+	case "multi":
+		step.Description = "Multistep API tests chain requests and cannot be expressed as a single monitor"
+		step.Action = "Option 1: Create one HTTP monitor per step that can be checked independently\n" +
+			"Option 2: Script the flow and report to a Hyperping healthcheck:\n" +
+			"1. Write a script performing the request chain\n" +
+			"2. Deploy as Kubernetes CronJob or scheduled Lambda\n" +
+			"3. Create Hyperping healthcheck\n" +
+			"4. Script pings healthcheck URL on success"
+
+	case "browser", "mobile":
+		step.Description = "Browser and mobile tests require an external script"
+		step.Action = "Create Playwright/Selenium script for the user journey:\n" +
+			"1. Write script simulating the recorded steps\n" +
+			"2. Deploy as Kubernetes CronJob or scheduled Lambda\n" +
+			"3. Create Hyperping healthcheck\n" +
+			"4. Script pings healthcheck URL on success"
+
+	default:
+		step.Description = fmt.Sprintf("Test type '%s' is not supported", result.UnsupportedType)
+		step.Action = "Manual review required. Contact support for migration options."
+	}
+
+	return step
+}
+
+// GenerateJSONReport generates a JSON report.
+func (r *Reporter) GenerateJSONReport(report *MigrationReport) (string, error) {
+	data, err := json.MarshalIndent(report, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("marshaling report: %w", err)
+	}
+
+	return string(data), nil
+}
+
+// GenerateTextReport generates a human-readable text report.
+func (r *Reporter) GenerateTextReport(report *MigrationReport) string {
+	var sb strings.Builder
+
+	sb.WriteString("=================================================================\n")
+	sb.WriteString("Datadog to Hyperping Migration Report\n")
+	sb.WriteString("=================================================================\n\n")
+
+	fmt.Fprintf(&sb, "Generated: %s\n\n", report.Timestamp.Format(time.RFC3339))
+
+	sb.WriteString("Summary\n")
+	sb.WriteString("-------\n")
+	fmt.Fprintf(&sb, "Total Tests:        %d\n", report.TotalTests)
+	fmt.Fprintf(&sb, "Supported:          %d (%.1f%%)\n", report.SupportedTests, float64(report.SupportedTests)/float64(report.TotalTests)*100)
+	fmt.Fprintf(&sb, "Unsupported:        %d (%.1f%%)\n", report.UnsupportedTests, float64(report.UnsupportedTests)/float64(report.TotalTests)*100)
+	if report.SkippedTests > 0 {
+		fmt.Fprintf(&sb, "Skipped:            %d (mapping overrides)\n", report.SkippedTests)
+	}
+	if len(report.FrequencyAdjustments) > 0 {
+		fmt.Fprintf(&sb, "Frequency Adjusted: %d\n", len(report.FrequencyAdjustments))
+	}
+	fmt.Fprintf(&sb, "Manual Steps:       %d\n\n", len(report.ManualSteps))
+
+	if report.Estimate != nil {
+		report.Estimate.WriteText(&sb)
+		sb.WriteString("\n")
+	}
+
+	if len(report.TestsByType) > 0 {
+		sb.WriteString("Tests by Type\n")
+		sb.WriteString("-------------\n")
+		for testType, count := range report.TestsByType {
+			fmt.Fprintf(&sb, "%-15s %d\n", testType+":", count)
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(report.UnsupportedTypes) > 0 {
+		sb.WriteString("Unsupported Test Types\n")
+		sb.WriteString("----------------------\n")
+		for testType, count := range report.UnsupportedTypes {
+			fmt.Fprintf(&sb, "%-15s %d test(s)\n", testType+":", count)
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(report.FrequencyAdjustments) > 0 {
+		sb.WriteString("Frequency Adjustments\n")
+		sb.WriteString("---------------------\n")
+		for _, a := range report.FrequencyAdjustments {
+			fmt.Fprintf(&sb, "Test %s (%s): %ds -> %ds (%s)\n", a.SourceID, a.Name, a.From, a.To, a.Policy)
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(report.Warnings) > 0 {
+		sb.WriteString("Warnings\n")
+		sb.WriteString("--------\n")
+		for i, warning := range report.Warnings {
+			fmt.Fprintf(&sb, "%d. %s\n", i+1, warning)
+		}
+		sb.WriteString("\n")
+	}
+
+	if len(report.ManualSteps) > 0 {
+		sb.WriteString("Manual Steps Required\n")
+		sb.WriteString("=====================\n\n")
+
+		for i, step := range report.ManualSteps {
+			fmt.Fprintf(&sb, "%d. Test ID %s: %s\n", i+1, step.TestID, step.TestName)
+			fmt.Fprintf(&sb, "   Type: %s\n", step.TestType)
+			fmt.Fprintf(&sb, "   Issue: %s\n", step.Description)
+			sb.WriteString("   Action:\n")
+			for _, line := range strings.Split(step.Action, "\n") {
+				fmt.Fprintf(&sb, "   %s\n", line)
+			}
+			sb.WriteString("\n")
+		}
+	}
+
+	sb.WriteString("=================================================================\n")
+
+	return sb.String()
+}
+
+// GenerateManualStepsMarkdown generates a markdown file for manual steps.
+func (r *Reporter) GenerateManualStepsMarkdown(report *MigrationReport) string {
+	var sb strings.Builder
+
+	sb.WriteString("# Manual Migration Steps\n\n")
+	fmt.Fprintf(&sb, "Generated: %s\n\n", report.Timestamp.Format(time.RFC1123))
+
+	if len(report.ManualSteps) == 0 {
+		sb.WriteString("No manual steps required. All tests were successfully converted!\n")
+		return sb.String()
+	}
+
+	fmt.Fprintf(&sb, "The following %d test(s) require manual intervention:\n\n", len(report.ManualSteps))
+
+	sb.WriteString("---\n\n")
+
+	for i, step := range report.ManualSteps {
+		fmt.Fprintf(&sb, "## %d. %s (ID: %s)\n\n", i+1, step.TestName, step.TestID)
+		fmt.Fprintf(&sb, "**Type:** `%s`\n\n", step.TestType)
+		fmt.Fprintf(&sb, "**Issue:** %s\n\n", step.Description)
+		sb.WriteString("**Action Required:**\n\n")
+		sb.WriteString(step.Action)
+		sb.WriteString("\n\n---\n\n")
+	}
+
+	sb.WriteString("## Additional Resources\n\n")
+	sb.WriteString("- [Automated Migration Guide](../docs/guides/automated-migration.md)\n")
+	sb.WriteString("- [Hyperping Documentation](https://hyperping.io/docs)\n")
+
+	return sb.String()
+}
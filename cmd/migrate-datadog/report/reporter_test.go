@@ -0,0 +1,206 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package report
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+)
+
+func sampleInputs() ([]datadog.Test, []converter.ConversionResult) {
+	tests := []datadog.Test{
+		{PublicID: "aaa-111-aaa", Name: "API", Type: "api", Subtype: "http", Tags: []string{"env:prod"},
+			Config: datadog.Config{Request: datadog.Request{URL: "https://api.example.com"}}, Options: datadog.Options{AcceptSelfSigned: true}},
+		{PublicID: "bbb-222-bbb", Name: "DB", Type: "api", Subtype: "tcp",
+			Config: datadog.Config{Request: datadog.Request{Host: "db.example.com", Port: 5432}}},
+		{PublicID: "ccc-333-ccc", Name: "Cert", Type: "api", Subtype: "ssl", Config: datadog.Config{Request: datadog.Request{Host: "example.com", Port: 443}}},
+		{PublicID: "ddd-444-ddd", Name: "Syslog", Type: "api", Subtype: "udp"},
+		{PublicID: "eee-555-eee", Name: "Login", Type: "browser"},
+		{PublicID: "fff-666-fff", Name: "Flow", Type: "api", Subtype: "multi"},
+	}
+	conv := converter.NewTestConverter()
+	results := make([]converter.ConversionResult, len(tests))
+	for i, test := range tests {
+		results[i] = conv.Convert(test)
+	}
+	return tests, results
+}
+
+func TestGenerateReport_Counts(t *testing.T) {
+	tests, results := sampleInputs()
+
+	r := NewReporter().GenerateReport(tests, results)
+
+	if r.TotalTests != 6 {
+		t.Errorf("TotalTests = %d, want 6", r.TotalTests)
+	}
+	if r.SupportedTests != 2 { // http + tcp
+		t.Errorf("SupportedTests = %d, want 2", r.SupportedTests)
+	}
+	if r.UnsupportedTests != 4 {
+		t.Errorf("UnsupportedTests = %d, want 4", r.UnsupportedTests)
+	}
+	if r.TestsByType["http"] != 1 || r.TestsByType["tcp"] != 1 || r.TestsByType["browser"] != 1 {
+		t.Errorf("TestsByType = %v", r.TestsByType)
+	}
+	if r.UnsupportedTypes["ssl"] != 1 || r.UnsupportedTypes["udp"] != 1 || r.UnsupportedTypes["browser"] != 1 || r.UnsupportedTypes["multi"] != 1 {
+		t.Errorf("UnsupportedTypes = %v", r.UnsupportedTypes)
+	}
+	if len(r.ManualSteps) != 4 {
+		t.Errorf("ManualSteps = %d, want 4", len(r.ManualSteps))
+	}
+	// accept_self_signed is noted on the HTTP monitor -> warning expected
+	if len(r.Warnings) == 0 {
+		t.Error("expected at least one warning for the accept_self_signed note")
+	}
+	if r.Estimate == nil || r.Estimate.Monitors != 2 {
+		t.Errorf("Estimate = %+v, want 2 monitors", r.Estimate)
+	}
+}
+
+func TestGenerateReport_SkippedTests(t *testing.T) {
+	tests, results := sampleInputs()
+	results[2] = converter.ConversionResult{Skipped: true, Notes: []string{"Skipped: skip in mapping overrides"}}
+
+	r := NewReporter().GenerateReport(tests, results)
+
+	if r.SkippedTests != 1 || r.UnsupportedTests != 3 || len(r.ManualSteps) != 3 {
+		t.Errorf("SkippedTests = %d, UnsupportedTests = %d, ManualSteps = %d; want 1, 3, 3",
+			r.SkippedTests, r.UnsupportedTests, len(r.ManualSteps))
+	}
+	if text := NewReporter().GenerateTextReport(r); !strings.Contains(text, "Skipped:            1 (mapping overrides)") {
+		t.Errorf("text report missing skipped count:\n%s", text)
+	}
+}
+
+func TestGenerateManualStep_ByType(t *testing.T) {
+	cases := []struct {
+		unsupportedType string
+		descContains    string
+		actContains     string
+	}{
+		{"ssl", "SSL certificate tests", "ssl_expiration"},
+		{"udp", "UDP tests are not supported", "TCP port monitor"},
+		{"grpc", "GRPC tests are not supported", "healthcheck"},
+		{"multi", "Multistep API tests", "one HTTP monitor per step"},
+		{"browser", "Browser and mobile tests", "Playwright/Selenium"},
+		{converter.UnsupportedVariables, "variables", "literal values"},
+		{"weirdo", "is not supported", "Manual review"},
+	}
+	r := NewReporter()
+	for _, tt := range cases {
+		t.Run(tt.unsupportedType, func(t *testing.T) {
+			step := r.generateManualStep(
+				datadog.Test{PublicID: "abc-def-ghi", Name: "x", Type: "api"},
+				converter.ConversionResult{UnsupportedType: tt.unsupportedType},
+			)
+			if !strings.Contains(step.Description, tt.descContains) {
+				t.Errorf("Description = %q, want substring %q", step.Description, tt.descContains)
+			}
+			if !strings.Contains(step.Action, tt.actContains) {
+				t.Errorf("Action = %q, want substring %q", step.Action, tt.actContains)
+			}
+		})
+	}
+}
+
+func TestGenerateJSONReport_RoundTrip(t *testing.T) {
+	tests, results := sampleInputs()
+	rep := NewReporter()
+	report := rep.GenerateReport(tests, results)
+
+	out, err := rep.GenerateJSONReport(report)
+	if err != nil {
+		t.Fatalf("GenerateJSONReport error: %v", err)
+	}
+
+	var got MigrationReport
+	if err := json.Unmarshal([]byte(out), &got); err != nil {
+		t.Fatalf("unmarshal: %v", err)
+	}
+	if got.TotalTests != report.TotalTests {
+		t.Errorf("TotalTests roundtrip: got %d, want %d", got.TotalTests, report.TotalTests)
+	}
+	if len(got.ManualSteps) != len(report.ManualSteps) || got.ManualSteps[0].TestID != "ccc-333-ccc" {
+		t.Errorf("ManualSteps roundtrip: got %+v", got.ManualSteps)
+	}
+}
+
+func TestGenerateTextReport_Sections(t *testing.T) {
+	tests, results := sampleInputs()
+	rep := NewReporter()
+	report := rep.GenerateReport(tests, results)
+
+	out := rep.GenerateTextReport(report)
+	for _, want := range []string{
+		"Datadog to Hyperping Migration Report",
+		"Total Tests:",
+		"Plan Impact Estimate",
+		"Tests by Type",
+		"Unsupported Test Types",
+		"Warnings",
+		"Manual Steps Required",
+		"Test ID ccc-333-ccc: Cert",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("text report missing %q", want)
+		}
+	}
+}
+
+func TestGenerateManualStepsMarkdown(t *testing.T) {
+	out := NewReporter().GenerateManualStepsMarkdown(&MigrationReport{})
+	if !strings.Contains(out, "No manual steps required") {
+		t.Errorf("expected empty-state message, got:\n%s", out)
+	}
+
+	tests, results := sampleInputs()
+	rep := NewReporter()
+	out = rep.GenerateManualStepsMarkdown(rep.GenerateReport(tests, results))
+	for _, want := range []string{
+		"# Manual Migration Steps",
+		"## 1. Cert (ID: ccc-333-ccc)",
+		"**Type:** `ssl`",
+		"**Action Required:**",
+		"## Additional Resources",
+	} {
+		if !strings.Contains(out, want) {
+			t.Errorf("markdown missing %q", want)
+		}
+	}
+}
+
+func TestGenerateReport_FrequencyAdjustments(t *testing.T) {
+	tests := []datadog.Test{
+		{PublicID: "aaa-111-aaa", Name: "api", Type: "api", Subtype: "http", Options: datadog.Options{TickEvery: 900}},
+		{PublicID: "bbb-222-bbb", Name: "web", Type: "api", Subtype: "http", Options: datadog.Options{TickEvery: 900}},
+	}
+	adjustment := migrate.FrequencyAdjustment{SourceID: "aaa-111-aaa", Name: "api", From: 900, To: 1800, Policy: migrate.FrequencyRoundUp}
+	results := []converter.ConversionResult{
+		{Monitor: &hyperping.CreateMonitorRequest{CheckFrequency: 1800}, Supported: true, FrequencyAdjustment: &adjustment},
+		{Supported: false, UnsupportedType: converter.UnsupportedFrequency},
+	}
+
+	r := NewReporter()
+	report := r.GenerateReport(tests, results)
+	if len(report.FrequencyAdjustments) != 1 || report.FrequencyAdjustments[0] != adjustment {
+		t.Errorf("FrequencyAdjustments = %+v", report.FrequencyAdjustments)
+	}
+	if len(report.ManualSteps) != 1 || !strings.Contains(report.ManualSteps[0].Description, "900s") ||
+		!strings.Contains(report.ManualSteps[0].Action, "--overrides") {
+		t.Errorf("ManualSteps = %+v", report.ManualSteps)
+	}
+
+	text := r.GenerateTextReport(report)
+	if !strings.Contains(text, "Test aaa-111-aaa (api): 900s -> 1800s (round-up)") {
+		t.Errorf("text report missing adjustment:\n%s", text)
+	}
+}
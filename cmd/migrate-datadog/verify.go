@@ -0,0 +1,111 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-datadog/datadog"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/verify"
+)
+
+// runVerification compares Datadog tests with the monitors that exist in
+// Hyperping and writes a field-by-field equivalence report.
+func (r *datadogRunner) runVerification() int {
+	log("Fetching Datadog tests for verification...")
+	tests, err := r.datadogClient().ListTests(r.ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching Datadog tests: %v\n", err)
+		return 1
+	}
+
+	log("Fetching Hyperping monitors for verification...")
+	destination, err := createHyperpingClient(r.hyperpingKey).ListMonitors(r.ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching Hyperping monitors: %v\n", err)
+		return 1
+	}
+
+	idMap, err := mapping.Load(mappingPath())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	result := verify.Monitors("Datadog", verify.ApplyMapping(verifySources(tests), idMap), destination)
+	result.PrintSummary(os.Stderr)
+
+	reportPath := verifyReportPath()
+	if err := result.WriteJSON(reportPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	log(fmt.Sprintf("Verification report written to %s", reportPath))
+
+	if recorded := result.RecordUUIDs(idMap); recorded > 0 {
+		if err := idMap.Write(mappingPath()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		log(fmt.Sprintf("Recorded %d Hyperping UUIDs in %s", recorded, mappingPath()))
+	}
+
+	if result.HasProblems() {
+		return 1
+	}
+	return 0
+}
+
+// verifyReportPath returns the --verify-report file. A relative path is
+// resolved against the output directory.
+func verifyReportPath() string {
+	if filepath.IsAbs(*verifyReport) {
+		return *verifyReport
+	}
+	return filepath.Join(*outputDir, *verifyReport)
+}
+
+// verifySources builds verification inputs from the raw Datadog tests,
+// using the converter only for names, URLs, and protocol vocabulary. Tests
+// skipped by the mapping overrides are not verified.
+func verifySources(tests []datadog.Test) []verify.Source {
+	testConverter := newTestConverter()
+
+	sources := make([]verify.Source, 0, len(tests))
+	for _, test := range tests {
+		result := testConverter.Convert(test)
+		if !result.Supported || result.Monitor == nil {
+			continue
+		}
+
+		var regions []string
+		if len(test.Locations) > 0 {
+			regions = testConverter.Regions(test.Locations)
+		}
+
+		var codes []string
+		for _, assertion := range test.Config.Assertions {
+			if assertion.Type == "statusCode" && assertion.Operator == "is" {
+				codes = append(codes, assertion.TargetString())
+			}
+		}
+
+		sources = append(sources, verify.Source{
+			ID:                  test.PublicID,
+			Name:                result.Monitor.Name,
+			URL:                 result.Monitor.URL,
+			Protocol:            result.Monitor.Protocol,
+			Frequency:           test.Options.TickEvery,
+			Locations:           test.Locations,
+			Regions:             regions,
+			ExpectedStatusCodes: codes,
+			Port:                int(test.Config.Request.Port),
+			Timeout:             int(test.Config.Request.Timeout),
+		})
+	}
+	return sources
+}
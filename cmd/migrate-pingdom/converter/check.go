@@ -5,6 +5,7 @@ package converter
 
 import (
 	"fmt"
+	"strconv"
 
 	hyperping "github.com/develeap/hyperping-go"
 
@@ -12,21 +13,72 @@ import (
 	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
+// UnsupportedFrequency is the UnsupportedType of a check whose resolution the
+// frequency policy rejects.
+const UnsupportedFrequency = "frequency"
+
 // ConversionResult represents the result of converting a Pingdom check.
 type ConversionResult struct {
 	Monitor         *hyperping.CreateMonitorRequest
 	Healthcheck     *hyperping.CreateHealthcheckRequest
 	Supported       bool
 	UnsupportedType string
+	Skipped         bool // skipped on purpose via the mapping overrides
 	Notes           []string
+	// FrequencyAdjustment is set when the check resolution was snapped to a
+	// different Hyperping check frequency.
+	FrequencyAdjustment *migrate.FrequencyAdjustment
 }
 
 // CheckConverter converts Pingdom checks to Hyperping resources.
-type CheckConverter struct{}
+type CheckConverter struct {
+	nameTemplate    *migrate.NameTemplate
+	overrides       *migrate.Overrides
+	frequencyPolicy migrate.FrequencyPolicy
+	regions         *migrate.RegionMapper
+}
+
+// probeRegions maps Pingdom probe filters to Hyperping regions.
+var probeRegions = map[string][]string{
+	"region:NA":    {"virginia", "california"},
+	"region:EU":    {"london", "frankfurt"},
+	"region:APAC":  {"singapore", "sydney", "tokyo"},
+	"region:LATAM": {"saopaulo"},
+}
 
 // NewCheckConverter creates a new CheckConverter.
 func NewCheckConverter() *CheckConverter {
-	return &CheckConverter{}
+	return &CheckConverter{frequencyPolicy: migrate.FrequencyNearest, regions: migrate.NewRegionMapper(probeRegions)}
+}
+
+// WithNameTemplate renders monitor names from the check name and tags using
+// tmpl instead of GenerateName. A nil template keeps GenerateName.
+func (c *CheckConverter) WithNameTemplate(tmpl *migrate.NameTemplate) *CheckConverter {
+	c.nameTemplate = tmpl
+	return c
+}
+
+// WithOverrides applies the mapping overrides in o, keyed by Pingdom check
+// ID. A skipped check converts to a result with Skipped set and no monitor.
+func (c *CheckConverter) WithOverrides(o *migrate.Overrides) *CheckConverter {
+	c.overrides = o
+	return c
+}
+
+// WithFrequencyPolicy sets how resolutions that Hyperping does not support
+// are snapped. Under migrate.FrequencyFail such checks convert to an
+// unsupported result with UnsupportedType UnsupportedFrequency.
+func (c *CheckConverter) WithFrequencyPolicy(p migrate.FrequencyPolicy) *CheckConverter {
+	c.frequencyPolicy = p
+	return c
+}
+
+// WithRegionMap maps probe filters with the mappings in rm ahead of the
+// built-in ones, and uses its defaults for checks whose filters map to no
+// region.
+func (c *CheckConverter) WithRegionMap(rm *migrate.RegionMap) *CheckConverter {
+	c.regions.WithRegionMap(rm)
+	return c
 }
 
 // Convert converts a Pingdom check to a Hyperping resource.
@@ -35,6 +87,13 @@ func (c *CheckConverter) Convert(check pingdom.Check) ConversionResult {
 		Notes: []string{},
 	}
 
+	override, _ := c.overrides.Lookup(strconv.Itoa(check.ID))
+	if override.Skip {
+		result.Skipped = true
+		result.Notes = append(result.Notes, "Skipped: skip in mapping overrides")
+		return result
+	}
+
 	switch check.Type {
 	case "http", "https":
 		result.Monitor = c.convertHTTPCheck(check)
@@ -75,9 +134,75 @@ func (c *CheckConverter) Convert(check pingdom.Check) ConversionResult {
 		result.Notes = append(result.Notes, fmt.Sprintf("Unknown check type: %s", check.Type))
 	}
 
+	if result.Monitor != nil && c.nameTemplate != nil {
+		name, err := c.nameTemplate.Render(check.Name, tagNames(check.Tags))
+		if err != nil {
+			result.Notes = append(result.Notes, fmt.Sprintf("Name template failed, using generated name: %v", err))
+		} else {
+			result.Monitor.Name = name
+		}
+	}
+
+	if result.Monitor != nil && override.Frequency == 0 {
+		c.snapFrequency(&result, check)
+	}
+
+	if result.Monitor != nil && len(override.Regions) == 0 {
+		result.Notes = append(result.Notes, c.regions.Map(check.ProbeFilters).Warnings()...)
+	}
+
+	if result.Monitor != nil {
+		applyOverride(result.Monitor, override)
+	}
+
 	return result
 }
 
+// snapFrequency sets the monitor's check frequency from the check resolution
+// under the frequency policy, recording any adjustment. A rejected resolution
+// turns the result into an unsupported one. A check without a resolution
+// keeps the frequency chosen by ConvertFrequency.
+func (c *CheckConverter) snapFrequency(result *ConversionResult, check pingdom.Check) {
+	if check.Resolution <= 0 {
+		return
+	}
+	seconds := check.Resolution * 60
+	frequency, err := c.frequencyPolicy.Snap(seconds)
+	if err != nil {
+		result.Monitor = nil
+		result.Supported = false
+		result.UnsupportedType = UnsupportedFrequency
+		result.Notes = append(result.Notes, err.Error())
+		return
+	}
+
+	result.Monitor.CheckFrequency = frequency
+	if frequency != seconds {
+		result.FrequencyAdjustment = &migrate.FrequencyAdjustment{
+			SourceID: strconv.Itoa(check.ID),
+			Name:     check.Name,
+			From:     seconds,
+			To:       frequency,
+			Policy:   c.frequencyPolicy,
+		}
+		result.Notes = append(result.Notes, result.FrequencyAdjustment.String())
+	}
+}
+
+// applyOverride replaces the converted name, regions, and check frequency
+// with those set by the mapping override.
+func applyOverride(monitor *hyperping.CreateMonitorRequest, override migrate.Override) {
+	if override.Name != "" {
+		monitor.Name = override.Name
+	}
+	if len(override.Regions) > 0 {
+		monitor.Regions = override.Regions
+	}
+	if override.Frequency != 0 {
+		monitor.CheckFrequency = override.Frequency
+	}
+}
+
 func (c *CheckConverter) convertHTTPCheck(check pingdom.Check) *hyperping.CreateMonitorRequest {
 	// Build URL
 	protocol := "http"
@@ -99,7 +224,7 @@ func (c *CheckConverter) convertHTTPCheck(check pingdom.Check) *hyperping.Create
 	}
 
 	// Convert regions
-	regions := ConvertRegions(check.ProbeFilters)
+	regions := c.Regions(check.ProbeFilters)
 
 	monitor := &hyperping.CreateMonitorRequest{
 		Name:            GenerateName(check),
@@ -138,7 +263,7 @@ func (c *CheckConverter) convertHTTPCheck(check pingdom.Check) *hyperping.Create
 
 func (c *CheckConverter) convertTCPCheck(check pingdom.Check) *hyperping.CreateMonitorRequest {
 	frequency := ConvertFrequency(check.Resolution)
-	regions := ConvertRegions(check.ProbeFilters)
+	regions := c.Regions(check.ProbeFilters)
 
 	port := check.Port
 	if port == 0 {
@@ -158,7 +283,7 @@ func (c *CheckConverter) convertTCPCheck(check pingdom.Check) *hyperping.CreateM
 
 func (c *CheckConverter) convertPingCheck(check pingdom.Check) *hyperping.CreateMonitorRequest {
 	frequency := ConvertFrequency(check.Resolution)
-	regions := ConvertRegions(check.ProbeFilters)
+	regions := c.Regions(check.ProbeFilters)
 
 	return &hyperping.CreateMonitorRequest{
 		Name:           GenerateName(check),
@@ -172,7 +297,7 @@ func (c *CheckConverter) convertPingCheck(check pingdom.Check) *hyperping.Create
 
 func (c *CheckConverter) convertSMTPCheck(check pingdom.Check) *hyperping.CreateMonitorRequest {
 	frequency := ConvertFrequency(check.Resolution)
-	regions := ConvertRegions(check.ProbeFilters)
+	regions := c.Regions(check.ProbeFilters)
 
 	port := check.Port
 	if port == 0 {
@@ -195,7 +320,7 @@ func (c *CheckConverter) convertSMTPCheck(check pingdom.Check) *hyperping.Create
 
 func (c *CheckConverter) convertPOP3Check(check pingdom.Check) *hyperping.CreateMonitorRequest {
 	frequency := ConvertFrequency(check.Resolution)
-	regions := ConvertRegions(check.ProbeFilters)
+	regions := c.Regions(check.ProbeFilters)
 
 	port := check.Port
 	if port == 0 {
@@ -218,7 +343,7 @@ func (c *CheckConverter) convertPOP3Check(check pingdom.Check) *hyperping.Create
 
 func (c *CheckConverter) convertIMAPCheck(check pingdom.Check) *hyperping.CreateMonitorRequest {
 	frequency := ConvertFrequency(check.Resolution)
-	regions := ConvertRegions(check.ProbeFilters)
+	regions := c.Regions(check.ProbeFilters)
 
 	port := check.Port
 	if port == 0 {
@@ -245,39 +370,23 @@ func ConvertFrequency(resolutionMinutes int) int {
 	return migrate.MapFrequency(seconds)
 }
 
-// ConvertRegions converts Pingdom probe filters to Hyperping regions.
+// ConvertRegions converts Pingdom probe filters to Hyperping regions with
+// the built-in mappings.
 func ConvertRegions(probeFilters []string) []string {
-	if len(probeFilters) == 0 {
-		// Default regions
-		return []string{"virginia", "london", "frankfurt", "singapore"}
-	}
-
-	regionMap := map[string][]string{
-		"region:NA":    {"virginia", "oregon"},
-		"region:EU":    {"london", "frankfurt"},
-		"region:APAC":  {"singapore", "sydney", "tokyo"},
-		"region:LATAM": {"saopaulo"},
-	}
-
-	regionsSet := make(map[string]bool)
-	for _, filter := range probeFilters {
-		if regions, ok := regionMap[filter]; ok {
-			for _, r := range regions {
-				regionsSet[r] = true
-			}
-		}
-	}
+	return NewCheckConverter().Regions(probeFilters)
+}
 
-	if len(regionsSet) == 0 {
-		return []string{"virginia", "london"}
+// Regions converts Pingdom probe filters to Hyperping regions. Checks without
+// probe filters, or whose filters map to no region, get the --region-map
+// defaults when set, and built-in defaults otherwise.
+func (c *CheckConverter) Regions(probeFilters []string) []string {
+	if len(probeFilters) == 0 {
+		return c.regions.Defaults([]string{"virginia", "london", "frankfurt", "singapore"})
 	}
-
-	regions := make([]string, 0, len(regionsSet))
-	for r := range regionsSet {
-		regions = append(regions, r)
+	if regions := c.regions.Map(probeFilters).Regions; len(regions) > 0 {
+		return regions
 	}
-
-	return regions
+	return c.regions.Defaults([]string{"virginia", "london"})
 }
 
 func boolPtr(b bool) *bool {
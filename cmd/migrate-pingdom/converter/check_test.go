@@ -5,9 +5,11 @@ package converter
 
 import (
 	"sort"
+	"strings"
 	"testing"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/pingdom"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 func TestConvert_DispatchByType(t *testing.T) {
@@ -397,7 +399,7 @@ func TestConvertRegions(t *testing.T) {
 		{
 			name:    "NA",
 			filters: []string{"region:NA"},
-			want:    []string{"oregon", "virginia"},
+			want:    []string{"california", "virginia"},
 		},
 		{
 			name:    "EU",
@@ -417,7 +419,7 @@ func TestConvertRegions(t *testing.T) {
 		{
 			name:    "NA+EU dedup",
 			filters: []string{"region:NA", "region:EU", "region:NA"},
-			want:    []string{"frankfurt", "london", "oregon", "virginia"},
+			want:    []string{"california", "frankfurt", "london", "virginia"},
 		},
 		{
 			name:    "unknown filter falls back",
@@ -440,3 +442,113 @@ func TestConvertRegions(t *testing.T) {
 		})
 	}
 }
+
+func TestConvert_NameTemplate(t *testing.T) {
+	check := pingdom.Check{Type: "http", Name: "Checkout API", Hostname: "a.example.com", Tags: []pingdom.Tag{{Name: "env:prod"}, {Name: "payments"}}}
+
+	if got := NewCheckConverter().Convert(check).Monitor.Name; got != GenerateName(check) {
+		t.Errorf("without template Name = %q, want GenerateName %q", got, GenerateName(check))
+	}
+
+	tmpl, err := migrate.ParseNameTemplate(`[{{.Tag "env" | upper}}] {{.Name}}{{if .HasTag "payments"}} (payments){{end}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := NewCheckConverter().WithNameTemplate(tmpl).Convert(check).Monitor.Name; got != "[PROD] Checkout API (payments)" {
+		t.Errorf("Name = %q", got)
+	}
+}
+
+func TestConvert_NameTemplateError(t *testing.T) {
+	check := pingdom.Check{Type: "http", Name: "Checkout API", Hostname: "a.example.com"}
+	tmpl, err := migrate.ParseNameTemplate(`{{.Owner}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	result := NewCheckConverter().WithNameTemplate(tmpl).Convert(check)
+	if result.Monitor.Name != GenerateName(check) {
+		t.Errorf("Name = %q, want generated name on template error", result.Monitor.Name)
+	}
+	if len(result.Notes) != 1 {
+		t.Errorf("Notes = %v, want one template note", result.Notes)
+	}
+}
+
+func TestConvert_Overrides(t *testing.T) {
+	overrides, err := migrate.ParseOverrides([]byte(`
+overrides:
+  1:
+    name: Checkout API
+    regions: [paris]
+    frequency: 600
+  2:
+    skip: true
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCheckConverter().WithOverrides(overrides)
+
+	result := c.Convert(pingdom.Check{ID: 1, Type: "http", Name: "checkout", Hostname: "a.example.com", Resolution: 1})
+	if result.Monitor.Name != "Checkout API" || result.Monitor.CheckFrequency != 600 {
+		t.Errorf("Name = %q, CheckFrequency = %d", result.Monitor.Name, result.Monitor.CheckFrequency)
+	}
+	if len(result.Monitor.Regions) != 1 || result.Monitor.Regions[0] != "paris" {
+		t.Errorf("Regions = %v", result.Monitor.Regions)
+	}
+
+	skipped := c.Convert(pingdom.Check{ID: 2, Type: "http", Name: "legacy", Hostname: "b.example.com"})
+	if !skipped.Skipped || skipped.Supported || skipped.Monitor != nil {
+		t.Errorf("skipped result = %+v", skipped)
+	}
+}
+
+func TestConvert_FrequencyPolicy(t *testing.T) {
+	check := pingdom.Check{ID: 7, Type: "http", Name: "checkout", Hostname: "a.example.com", Resolution: 15}
+
+	result := NewCheckConverter().WithFrequencyPolicy(migrate.FrequencyRoundUp).Convert(check)
+	if result.Monitor.CheckFrequency != 1800 {
+		t.Errorf("CheckFrequency = %d, want 1800", result.Monitor.CheckFrequency)
+	}
+	a := result.FrequencyAdjustment
+	if a == nil || a.SourceID != "7" || a.From != 900 || a.To != 1800 || a.Policy != migrate.FrequencyRoundUp {
+		t.Errorf("FrequencyAdjustment = %+v", a)
+	}
+
+	result = NewCheckConverter().WithFrequencyPolicy(migrate.FrequencyFail).Convert(check)
+	if result.Supported || result.Monitor != nil || result.UnsupportedType != UnsupportedFrequency {
+		t.Errorf("result = %+v, want an unsupported frequency", result)
+	}
+
+	check.Resolution = 5
+	result = NewCheckConverter().WithFrequencyPolicy(migrate.FrequencyFail).Convert(check)
+	if !result.Supported || result.Monitor.CheckFrequency != 300 || result.FrequencyAdjustment != nil {
+		t.Errorf("result = %+v, want a supported 300s monitor", result)
+	}
+}
+
+func TestConvert_RegionMap(t *testing.T) {
+	regionMap, err := migrate.ParseRegionMap([]byte(`
+regions:
+  region:EU: [paris]
+default: [nyc]
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := NewCheckConverter().WithRegionMap(regionMap)
+
+	result := c.Convert(pingdom.Check{ID: 1, Type: "http", Name: "checkout", Hostname: "a.example.com", ProbeFilters: []string{"region:EU", "region:LATAM"}})
+	if got := strings.Join(result.Monitor.Regions, ","); got != "paris,saopaulo" {
+		t.Errorf("Regions = %s, want paris,saopaulo", got)
+	}
+
+	result = c.Convert(pingdom.Check{ID: 2, Type: "ping", Name: "gateway", Hostname: "b.example.com", ProbeFilters: []string{"region:MARS"}})
+	if got := strings.Join(result.Monitor.Regions, ","); got != "nyc" {
+		t.Errorf("Regions = %s, want the region map defaults", got)
+	}
+	if len(result.Notes) != 1 || !strings.Contains(result.Notes[0], "region:MARS") {
+		t.Errorf("Notes = %v, want the unmapped probe filter reported", result.Notes)
+	}
+}
@@ -134,14 +134,14 @@ func sanitizeServiceName(name string) string {
 
 // TagsToString converts tags to a comma-separated string for display.
 func TagsToString(tags []pingdom.Tag) string {
-	if len(tags) == 0 {
-		return ""
-	}
+	return strings.Join(tagNames(tags), ", ")
+}
 
-	tagNames := make([]string, len(tags))
+// tagNames returns the names of tags, in order.
+func tagNames(tags []pingdom.Tag) []string {
+	names := make([]string, len(tags))
 	for i, tag := range tags {
-		tagNames[i] = tag.Name
+		names[i] = tag.Name
 	}
-
-	return strings.Join(tagNames, ", ")
+	return names
 }
@@ -5,10 +5,12 @@ package generator
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/converter"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/pingdom"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
 	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
@@ -97,6 +99,38 @@ func (g *ImportGenerator) GenerateImportCommands(checks []pingdom.Check, results
 	return sb.String()
 }
 
+// ImportAddresses returns the Terraform address GenerateImportScript imports
+// each created monitor to, keyed by monitor UUID.
+func (g *ImportGenerator) ImportAddresses(checks []pingdom.Check, results []converter.ConversionResult, createdResources map[int]string) map[string]string {
+	addresses := make(map[string]string)
+	for i, check := range checks {
+		uuid, ok := createdResources[check.ID]
+		if ok && results[i].Supported && results[i].Monitor != nil {
+			addresses[uuid] = "hyperping_monitor." + g.terraformName(results[i].Monitor.Name)
+		}
+	}
+	return addresses
+}
+
+// MappingEntries returns a mapping entry for every converted check, with the
+// UUID of the monitor created for it, if any.
+func (g *ImportGenerator) MappingEntries(checks []pingdom.Check, results []converter.ConversionResult, createdResources map[int]string) []mapping.Entry {
+	var entries []mapping.Entry
+	for i, check := range checks {
+		if !results[i].Supported || results[i].Monitor == nil {
+			continue
+		}
+		entries = append(entries, mapping.Entry{
+			SourceID:     strconv.Itoa(check.ID),
+			ResourceType: mapping.ResourceTypeMonitor,
+			Name:         results[i].Monitor.Name,
+			Address:      "hyperping_monitor." + g.terraformName(results[i].Monitor.Name),
+			UUID:         createdResources[check.ID],
+		})
+	}
+	return entries
+}
+
 func (g *ImportGenerator) terraformName(name string) string {
 	tg := NewTerraformGenerator(g.prefix)
 	return tg.terraformName(name)
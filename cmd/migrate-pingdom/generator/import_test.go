@@ -66,6 +66,31 @@ func TestGenerateImportScript_Prefix(t *testing.T) {
 	}
 }
 
+func TestImportAddresses(t *testing.T) {
+	checks, results := makeChecks()
+	created := map[int]string{1: "mon_aaaa", 2: "mon_stale"}
+	got := NewImportGenerator("pd_").ImportAddresses(checks, results, created)
+	if len(got) != 1 || got["mon_aaaa"] != "hyperping_monitor.pd_api_api" {
+		t.Errorf("ImportAddresses() = %v, want only mon_aaaa at hyperping_monitor.pd_api_api", got)
+	}
+}
+
+func TestMappingEntries(t *testing.T) {
+	checks, results := makeChecks()
+	created := map[int]string{1: "mon_aaaa"}
+	got := NewImportGenerator("pd_").MappingEntries(checks, results, created)
+
+	if len(got) != 2 {
+		t.Fatalf("MappingEntries() = %v, want the two supported checks", got)
+	}
+	if got[0].SourceID != "1" || got[0].UUID != "mon_aaaa" || got[0].Address != "hyperping_monitor.pd_api_api" {
+		t.Errorf("MappingEntries()[0] = %+v, want check 1 mapped to mon_aaaa at hyperping_monitor.pd_api_api", got[0])
+	}
+	if got[1].SourceID != "3" || got[1].UUID != "" {
+		t.Errorf("MappingEntries()[1] = %+v, want check 3 without a UUID", got[1])
+	}
+}
+
 func TestGenerateImportCommands_Shape(t *testing.T) {
 	checks, results := makeChecks()
 	created := map[int]string{1: "mon_aaaa"}
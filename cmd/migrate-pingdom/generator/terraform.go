@@ -4,7 +4,6 @@
 package generator
 
 import (
-	"fmt"
 	"regexp"
 	"strings"
 
@@ -12,12 +11,14 @@ import (
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/converter"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/pingdom"
-	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
 )
 
 // TerraformGenerator generates Terraform HCL configuration.
 type TerraformGenerator struct {
-	prefix string
+	prefix     string
+	compatMode compat.Mode
 }
 
 // NewTerraformGenerator creates a new TerraformGenerator.
@@ -27,157 +28,169 @@ func NewTerraformGenerator(prefix string) *TerraformGenerator {
 	}
 }
 
+// WithCompatMode sets the compatibility mode. With compat.IgnoreChanges,
+// monitors get lifecycle ignore_changes for the attributes the API does not
+// return faithfully.
+func (g *TerraformGenerator) WithCompatMode(mode compat.Mode) *TerraformGenerator {
+	g.compatMode = mode
+	return g
+}
+
 // GenerateHCL generates Terraform HCL for converted monitors.
 func (g *TerraformGenerator) GenerateHCL(checks []pingdom.Check, results []converter.ConversionResult) string {
-	var sb strings.Builder
+	f := hclgen.NewFile()
+	root := f.Body()
 
-	sb.WriteString("# Generated from Pingdom export\n")
-	sb.WriteString("# Review and adjust as needed before applying\n\n")
+	root.Comment("Generated from Pingdom export")
+	root.Comment("Review and adjust as needed before applying")
+	root.Newline()
 
 	for i, check := range checks {
 		result := results[i]
 
-		fmt.Fprintf(&sb, "# Pingdom Check ID: %d\n", check.ID)
-		fmt.Fprintf(&sb, "# Original Name: %s\n", check.Name)
-		fmt.Fprintf(&sb, "# Type: %s\n", check.Type)
+		root.Comment("Pingdom Check ID: %d", check.ID)
+		root.Comment("Original Name: %s", check.Name)
+		root.Comment("Type: %s", check.Type)
 
 		if len(check.Tags) > 0 {
-			fmt.Fprintf(&sb, "# Tags: %s\n", converter.TagsToString(check.Tags))
+			root.Comment("Tags: %s", converter.TagsToString(check.Tags))
+		}
+
+		if result.Skipped {
+			root.Comment("SKIPPED: skip in mapping overrides")
+			root.Newline()
+			continue
 		}
 
 		if !result.Supported {
-			fmt.Fprintf(&sb, "# UNSUPPORTED: %s\n", result.UnsupportedType)
+			root.Comment("UNSUPPORTED: %s", result.UnsupportedType)
 			for _, note := range result.Notes {
-				fmt.Fprintf(&sb, "# NOTE: %s\n", note)
+				root.Comment("NOTE: %s", note)
 			}
-			sb.WriteString("\n")
+			root.Newline()
 			continue
 		}
 
 		if result.Monitor != nil {
-			g.generateMonitorHCL(&sb, check, result.Monitor)
-		}
-
-		for _, note := range result.Notes {
-			fmt.Fprintf(&sb, "  # NOTE: %s\n", note)
+			body := g.generateMonitorHCL(root, result.Monitor)
+			for _, note := range result.Notes {
+				body.Comment("NOTE: %s", note)
+			}
+			compat.WriteLifecycle(body, g.compatMode, compat.MonitorFields(body, result.Monitor.Protocol))
+		} else {
+			for _, note := range result.Notes {
+				root.Comment("NOTE: %s", note)
+			}
 		}
 
-		sb.WriteString("\n")
+		root.Newline()
 	}
 
-	return sb.String()
+	return f.String()
 }
 
-func (g *TerraformGenerator) generateMonitorHCL(sb *strings.Builder, _ pingdom.Check, monitor *hyperping.CreateMonitorRequest) {
-	tfName := g.terraformName(monitor.Name)
-
-	// tfName is derived from terraformName() and only contains [a-z0-9_]; safe for %q.
-	fmt.Fprintf(sb, "resource \"hyperping_monitor\" %q {\n", tfName)
-	fmt.Fprintf(sb, "  name     = %s\n", migrate.QuoteHCL(monitor.Name))
-	fmt.Fprintf(sb, "  url      = %s\n", migrate.QuoteHCL(monitor.URL))
-	fmt.Fprintf(sb, "  protocol = %s\n", migrate.QuoteHCL(monitor.Protocol))
+func (g *TerraformGenerator) generateMonitorHCL(root *hclgen.Body, monitor *hyperping.CreateMonitorRequest) *hclgen.Body {
+	r := root.Block("resource", "hyperping_monitor", g.terraformName(monitor.Name))
+	r.SetString("name", monitor.Name)
+	r.SetString("url", monitor.URL)
+	r.SetString("protocol", monitor.Protocol)
 
-	sb.WriteString(buildOptionalHTTPMethod(monitor))
-	sb.WriteString(buildOptionalCheckFrequency(monitor))
-	sb.WriteString(buildOptionalRegions(monitor))
-	sb.WriteString(buildOptionalPort(monitor))
-	sb.WriteString(buildOptionalFollowRedirects(monitor))
-	sb.WriteString(buildOptionalExpectedStatus(monitor))
-	sb.WriteString(buildOptionalRequiredKeyword(monitor))
-	sb.WriteString(buildOptionalRequestHeaders(monitor))
-	sb.WriteString(buildOptionalRequestBody(monitor))
-	sb.WriteString(buildOptionalPaused(monitor))
+	setOptionalHTTPMethod(r, monitor)
+	setOptionalCheckFrequency(r, monitor)
+	setOptionalRegions(r, monitor)
+	setOptionalPort(r, monitor)
+	setOptionalFollowRedirects(r, monitor)
+	setOptionalExpectedStatus(r, monitor)
+	setOptionalRequiredKeyword(r, monitor)
+	setOptionalRequestHeaders(r, monitor)
+	setOptionalRequestBody(r, monitor)
+	setOptionalPaused(r, monitor)
 
-	sb.WriteString("}\n")
+	return r
 }
 
-// buildOptionalHTTPMethod returns the http_method line if non-default.
-func buildOptionalHTTPMethod(monitor *hyperping.CreateMonitorRequest) string {
+// setOptionalHTTPMethod sets http_method if non-default.
+func setOptionalHTTPMethod(r *hclgen.Body, monitor *hyperping.CreateMonitorRequest) {
 	if monitor.HTTPMethod == "" || monitor.HTTPMethod == "GET" {
-		return ""
+		return
 	}
-	return fmt.Sprintf("  http_method = %s\n", migrate.QuoteHCL(monitor.HTTPMethod))
+	r.SetString("http_method", monitor.HTTPMethod)
 }
 
-// buildOptionalCheckFrequency returns the check_frequency line if non-default.
-func buildOptionalCheckFrequency(monitor *hyperping.CreateMonitorRequest) string {
+// setOptionalCheckFrequency sets check_frequency if non-default.
+func setOptionalCheckFrequency(r *hclgen.Body, monitor *hyperping.CreateMonitorRequest) {
 	if monitor.CheckFrequency == 60 {
-		return ""
+		return
 	}
-	return fmt.Sprintf("  check_frequency = %d\n", monitor.CheckFrequency)
+	r.SetInt("check_frequency", monitor.CheckFrequency)
 }
 
-// buildOptionalRegions returns the regions line if non-empty.
-func buildOptionalRegions(monitor *hyperping.CreateMonitorRequest) string {
+// setOptionalRegions sets regions if non-empty.
+func setOptionalRegions(r *hclgen.Body, monitor *hyperping.CreateMonitorRequest) {
 	if len(monitor.Regions) == 0 {
-		return ""
+		return
 	}
-	return fmt.Sprintf("  regions = %s\n", formatStringList(monitor.Regions))
+	r.SetStringList("regions", monitor.Regions)
 }
 
-// buildOptionalPort returns the port line if non-zero.
-func buildOptionalPort(monitor *hyperping.CreateMonitorRequest) string {
+// setOptionalPort sets port if non-zero.
+func setOptionalPort(r *hclgen.Body, monitor *hyperping.CreateMonitorRequest) {
 	if monitor.Port == nil || *monitor.Port == 0 {
-		return ""
+		return
 	}
-	return fmt.Sprintf("  port = %d\n", *monitor.Port)
+	r.SetInt("port", *monitor.Port)
 }
 
-// buildOptionalFollowRedirects returns the follow_redirects line if explicitly false.
-func buildOptionalFollowRedirects(monitor *hyperping.CreateMonitorRequest) string {
+// setOptionalFollowRedirects sets follow_redirects if explicitly false.
+func setOptionalFollowRedirects(r *hclgen.Body, monitor *hyperping.CreateMonitorRequest) {
 	if monitor.FollowRedirects == nil || *monitor.FollowRedirects {
-		return ""
+		return
 	}
-	return "  follow_redirects = false\n"
+	r.SetBool("follow_redirects", false)
 }
 
-// buildOptionalExpectedStatus returns the expected_status_code line if non-default.
-func buildOptionalExpectedStatus(monitor *hyperping.CreateMonitorRequest) string {
+// setOptionalExpectedStatus sets expected_status_code if non-default.
+func setOptionalExpectedStatus(r *hclgen.Body, monitor *hyperping.CreateMonitorRequest) {
 	if monitor.ExpectedStatusCode == "" || monitor.ExpectedStatusCode == "200" {
-		return ""
+		return
 	}
-	return fmt.Sprintf("  expected_status_code = %s\n", migrate.QuoteHCL(monitor.ExpectedStatusCode))
+	r.SetString("expected_status_code", monitor.ExpectedStatusCode)
 }
 
-// buildOptionalRequiredKeyword returns the required_keyword line if set.
-func buildOptionalRequiredKeyword(monitor *hyperping.CreateMonitorRequest) string {
+// setOptionalRequiredKeyword sets required_keyword if set.
+func setOptionalRequiredKeyword(r *hclgen.Body, monitor *hyperping.CreateMonitorRequest) {
 	if monitor.RequiredKeyword == nil || *monitor.RequiredKeyword == "" {
-		return ""
+		return
 	}
-	return fmt.Sprintf("  required_keyword = %s\n", migrate.QuoteHCL(*monitor.RequiredKeyword))
+	r.SetString("required_keyword", *monitor.RequiredKeyword)
 }
 
-// buildOptionalRequestHeaders returns the request_headers list if non-empty.
-func buildOptionalRequestHeaders(monitor *hyperping.CreateMonitorRequest) string {
+// setOptionalRequestHeaders sets the request_headers list if non-empty.
+func setOptionalRequestHeaders(r *hclgen.Body, monitor *hyperping.CreateMonitorRequest) {
 	if len(monitor.RequestHeaders) == 0 {
-		return ""
+		return
 	}
-	var sb strings.Builder
-	sb.WriteString("  request_headers = [\n")
-	for _, h := range monitor.RequestHeaders {
-		sb.WriteString("    {\n")
-		fmt.Fprintf(&sb, "      name  = %s\n", migrate.QuoteHCL(h.Name))
-		fmt.Fprintf(&sb, "      value = %s\n", migrate.QuoteHCL(h.Value))
-		sb.WriteString("    },\n")
+	headers := make([][]hclgen.Attr, len(monitor.RequestHeaders))
+	for i, h := range monitor.RequestHeaders {
+		headers[i] = []hclgen.Attr{{Name: "name", Value: h.Name}, {Name: "value", Value: h.Value}}
 	}
-	sb.WriteString("  ]\n")
-	return sb.String()
+	r.SetObjectList("request_headers", headers)
 }
 
-// buildOptionalRequestBody returns the request_body line if set.
-func buildOptionalRequestBody(monitor *hyperping.CreateMonitorRequest) string {
+// setOptionalRequestBody sets request_body if set.
+func setOptionalRequestBody(r *hclgen.Body, monitor *hyperping.CreateMonitorRequest) {
 	if monitor.RequestBody == nil || *monitor.RequestBody == "" {
-		return ""
+		return
 	}
-	return fmt.Sprintf("  request_body = %s\n", migrate.QuoteHCL(*monitor.RequestBody))
+	r.SetString("request_body", *monitor.RequestBody)
 }
 
-// buildOptionalPaused returns the paused line if true.
-func buildOptionalPaused(monitor *hyperping.CreateMonitorRequest) string {
+// setOptionalPaused sets paused if true.
+func setOptionalPaused(r *hclgen.Body, monitor *hyperping.CreateMonitorRequest) {
 	if !monitor.Paused {
-		return ""
+		return
 	}
-	return "  paused = true\n"
+	r.SetBool("paused", true)
 }
 
 // terraformName converts a resource name to a valid Terraform identifier.
@@ -213,18 +226,3 @@ func (g *TerraformGenerator) terraformName(name string) string {
 
 	return tfName
 }
-
-// formatStringList formats a Go string slice as an HCL list, with each item
-// safely quoted via migrate.QuoteHCL (template-interpolation safe).
-func formatStringList(items []string) string {
-	if len(items) == 0 {
-		return "[]"
-	}
-
-	quoted := make([]string, len(items))
-	for i, item := range items {
-		quoted[i] = migrate.QuoteHCL(item)
-	}
-
-	return "[" + strings.Join(quoted, ", ") + "]"
-}
@@ -20,8 +20,8 @@ import (
 // A malicious Pingdom check whose name contains `${file("/etc/passwd")}` must
 // be emitted with the leading dollar/percent doubled so Terraform treats it
 // as literal text rather than evaluating it during plan/apply. This locks in
-// the use of migrate.QuoteHCL inside generator/terraform.go; replacing those
-// calls with fmt's %q verb (which does not escape template sigils) would
+// the use of hclgen inside generator/terraform.go; replacing it with string
+// formatting via fmt's %q verb (which does not escape template sigils) would
 // regress and this test would catch it.
 func TestGenerateHCL_TemplateInjection(t *testing.T) {
 	checks := []pingdom.Check{
@@ -106,3 +106,25 @@ func hasUnescapedTemplateInQuotes(s, needle string, escape byte) bool {
 	}
 	return false
 }
+
+// TestGenerateHCL_CommentInjection verifies that a check name containing line
+// breaks cannot escape the "# Original Name:" comment and inject live HCL.
+func TestGenerateHCL_CommentInjection(t *testing.T) {
+	checks := []pingdom.Check{
+		{
+			ID:       43,
+			Name:     "innocent\nresource \"null_resource\" \"pwn\" {}\r\n",
+			Type:     "dns",
+			Hostname: "example.com",
+		},
+	}
+	results := []converter.ConversionResult{converter.NewCheckConverter().Convert(checks[0])}
+
+	out := NewTerraformGenerator("").GenerateHCL(checks, results)
+
+	for _, line := range strings.Split(out, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "resource") {
+			t.Errorf("check name escaped its comment into a live line %q\n---\n%s\n---", line, out)
+		}
+	}
+}
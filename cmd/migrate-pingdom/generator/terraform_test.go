@@ -11,6 +11,8 @@ import (
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/converter"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/pingdom"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
 	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
@@ -61,72 +63,62 @@ func TestEscapeHCL(t *testing.T) {
 	}
 }
 
-func TestFormatStringList(t *testing.T) {
-	tests := []struct {
-		in   []string
-		want string
-	}{
-		{nil, "[]"},
-		{[]string{}, "[]"},
-		{[]string{"a"}, `["a"]`},
-		{[]string{"a", "b"}, `["a", "b"]`},
-	}
-	for _, tt := range tests {
-		if got := formatStringList(tt.in); got != tt.want {
-			t.Errorf("formatStringList(%v) = %q, want %q", tt.in, got, tt.want)
-		}
-	}
+// renderBody renders the attributes written by fn as a standalone file.
+func renderBody(fn func(*hclgen.Body)) string {
+	f := hclgen.NewFile()
+	fn(f.Body())
+	return f.String()
 }
 
-func TestBuildOptionalHelpers(t *testing.T) {
+func TestSetOptionalHelpers(t *testing.T) {
 	tests := []struct {
 		name string
-		fn   func(*hyperping.CreateMonitorRequest) string
+		fn   func(*hclgen.Body, *hyperping.CreateMonitorRequest)
 		mon  *hyperping.CreateMonitorRequest
 		want string
 	}{
-		{"http_method default GET omitted", buildOptionalHTTPMethod, &hyperping.CreateMonitorRequest{HTTPMethod: "GET"}, ""},
-		{"http_method empty omitted", buildOptionalHTTPMethod, &hyperping.CreateMonitorRequest{}, ""},
-		{"http_method POST emitted", buildOptionalHTTPMethod, &hyperping.CreateMonitorRequest{HTTPMethod: "POST"}, "  http_method = \"POST\"\n"},
+		{"http_method default GET omitted", setOptionalHTTPMethod, &hyperping.CreateMonitorRequest{HTTPMethod: "GET"}, ""},
+		{"http_method empty omitted", setOptionalHTTPMethod, &hyperping.CreateMonitorRequest{}, ""},
+		{"http_method POST emitted", setOptionalHTTPMethod, &hyperping.CreateMonitorRequest{HTTPMethod: "POST"}, "http_method = \"POST\"\n"},
 
-		{"frequency 60 omitted", buildOptionalCheckFrequency, &hyperping.CreateMonitorRequest{CheckFrequency: 60}, ""},
-		{"frequency 300 emitted", buildOptionalCheckFrequency, &hyperping.CreateMonitorRequest{CheckFrequency: 300}, "  check_frequency = 300\n"},
+		{"frequency 60 omitted", setOptionalCheckFrequency, &hyperping.CreateMonitorRequest{CheckFrequency: 60}, ""},
+		{"frequency 300 emitted", setOptionalCheckFrequency, &hyperping.CreateMonitorRequest{CheckFrequency: 300}, "check_frequency = 300\n"},
 
-		{"empty regions omitted", buildOptionalRegions, &hyperping.CreateMonitorRequest{}, ""},
-		{"regions emitted", buildOptionalRegions, &hyperping.CreateMonitorRequest{Regions: []string{"london", "virginia"}}, "  regions = [\"london\", \"virginia\"]\n"},
+		{"empty regions omitted", setOptionalRegions, &hyperping.CreateMonitorRequest{}, ""},
+		{"regions emitted", setOptionalRegions, &hyperping.CreateMonitorRequest{Regions: []string{"london", "virginia"}}, "regions = [\"london\", \"virginia\"]\n"},
 
-		{"port nil omitted", buildOptionalPort, &hyperping.CreateMonitorRequest{}, ""},
-		{"port zero omitted", buildOptionalPort, &hyperping.CreateMonitorRequest{Port: intPtr(0)}, ""},
-		{"port emitted", buildOptionalPort, &hyperping.CreateMonitorRequest{Port: intPtr(5432)}, "  port = 5432\n"},
+		{"port nil omitted", setOptionalPort, &hyperping.CreateMonitorRequest{}, ""},
+		{"port zero omitted", setOptionalPort, &hyperping.CreateMonitorRequest{Port: intPtr(0)}, ""},
+		{"port emitted", setOptionalPort, &hyperping.CreateMonitorRequest{Port: intPtr(5432)}, "port = 5432\n"},
 
-		{"follow nil omitted", buildOptionalFollowRedirects, &hyperping.CreateMonitorRequest{}, ""},
-		{"follow true omitted", buildOptionalFollowRedirects, &hyperping.CreateMonitorRequest{FollowRedirects: boolPtr(true)}, ""},
-		{"follow false emitted", buildOptionalFollowRedirects, &hyperping.CreateMonitorRequest{FollowRedirects: boolPtr(false)}, "  follow_redirects = false\n"},
+		{"follow nil omitted", setOptionalFollowRedirects, &hyperping.CreateMonitorRequest{}, ""},
+		{"follow true omitted", setOptionalFollowRedirects, &hyperping.CreateMonitorRequest{FollowRedirects: boolPtr(true)}, ""},
+		{"follow false emitted", setOptionalFollowRedirects, &hyperping.CreateMonitorRequest{FollowRedirects: boolPtr(false)}, "follow_redirects = false\n"},
 
-		{"status default omitted", buildOptionalExpectedStatus, &hyperping.CreateMonitorRequest{ExpectedStatusCode: "200"}, ""},
-		{"status empty omitted", buildOptionalExpectedStatus, &hyperping.CreateMonitorRequest{}, ""},
-		{"status 201 emitted", buildOptionalExpectedStatus, &hyperping.CreateMonitorRequest{ExpectedStatusCode: "201"}, "  expected_status_code = \"201\"\n"},
+		{"status default omitted", setOptionalExpectedStatus, &hyperping.CreateMonitorRequest{ExpectedStatusCode: "200"}, ""},
+		{"status empty omitted", setOptionalExpectedStatus, &hyperping.CreateMonitorRequest{}, ""},
+		{"status 201 emitted", setOptionalExpectedStatus, &hyperping.CreateMonitorRequest{ExpectedStatusCode: "201"}, "expected_status_code = \"201\"\n"},
 
-		{"keyword nil omitted", buildOptionalRequiredKeyword, &hyperping.CreateMonitorRequest{}, ""},
-		{"keyword empty omitted", buildOptionalRequiredKeyword, &hyperping.CreateMonitorRequest{RequiredKeyword: strPtr("")}, ""},
-		{"keyword emitted", buildOptionalRequiredKeyword, &hyperping.CreateMonitorRequest{RequiredKeyword: strPtr("ok")}, "  required_keyword = \"ok\"\n"},
+		{"keyword nil omitted", setOptionalRequiredKeyword, &hyperping.CreateMonitorRequest{}, ""},
+		{"keyword empty omitted", setOptionalRequiredKeyword, &hyperping.CreateMonitorRequest{RequiredKeyword: strPtr("")}, ""},
+		{"keyword emitted", setOptionalRequiredKeyword, &hyperping.CreateMonitorRequest{RequiredKeyword: strPtr("ok")}, "required_keyword = \"ok\"\n"},
 
-		{"body nil omitted", buildOptionalRequestBody, &hyperping.CreateMonitorRequest{}, ""},
-		{"body empty omitted", buildOptionalRequestBody, &hyperping.CreateMonitorRequest{RequestBody: strPtr("")}, ""},
-		{"body emitted (ASCII-safe)", buildOptionalRequestBody, &hyperping.CreateMonitorRequest{RequestBody: strPtr("hello")}, "  request_body = \"hello\"\n"},
+		{"body nil omitted", setOptionalRequestBody, &hyperping.CreateMonitorRequest{}, ""},
+		{"body empty omitted", setOptionalRequestBody, &hyperping.CreateMonitorRequest{RequestBody: strPtr("")}, ""},
+		{"body emitted (ASCII-safe)", setOptionalRequestBody, &hyperping.CreateMonitorRequest{RequestBody: strPtr("hello")}, "request_body = \"hello\"\n"},
 		// The earlier double-escape bug (terraform.go formatting an already-escaped
 		// string with %q) was fixed in PR #138, which switched the generator to
 		// migrate.QuoteHCL. For input {"a":1} the correct HCL output is
 		// `"{\"a\":1}"` (1 backslash before each quote), encoded here as the
-		// Go literal "  request_body = \"{\\\"a\\\":1}\"\n".
-		{"body emitted (json escaped once)", buildOptionalRequestBody, &hyperping.CreateMonitorRequest{RequestBody: strPtr(`{"a":1}`)}, "  request_body = \"{\\\"a\\\":1}\"\n"},
+		// Go literal "request_body = \"{\\\"a\\\":1}\"\n".
+		{"body emitted (json escaped once)", setOptionalRequestBody, &hyperping.CreateMonitorRequest{RequestBody: strPtr(`{"a":1}`)}, "request_body = \"{\\\"a\\\":1}\"\n"},
 
-		{"paused false omitted", buildOptionalPaused, &hyperping.CreateMonitorRequest{}, ""},
-		{"paused true emitted", buildOptionalPaused, &hyperping.CreateMonitorRequest{Paused: true}, "  paused = true\n"},
+		{"paused false omitted", setOptionalPaused, &hyperping.CreateMonitorRequest{}, ""},
+		{"paused true emitted", setOptionalPaused, &hyperping.CreateMonitorRequest{Paused: true}, "paused = true\n"},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			if got := tt.fn(tt.mon); got != tt.want {
+			if got := renderBody(func(b *hclgen.Body) { tt.fn(b, tt.mon) }); got != tt.want {
 				t.Errorf("got %q, want %q", got, tt.want)
 			}
 		})
@@ -134,7 +126,7 @@ func TestBuildOptionalHelpers(t *testing.T) {
 }
 
 func TestBuildOptionalRequestHeaders(t *testing.T) {
-	if got := buildOptionalRequestHeaders(&hyperping.CreateMonitorRequest{}); got != "" {
+	if got := renderBody(func(b *hclgen.Body) { setOptionalRequestHeaders(b, &hyperping.CreateMonitorRequest{}) }); got != "" {
 		t.Errorf("expected empty for no headers, got %q", got)
 	}
 	mon := &hyperping.CreateMonitorRequest{
@@ -142,8 +134,8 @@ func TestBuildOptionalRequestHeaders(t *testing.T) {
 			{Name: "X-Foo", Value: "bar"},
 		},
 	}
-	got := buildOptionalRequestHeaders(mon)
-	want := "  request_headers = [\n    {\n      name  = \"X-Foo\"\n      value = \"bar\"\n    },\n  ]\n"
+	got := renderBody(func(b *hclgen.Body) { setOptionalRequestHeaders(b, mon) })
+	want := "request_headers = [\n  {\n    name  = \"X-Foo\"\n    value = \"bar\"\n  },\n]\n"
 	if got != want {
 		t.Errorf("got:\n%s\nwant:\n%s", got, want)
 	}
@@ -242,3 +234,20 @@ func TestGenerateHCL_Notes(t *testing.T) {
 		t.Errorf("expected NOTE in HCL:\n%s", hcl)
 	}
 }
+
+func TestGenerateHCL_CompatMode(t *testing.T) {
+	check := pingdom.Check{ID: 4, Name: "Keyword", Type: "http", Hostname: "site.example.com", ShouldContain: "ok"}
+	results := []converter.ConversionResult{
+		converter.NewCheckConverter().Convert(check),
+	}
+
+	hcl := NewTerraformGenerator("").GenerateHCL([]pingdom.Check{check}, results)
+	if strings.Contains(hcl, "lifecycle") {
+		t.Errorf("compat mode none should not emit lifecycle blocks:\n%s", hcl)
+	}
+
+	hcl = NewTerraformGenerator("").WithCompatMode(compat.IgnoreChanges).GenerateHCL([]pingdom.Check{check}, results)
+	if !strings.Contains(hcl, "ignore_changes = [\n      required_keyword,\n    ]") {
+		t.Errorf("expected required_keyword to be ignored:\n%s", hcl)
+	}
+}
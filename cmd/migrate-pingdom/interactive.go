@@ -14,7 +14,11 @@ import (
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/generator"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/pingdom"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/report"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
 	"github.com/develeap/terraform-provider-hyperping/pkg/interactive"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 // interactiveConfigPD holds configuration collected from interactive prompts.
@@ -355,6 +359,15 @@ func (w *interactiveWizardPD) writeImportScript(createdResources map[int]string)
 		w.prompter.PrintError(fmt.Sprintf("Failed to write import script: %v", writeErr))
 		return 1
 	}
+
+	idMap := mapping.New("Pingdom", toolName, "")
+	for _, entry := range importGen.MappingEntries(w.checks, w.results, createdResources) {
+		idMap.Add(entry)
+	}
+	if err := idMap.Write(filepath.Join(w.config.outputDir, mapping.DefaultFileName)); err != nil {
+		w.prompter.PrintError(fmt.Sprintf("Failed to write mapping: %v", err))
+		return 1
+	}
 	return 0
 }
 
@@ -369,6 +382,7 @@ func (w *interactiveWizardPD) printFinalSummary(migrationReport *report.Migratio
 	fmt.Fprintf(os.Stderr, "  📊 report.json - Detailed migration report\n")
 	fmt.Fprintf(os.Stderr, "  📝 report.txt - Human-readable report\n")
 	fmt.Fprintf(os.Stderr, "  📋 manual-steps.md - Manual configuration steps\n")
+	fmt.Fprintf(os.Stderr, "  🔗 %s - Source ID mapping\n", mapping.DefaultFileName)
 	fmt.Fprintf(os.Stderr, "\n")
 
 	if len(migrationReport.ManualSteps) > 0 {
@@ -477,10 +491,13 @@ func isFlagPassed() bool {
 	if *hyperpingBaseURL != "https://api.hyperping.io" {
 		return true
 	}
-	if *dryRun || *verbose || *resume || *rollback || *rollbackForce || *listCheckpointsFlag {
+	if *dryRun || *verbose || *resume || *rollback || *rollbackForce || *listCheckpointsFlag || *loginFlag {
+		return true
+	}
+	if *resumeID != "" || *rollbackID != "" || *nameTemplateFlag != "" || *overridesFlag != "" || *frequencyPolicyFlag != string(migrate.FrequencyNearest) {
 		return true
 	}
-	if *resumeID != "" || *rollbackID != "" {
+	if *outputDialectFlag != string(dialect.Terraform) || *compatModeFlag != string(compat.None) {
 		return true
 	}
 	if os.Getenv("PINGDOM_API_KEY") != "" || os.Getenv("PINGDOM_API_TOKEN") != "" {
@@ -0,0 +1,42 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/pingdom"
+	"github.com/develeap/terraform-provider-hyperping/pkg/credentials"
+)
+
+// pingdomPlatform describes Pingdom for the --login flow.
+var pingdomPlatform = credentials.Platform{
+	Name:     "Pingdom",
+	Account:  "pingdom",
+	TokenURL: "https://my.pingdom.com/app/api-tokens",
+	Verify: func(ctx context.Context, token string) error {
+		_, err := pingdom.NewClient(token).ListChecks(ctx)
+		return err
+	},
+}
+
+// keychain holds source tokens stored by --login. Tests replace it so they
+// never read the developer's real keychain.
+var keychain = credentials.OSKeychain()
+
+// runLogin runs the --login flow and exits without migrating.
+func runLogin() int {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
+	defer cancel()
+
+	if _, err := credentials.Login(ctx, pingdomPlatform, credentials.LoginOptions{Keychain: keychain}); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Fprintln(os.Stderr, credentials.StoredTokenHint("pingdom-api-key", "PINGDOM_API_KEY"))
+	return 0
+}
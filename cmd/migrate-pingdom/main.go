@@ -16,6 +16,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"strconv"
 	"time"
 
 	hyperping "github.com/develeap/hyperping-go"
@@ -25,8 +26,15 @@ import (
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/pingdom"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/report"
 	"github.com/develeap/terraform-provider-hyperping/pkg/checkpoint"
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/credentials"
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 	"github.com/develeap/terraform-provider-hyperping/pkg/migrationstate"
 	"github.com/develeap/terraform-provider-hyperping/pkg/recovery"
+	"github.com/develeap/terraform-provider-hyperping/pkg/tfstate"
 )
 
 var (
@@ -44,6 +52,37 @@ var (
 	rollbackID          = flag.String("rollback-id", "", "Rollback specific migration ID")
 	rollbackForce       = flag.Bool("force", false, "Force rollback without confirmation")
 	listCheckpointsFlag = flag.Bool("list-checkpoints", false, "List available checkpoints")
+	verifyMode          = flag.Bool("verify", false, "Compare Pingdom checks with existing Hyperping monitors and write the verification report")
+	verifyReport        = flag.String("verify-report", "verification-report.json", "Output verification report file (use with --verify); relative paths are in the output directory")
+	nameTemplateFlag    = flag.String("name-template", "", "Go template for Hyperping names instead of the generated [ENV]-Category-Service name (fields: .Name, .Tags)")
+	overridesFlag       = flag.String("overrides", "", "YAML mapping override file: per Pingdom check ID, name, regions, frequency, or skip")
+	regionMapFlag       = flag.String("region-map", "", "YAML file mapping Pingdom probe filters to Hyperping regions, overriding the built-in table and nearest-region fallback")
+	frequencyPolicyFlag = flag.String("frequency-policy", string(migrate.FrequencyNearest), "How to snap resolutions Hyperping does not support: nearest, round-up, round-down, or fail")
+	logFlags            = recovery.RegisterLogFlags(flag.CommandLine)
+	checkWriteAccess    = recovery.RegisterWriteCheckFlag(flag.CommandLine)
+	listFlags           = migrationstate.RegisterListFlags(flag.CommandLine, toolName)
+	rollbackFlags       = migrationstate.RegisterRollbackFlags(flag.CommandLine)
+	webhookFlags        = migrationstate.RegisterWebhookFlags(flag.CommandLine)
+	loginFlag           = credentials.RegisterLoginFlag(flag.CommandLine, pingdomPlatform)
+	outputDialectFlag   = dialect.RegisterFlag(flag.CommandLine)
+	compatModeFlag      = compat.RegisterFlag(flag.CommandLine)
+	mappingFlag         = mapping.RegisterFlag(flag.CommandLine, "")
+	fromStateFlag       = tfstate.RegisterFlag(flag.CommandLine)
+
+	// nameTemplate is parsed from --name-template in run; nil keeps GenerateName.
+	nameTemplate *migrate.NameTemplate
+	// overrides is loaded from --overrides in run; nil applies none.
+	overrides *migrate.Overrides
+	// regionMap is loaded from --region-map in run; nil applies none.
+	regionMap *migrate.RegionMap
+	// frequencyPolicy is parsed from --frequency-policy in run.
+	frequencyPolicy migrate.FrequencyPolicy
+	// outputDialect is parsed from --output-dialect in run.
+	outputDialect dialect.Dialect
+	// compatMode is parsed from --compat-mode in run.
+	compatMode compat.Mode
+	// notifier is built from --webhook-url in run; nil sends no webhooks.
+	notifier *migrationstate.Notifier
 )
 
 // pingdomRunner holds resolved configuration for a non-interactive run.
@@ -54,6 +93,10 @@ type pingdomRunner struct {
 	cancel       context.CancelFunc
 	state        *migrationstate.State
 	migrationID  string
+
+	// stateInstances are the checks read from --from-state; nil when checks
+	// are fetched from the Pingdom API.
+	stateInstances []tfstate.Instance
 }
 
 func main() {
@@ -69,10 +112,31 @@ func main() {
 		fmt.Fprintf(os.Stderr, "  migrate-pingdom --output=./migration\n\n")
 		fmt.Fprintf(os.Stderr, "  # With resource name prefix\n")
 		fmt.Fprintf(os.Stderr, "  migrate-pingdom --prefix=pingdom_ --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Keep Pingdom names, prefixed with the env:<value> tag\n")
+		fmt.Fprintf(os.Stderr, "  migrate-pingdom --name-template='[{{.Tag \"env\" | upper}}] {{.Name}}'\n\n")
+		fmt.Fprintf(os.Stderr, "  # Correct names, regions, or frequencies per check, or skip checks\n")
+		fmt.Fprintf(os.Stderr, "  migrate-pingdom --overrides=overrides.yaml --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Map Pingdom probe regions to specific Hyperping regions\n")
+		fmt.Fprintf(os.Stderr, "  migrate-pingdom --region-map=regions.yaml --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Never check less often than Pingdom did\n")
+		fmt.Fprintf(os.Stderr, "  migrate-pingdom --frequency-policy=round-down --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Sign in through the browser and store the Pingdom token in the OS keychain\n")
+		fmt.Fprintf(os.Stderr, "  migrate-pingdom --login\n\n")
+		fmt.Fprintf(os.Stderr, "  # Generate a Terragrunt unit instead of plain Terraform\n")
+		fmt.Fprintf(os.Stderr, "  migrate-pingdom --output-dialect=terragrunt --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Report phase transitions to a Slack channel\n")
+		fmt.Fprintf(os.Stderr, "  migrate-pingdom --webhook-url=\"$SLACK_WEBHOOK_URL\" --output=./migration\n\n")
+		fmt.Fprintf(os.Stderr, "  # Convert checks managed by the Pingdom Terraform provider\n")
+		fmt.Fprintf(os.Stderr, "  terraform state pull > pingdom.tfstate\n")
+		fmt.Fprintf(os.Stderr, "  migrate-pingdom --from-state=pingdom.tfstate --output=./migration\n\n")
 		fmt.Fprintf(os.Stderr, "  # Resume from last checkpoint\n")
 		fmt.Fprintf(os.Stderr, "  migrate-pingdom --resume\n\n")
+		fmt.Fprintf(os.Stderr, "  # Verify migrated monitors\n")
+		fmt.Fprintf(os.Stderr, "  migrate-pingdom --verify --output=./migration\n\n")
 		fmt.Fprintf(os.Stderr, "  # Rollback migration\n")
 		fmt.Fprintf(os.Stderr, "  migrate-pingdom --rollback --rollback-id=pingdom-20260213-120000\n\n")
+		fmt.Fprintf(os.Stderr, "  # Rollback and also remove the imported state entries and generated files\n")
+		fmt.Fprintf(os.Stderr, "  migrate-pingdom --rollback --rollback-state-dir=./infra --rollback-files=remove\n\n")
 	}
 
 	os.Exit(run())
@@ -81,12 +145,67 @@ func main() {
 func run() int {
 	flag.Parse()
 
+	if err := clientenv.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var err error
+	nameTemplate, err = migrate.ParseNameTemplate(*nameTemplateFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	overrides, err = migrate.LoadOverrides(*overridesFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	regionMap, err = migrate.LoadRegionMap(*regionMapFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	frequencyPolicy, err = migrate.ParseFrequencyPolicy(*frequencyPolicyFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	outputDialect, err = dialect.ParseDialect(*outputDialectFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	compatMode, err = compat.ParseMode(*compatModeFlag)
+	if err == nil {
+		err = compatMode.CheckDialect(outputDialect)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	notifier, err = webhookFlags.Notifier()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if *loginFlag {
+		return runLogin()
+	}
+
 	if shouldUseInteractive() {
 		return runInteractive()
 	}
 
 	if *listCheckpointsFlag {
-		return migrationstate.ListCheckpoints(toolName)
+		return migrationstate.ListCheckpoints(listFlags.Options())
 	}
 
 	if *rollback {
@@ -99,6 +218,16 @@ func run() int {
 	}
 	defer r.cancel()
 
+	if *verifyMode {
+		return r.runVerification()
+	}
+
+	if *dryRun {
+		if exitCode := r.runDryValidation(); exitCode != 0 {
+			return r.fail(exitCode)
+		}
+	}
+
 	checks, results, exitCode := r.fetchAndConvert()
 	if exitCode != 0 {
 		return exitCode
@@ -108,13 +237,24 @@ func run() int {
 	migrationReport := reporter.GenerateReport(checks, results)
 
 	if exitCode := r.writeReports(reporter, migrationReport); exitCode != 0 {
-		return exitCode
+		return r.fail(exitCode)
 	}
 
 	createdResources := r.createHyperpingResources(checks, results)
+	if r.state != nil && !*dryRun {
+		r.state.Notify(migrationstate.PhaseResourcesCreated, fmt.Sprintf("created %d monitors in Hyperping", len(createdResources)))
+	}
 
 	if exitCode := r.writeImportScript(checks, results, createdResources); exitCode != 0 {
-		return exitCode
+		return r.fail(exitCode)
+	}
+
+	if exitCode := r.writeMapping(checks, results, createdResources); exitCode != 0 {
+		return r.fail(exitCode)
+	}
+
+	if exitCode := r.writeRemovedBlocks(); exitCode != 0 {
+		return r.fail(exitCode)
 	}
 
 	if r.state != nil {
@@ -125,7 +265,7 @@ func run() int {
 		}
 	}
 
-	printRunSummary(migrationReport)
+	printRunSummary(migrationReport, len(r.stateInstances) > 0)
 	return 0
 }
 
@@ -141,7 +281,7 @@ func handleRollback() int {
 		return 1
 	}
 
-	logger, err := recovery.NewLogger(false)
+	logger, err := recovery.NewLoggerWithOptions(*verbose, logFlags.Options())
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: Failed to create logger: %v\n", err)
 		return 1
@@ -164,7 +304,7 @@ func handleRollback() int {
 		migID = latest.MigrationID
 	}
 
-	return migrationstate.PerformRollback(migID, hpKey, *rollbackForce, logger)
+	return migrationstate.PerformRollback(migID, hpKey, *rollbackForce, rollbackOptions(), logger)
 }
 
 // newPingdomRunner validates flags, resolves API keys, sets up the context, and initialises state.
@@ -176,14 +316,17 @@ func newPingdomRunner() (*pingdomRunner, int) {
 	if pingdomKey == "" {
 		pingdomKey = os.Getenv("PINGDOM_API_TOKEN")
 	}
+	if pingdomKey == "" {
+		pingdomKey = credentials.Lookup(keychain, pingdomPlatform)
+	}
 
 	hyperpingKey := *hyperpingAPIKey
 	if hyperpingKey == "" {
 		hyperpingKey = os.Getenv("HYPERPING_API_KEY")
 	}
 
-	if pingdomKey == "" {
-		fmt.Fprintln(os.Stderr, "Error: Pingdom API key is required (--pingdom-api-key or PINGDOM_API_KEY)")
+	if pingdomKey == "" && *fromStateFlag == "" {
+		fmt.Fprintln(os.Stderr, "Error: Pingdom API key is required (--pingdom-api-key, PINGDOM_API_KEY, or --login), or use --from-state")
 		return nil, 1
 	}
 
@@ -207,6 +350,11 @@ func newPingdomRunner() (*pingdomRunner, int) {
 		cancel:       cancel,
 	}
 
+	// Verification is read-only and does not create a checkpoint.
+	if *verifyMode {
+		return r, 0
+	}
+
 	if err := r.initState(); err != nil {
 		cancel()
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -218,7 +366,7 @@ func newPingdomRunner() (*pingdomRunner, int) {
 
 // initState initialises or resumes migration state.
 func (r *pingdomRunner) initState() error {
-	logger, err := recovery.NewLogger(false)
+	logger, err := recovery.NewLoggerWithOptions(*verbose, logFlags.Options())
 	if err != nil {
 		return fmt.Errorf("failed to create logger: %w", err)
 	}
@@ -260,12 +408,33 @@ func (r *pingdomRunner) initState() error {
 	return nil
 }
 
+// runDryValidation validates the Pingdom and Hyperping API keys in dry-run
+// mode and reports both in one summary.
+func (r *pingdomRunner) runDryValidation() int {
+	validator := recovery.NewAPIValidator(r.state.Logger)
+
+	source := recovery.SkippedValidation("Pingdom", "reading --from-state")
+	if *fromStateFlag == "" {
+		source = validator.ValidateSourceAPI(r.ctx, "Pingdom", func(ctx context.Context) error {
+			_, err := createPingdomClient(r.pingdomKey).ListChecks(ctx)
+			return err
+		})
+	}
+
+	destination := recovery.SkippedValidation(recovery.DestinationServiceName, "no API key")
+	if r.hyperpingKey != "" {
+		destination = validator.ValidateDestinationAPI(r.ctx, createHyperpingClient(r.hyperpingKey), *checkWriteAccess)
+	}
+
+	if !recovery.PrintValidationSummary(os.Stderr, source, destination) {
+		return 1
+	}
+	return 0
+}
+
 // fetchAndConvert fetches Pingdom checks and converts them to Hyperping format.
 func (r *pingdomRunner) fetchAndConvert() ([]pingdom.Check, []converter.ConversionResult, int) {
-	log("Fetching Pingdom checks...")
-	pingdomClient := createPingdomClient(r.pingdomKey)
-
-	checks, err := pingdomClient.ListChecks(r.ctx)
+	checks, err := r.listChecks()
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error fetching Pingdom checks: %v\n", err)
 		return nil, nil, 1
@@ -274,12 +443,20 @@ func (r *pingdomRunner) fetchAndConvert() ([]pingdom.Check, []converter.Conversi
 
 	if r.state != nil {
 		r.state.Checkpoint.TotalResources = len(checks)
+		if !*dryRun {
+			r.state.SetNotifier(notifier)
+			r.state.Notify(migrationstate.PhaseStarted, "")
+		}
 	}
 
+	warnUnknownOverrides(checks)
+
 	log("Converting checks to Hyperping format...")
-	checkConverter := converter.NewCheckConverter()
+	checkConverter := converter.NewCheckConverter().WithNameTemplate(nameTemplate).WithOverrides(overrides).WithFrequencyPolicy(frequencyPolicy).
+		WithRegionMap(regionMap)
 	results := make([]converter.ConversionResult, len(checks))
 	supportedCount := 0
+	skippedCount := 0
 	for i, check := range checks {
 		checkID := fmt.Sprintf("check-%d", check.ID)
 		if r.state != nil && r.state.IsProcessed(checkID) {
@@ -295,35 +472,81 @@ func (r *pingdomRunner) fetchAndConvert() ([]pingdom.Check, []converter.Conversi
 		if results[i].Supported {
 			supportedCount++
 		}
+		if results[i].Skipped {
+			skippedCount++
+		}
 
 		if r.state != nil {
-			if results[i].Supported {
+			if results[i].Supported || results[i].Skipped {
 				r.state.MarkResourceProcessed(checkID)
 			} else {
-				r.state.MarkResourceFailed(checkID, "check", check.Name, "unsupported check type")
+				reason := "unsupported check type"
+				if results[i].UnsupportedType == converter.UnsupportedFrequency {
+					reason = "unsupported check frequency"
+				}
+				r.state.MarkResourceFailed(checkID, "check", check.Name, reason)
 			}
 		}
 	}
-	log(fmt.Sprintf("Converted %d/%d checks (%d unsupported)", supportedCount, len(checks), len(checks)-supportedCount))
+	log(fmt.Sprintf("Converted %d/%d checks (%d unsupported, %d skipped)", supportedCount, len(checks), len(checks)-supportedCount-skippedCount, skippedCount))
 
 	if r.state != nil {
 		r.state.SaveCheckpoint()
 	}
 
 	log("Generating Terraform configuration...")
-	tfGen := generator.NewTerraformGenerator(*prefix)
+	tfGen := generator.NewTerraformGenerator(*prefix).WithCompatMode(compatMode)
 	hclContent := tfGen.GenerateHCL(checks, results)
 
 	hclPath := filepath.Join(*outputDir, "monitors.tf")
-	if writeErr := os.WriteFile(hclPath, []byte(hclContent), 0o600); writeErr != nil {
+	paths, writeErr := dialect.WriteConfig(outputDialect, []byte(hclContent), hclPath)
+	if writeErr != nil {
 		fmt.Fprintf(os.Stderr, "Error writing Terraform configuration: %v\n", writeErr)
 		return nil, nil, 1
 	}
-	log(fmt.Sprintf("Terraform configuration written to %s", hclPath))
+	for _, path := range paths {
+		log(fmt.Sprintf("Terraform configuration written to %s", path))
+	}
+	if r.state != nil {
+		r.state.AddGeneratedFiles(paths...)
+	}
 
 	return checks, results, 0
 }
 
+// listChecks fetches Pingdom checks from the API, or reads the checks
+// managed by the Pingdom Terraform provider from the --from-state file.
+func (r *pingdomRunner) listChecks() ([]pingdom.Check, error) {
+	if *fromStateFlag == "" {
+		log("Fetching Pingdom checks...")
+		return createPingdomClient(r.pingdomKey).ListChecks(r.ctx)
+	}
+
+	log(fmt.Sprintf("Reading Pingdom checks from %s...", *fromStateFlag))
+	state, err := tfstate.Load(*fromStateFlag)
+	if err != nil {
+		return nil, err
+	}
+	checks, instances := pingdom.FromState(state)
+	if len(checks) == 0 {
+		return nil, fmt.Errorf("no %s resources found in %s", pingdom.CheckResourceType, *fromStateFlag)
+	}
+	r.stateInstances = instances
+	return checks, nil
+}
+
+// warnUnknownOverrides warns about mapping overrides whose ID matches no
+// fetched check, which usually means a typo.
+func warnUnknownOverrides(checks []pingdom.Check) {
+	ids := make([]string, len(checks))
+	for i, check := range checks {
+		ids[i] = strconv.Itoa(check.ID)
+	}
+	for _, id := range overrides.Unknown(ids) {
+		fmt.Fprintf(os.Stderr, "Warning: mapping override %q matches no Pingdom check\n", id)
+	}
+}
+
 // writeReports generates and writes all report files.
 func (r *pingdomRunner) writeReports(reporter *report.Reporter, migrationReport *report.MigrationReport) int {
 	log("Generating migration report...")
@@ -352,6 +575,9 @@ func (r *pingdomRunner) writeReports(reporter *report.Reporter, migrationReport
 		fmt.Fprintf(os.Stderr, "Error writing manual steps: %v\n", writeErr)
 		return 1
 	}
+	if r.state != nil {
+		r.state.AddGeneratedFiles(jsonPath, textPath, manualPath)
+	}
 
 	log(fmt.Sprintf("Reports written to %s", *outputDir))
 	return 0
@@ -397,6 +623,15 @@ func (r *pingdomRunner) createHyperpingResources(checks []pingdom.Check, results
 	return createdResources
 }
 
+// fail saves the checkpoint as failed, which also reports the failure to the
+// webhook, and returns exitCode.
+func (r *pingdomRunner) fail(exitCode int) int {
+	if r.state != nil {
+		r.state.Finalize(false)
+	}
+	return exitCode
+}
+
 // writeImportScript generates and writes the import shell script.
 func (r *pingdomRunner) writeImportScript(checks []pingdom.Check, results []converter.ConversionResult, createdResources map[int]string) int {
 	log("Generating import script...")
@@ -408,14 +643,73 @@ func (r *pingdomRunner) writeImportScript(checks []pingdom.Check, results []conv
 		fmt.Fprintf(os.Stderr, "Error writing import script: %v\n", writeErr)
 		return 1
 	}
+	if r.state != nil {
+		r.state.AddGeneratedFiles(importPath)
+		for uuid, address := range importGen.ImportAddresses(checks, results, createdResources) {
+			r.state.SetResourceAddress(uuid, address)
+		}
+	}
 
 	log(fmt.Sprintf("Import script written to %s", importPath))
 	return 0
 }
 
+// writeRemovedBlocks writes removed blocks for the checks read from
+// --from-state. It does nothing when checks came from the API.
+func (r *pingdomRunner) writeRemovedBlocks() int {
+	if len(r.stateInstances) == 0 {
+		return 0
+	}
+	removedPath := filepath.Join(*outputDir, "removed.tf")
+	if err := os.WriteFile(removedPath, []byte(tfstate.RemovedBlocks(r.stateInstances)), 0o600); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing removed blocks: %v\n", err)
+		return 1
+	}
+	if r.state != nil {
+		r.state.AddGeneratedFiles(removedPath)
+	}
+	log(fmt.Sprintf("Removed blocks written to %s", removedPath))
+	return 0
+}
+
+// writeMapping writes the mapping from Pingdom IDs to Hyperping UUIDs and
+// Terraform addresses. It is not recorded for --rollback-files, so it still
+// traces the resources to their origin after a rollback.
+func (r *pingdomRunner) writeMapping(checks []pingdom.Check, results []converter.ConversionResult, createdResources map[int]string) int {
+	idMap := mapping.New("Pingdom", toolName, r.migrationID)
+	for _, entry := range generator.NewImportGenerator(*prefix).MappingEntries(checks, results, createdResources) {
+		idMap.Add(entry)
+	}
+
+	path := mappingPath()
+	if err := idMap.Write(path); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing mapping: %v\n", err)
+		return 1
+	}
+	log(fmt.Sprintf("Mapping written to %s", path))
+	return 0
+}
+
+// mappingPath returns the --mapping file, which defaults to mapping.json in
+// the output directory.
+func mappingPath() string {
+	if *mappingFlag != "" {
+		return *mappingFlag
+	}
+	return filepath.Join(*outputDir, mapping.DefaultFileName)
+}
+
+// rollbackOptions returns the rollback options, including the mapping file
+// of the migration.
+func rollbackOptions() migrationstate.RollbackOptions {
+	opts := rollbackFlags.Options()
+	opts.MappingFile = mappingPath()
+	return opts
+}
+
 // printRunSummary prints the final migration summary and next steps.
-func printRunSummary(migrationReport *report.MigrationReport) {
-	hclPath := filepath.Join(*outputDir, "monitors.tf")
+// fromState adds the removed blocks generated for --from-state.
+func printRunSummary(migrationReport *report.MigrationReport, fromState bool) {
 	importPath := filepath.Join(*outputDir, "import.sh")
 	jsonPath := filepath.Join(*outputDir, "report.json")
 	textPath := filepath.Join(*outputDir, "report.txt")
@@ -429,11 +723,17 @@ func printRunSummary(migrationReport *report.MigrationReport) {
 	fmt.Printf("Output directory: %s\n", *outputDir)
 	fmt.Println()
 	fmt.Println("Generated files:")
-	fmt.Printf("  - %s (Terraform configuration)\n", filepath.Base(hclPath))
+	for _, name := range dialect.FileNames(outputDialect, "monitors.tf") {
+		fmt.Printf("  - %s (%s configuration)\n", name, outputDialect)
+	}
 	fmt.Printf("  - %s (import script)\n", filepath.Base(importPath))
 	fmt.Printf("  - %s (JSON report)\n", filepath.Base(jsonPath))
 	fmt.Printf("  - %s (text report)\n", filepath.Base(textPath))
 	fmt.Printf("  - %s (manual steps)\n", filepath.Base(manualPath))
+	fmt.Printf("  - %s (source ID mapping)\n", mappingPath())
+	if fromState {
+		fmt.Println("  - removed.tf (removed blocks for the Pingdom resources)")
+	}
 	fmt.Println()
 
 	if *dryRun {
@@ -445,6 +745,9 @@ func printRunSummary(migrationReport *report.MigrationReport) {
 		fmt.Println("  2. Run 'terraform init' and 'terraform plan'")
 		fmt.Println("  3. Run './import.sh' to import resources into Terraform state")
 		fmt.Println("  4. Review manual-steps.md for unsupported checks")
+		if fromState {
+			fmt.Println("  5. Move removed.tf into the Pingdom configuration, delete the migrated resource blocks, and apply")
+		}
 	}
 
 	fmt.Println()
@@ -468,7 +771,7 @@ func createPingdomClient(apiKey string) *pingdom.Client {
 }
 
 func createHyperpingClient(apiKey string) *hyperping.Client {
-	return hyperping.NewClient(apiKey, hyperping.WithBaseURL(*hyperpingBaseURL))
+	return clientenv.NewClient(apiKey, hyperping.WithBaseURL(*hyperpingBaseURL))
 }
 
 func log(msg string) {
@@ -4,6 +4,7 @@
 package main
 
 import (
+	"path/filepath"
 	"testing"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/converter"
@@ -205,7 +206,7 @@ func TestRegionConversion(t *testing.T) {
 		{
 			name:          "North America",
 			probeFilters:  []string{"region:NA"},
-			expectRegions: []string{"virginia", "oregon"},
+			expectRegions: []string{"virginia", "california"},
 		},
 		{
 			name:          "Europe",
@@ -215,7 +216,7 @@ func TestRegionConversion(t *testing.T) {
 		{
 			name:          "Multiple regions",
 			probeFilters:  []string{"region:NA", "region:EU"},
-			expectRegions: []string{"virginia", "oregon", "london", "frankfurt"},
+			expectRegions: []string{"virginia", "california", "london", "frankfurt"},
 		},
 		{
 			name:          "No filters (default)",
@@ -252,3 +253,20 @@ func ConvertFrequency(minutes int) int {
 func ConvertRegions(filters []string) []string {
 	return converter.ConvertRegions(filters)
 }
+
+func TestVerifyReportPath(t *testing.T) {
+	previousOutput, previousReport := *outputDir, *verifyReport
+	t.Cleanup(func() { *outputDir, *verifyReport = previousOutput, previousReport })
+
+	*outputDir = "migration"
+	*verifyReport = "verification-report.json"
+	if got := verifyReportPath(); got != filepath.Join("migration", "verification-report.json") {
+		t.Errorf("default = %q, want it in the output directory", got)
+	}
+
+	abs := filepath.Join(t.TempDir(), "report.json")
+	*verifyReport = abs
+	if got := verifyReportPath(); got != abs {
+		t.Errorf("absolute = %q, want %q", got, abs)
+	}
+}
@@ -0,0 +1,78 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package pingdom
+
+import (
+	"strings"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/tfstate"
+)
+
+// CheckResourceType is the check resource type of the Pingdom Terraform
+// provider (DrFaust92/pingdom).
+const CheckResourceType = "pingdom_check"
+
+// FromState returns the checks managed in a Terraform state, in the shape the
+// API returns them, together with the state instances they were read from.
+func FromState(s *tfstate.State) ([]Check, []tfstate.Instance) {
+	instances := s.Instances(CheckResourceType)
+	checks := make([]Check, 0, len(instances))
+	for _, inst := range instances {
+		checks = append(checks, checkFromState(inst))
+	}
+	return checks, instances
+}
+
+// checkFromState maps pingdom_check attributes to a Check. The provider
+// stores the host as host, and tags and probe filters as comma-separated
+// strings.
+func checkFromState(inst tfstate.Instance) Check {
+	var tags []Tag
+	for _, name := range splitList(inst.String("tags")) {
+		tags = append(tags, Tag{Name: name, Type: "u"})
+	}
+
+	return Check{
+		ID:                       inst.Int("id"),
+		Name:                     inst.String("name"),
+		Type:                     inst.String("type"),
+		Hostname:                 inst.String("host"),
+		URL:                      inst.String("url"),
+		Encryption:               inst.Bool("encryption"),
+		Port:                     inst.Int("port"),
+		Resolution:               inst.Int("resolution"),
+		Paused:                   inst.Bool("paused"),
+		Tags:                     tags,
+		ProbeFilters:             splitList(inst.String("probefilters")),
+		RequestHeaders:           inst.StringMap("requestheaders"),
+		PostData:                 inst.String("postdata"),
+		ShouldContain:            inst.String("shouldcontain"),
+		ShouldNotContain:         inst.String("shouldnotcontain"),
+		VerifyCertificate:        inst.Bool("verify_certificate"),
+		SSLDownDaysBefore:        inst.Int("ssl_down_days_before"),
+		SendNotificationWhenDown: inst.Int("sendnotificationwhendown"),
+		NotifyAgainEvery:         inst.Int("notifyagainevery"),
+		NotifyWhenBackup:         inst.Bool("notifywhenbackup"),
+		CustomMessage:            inst.String("custom_message"),
+		IntegrationIDs:           inst.Ints("integrationids"),
+		TeamIDs:                  inst.Ints("teamids"),
+		UserIDs:                  inst.Ints("userids"),
+		StringToExpect:           inst.String("stringtoexpect"),
+		StringToSend:             inst.String("stringtosend"),
+		ExpectedIP:               inst.String("expectedip"),
+		NameServer:               inst.String("nameserver"),
+	}
+}
+
+// splitList splits a comma-separated provider attribute, dropping empty
+// items.
+func splitList(s string) []string {
+	var out []string
+	for _, item := range strings.Split(s, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
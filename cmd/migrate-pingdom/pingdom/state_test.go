@@ -0,0 +1,61 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package pingdom
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/tfstate"
+)
+
+func TestFromState(t *testing.T) {
+	state, err := tfstate.Parse([]byte(`{
+  "version": 4,
+  "resources": [
+    {
+      "module": "module.shop", "mode": "managed", "type": "pingdom_check", "name": "checkout",
+      "instances": [{"attributes": {
+        "id": "3001", "name": "Checkout", "type": "http", "host": "shop.example.com", "url": "/checkout",
+        "encryption": true, "port": 443, "resolution": 5, "paused": false,
+        "tags": "env_prod, checkout,", "probefilters": "region: EU",
+        "requestheaders": {"Accept": "text/html"}, "shouldcontain": "Pay now",
+        "verify_certificate": true, "integrationids": [11, 12]
+      }}]
+    },
+    {
+      "mode": "managed", "type": "pingdom_contact", "name": "ops",
+      "instances": [{"attributes": {"id": "9"}}]
+    }
+  ]
+}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checks, instances := FromState(state)
+
+	want := Check{
+		ID:                3001,
+		Name:              "Checkout",
+		Type:              "http",
+		Hostname:          "shop.example.com",
+		URL:               "/checkout",
+		Encryption:        true,
+		Port:              443,
+		Resolution:        5,
+		Tags:              []Tag{{Name: "env_prod", Type: "u"}, {Name: "checkout", Type: "u"}},
+		ProbeFilters:      []string{"region: EU"},
+		RequestHeaders:    map[string]string{"Accept": "text/html"},
+		ShouldContain:     "Pay now",
+		VerifyCertificate: true,
+		IntegrationIDs:    []int{11, 12},
+	}
+	if len(checks) != 1 || !reflect.DeepEqual(checks[0], want) {
+		t.Errorf("checks = %+v, want %+v", checks, want)
+	}
+	if len(instances) != 1 || instances[0].Address != "module.shop.pingdom_check.checkout" {
+		t.Errorf("instances = %+v, want module.shop.pingdom_check.checkout only", instances)
+	}
+}
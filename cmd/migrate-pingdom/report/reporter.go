@@ -11,6 +11,7 @@ import (
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/converter"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/pingdom"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 // MigrationReport contains the complete migration report.
@@ -19,10 +20,15 @@ type MigrationReport struct {
 	TotalChecks       int            `json:"total_checks"`
 	SupportedChecks   int            `json:"supported_checks"`
 	UnsupportedChecks int            `json:"unsupported_checks"`
+	SkippedChecks     int            `json:"skipped_checks"`
 	ChecksByType      map[string]int `json:"checks_by_type"`
 	UnsupportedTypes  map[string]int `json:"unsupported_types"`
 	ManualSteps       []ManualStep   `json:"manual_steps"`
 	Warnings          []string       `json:"warnings"`
+	// FrequencyAdjustments lists every check resolution that was snapped to
+	// a supported Hyperping check frequency.
+	FrequencyAdjustments []migrate.FrequencyAdjustment `json:"frequency_adjustments"`
+	Estimate             *migrate.Estimate             `json:"estimate"`
 }
 
 // ManualStep represents a manual action required.
@@ -45,21 +51,40 @@ func NewReporter() *Reporter {
 // GenerateReport generates a comprehensive migration report.
 func (r *Reporter) GenerateReport(checks []pingdom.Check, results []converter.ConversionResult) *MigrationReport {
 	report := &MigrationReport{
-		Timestamp:        time.Now(),
-		TotalChecks:      len(checks),
-		ChecksByType:     make(map[string]int),
-		UnsupportedTypes: make(map[string]int),
-		ManualSteps:      []ManualStep{},
-		Warnings:         []string{},
+		Timestamp:            time.Now(),
+		TotalChecks:          len(checks),
+		ChecksByType:         make(map[string]int),
+		UnsupportedTypes:     make(map[string]int),
+		ManualSteps:          []ManualStep{},
+		Warnings:             []string{},
+		FrequencyAdjustments: []migrate.FrequencyAdjustment{},
 	}
 
+	var loads []migrate.MonitorLoad
+	healthchecks := 0
 	for i, check := range checks {
 		result := results[i]
+		if result.Monitor != nil && !result.Skipped {
+			loads = append(loads, migrate.MonitorLoad{
+				CheckFrequency: result.Monitor.CheckFrequency,
+				Regions:        len(result.Monitor.Regions),
+				Paused:         result.Monitor.Paused,
+			})
+		}
+		if result.Healthcheck != nil && !result.Skipped {
+			healthchecks++
+		}
+
+		if result.FrequencyAdjustment != nil && !result.Skipped {
+			report.FrequencyAdjustments = append(report.FrequencyAdjustments, *result.FrequencyAdjustment)
+		}
 
 		// Count by type
 		report.ChecksByType[check.Type]++
 
-		if result.Supported {
+		if result.Skipped {
+			report.SkippedChecks++
+		} else if result.Supported {
 			report.SupportedChecks++
 
 			// Add warnings for special handling
@@ -77,17 +102,25 @@ func (r *Reporter) GenerateReport(checks []pingdom.Check, results []converter.Co
 			report.ManualSteps = append(report.ManualSteps, step)
 		}
 	}
+	report.Estimate = migrate.NewEstimate(loads, healthchecks)
 
 	return report
 }
 
-func (r *Reporter) generateManualStep(check pingdom.Check, _ converter.ConversionResult) ManualStep {
+func (r *Reporter) generateManualStep(check pingdom.Check, result converter.ConversionResult) ManualStep {
 	step := ManualStep{
 		CheckID:   check.ID,
 		CheckName: check.Name,
 		CheckType: check.Type,
 	}
 
+	if result.UnsupportedType == converter.UnsupportedFrequency {
+		step.Description = fmt.Sprintf("Check resolution of %d minute(s) is not a supported Hyperping frequency (--frequency-policy=fail)", check.Resolution)
+		step.Action = "Option 1: Set a supported frequency for this check in the --overrides file\n" +
+			"Option 2: Rerun with --frequency-policy=round-up, round-down, or nearest"
+		return step
+	}
+
 	switch check.Type {
 	case "dns":
 		step.Description = "DNS checks are not directly supported by Hyperping"
@@ -143,8 +176,19 @@ func (r *Reporter) GenerateTextReport(report *MigrationReport) string {
 	fmt.Fprintf(&sb, "Total Checks:       %d\n", report.TotalChecks)
 	fmt.Fprintf(&sb, "Supported:          %d (%.1f%%)\n", report.SupportedChecks, float64(report.SupportedChecks)/float64(report.TotalChecks)*100)
 	fmt.Fprintf(&sb, "Unsupported:        %d (%.1f%%)\n", report.UnsupportedChecks, float64(report.UnsupportedChecks)/float64(report.TotalChecks)*100)
+	if report.SkippedChecks > 0 {
+		fmt.Fprintf(&sb, "Skipped:            %d (mapping overrides)\n", report.SkippedChecks)
+	}
+	if len(report.FrequencyAdjustments) > 0 {
+		fmt.Fprintf(&sb, "Frequency Adjusted: %d\n", len(report.FrequencyAdjustments))
+	}
 	fmt.Fprintf(&sb, "Manual Steps:       %d\n\n", len(report.ManualSteps))
 
+	if report.Estimate != nil {
+		report.Estimate.WriteText(&sb)
+		sb.WriteString("\n")
+	}
+
 	if len(report.ChecksByType) > 0 {
 		sb.WriteString("Checks by Type\n")
 		sb.WriteString("--------------\n")
@@ -163,6 +207,15 @@ func (r *Reporter) GenerateTextReport(report *MigrationReport) string {
 		sb.WriteString("\n")
 	}
 
+	if len(report.FrequencyAdjustments) > 0 {
+		sb.WriteString("Frequency Adjustments\n")
+		sb.WriteString("---------------------\n")
+		for _, a := range report.FrequencyAdjustments {
+			fmt.Fprintf(&sb, "Check %s (%s): %ds -> %ds (%s)\n", a.SourceID, a.Name, a.From, a.To, a.Policy)
+		}
+		sb.WriteString("\n")
+	}
+
 	if len(report.Warnings) > 0 {
 		sb.WriteString("Warnings\n")
 		sb.WriteString("--------\n")
@@ -8,8 +8,11 @@ import (
 	"strings"
 	"testing"
 
+	hyperping "github.com/develeap/hyperping-go"
+
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/converter"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/pingdom"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 func sampleInputs() ([]pingdom.Check, []converter.ConversionResult) {
@@ -56,6 +59,24 @@ func TestGenerateReport_Counts(t *testing.T) {
 	if len(r.Warnings) == 0 {
 		t.Error("expected at least one warning for SMTP note")
 	}
+	if r.Estimate == nil || r.Estimate.Monitors != 2 { // http + smtp
+		t.Errorf("Estimate = %+v, want 2 monitors", r.Estimate)
+	}
+}
+
+func TestGenerateReport_SkippedChecks(t *testing.T) {
+	checks, results := sampleInputs()
+	results[1] = converter.ConversionResult{Skipped: true, Notes: []string{"Skipped: skip in mapping overrides"}}
+
+	r := NewReporter().GenerateReport(checks, results)
+
+	if r.SkippedChecks != 1 || r.UnsupportedChecks != 3 || len(r.ManualSteps) != 3 {
+		t.Errorf("SkippedChecks = %d, UnsupportedChecks = %d, ManualSteps = %d; want 1, 3, 3",
+			r.SkippedChecks, r.UnsupportedChecks, len(r.ManualSteps))
+	}
+	if text := NewReporter().GenerateTextReport(r); !strings.Contains(text, "Skipped:            1 (mapping overrides)") {
+		t.Errorf("text report missing skipped count:\n%s", text)
+	}
 }
 
 func TestGenerateManualStep_ByType(t *testing.T) {
@@ -117,6 +138,8 @@ func TestGenerateTextReport_Sections(t *testing.T) {
 	for _, want := range []string{
 		"Pingdom to Hyperping Migration Report",
 		"Total Checks:",
+		"Plan Impact Estimate",
+		"Projected monthly checks:",
 		"Checks by Type",
 		"Unsupported Check Types",
 		"Warnings",
@@ -173,3 +196,30 @@ func TestGenerateManualStepsMarkdown_WithSteps(t *testing.T) {
 		}
 	}
 }
+
+func TestGenerateReport_FrequencyAdjustments(t *testing.T) {
+	checks := []pingdom.Check{
+		{ID: 1, Name: "api", Type: "http", Resolution: 15},
+		{ID: 2, Name: "web", Type: "http", Resolution: 15},
+	}
+	adjustment := migrate.FrequencyAdjustment{SourceID: "1", Name: "api", From: 900, To: 1800, Policy: migrate.FrequencyRoundUp}
+	results := []converter.ConversionResult{
+		{Monitor: &hyperping.CreateMonitorRequest{CheckFrequency: 1800}, Supported: true, FrequencyAdjustment: &adjustment},
+		{Supported: false, UnsupportedType: converter.UnsupportedFrequency},
+	}
+
+	r := NewReporter()
+	report := r.GenerateReport(checks, results)
+	if len(report.FrequencyAdjustments) != 1 || report.FrequencyAdjustments[0] != adjustment {
+		t.Errorf("FrequencyAdjustments = %+v", report.FrequencyAdjustments)
+	}
+	if len(report.ManualSteps) != 1 || !strings.Contains(report.ManualSteps[0].Description, "15 minute(s)") ||
+		!strings.Contains(report.ManualSteps[0].Action, "--overrides") {
+		t.Errorf("ManualSteps = %+v", report.ManualSteps)
+	}
+
+	text := r.GenerateTextReport(report)
+	if !strings.Contains(text, "Check 1 (api): 900s -> 1800s (round-up)") {
+		t.Errorf("text report missing adjustment:\n%s", text)
+	}
+}
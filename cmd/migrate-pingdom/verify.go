@@ -0,0 +1,104 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-pingdom/pingdom"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/verify"
+)
+
+// runVerification compares Pingdom checks with the monitors that exist in
+// Hyperping and writes a field-by-field equivalence report.
+func (r *pingdomRunner) runVerification() int {
+	log("Fetching Pingdom checks for verification...")
+	checks, err := r.listChecks()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching Pingdom checks: %v\n", err)
+		return 1
+	}
+
+	log("Fetching Hyperping monitors for verification...")
+	destination, err := createHyperpingClient(r.hyperpingKey).ListMonitors(r.ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching Hyperping monitors: %v\n", err)
+		return 1
+	}
+
+	idMap, err := mapping.Load(mappingPath())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	result := verify.Monitors("Pingdom", verify.ApplyMapping(verifySources(checks), idMap), destination)
+	result.PrintSummary(os.Stderr)
+
+	reportPath := verifyReportPath()
+	if err := result.WriteJSON(reportPath); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	log(fmt.Sprintf("Verification report written to %s", reportPath))
+
+	if recorded := result.RecordUUIDs(idMap); recorded > 0 {
+		if err := idMap.Write(mappingPath()); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		log(fmt.Sprintf("Recorded %d Hyperping UUIDs in %s", recorded, mappingPath()))
+	}
+
+	if result.HasProblems() {
+		return 1
+	}
+	return 0
+}
+
+// verifyReportPath returns the --verify-report file. A relative path is
+// resolved against the output directory.
+func verifyReportPath() string {
+	if filepath.IsAbs(*verifyReport) {
+		return *verifyReport
+	}
+	return filepath.Join(*outputDir, *verifyReport)
+}
+
+// verifySources builds verification inputs from the raw Pingdom checks,
+// using the converter only for names, URLs, and protocol vocabulary. Checks
+// skipped by the mapping overrides are not verified.
+func verifySources(checks []pingdom.Check) []verify.Source {
+	checkConverter := converter.NewCheckConverter().WithNameTemplate(nameTemplate).WithOverrides(overrides).WithRegionMap(regionMap)
+
+	sources := make([]verify.Source, 0, len(checks))
+	for _, check := range checks {
+		result := checkConverter.Convert(check)
+		if !result.Supported || result.Monitor == nil {
+			continue
+		}
+
+		var regions []string
+		if len(check.ProbeFilters) > 0 {
+			regions = checkConverter.Regions(check.ProbeFilters)
+		}
+
+		sources = append(sources, verify.Source{
+			ID:        strconv.Itoa(check.ID),
+			Name:      result.Monitor.Name,
+			URL:       result.Monitor.URL,
+			Protocol:  result.Monitor.Protocol,
+			Frequency: check.Resolution * 60,
+			Locations: check.ProbeFilters,
+			Regions:   regions,
+			Port:      check.Port,
+		})
+	}
+	return sources
+}
@@ -5,6 +5,8 @@ package converter
 
 import (
 	"fmt"
+	"strconv"
+	"time"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/uptimerobot"
 	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
@@ -24,7 +26,11 @@ type HyperpingMonitor struct {
 	FollowRedirects    bool
 	Regions            []string
 	OriginalID         int
+	Tags               []string
 	Warnings           []string
+	// FrequencyAdjustment is set when the UptimeRobot interval was snapped to
+	// a different Hyperping check frequency.
+	FrequencyAdjustment *migrate.FrequencyAdjustment
 }
 
 // HyperpingHealthcheck represents a Hyperping healthcheck configuration.
@@ -36,7 +42,37 @@ type HyperpingHealthcheck struct {
 	GracePeriodValue int
 	GracePeriodType  string
 	OriginalID       int
+	Tags             []string
 	Warnings         []string
+	// Schedule records how the heartbeat interval and grace period were
+	// mapped, for the migration report.
+	Schedule HeartbeatSchedule
+}
+
+// HeartbeatSchedule describes how a heartbeat monitor's interval and grace
+// period were mapped to a healthcheck period.
+type HeartbeatSchedule struct {
+	IntervalSeconds int
+	GraceSeconds    int
+	// Rule explains the mapping in one sentence.
+	Rule string
+}
+
+// DefaultHeartbeatGrace is the grace period given to healthchecks converted
+// from heartbeat monitors. UptimeRobot has no grace period: it alerts as
+// soon as an interval passes without a ping, so the default is the shortest
+// grace period the migration tools generate.
+const DefaultHeartbeatGrace = time.Minute
+
+// periodUnits are the healthcheck period types, longest first.
+var periodUnits = []struct {
+	name    string
+	seconds int
+}{
+	{"days", 86400},
+	{"hours", 3600},
+	{"minutes", 60},
+	{"seconds", 1},
 }
 
 // ConversionResult holds the results of converting UptimeRobot monitors.
@@ -47,20 +83,68 @@ type ConversionResult struct {
 	ContactsMap  map[string][]string // Alert contact ID to list of emails/webhooks
 }
 
-// SkippedMonitor represents a monitor that couldn't be converted.
+// SkippedMonitor represents a monitor that couldn't be converted, or that the
+// mapping overrides skip.
 type SkippedMonitor struct {
-	ID     int
-	Name   string
-	Type   int
-	Reason string
+	ID         int
+	Name       string
+	Type       int
+	Reason     string
+	ByOverride bool // skipped on purpose via the mapping overrides
 }
 
 // Converter converts UptimeRobot monitors to Hyperping resources.
-type Converter struct{}
+type Converter struct {
+	nameTemplate    *migrate.NameTemplate
+	overrides       *migrate.Overrides
+	frequencyPolicy migrate.FrequencyPolicy
+	heartbeatGrace  time.Duration
+	regionMap       *migrate.RegionMap
+}
 
 // NewConverter creates a new converter.
 func NewConverter() *Converter {
-	return &Converter{}
+	return &Converter{frequencyPolicy: migrate.FrequencyNearest, heartbeatGrace: DefaultHeartbeatGrace}
+}
+
+// WithNameTemplate renders Hyperping names from the source name and tags
+// using tmpl. A nil template keeps source names unchanged.
+func (c *Converter) WithNameTemplate(tmpl *migrate.NameTemplate) *Converter {
+	c.nameTemplate = tmpl
+	return c
+}
+
+// WithOverrides applies the mapping overrides in o, keyed by UptimeRobot
+// monitor ID. Skipped monitors are listed in ConversionResult.Skipped.
+func (c *Converter) WithOverrides(o *migrate.Overrides) *Converter {
+	c.overrides = o
+	return c
+}
+
+// WithFrequencyPolicy sets how intervals that Hyperping does not support are
+// snapped. Under migrate.FrequencyFail such monitors are listed in
+// ConversionResult.Skipped.
+func (c *Converter) WithFrequencyPolicy(p migrate.FrequencyPolicy) *Converter {
+	c.frequencyPolicy = p
+	return c
+}
+
+// WithRegionMap uses the defaults in rm as the regions of converted
+// monitors. UptimeRobot monitors have no regions of their own, so rm's
+// source mappings do not apply.
+func (c *Converter) WithRegionMap(rm *migrate.RegionMap) *Converter {
+	c.regionMap = rm
+	return c
+}
+
+// WithHeartbeatGrace sets the grace period of healthchecks converted from
+// heartbeat monitors. It is rounded down to whole seconds; zero or less
+// keeps DefaultHeartbeatGrace.
+func (c *Converter) WithHeartbeatGrace(grace time.Duration) *Converter {
+	if grace >= time.Second {
+		c.heartbeatGrace = grace
+	}
+	return c
 }
 
 // Convert converts UptimeRobot monitors to Hyperping resources.
@@ -80,30 +164,39 @@ func (c *Converter) Convert(monitors []uptimerobot.Monitor, alertContacts []upti
 	// Convert each monitor
 	seen := make(map[string]int)
 	for _, m := range monitors {
-		switch m.Type {
-		case 1: // HTTP/HTTPS
-			monitor := c.convertHTTPMonitor(m)
-			monitor.ResourceName = deduplicateResourceName(monitor.ResourceName, seen)
-			result.Monitors = append(result.Monitors, monitor)
-
-		case 2: // Keyword
-			monitor := c.convertKeywordMonitor(m)
-			monitor.ResourceName = deduplicateResourceName(monitor.ResourceName, seen)
-			result.Monitors = append(result.Monitors, monitor)
-
-		case 3: // Ping (ICMP)
-			monitor := c.convertPingMonitor(m)
-			monitor.ResourceName = deduplicateResourceName(monitor.ResourceName, seen)
-			result.Monitors = append(result.Monitors, monitor)
+		if c.overrides.Skipped(strconv.Itoa(m.ID)) {
+			result.Skipped = append(result.Skipped, SkippedMonitor{
+				ID:         m.ID,
+				Name:       m.FriendlyName,
+				Type:       m.Type,
+				Reason:     "skip in mapping overrides",
+				ByOverride: true,
+			})
+			continue
+		}
 
-		case 4: // Port
-			monitor := c.convertPortMonitor(m)
+		switch m.Type {
+		case 1, 2, 3, 4: // HTTP/HTTPS, Keyword, Ping (ICMP), Port
+			monitor, err := c.convertMonitor(m)
+			if err != nil {
+				result.Skipped = append(result.Skipped, SkippedMonitor{
+					ID:     m.ID,
+					Name:   m.FriendlyName,
+					Type:   m.Type,
+					Reason: err.Error(),
+				})
+				continue
+			}
 			monitor.ResourceName = deduplicateResourceName(monitor.ResourceName, seen)
+			monitor.Tags = m.Tags
+			monitor.Name, monitor.Warnings = c.renderName(m, monitor.Warnings)
 			result.Monitors = append(result.Monitors, monitor)
 
 		case 5: // Heartbeat
 			healthcheck := c.convertHeartbeatMonitor(m)
 			healthcheck.ResourceName = deduplicateResourceName(healthcheck.ResourceName, seen)
+			healthcheck.Tags = m.Tags
+			healthcheck.Name, healthcheck.Warnings = c.renderName(m, healthcheck.Warnings)
 			result.Healthchecks = append(result.Healthchecks, healthcheck)
 
 		default:
@@ -119,6 +212,82 @@ func (c *Converter) Convert(monitors []uptimerobot.Monitor, alertContacts []upti
 	return result
 }
 
+// convertMonitor converts an HTTP, keyword, ping, or port monitor and applies
+// its mapping override. It returns an error when the frequency policy rejects
+// the monitor's interval.
+func (c *Converter) convertMonitor(m uptimerobot.Monitor) (HyperpingMonitor, error) {
+	var monitor HyperpingMonitor
+	switch m.Type {
+	case 1:
+		monitor = c.convertHTTPMonitor(m)
+	case 2:
+		monitor = c.convertKeywordMonitor(m)
+	case 3:
+		monitor = c.convertPingMonitor(m)
+	default:
+		monitor = c.convertPortMonitor(m)
+	}
+	if err := c.applyOverride(&monitor, m); err != nil {
+		return HyperpingMonitor{}, err
+	}
+	return monitor, nil
+}
+
+// renderName applies the name override for m, or else the name template.
+// Resource names are derived from the friendly name beforehand, so Terraform
+// addresses do not depend on the template or override. A template error keeps
+// the friendly name and adds a warning.
+func (c *Converter) renderName(m uptimerobot.Monitor, warnings []string) (string, []string) {
+	if override := c.override(m); override.Name != "" {
+		return override.Name, warnings
+	}
+	name, err := c.nameTemplate.Render(m.FriendlyName, m.Tags)
+	if err != nil {
+		warnings = append(warnings, fmt.Sprintf("Name template failed, keeping source name: %v", err))
+	}
+	return name, warnings
+}
+
+// override returns the mapping override for m, if any.
+func (c *Converter) override(m uptimerobot.Monitor) migrate.Override {
+	override, _ := c.overrides.Lookup(strconv.Itoa(m.ID))
+	return override
+}
+
+// applyOverride sets the check frequency and regions from the mapping
+// override for m, or the regions from the --region-map defaults. Without a frequency override, the interval is snapped under
+// the frequency policy, and a change is recorded as a FrequencyAdjustment and
+// a warning. It returns an error when the policy rejects the interval.
+func (c *Converter) applyOverride(monitor *HyperpingMonitor, m uptimerobot.Monitor) error {
+	override := c.override(m)
+	if len(override.Regions) > 0 {
+		monitor.Regions = override.Regions
+	} else if defaults := c.regionMap.Defaults(); len(defaults) > 0 {
+		monitor.Regions = defaults
+	}
+	if override.Frequency != 0 {
+		monitor.CheckFrequency = override.Frequency
+		return nil
+	}
+
+	frequency, err := c.frequencyPolicy.Snap(m.Interval)
+	if err != nil {
+		return err
+	}
+	monitor.CheckFrequency = frequency
+	if frequency != m.Interval {
+		monitor.FrequencyAdjustment = &migrate.FrequencyAdjustment{
+			SourceID: strconv.Itoa(m.ID),
+			Name:     m.FriendlyName,
+			From:     m.Interval,
+			To:       frequency,
+			Policy:   c.frequencyPolicy,
+		}
+		monitor.Warnings = append(monitor.Warnings, monitor.FrequencyAdjustment.String())
+	}
+	return nil
+}
+
 // convertHTTPMonitor converts an HTTP/HTTPS monitor.
 func (c *Converter) convertHTTPMonitor(m uptimerobot.Monitor) HyperpingMonitor {
 	monitor := HyperpingMonitor{
@@ -135,13 +304,6 @@ func (c *Converter) convertHTTPMonitor(m uptimerobot.Monitor) HyperpingMonitor {
 		Warnings:           []string{},
 	}
 
-	// Warn if frequency was adjusted
-	if monitor.CheckFrequency != m.Interval {
-		monitor.Warnings = append(monitor.Warnings,
-			fmt.Sprintf("Check frequency adjusted from %ds to %ds (nearest allowed value)",
-				m.Interval, monitor.CheckFrequency))
-	}
-
 	return monitor
 }
 
@@ -173,13 +335,6 @@ func (c *Converter) convertKeywordMonitor(m uptimerobot.Monitor) HyperpingMonito
 		}
 	}
 
-	// Warn if frequency was adjusted
-	if monitor.CheckFrequency != m.Interval {
-		monitor.Warnings = append(monitor.Warnings,
-			fmt.Sprintf("Check frequency adjusted from %ds to %ds (nearest allowed value)",
-				m.Interval, monitor.CheckFrequency))
-	}
-
 	return monitor
 }
 
@@ -196,13 +351,6 @@ func (c *Converter) convertPingMonitor(m uptimerobot.Monitor) HyperpingMonitor {
 		Warnings:       []string{},
 	}
 
-	// Warn if frequency was adjusted
-	if monitor.CheckFrequency != m.Interval {
-		monitor.Warnings = append(monitor.Warnings,
-			fmt.Sprintf("Check frequency adjusted from %ds to %ds (nearest allowed value)",
-				m.Interval, monitor.CheckFrequency))
-	}
-
 	return monitor
 }
 
@@ -229,53 +377,65 @@ func (c *Converter) convertPortMonitor(m uptimerobot.Monitor) HyperpingMonitor {
 		monitor.Port = 80 // Default
 	}
 
-	// Warn if frequency was adjusted
-	if monitor.CheckFrequency != m.Interval {
-		monitor.Warnings = append(monitor.Warnings,
-			fmt.Sprintf("Check frequency adjusted from %ds to %ds (nearest allowed value)",
-				m.Interval, monitor.CheckFrequency))
-	}
-
 	return monitor
 }
 
 // convertHeartbeatMonitor converts a heartbeat monitor to a healthcheck.
+// UptimeRobot heartbeat intervals are in seconds; the period uses the
+// longest unit that represents the interval exactly, so 5400s becomes 90
+// minutes rather than 1 hour. The grace period is mapped the same way.
 func (c *Converter) convertHeartbeatMonitor(m uptimerobot.Monitor) HyperpingHealthcheck {
 	healthcheck := HyperpingHealthcheck{
-		ResourceName:     terraformName(m.FriendlyName),
-		Name:             m.FriendlyName,
-		GracePeriodValue: 1,
-		GracePeriodType:  "hours",
-		OriginalID:       m.ID,
-		Warnings:         []string{},
+		ResourceName: terraformName(m.FriendlyName),
+		Name:         m.FriendlyName,
+		OriginalID:   m.ID,
+		Warnings:     []string{},
 	}
 
-	// Convert interval to period
+	override := c.override(m)
 	seconds := m.Interval
-	if seconds >= 86400 {
-		// Days
-		healthcheck.PeriodValue = seconds / 86400
-		healthcheck.PeriodType = "days"
-	} else if seconds >= 3600 {
-		// Hours
-		healthcheck.PeriodValue = seconds / 3600
-		healthcheck.PeriodType = "hours"
-	} else if seconds >= 60 {
-		// Minutes
-		healthcheck.PeriodValue = seconds / 60
-		healthcheck.PeriodType = "minutes"
-	} else {
-		// Seconds (fallback)
-		healthcheck.PeriodValue = seconds
-		healthcheck.PeriodType = "seconds"
+	source := "UptimeRobot interval"
+	if override.Frequency != 0 {
+		seconds = override.Frequency
+		source = "Mapping override frequency"
+	}
+	graceSeconds := int(c.heartbeatGrace / time.Second)
+	graceSource := "default"
+	if c.heartbeatGrace != DefaultHeartbeatGrace {
+		graceSource = "-heartbeat-grace"
+	}
+
+	healthcheck.PeriodValue, healthcheck.PeriodType = exactPeriod(seconds)
+	healthcheck.GracePeriodValue, healthcheck.GracePeriodType = exactPeriod(graceSeconds)
+	healthcheck.Schedule = HeartbeatSchedule{
+		IntervalSeconds: seconds,
+		GraceSeconds:    graceSeconds,
+		Rule: fmt.Sprintf("%s %ds maps to period %d %s; grace %d %s (%s)",
+			source, seconds, healthcheck.PeriodValue, healthcheck.PeriodType,
+			healthcheck.GracePeriodValue, healthcheck.GracePeriodType, graceSource),
 	}
 
 	healthcheck.Warnings = append(healthcheck.Warnings,
 		"Heartbeat monitor converted to healthcheck. Update your script to ping the new URL (see manual-steps.md)")
+	if len(override.Regions) > 0 {
+		healthcheck.Warnings = append(healthcheck.Warnings,
+			"Mapping override sets regions, but healthchecks have no regions. Ignoring them.")
+	}
 
 	return healthcheck
 }
 
+// exactPeriod returns seconds as a healthcheck period value and type, using
+// the longest unit that divides it exactly.
+func exactPeriod(seconds int) (int, string) {
+	for _, u := range periodUnits {
+		if seconds >= u.seconds && seconds%u.seconds == 0 {
+			return seconds / u.seconds, u.name
+		}
+	}
+	return seconds, "seconds"
+}
+
 // convertHTTPMethod converts UptimeRobot HTTP method to string.
 func convertHTTPMethod(method *uptimerobot.FlexibleInt) string {
 	if method == nil {
@@ -6,8 +6,10 @@ package converter
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/uptimerobot"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 func flexInt(n int) *uptimerobot.FlexibleInt {
@@ -239,6 +241,9 @@ func TestConvertHeartbeatMonitor_PeriodMapping(t *testing.T) {
 		{"exactly 1 day", 86400, 1, "days"},
 		{"exactly 1 hour", 3600, 1, "hours"},
 		{"exactly 1 minute", 60, 1, "minutes"},
+		{"90 minutes not truncated to 1 hour", 5400, 90, "minutes"},
+		{"49 hours not truncated to 2 days", 176400, 49, "hours"},
+		{"90 seconds not truncated to 1 minute", 90, 90, "seconds"},
 	}
 	c := NewConverter()
 	for _, tt := range tests {
@@ -253,8 +258,11 @@ func TestConvertHeartbeatMonitor_PeriodMapping(t *testing.T) {
 			if h.PeriodValue != tt.wantValue || h.PeriodType != tt.wantType {
 				t.Errorf("Period = %d %s, want %d %s", h.PeriodValue, h.PeriodType, tt.wantValue, tt.wantType)
 			}
-			if h.GracePeriodValue != 1 || h.GracePeriodType != "hours" {
-				t.Errorf("Grace = %d %s, want 1 hours", h.GracePeriodValue, h.GracePeriodType)
+			if h.GracePeriodValue != 1 || h.GracePeriodType != "minutes" {
+				t.Errorf("Grace = %d %s, want 1 minutes", h.GracePeriodValue, h.GracePeriodType)
+			}
+			if h.Schedule.IntervalSeconds != tt.intervalSec || h.Schedule.GraceSeconds != 60 {
+				t.Errorf("Schedule = %+v", h.Schedule)
 			}
 			if len(h.Warnings) == 0 {
 				t.Error("expected note warning on heartbeat conversion")
@@ -263,6 +271,35 @@ func TestConvertHeartbeatMonitor_PeriodMapping(t *testing.T) {
 	}
 }
 
+func TestConvertHeartbeatMonitor_Grace(t *testing.T) {
+	tests := []struct {
+		name      string
+		grace     time.Duration
+		wantValue int
+		wantType  string
+		wantRule  string
+	}{
+		{"default", 0, 1, "minutes", "UptimeRobot interval 5400s maps to period 90 minutes; grace 1 minutes (default)"},
+		{"whole hours", 2 * time.Hour, 2, "hours", "UptimeRobot interval 5400s maps to period 90 minutes; grace 2 hours (-heartbeat-grace)"},
+		{"mixed units", 150 * time.Second, 150, "seconds", "UptimeRobot interval 5400s maps to period 90 minutes; grace 150 seconds (-heartbeat-grace)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := NewConverter().WithHeartbeatGrace(tt.grace)
+			r := c.Convert([]uptimerobot.Monitor{
+				{ID: 1, FriendlyName: "HB", Type: 5, Interval: 5400},
+			}, nil)
+			h := r.Healthchecks[0]
+			if h.GracePeriodValue != tt.wantValue || h.GracePeriodType != tt.wantType {
+				t.Errorf("Grace = %d %s, want %d %s", h.GracePeriodValue, h.GracePeriodType, tt.wantValue, tt.wantType)
+			}
+			if h.Schedule.Rule != tt.wantRule {
+				t.Errorf("Rule = %q, want %q", h.Schedule.Rule, tt.wantRule)
+			}
+		})
+	}
+}
+
 func TestConvert_ContactsMapPopulated(t *testing.T) {
 	c := NewConverter()
 	r := c.Convert(nil, []uptimerobot.AlertContact{
@@ -358,3 +395,143 @@ func TestMapFrequency_DelegatesToPkg(t *testing.T) {
 		t.Errorf("mapFrequency(60) = %d, want 60", got)
 	}
 }
+
+func TestConvert_NameTemplate(t *testing.T) {
+	tmpl, err := migrate.ParseNameTemplate(`{{.Name}}{{with .Tags}} ({{join . ", "}}){{end}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewConverter().WithNameTemplate(tmpl).Convert([]uptimerobot.Monitor{
+		{ID: 1, FriendlyName: "API", URL: "https://api.example.com", Type: 1, Interval: 60, Tags: migrate.Tags{"prod", "web"}},
+		{ID: 2, FriendlyName: "Backup", Type: 5, Interval: 3600},
+	}, nil)
+
+	m := r.Monitors[0]
+	if m.Name != "API (prod, web)" || m.ResourceName != "api" {
+		t.Errorf("monitor name = %q, resource name = %q", m.Name, m.ResourceName)
+	}
+	if strings.Join(m.Tags, ",") != "prod,web" {
+		t.Errorf("monitor tags = %v", m.Tags)
+	}
+	if h := r.Healthchecks[0]; h.Name != "Backup" {
+		t.Errorf("healthcheck name = %q, want source name when untagged", h.Name)
+	}
+}
+
+func TestConvert_NameTemplateError(t *testing.T) {
+	tmpl, err := migrate.ParseNameTemplate(`{{.Owner}}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewConverter().WithNameTemplate(tmpl).Convert([]uptimerobot.Monitor{
+		{ID: 1, FriendlyName: "API", URL: "https://api.example.com", Type: 1, Interval: 60},
+	}, nil)
+
+	m := r.Monitors[0]
+	if m.Name != "API" {
+		t.Errorf("Name = %q, want source name on template error", m.Name)
+	}
+	if len(m.Warnings) != 1 || !strings.Contains(m.Warnings[0], "Name template failed") {
+		t.Errorf("Warnings = %v", m.Warnings)
+	}
+}
+
+func TestConvert_Overrides(t *testing.T) {
+	overrides, err := migrate.ParseOverrides([]byte(`
+overrides:
+  1:
+    name: Checkout API
+    regions: [frankfurt, tokyo]
+    frequency: 120
+  2:
+    skip: true
+  3:
+    frequency: 21600
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewConverter().WithOverrides(overrides).Convert([]uptimerobot.Monitor{
+		{ID: 1, FriendlyName: "API", URL: "https://api.example.com", Type: 1, Interval: 45},
+		{ID: 2, FriendlyName: "Legacy", URL: "https://legacy.example.com", Type: 1, Interval: 60},
+		{ID: 3, FriendlyName: "Backup", Type: 5, Interval: 3600},
+	}, nil)
+
+	if len(r.Monitors) != 1 {
+		t.Fatalf("monitors = %d, want 1", len(r.Monitors))
+	}
+	m := r.Monitors[0]
+	if m.Name != "Checkout API" || m.ResourceName != "api" {
+		t.Errorf("monitor name = %q, resource name = %q", m.Name, m.ResourceName)
+	}
+	if m.CheckFrequency != 120 || strings.Join(m.Regions, ",") != "frankfurt,tokyo" {
+		t.Errorf("frequency = %d, regions = %v", m.CheckFrequency, m.Regions)
+	}
+	if len(m.Warnings) != 0 {
+		t.Errorf("Warnings = %v, want none for an overridden frequency", m.Warnings)
+	}
+
+	if len(r.Skipped) != 1 || r.Skipped[0].ID != 2 || !r.Skipped[0].ByOverride {
+		t.Errorf("Skipped = %+v", r.Skipped)
+	}
+	if h := r.Healthchecks[0]; h.PeriodValue != 6 || h.PeriodType != "hours" {
+		t.Errorf("healthcheck period = %d %s, want 6 hours", h.PeriodValue, h.PeriodType)
+	}
+}
+
+func TestConvert_FrequencyPolicy(t *testing.T) {
+	monitors := []uptimerobot.Monitor{
+		{ID: 1, FriendlyName: "API", URL: "https://api.example.com", Type: 1, Interval: 900},
+		{ID: 2, FriendlyName: "Web", URL: "https://www.example.com", Type: 1, Interval: 300},
+	}
+
+	r := NewConverter().WithFrequencyPolicy(migrate.FrequencyRoundUp).Convert(monitors, nil)
+	if len(r.Monitors) != 2 {
+		t.Fatalf("monitors = %d, want 2", len(r.Monitors))
+	}
+	a := r.Monitors[0].FrequencyAdjustment
+	if r.Monitors[0].CheckFrequency != 1800 || a == nil || a.SourceID != "1" || a.From != 900 || a.To != 1800 {
+		t.Errorf("frequency = %d, adjustment = %+v", r.Monitors[0].CheckFrequency, a)
+	}
+	if r.Monitors[1].FrequencyAdjustment != nil {
+		t.Errorf("adjustment = %+v, want none for a supported interval", r.Monitors[1].FrequencyAdjustment)
+	}
+
+	r = NewConverter().WithFrequencyPolicy(migrate.FrequencyFail).Convert(monitors, nil)
+	if len(r.Monitors) != 1 || r.Monitors[0].OriginalID != 2 {
+		t.Fatalf("monitors = %+v, want only monitor 2", r.Monitors)
+	}
+	if len(r.Skipped) != 1 || r.Skipped[0].ID != 1 || r.Skipped[0].ByOverride ||
+		!strings.Contains(r.Skipped[0].Reason, "check frequency 900s is not supported") {
+		t.Errorf("Skipped = %+v", r.Skipped)
+	}
+}
+
+func TestConvert_RegionMapDefaults(t *testing.T) {
+	regionMap, err := migrate.ParseRegionMap([]byte("default: [paris, amsterdam]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	overrides, err := migrate.ParseOverrides([]byte("overrides:\n  2:\n    regions: [tokyo]\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := NewConverter().WithRegionMap(regionMap).WithOverrides(overrides).Convert([]uptimerobot.Monitor{
+		{ID: 1, FriendlyName: "API", URL: "https://api.example.com", Type: 1, Interval: 60},
+		{ID: 2, FriendlyName: "Web", URL: "https://www.example.com", Type: 1, Interval: 60},
+	}, nil)
+
+	if len(r.Monitors) != 2 {
+		t.Fatalf("monitors = %d, want 2", len(r.Monitors))
+	}
+	if got := strings.Join(r.Monitors[0].Regions, ","); got != "paris,amsterdam" {
+		t.Errorf("regions = %s, want the region map defaults", got)
+	}
+	if got := strings.Join(r.Monitors[1].Regions, ","); got != "tokyo" {
+		t.Errorf("regions = %s, want the override to take precedence", got)
+	}
+}
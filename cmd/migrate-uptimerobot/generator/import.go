@@ -5,9 +5,11 @@ package generator
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/converter"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
 	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
@@ -130,6 +132,30 @@ func GenerateImportScript(result *converter.ConversionResult) string {
 }
 
 // escapeShellString escapes a string for use in shell scripts.
+// MappingEntries returns a mapping entry for every converted monitor and
+// healthcheck. UUIDs are left empty: the resources are created by terraform
+// apply, and -verify records them.
+func MappingEntries(result *converter.ConversionResult) []mapping.Entry {
+	entries := make([]mapping.Entry, 0, len(result.Monitors)+len(result.Healthchecks))
+	for _, m := range result.Monitors {
+		entries = append(entries, mapping.Entry{
+			SourceID:     strconv.Itoa(m.OriginalID),
+			ResourceType: mapping.ResourceTypeMonitor,
+			Name:         m.Name,
+			Address:      mapping.ResourceTypeMonitor + "." + m.ResourceName,
+		})
+	}
+	for _, h := range result.Healthchecks {
+		entries = append(entries, mapping.Entry{
+			SourceID:     strconv.Itoa(h.OriginalID),
+			ResourceType: mapping.ResourceTypeHealthcheck,
+			Name:         h.Name,
+			Address:      mapping.ResourceTypeHealthcheck + "." + h.ResourceName,
+		})
+	}
+	return entries
+}
+
 func escapeShellString(s string) string {
 	return migrate.EscapeShell(s)
 }
@@ -200,12 +200,16 @@ func generateSkippedResourcesSection(result *converter.ConversionResult) string
 
 	var sb strings.Builder
 	sb.WriteString("## Skipped Resources\n\n")
-	sb.WriteString("The following monitors could not be automatically migrated:\n\n")
+	sb.WriteString("The following monitors were not migrated:\n\n")
 
 	for _, s := range result.Skipped {
 		fmt.Fprintf(&sb, "### %s (ID: %d)\n\n", s.Name, s.ID)
 		fmt.Fprintf(&sb, "**Type:** %d\n\n", s.Type)
 		fmt.Fprintf(&sb, "**Reason:** %s\n\n", s.Reason)
+		if s.ByOverride {
+			sb.WriteString("**Action Required:** None, skipped on purpose\n\n")
+			continue
+		}
 		sb.WriteString("**Action Required:** Manual configuration needed\n\n")
 	}
 
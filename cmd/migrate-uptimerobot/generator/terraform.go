@@ -4,184 +4,192 @@
 package generator
 
 import (
-	"fmt"
 	"strings"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/converter"
-	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
 )
 
-// GenerateTerraform generates Terraform HCL configuration from conversion results.
-func GenerateTerraform(result *converter.ConversionResult) string {
-	var sb strings.Builder
+// sectionRule separates the generated file into sections.
+const sectionRule = "============================================"
+
+// GenerateTerraform generates Terraform HCL configuration from conversion
+// results. With compat.IgnoreChanges, monitors get lifecycle ignore_changes
+// for the attributes the API does not return faithfully.
+func GenerateTerraform(result *converter.ConversionResult, mode compat.Mode) string {
+	f := hclgen.NewFile()
+	root := f.Body()
 
 	// Header
-	sb.WriteString("# Terraform configuration generated from UptimeRobot migration\n")
-	sb.WriteString("# Review and adjust as needed before applying\n")
-	sb.WriteString("#\n")
-	fmt.Fprintf(&sb, "# Total monitors: %d\n", len(result.Monitors))
-	fmt.Fprintf(&sb, "# Total healthchecks: %d\n", len(result.Healthchecks))
+	root.Comment("Terraform configuration generated from UptimeRobot migration")
+	root.Comment("Review and adjust as needed before applying")
+	root.Comment("")
+	root.Comment("Total monitors: %d", len(result.Monitors))
+	root.Comment("Total healthchecks: %d", len(result.Healthchecks))
 	if len(result.Skipped) > 0 {
-		fmt.Fprintf(&sb, "# Skipped resources: %d (see comments below)\n", len(result.Skipped))
+		root.Comment("Skipped resources: %d (see comments below)", len(result.Skipped))
 	}
-	sb.WriteString("\n")
+	root.Newline()
 
 	// Terraform and provider configuration
-	sb.WriteString("terraform {\n")
-	sb.WriteString("  required_providers {\n")
-	sb.WriteString("    hyperping = {\n")
-	sb.WriteString("      source  = \"develeap/hyperping\"\n")
-	sb.WriteString("      version = \"~> 1.0\"\n")
-	sb.WriteString("    }\n")
-	sb.WriteString("  }\n")
-	sb.WriteString("}\n\n")
-
-	sb.WriteString("provider \"hyperping\" {\n")
-	sb.WriteString("  # API key will be read from HYPERPING_API_KEY environment variable\n")
-	sb.WriteString("}\n\n")
+	hclgen.AppendProviderConfig(root, "", "API key will be read from HYPERPING_API_KEY environment variable")
+	root.Newline()
 
 	// Variables for escalation policies
 	if len(result.Monitors) > 0 || len(result.Healthchecks) > 0 {
-		sb.WriteString("# Escalation Policy Configuration\n")
-		sb.WriteString("# Create escalation policies in Hyperping dashboard first,\n")
-		sb.WriteString("# then set their UUIDs here or via terraform.tfvars\n")
-		sb.WriteString("variable \"escalation_policy\" {\n")
-		sb.WriteString("  description = \"Default escalation policy UUID for alerts\"\n")
-		sb.WriteString("  type        = string\n")
-		sb.WriteString("  default     = \"\"  # Set this to your escalation policy UUID\n")
-		sb.WriteString("}\n\n")
+		root.Comment("Escalation Policy Configuration")
+		root.Comment("Create escalation policies in Hyperping dashboard first,")
+		root.Comment("then set their UUIDs here or via terraform.tfvars")
+		v := root.Block("variable", "escalation_policy")
+		v.SetString("description", "Default escalation policy UUID for alerts")
+		_ = v.SetReference("type", "string") //nolint:errcheck // constant, valid identifier
+		v.SetString("default", "")
+		v.Comment("Set default to your escalation policy UUID")
+		root.Newline()
 	}
 
 	// Generate monitors
 	if len(result.Monitors) > 0 {
-		sb.WriteString("# ============================================\n")
-		sb.WriteString("# Monitors\n")
-		sb.WriteString("# ============================================\n\n")
-
+		writeSection(root, "Monitors")
 		for _, m := range result.Monitors {
-			generateMonitorResource(&sb, m)
+			generateMonitorResource(root, m, mode)
 		}
 	}
 
 	// Generate healthchecks
 	if len(result.Healthchecks) > 0 {
-		sb.WriteString("# ============================================\n")
-		sb.WriteString("# Healthchecks (from Heartbeat monitors)\n")
-		sb.WriteString("# ============================================\n\n")
-
+		writeSection(root, "Healthchecks (from Heartbeat monitors)")
 		for _, h := range result.Healthchecks {
-			generateHealthcheckResource(&sb, h)
+			generateHealthcheckResource(root, h)
 		}
 	}
 
 	// Document skipped resources
 	if len(result.Skipped) > 0 {
-		sb.WriteString("# ============================================\n")
-		sb.WriteString("# Skipped Resources\n")
-		sb.WriteString("# ============================================\n")
-		sb.WriteString("# The following monitors could not be migrated:\n")
-		sb.WriteString("#\n")
+		root.Comment(sectionRule)
+		root.Comment("Skipped Resources")
+		root.Comment(sectionRule)
+		root.Comment("The following monitors could not be migrated:")
+		root.Comment("")
 		for _, s := range result.Skipped {
-			fmt.Fprintf(&sb, "# - %s (ID: %d, Type: %d): %s\n", s.Name, s.ID, s.Type, s.Reason)
+			root.Comment("- %s (ID: %d, Type: %d): %s", s.Name, s.ID, s.Type, s.Reason)
 		}
-		sb.WriteString("\n")
+		root.Newline()
 	}
 
 	// Outputs
-	sb.WriteString("# ============================================\n")
-	sb.WriteString("# Outputs\n")
-	sb.WriteString("# ============================================\n\n")
+	writeSection(root, "Outputs")
 
 	if len(result.Healthchecks) > 0 {
-		sb.WriteString("# Healthcheck ping URLs\n")
-		sb.WriteString("# Use these URLs to update your heartbeat scripts\n")
+		root.Comment("Healthcheck ping URLs")
+		root.Comment("Use these URLs to update your heartbeat scripts")
 		for _, h := range result.Healthchecks {
-			fmt.Fprintf(&sb, "output \"%s_ping_url\" {\n", h.ResourceName)
-			fmt.Fprintf(&sb, "  description = \"Ping URL for %s\"\n", escapeString(h.Name))
-			fmt.Fprintf(&sb, "  value       = hyperping_healthcheck.%s.ping_url\n", h.ResourceName)
-			sb.WriteString("  sensitive   = true\n")
-			sb.WriteString("}\n\n")
+			out := root.Block("output", h.ResourceName+"_ping_url")
+			out.SetString("description", "Ping URL for "+h.Name)
+			if err := out.SetReference("value", "hyperping_healthcheck."+h.ResourceName+".ping_url"); err != nil {
+				out.Comment("%v", err)
+			}
+			out.SetBool("sensitive", true)
+			root.Newline()
 		}
 	}
 
-	return sb.String()
+	return f.String()
 }
 
-// generateMonitorResource generates HCL for a single monitor resource.
-func generateMonitorResource(sb *strings.Builder, m converter.HyperpingMonitor) {
-	fmt.Fprintf(sb, "# Original UptimeRobot Monitor ID: %d\n", m.OriginalID)
-	if len(m.Warnings) > 0 {
-		sb.WriteString("# Warnings:\n")
-		for _, w := range m.Warnings {
-			fmt.Fprintf(sb, "#   - %s\n", w)
-		}
+// writeSection writes a section banner followed by a blank line.
+func writeSection(root *hclgen.Body, title string) {
+	root.Comment(sectionRule)
+	root.Comment("%s", title)
+	root.Comment(sectionRule)
+	root.Newline()
+}
+
+// writeSourceTags records the UptimeRobot tags above a resource. Hyperping
+// resources have no tag field, so the comment keeps them visible in review.
+func writeSourceTags(root *hclgen.Body, tags []string) {
+	if len(tags) > 0 {
+		root.Comment("Tags: %s", strings.Join(tags, ", "))
 	}
-	fmt.Fprintf(sb, "resource \"hyperping_monitor\" \"%s\" {\n", m.ResourceName)
-	fmt.Fprintf(sb, "  name            = %s\n", migrate.QuoteHCL(m.Name))
-	fmt.Fprintf(sb, "  url             = %s\n", migrate.QuoteHCL(m.URL))
-	fmt.Fprintf(sb, "  protocol        = %s\n", migrate.QuoteHCL(m.Protocol))
+}
+
+// writeWarnings writes conversion warnings as comments above a resource.
+func writeWarnings(root *hclgen.Body, warnings []string) {
+	if len(warnings) == 0 {
+		return
+	}
+	root.Comment("Warnings:")
+	for _, w := range warnings {
+		root.Comment("  - %s", w)
+	}
+}
+
+// writeAlertingHint closes a resource body with the commented escalation policy.
+func writeAlertingHint(body *hclgen.Body) {
+	body.Newline()
+	body.Comment("Uncomment to enable alerting:")
+	body.Comment("escalation_policy = var.escalation_policy")
+}
+
+// generateMonitorResource generates HCL for a single monitor resource.
+func generateMonitorResource(root *hclgen.Body, m converter.HyperpingMonitor, mode compat.Mode) {
+	root.Comment("Original UptimeRobot Monitor ID: %d", m.OriginalID)
+	writeSourceTags(root, m.Tags)
+	writeWarnings(root, m.Warnings)
+
+	r := root.Block("resource", "hyperping_monitor", m.ResourceName)
+	r.SetString("name", m.Name)
+	r.SetString("url", m.URL)
+	r.SetString("protocol", m.Protocol)
 
 	if m.HTTPMethod != "" {
-		fmt.Fprintf(sb, "  http_method     = %s\n", migrate.QuoteHCL(m.HTTPMethod))
+		r.SetString("http_method", m.HTTPMethod)
 	}
 
-	fmt.Fprintf(sb, "  check_frequency = %d\n", m.CheckFrequency)
+	r.SetInt("check_frequency", m.CheckFrequency)
 
 	if m.ExpectedStatusCode != "" {
-		fmt.Fprintf(sb, "  expected_status_code = %s\n", migrate.QuoteHCL(m.ExpectedStatusCode))
+		r.SetString("expected_status_code", m.ExpectedStatusCode)
 	}
 
 	if m.RequiredKeyword != "" {
-		fmt.Fprintf(sb, "  required_keyword     = %s\n", migrate.QuoteHCL(m.RequiredKeyword))
+		r.SetString("required_keyword", m.RequiredKeyword)
 	}
 
 	if m.Port > 0 {
-		fmt.Fprintf(sb, "  port            = %d\n", m.Port)
+		r.SetInt("port", m.Port)
 	}
 
 	if m.Protocol == "http" {
-		fmt.Fprintf(sb, "  follow_redirects = %t\n", m.FollowRedirects)
+		r.SetBool("follow_redirects", m.FollowRedirects)
 	}
 
 	if len(m.Regions) > 0 {
-		sb.WriteString("  regions         = [")
-		for i, r := range m.Regions {
-			if i > 0 {
-				sb.WriteString(", ")
-			}
-			sb.WriteString(migrate.QuoteHCL(r))
-		}
-		sb.WriteString("]\n")
+		r.SetStringList("regions", m.Regions)
 	}
 
-	sb.WriteString("\n")
-	sb.WriteString("  # Uncomment to enable alerting:\n")
-	sb.WriteString("  # escalation_policy = var.escalation_policy\n")
-	sb.WriteString("}\n\n")
+	writeAlertingHint(r)
+	compat.WriteLifecycle(r, mode, compat.MonitorFields(r, m.Protocol))
+	root.Newline()
 }
 
 // generateHealthcheckResource generates HCL for a single healthcheck resource.
-func generateHealthcheckResource(sb *strings.Builder, h converter.HyperpingHealthcheck) {
-	fmt.Fprintf(sb, "# Original UptimeRobot Heartbeat Monitor ID: %d\n", h.OriginalID)
-	if len(h.Warnings) > 0 {
-		sb.WriteString("# Warnings:\n")
-		for _, w := range h.Warnings {
-			fmt.Fprintf(sb, "#   - %s\n", w)
-		}
+func generateHealthcheckResource(root *hclgen.Body, h converter.HyperpingHealthcheck) {
+	root.Comment("Original UptimeRobot Heartbeat Monitor ID: %d", h.OriginalID)
+	if h.Schedule.Rule != "" {
+		root.Comment("Schedule: %s", h.Schedule.Rule)
 	}
-	fmt.Fprintf(sb, "resource \"hyperping_healthcheck\" \"%s\" {\n", h.ResourceName)
-	fmt.Fprintf(sb, "  name               = %s\n", migrate.QuoteHCL(h.Name))
-	fmt.Fprintf(sb, "  period_value       = %d\n", h.PeriodValue)
-	fmt.Fprintf(sb, "  period_type        = %s\n", migrate.QuoteHCL(h.PeriodType))
-	fmt.Fprintf(sb, "  grace_period_value = %d\n", h.GracePeriodValue)
-	fmt.Fprintf(sb, "  grace_period_type  = %s\n", migrate.QuoteHCL(h.GracePeriodType))
-	sb.WriteString("\n")
-	sb.WriteString("  # Uncomment to enable alerting:\n")
-	sb.WriteString("  # escalation_policy = var.escalation_policy\n")
-	sb.WriteString("}\n\n")
-}
-
-// escapeString escapes a string for use in Terraform configuration.
-func escapeString(s string) string {
-	return migrate.EscapeHCL(s)
+	writeSourceTags(root, h.Tags)
+	writeWarnings(root, h.Warnings)
+
+	r := root.Block("resource", "hyperping_healthcheck", h.ResourceName)
+	r.SetString("name", h.Name)
+	r.SetInt("period_value", h.PeriodValue)
+	r.SetString("period_type", h.PeriodType)
+	r.SetInt("grace_period_value", h.GracePeriodValue)
+	r.SetString("grace_period_type", h.GracePeriodType)
+
+	writeAlertingHint(r)
+	root.Newline()
 }
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/converter"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
 )
 
 // TestGenerateTerraform_HCLTemplateInjection verifies that attacker-controlled
@@ -43,7 +44,7 @@ func TestGenerateTerraform_HCLTemplateInjection(t *testing.T) {
 		},
 	}
 
-	out := GenerateTerraform(result)
+	out := GenerateTerraform(result, compat.None)
 
 	// The escaped forms MUST appear: ${...} must become $${...} and %{...}
 	// must become %%{...} so HCL treats them as literal text.
@@ -8,6 +8,7 @@ import (
 	"testing"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/converter"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
 )
 
 // goldenResult is a deliberately small, deterministic conversion result that
@@ -62,12 +63,12 @@ func goldenResult() *converter.ConversionResult {
 }
 
 func TestGenerateTerraform_Golden(t *testing.T) {
-	got := GenerateTerraform(goldenResult())
+	got := GenerateTerraform(goldenResult(), compat.None)
 	goldenAssert(t, "hyperping.tf.golden", got)
 }
 
 func TestGenerateTerraform_EmptyResult(t *testing.T) {
-	got := GenerateTerraform(&converter.ConversionResult{ContactsMap: map[string][]string{}})
+	got := GenerateTerraform(&converter.ConversionResult{ContactsMap: map[string][]string{}}, compat.None)
 
 	// Header + provider block must always be present.
 	for _, want := range []string{
@@ -98,7 +99,7 @@ func TestGenerateTerraform_HealthcheckOutput(t *testing.T) {
 			{ResourceName: "hb1", Name: "HB", PeriodValue: 1, PeriodType: "hours", GracePeriodValue: 1, GracePeriodType: "hours"},
 		},
 	}
-	got := GenerateTerraform(r)
+	got := GenerateTerraform(r, compat.None)
 	if !strings.Contains(got, `output "hb1_ping_url"`) {
 		t.Errorf("expected ping_url output for healthcheck, got:\n%s", got)
 	}
@@ -113,8 +114,44 @@ func TestGenerateTerraform_FollowRedirectsOnlyForHTTP(t *testing.T) {
 			{ResourceName: "p", Name: "P", URL: "host", Protocol: "icmp", CheckFrequency: 60, FollowRedirects: true},
 		},
 	}
-	got := GenerateTerraform(r)
+	got := GenerateTerraform(r, compat.None)
 	if strings.Contains(got, "follow_redirects") {
 		t.Errorf("follow_redirects should be omitted for non-HTTP protocols, got:\n%s", got)
 	}
 }
+
+func TestGenerateTerraform_SourceTagsComment(t *testing.T) {
+	r := &converter.ConversionResult{
+		Monitors: []converter.HyperpingMonitor{
+			{ResourceName: "api", Name: "API", URL: "https://api.example.com", Protocol: "http", CheckFrequency: 60, Tags: []string{"prod", "web"}},
+		},
+		Healthchecks: []converter.HyperpingHealthcheck{
+			{ResourceName: "hb1", Name: "HB", PeriodValue: 1, PeriodType: "hours", GracePeriodValue: 1, GracePeriodType: "hours"},
+		},
+	}
+	got := GenerateTerraform(r, compat.None)
+	if strings.Count(got, "# Tags:") != 1 || !strings.Contains(got, "# Tags: prod, web\n") {
+		t.Errorf("expected a single tags comment for the tagged monitor, got:\n%s", got)
+	}
+}
+
+func TestGenerateTerraform_CompatMode(t *testing.T) {
+	r := &converter.ConversionResult{
+		Monitors: []converter.HyperpingMonitor{
+			{ResourceName: "kw", Name: "KW", URL: "https://example.com", Protocol: "http", CheckFrequency: 60, RequiredKeyword: "ok"},
+			{ResourceName: "plain", Name: "Plain", URL: "https://example.com", Protocol: "http", CheckFrequency: 60},
+		},
+	}
+
+	if got := GenerateTerraform(r, compat.None); strings.Contains(got, "lifecycle") {
+		t.Errorf("compat mode none should not emit lifecycle blocks, got:\n%s", got)
+	}
+
+	got := GenerateTerraform(r, compat.IgnoreChanges)
+	if strings.Count(got, "lifecycle {") != 1 {
+		t.Errorf("expected one lifecycle block, for the monitor with a required keyword, got:\n%s", got)
+	}
+	if !strings.Contains(got, "ignore_changes = [\n      required_keyword,\n    ]") {
+		t.Errorf("expected required_keyword to be ignored, got:\n%s", got)
+	}
+}
@@ -14,7 +14,11 @@ import (
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/generator"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/report"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/uptimerobot"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
 	"github.com/develeap/terraform-provider-hyperping/pkg/interactive"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 // interactiveConfigUR holds configuration collected from interactive prompts.
@@ -307,7 +311,7 @@ func (w *interactiveWizardUR) writeOutputFiles(conversionResult *converter.Conve
 	fileSpinner := interactive.NewSpinner("Writing output files...", os.Stderr)
 	fileSpinner.Start()
 
-	tfConfig := generator.GenerateTerraform(conversionResult)
+	tfConfig := generator.GenerateTerraform(conversionResult, compatMode)
 	if writeErr := os.WriteFile(w.config.outputFile, []byte(tfConfig), 0o600); writeErr != nil {
 		fileSpinner.ErrorMessage(fmt.Sprintf("Failed to write %s", w.config.outputFile))
 		w.prompter.PrintError(fmt.Sprintf("Error: %v", writeErr))
@@ -340,6 +344,16 @@ func (w *interactiveWizardUR) writeOutputFiles(conversionResult *converter.Conve
 		return 1
 	}
 
+	idMap := mapping.New("UptimeRobot", toolName, "")
+	for _, entry := range generator.MappingEntries(conversionResult) {
+		idMap.Add(entry)
+	}
+	if writeErr := idMap.Write(*mappingFile); writeErr != nil {
+		fileSpinner.ErrorMessage(fmt.Sprintf("Failed to write %s", *mappingFile))
+		w.prompter.PrintError(fmt.Sprintf("Error: %v", writeErr))
+		return 1
+	}
+
 	fileSpinner.SuccessMessage("All files written successfully")
 	printFinalSummary(w.prompter, w.config, migrationReport)
 	return 0
@@ -355,6 +369,7 @@ func printFinalSummary(prompter *interactive.Prompter, config *interactiveConfig
 	fmt.Fprintf(os.Stderr, "  📜 %s - Import script\n", config.importScript)
 	fmt.Fprintf(os.Stderr, "  📊 %s - Migration report\n", config.reportFile)
 	fmt.Fprintf(os.Stderr, "  📝 %s - Manual configuration steps\n", config.manualStepsFile)
+	fmt.Fprintf(os.Stderr, "  🔗 %s - Source ID mapping\n", *mappingFile)
 	fmt.Fprintf(os.Stderr, "\n")
 
 	if len(migrationReport.Warnings) > 0 || len(migrationReport.Errors) > 0 {
@@ -487,6 +502,9 @@ func isFlagPassed() bool {
 	if *output != "hyperping.tf" {
 		return true
 	}
+	if *outputDialectFlag != string(dialect.Terraform) || *compatModeFlag != string(compat.None) {
+		return true
+	}
 	if *importScript != "import.sh" {
 		return true
 	}
@@ -496,10 +514,10 @@ func isFlagPassed() bool {
 	if *manualSteps != "manual-steps.md" {
 		return true
 	}
-	if *dryRun || *validate || *verbose || *resume || *rollback || *rollbackForce || *listCheckpointsFlag {
+	if *dryRun || *validate || *verbose || *resume || *rollback || *rollbackForce || *listCheckpointsFlag || *loginFlag {
 		return true
 	}
-	if *resumeID != "" || *rollbackID != "" {
+	if *resumeID != "" || *rollbackID != "" || *nameTemplateFlag != "" || *overridesFlag != "" || *frequencyPolicyFlag != string(migrate.FrequencyNearest) {
 		return true
 	}
 	if os.Getenv("UPTIMEROBOT_API_KEY") != "" {
@@ -20,6 +20,7 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strconv"
 	"time"
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/converter"
@@ -27,8 +28,15 @@ import (
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/report"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/uptimerobot"
 	"github.com/develeap/terraform-provider-hyperping/pkg/checkpoint"
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+	"github.com/develeap/terraform-provider-hyperping/pkg/compat"
+	"github.com/develeap/terraform-provider-hyperping/pkg/credentials"
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 	"github.com/develeap/terraform-provider-hyperping/pkg/migrationstate"
 	"github.com/develeap/terraform-provider-hyperping/pkg/recovery"
+	"github.com/develeap/terraform-provider-hyperping/pkg/tfstate"
 )
 
 var (
@@ -47,6 +55,39 @@ var (
 	rollbackID          = flag.String("rollback-id", "", "Rollback specific migration ID")
 	rollbackForce       = flag.Bool("force", false, "Force rollback without confirmation")
 	listCheckpointsFlag = flag.Bool("list-checkpoints", false, "List available checkpoints")
+	verifyMode          = flag.Bool("verify", false, "Compare UptimeRobot monitors with existing Hyperping monitors (run after terraform apply)")
+	verifyReport        = flag.String("verify-report", "verification-report.json", "Output verification report file (use with -verify)")
+	nameTemplateFlag    = flag.String("name-template", "", "Go template for Hyperping names, e.g. '[{{.Tag \"env\"}}] {{.Name}}' (fields: .Name, .Tags)")
+	overridesFlag       = flag.String("overrides", "", "YAML mapping override file: per UptimeRobot monitor ID, name, regions, frequency, or skip")
+	regionMapFlag       = flag.String("region-map", "", "YAML region map file; its default list replaces the built-in regions of converted monitors")
+	frequencyPolicyFlag = flag.String("frequency-policy", string(migrate.FrequencyNearest), "How to snap intervals Hyperping does not support: nearest, round-up, round-down, or fail")
+	heartbeatGraceFlag  = flag.Duration("heartbeat-grace", converter.DefaultHeartbeatGrace, "Grace period of healthchecks converted from heartbeat monitors (UptimeRobot has none), e.g. 5m")
+	logFlags            = recovery.RegisterLogFlags(flag.CommandLine)
+	checkWriteAccess    = recovery.RegisterWriteCheckFlag(flag.CommandLine)
+	listFlags           = migrationstate.RegisterListFlags(flag.CommandLine, toolName)
+	rollbackFlags       = migrationstate.RegisterRollbackFlags(flag.CommandLine)
+	webhookFlags        = migrationstate.RegisterWebhookFlags(flag.CommandLine)
+	loginFlag           = credentials.RegisterLoginFlag(flag.CommandLine, uptimerobotPlatform)
+	outputDialectFlag   = dialect.RegisterFlag(flag.CommandLine)
+	compatModeFlag      = compat.RegisterFlag(flag.CommandLine)
+	fromStateFlag       = tfstate.RegisterFlag(flag.CommandLine)
+	removedBlocksFile   = flag.String("removed-blocks", "removed.tf", "Output removed blocks that drop the source resources from state (use with -from-state)")
+	mappingFile         = mapping.RegisterFlag(flag.CommandLine, mapping.DefaultFileName)
+
+	// nameTemplate is parsed from -name-template in run; nil keeps source names.
+	nameTemplate *migrate.NameTemplate
+	// overrides is loaded from -overrides in run; nil applies none.
+	overrides *migrate.Overrides
+	// regionMap is loaded from -region-map in run; nil applies none.
+	regionMap *migrate.RegionMap
+	// frequencyPolicy is parsed from -frequency-policy in run.
+	frequencyPolicy migrate.FrequencyPolicy
+	// outputDialect is parsed from -output-dialect in run.
+	outputDialect dialect.Dialect
+	// compatMode is parsed from -compat-mode in run.
+	compatMode compat.Mode
+	// notifier is built from -webhook-url in run; nil sends no webhooks.
+	notifier *migrationstate.Notifier
 )
 
 // runner holds the resolved configuration for a non-interactive run.
@@ -56,6 +97,10 @@ type runner struct {
 	ctx         context.Context
 	state       *migrationstate.State
 	migrationID string
+
+	// stateInstances are the monitors read from -from-state; nil when
+	// monitors are fetched from the UptimeRobot API.
+	stateInstances []tfstate.Instance
 }
 
 func main() {
@@ -71,6 +116,25 @@ func main() {
 		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -dry-run -verbose\n\n")
 		fmt.Fprintf(os.Stderr, "  # Generate migration files\n")
 		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -output=hyperping.tf -import-script=import.sh\n\n")
+		fmt.Fprintf(os.Stderr, "  # Append tags to monitor names\n")
+		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -name-template='{{.Name}}{{with .Tags}} ({{join . \", \"}}){{end}}'\n\n")
+		fmt.Fprintf(os.Stderr, "  # Correct names, regions, or frequencies per monitor, or skip monitors\n")
+		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -overrides=overrides.yaml\n\n")
+		fmt.Fprintf(os.Stderr, "  # Check every monitor from the regions listed under default: in regions.yaml\n")
+		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -region-map=regions.yaml\n\n")
+		fmt.Fprintf(os.Stderr, "  # Never check less often than UptimeRobot did\n")
+		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -frequency-policy=round-down\n\n")
+		fmt.Fprintf(os.Stderr, "  # Convert monitors managed by the UptimeRobot Terraform provider\n")
+		fmt.Fprintf(os.Stderr, "  terraform state pull > uptimerobot.tfstate\n")
+		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -from-state=uptimerobot.tfstate\n\n")
+		fmt.Fprintf(os.Stderr, "  # Verify migrated monitors after terraform apply\n")
+		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -verify\n\n")
+		fmt.Fprintf(os.Stderr, "  # Sign in through the browser and store the UptimeRobot key in the OS keychain\n")
+		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -login\n\n")
+		fmt.Fprintf(os.Stderr, "  # Generate CDK for Terraform (Python) constructs instead of HCL\n")
+		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -output-dialect=cdktf-python\n\n")
+		fmt.Fprintf(os.Stderr, "  # Report phase transitions to a Slack channel\n")
+		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot -webhook-url=\"$SLACK_WEBHOOK_URL\"\n\n")
 		fmt.Fprintf(os.Stderr, "  # Resume from last checkpoint\n")
 		fmt.Fprintf(os.Stderr, "  migrate-uptimerobot --resume\n\n")
 		fmt.Fprintf(os.Stderr, "  # Rollback migration\n")
@@ -83,12 +147,72 @@ func main() {
 func run() int {
 	flag.Parse()
 
+	if err := clientenv.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var err error
+	nameTemplate, err = migrate.ParseNameTemplate(*nameTemplateFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	overrides, err = migrate.LoadOverrides(*overridesFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	regionMap, err = migrate.LoadRegionMap(*regionMapFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	frequencyPolicy, err = migrate.ParseFrequencyPolicy(*frequencyPolicyFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if *heartbeatGraceFlag < time.Second || *heartbeatGraceFlag%time.Second != 0 {
+		fmt.Fprintf(os.Stderr, "Error: -heartbeat-grace must be a whole number of seconds, at least 1s: %s\n", *heartbeatGraceFlag)
+		return 1
+	}
+
+	outputDialect, err = dialect.ParseDialect(*outputDialectFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	compatMode, err = compat.ParseMode(*compatModeFlag)
+	if err == nil {
+		err = compatMode.CheckDialect(outputDialect)
+	}
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	notifier, err = webhookFlags.Notifier()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	if *loginFlag {
+		return runLogin()
+	}
+
 	if shouldUseInteractive() {
 		return runInteractive()
 	}
 
 	if *listCheckpointsFlag {
-		return migrationstate.ListCheckpoints(toolName)
+		return migrationstate.ListCheckpoints(listFlags.Options())
 	}
 
 	if *rollback {
@@ -103,6 +227,12 @@ func run() int {
 		defer cancel()
 	}
 
+	if *dryRun && !*validate && !*verifyMode {
+		if exitCode := r.runDryValidation(); exitCode != 0 {
+			return r.fail(exitCode)
+		}
+	}
+
 	monitors, alertContacts, exitCode := r.fetchMonitors()
 	if exitCode != 0 {
 		return exitCode
@@ -112,6 +242,15 @@ func run() int {
 		return runValidation(monitors, alertContacts)
 	}
 
+	if *verifyMode {
+		return r.runVerification(monitors)
+	}
+
+	if r.state != nil && !*dryRun {
+		r.state.SetNotifier(notifier)
+		r.state.Notify(migrationstate.PhaseStarted, "")
+	}
+
 	conversionResult, migrationReport := r.convertAndReport(monitors, alertContacts)
 
 	if *dryRun {
@@ -140,7 +279,7 @@ func handleRollback() int {
 		return 1
 	}
 
-	logger, err := recovery.NewLogger(false)
+	logger, err := recovery.NewLoggerWithOptions(*verbose, logFlags.Options())
 	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error: Failed to create logger: %v\n", err)
 		return 1
@@ -163,7 +302,9 @@ func handleRollback() int {
 		migID = latest.MigrationID
 	}
 
-	return migrationstate.PerformRollback(migID, hpKey, *rollbackForce, logger)
+	opts := rollbackFlags.Options()
+	opts.MappingFile = *mappingFile
+	return migrationstate.PerformRollback(migID, hpKey, *rollbackForce, opts, logger)
 }
 
 // newRunner validates flags, resolves API keys, and sets up the context and state.
@@ -172,15 +313,18 @@ func newRunner() (*runner, int) {
 	if urAPIKey == "" {
 		urAPIKey = os.Getenv("UPTIMEROBOT_API_KEY")
 	}
+	if urAPIKey == "" {
+		urAPIKey = credentials.Lookup(keychain, uptimerobotPlatform)
+	}
 
 	hpAPIKey := *hyperpingAPIKey
 	if hpAPIKey == "" {
 		hpAPIKey = os.Getenv("HYPERPING_API_KEY")
 	}
 
-	if urAPIKey == "" {
+	if urAPIKey == "" && *fromStateFlag == "" {
 		fmt.Fprintln(os.Stderr, "Error: UPTIMEROBOT_API_KEY is required")
-		fmt.Fprintln(os.Stderr, "Set via environment variable or -uptimerobot-api-key flag")
+		fmt.Fprintln(os.Stderr, "Set via environment variable or -uptimerobot-api-key flag, run with -login, or use -from-state")
 		return nil, 1
 	}
 
@@ -195,6 +339,11 @@ func newRunner() (*runner, int) {
 
 	r := &runner{urAPIKey: urAPIKey, hpAPIKey: hpAPIKey, ctx: ctx}
 
+	// Verification is read-only and does not create a checkpoint.
+	if *verifyMode {
+		return r, 0
+	}
+
 	if err := r.initState(); err != nil {
 		cancel()
 		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
@@ -206,7 +355,7 @@ func newRunner() (*runner, int) {
 
 // initState initialises or resumes migration state.
 func (r *runner) initState() error {
-	logger, err := recovery.NewLogger(false)
+	logger, err := recovery.NewLoggerWithOptions(*verbose, logFlags.Options())
 	if err != nil {
 		return fmt.Errorf("failed to create logger: %w", err)
 	}
@@ -248,8 +397,37 @@ func (r *runner) initState() error {
 	return nil
 }
 
+// runDryValidation validates the UptimeRobot and Hyperping API keys in
+// dry-run mode and reports both in one summary.
+func (r *runner) runDryValidation() int {
+	validator := recovery.NewAPIValidator(r.state.Logger)
+
+	source := recovery.SkippedValidation("UptimeRobot", "reading -from-state")
+	if *fromStateFlag == "" {
+		// Alert contacts are a smaller list than monitors and need the same key.
+		source = validator.ValidateSourceAPI(r.ctx, "UptimeRobot", func(ctx context.Context) error {
+			_, err := uptimerobot.NewClient(r.urAPIKey).GetAlertContacts(ctx)
+			return err
+		})
+	}
+
+	destination := recovery.SkippedValidation(recovery.DestinationServiceName, "no API key")
+	if r.hpAPIKey != "" {
+		destination = validator.ValidateDestinationAPI(r.ctx, clientenv.NewClient(r.hpAPIKey), *checkWriteAccess)
+	}
+
+	if !recovery.PrintValidationSummary(os.Stderr, source, destination) {
+		return 1
+	}
+	return 0
+}
+
 // fetchMonitors fetches monitors and alert contacts from UptimeRobot.
 func (r *runner) fetchMonitors() ([]uptimerobot.Monitor, []uptimerobot.AlertContact, int) {
+	if *fromStateFlag != "" {
+		return r.loadStateMonitors()
+	}
+
 	urClient := uptimerobot.NewClient(r.urAPIKey)
 
 	if *verbose {
@@ -284,13 +462,58 @@ func (r *runner) fetchMonitors() ([]uptimerobot.Monitor, []uptimerobot.AlertCont
 	return monitors, alertContacts, 0
 }
 
+// loadStateMonitors reads monitors and alert contacts managed by the
+// UptimeRobot Terraform provider from the -from-state file.
+func (r *runner) loadStateMonitors() ([]uptimerobot.Monitor, []uptimerobot.AlertContact, int) {
+	if *verbose {
+		fmt.Fprintf(os.Stderr, "Reading UptimeRobot resources from %s...\n", *fromStateFlag)
+	}
+
+	state, err := tfstate.Load(*fromStateFlag)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return nil, nil, 1
+	}
+
+	monitors, alertContacts, instances := uptimerobot.FromState(state)
+	if len(monitors) == 0 {
+		fmt.Fprintf(os.Stderr, "Error: no %s resources found in %s\n", uptimerobot.MonitorResourceType, *fromStateFlag)
+		return nil, nil, 1
+	}
+	r.stateInstances = instances
+
+	if *verbose {
+		fmt.Fprintf(os.Stderr, "Found %d monitors and %d alert contacts in state\n", len(monitors), len(alertContacts))
+	}
+
+	if r.state != nil {
+		r.state.Checkpoint.TotalResources = len(monitors)
+	}
+
+	return monitors, alertContacts, 0
+}
+
+// warnUnknownOverrides warns about mapping overrides whose ID matches no
+// fetched monitor, which usually means a typo.
+func warnUnknownOverrides(monitors []uptimerobot.Monitor) {
+	ids := make([]string, len(monitors))
+	for i, m := range monitors {
+		ids[i] = strconv.Itoa(m.ID)
+	}
+	for _, id := range overrides.Unknown(ids) {
+		fmt.Fprintf(os.Stderr, "Warning: mapping override %q matches no UptimeRobot monitor\n", id)
+	}
+}
+
 // convertAndReport converts monitors and prints the migration summary.
 func (r *runner) convertAndReport(monitors []uptimerobot.Monitor, alertContacts []uptimerobot.AlertContact) (*converter.ConversionResult, *report.Report) {
 	if *verbose {
 		fmt.Fprintln(os.Stderr, "Converting monitors to Hyperping resources...")
 	}
 
-	conv := converter.NewConverter()
+	warnUnknownOverrides(monitors)
+	conv := converter.NewConverter().WithNameTemplate(nameTemplate).WithOverrides(overrides).WithFrequencyPolicy(frequencyPolicy).
+		WithHeartbeatGrace(*heartbeatGraceFlag).WithRegionMap(regionMap)
 	conversionResult := conv.Convert(monitors, alertContacts)
 
 	if r.state != nil {
@@ -312,6 +535,11 @@ func (r *runner) convertAndReport(monitors []uptimerobot.Monitor, alertContacts
 	fmt.Fprintf(os.Stderr, "  Migrated healthchecks: %d\n", migrationReport.Summary.MigratedHealthchecks)
 	fmt.Fprintf(os.Stderr, "  Warnings: %d\n", len(migrationReport.Warnings))
 	fmt.Fprintf(os.Stderr, "  Errors: %d\n", len(migrationReport.Errors))
+	if migrationReport.Summary.FrequencyAdjustments > 0 {
+		fmt.Fprintf(os.Stderr, "  Frequency adjustments: %d (see frequency_adjustments in %s)\n", migrationReport.Summary.FrequencyAdjustments, *reportFile)
+	}
+	fmt.Fprintln(os.Stderr)
+	migrationReport.Estimate.WriteText(os.Stderr)
 
 	if len(migrationReport.Errors) > 0 {
 		fmt.Fprintln(os.Stderr, "\nErrors encountered:")
@@ -333,16 +561,26 @@ func (r *runner) convertAndReport(monitors []uptimerobot.Monitor, alertContacts
 // writeFiles writes all generated output files.
 func (r *runner) writeFiles(conversionResult *converter.ConversionResult, migrationReport *report.Report, alertContacts []uptimerobot.AlertContact) int {
 	if exitCode := r.writeTerraformConfig(conversionResult); exitCode != 0 {
-		return exitCode
+		return r.fail(exitCode)
+	}
+	if r.state != nil {
+		r.state.Notify(migrationstate.PhaseResourcesCreated, fmt.Sprintf("wrote %d monitors and %d healthchecks to %s",
+			len(conversionResult.Monitors), len(conversionResult.Healthchecks), *output))
 	}
 	if exitCode := r.writeImportScript(conversionResult); exitCode != 0 {
-		return exitCode
+		return r.fail(exitCode)
 	}
 	if exitCode := r.writeMigrationReport(migrationReport); exitCode != 0 {
-		return exitCode
+		return r.fail(exitCode)
 	}
 	if exitCode := r.writeManualSteps(conversionResult, alertContacts); exitCode != 0 {
-		return exitCode
+		return r.fail(exitCode)
+	}
+	if exitCode := r.writeRemovedBlocks(); exitCode != 0 {
+		return r.fail(exitCode)
+	}
+	if exitCode := r.writeMapping(conversionResult); exitCode != 0 {
+		return r.fail(exitCode)
 	}
 
 	if r.state != nil {
@@ -359,20 +597,36 @@ func (r *runner) writeFiles(conversionResult *converter.ConversionResult, migrat
 	fmt.Fprintf(os.Stderr, "  2. Run: terraform init && terraform plan\n")
 	fmt.Fprintf(os.Stderr, "  3. Run: terraform apply\n")
 	fmt.Fprintf(os.Stderr, "  4. Review %s for manual configuration steps\n", *manualSteps)
+	if len(r.stateInstances) > 0 {
+		fmt.Fprintf(os.Stderr, "  5. Move %s into the UptimeRobot configuration, delete the migrated resource blocks, and apply\n", *removedBlocksFile)
+	}
 	return 0
 }
 
+// fail saves the checkpoint as failed, which also reports the failure to the
+// webhook, and returns exitCode.
+func (r *runner) fail(exitCode int) int {
+	if r.state != nil {
+		r.state.Finalize(false)
+	}
+	return exitCode
+}
+
 // writeTerraformConfig generates and writes the Terraform configuration file.
 func (r *runner) writeTerraformConfig(conversionResult *converter.ConversionResult) int {
 	if *verbose {
 		fmt.Fprintln(os.Stderr, "\nGenerating Terraform configuration...")
 	}
-	tfConfig := generator.GenerateTerraform(conversionResult)
-	if err := os.WriteFile(*output, []byte(tfConfig), 0o600); err != nil {
+	tfConfig := generator.GenerateTerraform(conversionResult, compatMode)
+	paths, err := dialect.WriteConfig(outputDialect, []byte(tfConfig), *output)
+	if err != nil {
 		fmt.Fprintf(os.Stderr, "Error writing Terraform config: %v\n", err)
 		return 1
 	}
-	fmt.Fprintf(os.Stderr, "  ✓ Terraform configuration written to %s\n", *output)
+	for _, path := range paths {
+		fmt.Fprintf(os.Stderr, "  ✓ Terraform configuration written to %s\n", path)
+	}
+	r.recordFiles(paths...)
 	return 0
 }
 
@@ -387,6 +641,7 @@ func (r *runner) writeImportScript(conversionResult *converter.ConversionResult)
 		return 1
 	}
 	fmt.Fprintf(os.Stderr, "  ✓ Import script written to %s\n", *importScript)
+	r.recordFiles(*importScript)
 	return 0
 }
 
@@ -405,6 +660,7 @@ func (r *runner) writeMigrationReport(migrationReport *report.Report) int {
 		return 1
 	}
 	fmt.Fprintf(os.Stderr, "  ✓ Migration report written to %s\n", *reportFile)
+	r.recordFiles(*reportFile)
 	return 0
 }
 
@@ -420,9 +676,48 @@ func (r *runner) writeManualSteps(conversionResult *converter.ConversionResult,
 		return 1
 	}
 	fmt.Fprintf(os.Stderr, "  ✓ Manual steps written to %s\n", *manualSteps)
+	r.recordFiles(*manualSteps)
+	return 0
+}
+
+// writeRemovedBlocks writes removed blocks for the monitors read from
+// -from-state. It does nothing when monitors came from the API.
+func (r *runner) writeRemovedBlocks() int {
+	if len(r.stateInstances) == 0 {
+		return 0
+	}
+	if err := os.WriteFile(*removedBlocksFile, []byte(tfstate.RemovedBlocks(r.stateInstances)), 0o600); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing removed blocks: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "  ✓ Removed blocks written to %s\n", *removedBlocksFile)
+	r.recordFiles(*removedBlocksFile)
+	return 0
+}
+
+// writeMapping writes the mapping from UptimeRobot IDs to Terraform
+// addresses. It is not recorded for -rollback-files, so it still traces the
+// resources to their origin after a rollback.
+func (r *runner) writeMapping(conversionResult *converter.ConversionResult) int {
+	idMap := mapping.New("UptimeRobot", toolName, r.migrationID)
+	for _, entry := range generator.MappingEntries(conversionResult) {
+		idMap.Add(entry)
+	}
+	if err := idMap.Write(*mappingFile); err != nil {
+		fmt.Fprintf(os.Stderr, "Error writing mapping: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "  ✓ Mapping written to %s\n", *mappingFile)
 	return 0
 }
 
+// recordFiles records generated files in the checkpoint for --rollback-files.
+func (r *runner) recordFiles(paths ...string) {
+	if r.state != nil {
+		r.state.AddGeneratedFiles(paths...)
+	}
+}
+
 func runValidation(monitors []uptimerobot.Monitor, alertContacts []uptimerobot.AlertContact) int {
 	fmt.Fprintln(os.Stderr, "Validating UptimeRobot monitors...")
 
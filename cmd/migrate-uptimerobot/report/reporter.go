@@ -8,6 +8,7 @@ import (
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/converter"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/uptimerobot"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 // Report represents a migration report.
@@ -17,6 +18,10 @@ type Report struct {
 	Monitors  []MonitorReport `json:"monitors"`
 	Warnings  []Warning       `json:"warnings"`
 	Errors    []Error         `json:"errors"`
+	// FrequencyAdjustments lists every interval that was snapped to a
+	// supported Hyperping check frequency.
+	FrequencyAdjustments []migrate.FrequencyAdjustment `json:"frequency_adjustments"`
+	Estimate             *migrate.Estimate             `json:"estimate"`
 }
 
 // Summary contains migration summary statistics.
@@ -26,6 +31,7 @@ type Summary struct {
 	MigratedHealthchecks int `json:"migrated_healthchecks"`
 	SkippedMonitors      int `json:"skipped_monitors"`
 	MonitorsWithWarnings int `json:"monitors_with_warnings"`
+	FrequencyAdjustments int `json:"frequency_adjustments"`
 }
 
 // MonitorReport contains details about a migrated monitor.
@@ -37,6 +43,20 @@ type MonitorReport struct {
 	ResourceName    string   `json:"resource_name"`
 	MigrationStatus string   `json:"migration_status"`
 	Warnings        []string `json:"warnings,omitempty"`
+	// Schedule is set for healthchecks converted from heartbeat monitors.
+	Schedule *HealthcheckSchedule `json:"schedule,omitempty"`
+}
+
+// HealthcheckSchedule records how a heartbeat monitor's interval was mapped
+// to a healthcheck period and grace period.
+type HealthcheckSchedule struct {
+	IntervalSeconds  int    `json:"interval_seconds"`
+	PeriodValue      int    `json:"period_value"`
+	PeriodType       string `json:"period_type"`
+	GraceSeconds     int    `json:"grace_seconds"`
+	GracePeriodValue int    `json:"grace_period_value"`
+	GracePeriodType  string `json:"grace_period_type"`
+	Rule             string `json:"rule"`
 }
 
 // Warning represents a migration warning.
@@ -65,10 +85,17 @@ func Generate(
 			MigratedHealthchecks: len(result.Healthchecks),
 			SkippedMonitors:      len(result.Skipped),
 		},
-		Monitors: []MonitorReport{},
-		Warnings: []Warning{},
-		Errors:   []Error{},
+		Monitors:             []MonitorReport{},
+		Warnings:             []Warning{},
+		Errors:               []Error{},
+		FrequencyAdjustments: []migrate.FrequencyAdjustment{},
+	}
+
+	loads := make([]migrate.MonitorLoad, len(result.Monitors))
+	for i, m := range result.Monitors {
+		loads[i] = migrate.MonitorLoad{CheckFrequency: m.CheckFrequency, Regions: len(m.Regions)}
 	}
+	report.Estimate = migrate.NewEstimate(loads, len(result.Healthchecks))
 
 	// Process migrated monitors
 	for _, m := range result.Monitors {
@@ -97,6 +124,10 @@ func Generate(
 
 		report.Monitors = append(report.Monitors, monitorReport)
 
+		if m.FrequencyAdjustment != nil {
+			report.FrequencyAdjustments = append(report.FrequencyAdjustments, *m.FrequencyAdjustment)
+		}
+
 		// Collect warnings
 		if len(m.Warnings) > 0 {
 			report.Summary.MonitorsWithWarnings++
@@ -119,6 +150,15 @@ func Generate(
 			ResourceName:    h.ResourceName,
 			MigrationStatus: "migrated",
 			Warnings:        h.Warnings,
+			Schedule: &HealthcheckSchedule{
+				IntervalSeconds:  h.Schedule.IntervalSeconds,
+				PeriodValue:      h.PeriodValue,
+				PeriodType:       h.PeriodType,
+				GraceSeconds:     h.Schedule.GraceSeconds,
+				GracePeriodValue: h.GracePeriodValue,
+				GracePeriodType:  h.GracePeriodType,
+				Rule:             h.Schedule.Rule,
+			},
 		}
 
 		report.Monitors = append(report.Monitors, monitorReport)
@@ -155,5 +195,7 @@ func Generate(
 		})
 	}
 
+	report.Summary.FrequencyAdjustments = len(report.FrequencyAdjustments)
+
 	return report
 }
@@ -10,6 +10,7 @@ import (
 
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/converter"
 	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/uptimerobot"
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 func sampleResult() *converter.ConversionResult {
@@ -148,3 +149,64 @@ func TestGenerate_WarningsCollected(t *testing.T) {
 		t.Errorf("expected both keyword and healthcheck warnings, got: %+v", r.Warnings)
 	}
 }
+
+func TestGenerate_Estimate(t *testing.T) {
+	result := sampleResult()
+	for i := range result.Monitors {
+		result.Monitors[i].CheckFrequency = 300
+		result.Monitors[i].Regions = []string{"london", "virginia"}
+	}
+
+	r := Generate(nil, nil, result)
+
+	if r.Estimate == nil {
+		t.Fatal("expected an estimate")
+	}
+	if r.Estimate.Monitors != 4 || r.Estimate.Healthchecks != 1 {
+		t.Errorf("Estimate counts = %d monitors, %d healthchecks, want 4 and 1", r.Estimate.Monitors, r.Estimate.Healthchecks)
+	}
+	if want := int64(4 * 8640 * 2); r.Estimate.MonthlyChecks != want {
+		t.Errorf("MonthlyChecks = %d, want %d", r.Estimate.MonthlyChecks, want)
+	}
+}
+
+func TestGenerate_FrequencyAdjustments(t *testing.T) {
+	result := sampleResult()
+	adjustment := migrate.FrequencyAdjustment{SourceID: "2", Name: "Keyword", From: 900, To: 600, Policy: migrate.FrequencyRoundDown}
+	result.Monitors[1].FrequencyAdjustment = &adjustment
+
+	r := Generate(nil, nil, result)
+	if len(r.FrequencyAdjustments) != 1 || r.FrequencyAdjustments[0] != adjustment {
+		t.Errorf("FrequencyAdjustments = %+v", r.FrequencyAdjustments)
+	}
+	if r.Summary.FrequencyAdjustments != 1 {
+		t.Errorf("Summary.FrequencyAdjustments = %d, want 1", r.Summary.FrequencyAdjustments)
+	}
+}
+
+func TestGenerate_HealthcheckSchedule(t *testing.T) {
+	result := sampleResult()
+	result.Healthchecks[0].PeriodValue = 90
+	result.Healthchecks[0].PeriodType = "minutes"
+	result.Healthchecks[0].GracePeriodValue = 1
+	result.Healthchecks[0].GracePeriodType = "minutes"
+	result.Healthchecks[0].Schedule = converter.HeartbeatSchedule{IntervalSeconds: 5400, GraceSeconds: 60, Rule: "rule"}
+
+	r := Generate(nil, nil, result)
+	for _, m := range r.Monitors {
+		switch m.ResourceType {
+		case "hyperping_healthcheck":
+			want := HealthcheckSchedule{
+				IntervalSeconds: 5400, PeriodValue: 90, PeriodType: "minutes",
+				GraceSeconds: 60, GracePeriodValue: 1, GracePeriodType: "minutes", Rule: "rule",
+			}
+			if m.Schedule == nil || *m.Schedule != want {
+				t.Errorf("Schedule = %+v, want %+v", m.Schedule, want)
+			}
+		default:
+			if m.Schedule != nil {
+				t.Errorf("%s: unexpected schedule %+v", m.OriginalName, m.Schedule)
+			}
+		}
+	}
+}
@@ -12,6 +12,8 @@ import (
 	"net/http"
 	"strconv"
 	"time"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/migrate"
 )
 
 // FlexibleInt handles JSON fields that may be encoded as either a number or a string.
@@ -78,6 +80,7 @@ type Monitor struct {
 	Timeout       *int              `json:"timeout,omitempty"`
 	Status        int               `json:"status"` // 0=paused, 1=not checked yet, 2=up, 8=seems down, 9=down
 	AlertContacts []AlertContactRef `json:"alert_contacts,omitempty"`
+	Tags          migrate.Tags      `json:"tags,omitempty"`
 }
 
 // AlertContactRef represents a reference to an alert contact in a monitor.
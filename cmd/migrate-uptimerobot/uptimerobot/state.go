@@ -0,0 +1,124 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package uptimerobot
+
+import (
+	"strings"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/tfstate"
+)
+
+// Resource types of the UptimeRobot Terraform provider (louy/uptimerobot).
+const (
+	MonitorResourceType      = "uptimerobot_monitor"
+	AlertContactResourceType = "uptimerobot_alert_contact"
+)
+
+// The provider stores enumerations by name; these map them to the numeric
+// codes used in Monitor and AlertContact.
+var (
+	stateMonitorTypes = map[string]int{"http": 1, "keyword": 2, "ping": 3, "port": 4, "heartbeat": 5}
+	stateSubTypes     = map[string]int{"http": 1, "https": 2, "ftp": 3, "smtp": 4, "pop3": 5, "imap": 6, "custom": 99}
+	stateKeywordTypes = map[string]int{"exists": 1, "not exists": 2}
+	stateHTTPMethods  = map[string]int{"GET": 1, "POST": 2, "PUT": 3, "PATCH": 4, "DELETE": 5, "HEAD": 6}
+	stateContactTypes = map[string]int{
+		"email": 2, "sms": 3, "webhook": 4, "twitter-dm": 5, "boxcar": 6, "pushbullet": 8, "zapier": 9,
+		"pushover": 10, "slack": 11, "hipchat": 12, "pagerduty": 14, "opsgenie": 15, "victorops": 16,
+	}
+)
+
+// FromState returns the monitors and alert contacts managed in a Terraform
+// state, in the shape the API returns them, together with the state
+// instances the monitors were read from. Alert contacts are not migrated as
+// resources, so their instances are left for the user to keep or remove.
+func FromState(s *tfstate.State) ([]Monitor, []AlertContact, []tfstate.Instance) {
+	monitorInstances := s.Instances(MonitorResourceType)
+	contactInstances := s.Instances(AlertContactResourceType)
+
+	monitors := make([]Monitor, 0, len(monitorInstances))
+	for _, inst := range monitorInstances {
+		monitors = append(monitors, monitorFromState(inst))
+	}
+
+	contacts := make([]AlertContact, 0, len(contactInstances))
+	for _, inst := range contactInstances {
+		contacts = append(contacts, AlertContact{
+			ID:           inst.ID(),
+			FriendlyName: inst.String("friendly_name"),
+			Type:         stateContactTypes[strings.ToLower(inst.String("type"))],
+			Value:        inst.String("value"),
+			Status:       contactStatusFromState(inst.String("status")),
+		})
+	}
+
+	return monitors, contacts, monitorInstances
+}
+
+// monitorFromState maps uptimerobot_monitor attributes to a Monitor.
+func monitorFromState(inst tfstate.Instance) Monitor {
+	m := Monitor{
+		ID:           inst.Int("id"),
+		FriendlyName: inst.String("friendly_name"),
+		URL:          inst.String("url"),
+		Type:         stateMonitorTypes[strings.ToLower(inst.String("type"))],
+		Interval:     inst.Int("interval"),
+		Status:       monitorStatusFromState(inst.String("status")),
+	}
+
+	if v, ok := stateSubTypes[strings.ToLower(inst.String("sub_type"))]; ok {
+		m.SubType = flexibleIntPtr(v)
+	}
+	if v, ok := stateKeywordTypes[strings.ToLower(inst.String("keyword_type"))]; ok {
+		m.KeywordType = flexibleIntPtr(v)
+	}
+	if v := inst.String("keyword_value"); v != "" {
+		m.KeywordValue = &v
+	}
+	if v, ok := stateHTTPMethods[strings.ToUpper(inst.String("http_method"))]; ok {
+		m.HTTPMethod = flexibleIntPtr(v)
+	}
+	if v := inst.Int("port"); v != 0 {
+		m.Port = flexibleIntPtr(v)
+	}
+	if v := inst.Int("timeout"); v != 0 {
+		m.Timeout = &v
+	}
+
+	for _, c := range inst.Objects("alert_contact") {
+		m.AlertContacts = append(m.AlertContacts, AlertContactRef{
+			ID:         c.String("id"),
+			Threshold:  c.Int("threshold"),
+			Recurrence: c.Int("recurrence"),
+		})
+	}
+	return m
+}
+
+// monitorStatusFromState maps the provider's status name to the API value.
+// Only paused matters for the migration; any other status is reported as
+// not checked yet.
+func monitorStatusFromState(status string) int {
+	if strings.EqualFold(status, "paused") {
+		return 0
+	}
+	return 1
+}
+
+// contactStatusFromState maps the provider's alert contact status name to
+// the API value (0 = not activated, 1 = paused, 2 = active).
+func contactStatusFromState(status string) int {
+	switch strings.ToLower(status) {
+	case "not activated":
+		return 0
+	case "paused":
+		return 1
+	default:
+		return 2
+	}
+}
+
+func flexibleIntPtr(v int) *FlexibleInt {
+	fi := FlexibleInt(v)
+	return &fi
+}
@@ -0,0 +1,68 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package uptimerobot
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/tfstate"
+)
+
+func TestFromState(t *testing.T) {
+	state, err := tfstate.Parse([]byte(`{
+  "version": 4,
+  "resources": [
+    {
+      "mode": "managed", "type": "uptimerobot_monitor", "name": "site",
+      "instances": [
+        {"index_key": "home", "attributes": {
+          "id": "778899", "friendly_name": "Homepage", "type": "keyword", "url": "https://www.example.com",
+          "interval": 300, "keyword_type": "not exists", "keyword_value": "error", "status": "paused",
+          "alert_contact": [{"id": "555", "threshold": 2, "recurrence": 0}]
+        }},
+        {"index_key": "db", "attributes": {
+          "id": "778900", "friendly_name": "Database", "type": "port", "url": "db.example.com",
+          "sub_type": "custom", "port": 5432, "interval": 60, "status": "up"
+        }}
+      ]
+    },
+    {
+      "mode": "managed", "type": "uptimerobot_alert_contact", "name": "ops",
+      "instances": [{"attributes": {"id": "555", "friendly_name": "Ops", "type": "email", "value": "ops@example.com", "status": "active"}}]
+    }
+  ]
+}`))
+	require.NoError(t, err)
+
+	monitors, contacts, instances := FromState(state)
+	require.Len(t, monitors, 2)
+
+	home := monitors[0]
+	assert.Equal(t, 778899, home.ID)
+	assert.Equal(t, 2, home.Type)
+	assert.Equal(t, 300, home.Interval)
+	assert.Equal(t, 0, home.Status, "paused")
+	require.NotNil(t, home.KeywordType)
+	assert.Equal(t, FlexibleInt(2), *home.KeywordType)
+	require.NotNil(t, home.KeywordValue)
+	assert.Equal(t, "error", *home.KeywordValue)
+	assert.Equal(t, []AlertContactRef{{ID: "555", Threshold: 2}}, home.AlertContacts)
+
+	db := monitors[1]
+	assert.Equal(t, 4, db.Type)
+	assert.Equal(t, 1, db.Status)
+	require.NotNil(t, db.SubType)
+	assert.Equal(t, FlexibleInt(99), *db.SubType)
+	require.NotNil(t, db.Port)
+	assert.Equal(t, FlexibleInt(5432), *db.Port)
+	assert.Nil(t, db.KeywordType)
+
+	assert.Equal(t, []AlertContact{{ID: "555", FriendlyName: "Ops", Type: 2, Value: "ops@example.com", Status: 2}}, contacts)
+
+	require.Len(t, instances, 2, "alert contacts are not migrated, so they are not removed from state")
+	assert.Equal(t, `uptimerobot_monitor.site["home"]`, instances[0].Address)
+}
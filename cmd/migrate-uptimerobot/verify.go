@@ -0,0 +1,94 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/converter"
+	"github.com/develeap/terraform-provider-hyperping/cmd/migrate-uptimerobot/uptimerobot"
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/verify"
+)
+
+// runVerification compares UptimeRobot monitors with the monitors that exist
+// in Hyperping and writes a field-by-field equivalence report.
+func (r *runner) runVerification(monitors []uptimerobot.Monitor) int {
+	if *verbose {
+		fmt.Fprintln(os.Stderr, "Fetching Hyperping monitors for verification...")
+	}
+
+	destination, err := clientenv.NewClient(r.hpAPIKey).ListMonitors(r.ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error fetching Hyperping monitors: %v\n", err)
+		return 1
+	}
+
+	idMap, err := mapping.Load(*mappingFile)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	result := verify.Monitors("UptimeRobot", verify.ApplyMapping(verifySources(monitors), idMap), destination)
+	result.PrintSummary(os.Stderr)
+
+	if err := result.WriteJSON(*verifyReport); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "  ✓ Verification report written to %s\n", *verifyReport)
+
+	if recorded := result.RecordUUIDs(idMap); recorded > 0 {
+		if err := idMap.Write(*mappingFile); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+			return 1
+		}
+		fmt.Fprintf(os.Stderr, "  ✓ Recorded %d Hyperping UUIDs in %s\n", recorded, *mappingFile)
+	}
+
+	if result.HasProblems() {
+		return 1
+	}
+	return 0
+}
+
+// verifySources builds verification inputs from the raw UptimeRobot
+// monitors, using the converter for names, URLs and protocol vocabulary.
+// UptimeRobot does not expose probe locations, so regions are not compared.
+// Monitors skipped by the mapping overrides are not verified.
+func verifySources(monitors []uptimerobot.Monitor) []verify.Source {
+	converted := converter.NewConverter().WithNameTemplate(nameTemplate).WithOverrides(overrides).Convert(monitors, nil)
+	byID := make(map[int]converter.HyperpingMonitor, len(converted.Monitors))
+	for _, m := range converted.Monitors {
+		byID[m.OriginalID] = m
+	}
+
+	sources := make([]verify.Source, 0, len(converted.Monitors))
+	for _, m := range monitors {
+		cm, ok := byID[m.ID]
+		if !ok {
+			continue
+		}
+
+		timeout := 0
+		if m.Timeout != nil {
+			timeout = *m.Timeout
+		}
+
+		sources = append(sources, verify.Source{
+			ID:        strconv.Itoa(m.ID),
+			Name:      cm.Name,
+			URL:       cm.URL,
+			Protocol:  cm.Protocol,
+			Frequency: m.Interval,
+			Port:      cm.Port,
+			Timeout:   timeout,
+		})
+	}
+	return sources
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// APIClient is the subset of the Hyperping client used by purge.
+type APIClient interface {
+	ListMonitors(ctx context.Context) ([]hyperping.Monitor, error)
+	ListHealthchecks(ctx context.Context) ([]hyperping.Healthcheck, error)
+	ListStatusPages(ctx context.Context, page *int, search *string) (*hyperping.StatusPagePaginatedResponse, error)
+	ListIncidents(ctx context.Context) ([]hyperping.Incident, error)
+	ListMaintenance(ctx context.Context) ([]hyperping.Maintenance, error)
+
+	DeleteMonitor(ctx context.Context, uuid string) error
+	DeleteHealthcheck(ctx context.Context, uuid string) error
+	DeleteStatusPage(ctx context.Context, uuid string) error
+	DeleteIncident(ctx context.Context, id string) error
+	DeleteMaintenance(ctx context.Context, id string) error
+}
+
+// Resource types purge can delete, in deletion order. Incidents and
+// maintenance windows reference status pages and monitors, so they go first.
+const (
+	typeIncident    = "hyperping_incident"
+	typeMaintenance = "hyperping_maintenance"
+	typeStatusPage  = "hyperping_statuspage"
+	typeHealthcheck = "hyperping_healthcheck"
+	typeMonitor     = "hyperping_monitor"
+)
+
+var deleteOrder = []string{typeIncident, typeMaintenance, typeStatusPage, typeHealthcheck, typeMonitor}
+
+// resourceFlags maps --resources values to resource types.
+var resourceFlags = map[string]string{
+	"incidents":    typeIncident,
+	"maintenance":  typeMaintenance,
+	"statuspages":  typeStatusPage,
+	"healthchecks": typeHealthcheck,
+	"monitors":     typeMonitor,
+}
+
+// maxStatusPagePages bounds status page pagination in case the API keeps
+// reporting a next page.
+const maxStatusPagePages = 100
+
+// parseResources parses a comma-separated --resources value into resource
+// types, in deletion order.
+func parseResources(s string) ([]string, error) {
+	if s == "" || s == "all" {
+		return deleteOrder, nil
+	}
+
+	selected := make(map[string]bool)
+	for _, name := range strings.Split(s, ",") {
+		name = strings.TrimSpace(name)
+		resourceType, ok := resourceFlags[name]
+		if !ok {
+			return nil, fmt.Errorf("invalid --resources value %q (allowed: all, incidents, maintenance, statuspages, healthchecks, monitors)", name)
+		}
+		selected[resourceType] = true
+	}
+
+	types := make([]string, 0, len(selected))
+	for _, resourceType := range deleteOrder {
+		if selected[resourceType] {
+			types = append(types, resourceType)
+		}
+	}
+	return types, nil
+}
+
+// Collect lists the resources of the given types as candidates.
+func Collect(ctx context.Context, client APIClient, resourceTypes []string) ([]Candidate, error) {
+	var candidates []Candidate
+	for _, resourceType := range resourceTypes {
+		var (
+			found []Candidate
+			err   error
+		)
+		switch resourceType {
+		case typeIncident:
+			found, err = collectIncidents(ctx, client)
+		case typeMaintenance:
+			found, err = collectMaintenance(ctx, client)
+		case typeStatusPage:
+			found, err = collectStatusPages(ctx, client)
+		case typeHealthcheck:
+			found, err = collectHealthchecks(ctx, client)
+		case typeMonitor:
+			found, err = collectMonitors(ctx, client)
+		}
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, found...)
+	}
+	return candidates, nil
+}
+
+func collectIncidents(ctx context.Context, client APIClient) ([]Candidate, error) {
+	incidents, err := client.ListIncidents(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing incidents: %w", err)
+	}
+	candidates := make([]Candidate, 0, len(incidents))
+	for _, i := range incidents {
+		// Incidents report no creation time; their date is used instead.
+		candidates = append(candidates, Candidate{
+			Type:      typeIncident,
+			ID:        i.UUID,
+			Name:      i.Title.En,
+			CreatedAt: parseAPITime(i.Date),
+			Resource:  i,
+		})
+	}
+	return candidates, nil
+}
+
+func collectMaintenance(ctx context.Context, client APIClient) ([]Candidate, error) {
+	windows, err := client.ListMaintenance(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing maintenance windows: %w", err)
+	}
+	candidates := make([]Candidate, 0, len(windows))
+	for _, m := range windows {
+		candidates = append(candidates, Candidate{
+			Type:      typeMaintenance,
+			ID:        m.UUID,
+			Name:      m.Name,
+			CreatedAt: parseAPITime(m.CreatedAt),
+			Resource:  m,
+		})
+	}
+	return candidates, nil
+}
+
+func collectStatusPages(ctx context.Context, client APIClient) ([]Candidate, error) {
+	var candidates []Candidate
+	for page := 0; page < maxStatusPagePages; page++ {
+		resp, err := client.ListStatusPages(ctx, &page, nil)
+		if err != nil {
+			return nil, fmt.Errorf("listing status pages: %w", err)
+		}
+		for _, p := range resp.StatusPages {
+			candidates = append(candidates, Candidate{
+				Type:     typeStatusPage,
+				ID:       p.UUID,
+				Name:     p.Name,
+				Resource: p,
+			})
+		}
+		if !resp.HasNextPage {
+			break
+		}
+	}
+	return candidates, nil
+}
+
+func collectHealthchecks(ctx context.Context, client APIClient) ([]Candidate, error) {
+	healthchecks, err := client.ListHealthchecks(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing healthchecks: %w", err)
+	}
+	candidates := make([]Candidate, 0, len(healthchecks))
+	for _, h := range healthchecks {
+		paused := h.IsPaused
+		candidates = append(candidates, Candidate{
+			Type:      typeHealthcheck,
+			ID:        h.UUID,
+			Name:      h.Name,
+			CreatedAt: parseAPITime(h.CreatedAt),
+			Paused:    &paused,
+			Resource:  h,
+		})
+	}
+	return candidates, nil
+}
+
+func collectMonitors(ctx context.Context, client APIClient) ([]Candidate, error) {
+	monitors, err := client.ListMonitors(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("listing monitors: %w", err)
+	}
+	candidates := make([]Candidate, 0, len(monitors))
+	for _, m := range monitors {
+		paused := m.Paused
+		candidates = append(candidates, Candidate{
+			Type:     typeMonitor,
+			ID:       m.UUID,
+			Name:     m.Name,
+			Paused:   &paused,
+			Resource: m,
+		})
+	}
+	return candidates, nil
+}
+
+// deleteResource deletes a single candidate.
+func deleteResource(ctx context.Context, client APIClient, c Candidate) error {
+	switch c.Type {
+	case typeIncident:
+		return client.DeleteIncident(ctx, c.ID)
+	case typeMaintenance:
+		return client.DeleteMaintenance(ctx, c.ID)
+	case typeStatusPage:
+		return client.DeleteStatusPage(ctx, c.ID)
+	case typeHealthcheck:
+		return client.DeleteHealthcheck(ctx, c.ID)
+	case typeMonitor:
+		return client.DeleteMonitor(ctx, c.ID)
+	default:
+		return fmt.Errorf("unsupported resource type %s", c.Type)
+	}
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Candidate is a Hyperping resource that purge may delete.
+type Candidate struct {
+	Type string // Terraform resource type, e.g. hyperping_monitor
+	ID   string
+	Name string
+
+	// CreatedAt is zero when the API does not report a creation time.
+	CreatedAt time.Time
+	// Paused is nil for resources that cannot be paused.
+	Paused *bool
+
+	// Resource is the API object, written to the undo file on deletion.
+	Resource interface{}
+}
+
+// Filter selects the candidates to delete. Every configured criterion must
+// match.
+type Filter struct {
+	NamePattern    *regexp.Regexp
+	ExcludePattern *regexp.Regexp
+	CreatedBefore  time.Time
+	PausedOnly     bool
+}
+
+// NewFilter creates a filter from command-line arguments. At least one of
+// the name, created-before, or paused criteria is required, so a purge never
+// selects every resource in the account.
+func NewFilter(namePattern, excludePattern, createdBefore string, pausedOnly bool, now time.Time) (*Filter, error) {
+	if namePattern == "" && createdBefore == "" && !pausedOnly {
+		return nil, errors.New("at least one of --name, --created-before, or --paused is required")
+	}
+
+	f := &Filter{PausedOnly: pausedOnly}
+
+	if namePattern != "" {
+		re, err := regexp.Compile(namePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --name pattern: %w", err)
+		}
+		f.NamePattern = re
+	}
+
+	if excludePattern != "" {
+		re, err := regexp.Compile(excludePattern)
+		if err != nil {
+			return nil, fmt.Errorf("invalid --exclude pattern: %w", err)
+		}
+		f.ExcludePattern = re
+	}
+
+	if createdBefore != "" {
+		t, err := parseCreatedBefore(createdBefore, now)
+		if err != nil {
+			return nil, err
+		}
+		f.CreatedBefore = t
+	}
+
+	return f, nil
+}
+
+// Matches reports whether the candidate meets every criterion. Under
+// --created-before, a resource without a creation time never matches, and
+// under --paused, neither does a resource that cannot be paused.
+func (f *Filter) Matches(c Candidate) bool {
+	if f.NamePattern != nil && !f.NamePattern.MatchString(c.Name) {
+		return false
+	}
+	if f.ExcludePattern != nil && f.ExcludePattern.MatchString(c.Name) {
+		return false
+	}
+	if !f.CreatedBefore.IsZero() && (c.CreatedAt.IsZero() || !c.CreatedAt.Before(f.CreatedBefore)) {
+		return false
+	}
+	if f.PausedOnly && (c.Paused == nil || !*c.Paused) {
+		return false
+	}
+	return true
+}
+
+// Apply returns the candidates that match the filter.
+func (f *Filter) Apply(candidates []Candidate) []Candidate {
+	matched := make([]Candidate, 0, len(candidates))
+	for _, c := range candidates {
+		if f.Matches(c) {
+			matched = append(matched, c)
+		}
+	}
+	return matched
+}
+
+// parseCreatedBefore accepts an RFC 3339 timestamp, a date (2006-01-02, UTC
+// midnight), or an age such as 72h or 7d, counted back from now.
+func parseCreatedBefore(s string, now time.Time) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	if t, err := time.Parse(time.DateOnly, s); err == nil {
+		return t, nil
+	}
+
+	if days, ok := strings.CutSuffix(s, "d"); ok {
+		if n, err := strconv.Atoi(days); err == nil && n > 0 {
+			return now.AddDate(0, 0, -n), nil
+		}
+	} else if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return now.Add(-d), nil
+	}
+
+	return time.Time{}, fmt.Errorf("invalid --created-before %q: use an RFC 3339 timestamp, a date (2006-01-02), or an age such as 72h or 7d", s)
+}
+
+// parseAPITime parses a timestamp reported by the API. Unparseable or empty
+// values yield the zero time, which --created-before never matches.
+func parseAPITime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
@@ -0,0 +1,153 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+	"time"
+)
+
+var testNow = time.Date(2026, 3, 10, 12, 0, 0, 0, time.UTC)
+
+func TestNewFilter(t *testing.T) {
+	tests := []struct {
+		name          string
+		namePattern   string
+		exclude       string
+		createdBefore string
+		paused        bool
+		wantErr       bool
+		wantBefore    time.Time
+	}{
+		{name: "no criteria", wantErr: true},
+		{name: "exclude alone is not a criterion", exclude: "^prod-", wantErr: true},
+		{name: "name", namePattern: "^tf-acc-test-"},
+		{name: "invalid name", namePattern: "[invalid(", wantErr: true},
+		{name: "invalid exclude", namePattern: "x", exclude: "[invalid(", wantErr: true},
+		{name: "paused", paused: true},
+		{name: "rfc3339", createdBefore: "2026-03-01T08:00:00Z", wantBefore: time.Date(2026, 3, 1, 8, 0, 0, 0, time.UTC)},
+		{name: "date", createdBefore: "2026-03-01", wantBefore: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)},
+		{name: "hours", createdBefore: "72h", wantBefore: testNow.Add(-72 * time.Hour)},
+		{name: "days", createdBefore: "7d", wantBefore: testNow.AddDate(0, 0, -7)},
+		{name: "zero age", createdBefore: "0d", wantErr: true},
+		{name: "invalid time", createdBefore: "last week", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFilter(tt.namePattern, tt.exclude, tt.createdBefore, tt.paused, testNow)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewFilter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && !f.CreatedBefore.Equal(tt.wantBefore) {
+				t.Errorf("CreatedBefore = %v, want %v", f.CreatedBefore, tt.wantBefore)
+			}
+		})
+	}
+}
+
+func TestFilter_Matches(t *testing.T) {
+	paused, active := true, false
+	old := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	recent := time.Date(2026, 3, 9, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name          string
+		namePattern   string
+		exclude       string
+		createdBefore string
+		paused        bool
+		candidate     Candidate
+		want          bool
+	}{
+		{
+			name:        "name matches",
+			namePattern: "^tf-acc-test-",
+			candidate:   Candidate{Name: "tf-acc-test-api"},
+			want:        true,
+		},
+		{
+			name:        "name does not match",
+			namePattern: "^tf-acc-test-",
+			candidate:   Candidate{Name: "prod-api"},
+		},
+		{
+			name:        "excluded",
+			namePattern: "^tf-acc-test-",
+			exclude:     "keep",
+			candidate:   Candidate{Name: "tf-acc-test-keep"},
+		},
+		{
+			name:          "created before",
+			createdBefore: "2026-02-01",
+			candidate:     Candidate{Name: "hc", CreatedAt: old},
+			want:          true,
+		},
+		{
+			name:          "created after",
+			createdBefore: "2026-02-01",
+			candidate:     Candidate{Name: "hc", CreatedAt: recent},
+		},
+		{
+			name:          "no creation time never matches created-before",
+			createdBefore: "2026-02-01",
+			candidate:     Candidate{Name: "monitor"},
+		},
+		{
+			name:      "paused",
+			paused:    true,
+			candidate: Candidate{Name: "monitor", Paused: &paused},
+			want:      true,
+		},
+		{
+			name:      "active",
+			paused:    true,
+			candidate: Candidate{Name: "monitor", Paused: &active},
+		},
+		{
+			name:      "cannot be paused",
+			paused:    true,
+			candidate: Candidate{Name: "status page"},
+		},
+		{
+			name:          "all criteria must match",
+			namePattern:   "^test-",
+			createdBefore: "2026-02-01",
+			paused:        true,
+			candidate:     Candidate{Name: "test-hc", CreatedAt: old, Paused: &active},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f, err := NewFilter(tt.namePattern, tt.exclude, tt.createdBefore, tt.paused, testNow)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := f.Matches(tt.candidate); got != tt.want {
+				t.Errorf("Matches() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseResources(t *testing.T) {
+	all, err := parseResources("all")
+	if err != nil || len(all) != len(deleteOrder) {
+		t.Fatalf("parseResources(all) = %v, %v", all, err)
+	}
+
+	got, err := parseResources("monitors, incidents")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Returned in deletion order, not flag order.
+	if len(got) != 2 || got[0] != typeIncident || got[1] != typeMonitor {
+		t.Errorf("parseResources() = %v, want [%s %s]", got, typeIncident, typeMonitor)
+	}
+
+	if _, err := parseResources("monitors,outages"); err == nil {
+		t.Error("expected an error for an unsupported resource")
+	}
+}
@@ -0,0 +1,177 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// purge deletes Hyperping resources that match name, creation time, and
+// paused filters, for cleaning up test debris in shared accounts.
+//
+// Usage:
+//
+//	export HYPERPING_API_KEY="sk_your_api_key"
+//	go run ./cmd/purge --name='^tf-acc-test-' --dry-run
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+	"github.com/develeap/terraform-provider-hyperping/pkg/interactive"
+)
+
+var (
+	// Filter flags
+	namePattern    = flag.String("name", "", "Delete resources whose name matches this regex")
+	excludePattern = flag.String("exclude", "", "Keep resources whose name matches this regex")
+	createdBefore  = flag.String("created-before", "", "Delete resources created before this time: RFC 3339, a date (2006-01-02), or an age such as 72h or 7d")
+	pausedOnly     = flag.Bool("paused", false, "Delete paused monitors and healthchecks only")
+	resources      = flag.String("resources", "all", "Resources to purge: all, or a comma-separated list of incidents, maintenance, statuspages, healthchecks, monitors")
+
+	// Execution flags
+	dryRun   = flag.Bool("dry-run", false, "List the resources that would be deleted without deleting them")
+	yes      = flag.Bool("yes", false, "Delete without the confirmation prompt (required in non-interactive sessions)")
+	parallel = flag.Int("parallel", defaultWorkers, fmt.Sprintf("Number of concurrent deletions (max %d)", maxWorkers))
+	undoFile = flag.String("undo-file", "", "File listing the deleted resources with their definitions (default: purge-undo-<timestamp>.json)")
+	baseURL  = flag.String("base-url", "https://api.hyperping.io", "Hyperping API base URL")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: purge [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Deletes Hyperping resources matching the given filters. At least one of\n")
+		fmt.Fprintf(os.Stderr, "--name, --created-before, or --paused is required.\n\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nEXAMPLES:\n")
+		fmt.Fprintf(os.Stderr, "  # Preview acceptance test debris\n")
+		fmt.Fprintf(os.Stderr, "  purge --name='^tf-acc-test-' --dry-run\n\n")
+		fmt.Fprintf(os.Stderr, "  # Delete test healthchecks older than a week, without prompting\n")
+		fmt.Fprintf(os.Stderr, "  purge --name='^test-' --created-before=7d --resources=healthchecks --yes\n\n")
+		fmt.Fprintf(os.Stderr, "  # Delete paused monitors except production ones\n")
+		fmt.Fprintf(os.Stderr, "  purge --paused --resources=monitors --exclude='^prod-'\n\n")
+	}
+	os.Exit(run())
+}
+
+func run() int {
+	flag.Parse()
+
+	if err := clientenv.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	now := time.Now()
+	filter, err := NewFilter(*namePattern, *excludePattern, *createdBefore, *pausedOnly, now)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	resourceTypes, err := parseResources(*resources)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	if *parallel < 1 || *parallel > maxWorkers {
+		fmt.Fprintf(os.Stderr, "Error: --parallel must be between 1 and %d\n", maxWorkers)
+		return 1
+	}
+	if !*dryRun && !*yes && !interactive.IsInteractive() {
+		fmt.Fprintln(os.Stderr, "Error: refusing to delete without confirmation in a non-interactive session; pass --yes or --dry-run")
+		return 1
+	}
+
+	apiKey := os.Getenv("HYPERPING_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: HYPERPING_API_KEY environment variable is required")
+		return 1
+	}
+	client := clientenv.NewClient(apiKey, hyperping.WithBaseURL(*baseURL))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Minute)
+	defer cancel()
+
+	candidates, err := Collect(ctx, client, resourceTypes)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	matched := filter.Apply(candidates)
+
+	printPlan(os.Stdout, matched, len(candidates))
+	if len(matched) == 0 || *dryRun {
+		return 0
+	}
+
+	if !*yes && !confirm(len(matched)) {
+		fmt.Println("Purge cancelled")
+		return 0
+	}
+
+	path := *undoFile
+	if path == "" {
+		path = defaultUndoFile(now)
+	}
+	undo, err := NewUndoLog(path, UndoFilters{
+		Name:          *namePattern,
+		Exclude:       *excludePattern,
+		CreatedBefore: *createdBefore,
+		Paused:        *pausedOnly,
+		Resources:     resourceTypes,
+	})
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	fmt.Printf("\nDeleting %d resource(s) with %d worker(s)...\n", len(matched), *parallel)
+	summary := NewPurger(client, *parallel, undo, os.Stdout).Purge(ctx, matched)
+
+	fmt.Printf("\nDeleted: %d, already gone: %d, failed: %d\n", summary.Deleted, summary.Gone, len(summary.Failed))
+	if summary.Deleted > 0 {
+		fmt.Printf("Undo file: %s\n", path)
+	}
+	if len(summary.Failed) > 0 {
+		return 1
+	}
+	return 0
+}
+
+// printPlan lists the matched resources grouped by type, in deletion order.
+func printPlan(w io.Writer, matched []Candidate, total int) {
+	fmt.Fprintf(w, "Matched %d of %d resource(s)\n", len(matched), total)
+
+	byType := make(map[string][]Candidate)
+	for _, c := range matched {
+		byType[c.Type] = append(byType[c.Type], c)
+	}
+	for _, resourceType := range deleteOrder {
+		group := byType[resourceType]
+		if len(group) == 0 {
+			continue
+		}
+		sort.Slice(group, func(i, j int) bool { return group[i].Name < group[j].Name })
+
+		fmt.Fprintf(w, "\n%s (%d):\n", resourceType, len(group))
+		for _, c := range group {
+			fmt.Fprintf(w, "  %s  %s\n", c.ID, c.Name)
+		}
+	}
+}
+
+// confirm asks the user to type "yes" before deleting.
+func confirm(count int) bool {
+	fmt.Printf("\nThis permanently deletes %d resource(s) from Hyperping.\n", count)
+	fmt.Print("Type 'yes' to proceed: ")
+
+	var response string
+	_, _ = fmt.Scanln(&response) //nolint:errcheck // #nosec G104 -- user input is optional, empty default is safe
+
+	return strings.TrimSpace(response) == "yes"
+}
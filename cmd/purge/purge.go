@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+const (
+	defaultWorkers = 4
+	maxWorkers     = 10
+
+	// maxRateLimitRetries is how many times a deletion is retried after the
+	// client's own retries for a 429 are exhausted.
+	maxRateLimitRetries = 3
+	// defaultRateLimitWait is the pause after a 429 without Retry-After.
+	defaultRateLimitWait = 30 * time.Second
+)
+
+// DeleteResult is the outcome of deleting one candidate.
+type DeleteResult struct {
+	Candidate Candidate
+	// Gone is true when the resource was already deleted.
+	Gone  bool
+	Error error
+}
+
+// Summary holds the outcome of a purge.
+type Summary struct {
+	Deleted int
+	Gone    int
+	Failed  []DeleteResult
+}
+
+// Purger deletes candidates concurrently. A 429 that outlasts the client's
+// retries pauses every worker, not just the one that hit it, so the workers
+// back off together instead of extending the rate limit.
+type Purger struct {
+	client  APIClient
+	workers int
+	undo    *UndoLog
+	out     io.Writer
+	gate    *rateGate
+}
+
+// NewPurger creates a purger recording deletions in undo.
+func NewPurger(client APIClient, workers int, undo *UndoLog, out io.Writer) *Purger {
+	if workers <= 0 {
+		workers = defaultWorkers
+	}
+	if workers > maxWorkers {
+		workers = maxWorkers
+	}
+	return &Purger{
+		client:  client,
+		workers: workers,
+		undo:    undo,
+		out:     out,
+		gate:    &rateGate{},
+	}
+}
+
+// Purge deletes the candidates one resource type at a time, in deletion
+// order, so dependents are gone before what they reference.
+func (p *Purger) Purge(ctx context.Context, candidates []Candidate) *Summary {
+	byType := make(map[string][]Candidate)
+	for _, c := range candidates {
+		byType[c.Type] = append(byType[c.Type], c)
+	}
+
+	summary := &Summary{}
+	for _, resourceType := range deleteOrder {
+		for _, result := range p.deleteAll(ctx, byType[resourceType]) {
+			switch {
+			case result.Error != nil:
+				summary.Failed = append(summary.Failed, result)
+				fmt.Fprintf(p.out, "  ✗ %s %s (%s): %v\n", result.Candidate.Type, result.Candidate.Name, result.Candidate.ID, result.Error)
+			case result.Gone:
+				summary.Gone++
+				fmt.Fprintf(p.out, "  - %s %s (%s): already deleted\n", result.Candidate.Type, result.Candidate.Name, result.Candidate.ID)
+			default:
+				summary.Deleted++
+				fmt.Fprintf(p.out, "  ✓ %s %s (%s)\n", result.Candidate.Type, result.Candidate.Name, result.Candidate.ID)
+			}
+		}
+	}
+	return summary
+}
+
+// deleteAll deletes candidates of one type with the worker pool.
+func (p *Purger) deleteAll(ctx context.Context, candidates []Candidate) []DeleteResult {
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	jobs := make(chan Candidate, len(candidates))
+	for _, c := range candidates {
+		jobs <- c
+	}
+	close(jobs)
+
+	results := make(chan DeleteResult, len(candidates))
+	var wg sync.WaitGroup
+	for i := 0; i < p.workers; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for c := range jobs {
+				results <- p.delete(ctx, c)
+			}
+		}()
+	}
+	wg.Wait()
+	close(results)
+
+	collected := make([]DeleteResult, 0, len(candidates))
+	for result := range results {
+		collected = append(collected, result)
+	}
+	return collected
+}
+
+// delete deletes one candidate, waiting out rate limits, and records it in
+// the undo log.
+func (p *Purger) delete(ctx context.Context, c Candidate) DeleteResult {
+	for attempt := 0; ; attempt++ {
+		if err := p.gate.wait(ctx); err != nil {
+			return DeleteResult{Candidate: c, Error: err}
+		}
+
+		err := deleteResource(ctx, p.client, c)
+		switch {
+		case err == nil:
+			if undoErr := p.undo.Record(c); undoErr != nil {
+				return DeleteResult{Candidate: c, Error: fmt.Errorf("deleted, but %w", undoErr)}
+			}
+			return DeleteResult{Candidate: c}
+		case hyperping.IsNotFound(err):
+			return DeleteResult{Candidate: c, Gone: true}
+		case hyperping.IsRateLimited(err) && attempt < maxRateLimitRetries:
+			p.gate.hold(retryAfter(err))
+		default:
+			return DeleteResult{Candidate: c, Error: err}
+		}
+	}
+}
+
+// retryAfter returns the wait requested by a 429 response.
+func retryAfter(err error) time.Duration {
+	var apiErr *hyperping.APIError
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return time.Duration(apiErr.RetryAfter) * time.Second
+	}
+	return defaultRateLimitWait
+}
+
+// rateGate holds every worker until a rate limit has passed.
+type rateGate struct {
+	mu    sync.Mutex
+	until time.Time
+}
+
+// hold blocks new requests for d. A shorter hold never shortens a longer one.
+func (g *rateGate) hold(d time.Duration) {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if until := time.Now().Add(d); until.After(g.until) {
+		g.until = until
+	}
+}
+
+// wait blocks until the gate is open or ctx is done.
+func (g *rateGate) wait(ctx context.Context) error {
+	for {
+		g.mu.Lock()
+		remaining := time.Until(g.until)
+		g.mu.Unlock()
+		if remaining <= 0 {
+			return nil
+		}
+
+		timer := time.NewTimer(remaining)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
@@ -0,0 +1,249 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// fakeClient serves fixed resources and records deletions.
+type fakeClient struct {
+	monitors     []hyperping.Monitor
+	healthchecks []hyperping.Healthcheck
+	statusPages  [][]hyperping.StatusPage // one slice per page
+	incidents    []hyperping.Incident
+	maintenance  []hyperping.Maintenance
+
+	mu          sync.Mutex
+	deleted     []string
+	errs        map[string][]error // queued errors per ID, returned before success
+	rateLimited int
+}
+
+func (f *fakeClient) ListMonitors(_ context.Context) ([]hyperping.Monitor, error) {
+	return f.monitors, nil
+}
+
+func (f *fakeClient) ListHealthchecks(_ context.Context) ([]hyperping.Healthcheck, error) {
+	return f.healthchecks, nil
+}
+
+func (f *fakeClient) ListStatusPages(_ context.Context, page *int, _ *string) (*hyperping.StatusPagePaginatedResponse, error) {
+	if *page >= len(f.statusPages) {
+		return &hyperping.StatusPagePaginatedResponse{}, nil
+	}
+	return &hyperping.StatusPagePaginatedResponse{
+		StatusPages: f.statusPages[*page],
+		HasNextPage: *page < len(f.statusPages)-1,
+		Page:        *page,
+	}, nil
+}
+
+func (f *fakeClient) ListIncidents(_ context.Context) ([]hyperping.Incident, error) {
+	return f.incidents, nil
+}
+
+func (f *fakeClient) ListMaintenance(_ context.Context) ([]hyperping.Maintenance, error) {
+	return f.maintenance, nil
+}
+
+func (f *fakeClient) remove(id string) error {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	if queued := f.errs[id]; len(queued) > 0 {
+		f.errs[id] = queued[1:]
+		if hyperping.IsRateLimited(queued[0]) {
+			f.rateLimited++
+		}
+		return queued[0]
+	}
+	f.deleted = append(f.deleted, id)
+	return nil
+}
+
+func (f *fakeClient) DeleteMonitor(_ context.Context, uuid string) error     { return f.remove(uuid) }
+func (f *fakeClient) DeleteHealthcheck(_ context.Context, uuid string) error { return f.remove(uuid) }
+func (f *fakeClient) DeleteStatusPage(_ context.Context, uuid string) error  { return f.remove(uuid) }
+func (f *fakeClient) DeleteIncident(_ context.Context, id string) error      { return f.remove(id) }
+func (f *fakeClient) DeleteMaintenance(_ context.Context, id string) error   { return f.remove(id) }
+
+func newTestUndoLog(t *testing.T) (*UndoLog, string) {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "undo.json")
+	undo, err := NewUndoLog(path, UndoFilters{Name: "^test-", Resources: deleteOrder})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return undo, path
+}
+
+func TestCollect(t *testing.T) {
+	client := &fakeClient{
+		monitors:     []hyperping.Monitor{{UUID: "mon_1", Name: "test-api", Paused: true}},
+		healthchecks: []hyperping.Healthcheck{{UUID: "tok_1", Name: "test-cron", CreatedAt: "2026-01-01T00:00:00Z"}},
+		statusPages: [][]hyperping.StatusPage{
+			{{UUID: "sp_1", Name: "test-page-1"}},
+			{{UUID: "sp_2", Name: "test-page-2"}},
+		},
+		incidents:   []hyperping.Incident{{UUID: "inci_1", Title: hyperping.LocalizedText{En: "test-incident"}}},
+		maintenance: []hyperping.Maintenance{{UUID: "mw_1", Name: "test-window"}},
+	}
+
+	candidates, err := Collect(context.Background(), client, deleteOrder)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var ids []string
+	for _, c := range candidates {
+		ids = append(ids, c.ID)
+	}
+	want := []string{"inci_1", "mw_1", "sp_1", "sp_2", "tok_1", "mon_1"}
+	if len(ids) != len(want) {
+		t.Fatalf("collected %v, want %v", ids, want)
+	}
+	for i := range want {
+		if ids[i] != want[i] {
+			t.Errorf("collected %v, want %v", ids, want)
+			break
+		}
+	}
+
+	if hc := candidates[4]; hc.CreatedAt.IsZero() || hc.Paused == nil || *hc.Paused {
+		t.Errorf("healthcheck candidate = %+v", hc)
+	}
+	if mon := candidates[5]; mon.Paused == nil || !*mon.Paused || !mon.CreatedAt.IsZero() {
+		t.Errorf("monitor candidate = %+v", mon)
+	}
+}
+
+func TestPurger_DeletesInOrderAndRecordsUndo(t *testing.T) {
+	client := &fakeClient{}
+	undo, path := newTestUndoLog(t)
+
+	candidates := []Candidate{
+		{Type: typeMonitor, ID: "mon_1", Name: "test-api", Resource: hyperping.Monitor{UUID: "mon_1", Name: "test-api"}},
+		{Type: typeStatusPage, ID: "sp_1", Name: "test-page"},
+		{Type: typeIncident, ID: "inci_1", Name: "test-incident"},
+	}
+	summary := NewPurger(client, 1, undo, io.Discard).Purge(context.Background(), candidates)
+
+	if summary.Deleted != 3 || len(summary.Failed) != 0 {
+		t.Fatalf("summary = %+v", summary)
+	}
+	want := []string{"inci_1", "sp_1", "mon_1"}
+	for i := range want {
+		if client.deleted[i] != want[i] {
+			t.Fatalf("deletion order = %v, want %v", client.deleted, want)
+		}
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved struct {
+		Filters UndoFilters `json:"filters"`
+		Deleted []struct {
+			Type     string                 `json:"type"`
+			ID       string                 `json:"id"`
+			Resource map[string]interface{} `json:"resource"`
+		} `json:"deleted"`
+	}
+	if err := json.Unmarshal(data, &saved); err != nil {
+		t.Fatal(err)
+	}
+	if saved.Filters.Name != "^test-" || len(saved.Deleted) != 3 {
+		t.Fatalf("undo file = %s", data)
+	}
+	if last := saved.Deleted[2]; last.Type != typeMonitor || last.Resource["name"] != "test-api" {
+		t.Errorf("undo entry = %+v, want the full monitor definition", last)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if perm := info.Mode().Perm(); perm != 0o600 {
+		t.Errorf("undo file mode = %o, want 600", perm)
+	}
+}
+
+func TestPurger_NotFoundAndFailures(t *testing.T) {
+	client := &fakeClient{errs: map[string][]error{
+		"mon_gone": {hyperping.NewAPIError(404, "not found")},
+		"mon_bad":  {hyperping.NewAPIError(500, "boom")},
+	}}
+	undo, _ := newTestUndoLog(t)
+
+	summary := NewPurger(client, 2, undo, io.Discard).Purge(context.Background(), []Candidate{
+		{Type: typeMonitor, ID: "mon_ok"},
+		{Type: typeMonitor, ID: "mon_gone"},
+		{Type: typeMonitor, ID: "mon_bad"},
+	})
+
+	if summary.Deleted != 1 || summary.Gone != 1 || len(summary.Failed) != 1 {
+		t.Fatalf("summary = %+v", summary)
+	}
+	if summary.Failed[0].Candidate.ID != "mon_bad" {
+		t.Errorf("failed = %+v", summary.Failed)
+	}
+	if len(undo.Deleted) != 1 || undo.Deleted[0].ID != "mon_ok" {
+		t.Errorf("undo log = %+v, want only mon_ok", undo.Deleted)
+	}
+}
+
+func TestPurger_RateLimitPausesAndRetries(t *testing.T) {
+	client := &fakeClient{errs: map[string][]error{
+		"mon_1": {hyperping.NewRateLimitError(1)},
+	}}
+	undo, _ := newTestUndoLog(t)
+
+	start := time.Now()
+	summary := NewPurger(client, 2, undo, io.Discard).Purge(context.Background(), []Candidate{
+		{Type: typeMonitor, ID: "mon_1"},
+		{Type: typeMonitor, ID: "mon_2"},
+	})
+
+	if summary.Deleted != 2 || len(summary.Failed) != 0 {
+		t.Fatalf("summary = %+v", summary)
+	}
+	if client.rateLimited != 1 {
+		t.Errorf("rate limited %d times, want 1", client.rateLimited)
+	}
+	if elapsed := time.Since(start); elapsed < time.Second {
+		t.Errorf("purge took %v, want at least the 1s Retry-After", elapsed)
+	}
+}
+
+func TestRateGate_WaitHonorsContext(t *testing.T) {
+	gate := &rateGate{}
+	gate.hold(time.Hour)
+	gate.hold(time.Millisecond) // a shorter hold does not shorten the gate
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	if err := gate.wait(ctx); err == nil {
+		t.Error("wait() returned before the hold expired")
+	}
+}
+
+func TestNewUndoLog_RefusesOverwrite(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "undo.json")
+	if err := os.WriteFile(path, []byte("{}"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := NewUndoLog(path, UndoFilters{}); err == nil {
+		t.Error("expected an error for an existing undo file")
+	}
+}
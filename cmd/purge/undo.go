@@ -0,0 +1,98 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+)
+
+// UndoLog lists the deleted resources with their full API definitions, so
+// they can be reviewed or recreated after a purge. Recreated resources get
+// new IDs.
+type UndoLog struct {
+	StartedAt time.Time   `json:"started_at"`
+	Filters   UndoFilters `json:"filters"`
+	Deleted   []UndoEntry `json:"deleted"`
+
+	mu   sync.Mutex
+	path string
+}
+
+// UndoFilters records the criteria the purge ran with.
+type UndoFilters struct {
+	Name          string   `json:"name,omitempty"`
+	Exclude       string   `json:"exclude,omitempty"`
+	CreatedBefore string   `json:"created_before,omitempty"`
+	Paused        bool     `json:"paused,omitempty"`
+	Resources     []string `json:"resources"`
+}
+
+// UndoEntry is a single deleted resource.
+type UndoEntry struct {
+	Type      string      `json:"type"`
+	ID        string      `json:"id"`
+	Name      string      `json:"name"`
+	DeletedAt time.Time   `json:"deleted_at"`
+	Resource  interface{} `json:"resource"`
+}
+
+// NewUndoLog creates an undo log written to path. An existing file is never
+// overwritten, so the record of an earlier purge cannot be lost.
+func NewUndoLog(path string, filters UndoFilters) (*UndoLog, error) {
+	if _, err := os.Stat(path); err == nil {
+		return nil, fmt.Errorf("undo file %s already exists", path)
+	}
+	return &UndoLog{
+		StartedAt: time.Now().UTC(),
+		Filters:   filters,
+		Deleted:   make([]UndoEntry, 0),
+		path:      path,
+	}, nil
+}
+
+// Record adds a deleted resource and saves the log, so an interrupted purge
+// still lists everything deleted so far.
+func (u *UndoLog) Record(c Candidate) error {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	u.Deleted = append(u.Deleted, UndoEntry{
+		Type:      c.Type,
+		ID:        c.ID,
+		Name:      c.Name,
+		DeletedAt: time.Now().UTC(),
+		Resource:  c.Resource,
+	})
+	return u.save()
+}
+
+// save writes the log atomically. The file can hold monitor request headers,
+// so it is readable by the owner only.
+func (u *UndoLog) save() error {
+	data, err := json.MarshalIndent(u, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal undo log: %w", err)
+	}
+
+	tempFile := u.path + ".tmp"
+	if err := os.WriteFile(tempFile, data, 0o600); err != nil {
+		return fmt.Errorf("failed to write undo log: %w", err)
+	}
+	if err := os.Rename(tempFile, u.path); err != nil {
+		_ = os.Remove(tempFile) //nolint:errcheck // #nosec G104 -- best-effort cleanup of temp file on rename error
+		return fmt.Errorf("failed to rename undo log: %w", err)
+	}
+
+	return nil
+}
+
+// defaultUndoFile returns a timestamped undo file name in the working
+// directory.
+func defaultUndoFile(now time.Time) string {
+	return fmt.Sprintf("purge-undo-%s.json", now.UTC().Format("20060102-150405"))
+}
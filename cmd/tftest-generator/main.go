@@ -0,0 +1,95 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// tftest-generator writes a native Terraform test suite for every resource
+// the provider registers: a minimal configuration and a .tftest.hcl file
+// that plans and applies it against a mock provider. Module authors can copy
+// the suites as scaffolding for their own tests; CI runs them with
+// terraform test to keep the provider schema and the examples in step.
+//
+// Usage:
+//
+//	go run ./cmd/tftest-generator
+//	go run ./cmd/tftest-generator --check
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+var (
+	outputDir = flag.String("output", "examples/tftest", "Directory to write the suites to, one subdirectory per resource")
+	check     = flag.Bool("check", false, "Report suites that are missing or out of date instead of writing them")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: tftest-generator [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Writes a terraform test suite with mock provider data for every\n")
+		fmt.Fprintf(os.Stderr, "Hyperping resource.\n\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nEXAMPLES:\n")
+		fmt.Fprintf(os.Stderr, "  # Regenerate the suites in examples/tftest\n")
+		fmt.Fprintf(os.Stderr, "  tftest-generator\n\n")
+		fmt.Fprintf(os.Stderr, "  # Fail if the committed suites are out of date (CI)\n")
+		fmt.Fprintf(os.Stderr, "  tftest-generator --check\n\n")
+	}
+	os.Exit(run())
+}
+
+func run() int {
+	flag.Parse()
+
+	suites, err := generateSuites(context.Background())
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	stale := 0
+	for _, s := range suites {
+		dir := filepath.Join(*outputDir, s.resourceType)
+		names := make([]string, 0, len(s.files))
+		for name := range s.files {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			path := filepath.Join(dir, name)
+			if *check {
+				existing, err := os.ReadFile(path) // #nosec G304 -- path is built from the output flag and resource type
+				if err != nil || !bytes.Equal(existing, s.files[name]) {
+					fmt.Fprintf(os.Stderr, "out of date: %s\n", path)
+					stale++
+				}
+				continue
+			}
+			if err := os.MkdirAll(dir, 0o750); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return 1
+			}
+			if err := os.WriteFile(path, s.files[name], 0o600); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				return 1
+			}
+		}
+	}
+
+	if *check {
+		if stale > 0 {
+			fmt.Fprintf(os.Stderr, "\n%d file(s) out of date; run: go run ./cmd/tftest-generator\n", stale)
+			return 1
+		}
+		fmt.Printf("%d suites up to date\n", len(suites))
+		return 0
+	}
+	fmt.Printf("Wrote %d suites to %s\n", len(suites), *outputDir)
+	return 0
+}
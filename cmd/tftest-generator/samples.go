@@ -0,0 +1,133 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import "github.com/zclconf/go-cty/cty"
+
+// attribute is a resource attribute and the literal value a suite uses for it.
+type attribute struct {
+	name  string
+	value cty.Value
+}
+
+// sample is the data behind one resource's suite.
+type sample struct {
+	// config is the resource configuration, in the order it is written.
+	// Every required attribute must be present, including the required
+	// attributes of nested objects.
+	config []attribute
+	// mocked are the mock_resource defaults for computed attributes. The
+	// mock provider fills the computed attributes not listed here with
+	// random values, so only values a test can assert on belong here.
+	mocked []attribute
+}
+
+// samples holds a sample for every resource the provider registers. The
+// generator fails when a resource has no sample or a sample misses a
+// required attribute, so a new resource cannot ship without a suite.
+//
+// References to other resources are written as literal IDs so that each
+// suite stands alone.
+var samples = map[string]sample{
+	"hyperping_monitor": {
+		config: []attribute{
+			{"name", cty.StringVal("Checkout API")},
+			{"url", cty.StringVal("https://api.example.com/health")},
+			{"protocol", cty.StringVal("http")},
+			{"http_method", cty.StringVal("GET")},
+			{"check_frequency", cty.NumberIntVal(60)},
+			{"expected_status_code", cty.StringVal("200")},
+			{"regions", cty.ListVal([]cty.Value{cty.StringVal("london"), cty.StringVal("virginia")})},
+		},
+		mocked: []attribute{
+			{"id", cty.StringVal("mon_mock01")},
+			{"status", cty.StringVal("up")},
+		},
+	},
+	"hyperping_incident": {
+		config: []attribute{
+			{"title", cty.StringVal("API Performance Degradation")},
+			{"text", cty.StringVal("We are investigating reports of slow API response times.")},
+			{"type", cty.StringVal("incident")},
+			{"status_pages", cty.ListVal([]cty.Value{cty.StringVal("sp_mock01")})},
+		},
+		mocked: []attribute{
+			{"id", cty.StringVal("inc_mock01")},
+		},
+	},
+	"hyperping_incident_update": {
+		config: []attribute{
+			{"incident_id", cty.StringVal("inc_mock01")},
+			{"type", cty.StringVal("investigating")},
+			{"text", cty.StringVal("We are investigating the issue affecting our API services.")},
+		},
+		mocked: []attribute{
+			{"id", cty.StringVal("inc_mock01/upd_mock01")},
+		},
+	},
+	"hyperping_maintenance": {
+		config: []attribute{
+			{"name", cty.StringVal("database-maintenance")},
+			{"title", cty.StringVal("Database Maintenance")},
+			{"text", cty.StringVal("Routine database maintenance window")},
+			{"start_date", cty.StringVal("2026-01-20T02:00:00.000Z")},
+			{"end_date", cty.StringVal("2026-01-20T04:00:00.000Z")},
+			{"monitors", cty.ListVal([]cty.Value{cty.StringVal("mon_mock01")})},
+		},
+		mocked: []attribute{
+			{"id", cty.StringVal("mw_mock01")},
+		},
+	},
+	"hyperping_outage": {
+		config: []attribute{
+			{"monitor_uuid", cty.StringVal("mon_mock01")},
+			{"start_date", cty.StringVal("2026-02-15T02:00:00Z")},
+			{"end_date", cty.StringVal("2026-02-15T04:00:00Z")},
+			{"status_code", cty.NumberIntVal(503)},
+			{"description", cty.StringVal("Planned database migration")},
+		},
+		mocked: []attribute{
+			{"id", cty.StringVal("out_mock01")},
+			{"is_resolved", cty.True},
+		},
+	},
+	"hyperping_healthcheck": {
+		config: []attribute{
+			{"name", cty.StringVal("Hourly Data Sync")},
+			{"period_value", cty.NumberIntVal(1)},
+			{"period_type", cty.StringVal("hours")},
+			{"grace_period_value", cty.NumberIntVal(15)},
+			{"grace_period_type", cty.StringVal("minutes")},
+		},
+		mocked: []attribute{
+			{"id", cty.StringVal("tok_mock01")},
+			{"ping_url", cty.StringVal("https://ping.hyperping.io/tok_mock01")},
+		},
+	},
+	"hyperping_statuspage": {
+		config: []attribute{
+			{"name", cty.StringVal("Production Status")},
+			{"hosted_subdomain", cty.StringVal("prod-status")},
+			{"settings", cty.ObjectVal(map[string]cty.Value{
+				"name":      cty.StringVal("Production Status"),
+				"languages": cty.ListVal([]cty.Value{cty.StringVal("en")}),
+			})},
+		},
+		mocked: []attribute{
+			{"id", cty.StringVal("sp_mock01")},
+			{"url", cty.StringVal("https://prod-status.hyperping.app")},
+		},
+	},
+	"hyperping_statuspage_subscriber": {
+		config: []attribute{
+			{"statuspage_uuid", cty.StringVal("sp_mock01")},
+			{"type", cty.StringVal("email")},
+			{"email", cty.StringVal("team@example.com")},
+			{"language", cty.StringVal("en")},
+		},
+		mocked: []attribute{
+			{"id", cty.NumberIntVal(1001)},
+		},
+	},
+}
@@ -0,0 +1,222 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	fwprovider "github.com/hashicorp/terraform-plugin-framework/provider"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
+)
+
+const (
+	// resourceName is the name of the resource in every suite.
+	resourceName = "example"
+	// requiredVersion matches the examples: mock providers need Terraform
+	// 1.7, and the write-only attributes of some resources need 1.11.
+	requiredVersion = ">= 1.11"
+	// generatedHeader marks the files the generator owns.
+	generatedHeader = "Code generated by tftest-generator. DO NOT EDIT."
+)
+
+// suite is the generated test module for one resource: a configuration and
+// the test file that plans and applies it.
+type suite struct {
+	resourceType string
+	files        map[string][]byte
+}
+
+// generateSuites builds a suite for every resource the provider registers,
+// in registration order.
+func generateSuites(ctx context.Context) ([]suite, error) {
+	p := provider.New("dev")()
+	var meta fwprovider.MetadataResponse
+	p.Metadata(ctx, fwprovider.MetadataRequest{}, &meta)
+
+	var suites []suite
+	seen := make(map[string]bool)
+	for _, newResource := range p.Resources(ctx) {
+		r := newResource()
+		var md resource.MetadataResponse
+		r.Metadata(ctx, resource.MetadataRequest{ProviderTypeName: meta.TypeName}, &md)
+		var sr resource.SchemaResponse
+		r.Schema(ctx, resource.SchemaRequest{}, &sr)
+		if sr.Diagnostics.HasError() {
+			return nil, fmt.Errorf("%s: schema: %v", md.TypeName, sr.Diagnostics)
+		}
+
+		s, ok := samples[md.TypeName]
+		if !ok {
+			return nil, fmt.Errorf("%s: no sample configuration; add one to samples.go", md.TypeName)
+		}
+		if err := s.validate(sr.Schema); err != nil {
+			return nil, fmt.Errorf("%s: %w", md.TypeName, err)
+		}
+		seen[md.TypeName] = true
+
+		st, err := s.render(md.TypeName, sr.Schema)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", md.TypeName, err)
+		}
+		suites = append(suites, st)
+	}
+
+	for resourceType := range samples {
+		if !seen[resourceType] {
+			return nil, fmt.Errorf("%s: sample for a resource the provider does not register", resourceType)
+		}
+	}
+	return suites, nil
+}
+
+// validate checks the sample against the resource schema: config sets only
+// configurable attributes and every required one, and mocked sets only
+// computed attributes.
+func (s sample) validate(sch schema.Schema) error {
+	var problems []string
+	for _, a := range s.config {
+		attr, ok := sch.Attributes[a.name]
+		if !ok || (!attr.IsRequired() && !attr.IsOptional()) {
+			problems = append(problems, fmt.Sprintf("config sets %s, which is not a configurable attribute", a.name))
+		}
+	}
+	for _, name := range missingRequired(sch.Attributes, s.configValue(), "") {
+		problems = append(problems, fmt.Sprintf("config is missing required attribute %s", name))
+	}
+	for _, a := range s.mocked {
+		attr, ok := sch.Attributes[a.name]
+		if !ok || !attr.IsComputed() {
+			problems = append(problems, fmt.Sprintf("mocked sets %s, which is not a computed attribute", a.name))
+		}
+	}
+	if len(problems) > 0 {
+		return fmt.Errorf("invalid sample:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return nil
+}
+
+func (s sample) configValue() cty.Value {
+	values := make(map[string]cty.Value, len(s.config))
+	for _, a := range s.config {
+		values[a.name] = a.value
+	}
+	return cty.ObjectVal(values)
+}
+
+// missingRequired returns the required attributes obj does not set, walking
+// into single nested attributes. Names are dotted paths from the resource.
+func missingRequired(attrs map[string]schema.Attribute, obj cty.Value, prefix string) []string {
+	names := make([]string, 0, len(attrs))
+	for name := range attrs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var missing []string
+	for _, name := range names {
+		attr := attrs[name]
+		if !attr.IsRequired() {
+			continue
+		}
+		if !obj.Type().IsObjectType() || !obj.Type().HasAttribute(name) {
+			missing = append(missing, prefix+name)
+			continue
+		}
+		if nested, ok := attr.(schema.SingleNestedAttribute); ok {
+			missing = append(missing, missingRequired(nested.Attributes, obj.GetAttr(name), prefix+name+".")...)
+		}
+	}
+	return missing
+}
+
+// render writes the suite's main.tf and <type>.tftest.hcl. Write-only
+// attributes are configured but not asserted: Terraform never stores them,
+// so they are null in the plan.
+func (s sample) render(resourceType string, sch schema.Schema) (suite, error) {
+	address := resourceType + "." + resourceName
+
+	config := hclgen.NewFile()
+	cb := config.Body()
+	cb.Comment("%s", generatedHeader)
+	cb.Newline()
+	hclgen.AppendProviderConfig(cb, requiredVersion, "Replaced by mock_provider in "+resourceType+".tftest.hcl.")
+	cb.Newline()
+	res := cb.Block("resource", resourceType, resourceName)
+	for _, a := range s.config {
+		res.SetValue(a.name, a.value)
+	}
+
+	test := hclgen.NewFile()
+	tb := test.Body()
+	tb.Comment("%s", generatedHeader)
+	tb.Comment("")
+	tb.Comment("Plans and applies %s against a mock provider:", address)
+	tb.Comment("no API key is needed and nothing is created in Hyperping. Copy the")
+	tb.Comment("mock_provider block into a module's tests to run them offline too.")
+	tb.Newline()
+	mock := tb.Block("mock_provider", "hyperping").Block("mock_resource", resourceType)
+	mock.SetValue("defaults", s.mockedValue())
+	tb.Newline()
+
+	plan := tb.Block("run", "plan")
+	if err := plan.SetReference("command", "plan"); err != nil {
+		return suite{}, err
+	}
+	for _, a := range s.config {
+		if !a.value.Type().IsPrimitiveType() {
+			continue
+		}
+		if attr, ok := sch.Attributes[a.name]; ok && attr.IsWriteOnly() {
+			continue
+		}
+		plan.Newline()
+		if err := appendAssert(plan, address+"."+a.name, a.value, a.name+" should be planned as configured"); err != nil {
+			return suite{}, err
+		}
+	}
+	tb.Newline()
+
+	apply := tb.Block("run", "apply")
+	for i, a := range s.mocked {
+		if i > 0 {
+			apply.Newline()
+		}
+		if err := appendAssert(apply, address+"."+a.name, a.value, a.name+" should be set from the mock defaults"); err != nil {
+			return suite{}, err
+		}
+	}
+
+	return suite{
+		resourceType: resourceType,
+		files: map[string][]byte{
+			"main.tf":                    config.Bytes(),
+			resourceType + ".tftest.hcl": test.Bytes(),
+		},
+	}, nil
+}
+
+func (s sample) mockedValue() cty.Value {
+	values := make(map[string]cty.Value, len(s.mocked))
+	for _, a := range s.mocked {
+		values[a.name] = a.value
+	}
+	return cty.ObjectVal(values)
+}
+
+func appendAssert(b *hclgen.Body, ref string, value cty.Value, message string) error {
+	assert := b.Block("assert")
+	if err := assert.SetEquals("condition", ref, value); err != nil {
+		return err
+	}
+	assert.SetString("error_message", message)
+	return nil
+}
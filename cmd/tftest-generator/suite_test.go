@@ -0,0 +1,132 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/zclconf/go-cty/cty"
+)
+
+func TestGenerateSuites(t *testing.T) {
+	suites, err := generateSuites(context.Background())
+	if err != nil {
+		t.Fatalf("generateSuites() error = %v", err)
+	}
+	if len(suites) != len(samples) {
+		t.Errorf("got %d suites, want one per sample (%d)", len(suites), len(samples))
+	}
+
+	for _, s := range suites {
+		for name, content := range s.files {
+			if _, diags := hclsyntax.ParseConfig(content, name, hcl.InitialPos); diags.HasErrors() {
+				t.Errorf("%s/%s does not parse: %v", s.resourceType, name, diags)
+			}
+
+			// The committed suites are what CI runs with terraform test.
+			committed, err := os.ReadFile(filepath.Join("..", "..", "examples", "tftest", s.resourceType, name))
+			if err != nil || !bytes.Equal(committed, content) {
+				t.Errorf("examples/tftest/%s/%s is out of date; run: go run ./cmd/tftest-generator", s.resourceType, name)
+			}
+		}
+	}
+}
+
+func TestSampleRender(t *testing.T) {
+	sch := schema.Schema{Attributes: map[string]schema.Attribute{
+		"id":           schema.StringAttribute{Computed: true},
+		"name":         schema.StringAttribute{Required: true},
+		"bearer_token": schema.StringAttribute{Optional: true, Sensitive: true, WriteOnly: true},
+		"regions":      schema.ListAttribute{Optional: true},
+	}}
+	s := sample{
+		config: []attribute{
+			{"name", cty.StringVal("API")},
+			{"bearer_token", cty.StringVal("secret")},
+			{"regions", cty.ListVal([]cty.Value{cty.StringVal("london")})},
+		},
+		mocked: []attribute{{"id", cty.StringVal("mon_mock01")}},
+	}
+	got, err := s.render("hyperping_monitor", sch)
+	if err != nil {
+		t.Fatalf("render() error = %v", err)
+	}
+
+	config := string(got.files["main.tf"])
+	for _, want := range []string{`resource "hyperping_monitor" "example" {`, `regions      = ["london"]`, `required_version = ">= 1.11"`} {
+		if !strings.Contains(config, want) {
+			t.Errorf("main.tf missing %q:\n%s", want, config)
+		}
+	}
+
+	test := string(got.files["hyperping_monitor.tftest.hcl"])
+	for _, want := range []string{
+		`mock_provider "hyperping" {`,
+		`mock_resource "hyperping_monitor" {`,
+		`command = plan`,
+		`condition     = hyperping_monitor.example.name == "API"`,
+		`condition     = hyperping_monitor.example.id == "mon_mock01"`,
+	} {
+		if !strings.Contains(test, want) {
+			t.Errorf("test file missing %q:\n%s", want, test)
+		}
+	}
+	for _, unwanted := range []string{"example.regions ==", "example.bearer_token =="} {
+		if strings.Contains(test, unwanted) {
+			t.Errorf("test file asserts %q; lists and write-only attributes are not asserted:\n%s", unwanted, test)
+		}
+	}
+}
+
+func TestSampleValidate(t *testing.T) {
+	sch := schema.Schema{Attributes: map[string]schema.Attribute{
+		"id":   schema.StringAttribute{Computed: true},
+		"name": schema.StringAttribute{Required: true},
+		"settings": schema.SingleNestedAttribute{Required: true, Attributes: map[string]schema.Attribute{
+			"languages": schema.ListAttribute{Required: true},
+			"theme":     schema.StringAttribute{Optional: true},
+		}},
+	}}
+
+	valid := sample{
+		config: []attribute{
+			{"name", cty.StringVal("API")},
+			{"settings", cty.ObjectVal(map[string]cty.Value{"languages": cty.ListVal([]cty.Value{cty.StringVal("en")})})},
+		},
+		mocked: []attribute{{"id", cty.StringVal("sp_mock01")}},
+	}
+	if err := valid.validate(sch); err != nil {
+		t.Errorf("validate() error = %v", err)
+	}
+
+	invalid := sample{
+		config: []attribute{
+			{"id", cty.StringVal("sp_1")},
+			{"settings", cty.ObjectVal(map[string]cty.Value{"theme": cty.StringVal("dark")})},
+		},
+		mocked: []attribute{{"name", cty.StringVal("API")}},
+	}
+	err := invalid.validate(sch)
+	if err == nil {
+		t.Fatal("validate() error = nil, want problems")
+	}
+	for _, want := range []string{
+		"config sets id",
+		"missing required attribute name",
+		"missing required attribute settings.languages",
+		"mocked sets name",
+	} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error missing %q:\n%v", want, err)
+		}
+	}
+}
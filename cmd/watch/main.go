@@ -0,0 +1,120 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// watch tails Hyperping monitor status changes, printing one line (or one
+// JSON object) per change until interrupted.
+//
+// Usage:
+//
+//	export HYPERPING_API_KEY="sk_your_api_key"
+//	go run ./cmd/watch --name='^prod-'
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/signal"
+	"regexp"
+	"syscall"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+)
+
+var (
+	interval    = flag.Duration("interval", 30*time.Second, fmt.Sprintf("How often to poll monitor status (minimum %s)", minInterval))
+	namePattern = flag.String("name", "", "Only watch monitors whose name matches this regex")
+	jsonOutput  = flag.Bool("json", false, "Print each change as a JSON object (one per line)")
+	baseURL     = flag.String("base-url", "https://api.hyperping.io", "Hyperping API base URL")
+)
+
+func main() {
+	flag.Usage = func() {
+		fmt.Fprintf(os.Stderr, "Usage: watch [options]\n\n")
+		fmt.Fprintf(os.Stderr, "Prints Hyperping monitor status changes as they are detected, until interrupted.\n\n")
+		flag.PrintDefaults()
+		fmt.Fprintf(os.Stderr, "\nEXAMPLES:\n")
+		fmt.Fprintf(os.Stderr, "  # Tail production monitors\n")
+		fmt.Fprintf(os.Stderr, "  watch --name='^prod-'\n\n")
+		fmt.Fprintf(os.Stderr, "  # Feed changes to another tool\n")
+		fmt.Fprintf(os.Stderr, "  watch --json --interval=1m | jq 'select(.to == \"down\")'\n\n")
+	}
+	os.Exit(run())
+}
+
+func run() int {
+	flag.Parse()
+
+	if err := clientenv.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	var nameFilter *regexp.Regexp
+	if *namePattern != "" {
+		re, err := regexp.Compile(*namePattern)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: invalid --name pattern: %v\n", err)
+			return 1
+		}
+		nameFilter = re
+	}
+
+	apiKey := os.Getenv("HYPERPING_API_KEY")
+	if apiKey == "" {
+		fmt.Fprintln(os.Stderr, "Error: HYPERPING_API_KEY environment variable is required")
+		return 1
+	}
+	client := clientenv.NewClient(apiKey, hyperping.WithBaseURL(*baseURL))
+
+	watcher, err := NewWatcher(client, *interval)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	watcher.OnError = func(err error) {
+		fmt.Fprintf(os.Stderr, "Warning: poll failed, retrying in %s: %v\n", *interval, err)
+	}
+	if nameFilter != nil {
+		watcher.Include = func(m hyperping.Monitor) bool { return nameFilter.MatchString(m.Name) }
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	events, err := watcher.MonitorEvents(ctx)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	fmt.Fprintf(os.Stderr, "Watching monitors every %s (Ctrl+C to stop)\n", *interval)
+
+	if err := printEvents(os.Stdout, events, *jsonOutput); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// printEvents writes events to w until the channel is closed.
+func printEvents(w io.Writer, events <-chan Event, asJSON bool) error {
+	enc := json.NewEncoder(w)
+	for event := range events {
+		var err error
+		if asJSON {
+			err = enc.Encode(event)
+		} else {
+			_, err = fmt.Fprintln(w, event)
+		}
+		if err != nil {
+			return fmt.Errorf("failed to write event: %w", err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// Event kinds.
+const (
+	EventStatusChanged = "status_changed"
+	EventAdded         = "added"
+	EventRemoved       = "removed"
+)
+
+// statusPaused is reported for paused monitors, whose last check status no
+// longer changes.
+const statusPaused = "paused"
+
+// minInterval keeps polling well inside the API rate limits.
+const minInterval = 10 * time.Second
+
+// MonitorLister is the subset of the Hyperping client the watcher needs.
+type MonitorLister interface {
+	ListMonitors(ctx context.Context) ([]hyperping.Monitor, error)
+}
+
+// Event is a change in a monitor between two polls.
+type Event struct {
+	Time        time.Time `json:"time"`
+	Kind        string    `json:"kind"`
+	MonitorUUID string    `json:"monitor_uuid"`
+	MonitorName string    `json:"monitor_name"`
+	From        string    `json:"from,omitempty"`
+	To          string    `json:"to,omitempty"`
+}
+
+// String formats the event as one log line.
+func (e Event) String() string {
+	ts := e.Time.UTC().Format(time.RFC3339)
+	switch e.Kind {
+	case EventAdded:
+		return fmt.Sprintf("%s  %s (%s) added: %s", ts, e.MonitorName, e.MonitorUUID, e.To)
+	case EventRemoved:
+		return fmt.Sprintf("%s  %s (%s) removed", ts, e.MonitorName, e.MonitorUUID)
+	default:
+		return fmt.Sprintf("%s  %s (%s) %s -> %s", ts, e.MonitorName, e.MonitorUUID, e.From, e.To)
+	}
+}
+
+// Watcher turns periodic monitor listings into a stream of change events.
+// The Hyperping API has no events, webhook subscription, or SSE/websocket
+// feed, so changes are detected by comparing successive ListMonitors
+// results; a status that flips and flips back between two polls is missed.
+type Watcher struct {
+	client   MonitorLister
+	interval time.Duration
+	now      func() time.Time
+
+	// OnError is called when a poll fails. The watcher keeps the last
+	// known state and tries again at the next interval.
+	OnError func(error)
+	// Include reports whether a monitor is watched. Nil watches all.
+	Include func(hyperping.Monitor) bool
+}
+
+// NewWatcher creates a watcher that polls client every interval.
+func NewWatcher(client MonitorLister, interval time.Duration) (*Watcher, error) {
+	if interval < minInterval {
+		return nil, fmt.Errorf("interval must be at least %s, got %s", minInterval, interval)
+	}
+	return &Watcher{
+		client:   client,
+		interval: interval,
+		now:      time.Now,
+	}, nil
+}
+
+// MonitorEvents takes an initial snapshot of the monitors and returns a
+// channel of the changes seen by later polls. The channel is closed when
+// ctx is done. An error is returned only when the initial snapshot fails.
+func (w *Watcher) MonitorEvents(ctx context.Context) (<-chan Event, error) {
+	state, err := w.poll(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list monitors: %w", err)
+	}
+
+	events := make(chan Event)
+	go func() {
+		defer close(events)
+
+		ticker := time.NewTicker(w.interval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			next, err := w.poll(ctx)
+			if err != nil {
+				if ctx.Err() != nil {
+					return
+				}
+				if w.OnError != nil {
+					w.OnError(err)
+				}
+				continue
+			}
+
+			for _, event := range diffMonitors(state, next, w.now()) {
+				select {
+				case events <- event:
+				case <-ctx.Done():
+					return
+				}
+			}
+			state = next
+		}
+	}()
+
+	return events, nil
+}
+
+// poll lists the watched monitors keyed by UUID.
+func (w *Watcher) poll(ctx context.Context) (map[string]hyperping.Monitor, error) {
+	monitors, err := w.client.ListMonitors(ctx)
+	if err != nil {
+		return nil, err
+	}
+	state := make(map[string]hyperping.Monitor, len(monitors))
+	for _, monitor := range monitors {
+		if w.Include != nil && !w.Include(monitor) {
+			continue
+		}
+		state[monitor.UUID] = monitor
+	}
+	return state, nil
+}
+
+// monitorStatus returns the status shown for a monitor.
+func monitorStatus(m hyperping.Monitor) string {
+	if m.Paused {
+		return statusPaused
+	}
+	if m.Status == "" {
+		return "unknown"
+	}
+	return m.Status
+}
+
+// diffMonitors returns the events between two snapshots, ordered by monitor
+// name so a poll's output is stable.
+func diffMonitors(prev, next map[string]hyperping.Monitor, at time.Time) []Event {
+	var events []Event
+	for uuid, monitor := range next {
+		old, ok := prev[uuid]
+		switch {
+		case !ok:
+			events = append(events, Event{Time: at, Kind: EventAdded, MonitorUUID: uuid, MonitorName: monitor.Name, To: monitorStatus(monitor)})
+		case monitorStatus(old) != monitorStatus(monitor):
+			events = append(events, Event{Time: at, Kind: EventStatusChanged, MonitorUUID: uuid, MonitorName: monitor.Name, From: monitorStatus(old), To: monitorStatus(monitor)})
+		}
+	}
+	for uuid, monitor := range prev {
+		if _, ok := next[uuid]; !ok {
+			events = append(events, Event{Time: at, Kind: EventRemoved, MonitorUUID: uuid, MonitorName: monitor.Name, From: monitorStatus(monitor)})
+		}
+	}
+
+	sort.Slice(events, func(i, j int) bool {
+		if events[i].MonitorName != events[j].MonitorName {
+			return events[i].MonitorName < events[j].MonitorName
+		}
+		return events[i].MonitorUUID < events[j].MonitorUUID
+	})
+	return events
+}
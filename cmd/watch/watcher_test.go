@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// scriptedLister returns one scripted response per ListMonitors call and
+// repeats the last one afterwards.
+type scriptedLister struct {
+	mu        sync.Mutex
+	responses []listResponse
+	calls     int
+}
+
+type listResponse struct {
+	monitors []hyperping.Monitor
+	err      error
+}
+
+func (s *scriptedLister) ListMonitors(_ context.Context) ([]hyperping.Monitor, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	r := s.responses[min(s.calls, len(s.responses)-1)]
+	s.calls++
+	return r.monitors, r.err
+}
+
+func monitor(uuid, name, status string) hyperping.Monitor {
+	return hyperping.Monitor{UUID: uuid, Name: name, Status: status}
+}
+
+func testWatcher(client MonitorLister) *Watcher {
+	return &Watcher{
+		client:   client,
+		interval: time.Millisecond,
+		now:      func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) },
+	}
+}
+
+func TestNewWatcher_MinimumInterval(t *testing.T) {
+	if _, err := NewWatcher(&scriptedLister{}, time.Second); err == nil {
+		t.Error("expected an error for an interval below the minimum")
+	}
+	if _, err := NewWatcher(&scriptedLister{}, minInterval); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestDiffMonitors(t *testing.T) {
+	at := time.Now()
+	paused := monitor("mon_3", "Web", "up")
+	paused.Paused = true
+
+	prev := map[string]hyperping.Monitor{
+		"mon_1": monitor("mon_1", "API", "up"),
+		"mon_2": monitor("mon_2", "Billing", "up"),
+		"mon_3": monitor("mon_3", "Web", "up"),
+		"mon_4": monitor("mon_4", "Docs", "down"),
+	}
+	next := map[string]hyperping.Monitor{
+		"mon_1": monitor("mon_1", "API", "down"),
+		"mon_2": monitor("mon_2", "Billing", "up"),
+		"mon_3": paused,
+		"mon_5": monitor("mon_5", "Auth", ""),
+	}
+
+	got := diffMonitors(prev, next, at)
+	want := []Event{
+		{Time: at, Kind: EventStatusChanged, MonitorUUID: "mon_1", MonitorName: "API", From: "up", To: "down"},
+		{Time: at, Kind: EventAdded, MonitorUUID: "mon_5", MonitorName: "Auth", To: "unknown"},
+		{Time: at, Kind: EventRemoved, MonitorUUID: "mon_4", MonitorName: "Docs", From: "down"},
+		{Time: at, Kind: EventStatusChanged, MonitorUUID: "mon_3", MonitorName: "Web", From: "up", To: statusPaused},
+	}
+	if len(got) != len(want) {
+		t.Fatalf("got %d events, want %d: %+v", len(got), len(want), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("event %d = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestMonitorEvents(t *testing.T) {
+	lister := &scriptedLister{responses: []listResponse{
+		{monitors: []hyperping.Monitor{monitor("mon_1", "API", "up"), monitor("mon_2", "Web", "up")}},
+		{err: errors.New("temporary failure")},
+		{monitors: []hyperping.Monitor{monitor("mon_1", "API", "down"), monitor("mon_2", "Web", "up")}},
+	}}
+	w := testWatcher(lister)
+	w.Include = func(m hyperping.Monitor) bool { return m.Name == "API" }
+	var pollErrors []error
+	w.OnError = func(err error) { pollErrors = append(pollErrors, err) }
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	events, err := w.MonitorEvents(ctx)
+	if err != nil {
+		t.Fatalf("MonitorEvents: %v", err)
+	}
+
+	select {
+	case event := <-events:
+		if event.Kind != EventStatusChanged || event.MonitorUUID != "mon_1" || event.From != "up" || event.To != "down" {
+			t.Errorf("event = %+v", event)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for an event")
+	}
+
+	cancel()
+	for range events {
+		// drain until the watcher closes the channel
+	}
+	if len(pollErrors) != 1 {
+		t.Errorf("OnError called %d times, want 1", len(pollErrors))
+	}
+}
+
+func TestMonitorEvents_InitialListFails(t *testing.T) {
+	lister := &scriptedLister{responses: []listResponse{{err: errors.New("unauthorized")}}}
+
+	if _, err := testWatcher(lister).MonitorEvents(context.Background()); err == nil {
+		t.Fatal("expected an error when the initial snapshot fails")
+	}
+}
+
+func TestPrintEvents(t *testing.T) {
+	event := Event{
+		Time:        time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC),
+		Kind:        EventStatusChanged,
+		MonitorUUID: "mon_1",
+		MonitorName: "API",
+		From:        "up",
+		To:          "down",
+	}
+
+	tests := []struct {
+		name   string
+		asJSON bool
+		want   string
+	}{
+		{name: "text", want: "2026-03-01T12:00:00Z  API (mon_1) up -> down\n"},
+		{name: "json", asJSON: true, want: `{"time":"2026-03-01T12:00:00Z","kind":"status_changed","monitor_uuid":"mon_1","monitor_name":"API","from":"up","to":"down"}` + "\n"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			events := make(chan Event, 1)
+			events <- event
+			close(events)
+
+			var buf bytes.Buffer
+			if err := printEvents(&buf, events, tt.asJSON); err != nil {
+				t.Fatalf("printEvents: %v", err)
+			}
+			if got := buf.String(); got != tt.want {
+				t.Errorf("output = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEventString(t *testing.T) {
+	at := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	added := Event{Time: at, Kind: EventAdded, MonitorUUID: "mon_1", MonitorName: "API", To: "up"}
+	removed := Event{Time: at, Kind: EventRemoved, MonitorUUID: "mon_1", MonitorName: "API", From: "up"}
+
+	if got := added.String(); !strings.HasSuffix(got, "API (mon_1) added: up") {
+		t.Errorf("added = %q", got)
+	}
+	if got := removed.String(); !strings.HasSuffix(got, "API (mon_1) removed") {
+		t.Errorf("removed = %q", got)
+	}
+}
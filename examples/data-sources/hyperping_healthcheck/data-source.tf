@@ -7,8 +7,10 @@ data "hyperping_healthcheck" "backup_job" {
 output "backup_status" {
   value = {
     name     = data.hyperping_healthcheck.backup_job.name
+    status   = data.hyperping_healthcheck.backup_job.status
     is_down  = data.hyperping_healthcheck.backup_job.is_down
     is_paused = data.hyperping_healthcheck.backup_job.is_paused
     last_ping = data.hyperping_healthcheck.backup_job.last_ping
+    due_date  = data.hyperping_healthcheck.backup_job.due_date
   }
 }
@@ -0,0 +1,17 @@
+# Fetch the five most recent checks of a monitor
+data "hyperping_monitor_check_result" "api" {
+  monitor_uuid = hyperping_monitor.api.id
+  limit        = 5
+}
+
+# Warn after apply if the latest check failed
+check "api_is_healthy" {
+  assert {
+    condition     = length(data.hyperping_monitor_check_result.api.checks) > 0 && data.hyperping_monitor_check_result.api.checks[0].status_code < 400
+    error_message = "The latest check of the API monitor did not succeed."
+  }
+}
+
+output "api_latest_response_time_ms" {
+  value = try(data.hyperping_monitor_check_result.api.checks[0].response_time_ms, null)
+}
@@ -36,3 +36,16 @@ output "monitors_by_protocol" {
     protocol => [for m in data.hyperping_monitors.all.monitors : m.name if m.protocol == protocol]
   }
 }
+
+# Fetch a known set of monitors in one data source instead of
+# one hyperping_monitor data source per UUID
+data "hyperping_monitors" "selected" {
+  uuids = ["mon_abc123", "mon_def456"]
+}
+
+output "selected_monitor_urls" {
+  value = zipmap(
+    data.hyperping_monitors.selected.ids,
+    data.hyperping_monitors.selected.monitors[*].url,
+  )
+}
@@ -0,0 +1,26 @@
+# Outage metrics for the critical monitors over the last 30 days
+data "hyperping_outage_summary" "last_30_days" {
+  monitor_uuids = [hyperping_monitor.api.id, hyperping_monitor.web.id]
+  from          = timeadd(plantimestamp(), "-720h")
+}
+
+# Flag monitors below a 99.9% availability objective
+locals {
+  slo_breaches = [
+    for m in data.hyperping_outage_summary.last_30_days.monitors :
+    m.monitor_uuid if m.availability < 99.9
+  ]
+}
+
+output "slo_report" {
+  value = {
+    for m in data.hyperping_outage_summary.last_30_days.monitors : m.monitor_uuid => {
+      availability      = m.availability
+      outages           = m.outage_count
+      downtime_minutes  = m.total_downtime / 60
+      mttr_seconds      = m.mttr
+      longest_outage    = m.longest_outage
+      currently_ongoing = m.ongoing_count > 0
+    }
+  }
+}
@@ -0,0 +1,11 @@
+# Report the provider build, e.g. when filing an issue
+data "hyperping_provider_info" "current" {}
+
+output "hyperping_provider" {
+  value = {
+    version        = data.hyperping_provider_info.current.version
+    commit         = data.hyperping_provider_info.current.commit
+    client_library = data.hyperping_provider_info.current.client_library_version
+    api_versions   = data.hyperping_provider_info.current.api_versions
+  }
+}
@@ -0,0 +1,23 @@
+# Stop the plan with a clear message when the Hyperping API is down
+data "hyperping_service_status" "gate" {
+  fail_on_unhealthy = true
+}
+
+# Or inspect the status and decide in configuration
+data "hyperping_service_status" "current" {}
+
+output "hyperping_status" {
+  value = "${data.hyperping_service_status.current.status}: ${data.hyperping_service_status.current.message}"
+}
+
+resource "hyperping_monitor" "api" {
+  name = "API"
+  url  = "https://api.example.com/health"
+
+  lifecycle {
+    precondition {
+      condition     = data.hyperping_service_status.current.healthy
+      error_message = data.hyperping_service_status.current.message
+    }
+  }
+}
@@ -45,3 +45,13 @@ output "first_section_name" {
 output "email_subscriptions_enabled" {
   value = data.hyperping_statuspage.existing.subscribe.email
 }
+
+# React to what the page is showing, e.g. to raise a banner elsewhere
+output "status_page_degraded" {
+  value       = data.hyperping_statuspage.existing.current_status != "operational"
+  description = "Whether the page shows an incident or a monitor down"
+}
+
+output "active_incident_count" {
+  value = data.hyperping_statuspage.existing.active_incident_count
+}
@@ -0,0 +1,16 @@
+# Store the URL the way the API returns it, so a differently-cased host or a
+# trailing slash does not show a diff on every plan.
+resource "hyperping_monitor" "api" {
+  name = "API Health"
+  url  = provider::hyperping::normalize_url("https://API.Example.com:443/health/")
+}
+
+# => "https://api.example.com/health"
+output "normalized" {
+  value = provider::hyperping::normalize_url(var.monitor_url)
+}
+
+variable "monitor_url" {
+  type    = string
+  default = "HTTPS://Example.com/"
+}
@@ -0,0 +1,7 @@
+# Import by resource identity (Terraform 1.12+)
+import {
+  to = hyperping_monitor.api
+  identity = {
+    id = "mon_abc123def456"
+  }
+}
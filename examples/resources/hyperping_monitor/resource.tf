@@ -40,3 +40,31 @@ resource "hyperping_monitor" "maintenance" {
   protocol = "http"
   paused   = true
 }
+
+# GraphQL health check - the provider builds the JSON POST body
+resource "hyperping_monitor" "graphql" {
+  name = "GraphQL API"
+  url  = "https://api.example.com/graphql"
+
+  graphql = {
+    query = "query($region: String!) { health(region: $region) { status } }"
+    variables = jsonencode({
+      region = "eu"
+    })
+    expected_json_path = "data.health.status"
+  }
+}
+
+# Endpoint behind authentication - the provider builds the Authorization header
+variable "health_token" {
+  type      = string
+  sensitive = true
+}
+
+# The token is write-only; bump bearer_token_version after rotating it.
+resource "hyperping_monitor" "private_api" {
+  name                 = "Private API"
+  url                  = "https://api.example.com/internal/health"
+  bearer_token         = var.health_token
+  bearer_token_version = 1
+}
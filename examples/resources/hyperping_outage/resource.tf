@@ -17,3 +17,6 @@ resource "hyperping_outage" "incident_tracking" {
 
 # Note: All outage fields are ForceNew - any change triggers destroy and recreate
 # To modify an outage, update it via the Hyperping dashboard or API directly
+# The API cannot edit an outage after creation, so postmortem links cannot be
+# attached to an existing outage record. Post them as an update on the related
+# incident (hyperping_incident_update) instead.
@@ -64,6 +64,7 @@ resource "hyperping_statuspage" "production" {
           uuid = hyperping_monitor.api.id
           name = {
             en = "Main API"
+            fr = "API principale"
           }
           show_uptime         = true
           show_response_times = true
@@ -72,6 +73,7 @@ resource "hyperping_statuspage" "production" {
           uuid = hyperping_monitor.auth.id
           name = {
             en = "Authentication API"
+            fr = "API d'authentification"
           }
           show_uptime         = true
           show_response_times = false
@@ -90,18 +92,24 @@ resource "hyperping_statuspage" "production" {
           is_group = true
           name = {
             en = "Database Cluster"
+            fr = "Cluster de bases de données"
           }
+          # Display toggles for the group row, set apart from its children
+          show_uptime         = true
+          show_response_times = false
           services = [
             {
               uuid = hyperping_monitor.db_primary.id
               name = {
                 en = "Primary DB"
+                fr = "Base principale"
               }
             },
             {
               uuid = hyperping_monitor.db_replica.id
               name = {
                 en = "Replica DB"
+                fr = "Base réplique"
               }
             }
           ]
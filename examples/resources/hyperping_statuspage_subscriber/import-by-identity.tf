@@ -0,0 +1,8 @@
+# Import by resource identity (Terraform 1.12+)
+import {
+  to = hyperping_statuspage_subscriber.oncall
+  identity = {
+    statuspage_uuid = "sp_abc123"
+    id              = 12345
+  }
+}
@@ -0,0 +1,55 @@
+# Code generated by tftest-generator. DO NOT EDIT.
+#
+# Plans and applies hyperping_healthcheck.example against a mock provider:
+# no API key is needed and nothing is created in Hyperping. Copy the
+# mock_provider block into a module's tests to run them offline too.
+
+mock_provider "hyperping" {
+  mock_resource "hyperping_healthcheck" {
+    defaults = {
+      id       = "tok_mock01"
+      ping_url = "https://ping.hyperping.io/tok_mock01"
+    }
+  }
+}
+
+run "plan" {
+  command = plan
+
+  assert {
+    condition     = hyperping_healthcheck.example.name == "Hourly Data Sync"
+    error_message = "name should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_healthcheck.example.period_value == 1
+    error_message = "period_value should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_healthcheck.example.period_type == "hours"
+    error_message = "period_type should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_healthcheck.example.grace_period_value == 15
+    error_message = "grace_period_value should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_healthcheck.example.grace_period_type == "minutes"
+    error_message = "grace_period_type should be planned as configured"
+  }
+}
+
+run "apply" {
+  assert {
+    condition     = hyperping_healthcheck.example.id == "tok_mock01"
+    error_message = "id should be set from the mock defaults"
+  }
+
+  assert {
+    condition     = hyperping_healthcheck.example.ping_url == "https://ping.hyperping.io/tok_mock01"
+    error_message = "ping_url should be set from the mock defaults"
+  }
+}
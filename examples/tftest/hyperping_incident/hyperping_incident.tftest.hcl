@@ -0,0 +1,39 @@
+# Code generated by tftest-generator. DO NOT EDIT.
+#
+# Plans and applies hyperping_incident.example against a mock provider:
+# no API key is needed and nothing is created in Hyperping. Copy the
+# mock_provider block into a module's tests to run them offline too.
+
+mock_provider "hyperping" {
+  mock_resource "hyperping_incident" {
+    defaults = {
+      id = "inc_mock01"
+    }
+  }
+}
+
+run "plan" {
+  command = plan
+
+  assert {
+    condition     = hyperping_incident.example.title == "API Performance Degradation"
+    error_message = "title should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_incident.example.text == "We are investigating reports of slow API response times."
+    error_message = "text should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_incident.example.type == "incident"
+    error_message = "type should be planned as configured"
+  }
+}
+
+run "apply" {
+  assert {
+    condition     = hyperping_incident.example.id == "inc_mock01"
+    error_message = "id should be set from the mock defaults"
+  }
+}
@@ -0,0 +1,39 @@
+# Code generated by tftest-generator. DO NOT EDIT.
+#
+# Plans and applies hyperping_incident_update.example against a mock provider:
+# no API key is needed and nothing is created in Hyperping. Copy the
+# mock_provider block into a module's tests to run them offline too.
+
+mock_provider "hyperping" {
+  mock_resource "hyperping_incident_update" {
+    defaults = {
+      id = "inc_mock01/upd_mock01"
+    }
+  }
+}
+
+run "plan" {
+  command = plan
+
+  assert {
+    condition     = hyperping_incident_update.example.incident_id == "inc_mock01"
+    error_message = "incident_id should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_incident_update.example.type == "investigating"
+    error_message = "type should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_incident_update.example.text == "We are investigating the issue affecting our API services."
+    error_message = "text should be planned as configured"
+  }
+}
+
+run "apply" {
+  assert {
+    condition     = hyperping_incident_update.example.id == "inc_mock01/upd_mock01"
+    error_message = "id should be set from the mock defaults"
+  }
+}
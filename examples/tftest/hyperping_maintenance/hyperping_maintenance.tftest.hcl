@@ -0,0 +1,49 @@
+# Code generated by tftest-generator. DO NOT EDIT.
+#
+# Plans and applies hyperping_maintenance.example against a mock provider:
+# no API key is needed and nothing is created in Hyperping. Copy the
+# mock_provider block into a module's tests to run them offline too.
+
+mock_provider "hyperping" {
+  mock_resource "hyperping_maintenance" {
+    defaults = {
+      id = "mw_mock01"
+    }
+  }
+}
+
+run "plan" {
+  command = plan
+
+  assert {
+    condition     = hyperping_maintenance.example.name == "database-maintenance"
+    error_message = "name should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_maintenance.example.title == "Database Maintenance"
+    error_message = "title should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_maintenance.example.text == "Routine database maintenance window"
+    error_message = "text should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_maintenance.example.start_date == "2026-01-20T02:00:00.000Z"
+    error_message = "start_date should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_maintenance.example.end_date == "2026-01-20T04:00:00.000Z"
+    error_message = "end_date should be planned as configured"
+  }
+}
+
+run "apply" {
+  assert {
+    condition     = hyperping_maintenance.example.id == "mw_mock01"
+    error_message = "id should be set from the mock defaults"
+  }
+}
@@ -0,0 +1,60 @@
+# Code generated by tftest-generator. DO NOT EDIT.
+#
+# Plans and applies hyperping_monitor.example against a mock provider:
+# no API key is needed and nothing is created in Hyperping. Copy the
+# mock_provider block into a module's tests to run them offline too.
+
+mock_provider "hyperping" {
+  mock_resource "hyperping_monitor" {
+    defaults = {
+      id     = "mon_mock01"
+      status = "up"
+    }
+  }
+}
+
+run "plan" {
+  command = plan
+
+  assert {
+    condition     = hyperping_monitor.example.name == "Checkout API"
+    error_message = "name should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_monitor.example.url == "https://api.example.com/health"
+    error_message = "url should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_monitor.example.protocol == "http"
+    error_message = "protocol should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_monitor.example.http_method == "GET"
+    error_message = "http_method should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_monitor.example.check_frequency == 60
+    error_message = "check_frequency should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_monitor.example.expected_status_code == "200"
+    error_message = "expected_status_code should be planned as configured"
+  }
+}
+
+run "apply" {
+  assert {
+    condition     = hyperping_monitor.example.id == "mon_mock01"
+    error_message = "id should be set from the mock defaults"
+  }
+
+  assert {
+    condition     = hyperping_monitor.example.status == "up"
+    error_message = "status should be set from the mock defaults"
+  }
+}
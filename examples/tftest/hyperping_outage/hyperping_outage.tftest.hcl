@@ -0,0 +1,55 @@
+# Code generated by tftest-generator. DO NOT EDIT.
+#
+# Plans and applies hyperping_outage.example against a mock provider:
+# no API key is needed and nothing is created in Hyperping. Copy the
+# mock_provider block into a module's tests to run them offline too.
+
+mock_provider "hyperping" {
+  mock_resource "hyperping_outage" {
+    defaults = {
+      id          = "out_mock01"
+      is_resolved = true
+    }
+  }
+}
+
+run "plan" {
+  command = plan
+
+  assert {
+    condition     = hyperping_outage.example.monitor_uuid == "mon_mock01"
+    error_message = "monitor_uuid should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_outage.example.start_date == "2026-02-15T02:00:00Z"
+    error_message = "start_date should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_outage.example.end_date == "2026-02-15T04:00:00Z"
+    error_message = "end_date should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_outage.example.status_code == 503
+    error_message = "status_code should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_outage.example.description == "Planned database migration"
+    error_message = "description should be planned as configured"
+  }
+}
+
+run "apply" {
+  assert {
+    condition     = hyperping_outage.example.id == "out_mock01"
+    error_message = "id should be set from the mock defaults"
+  }
+
+  assert {
+    condition     = hyperping_outage.example.is_resolved == true
+    error_message = "is_resolved should be set from the mock defaults"
+  }
+}
@@ -0,0 +1,40 @@
+# Code generated by tftest-generator. DO NOT EDIT.
+#
+# Plans and applies hyperping_statuspage.example against a mock provider:
+# no API key is needed and nothing is created in Hyperping. Copy the
+# mock_provider block into a module's tests to run them offline too.
+
+mock_provider "hyperping" {
+  mock_resource "hyperping_statuspage" {
+    defaults = {
+      id  = "sp_mock01"
+      url = "https://prod-status.hyperping.app"
+    }
+  }
+}
+
+run "plan" {
+  command = plan
+
+  assert {
+    condition     = hyperping_statuspage.example.name == "Production Status"
+    error_message = "name should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_statuspage.example.hosted_subdomain == "prod-status"
+    error_message = "hosted_subdomain should be planned as configured"
+  }
+}
+
+run "apply" {
+  assert {
+    condition     = hyperping_statuspage.example.id == "sp_mock01"
+    error_message = "id should be set from the mock defaults"
+  }
+
+  assert {
+    condition     = hyperping_statuspage.example.url == "https://prod-status.hyperping.app"
+    error_message = "url should be set from the mock defaults"
+  }
+}
@@ -0,0 +1,39 @@
+# Code generated by tftest-generator. DO NOT EDIT.
+#
+# Plans and applies hyperping_statuspage_subscriber.example against a mock provider:
+# no API key is needed and nothing is created in Hyperping. Copy the
+# mock_provider block into a module's tests to run them offline too.
+
+mock_provider "hyperping" {
+  mock_resource "hyperping_statuspage_subscriber" {
+    defaults = {
+      id = 1001
+    }
+  }
+}
+
+run "plan" {
+  command = plan
+
+  assert {
+    condition     = hyperping_statuspage_subscriber.example.statuspage_uuid == "sp_mock01"
+    error_message = "statuspage_uuid should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_statuspage_subscriber.example.type == "email"
+    error_message = "type should be planned as configured"
+  }
+
+  assert {
+    condition     = hyperping_statuspage_subscriber.example.language == "en"
+    error_message = "language should be planned as configured"
+  }
+}
+
+run "apply" {
+  assert {
+    condition     = hyperping_statuspage_subscriber.example.id == 1001
+    error_message = "id should be set from the mock defaults"
+  }
+}
@@ -0,0 +1,434 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// Package fakeapi is an in-memory stand-in for the Hyperping REST API. It
+// serves the endpoints the provider and the command line tools read and
+// write, seeded from a JSON fixture, so end-to-end runs need neither an
+// account nor network access.
+//
+// Resources are kept as plain JSON objects in the shape the API returns them.
+// The fake checks the bearer token and resource IDs, wraps responses the way
+// each endpoint does, and merges writes into the stored object; it does not
+// validate request fields beyond that.
+package fakeapi
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// Object is a resource as the API returns it.
+type Object = map[string]any
+
+// Fixture is the initial content of a Server.
+type Fixture struct {
+	Monitors     []Object `json:"monitors"`
+	Healthchecks []Object `json:"healthchecks"`
+	StatusPages  []Object `json:"statuspages"`
+	Incidents    []Object `json:"incidents"`
+	Maintenance  []Object `json:"maintenance"`
+	Outages      []Object `json:"outages"`
+}
+
+// LoadFixture reads a fixture from a JSON file.
+func LoadFixture(path string) (*Fixture, error) {
+	data, err := os.ReadFile(path) //nolint:gosec // path is supplied by the caller
+	if err != nil {
+		return nil, fmt.Errorf("reading fixture: %w", err)
+	}
+	return ParseFixture(data)
+}
+
+// ParseFixture decodes a fixture and checks that every resource has a unique,
+// valid uuid.
+func ParseFixture(data []byte) (*Fixture, error) {
+	var f Fixture
+	if err := json.Unmarshal(data, &f); err != nil {
+		return nil, fmt.Errorf("parsing fixture: %w", err)
+	}
+	for _, k := range kinds {
+		seen := map[string]bool{}
+		for i, obj := range *k.items(&f) {
+			id, _ := obj["uuid"].(string)
+			if !validID.MatchString(id) {
+				return nil, fmt.Errorf("%s[%d]: invalid uuid %q", k.name, i, id)
+			}
+			if seen[id] {
+				return nil, fmt.Errorf("%s[%d]: duplicate uuid %q", k.name, i, id)
+			}
+			seen[id] = true
+		}
+	}
+	return &f, nil
+}
+
+// validID mirrors the resource ID check of hyperping-go.
+var validID = regexp.MustCompile(`^[a-zA-Z0-9_-]{1,128}$`)
+
+// kind describes how one resource collection is addressed and how the API
+// wraps it.
+type kind struct {
+	// name is the fixture key, e.g. "monitors".
+	name string
+	// basePath is the collection path, e.g. "/v1/monitors".
+	basePath string
+	// idPrefix starts the uuid of created resources.
+	idPrefix string
+	// writable reports whether POST and PUT are served.
+	writable bool
+	items    func(f *Fixture) *[]Object
+
+	// list, get and write wrap the response bodies of the endpoint.
+	list  func(objs []Object) any
+	get   func(obj Object) any
+	write func(obj Object) any
+	// normalize maps request fields to the field names the API returns.
+	normalize func(req Object) Object
+}
+
+func unwrapped(obj Object) any { return obj }
+
+func wrapped(key string) func(Object) any {
+	return func(obj Object) any { return map[string]any{key: obj} }
+}
+
+func paged(key string) func([]Object) any {
+	return func(objs []Object) any {
+		return map[string]any{key: objs, "hasNextPage": false}
+	}
+}
+
+var kinds = []kind{
+	{
+		name:     "monitors",
+		basePath: hyperping.MonitorsBasePath,
+		idPrefix: "mon_",
+		writable: true,
+		items:    func(f *Fixture) *[]Object { return &f.Monitors },
+		list:     func(objs []Object) any { return objs },
+		get:      unwrapped,
+		write:    unwrapped,
+	},
+	{
+		name:     "healthchecks",
+		basePath: hyperping.HealthchecksBasePath,
+		idPrefix: "tok_",
+		writable: true,
+		items:    func(f *Fixture) *[]Object { return &f.Healthchecks },
+		list:     paged("healthchecks"),
+		get:      wrapped("healthcheck"),
+		write: func(obj Object) any {
+			return map[string]any{"message": "ok", "healthcheck": obj}
+		},
+		normalize: normalizeHealthcheck,
+	},
+	{
+		name:     "statuspages",
+		basePath: hyperping.StatuspagesBasePath,
+		items:    func(f *Fixture) *[]Object { return &f.StatusPages },
+		list: func(objs []Object) any {
+			return map[string]any{
+				"statuspages":    objs,
+				"hasNextPage":    false,
+				"total":          len(objs),
+				"page":           0,
+				"resultsPerPage": len(objs),
+			}
+		},
+		get: wrapped("statuspage"),
+	},
+	{
+		name:     "incidents",
+		basePath: hyperping.IncidentsBasePath,
+		items:    func(f *Fixture) *[]Object { return &f.Incidents },
+		list:     func(objs []Object) any { return objs },
+		get:      unwrapped,
+	},
+	{
+		name:     "maintenance",
+		basePath: hyperping.MaintenanceBasePath,
+		items:    func(f *Fixture) *[]Object { return &f.Maintenance },
+		list:     paged("maintenanceWindows"),
+		get:      unwrapped,
+	},
+	{
+		name:     "outages",
+		basePath: hyperping.OutagesBasePath,
+		items:    func(f *Fixture) *[]Object { return &f.Outages },
+		list:     paged("outages"),
+		get:      wrapped("outage"),
+	},
+}
+
+// healthcheckFields maps the snake_case request fields of a healthcheck to
+// the camelCase fields the API returns.
+var healthcheckFields = map[string]string{
+	"period_value":       "periodValue",
+	"period_type":        "periodType",
+	"grace_period_value": "gracePeriodValue",
+	"grace_period_type":  "gracePeriodType",
+}
+
+func normalizeHealthcheck(req Object) Object {
+	out := make(Object, len(req))
+	for k, v := range req {
+		if name, ok := healthcheckFields[k]; ok {
+			k = name
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// collection is the stored content of one kind, in insertion order.
+type collection struct {
+	kind  kind
+	order []string
+	items map[string]Object
+}
+
+func (c *collection) list() []Object {
+	objs := make([]Object, 0, len(c.order))
+	for _, id := range c.order {
+		objs = append(objs, c.items[id])
+	}
+	return objs
+}
+
+func (c *collection) put(obj Object) {
+	id := obj["uuid"].(string)
+	if _, ok := c.items[id]; !ok {
+		c.order = append(c.order, id)
+	}
+	c.items[id] = obj
+}
+
+func (c *collection) remove(id string) {
+	delete(c.items, id)
+	for i, o := range c.order {
+		if o == id {
+			c.order = append(c.order[:i], c.order[i+1:]...)
+			return
+		}
+	}
+}
+
+// Server is a running fake API.
+type Server struct {
+	apiKey string
+	srv    *httptest.Server
+
+	mu          sync.Mutex
+	collections []*collection
+	nextID      int
+	requests    map[string]int
+}
+
+// NewServer starts a fake API seeded with f that accepts apiKey as its only
+// bearer token. Close must be called to release it.
+func NewServer(apiKey string, f *Fixture) *Server {
+	s := &Server{apiKey: apiKey, requests: map[string]int{}}
+	if f == nil {
+		f = &Fixture{}
+	}
+	for _, k := range kinds {
+		c := &collection{kind: k, items: map[string]Object{}}
+		for _, obj := range *k.items(f) {
+			c.put(clone(obj))
+		}
+		s.collections = append(s.collections, c)
+	}
+	s.srv = httptest.NewServer(http.HandlerFunc(s.serveHTTP))
+	return s
+}
+
+// URL returns the base URL to pass to the client, the provider's base_url, or
+// a tool's --base-url.
+func (s *Server) URL() string { return s.srv.URL }
+
+// Close shuts the server down.
+func (s *Server) Close() { s.srv.Close() }
+
+// IDs returns the uuids of the resources of the named collection, e.g.
+// "monitors", in insertion order.
+func (s *Server) IDs(name string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, c := range s.collections {
+		if c.kind.name == name {
+			return append([]string(nil), c.order...)
+		}
+	}
+	return nil
+}
+
+// Requests returns the number of requests served per "METHOD /path" with
+// resource IDs replaced by "{id}", e.g. "GET /v1/monitors/{id}".
+func (s *Server) Requests() map[string]int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	out := make(map[string]int, len(s.requests))
+	for k, v := range s.requests {
+		out[k] = v
+	}
+	return out
+}
+
+// RequestKeys returns the keys of Requests in sorted order.
+func (s *Server) RequestKeys() []string {
+	reqs := s.Requests()
+	keys := make([]string, 0, len(reqs))
+	for k := range reqs {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func (s *Server) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Header.Get(hyperping.HeaderAuthorization) != hyperping.BearerPrefix+s.apiKey {
+		writeError(w, http.StatusUnauthorized, "invalid API key")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	c, id := s.route(r.URL.Path)
+	if c == nil {
+		s.count(r.Method, r.URL.Path)
+		writeError(w, http.StatusNotFound, "no such endpoint")
+		return
+	}
+	if id == "" {
+		s.count(r.Method, c.kind.basePath)
+	} else {
+		s.count(r.Method, c.kind.basePath+"/{id}")
+	}
+
+	switch {
+	case id == "" && r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, c.kind.list(c.list()))
+	case id == "" && r.Method == http.MethodPost && c.kind.writable:
+		req, ok := readObject(w, r, c.kind)
+		if !ok {
+			return
+		}
+		s.nextID++
+		req["uuid"] = fmt.Sprintf("%sfake%04d", c.kind.idPrefix, s.nextID)
+		c.put(req)
+		writeJSON(w, http.StatusCreated, c.kind.write(req))
+	case id != "":
+		s.serveItem(w, r, c, id)
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+func (s *Server) serveItem(w http.ResponseWriter, r *http.Request, c *collection, id string) {
+	if !validID.MatchString(id) {
+		writeError(w, http.StatusBadRequest, "invalid resource ID")
+		return
+	}
+	obj, ok := c.items[id]
+	if !ok {
+		writeError(w, http.StatusNotFound, "resource not found")
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet:
+		writeJSON(w, http.StatusOK, c.kind.get(obj))
+	case r.Method == http.MethodPut && c.kind.writable:
+		req, ok := readObject(w, r, c.kind)
+		if !ok {
+			return
+		}
+		merged := clone(obj)
+		for k, v := range req {
+			merged[k] = v
+		}
+		merged["uuid"] = id
+		c.put(merged)
+		writeJSON(w, http.StatusOK, c.kind.write(merged))
+	case r.Method == http.MethodDelete:
+		c.remove(id)
+		writeJSON(w, http.StatusOK, map[string]any{"message": "deleted"})
+	default:
+		writeError(w, http.StatusMethodNotAllowed, "method not allowed")
+	}
+}
+
+// route returns the collection path addresses and the resource ID, if any.
+func (s *Server) route(path string) (*collection, string) {
+	for _, c := range s.collections {
+		rest, ok := strings.CutPrefix(path, c.kind.basePath)
+		if !ok {
+			continue
+		}
+		switch {
+		case rest == "" || rest == "/":
+			return c, ""
+		case strings.HasPrefix(rest, "/") && !strings.Contains(rest[1:], "/"):
+			return c, rest[1:]
+		}
+	}
+	return nil, ""
+}
+
+func (s *Server) count(method, path string) {
+	s.requests[method+" "+path]++
+}
+
+// maxBodyBytes bounds request bodies; fixtures and provider requests are far
+// smaller.
+const maxBodyBytes = 1 << 20
+
+func readObject(w http.ResponseWriter, r *http.Request, k kind) (Object, bool) {
+	body, err := io.ReadAll(io.LimitReader(r.Body, maxBodyBytes))
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "reading request body")
+		return nil, false
+	}
+	var req Object
+	if err := json.Unmarshal(body, &req); err != nil || req == nil {
+		writeError(w, http.StatusBadRequest, "request body must be a JSON object")
+		return nil, false
+	}
+	if k.normalize != nil {
+		req = k.normalize(req)
+	}
+	return req, true
+}
+
+func writeJSON(w http.ResponseWriter, status int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(v) //nolint:errcheck // the client sees a truncated body
+}
+
+func writeError(w http.ResponseWriter, status int, msg string) {
+	writeJSON(w, status, map[string]any{"error": msg})
+}
+
+// clone returns a deep copy of obj so stored resources never share state
+// with callers.
+func clone(obj Object) Object {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return Object{}
+	}
+	var out Object
+	_ = json.Unmarshal(data, &out) //nolint:errcheck // data was just marshaled from an Object
+	return out
+}
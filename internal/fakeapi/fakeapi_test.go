@@ -0,0 +1,198 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package fakeapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+const testKey = "sk_fake_test"
+
+const testFixture = `{
+  "monitors": [
+    {"uuid": "mon_one", "name": "One", "url": "https://one.example.com", "protocol": "http", "check_frequency": 60}
+  ],
+  "healthchecks": [
+    {"uuid": "tok_one", "name": "Backup", "cron": "0 2 * * *", "tz": "UTC"}
+  ],
+  "statuspages": [
+    {"uuid": "sp_one", "name": "Status", "hostedsubdomain": "one", "settings": {"name": "Status"}}
+  ],
+  "incidents": [
+    {"uuid": "inci_one", "title": {"en": "Down"}, "type": "incident", "statuspages": ["sp_one"]}
+  ],
+  "maintenance": [
+    {"uuid": "mw_one", "name": "upgrade", "monitors": ["mon_one"]}
+  ],
+  "outages": [
+    {"uuid": "out_one", "startDate": "2026-01-01T00:00:00Z", "monitor": {"uuid": "mon_one", "name": "One"}}
+  ]
+}`
+
+func newTestServer(t *testing.T) (*Server, *hyperping.Client) {
+	t.Helper()
+	f, err := ParseFixture([]byte(testFixture))
+	if err != nil {
+		t.Fatalf("ParseFixture: %v", err)
+	}
+	s := NewServer(testKey, f)
+	t.Cleanup(s.Close)
+	return s, hyperping.NewClient(testKey, hyperping.WithBaseURL(s.URL()), hyperping.WithMaxRetries(0))
+}
+
+func TestServer_Lists(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	monitors, err := c.ListMonitors(ctx)
+	if err != nil || len(monitors) != 1 || monitors[0].UUID != "mon_one" {
+		t.Fatalf("ListMonitors = %+v, %v", monitors, err)
+	}
+	healthchecks, err := c.ListHealthchecks(ctx)
+	if err != nil || len(healthchecks) != 1 || healthchecks[0].GetTimezone() != "UTC" {
+		t.Fatalf("ListHealthchecks = %+v, %v", healthchecks, err)
+	}
+	pages, err := c.ListStatusPages(ctx, nil, nil)
+	if err != nil || pages.Total != 1 || pages.StatusPages[0].HostedSubdomain != "one" {
+		t.Fatalf("ListStatusPages = %+v, %v", pages, err)
+	}
+	incidents, err := c.ListIncidents(ctx)
+	if err != nil || len(incidents) != 1 || incidents[0].Title.En != "Down" {
+		t.Fatalf("ListIncidents = %+v, %v", incidents, err)
+	}
+	maintenance, err := c.ListMaintenance(ctx)
+	if err != nil || len(maintenance) != 1 || maintenance[0].Name != "upgrade" {
+		t.Fatalf("ListMaintenance = %+v, %v", maintenance, err)
+	}
+	outages, err := c.ListOutages(ctx)
+	if err != nil || len(outages) != 1 || outages[0].Monitor.UUID != "mon_one" {
+		t.Fatalf("ListOutages = %+v, %v", outages, err)
+	}
+}
+
+func TestServer_Gets(t *testing.T) {
+	_, c := newTestServer(t)
+	ctx := context.Background()
+
+	if hc, err := c.GetHealthcheck(ctx, "tok_one"); err != nil || hc.Name != "Backup" {
+		t.Errorf("GetHealthcheck = %+v, %v", hc, err)
+	}
+	if sp, err := c.GetStatusPage(ctx, "sp_one"); err != nil || sp.Name != "Status" {
+		t.Errorf("GetStatusPage = %+v, %v", sp, err)
+	}
+	if o, err := c.GetOutage(ctx, "out_one"); err != nil || o.Monitor.Name != "One" {
+		t.Errorf("GetOutage = %+v, %v", o, err)
+	}
+	if m, err := c.GetMaintenance(ctx, "mw_one"); err != nil || m.Name != "upgrade" {
+		t.Errorf("GetMaintenance = %+v, %v", m, err)
+	}
+}
+
+func TestServer_MonitorCRUD(t *testing.T) {
+	s, c := newTestServer(t)
+	ctx := context.Background()
+
+	created, err := c.CreateMonitor(ctx, hyperping.CreateMonitorRequest{
+		Name: "Two", URL: "https://two.example.com", Protocol: "http", CheckFrequency: 30,
+	})
+	if err != nil {
+		t.Fatalf("CreateMonitor: %v", err)
+	}
+	if created.UUID == "" || created.CheckFrequency != 30 {
+		t.Fatalf("created monitor = %+v", created)
+	}
+
+	name := "Two renamed"
+	updated, err := c.UpdateMonitor(ctx, created.UUID, hyperping.UpdateMonitorRequest{Name: &name})
+	if err != nil {
+		t.Fatalf("UpdateMonitor: %v", err)
+	}
+	if updated.Name != name || updated.URL != "https://two.example.com" {
+		t.Errorf("update did not merge: %+v", updated)
+	}
+
+	if got := s.IDs("monitors"); len(got) != 2 || got[1] != created.UUID {
+		t.Errorf("IDs = %v", got)
+	}
+
+	if err := c.DeleteMonitor(ctx, created.UUID); err != nil {
+		t.Fatalf("DeleteMonitor: %v", err)
+	}
+	_, err = c.GetMonitor(ctx, created.UUID)
+	if !hyperping.IsNotFound(err) {
+		t.Errorf("GetMonitor after delete: want not found, got %v", err)
+	}
+
+	reqs := s.Requests()
+	for _, key := range []string{"POST /v1/monitors", "PUT /v1/monitors/{id}", "DELETE /v1/monitors/{id}"} {
+		if reqs[key] != 1 {
+			t.Errorf("Requests()[%q] = %d, want 1", key, reqs[key])
+		}
+	}
+}
+
+func TestServer_HealthcheckCreateNormalizesFields(t *testing.T) {
+	_, c := newTestServer(t)
+	value, unit := 5, "minutes"
+
+	hc, err := c.CreateHealthcheck(context.Background(), hyperping.CreateHealthcheckRequest{
+		Name: "Worker", PeriodValue: &value, PeriodType: &unit, GracePeriodValue: 1, GracePeriodType: "minutes",
+	})
+	if err != nil {
+		t.Fatalf("CreateHealthcheck: %v", err)
+	}
+	if hc.PeriodValue == nil || *hc.PeriodValue != 5 || hc.PeriodType != "minutes" || hc.GracePeriodType != "minutes" {
+		t.Errorf("healthcheck fields not normalized: %+v", hc)
+	}
+}
+
+func TestServer_RejectsWrongKey(t *testing.T) {
+	s, _ := newTestServer(t)
+	c := hyperping.NewClient("sk_wrong", hyperping.WithBaseURL(s.URL()), hyperping.WithMaxRetries(0))
+
+	_, err := c.ListMonitors(context.Background())
+	var apiErr *hyperping.APIError
+	if !errors.As(err, &apiErr) || apiErr.StatusCode != http.StatusUnauthorized {
+		t.Fatalf("want 401, got %v", err)
+	}
+}
+
+func TestServer_ReadOnlyCollections(t *testing.T) {
+	s, _ := newTestServer(t)
+
+	req, err := http.NewRequest(http.MethodPost, s.URL()+hyperping.OutagesBasePath, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set(hyperping.HeaderAuthorization, hyperping.BearerPrefix+testKey)
+	resp, err := http.DefaultClient.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusMethodNotAllowed {
+		t.Errorf("POST outages: status %d, want 405", resp.StatusCode)
+	}
+}
+
+func TestParseFixture_Errors(t *testing.T) {
+	tests := map[string]string{
+		"invalid json":   `{`,
+		"missing uuid":   `{"monitors": [{"name": "x"}]}`,
+		"invalid uuid":   `{"monitors": [{"uuid": "../etc"}]}`,
+		"duplicate uuid": `{"outages": [{"uuid": "out_a"}, {"uuid": "out_a"}]}`,
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := ParseFixture([]byte(data)); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,389 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// auditRedactedFields are request fields whose values are never written to
+// the audit log: credentials, subscriber contact details, and monitor
+// request header values (header names are kept).
+var auditRedactedFields = map[string]bool{
+	"password":          true,
+	"value":             true,
+	"email":             true,
+	"phone":             true,
+	"teams_webhook_url": true,
+	"api_key":           true,
+	"token":             true,
+	"authorization":     true,
+}
+
+const auditRedacted = redacted
+
+// auditEntry is one line of the audit log.
+type auditEntry struct {
+	Timestamp    string         `json:"timestamp"`
+	Operation    string         `json:"operation"`
+	ResourceType string         `json:"resource_type"`
+	ID           string         `json:"id,omitempty"`
+	Request      map[string]any `json:"request,omitempty"`
+	Result       string         `json:"result"`
+	Error        string         `json:"error,omitempty"`
+}
+
+// auditLog appends an entry to a JSON Lines file for every create, update,
+// and delete the provider sends to the API. The file is opened in append
+// mode for each entry, so several provider processes (for example parallel
+// Terraform runs in CI) can share one log, and existing lines are never
+// rewritten. It is safe for concurrent use.
+//
+// Terraform does not tell providers the address of the resource being
+// changed, so entries identify resources by type and Hyperping ID.
+type auditLog struct {
+	path string
+	mu   sync.Mutex
+	now  func() time.Time
+}
+
+// newAuditLog checks that path can be opened for appending, creating it with
+// owner-only permissions if needed.
+func newAuditLog(path string) (*auditLog, error) {
+	path = filepath.Clean(path)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) // #nosec G304 -- path is operator-supplied provider configuration
+	if err != nil {
+		return nil, err
+	}
+	if err := f.Close(); err != nil {
+		return nil, err
+	}
+	return &auditLog{path: path, now: time.Now}, nil
+}
+
+// record appends an entry for a mutation. req is the API request body, or nil
+// for deletes; it is summarized with secrets redacted. A failure to write is
+// logged rather than returned: the API call has already happened, and failing
+// the operation would leave Terraform state out of step with the API.
+func (a *auditLog) record(ctx context.Context, operation, resourceType, id string, req any, opErr error) {
+	if a == nil {
+		return
+	}
+
+	entry := auditEntry{
+		Timestamp:    a.now().UTC().Format(time.RFC3339Nano),
+		Operation:    operation,
+		ResourceType: resourceType,
+		ID:           id,
+		Request:      auditRequestSummary(req),
+		Result:       "success",
+	}
+	if opErr != nil {
+		entry.Result = "error"
+		entry.Error = redactSecrets(opErr.Error())
+	}
+
+	if err := a.write(entry); err != nil {
+		tflog.Error(ctx, "Failed to write audit log entry", map[string]interface{}{
+			"path":          a.path,
+			"error":         err.Error(),
+			"operation":     operation,
+			"resource_type": resourceType,
+			"id":            id,
+		})
+	}
+}
+
+func (a *auditLog) write(entry auditEntry) error {
+	line, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	line = append(line, '\n')
+
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	f, err := os.OpenFile(a.path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) // #nosec G304 -- path is operator-supplied provider configuration
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(line); err != nil {
+		_ = f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// auditRequestSummary returns req as a JSON object with redacted fields and
+// API keys replaced, or nil when there is no request body.
+func auditRequestSummary(req any) map[string]any {
+	if req == nil {
+		return nil
+	}
+	data, err := json.Marshal(req)
+	if err != nil {
+		return map[string]any{"error": fmt.Sprintf("request not recorded: %s", err)}
+	}
+	var summary map[string]any
+	if err := json.Unmarshal(data, &summary); err != nil {
+		return nil
+	}
+	redactAuditValue(summary)
+	return summary
+}
+
+func redactAuditValue(v any) any {
+	switch val := v.(type) {
+	case map[string]any:
+		for key, field := range val {
+			if auditRedactedFields[key] && field != nil {
+				val[key] = auditRedacted
+				continue
+			}
+			val[key] = redactAuditValue(field)
+		}
+		return val
+	case []any:
+		for i := range val {
+			val[i] = redactAuditValue(val[i])
+		}
+		return val
+	case string:
+		return redactSecrets(val)
+	default:
+		return v
+	}
+}
+
+// auditedClient records every mutating call of the wrapped client in an
+// audit log. Reads pass through unchanged.
+type auditedClient struct {
+	hyperping.HyperpingAPI
+	audit *auditLog
+}
+
+var _ hyperping.HyperpingAPI = (*auditedClient)(nil)
+
+func newAuditedClient(client hyperping.HyperpingAPI, audit *auditLog) *auditedClient {
+	return &auditedClient{HyperpingAPI: client, audit: audit}
+}
+
+func (c *auditedClient) CreateMonitor(ctx context.Context, req hyperping.CreateMonitorRequest) (*hyperping.Monitor, error) {
+	m, err := c.HyperpingAPI.CreateMonitor(ctx, req)
+	id := ""
+	if m != nil {
+		id = m.UUID
+	}
+	c.audit.record(ctx, "create", "hyperping_monitor", id, req, err)
+	return m, err
+}
+
+func (c *auditedClient) UpdateMonitor(ctx context.Context, uuid string, req hyperping.UpdateMonitorRequest) (*hyperping.Monitor, error) {
+	m, err := c.HyperpingAPI.UpdateMonitor(ctx, uuid, req)
+	c.audit.record(ctx, "update", "hyperping_monitor", uuid, req, err)
+	return m, err
+}
+
+func (c *auditedClient) DeleteMonitor(ctx context.Context, uuid string) error {
+	err := c.HyperpingAPI.DeleteMonitor(ctx, uuid)
+	c.audit.record(ctx, "delete", "hyperping_monitor", uuid, nil, err)
+	return err
+}
+
+func (c *auditedClient) PauseMonitor(ctx context.Context, uuid string) (*hyperping.Monitor, error) {
+	m, err := c.HyperpingAPI.PauseMonitor(ctx, uuid)
+	c.audit.record(ctx, "pause", "hyperping_monitor", uuid, nil, err)
+	return m, err
+}
+
+func (c *auditedClient) ResumeMonitor(ctx context.Context, uuid string) (*hyperping.Monitor, error) {
+	m, err := c.HyperpingAPI.ResumeMonitor(ctx, uuid)
+	c.audit.record(ctx, "resume", "hyperping_monitor", uuid, nil, err)
+	return m, err
+}
+
+func (c *auditedClient) CreateIncident(ctx context.Context, req hyperping.CreateIncidentRequest) (*hyperping.Incident, error) {
+	i, err := c.HyperpingAPI.CreateIncident(ctx, req)
+	id := ""
+	if i != nil {
+		id = i.UUID
+	}
+	c.audit.record(ctx, "create", "hyperping_incident", id, req, err)
+	return i, err
+}
+
+func (c *auditedClient) UpdateIncident(ctx context.Context, id string, req hyperping.UpdateIncidentRequest) (*hyperping.Incident, error) {
+	i, err := c.HyperpingAPI.UpdateIncident(ctx, id, req)
+	c.audit.record(ctx, "update", "hyperping_incident", id, req, err)
+	return i, err
+}
+
+func (c *auditedClient) DeleteIncident(ctx context.Context, id string) error {
+	err := c.HyperpingAPI.DeleteIncident(ctx, id)
+	c.audit.record(ctx, "delete", "hyperping_incident", id, nil, err)
+	return err
+}
+
+func (c *auditedClient) AddIncidentUpdate(ctx context.Context, uuid string, req hyperping.AddIncidentUpdateRequest) (*hyperping.Incident, error) {
+	i, err := c.HyperpingAPI.AddIncidentUpdate(ctx, uuid, req)
+	c.audit.record(ctx, "create", "hyperping_incident_update", uuid, req, err)
+	return i, err
+}
+
+func (c *auditedClient) ResolveIncident(ctx context.Context, uuid string, message string) (*hyperping.Incident, error) {
+	i, err := c.HyperpingAPI.ResolveIncident(ctx, uuid, message)
+	c.audit.record(ctx, "resolve", "hyperping_incident", uuid, map[string]string{"message": message}, err)
+	return i, err
+}
+
+func (c *auditedClient) CreateMaintenance(ctx context.Context, req hyperping.CreateMaintenanceRequest) (*hyperping.Maintenance, error) {
+	m, err := c.HyperpingAPI.CreateMaintenance(ctx, req)
+	id := ""
+	if m != nil {
+		id = m.UUID
+	}
+	c.audit.record(ctx, "create", "hyperping_maintenance", id, req, err)
+	return m, err
+}
+
+func (c *auditedClient) UpdateMaintenance(ctx context.Context, id string, req hyperping.UpdateMaintenanceRequest) (*hyperping.Maintenance, error) {
+	m, err := c.HyperpingAPI.UpdateMaintenance(ctx, id, req)
+	c.audit.record(ctx, "update", "hyperping_maintenance", id, req, err)
+	return m, err
+}
+
+func (c *auditedClient) DeleteMaintenance(ctx context.Context, id string) error {
+	err := c.HyperpingAPI.DeleteMaintenance(ctx, id)
+	c.audit.record(ctx, "delete", "hyperping_maintenance", id, nil, err)
+	return err
+}
+
+func (c *auditedClient) CreateOutage(ctx context.Context, req hyperping.CreateOutageRequest) (*hyperping.Outage, error) {
+	o, err := c.HyperpingAPI.CreateOutage(ctx, req)
+	id := ""
+	if o != nil {
+		id = o.UUID
+	}
+	c.audit.record(ctx, "create", "hyperping_outage", id, req, err)
+	return o, err
+}
+
+func (c *auditedClient) AcknowledgeOutage(ctx context.Context, uuid string) (*hyperping.OutageAction, error) {
+	a, err := c.HyperpingAPI.AcknowledgeOutage(ctx, uuid)
+	c.audit.record(ctx, "acknowledge", "hyperping_outage", uuid, nil, err)
+	return a, err
+}
+
+func (c *auditedClient) UnacknowledgeOutage(ctx context.Context, uuid string) (*hyperping.OutageAction, error) {
+	a, err := c.HyperpingAPI.UnacknowledgeOutage(ctx, uuid)
+	c.audit.record(ctx, "unacknowledge", "hyperping_outage", uuid, nil, err)
+	return a, err
+}
+
+func (c *auditedClient) ResolveOutage(ctx context.Context, uuid string) (*hyperping.OutageAction, error) {
+	a, err := c.HyperpingAPI.ResolveOutage(ctx, uuid)
+	c.audit.record(ctx, "resolve", "hyperping_outage", uuid, nil, err)
+	return a, err
+}
+
+func (c *auditedClient) EscalateOutage(ctx context.Context, uuid string) (*hyperping.OutageAction, error) {
+	a, err := c.HyperpingAPI.EscalateOutage(ctx, uuid)
+	c.audit.record(ctx, "escalate", "hyperping_outage", uuid, nil, err)
+	return a, err
+}
+
+func (c *auditedClient) DeleteOutage(ctx context.Context, uuid string) error {
+	err := c.HyperpingAPI.DeleteOutage(ctx, uuid)
+	c.audit.record(ctx, "delete", "hyperping_outage", uuid, nil, err)
+	return err
+}
+
+func (c *auditedClient) CreateHealthcheck(ctx context.Context, req hyperping.CreateHealthcheckRequest) (*hyperping.Healthcheck, error) {
+	h, err := c.HyperpingAPI.CreateHealthcheck(ctx, req)
+	id := ""
+	if h != nil {
+		id = h.UUID
+	}
+	c.audit.record(ctx, "create", "hyperping_healthcheck", id, req, err)
+	return h, err
+}
+
+func (c *auditedClient) UpdateHealthcheck(ctx context.Context, uuid string, req hyperping.UpdateHealthcheckRequest) (*hyperping.Healthcheck, error) {
+	h, err := c.HyperpingAPI.UpdateHealthcheck(ctx, uuid, req)
+	c.audit.record(ctx, "update", "hyperping_healthcheck", uuid, req, err)
+	return h, err
+}
+
+func (c *auditedClient) DeleteHealthcheck(ctx context.Context, uuid string) error {
+	err := c.HyperpingAPI.DeleteHealthcheck(ctx, uuid)
+	c.audit.record(ctx, "delete", "hyperping_healthcheck", uuid, nil, err)
+	return err
+}
+
+func (c *auditedClient) PauseHealthcheck(ctx context.Context, uuid string) (*hyperping.HealthcheckAction, error) {
+	a, err := c.HyperpingAPI.PauseHealthcheck(ctx, uuid)
+	c.audit.record(ctx, "pause", "hyperping_healthcheck", uuid, nil, err)
+	return a, err
+}
+
+func (c *auditedClient) ResumeHealthcheck(ctx context.Context, uuid string) (*hyperping.HealthcheckAction, error) {
+	a, err := c.HyperpingAPI.ResumeHealthcheck(ctx, uuid)
+	c.audit.record(ctx, "resume", "hyperping_healthcheck", uuid, nil, err)
+	return a, err
+}
+
+func (c *auditedClient) CreateStatusPage(ctx context.Context, req hyperping.CreateStatusPageRequest) (*hyperping.StatusPage, error) {
+	sp, err := c.HyperpingAPI.CreateStatusPage(ctx, req)
+	id := ""
+	if sp != nil {
+		id = sp.UUID
+	}
+	c.audit.record(ctx, "create", "hyperping_statuspage", id, req, err)
+	return sp, err
+}
+
+func (c *auditedClient) UpdateStatusPage(ctx context.Context, uuid string, req hyperping.UpdateStatusPageRequest) (*hyperping.StatusPage, error) {
+	sp, err := c.HyperpingAPI.UpdateStatusPage(ctx, uuid, req)
+	c.audit.record(ctx, "update", "hyperping_statuspage", uuid, req, err)
+	return sp, err
+}
+
+func (c *auditedClient) DeleteStatusPage(ctx context.Context, uuid string) error {
+	err := c.HyperpingAPI.DeleteStatusPage(ctx, uuid)
+	c.audit.record(ctx, "delete", "hyperping_statuspage", uuid, nil, err)
+	return err
+}
+
+func (c *auditedClient) AddSubscriber(ctx context.Context, uuid string, req hyperping.AddSubscriberRequest) (*hyperping.StatusPageSubscriber, error) {
+	s, err := c.HyperpingAPI.AddSubscriber(ctx, uuid, req)
+	id := uuid
+	if s != nil {
+		id = subscriberAuditID(uuid, s.ID)
+	}
+	c.audit.record(ctx, "create", "hyperping_statuspage_subscriber", id, req, err)
+	return s, err
+}
+
+func (c *auditedClient) DeleteSubscriber(ctx context.Context, uuid string, subscriberID int) error {
+	err := c.HyperpingAPI.DeleteSubscriber(ctx, uuid, subscriberID)
+	c.audit.record(ctx, "delete", "hyperping_statuspage_subscriber", subscriberAuditID(uuid, subscriberID), nil, err)
+	return err
+}
+
+// subscriberAuditID formats a subscriber the way its import ID is written.
+func subscriberAuditID(statuspageUUID string, subscriberID int) string {
+	return statuspageUUID + ":" + strconv.Itoa(subscriberID)
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// fakeAuditAPI implements the mutations exercised below; any other method
+// panics through the nil embedded interface.
+type fakeAuditAPI struct {
+	hyperping.HyperpingAPI
+	deleteErr error
+}
+
+func (f *fakeAuditAPI) CreateMonitor(_ context.Context, req hyperping.CreateMonitorRequest) (*hyperping.Monitor, error) {
+	return &hyperping.Monitor{UUID: "mon_new", Name: req.Name}, nil
+}
+
+func (f *fakeAuditAPI) DeleteMonitor(_ context.Context, _ string) error {
+	return f.deleteErr
+}
+
+func (f *fakeAuditAPI) AddSubscriber(_ context.Context, _ string, _ hyperping.AddSubscriberRequest) (*hyperping.StatusPageSubscriber, error) {
+	return &hyperping.StatusPageSubscriber{ID: 42}, nil
+}
+
+func readAuditEntries(t *testing.T, path string) []auditEntry {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+
+	var entries []auditEntry
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		var entry auditEntry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			t.Fatalf("invalid audit line %q: %v", scanner.Text(), err)
+		}
+		entries = append(entries, entry)
+	}
+	if err := scanner.Err(); err != nil {
+		t.Fatal(err)
+	}
+	return entries
+}
+
+func TestAuditedClient_RecordsMutations(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit.jsonl")
+	audit, err := newAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	audit.now = func() time.Time { return time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC) }
+
+	client := newAuditedClient(&fakeAuditAPI{deleteErr: errors.New("boom for sk_abcdefghijklmnop1234")}, audit)
+	ctx := context.Background()
+
+	email := "ops@example.com"
+	if _, err := client.CreateMonitor(ctx, hyperping.CreateMonitorRequest{
+		Name:           "API",
+		URL:            "https://api.example.com",
+		RequestHeaders: []hyperping.RequestHeader{{Name: "Authorization", Value: "Bearer sk_abcdefghijklmnop1234"}},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.AddSubscriber(ctx, "sp_1", hyperping.AddSubscriberRequest{Type: "email", Email: &email}); err != nil {
+		t.Fatal(err)
+	}
+	if err := client.DeleteMonitor(ctx, "mon_new"); err == nil {
+		t.Fatal("expected delete error to be returned")
+	}
+
+	entries := readAuditEntries(t, path)
+	if len(entries) != 3 {
+		t.Fatalf("got %d entries, want 3", len(entries))
+	}
+
+	create := entries[0]
+	if create.Timestamp != "2026-03-01T12:00:00Z" || create.Operation != "create" ||
+		create.ResourceType != "hyperping_monitor" || create.ID != "mon_new" || create.Result != "success" {
+		t.Errorf("unexpected create entry: %+v", create)
+	}
+	if create.Request["name"] != "API" {
+		t.Errorf("request summary should keep non-secret fields, got %v", create.Request)
+	}
+	header := create.Request["request_headers"].([]any)[0].(map[string]any)
+	if header["name"] != "Authorization" || header["value"] != auditRedacted {
+		t.Errorf("header value should be redacted and name kept, got %v", header)
+	}
+
+	subscriber := entries[1]
+	if subscriber.ID != "sp_1:42" || subscriber.Request["email"] != auditRedacted || subscriber.Request["type"] != "email" {
+		t.Errorf("unexpected subscriber entry: %+v", subscriber)
+	}
+
+	del := entries[2]
+	if del.Operation != "delete" || del.Result != "error" || del.Request != nil {
+		t.Errorf("unexpected delete entry: %+v", del)
+	}
+	if strings.Contains(del.Error, "sk_") {
+		t.Errorf("API key leaked into error: %q", del.Error)
+	}
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, secret := range []string{"sk_abcdefghijklmnop1234", email} {
+		if strings.Contains(string(raw), secret) {
+			t.Errorf("audit log contains secret %q", secret)
+		}
+	}
+}
+
+func TestNewAuditLog(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "audit.jsonl")
+	if err := os.WriteFile(path, []byte("{\"existing\":true}\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	audit, err := newAuditLog(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	audit.record(context.Background(), "delete", "hyperping_incident", "inc_1", nil, nil)
+
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	lines := strings.Split(strings.TrimSpace(string(raw)), "\n")
+	if len(lines) != 2 || lines[0] != `{"existing":true}` {
+		t.Errorf("audit log must append to existing content, got:\n%s", raw)
+	}
+
+	if _, err := newAuditLog(filepath.Join(dir, "missing", "audit.jsonl")); err == nil {
+		t.Error("expected an error for a path in a missing directory")
+	}
+
+	var nilAudit *auditLog
+	nilAudit.record(context.Background(), "create", "hyperping_monitor", "", nil, nil)
+}
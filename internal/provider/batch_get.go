@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+const (
+	// batchGetConcurrency bounds the GET requests a single batched read keeps
+	// in flight. It stays well below maxConnsPerHost so one data source does
+	// not starve other operations sharing the client.
+	batchGetConcurrency = 8
+
+	// batchGetListThreshold is the number of UUIDs above which
+	// getMonitorsBatch lists all monitors once instead of fetching each one.
+	batchGetListThreshold = 25
+)
+
+// getMonitorsBatch returns the monitors with the given UUIDs, in the given
+// order with duplicates removed. A UUID that does not exist is an error.
+//
+// The Hyperping API has no batch GET endpoint. Up to batchGetListThreshold
+// UUIDs are fetched with one GET each, at most batchGetConcurrency at a time;
+// larger sets are served from a single ListMonitors call.
+func getMonitorsBatch(ctx context.Context, client hyperping.MonitorAPI, uuids []string) ([]hyperping.Monitor, error) {
+	uuids = uniqueStrings(uuids)
+
+	if len(uuids) > batchGetListThreshold {
+		all, err := client.ListMonitors(ctx)
+		if err != nil {
+			return nil, err
+		}
+		byUUID := make(map[string]hyperping.Monitor, len(all))
+		for _, m := range all {
+			byUUID[m.UUID] = m
+		}
+
+		monitors := make([]hyperping.Monitor, 0, len(uuids))
+		var missing []string
+		for _, uuid := range uuids {
+			m, ok := byUUID[uuid]
+			if !ok {
+				missing = append(missing, uuid)
+				continue
+			}
+			monitors = append(monitors, m)
+		}
+		if len(missing) > 0 {
+			return nil, fmt.Errorf("monitors not found: %s", strings.Join(missing, ", "))
+		}
+		return monitors, nil
+	}
+
+	return fetchConcurrently(ctx, uuids, batchGetConcurrency, func(ctx context.Context, uuid string) (hyperping.Monitor, error) {
+		m, err := client.GetMonitor(ctx, uuid)
+		if err != nil {
+			return hyperping.Monitor{}, fmt.Errorf("monitor %q: %w", uuid, err)
+		}
+		return *m, nil
+	})
+}
+
+// fetchConcurrently calls fetch for every key with at most limit calls in
+// flight and returns the results in key order. The first error cancels the
+// remaining calls and is returned.
+func fetchConcurrently[T any](ctx context.Context, keys []string, limit int, fetch func(context.Context, string) (T, error)) ([]T, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	fail := func(err error) {
+		once.Do(func() {
+			firstErr = err
+			cancel()
+		})
+	}
+
+	results := make([]T, len(keys))
+	sem := make(chan struct{}, limit)
+	for i, key := range keys {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			select {
+			case sem <- struct{}{}:
+				defer func() { <-sem }()
+			case <-ctx.Done():
+				fail(ctx.Err())
+				return
+			}
+			if err := ctx.Err(); err != nil {
+				fail(err)
+				return
+			}
+
+			result, err := fetch(ctx, key)
+			if err != nil {
+				fail(err)
+				return
+			}
+			results[i] = result
+		}()
+	}
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	return results, nil
+}
+
+// uniqueStrings returns values with duplicates removed, keeping the first
+// occurrence of each.
+func uniqueStrings(values []string) []string {
+	seen := make(map[string]struct{}, len(values))
+	unique := make([]string, 0, len(values))
+	for _, v := range values {
+		if _, ok := seen[v]; ok {
+			continue
+		}
+		seen[v] = struct{}{}
+		unique = append(unique, v)
+	}
+	return unique
+}
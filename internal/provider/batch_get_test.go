@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// batchGetServer serves GET /v1/monitors and GET /v1/monitors/{uuid} for
+// monitors mon_000 to mon_{count-1}, counting requests and peak concurrency.
+type batchGetServer struct {
+	*httptest.Server
+	listCalls, getCalls   atomic.Int64
+	inFlight, maxInFlight atomic.Int64
+}
+
+func newBatchGetServer(t *testing.T, count int) *batchGetServer {
+	t.Helper()
+	s := &batchGetServer{}
+	monitorJSON := func(uuid string) string {
+		return fmt.Sprintf(`{"uuid": %q, "name": "Monitor %s", "url": "https://example.com", "protocol": "http"}`, uuid, uuid)
+	}
+	exists := func(uuid string) bool {
+		var n int
+		_, err := fmt.Sscanf(uuid, "mon_%03d", &n)
+		return err == nil && n < count
+	}
+
+	s.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := s.inFlight.Add(1)
+		defer s.inFlight.Add(-1)
+		for {
+			peak := s.maxInFlight.Load()
+			if n <= peak || s.maxInFlight.CompareAndSwap(peak, n) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+
+		w.Header().Set(hyperping.HeaderContentType, hyperping.ContentTypeJSON)
+		if r.URL.Path == hyperping.MonitorsBasePath {
+			s.listCalls.Add(1)
+			items := make([]string, count)
+			for i := range count {
+				items[i] = monitorJSON(fmt.Sprintf("mon_%03d", i))
+			}
+			fmt.Fprintf(w, "[%s]", strings.Join(items, ","))
+			return
+		}
+
+		s.getCalls.Add(1)
+		uuid := strings.TrimPrefix(r.URL.Path, hyperping.MonitorsBasePath+"/")
+		if !exists(uuid) {
+			w.WriteHeader(http.StatusNotFound)
+			w.Write([]byte(`{"error": "Monitor not found"}`))
+			return
+		}
+		w.Write([]byte(monitorJSON(uuid)))
+	}))
+	t.Cleanup(s.Close)
+	return s
+}
+
+func (s *batchGetServer) client() *hyperping.Client {
+	return hyperping.NewClient("sk_test_key",
+		hyperping.WithBaseURL(s.URL),
+		hyperping.WithMaxRetries(0),
+	)
+}
+
+func monitorUUIDs(n int) []string {
+	uuids := make([]string, n)
+	for i := range n {
+		uuids[i] = fmt.Sprintf("mon_%03d", n-1-i) // reverse order
+	}
+	return uuids
+}
+
+func TestGetMonitorsBatch_FanOut(t *testing.T) {
+	server := newBatchGetServer(t, 50)
+	uuids := append(monitorUUIDs(20), "mon_005") // duplicate is dropped
+
+	monitors, err := getMonitorsBatch(context.Background(), server.client(), uuids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(monitors) != 20 {
+		t.Fatalf("got %d monitors, want 20", len(monitors))
+	}
+	for i, m := range monitors {
+		if m.UUID != uuids[i] {
+			t.Errorf("monitors[%d] = %q, want %q", i, m.UUID, uuids[i])
+		}
+	}
+	if got := server.getCalls.Load(); got != 20 {
+		t.Errorf("GET calls = %d, want 20", got)
+	}
+	if got := server.listCalls.Load(); got != 0 {
+		t.Errorf("list calls = %d, want 0", got)
+	}
+	if got := server.maxInFlight.Load(); got > batchGetConcurrency {
+		t.Errorf("server saw %d concurrent requests, want at most %d", got, batchGetConcurrency)
+	}
+}
+
+func TestGetMonitorsBatch_ListAboveThreshold(t *testing.T) {
+	server := newBatchGetServer(t, 200)
+	uuids := monitorUUIDs(100)
+
+	monitors, err := getMonitorsBatch(context.Background(), server.client(), uuids)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(monitors) != 100 || monitors[0].UUID != "mon_099" || monitors[99].UUID != "mon_000" {
+		t.Errorf("unexpected monitors: %d, first %q", len(monitors), monitors[0].UUID)
+	}
+	if server.listCalls.Load() != 1 || server.getCalls.Load() != 0 {
+		t.Errorf("list calls = %d, GET calls = %d, want 1 and 0", server.listCalls.Load(), server.getCalls.Load())
+	}
+}
+
+func TestGetMonitorsBatch_NotFound(t *testing.T) {
+	server := newBatchGetServer(t, 10)
+
+	_, err := getMonitorsBatch(context.Background(), server.client(), []string{"mon_001", "mon_404"})
+	if !hyperping.IsNotFound(err) || !strings.Contains(err.Error(), `"mon_404"`) {
+		t.Errorf("expected not found error naming mon_404, got %v", err)
+	}
+
+	uuids := append(monitorUUIDs(batchGetListThreshold), "mon_404", "mon_500")
+	_, err = getMonitorsBatch(context.Background(), server.client(), uuids)
+	if err == nil || !strings.Contains(err.Error(), "mon_404, mon_500") {
+		t.Errorf("expected error listing missing monitors, got %v", err)
+	}
+}
+
+func TestFetchConcurrently_FirstErrorCancels(t *testing.T) {
+	errBoom := errors.New("boom")
+	keys := make([]string, 20)
+	for i := range keys {
+		keys[i] = fmt.Sprint(i)
+	}
+
+	// Only key 7 fails; every other call blocks until cancelled, so the
+	// call returns promptly only if the failure cancels the rest. The limit
+	// admits every call so key 7 is not queued behind blocked calls.
+	start := time.Now()
+	_, err := fetchConcurrently(context.Background(), keys, len(keys), func(ctx context.Context, key string) (string, error) {
+		if key == "7" {
+			return "", errBoom
+		}
+		select {
+		case <-ctx.Done():
+			return "", ctx.Err()
+		case <-time.After(5 * time.Second):
+			return key, nil
+		}
+	})
+	if !errors.Is(err, errBoom) {
+		t.Errorf("err = %v, want %v", err, errBoom)
+	}
+	if elapsed := time.Since(start); elapsed > 2*time.Second {
+		t.Errorf("took %s, want remaining calls cancelled", elapsed)
+	}
+}
+
+func TestFetchConcurrently_CancelledContext(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err := fetchConcurrently(ctx, []string{"a", "b"}, 1, func(ctx context.Context, key string) (string, error) {
+		return key, ctx.Err()
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Errorf("err = %v, want context.Canceled", err)
+	}
+}
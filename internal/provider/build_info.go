@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"runtime/debug"
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// BuildInfo identifies a provider build. Release builds get Version and
+// Commit from the goreleaser ldflags in main; other builds fall back to the
+// VCS revision the Go toolchain stamps into the binary.
+type BuildInfo struct {
+	Version string
+	Commit  string
+}
+
+const (
+	// providerProduct is the User-Agent product name of the provider.
+	providerProduct = "terraform-provider-hyperping"
+	// clientLibraryPath is the module path of the API client library.
+	clientLibraryPath = "github.com/develeap/hyperping-go"
+	// unknownBuildValue is reported for build metadata that is not available.
+	unknownBuildValue = "unknown"
+	// shortCommitLength is the length of the commit in the User-Agent.
+	shortCommitLength = 12
+)
+
+// readBuildInfo is debug.ReadBuildInfo; tests replace it.
+var readBuildInfo = debug.ReadBuildInfo
+
+// resolved returns b with the commit read from the binary's VCS stamp when
+// the linker did not set one, as for go install and local builds.
+func (b BuildInfo) resolved() BuildInfo {
+	if b.Version == "" {
+		b.Version = "dev"
+	}
+	if b.Commit == "none" {
+		b.Commit = ""
+	}
+	if b.Commit == "" {
+		if info, ok := readBuildInfo(); ok {
+			for _, s := range info.Settings {
+				if s.Key == "vcs.revision" {
+					b.Commit = s.Value
+				}
+			}
+		}
+	}
+	return b
+}
+
+// clientLibraryVersion returns the version of hyperping-go compiled into the
+// provider, following replace directives.
+func clientLibraryVersion() string {
+	info, ok := readBuildInfo()
+	if !ok {
+		return unknownBuildValue
+	}
+	for _, dep := range info.Deps {
+		if dep.Path != clientLibraryPath {
+			continue
+		}
+		if dep.Replace != nil && dep.Replace.Version != "" {
+			return dep.Replace.Version
+		}
+		if dep.Version != "" {
+			return dep.Version
+		}
+	}
+	return unknownBuildValue
+}
+
+// apiVersions returns the REST API version each endpoint family is pinned to
+// by hyperping-go, e.g. "monitors" → "v1".
+func apiVersions() map[string]string {
+	paths := map[string]string{
+		"healthchecks": hyperping.HealthchecksBasePath,
+		"incidents":    hyperping.IncidentsBasePath,
+		"maintenance":  hyperping.MaintenanceBasePath,
+		"monitors":     hyperping.MonitorsBasePath,
+		"outages":      hyperping.OutagesBasePath,
+		"reports":      hyperping.ReportsBasePath,
+		"statuspages":  hyperping.StatuspagesBasePath,
+	}
+	versions := make(map[string]string, len(paths))
+	for name, p := range paths {
+		version, _, _ := strings.Cut(strings.TrimPrefix(p, "/"), "/")
+		versions[name] = version
+	}
+	return versions
+}
+
+// userAgentProduct returns the product token the provider appends to the
+// User-Agent of every request, e.g.
+// "terraform-provider-hyperping/1.9.0 (commit 1a2b3c4d5e6f)". With usage
+// telemetry enabled it is followed by the Terraform CLI version, e.g.
+// "Terraform/1.9.5". Nothing identifying the user or the configuration is
+// included either way.
+func userAgentProduct(b BuildInfo, terraformVersion string, usageTelemetry bool) string {
+	product := providerProduct + "/" + b.Version
+	if b.Commit != "" {
+		commit := b.Commit
+		if len(commit) > shortCommitLength {
+			commit = commit[:shortCommitLength]
+		}
+		product += " (commit " + commit + ")"
+	}
+	if usageTelemetry && terraformVersion != "" {
+		product += " Terraform/" + terraformVersion
+	}
+	return product
+}
+
+// userAgentTransport appends product to the User-Agent of every request.
+// hyperping-go sets its own User-Agent before the transport chain runs, so
+// the provider's token follows the client library's.
+type userAgentTransport struct {
+	next    http.RoundTripper
+	product string
+}
+
+func newUserAgentTransport(next http.RoundTripper, product string) *userAgentTransport {
+	return &userAgentTransport{next: next, product: product}
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	ua := t.product
+	if existing := req.Header.Get("User-Agent"); existing != "" {
+		ua = existing + " " + t.product
+	}
+	req.Header.Set("User-Agent", ua)
+	return t.next.RoundTrip(req)
+}
+
+// providerInfo is what hyperping_provider_info reports about the configured
+// provider.
+type providerInfo struct {
+	build            BuildInfo
+	terraformVersion string
+	usageTelemetry   bool
+	// userAgent is the product token added to every request.
+	userAgent string
+}
@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"runtime/debug"
+	"strings"
+	"testing"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func stubBuildInfo(t *testing.T, info *debug.BuildInfo) {
+	t.Helper()
+	orig := readBuildInfo
+	readBuildInfo = func() (*debug.BuildInfo, bool) { return info, info != nil }
+	t.Cleanup(func() { readBuildInfo = orig })
+}
+
+func TestBuildInfo_Resolved(t *testing.T) {
+	stubBuildInfo(t, &debug.BuildInfo{Settings: []debug.BuildSetting{{Key: "vcs.revision", Value: "0123456789abcdef"}}})
+
+	tests := []struct {
+		name string
+		in   BuildInfo
+		want BuildInfo
+	}{
+		{"release", BuildInfo{Version: "1.9.0", Commit: "feedface"}, BuildInfo{Version: "1.9.0", Commit: "feedface"}},
+		{"no commit from linker", BuildInfo{Version: "1.9.0"}, BuildInfo{Version: "1.9.0", Commit: "0123456789abcdef"}},
+		{"goreleaser placeholder", BuildInfo{Version: "1.9.0", Commit: "none"}, BuildInfo{Version: "1.9.0", Commit: "0123456789abcdef"}},
+		{"no version", BuildInfo{}, BuildInfo{Version: "dev", Commit: "0123456789abcdef"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.in.resolved(); got != tt.want {
+				t.Errorf("resolved() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBuildInfo_ResolvedWithoutBuildInfo(t *testing.T) {
+	stubBuildInfo(t, nil)
+
+	if got := (BuildInfo{Version: "dev"}).resolved(); got.Commit != "" {
+		t.Errorf("commit = %q, want empty", got.Commit)
+	}
+	if got := clientLibraryVersion(); got != unknownBuildValue {
+		t.Errorf("clientLibraryVersion() = %q, want %q", got, unknownBuildValue)
+	}
+}
+
+func TestClientLibraryVersion(t *testing.T) {
+	tests := []struct {
+		name string
+		deps []*debug.Module
+		want string
+	}{
+		{"required", []*debug.Module{{Path: clientLibraryPath, Version: "v0.7.1"}}, "v0.7.1"},
+		{"replaced", []*debug.Module{{Path: clientLibraryPath, Version: "v0.7.1", Replace: &debug.Module{Path: clientLibraryPath, Version: "v0.8.0-rc1"}}}, "v0.8.0-rc1"},
+		{"local replace", []*debug.Module{{Path: clientLibraryPath, Version: "v0.7.1", Replace: &debug.Module{Path: "../hyperping-go"}}}, "v0.7.1"},
+		{"missing", []*debug.Module{{Path: "example.com/other", Version: "v1.0.0"}}, unknownBuildValue},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			stubBuildInfo(t, &debug.BuildInfo{Deps: tt.deps})
+			if got := clientLibraryVersion(); got != tt.want {
+				t.Errorf("clientLibraryVersion() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestAPIVersions(t *testing.T) {
+	versions := apiVersions()
+	for family, want := range map[string]string{
+		"monitors":     "v1",
+		"healthchecks": "v2",
+		"statuspages":  "v2",
+		"incidents":    "v3",
+		"maintenance":  "v1",
+		"outages":      "v2",
+		"reports":      "v2",
+	} {
+		if versions[family] != want {
+			t.Errorf("apiVersions()[%q] = %q, want %q", family, versions[family], want)
+		}
+	}
+}
+
+func TestUserAgentProduct(t *testing.T) {
+	tests := []struct {
+		name      string
+		build     BuildInfo
+		tf        string
+		telemetry bool
+		want      string
+	}{
+		{"release", BuildInfo{Version: "1.9.0", Commit: "0123456789abcdef"}, "1.9.5", false, "terraform-provider-hyperping/1.9.0 (commit 0123456789ab)"},
+		{"no commit", BuildInfo{Version: "dev"}, "1.9.5", false, "terraform-provider-hyperping/dev"},
+		{"telemetry", BuildInfo{Version: "1.9.0", Commit: "abc"}, "1.9.5", true, "terraform-provider-hyperping/1.9.0 (commit abc) Terraform/1.9.5"},
+		{"telemetry without terraform version", BuildInfo{Version: "1.9.0"}, "", true, "terraform-provider-hyperping/1.9.0"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := userAgentProduct(tt.build, tt.tf, tt.telemetry); got != tt.want {
+				t.Errorf("userAgentProduct() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestUserAgentTransport_AppendsToClientUserAgent(t *testing.T) {
+	var got string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		got = r.Header.Get("User-Agent")
+		w.Header().Set(hyperping.HeaderContentType, hyperping.ContentTypeJSON)
+		w.Write([]byte(`{"uuid": "mon_1", "name": "Monitor", "url": "https://example.com", "protocol": "http"}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	httpClient, err := newHTTPClient(transportConfig{}, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := hyperping.NewClient("sk_test_key", hyperping.WithBaseURL(server.URL), hyperping.WithHTTPClient(httpClient), hyperping.WithVersion("1.9.0"))
+	httpClient.Transport = newUserAgentTransport(httpClient.Transport, "terraform-provider-hyperping/1.9.0 (commit abc)")
+
+	if _, err := client.GetMonitor(context.Background(), "mon_1"); err != nil {
+		t.Fatal(err)
+	}
+	if !strings.HasPrefix(got, "hyperping-go/1.9.0 ") || !strings.HasSuffix(got, " terraform-provider-hyperping/1.9.0 (commit abc)") {
+		t.Errorf("User-Agent = %q", got)
+	}
+}
+
+func TestUserAgentTransport_NoExistingUserAgent(t *testing.T) {
+	var got string
+	next := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+		got = r.Header.Get("User-Agent")
+		return &http.Response{StatusCode: http.StatusNoContent, Body: http.NoBody, Request: r}, nil
+	})
+
+	req := httptest.NewRequest(http.MethodPost, "https://api.hyperping.io/v1/mcp", nil)
+	req.Header.Del("User-Agent")
+	resp, err := newUserAgentTransport(next, "terraform-provider-hyperping/dev").RoundTrip(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if got != "terraform-provider-hyperping/dev" {
+		t.Errorf("User-Agent = %q", got)
+	}
+	if req.Header.Get("User-Agent") != "" {
+		t.Error("the transport must not modify the caller's request")
+	}
+}
+
+type roundTripFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(r *http.Request) (*http.Response, error) { return f(r) }
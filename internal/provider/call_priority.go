@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// rateLimitHold is how long read calls are held back after a rate limited
+// response. Every further 429 extends the hold, so reads resume only once the
+// API has stopped rejecting requests for this long.
+const rateLimitHold = 10 * time.Second
+
+// callPriority is the intent of an API call. Under quota pressure, reads are
+// slowed or shed first so that refreshes cannot starve the mutations of an
+// apply.
+type callPriority int
+
+const (
+	// priorityRead marks calls made to refresh state or read data sources.
+	// It is the default for read calls.
+	priorityRead callPriority = iota
+	// priorityMutation marks calls made while applying a change, including
+	// the reads a resource makes before or after its mutation.
+	priorityMutation
+)
+
+type callPriorityKey struct{}
+
+// withCallPriority marks the calls made with ctx as having priority p.
+// Resources mark their Create, Update, and Delete contexts so the reads they
+// make while applying are not held back with refreshes.
+func withCallPriority(ctx context.Context, p callPriority) context.Context {
+	return context.WithValue(ctx, callPriorityKey{}, p)
+}
+
+// callPriorityFrom returns the priority marked on ctx, or priorityRead.
+func callPriorityFrom(ctx context.Context) callPriority {
+	if p, ok := ctx.Value(callPriorityKey{}).(callPriority); ok {
+		return p
+	}
+	return priorityRead
+}
+
+// callScheduler holds back read calls while the API is rate limiting the
+// provider. Mutations are never held: the client retries them with backoff
+// as before, and the quota freed by the held reads goes to them. It is safe
+// for concurrent use.
+type callScheduler struct {
+	hold time.Duration
+	now  func() time.Time
+
+	mu        sync.Mutex
+	holdUntil time.Time
+}
+
+func newCallScheduler() *callScheduler {
+	return &callScheduler{hold: rateLimitHold, now: time.Now}
+}
+
+// rateLimited records a 429 response, holding reads for s.hold from now.
+func (s *callScheduler) rateLimited() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if until := s.now().Add(s.hold); until.After(s.holdUntil) {
+		s.holdUntil = until
+	}
+}
+
+// heldFor returns how long a read must still wait.
+func (s *callScheduler) heldFor() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.holdUntil.Sub(s.now())
+}
+
+// wait blocks a read-priority call until reads are no longer held. A read
+// whose deadline ends before the hold does is shed immediately with a rate
+// limit error rather than waiting for a timeout it cannot avoid.
+func (s *callScheduler) wait(ctx context.Context) error {
+	if callPriorityFrom(ctx) == priorityMutation {
+		return nil
+	}
+	for {
+		held := s.heldFor()
+		if held <= 0 {
+			return nil
+		}
+		if deadline, ok := ctx.Deadline(); ok && deadline.Before(s.now().Add(held)) {
+			return fmt.Errorf("read shed while the Hyperping API is rate limiting requests: %w", hyperping.ErrRateLimited)
+		}
+
+		tflog.Debug(ctx, "Holding Hyperping read while the API is rate limiting requests", map[string]interface{}{
+			"hold": held.String(),
+		})
+		timer := time.NewTimer(held)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return ctx.Err()
+		case <-timer.C:
+		}
+		// The hold may have been extended while waiting.
+	}
+}
+
+// scheduledClient passes every read call through a callScheduler. Mutations
+// go straight to the embedded client.
+type scheduledClient struct {
+	hyperping.HyperpingAPI
+	scheduler *callScheduler
+}
+
+var _ hyperping.HyperpingAPI = (*scheduledClient)(nil)
+
+func newScheduledClient(client hyperping.HyperpingAPI, scheduler *callScheduler) *scheduledClient {
+	return &scheduledClient{HyperpingAPI: client, scheduler: scheduler}
+}
+
+// scheduledRead waits for the scheduler, then runs call.
+func scheduledRead[T any](ctx context.Context, s *callScheduler, call func() (T, error)) (T, error) {
+	if err := s.wait(ctx); err != nil {
+		var zero T
+		return zero, err
+	}
+	return call()
+}
+
+func (c *scheduledClient) ListMonitors(ctx context.Context) ([]hyperping.Monitor, error) {
+	return scheduledRead(ctx, c.scheduler, func() ([]hyperping.Monitor, error) {
+		return c.HyperpingAPI.ListMonitors(ctx)
+	})
+}
+
+func (c *scheduledClient) GetMonitor(ctx context.Context, uuid string) (*hyperping.Monitor, error) {
+	return scheduledRead(ctx, c.scheduler, func() (*hyperping.Monitor, error) {
+		return c.HyperpingAPI.GetMonitor(ctx, uuid)
+	})
+}
+
+func (c *scheduledClient) ListIncidents(ctx context.Context) ([]hyperping.Incident, error) {
+	return scheduledRead(ctx, c.scheduler, func() ([]hyperping.Incident, error) {
+		return c.HyperpingAPI.ListIncidents(ctx)
+	})
+}
+
+func (c *scheduledClient) GetIncident(ctx context.Context, id string) (*hyperping.Incident, error) {
+	return scheduledRead(ctx, c.scheduler, func() (*hyperping.Incident, error) {
+		return c.HyperpingAPI.GetIncident(ctx, id)
+	})
+}
+
+func (c *scheduledClient) ListMaintenance(ctx context.Context) ([]hyperping.Maintenance, error) {
+	return scheduledRead(ctx, c.scheduler, func() ([]hyperping.Maintenance, error) {
+		return c.HyperpingAPI.ListMaintenance(ctx)
+	})
+}
+
+func (c *scheduledClient) GetMaintenance(ctx context.Context, id string) (*hyperping.Maintenance, error) {
+	return scheduledRead(ctx, c.scheduler, func() (*hyperping.Maintenance, error) {
+		return c.HyperpingAPI.GetMaintenance(ctx, id)
+	})
+}
+
+func (c *scheduledClient) GetMonitorReport(ctx context.Context, uuid string, from, to string) (*hyperping.MonitorReport, error) {
+	return scheduledRead(ctx, c.scheduler, func() (*hyperping.MonitorReport, error) {
+		return c.HyperpingAPI.GetMonitorReport(ctx, uuid, from, to)
+	})
+}
+
+func (c *scheduledClient) ListMonitorReports(ctx context.Context, from, to string) ([]hyperping.MonitorReport, error) {
+	return scheduledRead(ctx, c.scheduler, func() ([]hyperping.MonitorReport, error) {
+		return c.HyperpingAPI.ListMonitorReports(ctx, from, to)
+	})
+}
+
+func (c *scheduledClient) GetOutage(ctx context.Context, uuid string) (*hyperping.Outage, error) {
+	return scheduledRead(ctx, c.scheduler, func() (*hyperping.Outage, error) {
+		return c.HyperpingAPI.GetOutage(ctx, uuid)
+	})
+}
+
+func (c *scheduledClient) ListOutages(ctx context.Context, opts ...hyperping.OutageListOption) ([]hyperping.Outage, error) {
+	return scheduledRead(ctx, c.scheduler, func() ([]hyperping.Outage, error) {
+		return c.HyperpingAPI.ListOutages(ctx, opts...)
+	})
+}
+
+func (c *scheduledClient) GetHealthcheck(ctx context.Context, uuid string) (*hyperping.Healthcheck, error) {
+	return scheduledRead(ctx, c.scheduler, func() (*hyperping.Healthcheck, error) {
+		return c.HyperpingAPI.GetHealthcheck(ctx, uuid)
+	})
+}
+
+func (c *scheduledClient) ListHealthchecks(ctx context.Context) ([]hyperping.Healthcheck, error) {
+	return scheduledRead(ctx, c.scheduler, func() ([]hyperping.Healthcheck, error) {
+		return c.HyperpingAPI.ListHealthchecks(ctx)
+	})
+}
+
+func (c *scheduledClient) ListStatusPages(ctx context.Context, page *int, search *string) (*hyperping.StatusPagePaginatedResponse, error) {
+	return scheduledRead(ctx, c.scheduler, func() (*hyperping.StatusPagePaginatedResponse, error) {
+		return c.HyperpingAPI.ListStatusPages(ctx, page, search)
+	})
+}
+
+func (c *scheduledClient) GetStatusPage(ctx context.Context, uuid string) (*hyperping.StatusPage, error) {
+	return scheduledRead(ctx, c.scheduler, func() (*hyperping.StatusPage, error) {
+		return c.HyperpingAPI.GetStatusPage(ctx, uuid)
+	})
+}
+
+func (c *scheduledClient) ListSubscribers(ctx context.Context, uuid string, page *int, subscriberType *string) (*hyperping.SubscriberPaginatedResponse, error) {
+	return scheduledRead(ctx, c.scheduler, func() (*hyperping.SubscriberPaginatedResponse, error) {
+		return c.HyperpingAPI.ListSubscribers(ctx, uuid, page, subscriberType)
+	})
+}
+
+func (c *scheduledClient) GetSubscriber(ctx context.Context, statuspageID string, subscriberID int) (*hyperping.StatusPageSubscriber, error) {
+	return scheduledRead(ctx, c.scheduler, func() (*hyperping.StatusPageSubscriber, error) {
+		return c.HyperpingAPI.GetSubscriber(ctx, statuspageID, subscriberID)
+	})
+}
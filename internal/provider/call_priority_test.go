@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// fakeScheduledAPI implements the calls exercised below; any other method
+// panics through the nil embedded interface.
+type fakeScheduledAPI struct {
+	hyperping.HyperpingAPI
+	calls []string
+}
+
+func (f *fakeScheduledAPI) GetMonitor(_ context.Context, uuid string) (*hyperping.Monitor, error) {
+	f.calls = append(f.calls, "GetMonitor")
+	return &hyperping.Monitor{UUID: uuid}, nil
+}
+
+func (f *fakeScheduledAPI) DeleteMonitor(_ context.Context, _ string) error {
+	f.calls = append(f.calls, "DeleteMonitor")
+	return nil
+}
+
+func TestCallPriorityFrom(t *testing.T) {
+	ctx := context.Background()
+	if got := callPriorityFrom(ctx); got != priorityRead {
+		t.Errorf("unmarked context priority = %d, want priorityRead", got)
+	}
+	if got := callPriorityFrom(withCallPriority(ctx, priorityMutation)); got != priorityMutation {
+		t.Errorf("marked context priority = %d, want priorityMutation", got)
+	}
+}
+
+func TestCallScheduler_NotHeldWithoutRateLimit(t *testing.T) {
+	s := newCallScheduler()
+	if err := s.wait(context.Background()); err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+}
+
+func TestCallScheduler_HoldsReadsAfterRateLimit(t *testing.T) {
+	s := newCallScheduler()
+	s.hold = 50 * time.Millisecond
+	s.rateLimited()
+
+	start := time.Now()
+	if err := s.wait(context.Background()); err != nil {
+		t.Fatalf("wait: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Errorf("read waited %s, want about %s", elapsed, s.hold)
+	}
+}
+
+func TestCallScheduler_MutationsNotHeld(t *testing.T) {
+	s := newCallScheduler()
+	s.hold = time.Hour
+	s.rateLimited()
+
+	ctx, cancel := context.WithTimeout(withCallPriority(context.Background(), priorityMutation), time.Second)
+	defer cancel()
+	if err := s.wait(ctx); err != nil {
+		t.Fatalf("mutation-priority wait: %v", err)
+	}
+}
+
+func TestCallScheduler_ShedsReadsThatCannotWait(t *testing.T) {
+	s := newCallScheduler()
+	s.hold = time.Hour
+	s.rateLimited()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+	start := time.Now()
+	err := s.wait(ctx)
+	if !errors.Is(err, hyperping.ErrRateLimited) {
+		t.Fatalf("wait error = %v, want ErrRateLimited", err)
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("shed read waited %s, want immediate", elapsed)
+	}
+}
+
+func TestCallScheduler_CanceledWhileHeld(t *testing.T) {
+	s := newCallScheduler()
+	s.hold = time.Hour
+	s.rateLimited()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err := s.wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("wait error = %v, want context.Canceled", err)
+	}
+}
+
+func TestCallScheduler_RateLimitExtendsHold(t *testing.T) {
+	now := time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+	s := newCallScheduler()
+	s.now = func() time.Time { return now }
+
+	s.rateLimited()
+	now = now.Add(4 * time.Second)
+	s.rateLimited()
+	if got, want := s.heldFor(), rateLimitHold; got != want {
+		t.Errorf("held for %s, want %s", got, want)
+	}
+
+	now = now.Add(rateLimitHold)
+	if got := s.heldFor(); got > 0 {
+		t.Errorf("still held for %s after the hold ended", got)
+	}
+}
+
+func TestScheduledClient(t *testing.T) {
+	fake := &fakeScheduledAPI{}
+	s := newCallScheduler()
+	s.hold = time.Hour
+	s.rateLimited()
+	client := newScheduledClient(fake, s)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	if _, err := client.GetMonitor(ctx, "mon_1"); !errors.Is(err, hyperping.ErrRateLimited) {
+		t.Errorf("refresh read error = %v, want ErrRateLimited", err)
+	}
+	if err := client.DeleteMonitor(ctx, "mon_1"); err != nil {
+		t.Errorf("DeleteMonitor: %v", err)
+	}
+	if _, err := client.GetMonitor(withCallPriority(ctx, priorityMutation), "mon_1"); err != nil {
+		t.Errorf("apply read: %v", err)
+	}
+
+	if got := len(fake.calls); got != 2 || fake.calls[0] != "DeleteMonitor" || fake.calls[1] != "GetMonitor" {
+		t.Errorf("calls = %v, want [DeleteMonitor GetMonitor]", fake.calls)
+	}
+}
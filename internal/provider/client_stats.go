@@ -0,0 +1,186 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// clientStatsLogInterval is the number of API calls between periodic stats
+// log lines. Retries and circuit breaker transitions are logged immediately.
+const clientStatsLogInterval = 100
+
+// clientStats tracks connection pool and circuit breaker activity for the
+// REST client shared by all resources and data sources of a provider
+// instance. It implements hyperping.Metrics and is safe for concurrent use.
+//
+// Stats are emitted at debug level (TF_LOG=DEBUG) so connection saturation
+// under high -parallelism can be diagnosed without a packet capture. The
+// rate limit quota of the latest response is included, so operators can see
+// how close an apply comes to exhausting it.
+type clientStats struct {
+	connsOpened atomic.Int64
+	connsOpen   atomic.Int64
+	connsPeak   atomic.Int64
+	dialErrors  atomic.Int64
+
+	apiCalls  atomic.Int64
+	apiErrors atomic.Int64
+	retries   atomic.Int64
+
+	slowRequests atomic.Int64
+
+	mu             sync.Mutex
+	breakerState   string
+	breakerChanged bool
+	rateLimit      rateLimitStatus
+	rateLimitKnown bool
+
+	// onRateLimit, when set, is called for every 429 response.
+	onRateLimit func()
+}
+
+func newClientStats() *clientStats {
+	return &clientStats{breakerState: "closed"}
+}
+
+// dialContext wraps dial so that every connection opened by the transport is
+// counted until it is closed.
+func (s *clientStats) dialContext(dial func(ctx context.Context, network, addr string) (net.Conn, error)) func(ctx context.Context, network, addr string) (net.Conn, error) {
+	return func(ctx context.Context, network, addr string) (net.Conn, error) {
+		conn, err := dial(ctx, network, addr)
+		if err != nil {
+			s.dialErrors.Add(1)
+			return nil, err
+		}
+		s.connsOpened.Add(1)
+		open := s.connsOpen.Add(1)
+		for {
+			peak := s.connsPeak.Load()
+			if open <= peak || s.connsPeak.CompareAndSwap(peak, open) {
+				break
+			}
+		}
+		return &trackedConn{Conn: conn, stats: s}, nil
+	}
+}
+
+// trackedConn decrements the open connection count once when closed.
+type trackedConn struct {
+	net.Conn
+	stats  *clientStats
+	closed atomic.Bool
+}
+
+func (c *trackedConn) Close() error {
+	if c.closed.CompareAndSwap(false, true) {
+		c.stats.connsOpen.Add(-1)
+	}
+	return c.Conn.Close()
+}
+
+// RecordAPICall counts a completed API call. Server errors and rate limits
+// count as errors. It also flushes a pending circuit breaker transition to
+// the log, since the breaker callback runs without a Terraform log context.
+func (s *clientStats) RecordAPICall(ctx context.Context, _, _ string, statusCode int, _ float64) {
+	calls := s.apiCalls.Add(1)
+	if statusCode >= 500 || statusCode == 429 {
+		s.apiErrors.Add(1)
+	}
+	if statusCode == 429 && s.onRateLimit != nil {
+		s.onRateLimit()
+	}
+
+	s.mu.Lock()
+	changed := s.breakerChanged
+	s.breakerChanged = false
+	s.mu.Unlock()
+
+	switch {
+	case changed:
+		s.log(ctx, "Hyperping circuit breaker state changed")
+	case calls%clientStatsLogInterval == 0:
+		s.log(ctx, "Hyperping client stats")
+	}
+}
+
+// RecordRetry counts a retry and logs the current stats.
+func (s *clientStats) RecordRetry(ctx context.Context, method, path string, attempt int) {
+	s.retries.Add(1)
+	fields := s.snapshot()
+	fields["method"] = method
+	fields["path"] = path
+	fields["attempt"] = attempt
+	tflog.Debug(ctx, "Retrying Hyperping API request", fields)
+}
+
+// RecordCircuitBreakerState records a circuit breaker transition. It is
+// logged on the next API call.
+func (s *clientStats) RecordCircuitBreakerState(_ context.Context, state string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.breakerState = state
+	s.breakerChanged = true
+}
+
+// recordRateLimit stores the quota reported by the latest response.
+func (s *clientStats) recordRateLimit(status rateLimitStatus) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.rateLimit = status
+	s.rateLimitKnown = true
+}
+
+// RateLimitStatus returns the rate limit quota reported by the most recent
+// response, and false when no response has carried rate limit headers yet.
+func (s *clientStats) RateLimitStatus() (rateLimitStatus, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.rateLimit, s.rateLimitKnown
+}
+
+// snapshot returns the current stats as structured log fields.
+func (s *clientStats) snapshot() map[string]interface{} {
+	s.mu.Lock()
+	state := s.breakerState
+	rateLimit, rateLimitKnown := s.rateLimit, s.rateLimitKnown
+	s.mu.Unlock()
+
+	fields := map[string]interface{}{
+		"pool_conns_opened":     s.connsOpened.Load(),
+		"pool_conns_open":       s.connsOpen.Load(),
+		"pool_conns_peak":       s.connsPeak.Load(),
+		"pool_dial_errors":      s.dialErrors.Load(),
+		"api_calls":             s.apiCalls.Load(),
+		"api_errors":            s.apiErrors.Load(),
+		"retries":               s.retries.Load(),
+		"slow_requests":         s.slowRequests.Load(),
+		"circuit_breaker_state": state,
+	}
+	if rateLimitKnown {
+		fields["rate_limit_remaining"] = rateLimit.Remaining
+		if rateLimit.Limit >= 0 {
+			fields["rate_limit_limit"] = rateLimit.Limit
+		}
+		if !rateLimit.Reset.IsZero() {
+			fields["rate_limit_reset"] = rateLimit.Reset.UTC().Format(time.RFC3339)
+		}
+	}
+	return fields
+}
+
+func (s *clientStats) log(ctx context.Context, msg string) {
+	tflog.Debug(ctx, msg, s.snapshot())
+}
+
+// Ensure clientStats implements the Metrics interface.
+var _ hyperping.Metrics = (*clientStats)(nil)
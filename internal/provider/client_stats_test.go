@@ -0,0 +1,199 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// TestSharedClient_ParallelOperations simulates 50 resource operations
+// running in parallel against one shared REST client, as with
+// terraform apply -parallelism=50. Every operation must succeed, retries must
+// recover from transient 503s, and the connection pool must stay within its
+// per-host limit.
+func TestSharedClient_ParallelOperations(t *testing.T) {
+	const operations = 50
+
+	var inFlight, maxInFlight atomic.Int64
+	var failedOnce sync.Map
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		n := inFlight.Add(1)
+		defer inFlight.Add(-1)
+		for {
+			peak := maxInFlight.Load()
+			if n <= peak || maxInFlight.CompareAndSwap(peak, n) {
+				break
+			}
+		}
+		time.Sleep(10 * time.Millisecond)
+
+		uuid := strings.TrimPrefix(r.URL.Path, hyperping.MonitorsBasePath+"/")
+		// Every fifth monitor fails its first read, exercising retries.
+		if strings.HasSuffix(uuid, "0") || strings.HasSuffix(uuid, "5") {
+			if _, loaded := failedOnce.LoadOrStore(uuid, true); !loaded {
+				w.WriteHeader(http.StatusServiceUnavailable)
+				return
+			}
+		}
+		w.Header().Set(hyperping.HeaderContentType, hyperping.ContentTypeJSON)
+		fmt.Fprintf(w, `{"uuid": %q, "name": "Monitor", "url": "https://example.com", "protocol": "http"}`, uuid)
+	}))
+	defer server.Close()
+
+	stats := newClientStats()
+	httpClient, err := newHTTPClient(transportConfig{Stats: stats}, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := hyperping.NewClient("sk_test_key",
+		hyperping.WithBaseURL(server.URL),
+		hyperping.WithHTTPClient(httpClient),
+		hyperping.WithMetrics(stats),
+		hyperping.WithRetryWait(time.Millisecond, 5*time.Millisecond),
+	)
+
+	ctx := context.Background()
+	errs := make(chan error, operations)
+	var wg sync.WaitGroup
+	for i := range operations {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			uuid := fmt.Sprintf("mon_%03d", i)
+			// A resource Read followed by the post-apply refresh.
+			for range 2 {
+				monitor, err := client.GetMonitor(ctx, uuid)
+				if err != nil {
+					errs <- err
+					return
+				}
+				if monitor.UUID != uuid {
+					errs <- fmt.Errorf("got monitor %q, want %q", monitor.UUID, uuid)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+	close(errs)
+
+	for err := range errs {
+		t.Error(err)
+	}
+
+	snapshot := stats.snapshot()
+	if got := snapshot["retries"].(int64); got != 10 {
+		t.Errorf("retries = %d, want 10", got)
+	}
+	if got := snapshot["api_calls"].(int64); got != 2*operations+10 {
+		t.Errorf("api_calls = %d, want %d", got, 2*operations+10)
+	}
+	if got := snapshot["api_errors"].(int64); got != 10 {
+		t.Errorf("api_errors = %d, want 10", got)
+	}
+	if got := snapshot["circuit_breaker_state"]; got != "closed" {
+		t.Errorf("circuit_breaker_state = %v, want closed", got)
+	}
+	if peak := snapshot["pool_conns_peak"].(int64); peak < 2 || peak > maxConnsPerHost {
+		t.Errorf("pool_conns_peak = %d, want between 2 and %d", peak, maxConnsPerHost)
+	}
+	if got := maxInFlight.Load(); got > maxConnsPerHost {
+		t.Errorf("server saw %d concurrent requests, want at most %d", got, maxConnsPerHost)
+	}
+	if opened := snapshot["pool_conns_opened"].(int64); opened > maxConnsPerHost {
+		t.Errorf("pool_conns_opened = %d, want idle connections reused (at most %d)", opened, maxConnsPerHost)
+	}
+}
+
+func TestClientStats_DialTracking(t *testing.T) {
+	stats := newClientStats()
+	dialErr := errors.New("refused")
+	dial := stats.dialContext(func(_ context.Context, _, addr string) (net.Conn, error) {
+		if addr == "bad:443" {
+			return nil, dialErr
+		}
+		client, server := net.Pipe()
+		t.Cleanup(func() { server.Close() })
+		return client, nil
+	})
+
+	first, err := dial(context.Background(), "tcp", "good:443")
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := dial(context.Background(), "tcp", "good:443")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dial(context.Background(), "tcp", "bad:443"); !errors.Is(err, dialErr) {
+		t.Errorf("expected dial error, got %v", err)
+	}
+
+	first.Close()
+	first.Close() // closing twice must not decrement twice
+
+	s := stats.snapshot()
+	if s["pool_conns_opened"] != int64(2) || s["pool_conns_open"] != int64(1) || s["pool_conns_peak"] != int64(2) || s["pool_dial_errors"] != int64(1) {
+		t.Errorf("unexpected pool stats: %v", s)
+	}
+	second.Close()
+	if got := stats.connsOpen.Load(); got != 0 {
+		t.Errorf("pool_conns_open = %d after closing all, want 0", got)
+	}
+}
+
+func TestClientStats_CircuitBreakerState(t *testing.T) {
+	stats := newClientStats()
+	ctx := context.Background()
+
+	stats.RecordCircuitBreakerState(ctx, "open")
+	if s := stats.snapshot(); s["circuit_breaker_state"] != "open" {
+		t.Errorf("circuit_breaker_state = %v, want open", s["circuit_breaker_state"])
+	}
+
+	// The pending transition is flushed by the next API call.
+	stats.RecordAPICall(ctx, http.MethodGet, "/v1/monitors", http.StatusOK, 0.1)
+	stats.mu.Lock()
+	pending := stats.breakerChanged
+	stats.mu.Unlock()
+	if pending {
+		t.Error("expected breaker transition to be flushed by RecordAPICall")
+	}
+}
+
+func TestClientStats_APIErrors(t *testing.T) {
+	stats := newClientStats()
+	ctx := context.Background()
+	for _, status := range []int{200, 404, 429, 500, 503} {
+		stats.RecordAPICall(ctx, http.MethodGet, "/v1/monitors", status, 0)
+	}
+	if got := stats.apiErrors.Load(); got != 3 {
+		t.Errorf("api_errors = %d, want 3 (429 and 5xx)", got)
+	}
+}
+
+func TestClientStats_OnRateLimit(t *testing.T) {
+	stats := newClientStats()
+	calls := 0
+	stats.onRateLimit = func() { calls++ }
+	ctx := context.Background()
+	for _, status := range []int{200, 429, 500, 429} {
+		stats.RecordAPICall(ctx, http.MethodGet, "/v1/monitors", status, 0)
+	}
+	if calls != 2 {
+		t.Errorf("onRateLimit called %d times, want 2", calls)
+	}
+}
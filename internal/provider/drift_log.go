@@ -0,0 +1,240 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// driftLog reports the attributes a refresh changed, when log_drift is set.
+// Unexpected plan changes usually come from an attribute edited outside
+// Terraform or normalized by the API; the log entry names it without trace
+// logging. A nil driftLog logs nothing.
+type driftLog struct{}
+
+// log compares the state a resource Read started from with the refreshed
+// state and writes one INFO entry listing the changed attributes. Computed
+// attributes that cannot be configured (status, is_down, ...) change on their
+// own and are left out. Sensitive values are never logged.
+func (d *driftLog) log(ctx context.Context, resourceType string, prior, refreshed tfsdk.State) {
+	if d == nil || refreshed.Raw.IsNull() || importedOnly(prior.Raw) {
+		return
+	}
+
+	diffs, err := prior.Raw.Diff(refreshed.Raw)
+	if err != nil {
+		tflog.Debug(ctx, "Skipping drift log", map[string]interface{}{"resource_type": resourceType, "error": err.Error()})
+		return
+	}
+
+	changes := driftChanges(ctx, refreshed, diffs)
+	if len(changes) == 0 {
+		return
+	}
+
+	var id types.String
+	_ = refreshed.GetAttribute(ctx, path.Root("id"), &id) //nolint:errcheck // the ID only labels the entry
+
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, secretPatterns...)
+	tflog.Info(ctx, fmt.Sprintf("Drift detected on %s", resourceType), map[string]interface{}{
+		"resource_type": resourceType,
+		"id":            id.ValueString(),
+		"changes":       strings.Join(changes, "; "),
+	})
+}
+
+// importedOnly reports whether state holds nothing but the ID, as it does on
+// the refresh after an import. Every attribute would be reported otherwise.
+func importedOnly(state tftypes.Value) bool {
+	var attrs map[string]tftypes.Value
+	if state.IsNull() || state.As(&attrs) != nil {
+		return false
+	}
+	for name, v := range attrs {
+		if name != "id" && name != "timeouts" && !v.IsNull() {
+			return false
+		}
+	}
+	return true
+}
+
+// driftChanges formats the diffs as "path: old → new" lines, sorted by path.
+// A diff is reported at the outermost path where a value was added, removed,
+// or nulled, or at a changed primitive value; the container diffs above those
+// are implied.
+func driftChanges(ctx context.Context, state tfsdk.State, diffs []tftypes.ValueDiff) []string {
+	var terminal []tftypes.ValueDiff
+	for _, d := range diffs {
+		if isTerminalDiff(d) {
+			terminal = append(terminal, d)
+		}
+	}
+
+	var changes []string
+	for _, d := range terminal {
+		if hasTerminalAncestor(d.Path, terminal) || !isConfigurable(ctx, state, d.Path) {
+			continue
+		}
+		changes = append(changes, fmt.Sprintf("%s: %s → %s",
+			formatDriftPath(d.Path),
+			formatDriftValue(ctx, state, d.Path, d.Value1),
+			formatDriftValue(ctx, state, d.Path, d.Value2),
+		))
+	}
+	sort.Strings(changes)
+	return changes
+}
+
+// isTerminalDiff reports whether a diff is not just the parent of other diffs.
+func isTerminalDiff(d tftypes.ValueDiff) bool {
+	if d.Value1 == nil || d.Value2 == nil {
+		return true
+	}
+	for _, v := range []*tftypes.Value{d.Value1, d.Value2} {
+		if v.IsNull() || !v.IsKnown() {
+			return true
+		}
+	}
+	return d.Value1.Type().Is(tftypes.String) || d.Value1.Type().Is(tftypes.Number) || d.Value1.Type().Is(tftypes.Bool)
+}
+
+func hasTerminalAncestor(p *tftypes.AttributePath, terminal []tftypes.ValueDiff) bool {
+	steps := p.Steps()
+	for _, d := range terminal {
+		ancestor := d.Path.Steps()
+		if len(ancestor) < len(steps) && slices.EqualFunc(ancestor, steps[:len(ancestor)], tftypes.AttributePathStep.Equal) {
+			return true
+		}
+	}
+	return false
+}
+
+// isConfigurable reports whether the top-level attribute of p can be set in
+// configuration.
+func isConfigurable(ctx context.Context, state tfsdk.State, p *tftypes.AttributePath) bool {
+	steps := p.Steps()
+	if len(steps) == 0 {
+		return false
+	}
+	a, err := state.Schema.AttributeAtTerraformPath(ctx, tftypes.NewAttributePathWithSteps(steps[:1]))
+	if err != nil {
+		return false // blocks such as timeouts never drift
+	}
+	return a.IsRequired() || a.IsOptional()
+}
+
+// isSensitivePath reports whether p is, or is inside, a sensitive attribute.
+func isSensitivePath(ctx context.Context, state tfsdk.State, p *tftypes.AttributePath) bool {
+	steps := p.Steps()
+	for i := range steps {
+		if _, ok := steps[i].(tftypes.AttributeName); !ok {
+			continue
+		}
+		a, err := state.Schema.AttributeAtTerraformPath(ctx, tftypes.NewAttributePathWithSteps(steps[:i+1]))
+		if err == nil && a.IsSensitive() {
+			return true
+		}
+	}
+	return false
+}
+
+// formatDriftPath renders p in Terraform syntax, e.g. request_headers[0].name.
+func formatDriftPath(p *tftypes.AttributePath) string {
+	var b strings.Builder
+	for _, step := range p.Steps() {
+		switch s := step.(type) {
+		case tftypes.AttributeName:
+			if b.Len() > 0 {
+				b.WriteByte('.')
+			}
+			b.WriteString(string(s))
+		case tftypes.ElementKeyString:
+			fmt.Fprintf(&b, "[%q]", string(s))
+		case tftypes.ElementKeyInt:
+			fmt.Fprintf(&b, "[%d]", int64(s))
+		case tftypes.ElementKeyValue:
+			b.WriteString("[*]")
+		}
+	}
+	return b.String()
+}
+
+// formatDriftValue renders v in HCL-like syntax, masking sensitive values.
+// A nil v is an element that does not exist on that side.
+func formatDriftValue(ctx context.Context, state tfsdk.State, p *tftypes.AttributePath, v *tftypes.Value) string {
+	switch {
+	case v == nil:
+		return "(none)"
+	case v.IsNull():
+		return "null"
+	case !v.IsKnown():
+		return "(known after apply)"
+	case isSensitivePath(ctx, state, p):
+		return "(sensitive value)"
+	}
+
+	switch typ := v.Type().(type) {
+	case tftypes.Object, tftypes.Map:
+		var attrs map[string]tftypes.Value
+		if v.As(&attrs) != nil {
+			return v.String()
+		}
+		names := make([]string, 0, len(attrs))
+		for name := range attrs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		parts := make([]string, 0, len(names))
+		for _, name := range names {
+			child := attrs[name]
+			childPath := p.WithAttributeName(name)
+			if _, ok := typ.(tftypes.Map); ok {
+				childPath = p.WithElementKeyString(name)
+			}
+			parts = append(parts, fmt.Sprintf("%s = %s", name, formatDriftValue(ctx, state, childPath, &child)))
+		}
+		return "{" + strings.Join(parts, ", ") + "}"
+	case tftypes.List, tftypes.Set, tftypes.Tuple:
+		var elems []tftypes.Value
+		if v.As(&elems) != nil {
+			return v.String()
+		}
+		parts := make([]string, 0, len(elems))
+		for i := range elems {
+			elemPath := p.WithElementKeyInt(i)
+			if _, ok := typ.(tftypes.Set); ok {
+				elemPath = p.WithElementKeyValue(elems[i])
+			}
+			parts = append(parts, formatDriftValue(ctx, state, elemPath, &elems[i]))
+		}
+		return "[" + strings.Join(parts, ", ") + "]"
+	}
+
+	switch {
+	case v.Type().Is(tftypes.String):
+		var s string
+		_ = v.As(&s) //nolint:errcheck // type checked above
+		return fmt.Sprintf("%q", s)
+	case v.Type().Is(tftypes.Number):
+		var n big.Float
+		_ = v.As(&n) //nolint:errcheck // type checked above
+		return n.Text('g', -1)
+	case v.Type().Is(tftypes.Bool):
+		var b bool
+		_ = v.As(&b) //nolint:errcheck // type checked above
+		return fmt.Sprintf("%t", b)
+	}
+	return v.String()
+}
@@ -0,0 +1,145 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+var driftTestSchema = schema.Schema{
+	Attributes: map[string]schema.Attribute{
+		"id":      schema.StringAttribute{Computed: true},
+		"name":    schema.StringAttribute{Required: true},
+		"status":  schema.StringAttribute{Computed: true},
+		"regions": schema.ListAttribute{Optional: true, ElementType: types.StringType},
+		"token":   schema.StringAttribute{Optional: true, Sensitive: true},
+		"headers": schema.ListNestedAttribute{
+			Optional: true,
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name":  schema.StringAttribute{Required: true},
+					"value": schema.StringAttribute{Required: true, Sensitive: true},
+				},
+			},
+		},
+	},
+}
+
+// driftTestState builds a state of driftTestSchema. Nil arguments are null.
+func driftTestState(t *testing.T, name, status, token *string, regions []string, headers map[string]string) tfsdk.State {
+	t.Helper()
+	objType := driftTestSchema.Type().TerraformType(context.Background()).(tftypes.Object)
+	headerType := objType.AttributeTypes["headers"].(tftypes.List)
+
+	str := func(v *string) tftypes.Value {
+		if v == nil {
+			return tftypes.NewValue(tftypes.String, nil)
+		}
+		return tftypes.NewValue(tftypes.String, *v)
+	}
+
+	regionsVal := tftypes.NewValue(objType.AttributeTypes["regions"], nil)
+	if regions != nil {
+		elems := make([]tftypes.Value, 0, len(regions))
+		for _, r := range regions {
+			elems = append(elems, tftypes.NewValue(tftypes.String, r))
+		}
+		regionsVal = tftypes.NewValue(objType.AttributeTypes["regions"], elems)
+	}
+
+	headersVal := tftypes.NewValue(headerType, nil)
+	if headers != nil {
+		var elems []tftypes.Value
+		for k, v := range headers {
+			elems = append(elems, tftypes.NewValue(headerType.ElementType, map[string]tftypes.Value{
+				"name":  tftypes.NewValue(tftypes.String, k),
+				"value": tftypes.NewValue(tftypes.String, v),
+			}))
+		}
+		headersVal = tftypes.NewValue(headerType, elems)
+	}
+
+	id := "mon_1"
+	return tfsdk.State{
+		Schema: driftTestSchema,
+		Raw: tftypes.NewValue(objType, map[string]tftypes.Value{
+			"id":      str(&id),
+			"name":    str(name),
+			"status":  str(status),
+			"regions": regionsVal,
+			"token":   str(token),
+			"headers": headersVal,
+		}),
+	}
+}
+
+func TestDriftLog(t *testing.T) {
+	name, renamed := "api", "api-renamed"
+	up, down := "up", "down"
+	oldToken, newToken := "tok_old", "tok_new"
+
+	prior := driftTestState(t, &name, &up, &oldToken, []string{"london", "paris"}, nil)
+	refreshed := driftTestState(t, &renamed, &down, &newToken, []string{"london"}, map[string]string{"X-Team": "secret-value"})
+
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+	(&driftLog{}).log(ctx, "hyperping_test", prior, refreshed)
+	logged := buf.String()
+
+	for _, want := range []string{
+		"Drift detected on hyperping_test",
+		`"id":"mon_1"`,
+		`name: \"api\" → \"api-renamed\"`,
+		`regions[1]: \"paris\" → (none)`,
+		"token: (sensitive value) → (sensitive value)",
+		`headers: null → [{name = \"X-Team\", value = (sensitive value)}]`,
+	} {
+		if !strings.Contains(logged, want) {
+			t.Errorf("log is missing %s:\n%s", want, logged)
+		}
+	}
+	for _, unwanted := range []string{"status", "tok_new", "tok_old", "secret-value", "london"} {
+		if strings.Contains(logged, unwanted) {
+			t.Errorf("log should not contain %q:\n%s", unwanted, logged)
+		}
+	}
+}
+
+func TestDriftLog_Skipped(t *testing.T) {
+	name := "api"
+	up, down := "up", "down"
+	state := driftTestState(t, &name, &up, nil, nil, nil)
+
+	tests := []struct {
+		name      string
+		drift     *driftLog
+		prior     tfsdk.State
+		refreshed tfsdk.State
+	}{
+		{name: "disabled", prior: state, refreshed: driftTestState(t, &name, &down, nil, []string{"london"}, nil)},
+		{name: "unchanged", drift: &driftLog{}, prior: state, refreshed: state},
+		{name: "computed only", drift: &driftLog{}, prior: state, refreshed: driftTestState(t, &name, &down, nil, nil, nil)},
+		{name: "after import", drift: &driftLog{}, prior: driftTestState(t, nil, nil, nil, nil, nil), refreshed: state},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+			ctx := tflogtest.RootLogger(context.Background(), &buf)
+			tt.drift.log(ctx, "hyperping_test", tt.prior, tt.refreshed)
+			if buf.Len() > 0 {
+				t.Errorf("expected no log entry, got:\n%s", buf.String())
+			}
+		})
+	}
+}
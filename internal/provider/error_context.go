@@ -4,6 +4,8 @@
 package provider
 
 import (
+	"context"
+	"errors"
 	"fmt"
 	"regexp"
 	"strconv"
@@ -20,7 +22,7 @@ var (
 // ErrorContext provides structured error information for enhanced error messages.
 // This context is used to generate actionable troubleshooting steps for users.
 type ErrorContext struct {
-	Type         string // "not_found", "auth_error", "rate_limit", "server_error", "validation", "circuit_breaker", "unknown"
+	Type         string // "not_found", "auth_error", "rate_limit", "server_error", "validation", "circuit_breaker", "timeout", "unknown"
 	HTTPStatus   int
 	RetryAfter   int    // seconds (for rate limit errors)
 	ResourceType string // "Monitor", "Incident", "Maintenance", etc.
@@ -52,6 +54,8 @@ func DetectErrorContext(resourceType, resourceID, operation string, err error) E
 
 	// Detect error type from client package using error checking functions
 	switch {
+	case errors.Is(err, context.DeadlineExceeded):
+		ctx.Type = "timeout"
 	case hyperping.IsNotFound(err):
 		ctx.Type = "not_found"
 		ctx.HTTPStatus = 404
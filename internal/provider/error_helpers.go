@@ -17,6 +17,7 @@ import (
 
 // newReadAfterCreateError creates a standardized error for reading after successful create
 func newReadAfterCreateError(resourceType, resourceID string, err error) diag.Diagnostic {
+	err = redactError(err)
 	return diag.NewErrorDiagnostic(
 		fmt.Sprintf("%s Created But Read Failed", resourceType),
 		fmt.Sprintf("%s was created successfully (ID: %s) but reading it back failed: %s\n\n"+
@@ -29,6 +30,7 @@ func newReadAfterCreateError(resourceType, resourceID string, err error) diag.Di
 
 // newImportError creates a standardized error for import operations
 func newImportError(resourceType string, err error) diag.Diagnostic {
+	err = redactError(err)
 	return diag.NewErrorDiagnostic(
 		"Import Failed",
 		fmt.Sprintf("Cannot import %s: %s\n\n"+
@@ -69,6 +71,8 @@ func BuildTroubleshootingSteps(ctx ErrorContext) string {
 		steps = buildValidationErrorSteps(ctx)
 	case "circuit_breaker":
 		steps = buildCircuitBreakerSteps(ctx)
+	case "timeout":
+		steps = buildTimeoutSteps(ctx)
 	default:
 		steps = buildGenericSteps(ctx)
 	}
@@ -205,6 +209,22 @@ func buildCircuitBreakerSteps(_ ErrorContext) []string {
 	}
 }
 
+// buildTimeoutSteps generates troubleshooting steps for operations that ran
+// past their timeout.
+func buildTimeoutSteps(ctx ErrorContext) []string {
+	steps := []string{
+		fmt.Sprintf("1. The %s operation did not finish within its timeout", ctx.Operation),
+		"2. Large resources (such as status pages with many sections) can take longer; raise the timeout in the resource's timeouts block:",
+		fmt.Sprintf("   timeouts {\n     %s = \"10m\"\n   }", ctx.Operation),
+		"3. Check Hyperping API status: https://status.hyperping.app",
+	}
+	if ctx.Operation == "create" || ctx.Operation == "update" {
+		steps = append(steps, "4. The change may have been applied before the timeout; refresh to see the current state:",
+			"   $ terraform apply -refresh-only")
+	}
+	return steps
+}
+
 // buildGenericSteps generates generic troubleshooting steps for unknown errors.
 func buildGenericSteps(ctx ErrorContext) []string {
 	steps := []string{
@@ -251,6 +271,7 @@ func joinSteps(steps []string) string {
 
 // NewReadErrorWithContext creates an enhanced read error with troubleshooting steps.
 func NewReadErrorWithContext(resourceType, resourceID string, err error) diag.Diagnostic {
+	err = redactError(err)
 	ctx := DetectErrorContext(resourceType, resourceID, "read", err)
 	troubleshooting := BuildTroubleshootingSteps(ctx)
 
@@ -262,31 +283,38 @@ func NewReadErrorWithContext(resourceType, resourceID string, err error) diag.Di
 }
 
 // NewCreateErrorWithContext creates an enhanced create error with troubleshooting steps.
+// Validation details that newValidationDetailDiagnostics cannot attach to an
+// attribute are listed in the message.
 func NewCreateErrorWithContext(resourceType string, err error) diag.Diagnostic {
+	err = redactError(err)
 	ctx := DetectErrorContext(resourceType, "", "create", err)
 	troubleshooting := BuildTroubleshootingSteps(ctx)
 
 	return diag.NewErrorDiagnostic(
 		fmt.Sprintf("Failed to Create %s", resourceType),
-		fmt.Sprintf("Unable to create %s, got error: %s\n\n%s",
-			resourceType, err, troubleshooting),
+		fmt.Sprintf("Unable to create %s, got error: %s%s\n\n%s",
+			resourceType, err, validationDetailsText(resourceType, err), troubleshooting),
 	)
 }
 
 // NewUpdateErrorWithContext creates an enhanced update error with troubleshooting steps.
+// Validation details that newValidationDetailDiagnostics cannot attach to an
+// attribute are listed in the message.
 func NewUpdateErrorWithContext(resourceType, resourceID string, err error) diag.Diagnostic {
+	err = redactError(err)
 	ctx := DetectErrorContext(resourceType, resourceID, "update", err)
 	troubleshooting := BuildTroubleshootingSteps(ctx)
 
 	return diag.NewErrorDiagnostic(
 		fmt.Sprintf("Failed to Update %s", resourceType),
-		fmt.Sprintf("Unable to update %s (ID: %s), got error: %s\n\n%s",
-			resourceType, resourceID, err, troubleshooting),
+		fmt.Sprintf("Unable to update %s (ID: %s), got error: %s%s\n\n%s",
+			resourceType, resourceID, err, validationDetailsText(resourceType, err), troubleshooting),
 	)
 }
 
 // NewDeleteErrorWithContext creates an enhanced delete error with troubleshooting steps.
 func NewDeleteErrorWithContext(resourceType, resourceID string, err error) diag.Diagnostic {
+	err = redactError(err)
 	ctx := DetectErrorContext(resourceType, resourceID, "delete", err)
 	troubleshooting := BuildTroubleshootingSteps(ctx)
 
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -119,6 +120,16 @@ func TestDetectErrorContext(t *testing.T) {
 			wantStatus:   0,
 			wantMessage:  "circuit breaker is open",
 		},
+		{
+			name:         "operation timeout",
+			resourceType: "Statuspage",
+			resourceID:   "sp_123",
+			operation:    "update",
+			err:          fmt.Errorf("request failed: %w", context.DeadlineExceeded),
+			wantType:     "timeout",
+			wantStatus:   0,
+			wantMessage:  "deadline exceeded",
+		},
 		{
 			name:         "empty resource ID for validation",
 			resourceType: "Monitor",
@@ -842,6 +853,23 @@ func TestBuildServerErrorSteps_Content(t *testing.T) {
 	}
 }
 
+func TestBuildTimeoutSteps_Content(t *testing.T) {
+	t.Parallel()
+
+	steps := buildTimeoutSteps(ErrorContext{Type: "timeout", Operation: "update"})
+	stepsText := strings.Join(steps, "\n")
+	for _, want := range []string{"timeouts {", `update = "10m"`, "-refresh-only"} {
+		if !strings.Contains(stepsText, want) {
+			t.Errorf("Steps missing %q", want)
+		}
+	}
+
+	steps = buildTimeoutSteps(ErrorContext{Type: "timeout", Operation: "read"})
+	if stepsText := strings.Join(steps, "\n"); strings.Contains(stepsText, "-refresh-only") {
+		t.Errorf("read timeout steps should not suggest a refresh:\n%s", stepsText)
+	}
+}
+
 func TestBuildValidationErrorSteps_Content(t *testing.T) {
 	t.Parallel()
 
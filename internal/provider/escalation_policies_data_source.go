@@ -130,6 +130,9 @@ func (d *EscalationPoliciesDataSource) Configure(_ context.Context, req datasour
 
 // Read refreshes the Terraform state with the latest data.
 func (d *EscalationPoliciesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var state EscalationPoliciesDataSourceModel
 
 	if d.client == nil {
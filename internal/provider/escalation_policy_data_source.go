@@ -110,6 +110,9 @@ func (d *EscalationPolicyDataSource) Configure(_ context.Context, req datasource
 
 // Read refreshes the Terraform state with the latest data.
 func (d *EscalationPolicyDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var data EscalationPolicyDataSourceModel
 
 	if d.client == nil {
@@ -6,6 +6,7 @@ package provider
 import (
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -65,7 +66,7 @@ func MonitorFilterSchema() schema.SingleNestedAttribute {
 }
 
 // IncidentFilterSchema returns filter block for incident data sources.
-// Includes name_regex, status, and severity filtering.
+// Includes name_regex, status, severity, state, status page, and date range filtering.
 func IncidentFilterSchema() schema.SingleNestedAttribute {
 	return schema.SingleNestedAttribute{
 		Optional:    true,
@@ -83,6 +84,34 @@ func IncidentFilterSchema() schema.SingleNestedAttribute {
 				Optional:    true,
 				Description: "Filter by severity (minor, major, critical)",
 			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter by resolution state. Must be `ongoing` (latest update is not `resolved`) or `resolved`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf("ongoing", "resolved"),
+				},
+			},
+			"status_page_uuid": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Filter incidents displayed on this status page UUID.",
+				Validators: []validator.String{
+					UUIDFormat(),
+				},
+			},
+			"date_from": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only include incidents dated at or after this ISO 8601 timestamp (e.g., `2026-01-01T00:00:00Z`).",
+				Validators: []validator.String{
+					ISO8601(),
+				},
+			},
+			"date_to": schema.StringAttribute{
+				Optional:            true,
+				MarkdownDescription: "Only include incidents dated at or before this ISO 8601 timestamp.",
+				Validators: []validator.String{
+					ISO8601(),
+				},
+			},
 		},
 	}
 }
@@ -272,6 +301,39 @@ func MatchesInt64Range(value int64, minBound, maxBound types.Int64) bool {
 	return true
 }
 
+// MatchesTimeRange checks if an RFC 3339 timestamp is within [from, to] inclusive.
+// Returns true if both bounds are null/unknown (no filter).
+// Returns false if a bound is set and value cannot be parsed.
+func MatchesTimeRange(value string, from, to types.String) bool {
+	hasFrom := !isNullOrUnknown(from)
+	hasTo := !isNullOrUnknown(to)
+
+	if !hasFrom && !hasTo {
+		return true
+	}
+
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		return false
+	}
+
+	if hasFrom {
+		fromTime, err := time.Parse(time.RFC3339, from.ValueString())
+		if err != nil || t.Before(fromTime) {
+			return false
+		}
+	}
+
+	if hasTo {
+		toTime, err := time.Parse(time.RFC3339, to.ValueString())
+		if err != nil || t.After(toTime) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // ContainsSubstring checks if value contains the filter substring (case-insensitive).
 // Returns true if filter is null/unknown (no filter).
 func ContainsSubstring(value string, filter types.String) bool {
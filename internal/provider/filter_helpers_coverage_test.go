@@ -69,12 +69,12 @@ func TestIncidentFilterSchema(t *testing.T) {
 	assertSchemaIsOptional(t, s)
 	assertSchemaDescription(t, s, "Filter criteria for incidents")
 
-	expectedAttrs := []string{"name_regex", "status", "severity"}
+	expectedAttrs := []string{"name_regex", "status", "severity", "state", "status_page_uuid", "date_from", "date_to"}
 	assertSchemaAttributeNames(t, s, expectedAttrs)
 
-	assertStringAttrOptional(t, s, "name_regex")
-	assertStringAttrOptional(t, s, "status")
-	assertStringAttrOptional(t, s, "severity")
+	for _, name := range expectedAttrs {
+		assertStringAttrOptional(t, s, name)
+	}
 }
 
 func TestMaintenanceFilterSchema(t *testing.T) {
@@ -148,9 +148,9 @@ func TestSchemaFunctions_AttributeCounts(t *testing.T) {
 			expectedCount: 5,
 		},
 		{
-			name:          "IncidentFilterSchema has 3 attributes",
+			name:          "IncidentFilterSchema has 7 attributes",
 			schemaFn:      IncidentFilterSchema,
-			expectedCount: 3,
+			expectedCount: 7,
 		},
 		{
 			name:          "MaintenanceFilterSchema has 2 attributes",
@@ -288,6 +288,10 @@ func TestIncidentFilterSchema_Descriptions(t *testing.T) {
 		{"name_regex"},
 		{"status"},
 		{"severity"},
+		{"state"},
+		{"status_page_uuid"},
+		{"date_from"},
+		{"date_to"},
 	}
 
 	for _, tt := range tests {
@@ -300,7 +304,7 @@ func TestIncidentFilterSchema_Descriptions(t *testing.T) {
 			if !ok {
 				t.Fatalf("attribute %s is not a StringAttribute", tt.attrName)
 			}
-			if strAttr.Description == "" {
+			if strAttr.Description == "" && strAttr.MarkdownDescription == "" {
 				t.Errorf("attribute %s should have a description", tt.attrName)
 			}
 		})
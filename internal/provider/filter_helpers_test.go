@@ -566,6 +566,77 @@ func TestMatchesInt64Range(t *testing.T) {
 	}
 }
 
+func TestMatchesTimeRange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		value    string
+		from     types.String
+		to       types.String
+		expected bool
+	}{
+		{
+			name:     "no bounds matches all",
+			value:    "not-a-date",
+			from:     types.StringNull(),
+			to:       types.StringNull(),
+			expected: true,
+		},
+		{
+			name:     "within range",
+			value:    "2026-03-15T12:00:00Z",
+			from:     types.StringValue("2026-03-01T00:00:00Z"),
+			to:       types.StringValue("2026-03-31T23:59:59Z"),
+			expected: true,
+		},
+		{
+			name:     "inclusive lower bound",
+			value:    "2026-03-01T00:00:00Z",
+			from:     types.StringValue("2026-03-01T00:00:00Z"),
+			to:       types.StringNull(),
+			expected: true,
+		},
+		{
+			name:     "before lower bound",
+			value:    "2026-02-28T23:59:59Z",
+			from:     types.StringValue("2026-03-01T00:00:00Z"),
+			to:       types.StringNull(),
+			expected: false,
+		},
+		{
+			name:     "after upper bound",
+			value:    "2026-04-01T00:00:00Z",
+			from:     types.StringNull(),
+			to:       types.StringValue("2026-03-31T23:59:59Z"),
+			expected: false,
+		},
+		{
+			name:     "offset timezone compared as instant",
+			value:    "2026-03-01T01:00:00+02:00",
+			from:     types.StringValue("2026-03-01T00:00:00Z"),
+			to:       types.StringNull(),
+			expected: false,
+		},
+		{
+			name:     "unparseable value excluded when bounded",
+			value:    "",
+			from:     types.StringValue("2026-03-01T00:00:00Z"),
+			to:       types.StringNull(),
+			expected: false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := MatchesTimeRange(tt.value, tt.from, tt.to)
+			if got != tt.expected {
+				t.Errorf("MatchesTimeRange() = %v, want %v", got, tt.expected)
+			}
+		})
+	}
+}
+
 func TestContainsSubstring(t *testing.T) {
 	t.Parallel()
 
@@ -18,9 +18,13 @@ type MonitorFilterModel struct {
 
 // IncidentFilterModel represents incident filter criteria.
 type IncidentFilterModel struct {
-	NameRegex types.String `tfsdk:"name_regex"`
-	Status    types.String `tfsdk:"status"`   // investigating, identified, monitoring, resolved
-	Severity  types.String `tfsdk:"severity"` // minor, major, critical
+	NameRegex      types.String `tfsdk:"name_regex"`
+	Status         types.String `tfsdk:"status"`   // investigating, identified, monitoring, resolved
+	Severity       types.String `tfsdk:"severity"` // minor, major, critical
+	State          types.String `tfsdk:"state"`    // ongoing, resolved
+	StatusPageUUID types.String `tfsdk:"status_page_uuid"`
+	DateFrom       types.String `tfsdk:"date_from"`
+	DateTo         types.String `tfsdk:"date_to"`
 }
 
 // MaintenanceFilterModel represents maintenance window filter criteria.
@@ -47,6 +47,8 @@ type HealthcheckDataSourceModel struct {
 	Period           types.Int64  `tfsdk:"period"`
 	GracePeriod      types.Int64  `tfsdk:"grace_period"`
 	LastPing         types.String `tfsdk:"last_ping"`
+	DueDate          types.String `tfsdk:"due_date"`
+	Status           types.String `tfsdk:"status"`
 	CreatedAt        types.String `tfsdk:"created_at"`
 }
 
@@ -121,6 +123,14 @@ func (d *HealthcheckDataSource) Schema(_ context.Context, _ datasource.SchemaReq
 				MarkdownDescription: "Timestamp of the last ping received in ISO 8601 format.",
 				Computed:            true,
 			},
+			"due_date": schema.StringAttribute{
+				MarkdownDescription: "Timestamp by which the next ping is expected, in ISO 8601 format.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: healthcheckStatusDescription + ".",
+				Computed:            true,
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Creation timestamp in ISO 8601 format.",
 				Computed:            true,
@@ -144,11 +154,14 @@ func (d *HealthcheckDataSource) Configure(_ context.Context, req datasource.Conf
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *HealthcheckDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config HealthcheckDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -191,5 +204,7 @@ func mapHealthcheckToDataSourceModel(hc *hyperping.Healthcheck, model *Healthche
 	model.Period = f.Period
 	model.GracePeriod = f.GracePeriod
 	model.LastPing = f.LastPing
+	model.DueDate = f.DueDate
+	model.Status = f.Status
 	model.CreatedAt = f.CreatedAt
 }
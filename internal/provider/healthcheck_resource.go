@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,6 +25,7 @@ import (
 var (
 	_ resource.Resource                   = &HealthcheckResource{}
 	_ resource.ResourceWithImportState    = &HealthcheckResource{}
+	_ resource.ResourceWithIdentity       = &HealthcheckResource{}
 	_ resource.ResourceWithValidateConfig = &HealthcheckResource{}
 )
 
@@ -35,26 +37,31 @@ func NewHealthcheckResource() resource.Resource {
 // HealthcheckResource defines the resource implementation.
 type HealthcheckResource struct {
 	client hyperping.HealthcheckAPI
+	drift  *driftLog
 }
 
 // HealthcheckResourceModel describes the resource data model.
 type HealthcheckResourceModel struct {
-	ID               types.String `tfsdk:"id"`
-	Name             types.String `tfsdk:"name"`
-	PingURL          types.String `tfsdk:"ping_url"`
-	Cron             types.String `tfsdk:"cron"`
-	Timezone         types.String `tfsdk:"timezone"`
-	PeriodValue      types.Int64  `tfsdk:"period_value"`
-	PeriodType       types.String `tfsdk:"period_type"`
-	GracePeriodValue types.Int64  `tfsdk:"grace_period_value"`
-	GracePeriodType  types.String `tfsdk:"grace_period_type"`
-	EscalationPolicy types.String `tfsdk:"escalation_policy"`
-	IsPaused         types.Bool   `tfsdk:"is_paused"`
-	IsDown           types.Bool   `tfsdk:"is_down"`
-	Period           types.Int64  `tfsdk:"period"`
-	GracePeriod      types.Int64  `tfsdk:"grace_period"`
-	LastPing         types.String `tfsdk:"last_ping"`
-	CreatedAt        types.String `tfsdk:"created_at"`
+	ID               types.String  `tfsdk:"id"`
+	Name             types.String  `tfsdk:"name"`
+	PingURL          types.String  `tfsdk:"ping_url"`
+	Cron             types.String  `tfsdk:"cron"`
+	Timezone         TimezoneValue `tfsdk:"timezone"`
+	PeriodValue      types.Int64   `tfsdk:"period_value"`
+	PeriodType       types.String  `tfsdk:"period_type"`
+	GracePeriodValue types.Int64   `tfsdk:"grace_period_value"`
+	GracePeriodType  types.String  `tfsdk:"grace_period_type"`
+	EscalationPolicy types.String  `tfsdk:"escalation_policy"`
+	IsPaused         types.Bool    `tfsdk:"is_paused"`
+	IsDown           types.Bool    `tfsdk:"is_down"`
+	Period           types.Int64   `tfsdk:"period"`
+	GracePeriod      types.Int64   `tfsdk:"grace_period"`
+	LastPing         types.String  `tfsdk:"last_ping"`
+	DueDate          types.String  `tfsdk:"due_date"`
+	Status           types.String  `tfsdk:"status"`
+	CreatedAt        types.String  `tfsdk:"created_at"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -63,7 +70,7 @@ func (r *HealthcheckResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema defines the schema for the resource.
-func (r *HealthcheckResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *HealthcheckResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Hyperping healthcheck for cron job monitoring (dead man's switch).",
 
@@ -98,8 +105,10 @@ func (r *HealthcheckResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 			"timezone": schema.StringAttribute{
-				MarkdownDescription: "Timezone for the cron expression (e.g., `America/New_York`). Required when `cron` is set.",
-				Optional:            true,
+				MarkdownDescription: "IANA timezone for the cron expression (e.g., `America/New_York`). Required when `cron` is set. " +
+					"Validated at plan time; aliases of the same zone, such as `UTC` and `Etc/UTC`, do not cause a diff.",
+				CustomType: TimezoneType{},
+				Optional:   true,
 				Validators: []validator.String{
 					Timezone(),
 				},
@@ -155,6 +164,14 @@ func (r *HealthcheckResource) Schema(_ context.Context, _ resource.SchemaRequest
 				MarkdownDescription: "Timestamp of the last ping received in ISO 8601 format (read-only).",
 				Computed:            true,
 			},
+			"due_date": schema.StringAttribute{
+				MarkdownDescription: "Timestamp by which the next ping is expected, in ISO 8601 format (read-only).",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: healthcheckStatusDescription + " (read-only).",
+				Computed:            true,
+			},
 			"created_at": schema.StringAttribute{
 				MarkdownDescription: "Creation timestamp in ISO 8601 format (read-only).",
 				Computed:            true,
@@ -163,6 +180,15 @@ func (r *HealthcheckResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -217,7 +243,8 @@ func (r *HealthcheckResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	r.client = clients.REST
+	r.client = clients.restAPI()
+	r.drift = clients.driftLog
 }
 
 // validateCronFields validates that cron-specific requirements are met.
@@ -279,6 +306,13 @@ func (r *HealthcheckResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := validateCronPeriodExclusivity(&plan); err != nil {
 		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
 		return
@@ -294,8 +328,9 @@ func (r *HealthcheckResource) Create(ctx context.Context, req resource.CreateReq
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating healthcheck",
-			fmt.Sprintf("Could not create healthcheck: %s", err),
+			fmt.Sprintf("Could not create healthcheck: %s%s", redactError(err), validationDetailsText("Healthcheck", err)),
 		)
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Healthcheck", "create", err)...)
 		return
 	}
 
@@ -312,7 +347,7 @@ func (r *HealthcheckResource) Create(ctx context.Context, req resource.CreateReq
 			"Error reading created healthcheck",
 			fmt.Sprintf("Healthcheck created (ID: %s) but failed to read full state: %s. "+
 				"The resource has been saved to state with its ID. "+
-				"Run 'terraform apply' again to retry reading the full state.", created.UUID, err),
+				"Run 'terraform apply' again to retry reading the full state.", created.UUID, redactError(err)),
 		)
 		return
 	}
@@ -328,7 +363,7 @@ func (r *HealthcheckResource) Create(ctx context.Context, req resource.CreateReq
 			resp.Diagnostics.AddWarning(
 				"Healthcheck created but not paused",
 				fmt.Sprintf("Healthcheck %s was created successfully but the pause request failed: %s. "+
-					"The resource is active. Set is_paused = true again on next apply to retry.", healthcheck.UUID, err),
+					"The resource is active. Set is_paused = true again on next apply to retry.", healthcheck.UUID, redactError(err)),
 			)
 		} else {
 			plan.IsPaused = types.BoolValue(true)
@@ -336,6 +371,7 @@ func (r *HealthcheckResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // buildCreateHealthcheckRequest converts the plan model into an API request.
@@ -379,6 +415,14 @@ func (r *HealthcheckResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	healthcheck, err := r.client.GetHealthcheck(ctx, state.ID.ValueString())
 	if err != nil {
 		if hyperping.IsNotFound(err) {
@@ -387,7 +431,7 @@ func (r *HealthcheckResource) Read(ctx context.Context, req resource.ReadRequest
 		}
 		resp.Diagnostics.AddError(
 			"Error reading healthcheck",
-			fmt.Sprintf("Could not read healthcheck %s: %s", state.ID.ValueString(), err),
+			fmt.Sprintf("Could not read healthcheck %s: %s", state.ID.ValueString(), redactError(err)),
 		)
 		return
 	}
@@ -395,6 +439,7 @@ func (r *HealthcheckResource) Read(ctx context.Context, req resource.ReadRequest
 	r.mapHealthcheckToModel(healthcheck, &state)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	r.drift.log(ctx, "hyperping_healthcheck", req.State, resp.State)
 }
 
 // Update updates the resource and sets the updated Terraform state.
@@ -408,6 +453,13 @@ func (r *HealthcheckResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	if err := validateCronPeriodExclusivity(&plan); err != nil {
 		resp.Diagnostics.AddError("Invalid Configuration", err.Error())
 		return
@@ -440,7 +492,7 @@ func (r *HealthcheckResource) Update(ctx context.Context, req resource.UpdateReq
 			"Error reading updated healthcheck",
 			fmt.Sprintf("Could not read healthcheck %s: %s. "+
 				"State has been updated with plan values. "+
-				"Run 'terraform apply' again to refresh.", state.ID.ValueString(), err),
+				"Run 'terraform apply' again to refresh.", state.ID.ValueString(), redactError(err)),
 		)
 		return
 	}
@@ -448,6 +500,7 @@ func (r *HealthcheckResource) Update(ctx context.Context, req resource.UpdateReq
 	r.mapHealthcheckToModel(healthcheck, &plan)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // applyHealthcheckTimingFields handles schedule/timing field changes for healthcheck updates.
@@ -548,8 +601,9 @@ func (r *HealthcheckResource) applyFieldChanges(ctx context.Context, plan, state
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error updating healthcheck",
-			fmt.Sprintf("Could not update healthcheck %s: %s", state.ID.ValueString(), err),
+			fmt.Sprintf("Could not update healthcheck %s: %s%s", state.ID.ValueString(), redactError(err), validationDetailsText("Healthcheck", err)),
 		)
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Healthcheck", "update", err)...)
 	}
 }
 
@@ -560,7 +614,7 @@ func (r *HealthcheckResource) applyPauseState(ctx context.Context, id string, wa
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"Failed to pause healthcheck",
-				fmt.Sprintf("Healthcheck %s updates applied but pause failed: %s. Retry on next apply.", id, err),
+				fmt.Sprintf("Healthcheck %s updates applied but pause failed: %s. Retry on next apply.", id, redactError(err)),
 			)
 		}
 	} else {
@@ -568,7 +622,7 @@ func (r *HealthcheckResource) applyPauseState(ctx context.Context, id string, wa
 		if err != nil {
 			resp.Diagnostics.AddWarning(
 				"Failed to resume healthcheck",
-				fmt.Sprintf("Healthcheck %s updates applied but resume failed: %s. Retry on next apply.", id, err),
+				fmt.Sprintf("Healthcheck %s updates applied but resume failed: %s. Retry on next apply.", id, redactError(err)),
 			)
 		}
 	}
@@ -583,12 +637,19 @@ func (r *HealthcheckResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteHealthcheck(ctx, state.ID.ValueString())
 	if err != nil {
 		if !hyperping.IsNotFound(err) {
 			resp.Diagnostics.AddError(
 				"Error deleting healthcheck",
-				fmt.Sprintf("Could not delete healthcheck %s: %s", state.ID.ValueString(), err),
+				fmt.Sprintf("Could not delete healthcheck %s: %s", state.ID.ValueString(), redactError(err)),
 			)
 			return
 		}
@@ -603,13 +664,23 @@ func (r *HealthcheckResource) Delete(ctx context.Context, req resource.DeleteReq
 	)
 }
 
+// IdentitySchema defines the resource identity used by import blocks.
+func (r *HealthcheckResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("UUID of the healthcheck (e.g. `tok_abc123`).")
+}
+
 // ImportState imports an existing resource into Terraform.
 func (r *HealthcheckResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if err := hyperping.ValidateResourceID(req.ID); err != nil {
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := hyperping.ValidateResourceID(id); err != nil {
 		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Cannot import healthcheck: %s", err))
 		return
 	}
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // mapHealthcheckToModel maps a hyperping.Healthcheck to the Terraform resource model
@@ -620,7 +691,7 @@ func (r *HealthcheckResource) mapHealthcheckToModel(hc *hyperping.Healthcheck, m
 	model.Name = f.Name
 	model.PingURL = f.PingURL
 	model.Cron = f.Cron
-	model.Timezone = f.Timezone
+	model.Timezone = TimezoneValue{StringValue: f.Timezone}
 	model.PeriodValue = f.PeriodValue
 	model.PeriodType = f.PeriodType
 	model.GracePeriodValue = f.GracePeriodValue
@@ -631,5 +702,7 @@ func (r *HealthcheckResource) mapHealthcheckToModel(hc *hyperping.Healthcheck, m
 	model.Period = f.Period
 	model.GracePeriod = f.GracePeriod
 	model.LastPing = f.LastPing
+	model.DueDate = f.DueDate
+	model.Status = f.Status
 	model.CreatedAt = f.CreatedAt
 }
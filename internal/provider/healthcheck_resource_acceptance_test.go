@@ -82,6 +82,41 @@ func TestAccHealthcheckResource_full(t *testing.T) {
 	})
 }
 
+// TestAccHealthcheckResource_unknownEscalationPolicy verifies that
+// escalation_policy can reference a value that is only known after apply.
+func TestAccHealthcheckResource_unknownEscalationPolicy(t *testing.T) {
+	server := newMockHealthcheckServer(t)
+	defer server.Close()
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "hyperping" {
+  api_key  = "test_api_key"
+  base_url = %[1]q
+}
+
+resource "terraform_data" "policy" {
+  input = "ep_test123"
+}
+
+resource "hyperping_healthcheck" "test" {
+  name               = "unknown-policy"
+  period_value       = 300
+  period_type        = "seconds"
+  grace_period_value = 600
+  grace_period_type  = "seconds"
+  escalation_policy  = terraform_data.policy.output
+}
+`, server.URL),
+				Check: tfresource.TestCheckResourceAttr("hyperping_healthcheck.test", "escalation_policy", "ep_test123"),
+			},
+		},
+	})
+}
+
 func TestAccHealthcheckResource_pause(t *testing.T) {
 	server := newMockHealthcheckServer(t)
 	defer server.Close()
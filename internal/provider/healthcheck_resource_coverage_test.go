@@ -20,7 +20,7 @@ func TestApplyHealthcheckTimingFields_noChanges(t *testing.T) {
 
 	plan := &HealthcheckResourceModel{
 		Cron:             types.StringValue("0 * * * *"),
-		Timezone:         types.StringValue("UTC"),
+		Timezone:         NewTimezoneValue("UTC"),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(30),
@@ -28,7 +28,7 @@ func TestApplyHealthcheckTimingFields_noChanges(t *testing.T) {
 	}
 	state := &HealthcheckResourceModel{
 		Cron:             types.StringValue("0 * * * *"),
-		Timezone:         types.StringValue("UTC"),
+		Timezone:         NewTimezoneValue("UTC"),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(30),
@@ -66,7 +66,7 @@ func TestApplyHealthcheckTimingFields_cronChanged(t *testing.T) {
 
 	plan := &HealthcheckResourceModel{
 		Cron:             types.StringValue("0 0 * * *"),
-		Timezone:         types.StringValue("UTC"),
+		Timezone:         NewTimezoneValue("UTC"),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(30),
@@ -74,7 +74,7 @@ func TestApplyHealthcheckTimingFields_cronChanged(t *testing.T) {
 	}
 	state := &HealthcheckResourceModel{
 		Cron:             types.StringValue("0 * * * *"),
-		Timezone:         types.StringValue("UTC"),
+		Timezone:         NewTimezoneValue("UTC"),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(30),
@@ -97,7 +97,7 @@ func TestApplyHealthcheckTimingFields_cronRemoved(t *testing.T) {
 
 	plan := &HealthcheckResourceModel{
 		Cron:             types.StringNull(),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Value(60),
 		PeriodType:       types.StringValue("minutes"),
 		GracePeriodValue: types.Int64Value(30),
@@ -105,7 +105,7 @@ func TestApplyHealthcheckTimingFields_cronRemoved(t *testing.T) {
 	}
 	state := &HealthcheckResourceModel{
 		Cron:             types.StringValue("0 * * * *"),
-		Timezone:         types.StringValue("UTC"),
+		Timezone:         NewTimezoneValue("UTC"),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(30),
@@ -131,7 +131,7 @@ func TestApplyHealthcheckTimingFields_periodValueChanged(t *testing.T) {
 
 	plan := &HealthcheckResourceModel{
 		Cron:             types.StringNull(),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Value(120),
 		PeriodType:       types.StringValue("minutes"),
 		GracePeriodValue: types.Int64Value(30),
@@ -139,7 +139,7 @@ func TestApplyHealthcheckTimingFields_periodValueChanged(t *testing.T) {
 	}
 	state := &HealthcheckResourceModel{
 		Cron:             types.StringNull(),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Value(60),
 		PeriodType:       types.StringValue("minutes"),
 		GracePeriodValue: types.Int64Value(30),
@@ -166,7 +166,7 @@ func TestApplyHealthcheckTimingFields_periodValueRemoved(t *testing.T) {
 	// the field entirely."
 	plan := &HealthcheckResourceModel{
 		Cron:             types.StringValue("0 * * * *"),
-		Timezone:         types.StringValue("UTC"),
+		Timezone:         NewTimezoneValue("UTC"),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(30),
@@ -174,7 +174,7 @@ func TestApplyHealthcheckTimingFields_periodValueRemoved(t *testing.T) {
 	}
 	state := &HealthcheckResourceModel{
 		Cron:             types.StringNull(),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Value(60),
 		PeriodType:       types.StringValue("minutes"),
 		GracePeriodValue: types.Int64Value(30),
@@ -202,7 +202,7 @@ func TestApplyHealthcheckTimingFields_gracePeriodChanged(t *testing.T) {
 
 	plan := &HealthcheckResourceModel{
 		Cron:             types.StringValue("0 * * * *"),
-		Timezone:         types.StringValue("UTC"),
+		Timezone:         NewTimezoneValue("UTC"),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(60),
@@ -210,7 +210,7 @@ func TestApplyHealthcheckTimingFields_gracePeriodChanged(t *testing.T) {
 	}
 	state := &HealthcheckResourceModel{
 		Cron:             types.StringValue("0 * * * *"),
-		Timezone:         types.StringValue("UTC"),
+		Timezone:         NewTimezoneValue("UTC"),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(30),
@@ -240,7 +240,7 @@ func TestValidateCronPeriodExclusivity_cronModeOnly(t *testing.T) {
 
 	model := &HealthcheckResourceModel{
 		Cron:        types.StringValue("0 * * * *"),
-		Timezone:    types.StringValue("UTC"),
+		Timezone:    NewTimezoneValue("UTC"),
 		PeriodValue: types.Int64Null(),
 		PeriodType:  types.StringNull(),
 	}
@@ -256,7 +256,7 @@ func TestValidateCronPeriodExclusivity_periodModeOnly(t *testing.T) {
 
 	model := &HealthcheckResourceModel{
 		Cron:        types.StringNull(),
-		Timezone:    types.StringNull(),
+		Timezone:    NewTimezoneNull(),
 		PeriodValue: types.Int64Value(60),
 		PeriodType:  types.StringValue("minutes"),
 	}
@@ -272,7 +272,7 @@ func TestValidateCronPeriodExclusivity_bothSet(t *testing.T) {
 
 	model := &HealthcheckResourceModel{
 		Cron:        types.StringValue("0 * * * *"),
-		Timezone:    types.StringValue("UTC"),
+		Timezone:    NewTimezoneValue("UTC"),
 		PeriodValue: types.Int64Value(60),
 		PeriodType:  types.StringValue("minutes"),
 	}
@@ -292,7 +292,7 @@ func TestValidateCronPeriodExclusivity_neitherSet(t *testing.T) {
 
 	model := &HealthcheckResourceModel{
 		Cron:        types.StringNull(),
-		Timezone:    types.StringNull(),
+		Timezone:    NewTimezoneNull(),
 		PeriodValue: types.Int64Null(),
 		PeriodType:  types.StringNull(),
 	}
@@ -312,7 +312,7 @@ func TestValidateCronPeriodExclusivity_cronWithoutTimezone(t *testing.T) {
 
 	model := &HealthcheckResourceModel{
 		Cron:        types.StringValue("0 * * * *"),
-		Timezone:    types.StringNull(),
+		Timezone:    NewTimezoneNull(),
 		PeriodValue: types.Int64Null(),
 		PeriodType:  types.StringNull(),
 	}
@@ -332,7 +332,7 @@ func TestValidateCronPeriodExclusivity_timezoneWithoutCron(t *testing.T) {
 
 	model := &HealthcheckResourceModel{
 		Cron:        types.StringNull(),
-		Timezone:    types.StringValue("UTC"),
+		Timezone:    NewTimezoneValue("UTC"),
 		PeriodValue: types.Int64Null(),
 		PeriodType:  types.StringNull(),
 	}
@@ -352,7 +352,7 @@ func TestValidateCronPeriodExclusivity_periodValueWithoutType(t *testing.T) {
 
 	model := &HealthcheckResourceModel{
 		Cron:        types.StringNull(),
-		Timezone:    types.StringNull(),
+		Timezone:    NewTimezoneNull(),
 		PeriodValue: types.Int64Value(60),
 		PeriodType:  types.StringNull(),
 	}
@@ -372,7 +372,7 @@ func TestValidateCronPeriodExclusivity_periodTypeWithoutValue(t *testing.T) {
 
 	model := &HealthcheckResourceModel{
 		Cron:        types.StringNull(),
-		Timezone:    types.StringNull(),
+		Timezone:    NewTimezoneNull(),
 		PeriodValue: types.Int64Null(),
 		PeriodType:  types.StringValue("minutes"),
 	}
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
 )
 
 func TestAccHealthcheckResource_import(t *testing.T) {
@@ -19,7 +21,8 @@ func TestAccHealthcheckResource_import(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Step 1: Create the resource
 			{
-				Config: testAccHealthcheckResourceConfig_basic(server.URL, "test-import"),
+				Config:           testAccHealthcheckResourceConfig_basic(server.URL, "test-import"),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_healthcheck.test", "name", "test-import"),
 					tfresource.TestCheckResourceAttr("hyperping_healthcheck.test", "period_value", "60"),
@@ -36,6 +39,8 @@ func TestAccHealthcheckResource_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_healthcheck.test"),
 		},
 	})
 }
@@ -49,7 +54,8 @@ func TestAccHealthcheckResource_importFull(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Create resource with all optional fields
 			{
-				Config: testAccHealthcheckResourceConfig_full(server.URL),
+				Config:           testAccHealthcheckResourceConfig_full(server.URL),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_healthcheck.test", "name", "full-healthcheck"),
 					tfresource.TestCheckResourceAttr("hyperping_healthcheck.test", "period_value", "300"),
@@ -66,6 +72,8 @@ func TestAccHealthcheckResource_importFull(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_healthcheck.test"),
 		},
 	})
 }
@@ -56,6 +56,8 @@ type HealthcheckDataModel struct {
 	Period           types.Int64  `tfsdk:"period"`
 	GracePeriod      types.Int64  `tfsdk:"grace_period"`
 	LastPing         types.String `tfsdk:"last_ping"`
+	DueDate          types.String `tfsdk:"due_date"`
+	Status           types.String `tfsdk:"status"`
 	CreatedAt        types.String `tfsdk:"created_at"`
 }
 
@@ -145,6 +147,14 @@ func (d *HealthchecksDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 							MarkdownDescription: "Timestamp of the last ping received in ISO 8601 format.",
 							Computed:            true,
 						},
+						"due_date": schema.StringAttribute{
+							MarkdownDescription: "Timestamp by which the next ping is expected, in ISO 8601 format.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: healthcheckStatusDescription + ".",
+							Computed:            true,
+						},
 						"created_at": schema.StringAttribute{
 							MarkdownDescription: "Creation timestamp in ISO 8601 format.",
 							Computed:            true,
@@ -171,11 +181,14 @@ func (d *HealthchecksDataSource) Configure(_ context.Context, req datasource.Con
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *HealthchecksDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config HealthchecksDataSourceModel
 
 	// Get configuration (includes filter if provided)
@@ -188,7 +201,7 @@ func (d *HealthchecksDataSource) Read(ctx context.Context, req datasource.ReadRe
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading healthchecks",
-			fmt.Sprintf("Could not list healthchecks: %s", err),
+			fmt.Sprintf("Could not list healthchecks: %s", redactError(err)),
 		)
 		return
 	}
@@ -284,5 +297,7 @@ func (d *HealthchecksDataSource) mapHealthcheckToDataModel(hc *hyperping.Healthc
 	model.Period = f.Period
 	model.GracePeriod = f.GracePeriod
 	model.LastPing = f.LastPing
+	model.DueDate = f.DueDate
+	model.Status = f.Status
 	model.CreatedAt = f.CreatedAt
 }
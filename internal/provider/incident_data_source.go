@@ -128,11 +128,14 @@ func (d *IncidentDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *IncidentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config IncidentDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
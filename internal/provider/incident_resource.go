@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -26,6 +27,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentResource{}
 	_ resource.ResourceWithImportState = &IncidentResource{}
+	_ resource.ResourceWithIdentity    = &IncidentResource{}
 )
 
 // NewIncidentResource creates a new incident resource.
@@ -36,6 +38,7 @@ func NewIncidentResource() resource.Resource {
 // IncidentResource defines the resource implementation.
 type IncidentResource struct {
 	client hyperping.IncidentAPI
+	drift  *driftLog
 }
 
 // IncidentResourceModel describes the resource data model.
@@ -47,6 +50,8 @@ type IncidentResourceModel struct {
 	AffectedComponents types.List   `tfsdk:"affected_components"`
 	StatusPages        types.List   `tfsdk:"status_pages"`
 	Date               types.String `tfsdk:"date"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -55,7 +60,7 @@ func (r *IncidentResource) Metadata(_ context.Context, req resource.MetadataRequ
 }
 
 // Schema defines the schema for the resource.
-func (r *IncidentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IncidentResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Hyperping incident for status page updates.",
 
@@ -114,6 +119,15 @@ func (r *IncidentResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -129,7 +143,8 @@ func (r *IncidentResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	r.client = clients.REST
+	r.client = clients.restAPI()
+	r.drift = clients.driftLog
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -141,6 +156,13 @@ func (r *IncidentResource) Create(ctx context.Context, req resource.CreateReques
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build create request with localized text
 	createReq := hyperping.CreateIncidentRequest{
 		Title: hyperping.LocalizedText{En: plan.Title.ValueString()},
@@ -170,6 +192,7 @@ func (r *IncidentResource) Create(ctx context.Context, req resource.CreateReques
 	createResp, err := r.client.CreateIncident(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.Append(NewCreateErrorWithContext("Incident", err))
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Incident", "create", err)...)
 		return
 	}
 
@@ -182,6 +205,7 @@ func (r *IncidentResource) Create(ctx context.Context, req resource.CreateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -193,6 +217,14 @@ func (r *IncidentResource) Read(ctx context.Context, req resource.ReadRequest, r
 		return
 	}
 
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	incident, err := r.client.GetIncident(ctx, state.ID.ValueString())
 	if err != nil {
 		if hyperping.IsNotFound(err) {
@@ -209,6 +241,7 @@ func (r *IncidentResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	r.drift.log(ctx, "hyperping_incident", req.State, resp.State)
 }
 
 // Update updates the resource and sets the updated Terraform state.
@@ -222,6 +255,13 @@ func (r *IncidentResource) Update(ctx context.Context, req resource.UpdateReques
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build update request
 	updateReq := hyperping.UpdateIncidentRequest{}
 
@@ -271,6 +311,7 @@ func (r *IncidentResource) Update(ctx context.Context, req resource.UpdateReques
 	updateResp, err := r.client.UpdateIncident(ctx, state.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.Append(NewUpdateErrorWithContext("Incident", state.ID.ValueString(), err))
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Incident", "update", err)...)
 		return
 	}
 
@@ -281,6 +322,7 @@ func (r *IncidentResource) Update(ctx context.Context, req resource.UpdateReques
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource.
@@ -292,6 +334,13 @@ func (r *IncidentResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteIncident(ctx, state.ID.ValueString())
 	if err != nil {
 		if hyperping.IsNotFound(err) {
@@ -303,14 +352,24 @@ func (r *IncidentResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 }
 
+// IdentitySchema defines the resource identity used by import blocks.
+func (r *IncidentResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("UUID of the incident.")
+}
+
 // ImportState imports an existing resource into Terraform.
 func (r *IncidentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Validate the import ID before setting state (VULN-015)
-	if err := hyperping.ValidateResourceID(req.ID); err != nil {
+	if err := hyperping.ValidateResourceID(id); err != nil {
 		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Cannot import incident: %s", err))
 		return
 	}
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // mapIncidentToModel maps a hyperping.Incident to the Terraform model.
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
 )
 
 func TestAccIncidentResource_import(t *testing.T) {
@@ -19,7 +21,8 @@ func TestAccIncidentResource_import(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Step 1: Create the resource
 			{
-				Config: testAccIncidentResourceConfig_basic(server.URL, "Test Import Incident"),
+				Config:           testAccIncidentResourceConfig_basic(server.URL, "Test Import Incident"),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_incident.test", "title", "Test Import Incident"),
 					tfresource.TestCheckResourceAttr("hyperping_incident.test", "text", "Something went wrong"),
@@ -33,6 +36,8 @@ func TestAccIncidentResource_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_incident.test"),
 		},
 	})
 }
@@ -46,7 +51,8 @@ func TestAccIncidentResource_importFull(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Create resource with all optional fields
 			{
-				Config: testAccIncidentResourceConfig_full(server.URL),
+				Config:           testAccIncidentResourceConfig_full(server.URL),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_incident.test", "title", "Major Outage"),
 					tfresource.TestCheckResourceAttr("hyperping_incident.test", "text", "We are experiencing a major outage"),
@@ -60,6 +66,8 @@ func TestAccIncidentResource_importFull(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_incident.test"),
 		},
 	})
 }
@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -24,6 +25,7 @@ import (
 var (
 	_ resource.Resource                = &IncidentUpdateResource{}
 	_ resource.ResourceWithImportState = &IncidentUpdateResource{}
+	_ resource.ResourceWithIdentity    = &IncidentUpdateResource{}
 )
 
 // NewIncidentUpdateResource creates a new incident update resource.
@@ -34,6 +36,7 @@ func NewIncidentUpdateResource() resource.Resource {
 // IncidentUpdateResource defines the resource implementation.
 type IncidentUpdateResource struct {
 	client hyperping.IncidentAPI
+	drift  *driftLog
 }
 
 // IncidentUpdateResourceModel describes the resource data model.
@@ -43,6 +46,8 @@ type IncidentUpdateResourceModel struct {
 	Text       types.String `tfsdk:"text"`
 	Type       types.String `tfsdk:"type"`
 	Date       types.String `tfsdk:"date"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -51,7 +56,7 @@ func (r *IncidentUpdateResource) Metadata(_ context.Context, req resource.Metada
 }
 
 // Schema defines the schema for the resource.
-func (r *IncidentUpdateResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *IncidentUpdateResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages an update to a Hyperping incident. Use this to add status updates to an incident timeline.",
 
@@ -99,6 +104,13 @@ func (r *IncidentUpdateResource) Schema(_ context.Context, _ resource.SchemaRequ
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+		},
 	}
 }
 
@@ -114,7 +126,8 @@ func (r *IncidentUpdateResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	r.client = clients.REST
+	r.client = clients.restAPI()
+	r.drift = clients.driftLog
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -126,6 +139,13 @@ func (r *IncidentUpdateResource) Create(ctx context.Context, req resource.Create
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build the request
 	addReq := hyperping.AddIncidentUpdateRequest{
 		Text: hyperping.LocalizedText{En: plan.Text.ValueString()},
@@ -142,8 +162,9 @@ func (r *IncidentUpdateResource) Create(ctx context.Context, req resource.Create
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating incident update",
-			fmt.Sprintf("Could not add update to incident %s: %s", plan.IncidentID.ValueString(), err),
+			fmt.Sprintf("Could not add update to incident %s: %s%s", plan.IncidentID.ValueString(), redactError(err), validationDetailsText("Incident Update", err)),
 		)
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Incident Update", "create", err)...)
 		return
 	}
 
@@ -175,6 +196,7 @@ func (r *IncidentUpdateResource) Create(ctx context.Context, req resource.Create
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIncidentUpdateIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -186,6 +208,14 @@ func (r *IncidentUpdateResource) Read(ctx context.Context, req resource.ReadRequ
 		return
 	}
 
+	resp.Diagnostics.Append(setIncidentUpdateIdentity(ctx, resp.Identity, state.ID)...)
+
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Parse the composite ID
 	incidentID, updateID := parseIncidentUpdateID(state.ID.ValueString())
 	if incidentID == "" || updateID == "" {
@@ -205,7 +235,7 @@ func (r *IncidentUpdateResource) Read(ctx context.Context, req resource.ReadRequ
 		}
 		resp.Diagnostics.AddError(
 			"Error reading incident",
-			fmt.Sprintf("Could not read incident %s: %s", incidentID, err),
+			fmt.Sprintf("Could not read incident %s: %s", incidentID, redactError(err)),
 		)
 		return
 	}
@@ -229,6 +259,7 @@ func (r *IncidentUpdateResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	r.drift.log(ctx, "hyperping_incident_update", req.State, resp.State)
 }
 
 // Update handles in-place updates. Since text, type, and incident_id all have
@@ -248,6 +279,7 @@ func (r *IncidentUpdateResource) Update(ctx context.Context, req resource.Update
 	plan.ID = state.ID
 	plan.Date = state.Date
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIncidentUpdateIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource.
@@ -281,14 +313,24 @@ func (r *IncidentUpdateResource) Delete(ctx context.Context, req resource.Delete
 	)
 }
 
+// IdentitySchema defines the resource identity used by import blocks.
+func (r *IncidentUpdateResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = incidentUpdateIdentitySchema()
+}
+
 // ImportState imports an existing resource into Terraform.
 func (r *IncidentUpdateResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := incidentUpdateImportID(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Expect format: incident_id/update_id
-	incidentID, updateID := parseIncidentUpdateID(req.ID)
+	incidentID, updateID := parseIncidentUpdateID(id)
 	if incidentID == "" || updateID == "" {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Expected format: incident_id/update_id, got: %s", req.ID),
+			fmt.Sprintf("Expected format: incident_id/update_id, got: %s", id),
 		)
 		return
 	}
@@ -303,7 +345,7 @@ func (r *IncidentUpdateResource) ImportState(ctx context.Context, req resource.I
 		return
 	}
 
-	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("incident_id"), incidentID)...)
 }
 
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
 )
 
 func TestAccIncidentUpdateResource_import(t *testing.T) {
@@ -29,7 +31,8 @@ func TestAccIncidentUpdateResource_import(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Step 1: Create the resource
 			{
-				Config: testAccIncidentUpdateResourceConfig_basic(server.URL),
+				Config:           testAccIncidentUpdateResourceConfig_basic(server.URL),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_incident_update.test", "incident_id", "inci_base"),
 					tfresource.TestCheckResourceAttr("hyperping_incident_update.test", "text", "We are investigating the issue"),
@@ -43,6 +46,8 @@ func TestAccIncidentUpdateResource_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_incident_update.test"),
 		},
 	})
 }
@@ -71,7 +76,8 @@ func TestAccIncidentUpdateResource_importAllTypes(t *testing.T) {
 				Steps: []tfresource.TestStep{
 					// Create resource
 					{
-						Config: testAccIncidentUpdateResourceConfig_withTypeAndIncident(server.URL, incidentID, updateType),
+						Config:           testAccIncidentUpdateResourceConfig_withTypeAndIncident(server.URL, incidentID, updateType),
+						ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 						Check: tfresource.ComposeTestCheckFunc(
 							tfresource.TestCheckResourceAttr("hyperping_incident_update.test", "type", updateType),
 						),
@@ -82,6 +88,8 @@ func TestAccIncidentUpdateResource_importAllTypes(t *testing.T) {
 						ImportState:       true,
 						ImportStateVerify: true,
 					},
+					// Import with an import block and expect no changes
+					testutil.NoOpImportStep("hyperping_incident_update.test"),
 				},
 			})
 		})
@@ -96,10 +96,19 @@ func incidentUpdateTFType() tftypes.Object {
 			"text":        tftypes.String,
 			"type":        tftypes.String,
 			"date":        tftypes.String,
+			"timeouts":    incidentUpdateTimeoutsTFType,
 		},
 	}
 }
 
+// incidentUpdateTimeoutsTFType is the type of the timeouts block.
+var incidentUpdateTimeoutsTFType = tftypes.Object{
+	AttributeTypes: map[string]tftypes.Type{
+		"create": tftypes.String,
+		"read":   tftypes.String,
+	},
+}
+
 // buildIncidentUpdatePlan creates a tfsdk.Plan for testing Create.
 func buildIncidentUpdatePlan(incidentID, text, updateType, date string) tfsdk.Plan {
 	schemaResp := getIncidentUpdateSchema()
@@ -116,6 +125,7 @@ func buildIncidentUpdatePlan(incidentID, text, updateType, date string) tfsdk.Pl
 		"text":        tftypes.NewValue(tftypes.String, text),
 		"type":        tftypes.NewValue(tftypes.String, updateType),
 		"date":        dateVal,
+		"timeouts":    tftypes.NewValue(incidentUpdateTimeoutsTFType, nil),
 	})
 
 	return tfsdk.Plan{
@@ -140,6 +150,7 @@ func buildIncidentUpdateState(id, incidentID, text, updateType, date string) tfs
 		"text":        tftypes.NewValue(tftypes.String, text),
 		"type":        tftypes.NewValue(tftypes.String, updateType),
 		"date":        dateVal,
+		"timeouts":    tftypes.NewValue(incidentUpdateTimeoutsTFType, nil),
 	})
 
 	return tfsdk.State{
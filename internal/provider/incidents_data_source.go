@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 	"fmt"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -70,7 +71,7 @@ func IncidentUpdateAttrTypes() map[string]attr.Type {
 // Schema defines the schema for the data source.
 func (d *IncidentsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Fetches the list of all Hyperping incidents.",
+		MarkdownDescription: "Fetches the list of all Hyperping incidents, optionally filtered by title, resolution state, status page, and date range.",
 
 		Attributes: map[string]schema.Attribute{
 			"filter": IncidentFilterSchema(),
@@ -164,11 +165,14 @@ func (d *IncidentsDataSource) Configure(_ context.Context, req datasource.Config
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *IncidentsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config IncidentsDataSourceModel
 
 	// Get configuration (includes filter if provided)
@@ -182,7 +186,7 @@ func (d *IncidentsDataSource) Read(ctx context.Context, req datasource.ReadReque
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading incidents",
-			fmt.Sprintf("Could not list incidents: %s", err),
+			fmt.Sprintf("Could not list incidents: %s", redactError(err)),
 		)
 		return
 	}
@@ -248,9 +252,51 @@ func (d *IncidentsDataSource) shouldIncludeIncident(incident *hyperping.Incident
 		func() bool {
 			return isNullOrUnknown(filter.Severity)
 		},
+		// State filter (ongoing/resolved, derived from the latest update)
+		func() bool {
+			if isNullOrUnknown(filter.State) {
+				return true
+			}
+			return incidentState(incident) == filter.State.ValueString()
+		},
+		// Status page filter
+		func() bool {
+			return MatchesStringSlice(incident.StatusPages, filter.StatusPageUUID)
+		},
+		// Date range filter
+		func() bool {
+			return MatchesTimeRange(incident.Date, filter.DateFrom, filter.DateTo)
+		},
 	)
 }
 
+// incidentState returns "resolved" when the most recent update is a
+// resolution, and "ongoing" otherwise. Updates with unparseable dates fall
+// back to list order.
+func incidentState(incident *hyperping.Incident) string {
+	if len(incident.Updates) == 0 {
+		return "ongoing"
+	}
+
+	latest := incident.Updates[len(incident.Updates)-1]
+	var latestTime time.Time
+	for _, u := range incident.Updates {
+		t, err := time.Parse(time.RFC3339, u.Date)
+		if err != nil {
+			continue
+		}
+		if latestTime.IsZero() || !t.Before(latestTime) {
+			latest = u
+			latestTime = t
+		}
+	}
+
+	if latest.Type == "resolved" {
+		return "resolved"
+	}
+	return "ongoing"
+}
+
 // mapIncidentToDataModel maps a hyperping.Incident to the Terraform data model.
 func (d *IncidentsDataSource) mapIncidentToDataModel(incident *hyperping.Incident, model *IncidentDataModel, diags *diag.Diagnostics) {
 	model.ID = types.StringValue(incident.UUID)
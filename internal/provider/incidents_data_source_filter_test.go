@@ -134,6 +134,74 @@ func TestIncidentsDataSource_shouldIncludeIncident(t *testing.T) {
 			expected: false,
 			hasError: true,
 		},
+		{
+			name: "state resolved matches latest resolved update",
+			incident: hyperping.Incident{
+				Title: hyperping.LocalizedText{En: "DB Outage"},
+				Updates: []hyperping.IncidentUpdate{
+					{Date: "2026-03-01T11:00:00Z", Type: "resolved"},
+					{Date: "2026-03-01T10:00:00Z", Type: "investigating"},
+				},
+			},
+			filter:   &IncidentFilterModel{State: types.StringValue("resolved")},
+			expected: true,
+		},
+		{
+			name: "state ongoing excludes resolved incident",
+			incident: hyperping.Incident{
+				Title:   hyperping.LocalizedText{En: "DB Outage"},
+				Updates: []hyperping.IncidentUpdate{{Date: "2026-03-01T11:00:00Z", Type: "resolved"}},
+			},
+			filter:   &IncidentFilterModel{State: types.StringValue("ongoing")},
+			expected: false,
+		},
+		{
+			name: "state ongoing matches incident without updates",
+			incident: hyperping.Incident{
+				Title: hyperping.LocalizedText{En: "DB Outage"},
+			},
+			filter:   &IncidentFilterModel{State: types.StringValue("ongoing")},
+			expected: true,
+		},
+		{
+			name: "status page match",
+			incident: hyperping.Incident{
+				Title:       hyperping.LocalizedText{En: "DB Outage"},
+				StatusPages: []string{"sp_main", "sp_internal"},
+			},
+			filter:   &IncidentFilterModel{StatusPageUUID: types.StringValue("sp_internal")},
+			expected: true,
+		},
+		{
+			name: "status page no match",
+			incident: hyperping.Incident{
+				Title:       hyperping.LocalizedText{En: "DB Outage"},
+				StatusPages: []string{"sp_main"},
+			},
+			filter:   &IncidentFilterModel{StatusPageUUID: types.StringValue("sp_other")},
+			expected: false,
+		},
+		{
+			name: "date range match",
+			incident: hyperping.Incident{
+				Title: hyperping.LocalizedText{En: "DB Outage"},
+				Date:  "2026-03-15T08:00:00Z",
+			},
+			filter: &IncidentFilterModel{
+				DateFrom: types.StringValue("2026-03-01T00:00:00Z"),
+				DateTo:   types.StringValue("2026-03-31T23:59:59Z"),
+			},
+			expected: true,
+		},
+		{
+			name: "date range excludes older incident",
+			incident: hyperping.Incident{
+				Title: hyperping.LocalizedText{En: "DB Outage"},
+				Date:  "2026-02-15T08:00:00Z",
+			},
+			filter:   &IncidentFilterModel{DateFrom: types.StringValue("2026-03-01T00:00:00Z")},
+			expected: false,
+		},
 	}
 
 	for _, tt := range tests {
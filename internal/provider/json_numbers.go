@@ -0,0 +1,231 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math"
+	"mime"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// JSON number handling modes, set by the provider's json_numbers attribute.
+const (
+	// jsonNumbersLenient rewrites numeric fields the API returned as strings
+	// into JSON numbers before hyperping-go decodes the response.
+	jsonNumbersLenient = "lenient"
+	// jsonNumbersStrict passes responses through unchanged, so a string in a
+	// numeric field fails the read as it would without the provider.
+	jsonNumbersStrict = "strict"
+)
+
+// numberKind is the Go type hyperping-go decodes a numeric field into.
+type numberKind int
+
+const (
+	integerField numberKind = iota
+	floatField
+)
+
+// numericFields are the response fields hyperping-go decodes into Go
+// numbers, keyed by JSON name. The API occasionally returns them as strings,
+// e.g. "port": "443", which encoding/json refuses to put in an int.
+// expected_status_code and status page service IDs are not listed:
+// hyperping-go already decodes them with hyperping.FlexibleString.
+var numericFields = map[string]numberKind{
+	// Monitors
+	"check_frequency": integerField,
+	"port":            integerField,
+	"alerts_wait":     integerField,
+	"ssl_expiration":  integerField,
+	// Healthchecks
+	"period":             integerField,
+	"periodValue":        integerField,
+	"gracePeriod":        integerField,
+	"gracePeriodValue":   integerField,
+	"period_value":       integerField,
+	"grace_period_value": integerField,
+	// Maintenance windows
+	"notificationMinutes": integerField,
+	// Outages
+	"durationMs":   integerField,
+	"statusCode":   integerField,
+	"alertedSteps": integerField,
+	"totalSteps":   integerField,
+	// Reports
+	"sla":           floatField,
+	"mttr":          integerField,
+	"count":         integerField,
+	"totalDowntime": integerField,
+	"longestOutage": integerField,
+	"duration":      integerField,
+	// Pagination
+	"total":          integerField,
+	"page":           integerField,
+	"resultsPerPage": integerField,
+}
+
+// maxNormalizedBodyBytes bounds the responses the transport rewrites. Larger
+// responses are passed through unchanged rather than buffered.
+const maxNormalizedBodyBytes = 10 << 20
+
+// normalizeJSONNumbers rewrites the numericFields in a JSON document whose
+// values are numeric strings, such as "60" or " 60 ", into JSON numbers. An
+// empty string becomes null, and an integer field holding a whole float
+// such as 60.0 becomes 60. Values that are not numeric are left for
+// hyperping-go to reject. It returns the rewritten document and the paths of
+// the fields it changed, or the input and no paths when nothing changed or
+// the document is not valid JSON.
+func normalizeJSONNumbers(data []byte) ([]byte, []string) {
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var doc any
+	if err := dec.Decode(&doc); err != nil {
+		return data, nil
+	}
+
+	var changed []string
+	doc = normalizeValue(doc, "", &changed)
+	if len(changed) == 0 {
+		return data, nil
+	}
+
+	out, err := json.Marshal(doc)
+	if err != nil {
+		return data, nil
+	}
+	sort.Strings(changed)
+	return out, changed
+}
+
+func normalizeValue(v any, path string, changed *[]string) any {
+	switch v := v.(type) {
+	case map[string]any:
+		for key, value := range v {
+			fieldPath := key
+			if path != "" {
+				fieldPath = path + "." + key
+			}
+			if kind, ok := numericFields[key]; ok {
+				if normalized, ok := normalizeNumber(value, kind); ok {
+					v[key] = normalized
+					*changed = append(*changed, fieldPath)
+					continue
+				}
+			}
+			v[key] = normalizeValue(value, fieldPath, changed)
+		}
+	case []any:
+		for i, value := range v {
+			v[i] = normalizeValue(value, fmt.Sprintf("%s[%d]", path, i), changed)
+		}
+	}
+	return v
+}
+
+// normalizeNumber returns the JSON number for a numeric field value, and
+// whether the value needed rewriting.
+func normalizeNumber(v any, kind numberKind) (any, bool) {
+	var text string
+	switch v := v.(type) {
+	case string:
+		text = strings.TrimSpace(v)
+		if text == "" {
+			return nil, true
+		}
+	case json.Number:
+		if kind == floatField {
+			return v, false
+		}
+		if _, err := v.Int64(); err == nil {
+			return v, false
+		}
+		text = v.String()
+	default:
+		return v, false
+	}
+
+	f, err := strconv.ParseFloat(text, 64)
+	if err != nil || math.IsInf(f, 0) || math.IsNaN(f) {
+		return v, false
+	}
+	if kind == floatField {
+		return json.Number(strconv.FormatFloat(f, 'f', -1, 64)), true
+	}
+	if f != math.Trunc(f) || math.Abs(f) > math.MaxInt32 {
+		return v, false
+	}
+	return json.Number(strconv.FormatInt(int64(f), 10)), true
+}
+
+// jsonNumberTransport applies normalizeJSONNumbers to successful JSON
+// responses, so a field the API sends as a string does not fail a read.
+// Each rewrite is logged at debug level so schema drift in the API stays
+// visible.
+type jsonNumberTransport struct {
+	next http.RoundTripper
+}
+
+func newJSONNumberTransport(next http.RoundTripper) *jsonNumberTransport {
+	return &jsonNumberTransport{next: next}
+}
+
+func (t *jsonNumberTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil || resp == nil || resp.Body == nil || !isJSONSuccess(resp) {
+		return resp, err
+	}
+	if resp.ContentLength > maxNormalizedBodyBytes {
+		return resp, nil
+	}
+
+	data, readErr := io.ReadAll(io.LimitReader(resp.Body, maxNormalizedBodyBytes+1))
+	if readErr != nil || len(data) > maxNormalizedBodyBytes {
+		// Hand back what was read followed by the unread remainder, so the
+		// client sees the response, or the read error, as it was.
+		resp.Body = readCloser{io.MultiReader(bytes.NewReader(data), resp.Body), resp.Body}
+		return resp, nil
+	}
+	resp.Body.Close() //nolint:errcheck // fully read
+
+	normalized, changed := normalizeJSONNumbers(data)
+	if len(changed) > 0 {
+		tflog.Debug(req.Context(), "Converted string values in numeric API response fields", map[string]any{
+			"method": req.Method,
+			"path":   req.URL.Path,
+			"fields": strings.Join(changed, ", "),
+		})
+		resp.Header.Del("Content-Length")
+	}
+	resp.Body = io.NopCloser(bytes.NewReader(normalized))
+	resp.ContentLength = int64(len(normalized))
+	return resp, nil
+}
+
+// isJSONSuccess reports whether resp is a 2xx response with a JSON body.
+func isJSONSuccess(resp *http.Response) bool {
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return false
+	}
+	mediaType, _, err := mime.ParseMediaType(resp.Header.Get(hyperping.HeaderContentType))
+	return err == nil && mediaType == hyperping.ContentTypeJSON
+}
+
+// readCloser reads from r and closes c.
+type readCloser struct {
+	io.Reader
+	c io.Closer
+}
+
+func (rc readCloser) Close() error { return rc.c.Close() }
@@ -0,0 +1,209 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestNormalizeJSONNumbers(t *testing.T) {
+	tests := []struct {
+		name        string
+		in          string
+		want        string
+		wantChanged string
+	}{
+		{"integer string", `{"port":"443"}`, `{"port":443}`, "port"},
+		{"padded integer string", `{"check_frequency":" 60 "}`, `{"check_frequency":60}`, "check_frequency"},
+		{"empty string", `{"ssl_expiration":""}`, `{"ssl_expiration":null}`, "ssl_expiration"},
+		{"whole float string", `{"period":"300.0"}`, `{"period":300}`, "period"},
+		{"whole float number", `{"gracePeriod":300.0}`, `{"gracePeriod":300}`, "gracePeriod"},
+		{"float field", `{"sla":"99.95"}`, `{"sla":99.95}`, "sla"},
+		{"nested in array", `{"items":[{"port":"22"},{"port":80}]}`, `{"items":[{"port":22},{"port":80}]}`, "items[0].port"},
+		{"numbers unchanged", `{"port":443,"sla":99.9}`, `{"port":443,"sla":99.9}`, ""},
+		{"fractional integer", `{"port":"44.3"}`, `{"port":"44.3"}`, ""},
+		{"not a number", `{"port":"https"}`, `{"port":"https"}`, ""},
+		{"out of range", `{"port":"1e12"}`, `{"port":"1e12"}`, ""},
+		{"unlisted field", `{"id":"48213","name":"60"}`, `{"id":"48213","name":"60"}`, ""},
+		{"object value", `{"period":{"from":"1","to":"2"}}`, `{"period":{"from":"1","to":"2"}}`, ""},
+		{"invalid JSON", `{"port":"443"`, `{"port":"443"`, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, changed := normalizeJSONNumbers([]byte(tt.in))
+			if string(got) != tt.want {
+				t.Errorf("normalizeJSONNumbers(%s) = %s, want %s", tt.in, got, tt.want)
+			}
+			if strings.Join(changed, ",") != tt.wantChanged {
+				t.Errorf("changed = %v, want %q", changed, tt.wantChanged)
+			}
+		})
+	}
+}
+
+// newRecordedPayloadClient returns a client whose API serves the recorded
+// payload files in testdata/json_numbers, keyed by request path.
+func newRecordedPayloadClient(t *testing.T, lenient bool, files map[string]string) *hyperping.Client {
+	t.Helper()
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		file, ok := files[r.URL.Path]
+		if !ok {
+			http.NotFound(w, r)
+			return
+		}
+		data, err := os.ReadFile(filepath.Join("testdata", "json_numbers", file))
+		if err != nil {
+			t.Errorf("reading payload: %v", err)
+			w.WriteHeader(http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set(hyperping.HeaderContentType, hyperping.ContentTypeJSON+"; charset=utf-8")
+		w.Write(data) //nolint:errcheck
+	}))
+	t.Cleanup(server.Close)
+
+	httpClient := &http.Client{Transport: http.DefaultTransport}
+	client := hyperping.NewClient("sk_test_key", hyperping.WithBaseURL(server.URL), hyperping.WithHTTPClient(httpClient), hyperping.WithMaxRetries(0))
+	if lenient {
+		httpClient.Transport = newJSONNumberTransport(httpClient.Transport)
+	}
+	return client
+}
+
+func TestJSONNumberTransport_RecordedPayloads(t *testing.T) {
+	client := newRecordedPayloadClient(t, true, map[string]string{
+		hyperping.MonitorsBasePath + "/mon_2b7Hq5rKx1": "monitor.json",
+		hyperping.HealthchecksBasePath:                 "healthchecks.json",
+		hyperping.OutagesBasePath:                      "outages.json",
+		hyperping.ReportsBasePath + "/mon_2b7Hq5rKx1":  "monitor_report.json",
+	})
+	ctx := context.Background()
+
+	monitor, err := client.GetMonitor(ctx, "mon_2b7Hq5rKx1")
+	if err != nil {
+		t.Fatalf("GetMonitor: %v", err)
+	}
+	if monitor.CheckFrequency != 60 || monitor.Port == nil || *monitor.Port != 443 || monitor.AlertsWait != 2 || monitor.SSLExpiration != nil {
+		t.Errorf("monitor = %+v", monitor)
+	}
+
+	healthchecks, err := client.ListHealthchecks(ctx)
+	if err != nil {
+		t.Fatalf("ListHealthchecks: %v", err)
+	}
+	if len(healthchecks) != 2 {
+		t.Fatalf("healthchecks = %d, want 2", len(healthchecks))
+	}
+	if h := healthchecks[0]; h.PeriodValue == nil || *h.PeriodValue != 1 || h.Period != 86400 || h.GracePeriod != 3600 || h.GracePeriodValue != 1 {
+		t.Errorf("healthcheck = %+v", h)
+	}
+
+	outages, err := client.ListOutages(ctx)
+	if err != nil {
+		t.Fatalf("ListOutages: %v", err)
+	}
+	if len(outages) != 1 || outages[0].DurationMs != 120000 || outages[0].StatusCode != 503 ||
+		outages[0].EscalationPolicy == nil || outages[0].EscalationPolicy.TotalSteps != 3 {
+		t.Errorf("outages = %+v", outages)
+	}
+
+	report, err := client.GetMonitorReport(ctx, "mon_2b7Hq5rKx1", "", "")
+	if err != nil {
+		t.Fatalf("GetMonitorReport: %v", err)
+	}
+	if report.SLA != 99.95 || report.MTTR != 120 || report.Outages.Count != 1 || report.Outages.Details[0].Duration != 120 {
+		t.Errorf("report = %+v", report)
+	}
+}
+
+func TestJSONNumberTransport_StrictFails(t *testing.T) {
+	client := newRecordedPayloadClient(t, false, map[string]string{
+		hyperping.MonitorsBasePath + "/mon_2b7Hq5rKx1": "monitor.json",
+	})
+	if _, err := client.GetMonitor(context.Background(), "mon_2b7Hq5rKx1"); err == nil {
+		t.Fatal("expected the recorded payload to fail without the transport")
+	}
+}
+
+func TestJSONNumberTransport_PassThrough(t *testing.T) {
+	tests := []struct {
+		name        string
+		status      int
+		contentType string
+	}{
+		{"error response", http.StatusUnprocessableEntity, hyperping.ContentTypeJSON},
+		{"not JSON", http.StatusOK, "text/plain"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			body := `{"port":"443"}`
+			next := roundTripFunc(func(r *http.Request) (*http.Response, error) {
+				return &http.Response{
+					StatusCode: tt.status,
+					Header:     http.Header{hyperping.HeaderContentType: []string{tt.contentType}},
+					Body:       io.NopCloser(strings.NewReader(body)),
+					Request:    r,
+				}, nil
+			})
+
+			req := httptest.NewRequest(http.MethodGet, "https://api.hyperping.io/v1/monitors", nil)
+			resp, err := newJSONNumberTransport(next).RoundTrip(req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer resp.Body.Close()
+			got, _ := io.ReadAll(resp.Body) //nolint:errcheck
+			if string(got) != body {
+				t.Errorf("body = %s, want %s unchanged", got, body)
+			}
+		})
+	}
+}
+
+func TestAccProvider_JSONNumbers(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(hyperping.HeaderContentType, hyperping.ContentTypeJSON)
+		w.Write([]byte(`[{"uuid": "mon_1", "name": "API", "url": "https://api.example.com", "protocol": "http", "check_frequency": "60"}]`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	config := func(mode string) string {
+		return fmt.Sprintf(`
+provider "hyperping" {
+  api_key      = "test_api_key"
+  base_url     = %q
+  json_numbers = %q
+}
+
+data "hyperping_monitors" "all" {}
+`, server.URL, mode)
+	}
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: config(jsonNumbersLenient),
+				Check:  tfresource.TestCheckResourceAttr("data.hyperping_monitors.all", "monitors.0.check_frequency", "60"),
+			},
+			{
+				Config:      config(jsonNumbersStrict),
+				ExpectError: regexp.MustCompile(`(?i)cannot unmarshal string`),
+			},
+		},
+	})
+}
@@ -30,9 +30,10 @@ var sensitiveLogFieldKeys = []string{
 // Every Debug call applies tflog masking before emitting:
 //   - any field whose key matches a known sensitive header/parameter name is
 //     replaced with [REDACTED];
-//   - any value matching hyperping.APIKeyPattern (sk_...) is replaced with
-//     [REDACTED] regardless of field name, catching cases where a secret is
-//     logged under an unexpected key.
+//   - any part of a value matching hyperping.APIKeyPattern (sk_...), an
+//     authorization credential, or a sensitive JSON field (secretPatterns) is
+//     masked regardless of field name, catching secrets logged under an
+//     unexpected key or echoed in an error response body.
 //
 // This per-call masking is the runtime guarantee: a derived context built in
 // provider.Configure does not survive into the per-operation contexts that
@@ -47,7 +48,8 @@ func NewTFLogAdapter() *TFLogAdapter {
 // Debug logs a debug-level message using tflog, redacting sensitive fields.
 func (l *TFLogAdapter) Debug(ctx context.Context, msg string, fields map[string]interface{}) {
 	ctx = tflog.MaskFieldValuesWithFieldKeys(ctx, sensitiveLogFieldKeys...)
-	ctx = tflog.MaskAllFieldValuesRegexes(ctx, hyperping.APIKeyPattern)
+	ctx = tflog.MaskAllFieldValuesRegexes(ctx, secretPatterns...)
+	ctx = tflog.MaskMessageRegexes(ctx, secretPatterns...)
 	tflog.Debug(ctx, msg, fields)
 }
 
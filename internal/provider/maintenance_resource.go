@@ -8,6 +8,7 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -29,6 +30,7 @@ import (
 var (
 	_ resource.Resource                   = &MaintenanceResource{}
 	_ resource.ResourceWithImportState    = &MaintenanceResource{}
+	_ resource.ResourceWithIdentity       = &MaintenanceResource{}
 	_ resource.ResourceWithValidateConfig = &MaintenanceResource{}
 )
 
@@ -40,6 +42,7 @@ func NewMaintenanceResource() resource.Resource {
 // MaintenanceResource defines the resource implementation.
 type MaintenanceResource struct {
 	client hyperping.MaintenanceAPI
+	drift  *driftLog
 }
 
 // MaintenanceResourceModel describes the resource data model.
@@ -54,6 +57,8 @@ type MaintenanceResourceModel struct {
 	StatusPages         types.List   `tfsdk:"status_pages"`
 	NotificationOption  types.String `tfsdk:"notification_option"`
 	NotificationMinutes types.Int64  `tfsdk:"notification_minutes"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -62,7 +67,7 @@ func (r *MaintenanceResource) Metadata(_ context.Context, req resource.MetadataR
 }
 
 // Schema defines the schema for the resource.
-func (r *MaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MaintenanceResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Hyperping maintenance window for scheduled downtime.",
 
@@ -141,6 +146,15 @@ func (r *MaintenanceResource) Schema(_ context.Context, _ resource.SchemaRequest
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -156,7 +170,8 @@ func (r *MaintenanceResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	r.client = clients.REST
+	r.client = clients.restAPI()
+	r.drift = clients.driftLog
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -168,6 +183,13 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Validate date format, order, and add warnings
 	resp.Diagnostics.Append(validateMaintenanceDates(&plan)...)
 	if resp.Diagnostics.HasError() {
@@ -225,6 +247,7 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 	createResp, err := r.client.CreateMaintenance(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.Append(NewCreateErrorWithContext("Maintenance Window", err))
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Maintenance Window", "create", err)...)
 		return
 	}
 
@@ -237,6 +260,7 @@ func (r *MaintenanceResource) Create(ctx context.Context, req resource.CreateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -248,6 +272,14 @@ func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest
 		return
 	}
 
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	maintenance, err := r.client.GetMaintenance(ctx, state.ID.ValueString())
 	if err != nil {
 		if hyperping.IsNotFound(err) {
@@ -264,6 +296,7 @@ func (r *MaintenanceResource) Read(ctx context.Context, req resource.ReadRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	r.drift.log(ctx, "hyperping_maintenance", req.State, resp.State)
 }
 
 // buildMaintenanceUpdateRequest constructs an UpdateMaintenanceRequest with only changed fields.
@@ -341,6 +374,13 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Validate dates if they changed
 	if !plan.StartDate.Equal(state.StartDate) || !plan.EndDate.Equal(state.EndDate) {
 		resp.Diagnostics.Append(validateMaintenanceDates(&plan)...)
@@ -359,6 +399,7 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 	updateResp, err := r.client.UpdateMaintenance(ctx, state.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.Append(NewUpdateErrorWithContext("Maintenance Window", state.ID.ValueString(), err))
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Maintenance Window", "update", err)...)
 		return
 	}
 
@@ -369,6 +410,7 @@ func (r *MaintenanceResource) Update(ctx context.Context, req resource.UpdateReq
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource.
@@ -380,6 +422,13 @@ func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteReq
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteMaintenance(ctx, state.ID.ValueString())
 	if err != nil {
 		if hyperping.IsNotFound(err) {
@@ -391,14 +440,24 @@ func (r *MaintenanceResource) Delete(ctx context.Context, req resource.DeleteReq
 	}
 }
 
+// IdentitySchema defines the resource identity used by import blocks.
+func (r *MaintenanceResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("UUID of the maintenance window.")
+}
+
 // ImportState imports an existing resource into Terraform.
 func (r *MaintenanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Validate the import ID before setting state (VULN-015)
-	if err := hyperping.ValidateResourceID(req.ID); err != nil {
+	if err := hyperping.ValidateResourceID(id); err != nil {
 		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Cannot import maintenance window: %s", err))
 		return
 	}
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // ValidateConfig implements resource.ResourceWithValidateConfig for cross-field
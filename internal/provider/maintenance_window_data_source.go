@@ -117,11 +117,14 @@ func (d *MaintenanceWindowDataSource) Configure(_ context.Context, req datasourc
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *MaintenanceWindowDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config MaintenanceWindowDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
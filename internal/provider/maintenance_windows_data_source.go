@@ -143,11 +143,14 @@ func (d *MaintenanceWindowsDataSource) Configure(_ context.Context, req datasour
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *MaintenanceWindowsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config MaintenanceWindowsDataSourceModel
 
 	// Get configuration (includes filter if provided)
@@ -161,7 +164,7 @@ func (d *MaintenanceWindowsDataSource) Read(ctx context.Context, req datasource.
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading maintenance windows",
-			fmt.Sprintf("Could not list maintenance windows: %s", err),
+			fmt.Sprintf("Could not list maintenance windows: %s", redactError(err)),
 		)
 		return
 	}
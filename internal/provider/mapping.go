@@ -250,6 +250,8 @@ type HealthcheckCommonFields struct {
 	Period           types.Int64
 	GracePeriod      types.Int64
 	LastPing         types.String
+	DueDate          types.String
+	Status           types.String
 	CreatedAt        types.String
 }
 
@@ -273,6 +275,8 @@ func MapHealthcheckCommonFields(hc *hyperping.Healthcheck) HealthcheckCommonFiel
 			Period:           types.Int64Null(),
 			GracePeriod:      types.Int64Null(),
 			LastPing:         types.StringNull(),
+			DueDate:          types.StringNull(),
+			Status:           types.StringNull(),
 			CreatedAt:        types.StringNull(),
 		}
 	}
@@ -286,6 +290,7 @@ func MapHealthcheckCommonFields(hc *hyperping.Healthcheck) HealthcheckCommonFiel
 		GracePeriod:      types.Int64Value(int64(hc.GracePeriod)),
 		GracePeriodValue: types.Int64Value(int64(hc.GracePeriodValue)),
 		GracePeriodType:  types.StringValue(hc.GracePeriodType),
+		Status:           types.StringValue(healthcheckStatus(hc)),
 	}
 
 	if hc.Cron != "" {
@@ -318,6 +323,11 @@ func MapHealthcheckCommonFields(hc *hyperping.Healthcheck) HealthcheckCommonFiel
 	} else {
 		f.LastPing = types.StringNull()
 	}
+	if hc.DueDate != "" {
+		f.DueDate = types.StringValue(hc.DueDate)
+	} else {
+		f.DueDate = types.StringNull()
+	}
 	if hc.CreatedAt != "" {
 		f.CreatedAt = types.StringValue(hc.CreatedAt)
 	} else {
@@ -327,6 +337,34 @@ func MapHealthcheckCommonFields(hc *hyperping.Healthcheck) HealthcheckCommonFiel
 	return f
 }
 
+// Healthcheck status values derived from the API's is_paused, is_down and
+// last_ping fields.
+const (
+	healthcheckStatusPaused  = "paused"
+	healthcheckStatusDown    = "down"
+	healthcheckStatusPending = "pending"
+	healthcheckStatusUp      = "up"
+
+	healthcheckStatusDescription = "Operational status: `paused`, `down` (the expected ping is overdue), " +
+		"`pending` (no ping received yet), or `up`"
+)
+
+// healthcheckStatus summarises a healthcheck's state as a single string so
+// modules can branch on it without combining the individual flags. Paused
+// takes precedence because a paused healthcheck is not evaluated.
+func healthcheckStatus(hc *hyperping.Healthcheck) string {
+	switch {
+	case hc.IsPaused:
+		return healthcheckStatusPaused
+	case hc.IsDown:
+		return healthcheckStatusDown
+	case hc.LastPing == "":
+		return healthcheckStatusPending
+	default:
+		return healthcheckStatusUp
+	}
+}
+
 // MapOutageNestedObjects builds the monitor and acknowledged_by nested objects from an outage.
 // Returns null objects if the outage or its monitor reference is missing/empty.
 func MapOutageNestedObjects(outage *hyperping.Outage, diags *diag.Diagnostics) (types.Object, types.Object) {
@@ -569,6 +569,8 @@ func TestMapHealthcheckCommonFields_NilReturnsAllNulls(t *testing.T) {
 		"GracePeriodType":  f.GracePeriodType,
 		"EscalationPolicy": f.EscalationPolicy,
 		"LastPing":         f.LastPing,
+		"DueDate":          f.DueDate,
+		"Status":           f.Status,
 		"CreatedAt":        f.CreatedAt,
 	}
 	for name, field := range nullStrings {
@@ -49,6 +49,7 @@ func TestMapHealthcheckCommonFields_FullHealthcheck(t *testing.T) {
 		Period:           60,
 		GracePeriod:      30,
 		LastPing:         "2026-01-28T10:00:00Z",
+		DueDate:          "2026-01-28T10:01:30Z",
 		CreatedAt:        "2026-01-01T00:00:00Z",
 	}
 
@@ -78,6 +79,36 @@ func TestMapHealthcheckCommonFields_FullHealthcheck(t *testing.T) {
 	if f.LastPing.ValueString() != "2026-01-28T10:00:00Z" {
 		t.Errorf("expected LastPing '2026-01-28T10:00:00Z', got %s", f.LastPing.ValueString())
 	}
+	if f.DueDate.ValueString() != "2026-01-28T10:01:30Z" {
+		t.Errorf("expected DueDate '2026-01-28T10:01:30Z', got %s", f.DueDate.ValueString())
+	}
+	if f.Status.ValueString() != "down" {
+		t.Errorf("expected Status 'down', got %s", f.Status.ValueString())
+	}
+}
+
+func TestHealthcheckStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		hc   hyperping.Healthcheck
+		want string
+	}{
+		{"paused wins over down", hyperping.Healthcheck{IsPaused: true, IsDown: true, LastPing: "2026-01-28T10:00:00Z"}, "paused"},
+		{"down", hyperping.Healthcheck{IsDown: true, LastPing: "2026-01-28T10:00:00Z"}, "down"},
+		{"never pinged", hyperping.Healthcheck{}, "pending"},
+		{"up", hyperping.Healthcheck{LastPing: "2026-01-28T10:00:00Z"}, "up"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			if got := healthcheckStatus(&tt.hc); got != tt.want {
+				t.Errorf("healthcheckStatus() = %q, want %q", got, tt.want)
+			}
+		})
+	}
 }
 
 func TestMapHealthcheckCommonFields_NullOptionalFields(t *testing.T) {
@@ -308,9 +339,9 @@ func buildTestPlan(cron, tz string, periodValue *int, periodType string) Healthc
 		plan.Cron = tfStringNull()
 	}
 	if tz != "" {
-		plan.Timezone = tfString(tz)
+		plan.Timezone = NewTimezoneValue(tz)
 	} else {
-		plan.Timezone = tfStringNull()
+		plan.Timezone = NewTimezoneNull()
 	}
 	if periodValue != nil {
 		plan.PeriodValue = tfInt64(int64(*periodValue))
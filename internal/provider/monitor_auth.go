@@ -0,0 +1,274 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// authorizationHeader is the header generated from basic_auth and
+// bearer_token.
+const authorizationHeader = "Authorization"
+
+// basicAuthAttrTypes returns the attribute types for the monitor basic_auth
+// object.
+func basicAuthAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"username":         types.StringType,
+		"password":         types.StringType,
+		"password_version": types.Int64Type,
+	}
+}
+
+// basicAuthSchemaAttribute returns the schema of the monitor basic_auth
+// attribute.
+func basicAuthSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "HTTP Basic authentication for the monitored endpoint. The provider sends an " +
+			"`Authorization: Basic` header built from `username` and `password`, so the credentials do not have to be " +
+			"encoded into `request_headers` by hand. Like `request_headers` values, the password is write-only: it is " +
+			"never persisted to state, and the header is never read back from the API, so a header edited outside " +
+			"Terraform is not detected. Change `password_version` to send a new password. Only valid when protocol is " +
+			"`http`. Conflicts with `bearer_token` and with an `Authorization` entry in `request_headers`.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"username": schema.StringAttribute{
+				MarkdownDescription: "The username. Must not contain a colon.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexp.MustCompile(`^[^:]*$`), "must not contain a colon"),
+					NoControlCharacters("Username must not contain CR, LF, or NULL characters."),
+				},
+			},
+			"password": schema.StringAttribute{
+				MarkdownDescription: "The password. Sensitive: masked in plan output. " +
+					"Write-only: the value is never persisted to Terraform state (requires Terraform >= 1.11). " +
+					"Because write-only values are null in state, editing only the password produces no diff; " +
+					"change `password_version` to send the new value.",
+				Required:  true,
+				Sensitive: true,
+				WriteOnly: true,
+			},
+			"password_version": schema.Int64Attribute{
+				MarkdownDescription: "Any number, stored in state. Changing it sends the current `password` on the " +
+					"next apply, for example after rotating it.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// bearerTokenSchemaAttribute returns the schema of the monitor bearer_token
+// attribute.
+func bearerTokenSchemaAttribute() schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: "Token sent as an `Authorization: Bearer` header to the monitored endpoint. " +
+			"Sensitive: masked in plan output. Write-only: the value is never persisted to Terraform state " +
+			"(requires Terraform >= 1.11), and the header is never read back from the API. Requires " +
+			"`bearer_token_version`, which is stored in state and must change for a rotated token to be sent. " +
+			"Only valid when protocol is `http`. Conflicts with `basic_auth` and with an `Authorization` entry " +
+			"in `request_headers`.",
+		Optional:  true,
+		Sensitive: true,
+		WriteOnly: true,
+		Validators: []validator.String{
+			stringvalidator.LengthAtLeast(1),
+			stringvalidator.AlsoRequires(path.MatchRoot("bearer_token_version")),
+			NoControlCharacters("Bearer token must not contain CR, LF, or NULL characters to prevent HTTP header injection."),
+		},
+	}
+}
+
+// bearerTokenVersionSchemaAttribute returns the schema of the monitor
+// bearer_token_version attribute.
+func bearerTokenVersionSchemaAttribute() schema.Int64Attribute {
+	return schema.Int64Attribute{
+		MarkdownDescription: "Any number, stored in state. Required with `bearer_token`, which is write-only and so " +
+			"has no diff of its own: changing this value sends the current token on the next apply, and adding " +
+			"or removing it with `bearer_token` adds or removes the `Authorization` header.",
+		Optional: true,
+		Validators: []validator.Int64{
+			int64validator.AlsoRequires(path.MatchRoot("bearer_token")),
+		},
+	}
+}
+
+// readConfigAuth populates basic_auth and bearer_token on the model from the
+// resource config. The password and token are write-only (TF-09), so they are
+// null in the plan and must be read from the config for the API request.
+func readConfigAuth(ctx context.Context, cfg tfsdk.Config, model *MonitorResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	diags.Append(cfg.GetAttribute(ctx, path.Root("basic_auth"), &model.BasicAuth)...)
+	diags.Append(cfg.GetAttribute(ctx, path.Root("bearer_token"), &model.BearerToken)...)
+	return diags
+}
+
+// authorizationHeaderValue returns the Authorization header value for the
+// configured basic_auth or bearer_token. ok is false when neither is set.
+func authorizationHeaderValue(model *MonitorResourceModel, diags *diag.Diagnostics) (value string, ok bool) {
+	if !model.BearerToken.IsNull() && !model.BearerToken.IsUnknown() {
+		return "Bearer " + model.BearerToken.ValueString(), true
+	}
+	if model.BasicAuth.IsNull() || model.BasicAuth.IsUnknown() {
+		return "", false
+	}
+
+	attrs := model.BasicAuth.Attributes()
+	username, okUser := attrs["username"].(types.String)
+	password, okPassword := attrs["password"].(types.String)
+	if !okUser || !okPassword {
+		diags.AddError("Invalid basic_auth attribute", "Expected string types for basic_auth.username and basic_auth.password")
+		return "", false
+	}
+	credentials := base64.StdEncoding.EncodeToString([]byte(username.ValueString() + ":" + password.ValueString()))
+	return "Basic " + credentials, true
+}
+
+// withAuthorization returns headers with the Authorization header appended.
+func withAuthorization(headers []hyperping.RequestHeader, value string) []hyperping.RequestHeader {
+	return append(headers, hyperping.RequestHeader{Name: authorizationHeader, Value: value})
+}
+
+// applyAuthToCreateRequest adds the Authorization header generated from the
+// plan's basic_auth or bearer_token to a create request.
+func applyAuthToCreateRequest(plan *MonitorResourceModel, createReq *hyperping.CreateMonitorRequest, diags *diag.Diagnostics) {
+	value, ok := authorizationHeaderValue(plan, diags)
+	if !ok {
+		return
+	}
+	createReq.RequestHeaders = withAuthorization(createReq.RequestHeaders, value)
+}
+
+// applyAuthChanges adds the Authorization header to an update request. The
+// plan must carry the write-only credentials from the config (readConfigAuth).
+// The API replaces the whole header list, so the header is sent again whenever
+// request_headers is sent, and a change to basic_auth.username, a
+// *_version attribute, or which credential is set sends the full list,
+// including the Content-Type header of a graphql check.
+func applyAuthChanges(plan *MonitorResourceModel, state *MonitorResourceModel, updateReq *hyperping.UpdateMonitorRequest, diags *diag.Diagnostics) {
+	if plan.BasicAuth.IsUnknown() || plan.BearerToken.IsUnknown() {
+		return
+	}
+
+	value, ok := authorizationHeaderValue(plan, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if updateReq.RequestHeaders != nil {
+		if ok {
+			headers := withAuthorization(*updateReq.RequestHeaders, value)
+			updateReq.RequestHeaders = &headers
+		}
+		return
+	}
+
+	if !authChanged(plan, state) {
+		return
+	}
+
+	headers := mapTFListToRequestHeaders(plan.RequestHeaders, diags)
+	if !plan.GraphQL.IsNull() {
+		headers = withGraphQLContentType(headers)
+	}
+	if ok {
+		headers = withAuthorization(headers, value)
+	}
+	if headers == nil {
+		headers = []hyperping.RequestHeader{}
+	}
+	updateReq.RequestHeaders = &headers
+}
+
+// authChanged reports whether the stored parts of basic_auth and
+// bearer_token differ between plan and state. The write-only password and
+// token are not compared: they are null in state.
+func authChanged(plan *MonitorResourceModel, state *MonitorResourceModel) bool {
+	if !plan.BearerTokenVersion.Equal(state.BearerTokenVersion) {
+		return true
+	}
+	if plan.BasicAuth.IsNull() || state.BasicAuth.IsNull() {
+		return plan.BasicAuth.IsNull() != state.BasicAuth.IsNull()
+	}
+	planAttrs, stateAttrs := plan.BasicAuth.Attributes(), state.BasicAuth.Attributes()
+	return !planAttrs["username"].Equal(stateAttrs["username"]) ||
+		!planAttrs["password_version"].Equal(stateAttrs["password_version"])
+}
+
+// refreshAuth keeps the credentials out of state on read. The Authorization
+// header generated from basic_auth or bearer_token is dropped from
+// request_headers, and the header value is never parsed back into the
+// write-only password or token (TF-09): state keeps the configured username
+// and versions, with the password null.
+func refreshAuth(model *MonitorResourceModel, diags *diag.Diagnostics) {
+	model.BearerToken = types.StringNull()
+	if model.BasicAuth.IsNull() && model.BearerTokenVersion.IsNull() {
+		return
+	}
+
+	model.RequestHeaders = withoutHeader(model.RequestHeaders, authorizationHeader, diags)
+	model.BasicAuth = nullifyBasicAuthPassword(model.BasicAuth, diags)
+}
+
+// nullifyBasicAuthPassword returns a copy of a basic_auth object with the
+// write-only password set to null.
+func nullifyBasicAuthPassword(obj types.Object, diags *diag.Diagnostics) types.Object {
+	if obj.IsNull() || obj.IsUnknown() {
+		return obj
+	}
+
+	attrs := obj.Attributes()
+	newObj, objDiags := types.ObjectValue(basicAuthAttrTypes(), map[string]attr.Value{
+		"username":         attrs["username"],
+		"password":         types.StringNull(),
+		"password_version": attrs["password_version"],
+	})
+	diags.Append(objDiags...)
+	return newObj
+}
+
+// validateAuth checks that at most one source of the Authorization header is
+// configured.
+func validateAuth(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var basicAuth types.Object
+	var bearerToken types.String
+	var headers types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("basic_auth"), &basicAuth)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("bearer_token"), &bearerToken)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("request_headers"), &headers)...)
+	if resp.Diagnostics.HasError() || (basicAuth.IsNull() && bearerToken.IsNull()) {
+		return
+	}
+
+	if !basicAuth.IsNull() && !bearerToken.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("bearer_token"),
+			"Invalid Attribute Combination",
+			"bearer_token cannot be set together with basic_auth: both are sent as the Authorization header.",
+		)
+	}
+	if listHasHeader(headers, authorizationHeader) {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_headers"),
+			"Invalid Attribute Combination",
+			"request_headers cannot contain an Authorization header when basic_auth or bearer_token is set: "+
+				"the header is generated from those attributes.",
+		)
+	}
+}
@@ -0,0 +1,370 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
+)
+
+func testBasicAuthObject(t *testing.T, username, password string, version types.Int64) types.Object {
+	t.Helper()
+	passwordValue := types.StringNull()
+	if password != "" {
+		passwordValue = types.StringValue(password)
+	}
+	obj, diags := types.ObjectValue(basicAuthAttrTypes(), map[string]attr.Value{
+		"username":         types.StringValue(username),
+		"password":         passwordValue,
+		"password_version": version,
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return obj
+}
+
+// testAuthModel returns a monitor model with no headers, graphql, or credentials.
+func testAuthModel() *MonitorResourceModel {
+	return &MonitorResourceModel{
+		RequestHeaders:     types.ListNull(types.ObjectType{AttrTypes: RequestHeaderAttrTypes()}),
+		GraphQL:            types.ObjectNull(graphQLAttrTypes()),
+		BasicAuth:          types.ObjectNull(basicAuthAttrTypes()),
+		BearerToken:        types.StringNull(),
+		BearerTokenVersion: types.Int64Null(),
+	}
+}
+
+func TestAuthorizationHeaderValue(t *testing.T) {
+	var diags diag.Diagnostics
+
+	model := testAuthModel()
+	if _, ok := authorizationHeaderValue(model, &diags); ok {
+		t.Error("expected no header without credentials")
+	}
+
+	model.BasicAuth = testBasicAuthObject(t, "ops", "s3cret:pass", types.Int64Null())
+	if got, _ := authorizationHeaderValue(model, &diags); got != "Basic b3BzOnMzY3JldDpwYXNz" {
+		t.Errorf("basic_auth header = %q", got)
+	}
+
+	model = testAuthModel()
+	model.BearerToken = types.StringValue("tok_123")
+	if got, _ := authorizationHeaderValue(model, &diags); got != "Bearer tok_123" {
+		t.Errorf("bearer_token header = %q", got)
+	}
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestApplyAuthChanges(t *testing.T) {
+	t.Run("token rotated", func(t *testing.T) {
+		var diags diag.Diagnostics
+		plan, state := testAuthModel(), testAuthModel()
+		plan.RequestHeaders = mapRequestHeadersToTFList([]hyperping.RequestHeader{{Name: "X-Team", Value: "ops"}}, &diags)
+		plan.BearerToken = types.StringValue("new")
+		plan.BearerTokenVersion = types.Int64Value(2)
+		state.BearerTokenVersion = types.Int64Value(1)
+		req := hyperping.UpdateMonitorRequest{}
+
+		applyAuthChanges(plan, state, &req, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if req.RequestHeaders == nil || len(*req.RequestHeaders) != 2 || (*req.RequestHeaders)[1].Value != "Bearer new" {
+			t.Errorf("RequestHeaders = %v, want X-Team and the new token", req.RequestHeaders)
+		}
+	})
+
+	t.Run("password rotated", func(t *testing.T) {
+		var diags diag.Diagnostics
+		plan, state := testAuthModel(), testAuthModel()
+		plan.BasicAuth = testBasicAuthObject(t, "ops", "new", types.Int64Value(2))
+		state.BasicAuth = testBasicAuthObject(t, "ops", "", types.Int64Value(1))
+		req := hyperping.UpdateMonitorRequest{}
+
+		applyAuthChanges(plan, state, &req, &diags)
+		if req.RequestHeaders == nil || len(*req.RequestHeaders) != 1 || (*req.RequestHeaders)[0].Value != "Basic b3BzOm5ldw==" {
+			t.Errorf("RequestHeaders = %v, want the new password", req.RequestHeaders)
+		}
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		var diags diag.Diagnostics
+		plan, state := testAuthModel(), testAuthModel()
+		state.BasicAuth = testBasicAuthObject(t, "ops", "", types.Int64Null())
+		req := hyperping.UpdateMonitorRequest{}
+
+		applyAuthChanges(plan, state, &req, &diags)
+		if req.RequestHeaders == nil || len(*req.RequestHeaders) != 0 {
+			t.Errorf("RequestHeaders = %v, want cleared", req.RequestHeaders)
+		}
+	})
+
+	t.Run("graphql keeps Content-Type", func(t *testing.T) {
+		var diags diag.Diagnostics
+		plan, state := testAuthModel(), testAuthModel()
+		plan.GraphQL = testGraphQLObject(t, "query { ok }", types.StringNull())
+		state.GraphQL = plan.GraphQL
+		plan.BearerToken = types.StringValue("tok")
+		plan.BearerTokenVersion = types.Int64Value(1)
+		req := hyperping.UpdateMonitorRequest{}
+
+		applyAuthChanges(plan, state, &req, &diags)
+		if req.RequestHeaders == nil || len(*req.RequestHeaders) != 2 || (*req.RequestHeaders)[0].Name != "Content-Type" {
+			t.Errorf("RequestHeaders = %v, want Content-Type and Authorization", req.RequestHeaders)
+		}
+	})
+
+	t.Run("unchanged with header change", func(t *testing.T) {
+		var diags diag.Diagnostics
+		plan, state := testAuthModel(), testAuthModel()
+		plan.BearerToken = types.StringValue("tok")
+		plan.BearerTokenVersion = types.Int64Value(1)
+		state.BearerTokenVersion = plan.BearerTokenVersion
+		headers := []hyperping.RequestHeader{{Name: "X-Team", Value: "ops"}}
+		req := hyperping.UpdateMonitorRequest{RequestHeaders: &headers}
+
+		applyAuthChanges(plan, state, &req, &diags)
+		if len(*req.RequestHeaders) != 2 || (*req.RequestHeaders)[1].Name != authorizationHeader {
+			t.Errorf("RequestHeaders = %v, want Authorization re-sent", *req.RequestHeaders)
+		}
+	})
+
+	t.Run("unchanged", func(t *testing.T) {
+		var diags diag.Diagnostics
+		plan, state := testAuthModel(), testAuthModel()
+		plan.BasicAuth = testBasicAuthObject(t, "ops", "edited", types.Int64Value(1))
+		state.BasicAuth = testBasicAuthObject(t, "ops", "", types.Int64Value(1))
+		req := hyperping.UpdateMonitorRequest{}
+
+		applyAuthChanges(plan, state, &req, &diags)
+		if req.RequestHeaders != nil {
+			t.Errorf("RequestHeaders = %v, want unchanged until password_version changes", *req.RequestHeaders)
+		}
+	})
+}
+
+func TestRefreshAuth(t *testing.T) {
+	apiHeaders := []hyperping.RequestHeader{
+		{Name: "X-Team", Value: "ops"},
+		{Name: "authorization", Value: "Bearer rotated"},
+	}
+
+	t.Run("bearer_token", func(t *testing.T) {
+		var diags diag.Diagnostics
+		model := testAuthModel()
+		model.RequestHeaders = mapRequestHeadersToTFList(apiHeaders, &diags)
+		model.BearerTokenVersion = types.Int64Value(1)
+
+		refreshAuth(model, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if !model.BearerToken.IsNull() || model.BearerTokenVersion.ValueInt64() != 1 {
+			t.Errorf("bearer_token = %v, bearer_token_version = %v", model.BearerToken, model.BearerTokenVersion)
+		}
+		if len(model.RequestHeaders.Elements()) != 1 || listHasHeader(model.RequestHeaders, authorizationHeader) {
+			t.Errorf("generated Authorization header should be dropped, got %v", model.RequestHeaders)
+		}
+	})
+
+	t.Run("basic_auth password never stored", func(t *testing.T) {
+		var diags diag.Diagnostics
+		model := testAuthModel()
+		model.RequestHeaders = mapRequestHeadersToTFList(apiHeaders, &diags)
+		model.BasicAuth = testBasicAuthObject(t, "ops", "secret", types.Int64Value(3))
+
+		refreshAuth(model, &diags)
+		attrs := model.BasicAuth.Attributes()
+		if !attrs["password"].IsNull() || !attrs["username"].Equal(types.StringValue("ops")) || !attrs["password_version"].Equal(types.Int64Value(3)) {
+			t.Errorf("basic_auth = %v, want username and version kept and password null", model.BasicAuth)
+		}
+		if !model.BearerToken.IsNull() || listHasHeader(model.RequestHeaders, authorizationHeader) {
+			t.Errorf("the Authorization header must not be read back, got bearer_token = %v, request_headers = %v", model.BearerToken, model.RequestHeaders)
+		}
+	})
+
+	t.Run("not configured", func(t *testing.T) {
+		var diags diag.Diagnostics
+		model := testAuthModel()
+		model.RequestHeaders = mapRequestHeadersToTFList(apiHeaders, &diags)
+
+		refreshAuth(model, &diags)
+		if !model.BearerToken.IsNull() || len(model.RequestHeaders.Elements()) != 2 {
+			t.Errorf("imported Authorization header should stay in request_headers, got %v", model.RequestHeaders)
+		}
+	})
+}
+
+func TestValidateConfig_Auth(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		builder   *monitorConfigBuilder
+		wantError string
+	}{
+		{
+			name:    "basic_auth",
+			builder: &monitorConfigBuilder{protocol: "http", basicAuth: &[2]string{"ops", "secret"}},
+		},
+		{
+			name: "bearer_token with other headers",
+			builder: &monitorConfigBuilder{
+				protocol:       "http",
+				bearerToken:    testutil.Ptr("tok"),
+				requestHeaders: []map[string]string{{"name": "X-Team", "value": "ops"}},
+			},
+		},
+		{
+			name:      "both",
+			builder:   &monitorConfigBuilder{protocol: "http", basicAuth: &[2]string{"ops", "secret"}, bearerToken: testutil.Ptr("tok")},
+			wantError: "bearer_token cannot be set together with basic_auth",
+		},
+		{
+			name: "Authorization header conflicts",
+			builder: &monitorConfigBuilder{
+				protocol:       "http",
+				bearerToken:    testutil.Ptr("tok"),
+				requestHeaders: []map[string]string{{"name": "authorization", "value": "Bearer other"}},
+			},
+			wantError: "request_headers cannot contain an Authorization header",
+		},
+		{
+			name:      "non-HTTP basic_auth",
+			builder:   &monitorConfigBuilder{protocol: "icmp", basicAuth: &[2]string{"ops", "secret"}},
+			wantError: "basic_auth is only valid for HTTP monitors",
+		},
+		{
+			name:      "non-HTTP bearer_token",
+			builder:   &monitorConfigBuilder{protocol: "port", port: testutil.Ptr(int64(443)), bearerToken: testutil.Ptr("tok")},
+			wantError: "bearer_token is only valid for HTTP monitors",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp := runValidateConfig(t, tt.builder)
+			if tt.wantError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("expected no errors, got: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !hasErrorOnPath(resp, tt.wantError) {
+				t.Errorf("expected an error mentioning %q, got: %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAccMonitorResource_auth(t *testing.T) {
+	server := newMockHyperpingServer(t)
+	defer server.Close()
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_11_0),
+		},
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccMonitorResourceConfigAuth(server.URL, `
+  bearer_token         = "tok_123"
+  bearer_token_version = 1`),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckNoResourceAttr("hyperping_monitor.test", "bearer_token"),
+					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "bearer_token_version", "1"),
+					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "request_headers.#", "1"),
+					testAccCheckAuthorizationHeader(server, http.MethodPost, "Bearer tok_123"),
+				),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
+			},
+			{
+				Config: testAccMonitorResourceConfigAuth(server.URL, `
+  bearer_token         = "tok_456"
+  bearer_token_version = 2`),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckNoResourceAttr("hyperping_monitor.test", "bearer_token"),
+					testAccCheckAuthorizationHeader(server, http.MethodPut, "Bearer tok_456"),
+				),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
+			},
+			{
+				Config: testAccMonitorResourceConfigAuth(server.URL, `
+  basic_auth = { username = "ops", password = "secret" }`),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckNoResourceAttr("hyperping_monitor.test", "bearer_token_version"),
+					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "basic_auth.username", "ops"),
+					tfresource.TestCheckNoResourceAttr("hyperping_monitor.test", "basic_auth.password"),
+					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "request_headers.#", "1"),
+					testAccCheckAuthorizationHeader(server, http.MethodPut, "Basic b3BzOnNlY3JldA=="),
+				),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
+			},
+		},
+	})
+}
+
+// testAccCheckAuthorizationHeader checks the Authorization header of the last
+// create or update request sent for a monitor.
+func testAccCheckAuthorizationHeader(server *mockHyperpingServer, method, want string) tfresource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var last *recordedRequest
+		for _, r := range server.getRequests() {
+			if r.Method == method {
+				r := r
+				last = &r
+			}
+		}
+		if last == nil {
+			return fmt.Errorf("no %s request recorded", method)
+		}
+		headers, _ := last.Body["request_headers"].([]interface{})
+		for _, h := range headers {
+			if header, ok := h.(map[string]interface{}); ok && header["name"] == authorizationHeader {
+				if header["value"] != want {
+					return fmt.Errorf("Authorization = %v, want %s", header["value"], want)
+				}
+				return nil
+			}
+		}
+		return fmt.Errorf("request_headers %v missing Authorization", headers)
+	}
+}
+
+func testAccMonitorResourceConfigAuth(baseURL, auth string) string {
+	return fmt.Sprintf(`
+provider "hyperping" {
+  api_key  = "test_api_key"
+  base_url = %[1]q
+}
+
+resource "hyperping_monitor" "test" {
+  name = "auth-monitor"
+  url  = "https://api.example.com/health"
+
+  request_headers = [
+    { name = "X-Team", value = "ops" }
+  ]
+
+  %[2]s
+}
+`, baseURL, auth)
+}
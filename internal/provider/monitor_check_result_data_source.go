@@ -0,0 +1,194 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// defaultCheckResultLimit is the number of checks returned when limit is unset.
+const defaultCheckResultLimit = 10
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &MonitorCheckResultDataSource{}
+	_ datasource.DataSourceWithConfigure = &MonitorCheckResultDataSource{}
+)
+
+// NewMonitorCheckResultDataSource creates a new monitor check result data source.
+func NewMonitorCheckResultDataSource() datasource.DataSource {
+	return &MonitorCheckResultDataSource{}
+}
+
+// MonitorCheckResultDataSource defines the data source implementation.
+type MonitorCheckResultDataSource struct {
+	client *hyperping.MCPClient
+}
+
+// MonitorCheckResultDataSourceModel describes the data source data model.
+type MonitorCheckResultDataSourceModel struct {
+	MonitorUUID types.String              `tfsdk:"monitor_uuid"`
+	Limit       types.Int64               `tfsdk:"limit"`
+	Checks      []MonitorCheckResultModel `tfsdk:"checks"`
+}
+
+// MonitorCheckResultModel describes a single check.
+type MonitorCheckResultModel struct {
+	Timestamp      types.String `tfsdk:"timestamp"`
+	StatusCode     types.Int64  `tfsdk:"status_code"`
+	ResponseTimeMs types.Int64  `tfsdk:"response_time_ms"`
+}
+
+// Metadata returns the data source type name.
+func (d *MonitorCheckResultDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_monitor_check_result"
+}
+
+// Schema defines the schema for the data source.
+func (d *MonitorCheckResultDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Fetches the most recent check results of a Hyperping monitor via MCP, newest first. " +
+			"Use it to verify a monitor after apply, for example in a `check` block or a `postcondition`. " +
+			"The API does not report which region ran each check.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_uuid": schema.StringAttribute{
+				MarkdownDescription: "The UUID of the monitor.",
+				Required:            true,
+			},
+			"limit": schema.Int64Attribute{
+				MarkdownDescription: fmt.Sprintf("Maximum number of checks to return (1-100). Defaults to %d.", defaultCheckResultLimit),
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 100),
+				},
+			},
+			"checks": schema.ListNestedAttribute{
+				MarkdownDescription: "Recent checks, newest first.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"timestamp": schema.StringAttribute{
+							MarkdownDescription: "When the check ran (ISO 8601).",
+							Computed:            true,
+						},
+						"status_code": schema.Int64Attribute{
+							MarkdownDescription: "HTTP status code returned by the monitored endpoint.",
+							Computed:            true,
+						},
+						"response_time_ms": schema.Int64Attribute{
+							MarkdownDescription: "Response time in milliseconds.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *MonitorCheckResultDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*hyperpingClients)
+	if !ok {
+		resp.Diagnostics.Append(newUnexpectedConfigTypeError("*hyperpingClients", req.ProviderData))
+		return
+	}
+
+	d.client = clients.MCP
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *MonitorCheckResultDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
+	var config MonitorCheckResultDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if d.client == nil {
+		resp.Diagnostics.AddError("MCP Client Not Configured",
+			"The MCP client was not initialized. Ensure the provider is configured with a valid api_key.")
+		return
+	}
+
+	monitorUUID := config.MonitorUUID.ValueString()
+	if err := hyperping.ValidateResourceID(monitorUUID); err != nil {
+		resp.Diagnostics.AddError("Invalid Monitor ID", fmt.Sprintf("Cannot look up check results: %s", err))
+		return
+	}
+
+	logs, err := d.client.GetMonitorHttpLogs(ctx, monitorUUID)
+	if err != nil {
+		resp.Diagnostics.Append(NewReadErrorWithContext("Monitor Check Results", monitorUUID, err))
+		return
+	}
+
+	limit := defaultCheckResultLimit
+	if !config.Limit.IsNull() {
+		limit = int(config.Limit.ValueInt64())
+	}
+
+	config.Checks = mapCheckResults(logs, limit)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// mapCheckResults returns up to limit checks from logs, newest first. Logs
+// whose timestamp cannot be parsed sort after those that can.
+func mapCheckResults(logs *hyperping.ProbeLogResponse, limit int) []MonitorCheckResultModel {
+	if logs == nil {
+		return []MonitorCheckResultModel{}
+	}
+
+	entries := make([]hyperping.ProbeLog, len(logs.Logs))
+	copy(entries, logs.Logs)
+
+	parsed := make(map[string]time.Time, len(entries))
+	for _, e := range entries {
+		if ts, err := time.Parse(time.RFC3339Nano, e.Timestamp); err == nil {
+			parsed[e.Timestamp] = ts
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		ti, iok := parsed[entries[i].Timestamp]
+		tj, jok := parsed[entries[j].Timestamp]
+		if iok != jok {
+			return iok
+		}
+		return ti.After(tj)
+	})
+
+	if len(entries) > limit {
+		entries = entries[:limit]
+	}
+
+	checks := make([]MonitorCheckResultModel, 0, len(entries))
+	for _, e := range entries {
+		checks = append(checks, MonitorCheckResultModel{
+			Timestamp:      types.StringValue(e.Timestamp),
+			StatusCode:     types.Int64Value(int64(e.Status)),
+			ResponseTimeMs: types.Int64Value(int64(e.Response)),
+		})
+	}
+	return checks
+}
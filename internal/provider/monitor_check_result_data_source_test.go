@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestMonitorCheckResultDataSource_Metadata(t *testing.T) {
+	d := &MonitorCheckResultDataSource{}
+	resp := &datasource.MetadataResponse{}
+
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "hyperping"}, resp)
+
+	if resp.TypeName != "hyperping_monitor_check_result" {
+		t.Errorf("expected type name 'hyperping_monitor_check_result', got '%s'", resp.TypeName)
+	}
+}
+
+func TestMonitorCheckResultDataSource_Schema(t *testing.T) {
+	d := &MonitorCheckResultDataSource{}
+	resp := &datasource.SchemaResponse{}
+
+	d.Schema(context.Background(), datasource.SchemaRequest{}, resp)
+
+	for _, attr := range []string{"monitor_uuid", "limit", "checks"} {
+		if _, ok := resp.Schema.Attributes[attr]; !ok {
+			t.Errorf("schema missing '%s' attribute", attr)
+		}
+	}
+	if !resp.Schema.Attributes["monitor_uuid"].IsRequired() {
+		t.Error("monitor_uuid should be required")
+	}
+}
+
+func TestMapCheckResults(t *testing.T) {
+	logs := &hyperping.ProbeLogResponse{
+		UUID: "mon_123",
+		Logs: []hyperping.ProbeLog{
+			{Timestamp: "2026-03-01T10:00:00Z", Status: 200, Response: 120},
+			{Timestamp: "not a time", Status: 0, Response: 0},
+			{Timestamp: "2026-03-01T10:02:00Z", Status: 503, Response: 900},
+			{Timestamp: "2026-03-01T10:01:00.5Z", Status: 200, Response: 80},
+		},
+	}
+
+	checks := mapCheckResults(logs, 10)
+	want := []string{"2026-03-01T10:02:00Z", "2026-03-01T10:01:00.5Z", "2026-03-01T10:00:00Z", "not a time"}
+	if len(checks) != len(want) {
+		t.Fatalf("got %d checks, want %d", len(checks), len(want))
+	}
+	for i, ts := range want {
+		if got := checks[i].Timestamp.ValueString(); got != ts {
+			t.Errorf("checks[%d].timestamp = %q, want %q", i, got, ts)
+		}
+	}
+	if checks[0].StatusCode.ValueInt64() != 503 || checks[0].ResponseTimeMs.ValueInt64() != 900 {
+		t.Errorf("unexpected newest check: %+v", checks[0])
+	}
+
+	if got := mapCheckResults(logs, 2); len(got) != 2 || got[1].StatusCode.ValueInt64() != 200 {
+		t.Errorf("limit 2: got %+v", got)
+	}
+	if logs.Logs[0].Timestamp != "2026-03-01T10:00:00Z" {
+		t.Error("mapCheckResults must not reorder the response")
+	}
+	if got := mapCheckResults(nil, 10); got == nil || len(got) != 0 {
+		t.Errorf("nil response: got %#v, want empty list", got)
+	}
+}
+
+func TestAccMonitorCheckResultDataSource_basic(t *testing.T) {
+	t.Setenv("HYPERPING_ALLOW_LOCAL", "1")
+
+	server := newStrictMCPTestServer(t, map[string]strictMCPTool{
+		"get_monitor_http_logs": {
+			Properties: []string{"uuid"},
+			Required:   []string{"uuid"},
+			Handler: func(args map[string]any) (any, error) {
+				if args["uuid"] != "mon_123" {
+					return nil, fmt.Errorf("unexpected uuid %v", args["uuid"])
+				}
+				return map[string]any{
+					"uuid":   "mon_123",
+					"status": 200,
+					"logs": []any{
+						map[string]any{"timestamp": "2026-03-01T10:00:00Z", "status": 503, "response": 900},
+						map[string]any{"timestamp": "2026-03-01T10:01:00Z", "status": 200, "response": 120},
+						map[string]any{"timestamp": "2026-03-01T10:02:00Z", "status": 200, "response": 95},
+					},
+				}, nil
+			},
+		},
+	})
+	defer server.Close()
+
+	tfresource.Test(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+provider "hyperping" {
+  api_key = "sk_test"
+  mcp_url = %[1]q
+}
+
+data "hyperping_monitor_check_result" "test" {
+  monitor_uuid = "mon_123"
+  limit        = 2
+}
+`, server.URL),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.hyperping_monitor_check_result.test", "checks.#", "2"),
+					tfresource.TestCheckResourceAttr("data.hyperping_monitor_check_result.test", "checks.0.timestamp", "2026-03-01T10:02:00Z"),
+					tfresource.TestCheckResourceAttr("data.hyperping_monitor_check_result.test", "checks.0.status_code", "200"),
+					tfresource.TestCheckResourceAttr("data.hyperping_monitor_check_result.test", "checks.0.response_time_ms", "95"),
+					tfresource.TestCheckResourceAttr("data.hyperping_monitor_check_result.test", "checks.1.timestamp", "2026-03-01T10:01:00Z"),
+				),
+			},
+		},
+	})
+}
@@ -200,11 +200,14 @@ func (d *MonitorDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *MonitorDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config MonitorDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -0,0 +1,472 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// graphQLContentType is the header the provider adds to GraphQL checks so the
+// server parses the request body as JSON.
+const graphQLContentType = "application/json"
+
+// graphQLPathSegmentPattern matches one field name of an expected_json_path.
+// GraphQL names are restricted to this form, so the path can only address
+// fields the server may return.
+var graphQLPathSegmentPattern = regexp.MustCompile(`^[_A-Za-z][_0-9A-Za-z]*$`)
+
+// MonitorGraphQLModel describes the graphql attribute of a monitor.
+type MonitorGraphQLModel struct {
+	Query            types.String `tfsdk:"query"`
+	Variables        types.String `tfsdk:"variables"`
+	ExpectedJSONPath types.String `tfsdk:"expected_json_path"`
+}
+
+// graphQLAttrTypes returns the attribute types for the monitor graphql object.
+func graphQLAttrTypes() map[string]attr.Type {
+	return map[string]attr.Type{
+		"query":              types.StringType,
+		"variables":          types.StringType,
+		"expected_json_path": types.StringType,
+	}
+}
+
+// graphQLSchemaAttribute returns the schema of the monitor graphql attribute.
+func graphQLSchemaAttribute() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: "GraphQL health check. The provider sends `query` and `variables` as a JSON `POST` body " +
+			"with a `Content-Type: application/json` header, so the request body does not have to be written by hand. " +
+			"Only valid when protocol is `http`. Conflicts with `request_body`; `http_method` defaults to `POST` and " +
+			"must not be set to another method.",
+		Optional: true,
+		Attributes: map[string]schema.Attribute{
+			"query": schema.StringAttribute{
+				MarkdownDescription: "The GraphQL query document, e.g. `query { health { status } }`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"variables": schema.StringAttribute{
+				MarkdownDescription: "Query variables as a JSON object, typically written with `jsonencode()`.",
+				Optional:            true,
+			},
+			"expected_json_path": schema.StringAttribute{
+				MarkdownDescription: "Dot-separated path of a field the response must contain, e.g. `data.health.status`. " +
+					"Only the final field name is checked: the API only supports substring keyword checks, so the path " +
+					"is sent as a `required_keyword` matching the last field name as a JSON key (`\"status\":`). " +
+					"The parent fields are validated as GraphQL names but not checked, so a response containing that " +
+					"key at any depth passes, and a GraphQL error response that resolves the field to null still " +
+					"contains the key. Conflicts with `required_keyword`.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+// graphQLFromObject reads the graphql object. It returns nil when the
+// attribute is null or unknown.
+func graphQLFromObject(obj types.Object, diags *diag.Diagnostics) *MonitorGraphQLModel {
+	if obj.IsNull() || obj.IsUnknown() {
+		return nil
+	}
+	attrs := obj.Attributes()
+	model := &MonitorGraphQLModel{}
+	var ok bool
+	if model.Query, ok = attrs["query"].(types.String); !ok {
+		diags.AddError("Invalid graphql attribute", "Expected string type for graphql.query")
+		return nil
+	}
+	if model.Variables, ok = attrs["variables"].(types.String); !ok {
+		diags.AddError("Invalid graphql attribute", "Expected string type for graphql.variables")
+		return nil
+	}
+	if model.ExpectedJSONPath, ok = attrs["expected_json_path"].(types.String); !ok {
+		diags.AddError("Invalid graphql attribute", "Expected string type for graphql.expected_json_path")
+		return nil
+	}
+	return model
+}
+
+// buildGraphQLRequestBody encodes the query and variables as a GraphQL over
+// HTTP request body: {"query":"...","variables":{...}}.
+func buildGraphQLRequestBody(query, variables string) (string, error) {
+	body := struct {
+		Query     string          `json:"query"`
+		Variables json.RawMessage `json:"variables,omitempty"`
+	}{Query: query}
+
+	if variables != "" {
+		var compact bytes.Buffer
+		if err := json.Compact(&compact, []byte(variables)); err != nil {
+			return "", fmt.Errorf("variables must be valid JSON: %w", err)
+		}
+		if compact.Len() == 0 || compact.Bytes()[0] != '{' {
+			return "", fmt.Errorf("variables must be a JSON object")
+		}
+		body.Variables = compact.Bytes()
+	}
+
+	encoded, err := json.Marshal(body)
+	if err != nil {
+		return "", err
+	}
+	return string(encoded), nil
+}
+
+// graphQLRequiredKeyword translates expected_json_path into the keyword the
+// API checks for: the last field name as a JSON object key. Only that key is
+// checked. A keyword built from the whole path, such as
+// {"data":{"health":{"status":, would depend on the server's key order and
+// whitespace, so the parent fields are validated but not sent.
+func graphQLRequiredKeyword(expectedPath string) (string, error) {
+	segments := strings.Split(expectedPath, ".")
+	for _, segment := range segments {
+		if !graphQLPathSegmentPattern.MatchString(segment) {
+			return "", fmt.Errorf("%q is not a dot-separated path of GraphQL field names (e.g. \"data.health.status\")", expectedPath)
+		}
+	}
+	return fmt.Sprintf("%q:", segments[len(segments)-1]), nil
+}
+
+// withGraphQLContentType returns headers with a JSON Content-Type header
+// appended, unless one is already configured.
+func withGraphQLContentType(headers []hyperping.RequestHeader) []hyperping.RequestHeader {
+	for _, h := range headers {
+		if strings.EqualFold(h.Name, "Content-Type") {
+			return headers
+		}
+	}
+	return append(headers, hyperping.RequestHeader{Name: "Content-Type", Value: graphQLContentType})
+}
+
+// graphQLRequestFields holds the API request fields derived from a graphql
+// attribute.
+type graphQLRequestFields struct {
+	body    string
+	keyword *string
+}
+
+// expandGraphQL derives the request body and keyword for a graphql attribute.
+func expandGraphQL(model *MonitorGraphQLModel, diags *diag.Diagnostics) graphQLRequestFields {
+	var fields graphQLRequestFields
+
+	body, err := buildGraphQLRequestBody(model.Query.ValueString(), model.Variables.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("graphql").AtName("variables"), "Invalid GraphQL Variables", err.Error())
+		return fields
+	}
+	fields.body = body
+
+	if !model.ExpectedJSONPath.IsNull() && !model.ExpectedJSONPath.IsUnknown() {
+		keyword, err := graphQLRequiredKeyword(model.ExpectedJSONPath.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("graphql").AtName("expected_json_path"), "Invalid GraphQL Expected Path", err.Error())
+			return fields
+		}
+		fields.keyword = &keyword
+	}
+	return fields
+}
+
+// applyGraphQLToCreateRequest replaces the body, method, and keyword of a
+// create request with those derived from the plan's graphql attribute.
+func applyGraphQLToCreateRequest(plan *MonitorResourceModel, createReq *hyperping.CreateMonitorRequest, diags *diag.Diagnostics) {
+	model := graphQLFromObject(plan.GraphQL, diags)
+	if model == nil {
+		return
+	}
+
+	fields := expandGraphQL(model, diags)
+	if diags.HasError() {
+		return
+	}
+
+	createReq.HTTPMethod = "POST"
+	createReq.RequestBody = &fields.body
+	createReq.RequestHeaders = withGraphQLContentType(createReq.RequestHeaders)
+	if fields.keyword != nil {
+		createReq.RequiredKeyword = fields.keyword
+	}
+}
+
+// applyGraphQLChanges adds the body, headers, and keyword of a changed graphql
+// attribute to an update request. request_body and required_keyword are null
+// in the plan while graphql is set, so the regular field comparisons would
+// never send them. Removing the attribute clears whatever it sent, unless the
+// plan now sets the field directly.
+func applyGraphQLChanges(plan *MonitorResourceModel, state *MonitorResourceModel, updateReq *hyperping.UpdateMonitorRequest, diags *diag.Diagnostics) {
+	if plan.GraphQL.IsUnknown() {
+		return
+	}
+
+	model := graphQLFromObject(plan.GraphQL, diags)
+	if diags.HasError() {
+		return
+	}
+
+	if model == nil {
+		if state.GraphQL.IsNull() {
+			return
+		}
+		empty := ""
+		if plan.RequestBody.IsNull() {
+			updateReq.RequestBody = &empty
+		}
+		if plan.RequiredKeyword.IsNull() {
+			updateReq.RequiredKeyword = &empty
+		}
+		if updateReq.RequestHeaders == nil {
+			headers := mapTFListToRequestHeaders(plan.RequestHeaders, diags)
+			if headers == nil {
+				headers = []hyperping.RequestHeader{}
+			}
+			updateReq.RequestHeaders = &headers
+		}
+		return
+	}
+
+	// A header change replaces the whole list, so the Content-Type header must
+	// be sent again even when the graphql attribute itself is unchanged.
+	if updateReq.RequestHeaders != nil {
+		headers := withGraphQLContentType(*updateReq.RequestHeaders)
+		updateReq.RequestHeaders = &headers
+	}
+
+	if plan.GraphQL.Equal(state.GraphQL) {
+		return
+	}
+
+	fields := expandGraphQL(model, diags)
+	if diags.HasError() {
+		return
+	}
+
+	method := "POST"
+	updateReq.HTTPMethod = &method
+	updateReq.RequestBody = &fields.body
+	if fields.keyword != nil {
+		updateReq.RequiredKeyword = fields.keyword
+	} else if plan.RequiredKeyword.IsNull() {
+		empty := ""
+		updateReq.RequiredKeyword = &empty
+	}
+	if updateReq.RequestHeaders == nil {
+		headers := withGraphQLContentType(mapTFListToRequestHeaders(plan.RequestHeaders, diags))
+		updateReq.RequestHeaders = &headers
+	}
+}
+
+// restoreGraphQLFields keeps request_body and required_keyword at their
+// planned (null) values after mapping the API response. While graphql is set
+// the API holds the derived body and keyword, which are not part of the
+// configuration.
+func restoreGraphQLFields(model *MonitorResourceModel, requestBody, requiredKeyword types.String) {
+	if model.GraphQL.IsNull() {
+		return
+	}
+	model.RequestBody = requestBody
+	model.RequiredKeyword = requiredKeyword
+}
+
+// refreshGraphQL updates the graphql attribute from the request body returned
+// by the API, so a body edited outside Terraform shows up as drift on query
+// and variables. Variables that are semantically equal keep their configured
+// formatting. A body that is not a GraphQL request leaves the state as is.
+func refreshGraphQL(apiBody string, model *MonitorResourceModel, diags *diag.Diagnostics) {
+	prior := graphQLFromObject(model.GraphQL, diags)
+	if prior == nil {
+		return
+	}
+
+	var body struct {
+		Query     *string         `json:"query"`
+		Variables json.RawMessage `json:"variables"`
+	}
+	if err := json.Unmarshal([]byte(apiBody), &body); err != nil || body.Query == nil {
+		return
+	}
+
+	refreshed := *prior
+	refreshed.Query = types.StringValue(*body.Query)
+
+	variables := bytes.TrimSpace(body.Variables)
+	switch {
+	case len(variables) == 0 || bytes.Equal(variables, []byte("null")):
+		refreshed.Variables = types.StringNull()
+	case !prior.Variables.IsNull() && jsonEqual(prior.Variables.ValueString(), string(variables)):
+		// Keep the configured formatting.
+	default:
+		refreshed.Variables = types.StringValue(string(variables))
+	}
+
+	obj, objDiags := types.ObjectValue(graphQLAttrTypes(), map[string]attr.Value{
+		"query":              refreshed.Query,
+		"variables":          refreshed.Variables,
+		"expected_json_path": refreshed.ExpectedJSONPath,
+	})
+	diags.Append(objDiags...)
+	if !objDiags.HasError() {
+		model.GraphQL = obj
+	}
+}
+
+// jsonEqual reports whether two JSON documents hold the same value.
+func jsonEqual(a, b string) bool {
+	var va, vb interface{}
+	if json.Unmarshal([]byte(a), &va) != nil || json.Unmarshal([]byte(b), &vb) != nil {
+		return false
+	}
+	return reflect.DeepEqual(va, vb)
+}
+
+// withoutGraphQLContentType drops the Content-Type header the provider added
+// for a graphql check from headers refreshed from the API, unless the prior
+// state lists one. Without this, every refresh would report the generated
+// header as drift on request_headers.
+func withoutGraphQLContentType(headers, prior types.List, diags *diag.Diagnostics) types.List {
+	if listHasHeader(prior, "Content-Type") {
+		return headers
+	}
+	return withoutHeader(headers, "Content-Type", diags)
+}
+
+// withoutHeader drops the headers named name, compared case-insensitively,
+// from a request_headers list. The list is null when no header remains.
+func withoutHeader(headers types.List, name string, diags *diag.Diagnostics) types.List {
+	if headers.IsNull() || headers.IsUnknown() {
+		return headers
+	}
+
+	elems := make([]attr.Value, 0, len(headers.Elements()))
+	for _, e := range headers.Elements() {
+		if obj, ok := e.(types.Object); ok {
+			if n, ok := obj.Attributes()["name"].(types.String); ok && strings.EqualFold(n.ValueString(), name) {
+				continue
+			}
+		}
+		elems = append(elems, e)
+	}
+
+	objType := types.ObjectType{AttrTypes: RequestHeaderAttrTypes()}
+	if len(elems) == 0 {
+		return types.ListNull(objType)
+	}
+	list, listDiags := types.ListValue(objType, elems)
+	diags.Append(listDiags...)
+	return list
+}
+
+// listHasHeader reports whether a request_headers list contains a header
+// named name, compared case-insensitively.
+func listHasHeader(headers types.List, name string) bool {
+	if headers.IsNull() || headers.IsUnknown() {
+		return false
+	}
+	for _, e := range headers.Elements() {
+		obj, ok := e.(types.Object)
+		if !ok {
+			continue
+		}
+		if n, ok := obj.Attributes()["name"].(types.String); ok && strings.EqualFold(n.ValueString(), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// ModifyPlan plans http_method as POST for GraphQL checks when the method is
+// not configured, instead of the GET default.
+func (r *MonitorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return // destroy plan
+	}
+
+	var graphql types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("graphql"), &graphql)...)
+	if resp.Diagnostics.HasError() || graphql.IsNull() || graphql.IsUnknown() {
+		return
+	}
+
+	var method types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("http_method"), &method)...)
+	if resp.Diagnostics.HasError() || !method.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("http_method"), types.StringValue("POST"))...)
+}
+
+// validateGraphQL checks the graphql attribute of an HTTP monitor against the
+// fields it generates.
+func validateGraphQL(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var graphql types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("graphql"), &graphql)...)
+	if resp.Diagnostics.HasError() || graphql.IsNull() || graphql.IsUnknown() {
+		return
+	}
+
+	model := graphQLFromObject(graphql, &resp.Diagnostics)
+	if model == nil {
+		return
+	}
+
+	var requestBody, requiredKeyword, method types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("request_body"), &requestBody)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("required_keyword"), &requiredKeyword)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("http_method"), &method)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !requestBody.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("request_body"),
+			"Invalid Attribute Combination",
+			"request_body cannot be set together with graphql: the request body is built from graphql.query and graphql.variables.",
+		)
+	}
+	if !method.IsNull() && !method.IsUnknown() && method.ValueString() != "POST" {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("http_method"),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("http_method must be \"POST\" for GraphQL checks, got %q. Remove http_method or set it to \"POST\".", method.ValueString()),
+		)
+	}
+	if !requiredKeyword.IsNull() && !model.ExpectedJSONPath.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("required_keyword"),
+			"Invalid Attribute Combination",
+			"required_keyword cannot be set together with graphql.expected_json_path: the path is sent as the monitor's keyword.",
+		)
+	}
+
+	if !model.Variables.IsNull() && !model.Variables.IsUnknown() {
+		if _, err := buildGraphQLRequestBody("", model.Variables.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("graphql").AtName("variables"), "Invalid GraphQL Variables", err.Error())
+		}
+	}
+	if !model.ExpectedJSONPath.IsNull() && !model.ExpectedJSONPath.IsUnknown() {
+		if _, err := graphQLRequiredKeyword(model.ExpectedJSONPath.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("graphql").AtName("expected_json_path"), "Invalid GraphQL Expected Path", err.Error())
+		}
+	}
+}
@@ -0,0 +1,401 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
+)
+
+func TestBuildGraphQLRequestBody(t *testing.T) {
+	tests := []struct {
+		name      string
+		query     string
+		variables string
+		want      string
+		wantErr   bool
+	}{
+		{
+			name:  "query only",
+			query: "query { health { status } }",
+			want:  `{"query":"query { health { status } }"}`,
+		},
+		{
+			name:      "variables are compacted",
+			query:     "query($id: ID!) { node(id: $id) { id } }",
+			variables: "{\n  \"id\": \"42\"\n}",
+			want:      `{"query":"query($id: ID!) { node(id: $id) { id } }","variables":{"id":"42"}}`,
+		},
+		{
+			name:  "quotes are escaped",
+			query: `query { user(name: "ops") { id } }`,
+			want:  `{"query":"query { user(name: \"ops\") { id } }"}`,
+		},
+		{
+			name:      "invalid JSON",
+			query:     "query { a }",
+			variables: "{id:",
+			wantErr:   true,
+		},
+		{
+			name:      "not an object",
+			query:     "query { a }",
+			variables: `["a"]`,
+			wantErr:   true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := buildGraphQLRequestBody(tt.query, tt.variables)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("buildGraphQLRequestBody() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("buildGraphQLRequestBody() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphQLRequiredKeyword(t *testing.T) {
+	tests := []struct {
+		path    string
+		want    string
+		wantErr bool
+	}{
+		{path: "data.health.status", want: `"status":`},
+		{path: "data", want: `"data":`},
+		// Only the final key is checked; parent fields are not part of the keyword.
+		{path: "errors.extensions.status", want: `"status":`},
+		{path: "data..status", wantErr: true},
+		{path: "data.items[0]", wantErr: true},
+		{path: "", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := graphQLRequiredKeyword(tt.path)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("graphQLRequiredKeyword() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if got != tt.want {
+				t.Errorf("graphQLRequiredKeyword() = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestWithGraphQLContentType(t *testing.T) {
+	headers := withGraphQLContentType([]hyperping.RequestHeader{{Name: "X-Team", Value: "ops"}})
+	if len(headers) != 2 || headers[1].Name != "Content-Type" || headers[1].Value != graphQLContentType {
+		t.Errorf("expected Content-Type to be appended, got %+v", headers)
+	}
+
+	configured := []hyperping.RequestHeader{{Name: "content-type", Value: "application/graphql+json"}}
+	if got := withGraphQLContentType(configured); len(got) != 1 || got[0].Value != "application/graphql+json" {
+		t.Errorf("configured Content-Type should be kept, got %+v", got)
+	}
+}
+
+func TestWithoutGraphQLContentType(t *testing.T) {
+	var diags diag.Diagnostics
+	headers := mapRequestHeadersToTFList([]hyperping.RequestHeader{
+		{Name: "X-Team", Value: "ops"},
+		{Name: "Content-Type", Value: graphQLContentType},
+	}, &diags)
+	nullHeaders := types.ListNull(types.ObjectType{AttrTypes: RequestHeaderAttrTypes()})
+
+	got := withoutGraphQLContentType(headers, nullHeaders, &diags)
+	if len(got.Elements()) != 1 || listHasHeader(got, "Content-Type") {
+		t.Errorf("generated Content-Type should be dropped, got %v", got)
+	}
+
+	if got := withoutGraphQLContentType(headers, headers, &diags); len(got.Elements()) != 2 {
+		t.Errorf("configured Content-Type should be kept, got %v", got)
+	}
+
+	onlyGenerated := mapRequestHeadersToTFList([]hyperping.RequestHeader{{Name: "Content-Type", Value: graphQLContentType}}, &diags)
+	if got := withoutGraphQLContentType(onlyGenerated, nullHeaders, &diags); !got.IsNull() {
+		t.Errorf("headers should be null when only the generated header remains, got %v", got)
+	}
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func testGraphQLObject(t *testing.T, query string, variables types.String) types.Object {
+	t.Helper()
+	obj, diags := types.ObjectValue(graphQLAttrTypes(), map[string]attr.Value{
+		"query":              types.StringValue(query),
+		"variables":          variables,
+		"expected_json_path": types.StringNull(),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	return obj
+}
+
+func TestApplyGraphQLChanges(t *testing.T) {
+	nullHeaders := types.ListNull(types.ObjectType{AttrTypes: RequestHeaderAttrTypes()})
+	graphql := testGraphQLObject(t, "query { ok }", types.StringNull())
+
+	t.Run("added", func(t *testing.T) {
+		var diags diag.Diagnostics
+		plan := &MonitorResourceModel{GraphQL: graphql, RequestHeaders: nullHeaders}
+		state := &MonitorResourceModel{GraphQL: types.ObjectNull(graphQLAttrTypes()), RequestHeaders: nullHeaders}
+		req := hyperping.UpdateMonitorRequest{}
+
+		applyGraphQLChanges(plan, state, &req, &diags)
+		if diags.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", diags)
+		}
+		if req.RequestBody == nil || *req.RequestBody != `{"query":"query { ok }"}` {
+			t.Errorf("RequestBody = %v", req.RequestBody)
+		}
+		if req.HTTPMethod == nil || *req.HTTPMethod != "POST" {
+			t.Errorf("HTTPMethod = %v, want POST", req.HTTPMethod)
+		}
+		if req.RequestHeaders == nil || len(*req.RequestHeaders) != 1 || (*req.RequestHeaders)[0].Name != "Content-Type" {
+			t.Errorf("RequestHeaders = %v, want Content-Type", req.RequestHeaders)
+		}
+	})
+
+	t.Run("removed", func(t *testing.T) {
+		var diags diag.Diagnostics
+		plan := &MonitorResourceModel{GraphQL: types.ObjectNull(graphQLAttrTypes()), RequestHeaders: nullHeaders}
+		state := &MonitorResourceModel{GraphQL: graphql, RequestHeaders: nullHeaders}
+		req := hyperping.UpdateMonitorRequest{}
+
+		applyGraphQLChanges(plan, state, &req, &diags)
+		if req.RequestBody == nil || *req.RequestBody != "" {
+			t.Errorf("RequestBody = %v, want cleared", req.RequestBody)
+		}
+		if req.RequestHeaders == nil || len(*req.RequestHeaders) != 0 {
+			t.Errorf("RequestHeaders = %v, want cleared", req.RequestHeaders)
+		}
+	})
+
+	t.Run("unchanged with header change", func(t *testing.T) {
+		var diags diag.Diagnostics
+		plan := &MonitorResourceModel{GraphQL: graphql}
+		state := &MonitorResourceModel{GraphQL: graphql}
+		headers := []hyperping.RequestHeader{{Name: "X-Team", Value: "ops"}}
+		req := hyperping.UpdateMonitorRequest{RequestHeaders: &headers}
+
+		applyGraphQLChanges(plan, state, &req, &diags)
+		if req.RequestBody != nil {
+			t.Errorf("RequestBody = %v, want unchanged", *req.RequestBody)
+		}
+		if len(*req.RequestHeaders) != 2 {
+			t.Errorf("RequestHeaders = %v, want Content-Type re-sent", *req.RequestHeaders)
+		}
+	})
+}
+
+func TestRefreshGraphQL(t *testing.T) {
+	var diags diag.Diagnostics
+	model := &MonitorResourceModel{GraphQL: testGraphQLObject(t, "query { ok }", types.StringValue("{ \"id\": 1 }"))}
+
+	refreshGraphQL(`{"query":"query { ok }","variables":{"id":1}}`, model, &diags)
+	if got := model.GraphQL.Attributes()["variables"].(types.String).ValueString(); got != `{ "id": 1 }` {
+		t.Errorf("equal variables should keep their formatting, got %s", got)
+	}
+
+	refreshGraphQL(`{"query":"query { changed }","variables":{"id":2}}`, model, &diags)
+	attrs := model.GraphQL.Attributes()
+	if got := attrs["query"].(types.String).ValueString(); got != "query { changed }" {
+		t.Errorf("query = %s, want drift to be reported", got)
+	}
+	if got := attrs["variables"].(types.String).ValueString(); got != `{"id":2}` {
+		t.Errorf("variables = %s, want drift to be reported", got)
+	}
+
+	refreshGraphQL("not json", model, &diags)
+	if got := model.GraphQL.Attributes()["query"].(types.String).ValueString(); got != "query { changed }" {
+		t.Errorf("a non-GraphQL body should leave the state as is, got %s", got)
+	}
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+}
+
+func TestValidateConfig_GraphQL(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		builder   *monitorConfigBuilder
+		wantError string
+	}{
+		{
+			name:    "query only",
+			builder: &monitorConfigBuilder{protocol: "http", graphql: &graphQLConfig{query: "query { ok }"}},
+		},
+		{
+			name: "POST method and keyword",
+			builder: &monitorConfigBuilder{
+				protocol:        "http",
+				httpMethod:      testutil.Ptr("POST"),
+				requiredKeyword: testutil.Ptr("ok"),
+				graphql:         &graphQLConfig{query: "query { ok }", variables: testutil.Ptr(`{"a":1}`)},
+			},
+		},
+		{
+			name:      "request_body conflicts",
+			builder:   &monitorConfigBuilder{protocol: "http", requestBody: testutil.Ptr("{}"), graphql: &graphQLConfig{query: "query { ok }"}},
+			wantError: "request_body",
+		},
+		{
+			name:      "GET method",
+			builder:   &monitorConfigBuilder{protocol: "http", httpMethod: testutil.Ptr("GET"), graphql: &graphQLConfig{query: "query { ok }"}},
+			wantError: "http_method",
+		},
+		{
+			name: "keyword conflicts with expected path",
+			builder: &monitorConfigBuilder{
+				protocol:        "http",
+				requiredKeyword: testutil.Ptr("ok"),
+				graphql:         &graphQLConfig{query: "query { ok }", expectedJSONPath: testutil.Ptr("data.ok")},
+			},
+			wantError: "required_keyword",
+		},
+		{
+			name:      "variables not an object",
+			builder:   &monitorConfigBuilder{protocol: "http", graphql: &graphQLConfig{query: "query { ok }", variables: testutil.Ptr("[1]")}},
+			wantError: "JSON object",
+		},
+		{
+			name:      "invalid expected path",
+			builder:   &monitorConfigBuilder{protocol: "http", graphql: &graphQLConfig{query: "query { ok }", expectedJSONPath: testutil.Ptr("data.items[0]")}},
+			wantError: "dot-separated path",
+		},
+		{
+			name:      "non-HTTP protocol",
+			builder:   &monitorConfigBuilder{protocol: "icmp", graphql: &graphQLConfig{query: "query { ok }"}},
+			wantError: "graphql is only valid for HTTP monitors",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp := runValidateConfig(t, tt.builder)
+			if tt.wantError == "" {
+				if resp.Diagnostics.HasError() {
+					t.Errorf("expected no errors, got: %v", resp.Diagnostics)
+				}
+				return
+			}
+			if !hasErrorOnPath(resp, tt.wantError) {
+				t.Errorf("expected an error mentioning %q, got: %v", tt.wantError, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestAccMonitorResource_graphql(t *testing.T) {
+	server := newMockHyperpingServer(t)
+	defer server.Close()
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccMonitorResourceConfigGraphQL(server.URL, `{ id = "42" }`),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "http_method", "POST"),
+					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "graphql.expected_json_path", "data.node.id"),
+					tfresource.TestCheckNoResourceAttr("hyperping_monitor.test", "request_body"),
+					tfresource.TestCheckNoResourceAttr("hyperping_monitor.test", "required_keyword"),
+					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "request_headers.#", "1"),
+					testAccCheckGraphQLRequest(server, http.MethodPost, `{"query":"query($id: ID!) { node(id: $id) { id } }","variables":{"id":"42"}}`),
+				),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
+			},
+			{
+				Config: testAccMonitorResourceConfigGraphQL(server.URL, `{ id = "43" }`),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					testAccCheckGraphQLRequest(server, http.MethodPut, `{"query":"query($id: ID!) { node(id: $id) { id } }","variables":{"id":"43"}}`),
+				),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
+			},
+			{
+				Config: testAccMonitorResourceConfigBasic(server.URL, "graphql-monitor"),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "http_method", "GET"),
+					tfresource.TestCheckNoResourceAttr("hyperping_monitor.test", "graphql.query"),
+					tfresource.TestCheckNoResourceAttr("hyperping_monitor.test", "request_body"),
+				),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
+			},
+		},
+	})
+}
+
+// testAccCheckGraphQLRequest checks the body, keyword, and headers of the last
+// create or update request sent for a GraphQL monitor.
+func testAccCheckGraphQLRequest(server *mockHyperpingServer, method, wantBody string) tfresource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		var last *recordedRequest
+		for _, r := range server.getRequests() {
+			if r.Method == method {
+				r := r
+				last = &r
+			}
+		}
+		if last == nil {
+			return fmt.Errorf("no %s request recorded", method)
+		}
+		if got := last.Body["request_body"]; got != wantBody {
+			return fmt.Errorf("request_body = %v, want %s", got, wantBody)
+		}
+		if got := last.Body["required_keyword"]; got != `"id":` {
+			return fmt.Errorf("required_keyword = %v, want \"id\":", got)
+		}
+		headers, _ := last.Body["request_headers"].([]interface{})
+		for _, h := range headers {
+			if header, ok := h.(map[string]interface{}); ok && header["name"] == "Content-Type" && header["value"] == graphQLContentType {
+				return nil
+			}
+		}
+		return fmt.Errorf("request_headers %v missing Content-Type: %s", headers, graphQLContentType)
+	}
+}
+
+func testAccMonitorResourceConfigGraphQL(baseURL, variables string) string {
+	return fmt.Sprintf(`
+provider "hyperping" {
+  api_key  = "test_api_key"
+  base_url = %[1]q
+}
+
+resource "hyperping_monitor" "test" {
+  name = "graphql-monitor"
+  url  = "https://api.example.com/graphql"
+
+  request_headers = [
+    { name = "X-Team", value = "ops" }
+  ]
+
+  graphql = {
+    query              = "query($id: ID!) { node(id: $id) { id } }"
+    variables          = jsonencode(%[2]s)
+    expected_json_path = "data.node.id"
+  }
+}
+`, baseURL, variables)
+}
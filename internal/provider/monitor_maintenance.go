@@ -0,0 +1,162 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// maintenanceWindowTTL is how long a maintenance listing is reused. A refresh
+// reads every monitor within a few seconds, so one listing serves all of them
+// instead of one ListMaintenance call per monitor.
+const maintenanceWindowTTL = 30 * time.Second
+
+// activeMaintenance describes the maintenance window currently covering a
+// monitor.
+type activeMaintenance struct {
+	UUID string
+	// EndDate is the end of the window in RFC 3339, or empty when the API
+	// reports the window as ongoing without a parseable end date.
+	EndDate string
+}
+
+// maintenanceWindows answers which maintenance window, if any, currently
+// covers a monitor. It is shared by all monitor resources of a provider
+// instance and caches the maintenance listing for maintenanceWindowTTL.
+type maintenanceWindows struct {
+	client hyperping.MaintenanceAPI
+	now    func() time.Time
+
+	mu        sync.Mutex
+	fetchedAt time.Time
+	windows   []hyperping.Maintenance
+}
+
+// newMaintenanceWindows returns a maintenance window lookup backed by client.
+func newMaintenanceWindows(client hyperping.MaintenanceAPI) *maintenanceWindows {
+	return &maintenanceWindows{client: client, now: time.Now}
+}
+
+// activeFor returns the active maintenance window that includes monitorUUID,
+// or nil when the monitor is not muted. When several windows overlap, the one
+// ending last is returned, since the monitor stays muted until then; a window
+// without a known end outlasts any other. A nil receiver always returns nil.
+func (m *maintenanceWindows) activeFor(ctx context.Context, monitorUUID string) (*activeMaintenance, error) {
+	if m == nil {
+		return nil, nil
+	}
+
+	windows, now, err := m.list(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var found *activeMaintenance
+	var foundEnd time.Time
+	for _, w := range windows {
+		if !slices.Contains(w.Monitors, monitorUUID) {
+			continue
+		}
+		end, active := maintenanceActiveAt(w, now)
+		if !active {
+			continue
+		}
+		if found != nil && (foundEnd.IsZero() || (!end.IsZero() && !end.After(foundEnd))) {
+			continue
+		}
+		found = &activeMaintenance{UUID: w.UUID}
+		foundEnd = end
+		if !end.IsZero() {
+			found.EndDate = end.UTC().Format(time.RFC3339)
+		}
+	}
+	return found, nil
+}
+
+// list returns the cached maintenance windows, fetching them again once the
+// cache is older than maintenanceWindowTTL, together with the current time.
+func (m *maintenanceWindows) list(ctx context.Context) ([]hyperping.Maintenance, time.Time, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	now := m.now()
+	if m.windows != nil && now.Sub(m.fetchedAt) < maintenanceWindowTTL {
+		return m.windows, now, nil
+	}
+
+	windows, err := m.client.ListMaintenance(ctx)
+	if err != nil {
+		return nil, now, err
+	}
+	if windows == nil {
+		windows = []hyperping.Maintenance{}
+	}
+	m.windows = windows
+	m.fetchedAt = now
+	return windows, now, nil
+}
+
+// maintenanceActiveAt reports whether w is in progress at now, and its end
+// time when known. The start and end dates decide when both parse, because
+// the API status can lag behind a window that was just ended early; otherwise
+// the API status is used.
+func maintenanceActiveAt(w hyperping.Maintenance, now time.Time) (time.Time, bool) {
+	start, startOK := parseMaintenanceDate(w.StartDate)
+	end, endOK := parseMaintenanceDate(w.EndDate)
+	if startOK && endOK {
+		return end, !now.Before(start) && now.Before(end)
+	}
+	if w.Status != "ongoing" {
+		return time.Time{}, false
+	}
+	return end, true
+}
+
+// parseMaintenanceDate parses an optional RFC 3339 maintenance date, returning
+// the zero time and false when it is missing or malformed.
+func parseMaintenanceDate(value *string) (time.Time, bool) {
+	if value == nil {
+		return time.Time{}, false
+	}
+	t, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return time.Time{}, false
+	}
+	return t, true
+}
+
+// setActiveMaintenance sets the active_maintenance and muted_until attributes
+// of model from the window covering the monitor. Failing to list maintenance
+// windows only produces a warning, so that a refresh never fails over these
+// informational attributes; both are then left null.
+func (r *MonitorResource) setActiveMaintenance(ctx context.Context, model *MonitorResourceModel, diags *diag.Diagnostics) {
+	model.ActiveMaintenance = types.StringNull()
+	model.MutedUntil = types.StringNull()
+
+	active, err := r.maintenance.activeFor(ctx, model.ID.ValueString())
+	if err != nil {
+		diags.AddWarning(
+			"Could Not Determine Active Maintenance",
+			fmt.Sprintf("Listing maintenance windows failed, so active_maintenance and muted_until "+
+				"are left null for monitor %s: %s", model.ID.ValueString(), redactError(err)),
+		)
+		return
+	}
+	if active == nil {
+		return
+	}
+	model.ActiveMaintenance = types.StringValue(active.UUID)
+	if active.EndDate != "" {
+		model.MutedUntil = types.StringValue(active.EndDate)
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// fakeMaintenanceAPI serves a fixed maintenance listing and counts calls; any
+// other method panics through the nil embedded interface.
+type fakeMaintenanceAPI struct {
+	hyperping.MaintenanceAPI
+	windows []hyperping.Maintenance
+	err     error
+	calls   int
+}
+
+func (f *fakeMaintenanceAPI) ListMaintenance(_ context.Context) ([]hyperping.Maintenance, error) {
+	f.calls++
+	return f.windows, f.err
+}
+
+func TestMaintenanceWindows_ActiveFor(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	window := func(uuid, start, end, status string, monitors ...string) hyperping.Maintenance {
+		w := hyperping.Maintenance{UUID: uuid, Status: status, Monitors: monitors}
+		if start != "" {
+			w.StartDate = &start
+		}
+		if end != "" {
+			w.EndDate = &end
+		}
+		return w
+	}
+
+	tests := []struct {
+		name     string
+		windows  []hyperping.Maintenance
+		wantUUID string
+		wantEnd  string
+	}{
+		{
+			name:    "no windows",
+			windows: nil,
+		},
+		{
+			name:     "in progress by dates",
+			windows:  []hyperping.Maintenance{window("mw_1", "2026-05-01T11:00:00Z", "2026-05-01T15:00:00+02:00", "upcoming", "mon_1")},
+			wantUUID: "mw_1",
+			wantEnd:  "2026-05-01T13:00:00Z",
+		},
+		{
+			name:    "ended early despite status",
+			windows: []hyperping.Maintenance{window("mw_1", "2026-05-01T11:00:00Z", "2026-05-01T11:30:00Z", "ongoing", "mon_1")},
+		},
+		{
+			name:    "upcoming",
+			windows: []hyperping.Maintenance{window("mw_1", "2026-05-02T00:00:00Z", "2026-05-02T02:00:00Z", "upcoming", "mon_1")},
+		},
+		{
+			name:    "other monitor",
+			windows: []hyperping.Maintenance{window("mw_1", "2026-05-01T11:00:00Z", "2026-05-01T13:00:00Z", "ongoing", "mon_2")},
+		},
+		{
+			name:     "ongoing without dates",
+			windows:  []hyperping.Maintenance{window("mw_1", "", "", "ongoing", "mon_1")},
+			wantUUID: "mw_1",
+		},
+		{
+			name: "overlapping windows pick the latest end",
+			windows: []hyperping.Maintenance{
+				window("mw_1", "2026-05-01T11:00:00Z", "2026-05-01T13:00:00Z", "ongoing", "mon_1"),
+				window("mw_2", "2026-05-01T10:00:00Z", "2026-05-01T18:00:00Z", "ongoing", "mon_2", "mon_1"),
+				window("mw_3", "2026-05-01T11:30:00Z", "2026-05-01T15:00:00Z", "ongoing", "mon_1"),
+			},
+			wantUUID: "mw_2",
+			wantEnd:  "2026-05-01T18:00:00Z",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m := newMaintenanceWindows(&fakeMaintenanceAPI{windows: tt.windows})
+			m.now = func() time.Time { return now }
+
+			got, err := m.activeFor(context.Background(), "mon_1")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if tt.wantUUID == "" {
+				if got != nil {
+					t.Fatalf("expected no active maintenance, got %+v", got)
+				}
+				return
+			}
+			if got == nil || got.UUID != tt.wantUUID || got.EndDate != tt.wantEnd {
+				t.Fatalf("got %+v, want UUID %q and end %q", got, tt.wantUUID, tt.wantEnd)
+			}
+		})
+	}
+}
+
+func TestMaintenanceWindows_CachesListing(t *testing.T) {
+	now := time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC)
+	api := &fakeMaintenanceAPI{}
+	m := newMaintenanceWindows(api)
+	m.now = func() time.Time { return now }
+	ctx := context.Background()
+
+	for _, id := range []string{"mon_1", "mon_2", "mon_3"} {
+		if _, err := m.activeFor(ctx, id); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if api.calls != 1 {
+		t.Errorf("expected a single listing within the TTL, got %d", api.calls)
+	}
+
+	now = now.Add(maintenanceWindowTTL)
+	if _, err := m.activeFor(ctx, "mon_1"); err != nil {
+		t.Fatal(err)
+	}
+	if api.calls != 2 {
+		t.Errorf("expected the listing to be refreshed after the TTL, got %d calls", api.calls)
+	}
+
+	var nilWindows *maintenanceWindows
+	if got, err := nilWindows.activeFor(ctx, "mon_1"); got != nil || err != nil {
+		t.Errorf("nil lookup should report no maintenance, got %+v, %v", got, err)
+	}
+}
+
+func TestMonitorResource_SetActiveMaintenance(t *testing.T) {
+	start, end := "2026-05-01T11:00:00Z", "2026-05-01T13:00:00Z"
+	api := &fakeMaintenanceAPI{windows: []hyperping.Maintenance{
+		{UUID: "mw_1", StartDate: &start, EndDate: &end, Monitors: []string{"mon_1"}},
+	}}
+	r := &MonitorResource{maintenance: newMaintenanceWindows(api)}
+	r.maintenance.now = func() time.Time { return time.Date(2026, 5, 1, 12, 0, 0, 0, time.UTC) }
+
+	model := MonitorResourceModel{ID: types.StringValue("mon_1")}
+	var diags diag.Diagnostics
+	r.setActiveMaintenance(context.Background(), &model, &diags)
+	if diags.HasError() || model.ActiveMaintenance.ValueString() != "mw_1" || model.MutedUntil.ValueString() != end {
+		t.Errorf("unexpected result: active=%s muted_until=%s diags=%v", model.ActiveMaintenance, model.MutedUntil, diags)
+	}
+
+	r.maintenance = newMaintenanceWindows(&fakeMaintenanceAPI{err: errors.New("unavailable")})
+	diags = nil
+	r.setActiveMaintenance(context.Background(), &model, &diags)
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("listing failure should be a single warning, got %v", diags)
+	}
+	if !model.ActiveMaintenance.IsNull() || !model.MutedUntil.IsNull() {
+		t.Error("attributes should be null when the listing fails")
+	}
+}
@@ -134,11 +134,14 @@ func (d *MonitorReportDataSource) Configure(_ context.Context, req datasource.Co
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *MonitorReportDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config MonitorReportDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -148,11 +148,14 @@ func (d *MonitorReportsDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *MonitorReportsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config MonitorReportsDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -167,7 +170,7 @@ func (d *MonitorReportsDataSource) Read(ctx context.Context, req datasource.Read
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading monitor reports",
-			fmt.Sprintf("Could not list monitor reports: %s", err),
+			fmt.Sprintf("Could not list monitor reports: %s", redactError(err)),
 		)
 		return
 	}
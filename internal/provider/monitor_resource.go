@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
@@ -30,7 +31,9 @@ import (
 var (
 	_ resource.Resource                   = &MonitorResource{}
 	_ resource.ResourceWithImportState    = &MonitorResource{}
+	_ resource.ResourceWithIdentity       = &MonitorResource{}
 	_ resource.ResourceWithValidateConfig = &MonitorResource{}
+	_ resource.ResourceWithModifyPlan     = &MonitorResource{}
 )
 
 // NewMonitorResource creates a new monitor resource.
@@ -40,7 +43,9 @@ func NewMonitorResource() resource.Resource {
 
 // MonitorResource defines the resource implementation.
 type MonitorResource struct {
-	client hyperping.MonitorAPI
+	client      hyperping.MonitorAPI
+	maintenance *maintenanceWindows
+	drift       *driftLog
 }
 
 // MonitorResourceModel describes the resource data model.
@@ -54,6 +59,10 @@ type MonitorResourceModel struct {
 	Regions              types.List   `tfsdk:"regions"`
 	RequestHeaders       types.List   `tfsdk:"request_headers"`
 	RequestBody          types.String `tfsdk:"request_body"`
+	GraphQL              types.Object `tfsdk:"graphql"`
+	BasicAuth            types.Object `tfsdk:"basic_auth"`
+	BearerToken          types.String `tfsdk:"bearer_token"`
+	BearerTokenVersion   types.Int64  `tfsdk:"bearer_token_version"`
 	ExpectedStatusCode   types.String `tfsdk:"expected_status_code"`
 	FollowRedirects      types.Bool   `tfsdk:"follow_redirects"`
 	Paused               types.Bool   `tfsdk:"paused"`
@@ -69,6 +78,10 @@ type MonitorResourceModel struct {
 	IsDown               types.Bool   `tfsdk:"is_down"`
 	SSLExpiration        types.Int64  `tfsdk:"ssl_expiration"`
 	ProjectUUID          types.String `tfsdk:"project_uuid"`
+	ActiveMaintenance    types.String `tfsdk:"active_maintenance"`
+	MutedUntil           types.String `tfsdk:"muted_until"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -77,7 +90,7 @@ func (r *MonitorResource) Metadata(_ context.Context, req resource.MetadataReque
 }
 
 // Schema defines the schema for the resource.
-func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *MonitorResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a Hyperping monitor for uptime monitoring.",
 
@@ -177,6 +190,10 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				MarkdownDescription: "HTTP request body. Only valid when protocol is `http` and http_method is `POST`, `PUT`, or `PATCH`.",
 				Optional:            true,
 			},
+			"graphql":              graphQLSchemaAttribute(),
+			"basic_auth":           basicAuthSchemaAttribute(),
+			"bearer_token":         bearerTokenSchemaAttribute(),
+			"bearer_token_version": bearerTokenVersionSchemaAttribute(),
 			"expected_status_code": schema.StringAttribute{
 				MarkdownDescription: "Expected HTTP status code pattern. " +
 					"Use a specific code like `200`, a wildcard like `2xx` (200-299), " +
@@ -270,6 +287,25 @@ func (r *MonitorResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
+			"active_maintenance": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "UUID of the maintenance window the monitor is currently in, or null when it is not " +
+					"in maintenance. Modules can check it to hold back changes to a monitor while its alerts are muted.",
+			},
+			"muted_until": schema.StringAttribute{
+				Computed: true,
+				MarkdownDescription: "End of the current maintenance window in RFC 3339 format, or null when the monitor is " +
+					"not in maintenance or the window has no end date.",
+			},
+		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
 		},
 	}
 }
@@ -286,7 +322,9 @@ func (r *MonitorResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	r.client = clients.REST
+	r.client = clients.restAPI()
+	r.drift = clients.driftLog
+	r.maintenance = clients.maintenanceWindows
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -298,11 +336,20 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// request_headers[].value is write-only: it lives only in the config, never in
 	// the plan or state. Persist the plan headers (names only, value null) to state,
 	// but build the API request from the config headers (which carry the values).
-	stateHeaders := plan.RequestHeaders
+	// The basic_auth password and bearer_token are handled the same way.
+	stateHeaders, stateBasicAuth := plan.RequestHeaders, plan.BasicAuth
 	plan.RequestHeaders = readConfigRequestHeaders(ctx, req.Config, &resp.Diagnostics)
+	resp.Diagnostics.Append(readConfigAuth(ctx, req.Config, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -313,8 +360,9 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 		return
 	}
 
-	// State must never persist write-only header values.
+	// State must never persist write-only header values or credentials.
 	plan.RequestHeaders = stateHeaders
+	plan.BasicAuth, plan.BearerToken = stateBasicAuth, types.StringNull()
 
 	// Save desired paused state (create API doesn't support paused field)
 	wantPaused := !plan.Paused.IsNull() && plan.Paused.ValueBool()
@@ -323,6 +371,7 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 	createResp, err := r.client.CreateMonitor(ctx, createReq)
 	if err != nil {
 		resp.Diagnostics.Append(NewCreateErrorWithContext("Monitor", err))
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Monitor", "create", err)...)
 		return
 	}
 
@@ -339,6 +388,7 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 
 	// Save write-only fields before mapping (API doesn't return these)
 	saved := saveHTTPFields(&plan)
+	planRequestBody := plan.RequestBody
 	planRequiredKeyword := plan.RequiredKeyword
 
 	// Map API response to Terraform state
@@ -354,9 +404,14 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 		plan.RequiredKeyword = planRequiredKeyword
 	}
 
+	// The body and keyword generated from graphql are not part of the config.
+	restoreGraphQLFields(&plan, planRequestBody, planRequiredKeyword)
+
 	// request_headers[].value is write-only: persist names only, never the values.
 	plan.RequestHeaders = stateHeaders
 
+	r.setActiveMaintenance(ctx, &plan, &resp.Diagnostics)
+
 	// Handle pause state via separate API call if needed
 	if wantPaused {
 		r.handlePostCreatePause(ctx, monitor.UUID, &plan, &resp.Diagnostics)
@@ -366,6 +421,7 @@ func (r *MonitorResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -377,6 +433,14 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	monitor, err := r.client.GetMonitor(ctx, state.ID.ValueString())
 	if err != nil {
 		if hyperping.IsNotFound(err) {
@@ -389,7 +453,9 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 
 	// Save write-only fields before mapping (API doesn't return these)
 	saved := saveHTTPFields(&state)
+	priorRequestBody := state.RequestBody
 	priorRequiredKeyword := state.RequiredKeyword
+	priorHeaders := state.RequestHeaders
 
 	r.mapMonitorToModel(monitor, &state, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -403,6 +469,18 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 		state.RequiredKeyword = priorRequiredKeyword
 	}
 
+	// For GraphQL checks, refresh query and variables from the body and keep
+	// the generated body, keyword, and Content-Type header out of state.
+	if !state.GraphQL.IsNull() {
+		refreshGraphQL(monitor.RequestBody, &state, &resp.Diagnostics)
+		restoreGraphQLFields(&state, priorRequestBody, priorRequiredKeyword)
+		state.RequestHeaders = withoutGraphQLContentType(state.RequestHeaders, priorHeaders, &resp.Diagnostics)
+	}
+
+	// Keep the Authorization header generated from basic_auth or bearer_token
+	// out of request_headers. It is never parsed back into state (TF-09).
+	refreshAuth(&state, &resp.Diagnostics)
+
 	// request_headers[].value is write-only: keep the header names from the API
 	// (so import and drift detection work) but never persist the values.
 	state.RequestHeaders = nullifyRequestHeaderValues(state.RequestHeaders, &resp.Diagnostics)
@@ -410,7 +488,10 @@ func (r *MonitorResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
+	r.setActiveMaintenance(ctx, &state, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	r.drift.log(ctx, "hyperping_monitor", req.State, resp.State)
 }
 
 // Update updates the resource and sets the updated Terraform state.
@@ -424,10 +505,19 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// request_headers[].value is write-only: read the config headers (with values)
 	// to forward to the API, but persist only the names (value null) to state.
-	stateHeaders := plan.RequestHeaders
+	// The basic_auth password and bearer_token are handled the same way.
+	stateHeaders, stateBasicAuth := plan.RequestHeaders, plan.BasicAuth
 	plan.RequestHeaders = readConfigRequestHeaders(ctx, req.Config, &resp.Diagnostics)
+	resp.Diagnostics.Append(readConfigAuth(ctx, req.Config, &plan)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
@@ -438,18 +528,21 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	// State must never persist write-only header values.
+	// State must never persist write-only header values or credentials.
 	plan.RequestHeaders = stateHeaders
+	plan.BasicAuth, plan.BearerToken = stateBasicAuth, types.StringNull()
 
 	// Call API to update monitor
 	monitor, err := r.client.UpdateMonitor(ctx, state.ID.ValueString(), updateReq)
 	if err != nil {
 		resp.Diagnostics.Append(NewUpdateErrorWithContext("Monitor", state.ID.ValueString(), err))
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Monitor", "update", err)...)
 		return
 	}
 
 	// Save write-only fields before mapping (API doesn't return these)
 	saved := saveHTTPFields(&plan)
+	planRequestBody := plan.RequestBody
 	planRequiredKeyword := plan.RequiredKeyword
 
 	// Map API response to Terraform state
@@ -465,10 +558,16 @@ func (r *MonitorResource) Update(ctx context.Context, req resource.UpdateRequest
 		plan.RequiredKeyword = planRequiredKeyword
 	}
 
+	// The body and keyword generated from graphql are not part of the config.
+	restoreGraphQLFields(&plan, planRequestBody, planRequiredKeyword)
+
 	// request_headers[].value is write-only: persist names only, never the values.
 	plan.RequestHeaders = stateHeaders
 
+	r.setActiveMaintenance(ctx, &plan, &resp.Diagnostics)
+
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Delete deletes the resource.
@@ -480,6 +579,13 @@ func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	err := r.client.DeleteMonitor(ctx, state.ID.ValueString())
 	if err != nil {
 		if hyperping.IsNotFound(err) {
@@ -491,14 +597,24 @@ func (r *MonitorResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 }
 
+// IdentitySchema defines the resource identity used by import blocks.
+func (r *MonitorResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("UUID of the monitor (e.g. `mon_abc123`).")
+}
+
 // ImportState imports an existing resource into Terraform.
 func (r *MonitorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Validate the import ID before setting state (VULN-015)
-	if err := hyperping.ValidateResourceID(req.ID); err != nil {
+	if err := hyperping.ValidateResourceID(id); err != nil {
 		resp.Diagnostics.Append(newImportError("Monitor", err))
 		return
 	}
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // readConfigRequestHeaders returns the request_headers list from the resource
@@ -636,6 +752,12 @@ func (r *MonitorResource) buildCreateRequest(ctx context.Context, plan *MonitorR
 	// Handle optional project_uuid
 	createReq.ProjectUUID = plan.ProjectUUID.ValueString()
 
+	// Handle optional graphql (generates the body, method, and keyword)
+	applyGraphQLToCreateRequest(plan, &createReq, diags)
+
+	// Handle optional basic_auth and bearer_token (generate the Authorization header)
+	applyAuthToCreateRequest(plan, &createReq, diags)
+
 	return createReq
 }
 
@@ -811,8 +933,12 @@ func applyMonitoringFieldChanges(ctx context.Context, plan *MonitorResourceModel
 }
 
 // applyComplexFieldChanges detects and applies changes for complex fields.
-// Dispatches to applyHTTPFieldChanges and applyMonitoringFieldChanges.
+// Dispatches to applyHTTPFieldChanges, applyMonitoringFieldChanges, applyGraphQLChanges,
+// and applyAuthChanges. The generated headers are applied last so they are
+// added to whatever header list the earlier steps send.
 func (r *MonitorResource) applyComplexFieldChanges(ctx context.Context, plan *MonitorResourceModel, state *MonitorResourceModel, updateReq *hyperping.UpdateMonitorRequest, diags *diag.Diagnostics) {
 	applyHTTPFieldChanges(plan, state, updateReq, diags)
 	applyMonitoringFieldChanges(ctx, plan, state, updateReq, diags)
+	applyGraphQLChanges(plan, state, updateReq, diags)
+	applyAuthChanges(plan, state, updateReq, diags)
 }
@@ -425,6 +425,28 @@ func TestAccMonitorResource_escalationPolicyStateRefresh(t *testing.T) {
 	})
 }
 
+// TestAccMonitorResource_unknownReferences verifies that escalation_policy and
+// project_uuid can reference values that are only known after apply, such as
+// IDs of resources created in the same run. Validators skip unknown values,
+// so the plan succeeds and the IDs are checked once they are known.
+func TestAccMonitorResource_unknownReferences(t *testing.T) {
+	server := newMockHyperpingServer(t)
+	defer server.Close()
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccMonitorResourceConfigWithUnknownReferences(server.URL),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "escalation_policy", "policy_abc123"),
+					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "project_uuid", "proj_test123"),
+				),
+			},
+		},
+	})
+}
+
 // TestAccMonitorResource_portField tests the port field for port protocol monitors.
 // Tests various port numbers and protocol combinations.
 func TestAccMonitorResource_portField(t *testing.T) {
@@ -706,6 +728,31 @@ resource "hyperping_monitor" "test" {
 `, baseURL, policyUUID)
 }
 
+func testAccMonitorResourceConfigWithUnknownReferences(baseURL string) string {
+	return fmt.Sprintf(`
+provider "hyperping" {
+  api_key  = "test_api_key"
+  base_url = %[1]q
+}
+
+# terraform_data.output is unknown until apply, like the ID of a resource
+# created in the same run.
+resource "terraform_data" "refs" {
+  input = {
+    escalation_policy = "policy_abc123"
+    project_uuid      = "proj_test123"
+  }
+}
+
+resource "hyperping_monitor" "test" {
+  name              = "unknown-references"
+  url               = "https://example.com"
+  escalation_policy = terraform_data.refs.output.escalation_policy
+  project_uuid      = terraform_data.refs.output.project_uuid
+}
+`, baseURL)
+}
+
 func testAccMonitorResourceConfigWithPort(baseURL string, port int) string {
 	return fmt.Sprintf(`
 provider "hyperping" {
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
 )
 
 func TestAccMonitorResource_import(t *testing.T) {
@@ -19,7 +21,8 @@ func TestAccMonitorResource_import(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Step 1: Create the resource
 			{
-				Config: testAccMonitorResourceConfigBasic(server.URL, "test-import"),
+				Config:           testAccMonitorResourceConfigBasic(server.URL, "test-import"),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "name", "test-import"),
 					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "url", "https://example.com"),
@@ -32,6 +35,8 @@ func TestAccMonitorResource_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_monitor.test"),
 		},
 	})
 }
@@ -45,7 +50,8 @@ func TestAccMonitorResource_importFull(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Create resource with all optional fields
 			{
-				Config: testAccMonitorResourceConfigFull(server.URL),
+				Config:           testAccMonitorResourceConfigFull(server.URL),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "name", "full-monitor"),
 					tfresource.TestCheckResourceAttr("hyperping_monitor.test", "url", "https://api.example.com/health"),
@@ -64,6 +70,8 @@ func TestAccMonitorResource_importFull(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_monitor.test"),
 		},
 	})
 }
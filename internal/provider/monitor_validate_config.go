@@ -45,6 +45,8 @@ func (r *MonitorResource) ValidateConfig(ctx context.Context, req resource.Valid
 	case "http":
 		validateURLIsHTTP(ctx, req, resp)
 		validateHTTPProtocol(ctx, req, resp)
+		validateGraphQL(ctx, req, resp)
+		validateAuth(ctx, req, resp)
 		validateDNSFieldsNotSet(ctx, req, resp, "http")
 	case "dns":
 		validateNonHTTPProtocol(ctx, req, resp, "dns")
@@ -81,6 +83,9 @@ func validateNonHTTPProtocol(ctx context.Context, req resource.ValidateConfigReq
 	checkListNotSet(ctx, req, resp, "request_headers", protocol)
 	checkStringNotSet(ctx, req, resp, "request_body", protocol, "http")
 	checkStringNotSet(ctx, req, resp, "required_keyword", protocol, "http")
+	checkObjectNotSet(ctx, req, resp, "graphql", protocol)
+	checkObjectNotSet(ctx, req, resp, "basic_auth", protocol)
+	checkStringNotSet(ctx, req, resp, "bearer_token", protocol, "http")
 }
 
 // validatePortRequired checks that port is set when protocol is "port".
@@ -197,3 +202,21 @@ func checkListNotSet(ctx context.Context, req resource.ValidateConfigRequest, re
 		)
 	}
 }
+
+// checkObjectNotSet adds a diagnostic error if a nested object attribute is explicitly set.
+func checkObjectNotSet(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse, attrName, protocol string) {
+	var val types.Object
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root(attrName), &val)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !val.IsNull() && !val.IsUnknown() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root(attrName),
+			"Invalid Attribute Combination",
+			fmt.Sprintf("%s is only valid for HTTP monitors. When protocol is %q, remove %s or change protocol to \"http\".",
+				attrName, protocol, attrName),
+		)
+	}
+}
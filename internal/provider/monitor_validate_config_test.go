@@ -50,6 +50,10 @@ func TestMonitorValidateConfig_SchemaConsistency(t *testing.T) {
 		{"dns_record_type", "schema.StringAttribute"},
 		{"dns_nameserver", "schema.StringAttribute"},
 		{"dns_expected_answer", "schema.StringAttribute"},
+		{"graphql", "schema.SingleNestedAttribute"},
+		{"basic_auth", "schema.SingleNestedAttribute"},
+		{"bearer_token", "schema.StringAttribute"},
+		{"bearer_token_version", "schema.Int64Attribute"},
 	}
 
 	for _, exp := range expectations {
@@ -81,6 +85,16 @@ type monitorConfigBuilder struct {
 	dnsNameserver     *string
 	dnsExpectedAnswer *string
 	requestHeaders    []map[string]string // nil = null, non-nil = set list
+	graphql           *graphQLConfig      // nil = null
+	basicAuth         *[2]string          // username and password; nil = null
+	bearerToken       *string
+}
+
+// graphQLConfig mirrors the monitor graphql attribute; nil fields are null.
+type graphQLConfig struct {
+	query            string
+	variables        *string
+	expectedJSONPath *string
 }
 
 // buildConfigValue converts the builder into a tftypes.Value matching the monitor schema.
@@ -122,6 +136,7 @@ func (b *monitorConfigBuilder) buildConfigValue(s schema.Schema) tftypes.Value {
 	setStringAttr(vals, "dns_record_type", b.dnsRecordType)
 	setStringAttr(vals, "dns_nameserver", b.dnsNameserver)
 	setStringAttr(vals, "dns_expected_answer", b.dnsExpectedAnswer)
+	setStringAttr(vals, "bearer_token", b.bearerToken)
 
 	if b.followRedirects != nil {
 		vals["follow_redirects"] = tftypes.NewValue(tftypes.Bool, *b.followRedirects)
@@ -145,6 +160,25 @@ func (b *monitorConfigBuilder) buildConfigValue(s schema.Schema) tftypes.Value {
 		vals["request_headers"] = tftypes.NewValue(attrTypes["request_headers"], headerVals)
 	}
 
+	if b.graphql != nil {
+		graphqlVals := map[string]tftypes.Value{
+			"query":              tftypes.NewValue(tftypes.String, b.graphql.query),
+			"variables":          tftypes.NewValue(tftypes.String, nil),
+			"expected_json_path": tftypes.NewValue(tftypes.String, nil),
+		}
+		setStringAttr(graphqlVals, "variables", b.graphql.variables)
+		setStringAttr(graphqlVals, "expected_json_path", b.graphql.expectedJSONPath)
+		vals["graphql"] = tftypes.NewValue(attrTypes["graphql"], graphqlVals)
+	}
+
+	if b.basicAuth != nil {
+		vals["basic_auth"] = tftypes.NewValue(attrTypes["basic_auth"], map[string]tftypes.Value{
+			"username":         tftypes.NewValue(tftypes.String, b.basicAuth[0]),
+			"password":         tftypes.NewValue(tftypes.String, b.basicAuth[1]),
+			"password_version": tftypes.NewValue(tftypes.Number, nil),
+		})
+	}
+
 	return tftypes.NewValue(objType, vals)
 }
 
@@ -35,6 +35,7 @@ type MonitorsDataSource struct {
 // MonitorsDataSourceModel describes the data source data model.
 type MonitorsDataSourceModel struct {
 	Monitors []MonitorDataModel  `tfsdk:"monitors"`
+	UUIDs    types.List          `tfsdk:"uuids"`
 	Filter   *MonitorFilterModel `tfsdk:"filter"`
 	Total    types.Int64         `tfsdk:"total"`
 	IDs      types.List          `tfsdk:"ids"`
@@ -79,6 +80,14 @@ func (d *MonitorsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 		MarkdownDescription: "Fetches the list of all Hyperping monitors.",
 
 		Attributes: map[string]schema.Attribute{
+			"uuids": schema.ListAttribute{
+				MarkdownDescription: "Fetch only the monitors with these UUIDs, in this order, instead of listing all monitors. " +
+					"Use this in place of many `hyperping_monitor` data sources in a `for_each`: small sets are fetched " +
+					"concurrently and larger sets with a single list request. An unknown UUID is an error. " +
+					"`filter` still applies to the result.",
+				Optional:    true,
+				ElementType: types.StringType,
+			},
 			"filter": MonitorFilterSchema(),
 			"total": schema.Int64Attribute{
 				MarkdownDescription: "Total number of monitors returned (after filtering).",
@@ -227,11 +236,14 @@ func (d *MonitorsDataSource) Configure(_ context.Context, req datasource.Configu
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *MonitorsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config MonitorsDataSourceModel
 
 	// Get configuration (includes filter if provided)
@@ -240,14 +252,34 @@ func (d *MonitorsDataSource) Read(ctx context.Context, req datasource.ReadReques
 		return
 	}
 
-	// Fetch all monitors from API
-	monitors, err := d.client.ListMonitors(ctx)
-	if err != nil {
-		resp.Diagnostics.AddError(
-			"Error reading monitors",
-			fmt.Sprintf("Could not list monitors: %s", err),
-		)
-		return
+	var monitors []hyperping.Monitor
+	if !config.UUIDs.IsNull() {
+		var uuids []string
+		resp.Diagnostics.Append(config.UUIDs.ElementsAs(ctx, &uuids, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		var err error
+		monitors, err = getMonitorsBatch(ctx, d.client, uuids)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading monitors",
+				fmt.Sprintf("Could not fetch monitors by UUID: %s", redactError(err)),
+			)
+			return
+		}
+	} else {
+		// Fetch all monitors from API
+		var err error
+		monitors, err = d.client.ListMonitors(ctx)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error reading monitors",
+				fmt.Sprintf("Could not list monitors: %s", redactError(err)),
+			)
+			return
+		}
 	}
 
 	// Apply client-side filtering if filter provided
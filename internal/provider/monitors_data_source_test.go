@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"net/http/httptest"
 	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
@@ -137,6 +138,34 @@ func TestAccMonitorsDataSource_manyMonitors(t *testing.T) {
 	})
 }
 
+func TestAccMonitorsDataSource_uuids(t *testing.T) {
+	server := newMockHyperpingServerForDataSource(t)
+	defer server.Close()
+
+	server.createTestMonitor("mon-1", "Monitor One")
+	server.createTestMonitor("mon-2", "Monitor Two")
+	server.createTestMonitor("mon-3", "Monitor Three")
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccMonitorsDataSourceConfig_withUUIDs(server.URL, `["mon-3", "mon-1"]`),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.hyperping_monitors.selected", "total", "2"),
+					tfresource.TestCheckResourceAttr("data.hyperping_monitors.selected", "ids.0", "mon-3"),
+					tfresource.TestCheckResourceAttr("data.hyperping_monitors.selected", "ids.1", "mon-1"),
+					tfresource.TestCheckResourceAttr("data.hyperping_monitors.selected", "monitors.0.name", "Monitor Three"),
+				),
+			},
+			{
+				Config:      testAccMonitorsDataSourceConfig_withUUIDs(server.URL, `["mon-1", "mon-missing"]`),
+				ExpectError: regexp.MustCompile(`Could not fetch monitors by UUID`),
+			},
+		},
+	})
+}
+
 // Unit tests for data source
 
 func TestMonitorsDataSource_Metadata(t *testing.T) {
@@ -174,6 +203,9 @@ func TestMonitorsDataSource_Schema(t *testing.T) {
 	if _, ok := resp.Schema.Attributes["ids"]; !ok {
 		t.Error("Schema missing 'ids' attribute")
 	}
+	if _, ok := resp.Schema.Attributes["uuids"]; !ok {
+		t.Error("Schema missing 'uuids' attribute")
+	}
 }
 
 func TestMonitorsDataSource_ConfigureWrongType(t *testing.T) {
@@ -257,6 +289,19 @@ data "hyperping_monitors" "all" {
 `, baseURL)
 }
 
+func testAccMonitorsDataSourceConfig_withUUIDs(baseURL, uuids string) string {
+	return fmt.Sprintf(`
+provider "hyperping" {
+  api_key  = "test_api_key"
+  base_url = %[1]q
+}
+
+data "hyperping_monitors" "selected" {
+  uuids = %[2]s
+}
+`, baseURL, uuids)
+}
+
 // Mock server for data source tests
 
 type mockHyperpingServerForDS struct {
@@ -284,6 +329,14 @@ func (m *mockHyperpingServerForDS) handleRequest(w http.ResponseWriter, r *http.
 	switch {
 	case r.Method == "GET" && r.URL.Path == hyperping.MonitorsBasePath:
 		m.listMonitors(w)
+	case r.Method == "GET" && strings.HasPrefix(r.URL.Path, hyperping.MonitorsBasePath+"/"):
+		monitor, ok := m.monitors[strings.TrimPrefix(r.URL.Path, hyperping.MonitorsBasePath+"/")]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			json.NewEncoder(w).Encode(map[string]string{"error": "Monitor not found"})
+			return
+		}
+		json.NewEncoder(w).Encode(monitor)
 	default:
 		w.WriteHeader(http.StatusNotFound)
 		json.NewEncoder(w).Encode(map[string]string{"error": "Not found"})
@@ -0,0 +1,117 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure NormalizeURLFunction satisfies the function.Function interface.
+var _ function.Function = &NormalizeURLFunction{}
+
+// NormalizeURLFunction implements provider::hyperping::normalize_url.
+type NormalizeURLFunction struct{}
+
+// NewNormalizeURLFunction creates a new normalize_url function.
+func NewNormalizeURLFunction() function.Function {
+	return &NormalizeURLFunction{}
+}
+
+// Metadata returns the function name.
+func (f *NormalizeURLFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "normalize_url"
+}
+
+// Definition returns the function signature.
+func (f *NormalizeURLFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Normalize a monitor URL the way the Hyperping API does",
+		MarkdownDescription: "Returns `url` as the Hyperping API stores it: the scheme and host are lowercased, " +
+			"the default port of the scheme (`:80` for `http`, `:443` for `https`) is removed, and trailing slashes " +
+			"are stripped from the path. The path, query, and fragment are otherwise kept as written. A bare domain, " +
+			"as used by DNS monitors, is lowercased.\n\n" +
+			"Pass a monitor's `url` through this function to avoid the perpetual diff caused when the API " +
+			"normalizes it on write.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "The monitor URL, such as `HTTPS://API.Example.com:443/health/`, or a bare domain.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+// Run normalizes the URL argument.
+func (f *NormalizeURLFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var raw string
+	resp.Error = function.ConcatFuncErrors(resp.Error, req.Arguments.Get(ctx, &raw))
+	if resp.Error != nil {
+		return
+	}
+
+	normalized, err := normalizeMonitorURL(raw)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Error, resp.Result.Set(ctx, normalized))
+}
+
+// defaultPorts are the ports the API drops from URLs of each scheme.
+var defaultPorts = map[string]string{
+	"http":  "80",
+	"https": "443",
+}
+
+// normalizeMonitorURL lowercases the scheme and host of raw, drops the
+// scheme's default port, and strips trailing slashes from the path. Input
+// without a scheme is treated as a bare domain.
+func normalizeMonitorURL(raw string) (string, error) {
+	raw = strings.TrimSpace(raw)
+	if raw == "" {
+		return "", fmt.Errorf("url must not be empty")
+	}
+
+	if !strings.Contains(raw, "://") {
+		return strings.ToLower(strings.TrimRight(raw, "/")), nil
+	}
+
+	u, err := url.Parse(raw)
+	if err != nil {
+		return "", fmt.Errorf("invalid URL %q: %v", raw, err)
+	}
+	if u.Host == "" {
+		return "", fmt.Errorf("invalid URL %q: missing host", raw)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if port == defaultPorts[u.Scheme] {
+		port = ""
+	}
+	switch {
+	case port != "":
+		u.Host = net.JoinHostPort(host, port)
+	case strings.Contains(host, ":"):
+		// IPv6 literal.
+		u.Host = "[" + host + "]"
+	default:
+		u.Host = host
+	}
+
+	u.Path = strings.TrimRight(u.Path, "/")
+	u.RawPath = strings.TrimRight(u.RawPath, "/")
+
+	return u.String(), nil
+}
@@ -108,6 +108,9 @@ func (d *OnCallScheduleDataSource) Configure(_ context.Context, req datasource.C
 
 // Read refreshes the Terraform state with the latest data.
 func (d *OnCallScheduleDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var data OnCallScheduleDataSourceModel
 
 	if d.client == nil {
@@ -120,6 +120,9 @@ func (d *OnCallSchedulesDataSource) Configure(_ context.Context, req datasource.
 
 // Read refreshes the Terraform state with the latest data.
 func (d *OnCallSchedulesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var state OnCallSchedulesDataSourceModel
 
 	if d.client == nil {
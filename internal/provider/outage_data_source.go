@@ -167,11 +167,14 @@ func (d *OutageDataSource) Configure(_ context.Context, req datasource.Configure
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *OutageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config OutageDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,7 @@ const OutageTypeManual = "manual"
 var (
 	_ resource.Resource                = &OutageResource{}
 	_ resource.ResourceWithImportState = &OutageResource{}
+	_ resource.ResourceWithIdentity    = &OutageResource{}
 )
 
 // NewOutageResource creates a new outage resource.
@@ -38,6 +40,7 @@ func NewOutageResource() resource.Resource {
 // OutageResource defines the resource implementation.
 type OutageResource struct {
 	client hyperping.OutageAPI
+	drift  *driftLog
 }
 
 // OutageResourceModel describes the resource data model.
@@ -57,6 +60,8 @@ type OutageResourceModel struct {
 	Summary              types.String `tfsdk:"summary"`
 	Monitor              types.Object `tfsdk:"monitor"`
 	AcknowledgedBy       types.Object `tfsdk:"acknowledged_by"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // Metadata returns the resource type name.
@@ -65,7 +70,7 @@ func (r *OutageResource) Metadata(_ context.Context, req resource.MetadataReques
 }
 
 // Schema defines the schema for the resource.
-func (r *OutageResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+func (r *OutageResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "Manages a manual Hyperping outage. All user-settable fields are ForceNew since outages cannot be updated via the API.",
 
@@ -210,6 +215,13 @@ func (r *OutageResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+			}),
+		},
 	}
 }
 
@@ -225,7 +237,8 @@ func (r *OutageResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	r.client = clients.REST
+	r.client = clients.restAPI()
+	r.drift = clients.driftLog
 }
 
 // Create creates the resource and sets the initial Terraform state.
@@ -237,6 +250,13 @@ func (r *OutageResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	createReq := hyperping.CreateOutageRequest{
 		MonitorUUID: plan.MonitorUUID.ValueString(),
 		StartDate:   plan.StartDate.ValueString(),
@@ -269,8 +289,9 @@ func (r *OutageResource) Create(ctx context.Context, req resource.CreateRequest,
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error creating outage",
-			fmt.Sprintf("Could not create outage: %s", err),
+			fmt.Sprintf("Could not create outage: %s%s", redactError(err), validationDetailsText("Outage", err)),
 		)
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Outage", "create", err)...)
 		return
 	}
 
@@ -289,7 +310,7 @@ func (r *OutageResource) Create(ctx context.Context, req resource.CreateRequest,
 			"Partial state after outage creation",
 			fmt.Sprintf("Outage created (ID: %s) but the read-back failed: %s. "+
 				"State has been saved with the values from the create request. "+
-				"Run 'terraform refresh' or 'terraform apply' to reconcile with the API.", created.UUID, err),
+				"Run 'terraform refresh' or 'terraform apply' to reconcile with the API.", created.UUID, redactError(err)),
 		)
 		return
 	}
@@ -300,6 +321,7 @@ func (r *OutageResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 // Read refreshes the Terraform state with the latest data.
@@ -311,6 +333,14 @@ func (r *OutageResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	outage, err := r.client.GetOutage(ctx, state.ID.ValueString())
 	if err != nil {
 		if hyperping.IsNotFound(err) {
@@ -319,7 +349,7 @@ func (r *OutageResource) Read(ctx context.Context, req resource.ReadRequest, res
 		}
 		resp.Diagnostics.AddError(
 			"Error reading outage",
-			fmt.Sprintf("Could not read outage %s: %s", state.ID.ValueString(), err),
+			fmt.Sprintf("Could not read outage %s: %s", state.ID.ValueString(), redactError(err)),
 		)
 		return
 	}
@@ -330,18 +360,25 @@ func (r *OutageResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	r.drift.log(ctx, "hyperping_outage", req.State, resp.State)
 }
 
-// Update is a no-op since all fields are ForceNew.
-// If this method is ever called, it indicates a bug where a field was made non-ForceNew.
-func (r *OutageResource) Update(_ context.Context, _ resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// All user-settable fields are ForceNew, so Terraform will destroy and recreate.
-	// This should never be called in practice. If it is, it indicates a schema misconfiguration.
-	resp.Diagnostics.AddError(
-		"Unexpected Update Call",
-		"BUG: OutageResource.Update was called, but all attributes are ForceNew. "+
-			"This indicates a schema misconfiguration. Please report this issue to the provider developers.",
-	)
+// Update only runs when the timeouts block changes, since every other
+// user-settable attribute is ForceNew. It stores the new timeouts without
+// calling the API.
+func (r *OutageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan OutageResourceModel
+	var state OutageResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Timeouts = plan.Timeouts
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
 }
 
 // Delete removes the outage from Terraform state without calling the API.
@@ -364,13 +401,23 @@ func (r *OutageResource) Delete(ctx context.Context, req resource.DeleteRequest,
 	)
 }
 
+// IdentitySchema defines the resource identity used by import blocks.
+func (r *OutageResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("UUID of the outage.")
+}
+
 // ImportState imports an existing resource into Terraform.
 func (r *OutageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	if err := hyperping.ValidateResourceID(req.ID); err != nil {
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := hyperping.ValidateResourceID(id); err != nil {
 		resp.Diagnostics.AddError("Invalid Import ID", fmt.Sprintf("Cannot import outage: %s", err))
 		return
 	}
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // mapOutageToModel maps a hyperping.Outage to the Terraform resource model
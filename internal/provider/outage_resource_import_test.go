@@ -9,6 +9,8 @@ import (
 	"time"
 
 	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
 )
 
 func TestAccOutageResource_import(t *testing.T) {
@@ -24,7 +26,8 @@ func TestAccOutageResource_import(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Step 1: Create the resource
 			{
-				Config: testAccOutageResourceConfig_basic(server.URL, startDate, endDate),
+				Config:           testAccOutageResourceConfig_basic(server.URL, startDate, endDate),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_outage.test", "monitor_uuid", "mon_test123"),
 					tfresource.TestCheckResourceAttr("hyperping_outage.test", "start_date", startDate),
@@ -39,6 +42,8 @@ func TestAccOutageResource_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_outage.test"),
 		},
 	})
 }
@@ -56,7 +61,8 @@ func TestAccOutageResource_importFull(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Create resource with all optional fields
 			{
-				Config: testAccOutageResourceConfig_full(server.URL, startDate, endDate),
+				Config:           testAccOutageResourceConfig_full(server.URL, startDate, endDate),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_outage.test", "monitor_uuid", "mon_test456"),
 					tfresource.TestCheckResourceAttr("hyperping_outage.test", "start_date", startDate),
@@ -71,6 +77,8 @@ func TestAccOutageResource_importFull(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_outage.test"),
 		},
 	})
 }
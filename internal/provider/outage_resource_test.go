@@ -7,7 +7,11 @@ import (
 	"context"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 
 	hyperping "github.com/develeap/hyperping-go"
 )
@@ -100,17 +104,31 @@ func TestOutageResource_ConfigureValidClient(t *testing.T) {
 	}
 }
 
-func TestOutageResource_UpdateShouldNotBeCalled(t *testing.T) {
-	// This test documents that Update should never be called
-	// since all fields are ForceNew
+func TestOutageResource_UpdateStoresTimeouts(t *testing.T) {
+	// Every attribute except timeouts is ForceNew, so Update only has to
+	// store the new timeouts block.
+	ctx := context.Background()
 	r := &OutageResource{}
-	req := resource.UpdateRequest{}
-	resp := &resource.UpdateResponse{}
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+	objType := schemaResp.Schema.Type().TerraformType(ctx)
 
-	// Calling Update should add an error diagnostic
-	r.Update(context.Background(), req, resp)
+	state := tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, nil)}
+	state.SetAttribute(ctx, path.Root("id"), "out_123")
+	plan := tfsdk.Plan{Schema: schemaResp.Schema, Raw: state.Raw.Copy()}
+	plan.SetAttribute(ctx, path.Root("timeouts").AtName("create"), "30m")
 
-	if !resp.Diagnostics.HasError() {
-		t.Error("expected error diagnostic when Update is called")
+	req := resource.UpdateRequest{Plan: plan, State: state}
+	resp := &resource.UpdateResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: state.Raw.Copy()}}
+	r.Update(ctx, req, resp)
+
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics.Errors())
+	}
+	var id, create types.String
+	resp.State.GetAttribute(ctx, path.Root("id"), &id)
+	resp.State.GetAttribute(ctx, path.Root("timeouts").AtName("create"), &create)
+	if id.ValueString() != "out_123" || create.ValueString() != "30m" {
+		t.Errorf("state id = %s, timeouts.create = %s; want out_123 and 30m", id, create)
 	}
 }
@@ -0,0 +1,435 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &OutageSummaryDataSource{}
+	_ datasource.DataSourceWithConfigure = &OutageSummaryDataSource{}
+)
+
+// NewOutageSummaryDataSource creates a new outage summary data source.
+func NewOutageSummaryDataSource() datasource.DataSource {
+	return &OutageSummaryDataSource{}
+}
+
+// OutageSummaryDataSource defines the data source implementation.
+type OutageSummaryDataSource struct {
+	client hyperping.OutageAPI
+	now    func() time.Time
+}
+
+// OutageSummaryDataSourceModel describes the data source data model.
+type OutageSummaryDataSourceModel struct {
+	MonitorUUIDs  types.List                  `tfsdk:"monitor_uuids"`
+	From          types.String                `tfsdk:"from"`
+	To            types.String                `tfsdk:"to"`
+	Monitors      []OutageSummaryMonitorModel `tfsdk:"monitors"`
+	OutageCount   types.Int64                 `tfsdk:"outage_count"`
+	OngoingCount  types.Int64                 `tfsdk:"ongoing_count"`
+	TotalDowntime types.Int64                 `tfsdk:"total_downtime"`
+	MTTR          types.Int64                 `tfsdk:"mttr"`
+}
+
+// OutageSummaryMonitorModel describes the outage metrics of one monitor.
+type OutageSummaryMonitorModel struct {
+	MonitorUUID   types.String  `tfsdk:"monitor_uuid"`
+	Name          types.String  `tfsdk:"name"`
+	OutageCount   types.Int64   `tfsdk:"outage_count"`
+	OngoingCount  types.Int64   `tfsdk:"ongoing_count"`
+	TotalDowntime types.Int64   `tfsdk:"total_downtime"`
+	LongestOutage types.Int64   `tfsdk:"longest_outage"`
+	MTTR          types.Int64   `tfsdk:"mttr"`
+	Availability  types.Float64 `tfsdk:"availability"`
+}
+
+// Metadata returns the data source type name.
+func (d *OutageSummaryDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_outage_summary"
+}
+
+// Schema defines the schema for the data source.
+func (d *OutageSummaryDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Aggregates outage metrics per monitor over a time window for SLO reporting: " +
+			"outage count, total downtime, longest outage, MTTR and availability. " +
+			"The metrics are computed from the outage list, so any number of monitors is summarized without a `hyperping_monitor_report` request per monitor. " +
+			"Outages that overlap the window are counted with the part of their downtime inside it; " +
+			"ongoing outages count as down until the time of the read.",
+
+		Attributes: map[string]schema.Attribute{
+			"monitor_uuids": schema.ListAttribute{
+				MarkdownDescription: "UUIDs of the monitors to summarize. Each is reported, with zero outages if it had none. " +
+					"When unset, every monitor with an outage in the window is reported.",
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"from": schema.StringAttribute{
+				MarkdownDescription: "Start of the window in ISO 8601 format. When unset, the window starts with the oldest outage and `availability` is null.",
+				Optional:            true,
+				Validators:          []validator.String{ISO8601()},
+			},
+			"to": schema.StringAttribute{
+				MarkdownDescription: "End of the window in ISO 8601 format. Defaults to the time of the read.",
+				Optional:            true,
+				Validators:          []validator.String{ISO8601()},
+			},
+			"monitors": schema.ListNestedAttribute{
+				MarkdownDescription: "Outage metrics per monitor, in the order of `monitor_uuids` when set, otherwise sorted by monitor name.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"monitor_uuid": schema.StringAttribute{
+							MarkdownDescription: "The UUID of the monitor.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the monitor. Null for a requested monitor without outages.",
+							Computed:            true,
+						},
+						"outage_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of outages that overlap the window.",
+							Computed:            true,
+						},
+						"ongoing_count": schema.Int64Attribute{
+							MarkdownDescription: "Number of those outages that were unresolved at the time of the read.",
+							Computed:            true,
+						},
+						"total_downtime": schema.Int64Attribute{
+							MarkdownDescription: "Downtime inside the window, in seconds.",
+							Computed:            true,
+						},
+						"longest_outage": schema.Int64Attribute{
+							MarkdownDescription: "Longest downtime of a single outage inside the window, in seconds.",
+							Computed:            true,
+						},
+						"mttr": schema.Int64Attribute{
+							MarkdownDescription: "Mean time to recovery of the resolved outages, in seconds, using their full duration.",
+							Computed:            true,
+						},
+						"availability": schema.Float64Attribute{
+							MarkdownDescription: "Percentage of the window the monitor was up. Null when `from` is unset.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"outage_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of outages across the reported monitors.",
+				Computed:            true,
+			},
+			"ongoing_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of unresolved outages across the reported monitors.",
+				Computed:            true,
+			},
+			"total_downtime": schema.Int64Attribute{
+				MarkdownDescription: "Downtime inside the window across the reported monitors, in seconds.",
+				Computed:            true,
+			},
+			"mttr": schema.Int64Attribute{
+				MarkdownDescription: "Mean time to recovery across the reported monitors, in seconds.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *OutageSummaryDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*hyperpingClients)
+	if !ok {
+		resp.Diagnostics.Append(newUnexpectedConfigTypeError("*hyperpingClients", req.ProviderData))
+		return
+	}
+
+	d.client = clients.restAPI()
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (d *OutageSummaryDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
+	var config OutageSummaryDataSourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now
+	if d.now != nil {
+		now = d.now
+	}
+
+	var window outageWindow
+	var err error
+	if !isNullOrUnknown(config.From) {
+		if window.from, err = time.Parse(time.RFC3339, config.From.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Invalid Window Start", err.Error())
+			return
+		}
+	}
+	if !isNullOrUnknown(config.To) {
+		if window.to, err = time.Parse(time.RFC3339, config.To.ValueString()); err != nil {
+			resp.Diagnostics.AddError("Invalid Window End", err.Error())
+			return
+		}
+	}
+	if !window.from.IsZero() && !window.to.IsZero() && !window.from.Before(window.to) {
+		resp.Diagnostics.AddError("Invalid Window",
+			fmt.Sprintf("from (%s) must be before to (%s).", config.From.ValueString(), config.To.ValueString()))
+		return
+	}
+
+	var monitorUUIDs []string
+	if !isNullOrUnknown(config.MonitorUUIDs) {
+		resp.Diagnostics.Append(config.MonitorUUIDs.ElementsAs(ctx, &monitorUUIDs, false)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	outages, err := d.client.ListOutages(ctx)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error reading outages",
+			fmt.Sprintf("Could not list outages: %s", redactError(err)),
+		)
+		return
+	}
+
+	summary, skipped := summarizeOutages(outages, window, now())
+	if len(skipped) > 0 {
+		resp.Diagnostics.AddWarning("Outages Left Out of Summary",
+			fmt.Sprintf("%d outage(s) have dates that are not ISO 8601 and were not counted: %s",
+				len(skipped), strings.Join(skipped, ", ")))
+	}
+
+	stats := summary.monitors(monitorUUIDs)
+	config.Monitors = make([]OutageSummaryMonitorModel, len(stats))
+	var total monitorOutageStats
+	for i, s := range stats {
+		config.Monitors[i] = s.toModel(summary.span)
+		total.add(s)
+	}
+
+	config.OutageCount = types.Int64Value(int64(total.count))
+	config.OngoingCount = types.Int64Value(int64(total.ongoing))
+	config.TotalDowntime = types.Int64Value(seconds(total.downtime))
+	config.MTTR = types.Int64Value(seconds(total.mttr()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
+}
+
+// outageWindow is the time window an outage summary covers. A zero from or
+// to leaves that end of the window open.
+type outageWindow struct {
+	from, to time.Time
+}
+
+// outageSummary holds the outage metrics of each monitor, keyed by UUID.
+type outageSummary struct {
+	byMonitor map[string]*monitorOutageStats
+	// span is the length of the window, or zero when it has no start.
+	span time.Duration
+}
+
+// monitorOutageStats accumulates the outages of one monitor.
+type monitorOutageStats struct {
+	uuid, name string
+	count      int
+	ongoing    int
+	downtime   time.Duration
+	longest    time.Duration
+	resolved   int
+	recovery   time.Duration
+}
+
+// summarizeOutages aggregates the outages that overlap the window per
+// monitor. Outages without a monitor, such as manual ones, are ignored. An
+// outage without an end date counts as down until now, and its downtime is
+// clipped to the window. It returns the UUIDs of outages whose dates could
+// not be parsed separately.
+func summarizeOutages(outages []hyperping.Outage, window outageWindow, now time.Time) (outageSummary, []string) {
+	end := window.to
+	if end.IsZero() || end.After(now) {
+		end = now
+	}
+	summary := outageSummary{byMonitor: make(map[string]*monitorOutageStats)}
+	if !window.from.IsZero() && end.After(window.from) {
+		summary.span = end.Sub(window.from)
+	}
+
+	var skipped []string
+	for _, outage := range outages {
+		if outage.Monitor.UUID == "" {
+			continue
+		}
+		start, stop, ongoing, err := outagePeriod(outage, now)
+		if err != nil {
+			skipped = append(skipped, outage.UUID)
+			continue
+		}
+		if (!window.to.IsZero() && start.After(window.to)) || (!window.from.IsZero() && stop.Before(window.from)) {
+			continue
+		}
+
+		s, ok := summary.byMonitor[outage.Monitor.UUID]
+		if !ok {
+			s = &monitorOutageStats{uuid: outage.Monitor.UUID}
+			summary.byMonitor[outage.Monitor.UUID] = s
+		}
+		if s.name == "" {
+			s.name = outage.Monitor.Name
+		}
+
+		s.count++
+		if ongoing {
+			s.ongoing++
+		} else {
+			s.resolved++
+			s.recovery += stop.Sub(start)
+		}
+
+		clippedStart, clippedStop := start, stop
+		if !window.from.IsZero() && clippedStart.Before(window.from) {
+			clippedStart = window.from
+		}
+		if clippedStop.After(end) {
+			clippedStop = end
+		}
+		if down := clippedStop.Sub(clippedStart); down > 0 {
+			s.downtime += down
+			s.longest = max(s.longest, down)
+		}
+	}
+	sort.Strings(skipped)
+	return summary, skipped
+}
+
+// outagePeriod returns when an outage started and ended, and whether it is
+// still ongoing, in which case it ends now. A resolved outage without an end
+// date ends its duration after the start.
+func outagePeriod(outage hyperping.Outage, now time.Time) (start, stop time.Time, ongoing bool, err error) {
+	start, err = time.Parse(time.RFC3339, outage.StartDate)
+	if err != nil {
+		return start, stop, false, err
+	}
+	switch {
+	case outage.EndDate != nil && *outage.EndDate != "":
+		stop, err = time.Parse(time.RFC3339, *outage.EndDate)
+	case outage.IsResolved:
+		stop = start.Add(time.Duration(outage.DurationMs) * time.Millisecond)
+	default:
+		stop, ongoing = now, true
+	}
+	if stop.Before(start) {
+		stop = start
+	}
+	return start, stop, ongoing, err
+}
+
+// monitors returns the stats of the given monitors in order, with empty
+// stats for those without outages. When uuids is empty, it returns the stats
+// of every monitor with outages, sorted by name, then UUID.
+func (s outageSummary) monitors(uuids []string) []*monitorOutageStats {
+	var stats []*monitorOutageStats
+	if len(uuids) > 0 {
+		seen := make(map[string]bool, len(uuids))
+		for _, uuid := range uuids {
+			if seen[uuid] {
+				continue
+			}
+			seen[uuid] = true
+			st, ok := s.byMonitor[uuid]
+			if !ok {
+				st = &monitorOutageStats{uuid: uuid}
+			}
+			stats = append(stats, st)
+		}
+		return stats
+	}
+
+	for _, st := range s.byMonitor {
+		stats = append(stats, st)
+	}
+	sort.Slice(stats, func(i, j int) bool {
+		if stats[i].name != stats[j].name {
+			return stats[i].name < stats[j].name
+		}
+		return stats[i].uuid < stats[j].uuid
+	})
+	return stats
+}
+
+// add adds the counters of o to s.
+func (s *monitorOutageStats) add(o *monitorOutageStats) {
+	s.count += o.count
+	s.ongoing += o.ongoing
+	s.downtime += o.downtime
+	s.longest = max(s.longest, o.longest)
+	s.resolved += o.resolved
+	s.recovery += o.recovery
+}
+
+// mttr returns the mean time to recovery of the resolved outages.
+func (s *monitorOutageStats) mttr() time.Duration {
+	if s.resolved == 0 {
+		return 0
+	}
+	return s.recovery / time.Duration(s.resolved)
+}
+
+// availability returns the percentage of a window of the given span the
+// monitor was up, rounded to four decimal places.
+func (s *monitorOutageStats) availability(span time.Duration) float64 {
+	up := 100 * (1 - float64(min(s.downtime, span))/float64(span))
+	return math.Round(up*1e4) / 1e4
+}
+
+func (s *monitorOutageStats) toModel(span time.Duration) OutageSummaryMonitorModel {
+	model := OutageSummaryMonitorModel{
+		MonitorUUID:   types.StringValue(s.uuid),
+		Name:          types.StringNull(),
+		OutageCount:   types.Int64Value(int64(s.count)),
+		OngoingCount:  types.Int64Value(int64(s.ongoing)),
+		TotalDowntime: types.Int64Value(seconds(s.downtime)),
+		LongestOutage: types.Int64Value(seconds(s.longest)),
+		MTTR:          types.Int64Value(seconds(s.mttr())),
+		Availability:  types.Float64Null(),
+	}
+	if s.name != "" {
+		model.Name = types.StringValue(s.name)
+	}
+	if span > 0 {
+		model.Availability = types.Float64Value(s.availability(span))
+	}
+	return model
+}
+
+// seconds returns d in whole seconds.
+func seconds(d time.Duration) int64 {
+	return int64(d / time.Second)
+}
@@ -0,0 +1,229 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestOutageSummaryDataSource_Metadata(t *testing.T) {
+	d := NewOutageSummaryDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "hyperping"}, resp)
+
+	if resp.TypeName != "hyperping_outage_summary" {
+		t.Errorf("expected type name hyperping_outage_summary, got %s", resp.TypeName)
+	}
+}
+
+func TestOutageSummaryDataSource_Configure(t *testing.T) {
+	d := &OutageSummaryDataSource{}
+	resp := &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: "wrong"}, resp)
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error for the wrong provider data type")
+	}
+
+	resp = &datasource.ConfigureResponse{}
+	d.Configure(context.Background(), datasource.ConfigureRequest{ProviderData: &hyperpingClients{REST: &hyperping.Client{}}}, resp)
+	if resp.Diagnostics.HasError() || d.client == nil {
+		t.Errorf("Configure: %v, client set = %t", resp.Diagnostics, d.client != nil)
+	}
+}
+
+// testOutage returns a resolved outage of monitor uuid, or an ongoing one
+// when end is empty.
+func testOutage(id, monitor, start, end string) hyperping.Outage {
+	outage := hyperping.Outage{
+		UUID:      id,
+		StartDate: start,
+		Monitor:   hyperping.MonitorReference{UUID: monitor, Name: "Monitor " + monitor},
+	}
+	if end != "" {
+		outage.EndDate = &end
+		outage.IsResolved = true
+	}
+	return outage
+}
+
+func TestSummarizeOutages(t *testing.T) {
+	now := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	outages := []hyperping.Outage{
+		// 10 minutes inside March.
+		testOutage("out_1", "mon_a", "2026-03-02T10:00:00Z", "2026-03-02T10:10:00Z"),
+		// 30 minutes, half of it before March.
+		testOutage("out_2", "mon_a", "2026-02-28T23:45:00Z", "2026-03-01T00:15:00Z"),
+		// Before the window.
+		testOutage("out_3", "mon_a", "2026-02-01T00:00:00Z", "2026-02-01T01:00:00Z"),
+		// Ongoing for the last hour.
+		testOutage("out_4", "mon_b", "2026-03-30T23:00:00Z", ""),
+		// Manual outage without a monitor.
+		testOutage("out_5", "", "2026-03-05T00:00:00Z", "2026-03-05T01:00:00Z"),
+		// Unparseable start.
+		testOutage("out_6", "mon_b", "yesterday", ""),
+	}
+	// Resolved, without an end date: five minutes from its duration.
+	resolved := testOutage("out_7", "mon_c", "2026-03-10T00:00:00Z", "")
+	resolved.IsResolved = true
+	resolved.DurationMs = 300000
+	outages = append(outages, resolved)
+
+	window := outageWindow{from: time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)}
+	summary, skipped := summarizeOutages(outages, window, now)
+
+	if len(skipped) != 1 || skipped[0] != "out_6" {
+		t.Errorf("skipped = %v, want [out_6]", skipped)
+	}
+	if summary.span != 30*24*time.Hour {
+		t.Errorf("span = %s, want 720h", summary.span)
+	}
+
+	a := summary.byMonitor["mon_a"]
+	if a == nil || a.count != 2 || a.downtime != 25*time.Minute || a.longest != 15*time.Minute || a.mttr() != 20*time.Minute {
+		t.Errorf("mon_a = %+v", a)
+	}
+	b := summary.byMonitor["mon_b"]
+	if b == nil || b.count != 1 || b.ongoing != 1 || b.downtime != time.Hour || b.mttr() != 0 {
+		t.Errorf("mon_b = %+v", b)
+	}
+	c := summary.byMonitor["mon_c"]
+	if c == nil || c.downtime != 5*time.Minute || c.mttr() != 5*time.Minute {
+		t.Errorf("mon_c = %+v", c)
+	}
+	if len(summary.byMonitor) != 3 {
+		t.Errorf("monitors = %d, want 3", len(summary.byMonitor))
+	}
+
+	if got := b.availability(summary.span); got != 99.8611 {
+		t.Errorf("mon_b availability = %v, want 99.8611", got)
+	}
+}
+
+func TestSummarizeOutages_WindowEnd(t *testing.T) {
+	now := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	outages := []hyperping.Outage{
+		testOutage("out_1", "mon_a", "2026-03-14T23:00:00Z", ""),
+		testOutage("out_2", "mon_a", "2026-03-20T00:00:00Z", "2026-03-20T01:00:00Z"),
+	}
+	window := outageWindow{
+		from: time.Date(2026, 3, 14, 0, 0, 0, 0, time.UTC),
+		to:   time.Date(2026, 3, 15, 0, 0, 0, 0, time.UTC),
+	}
+	summary, _ := summarizeOutages(outages, window, now)
+
+	a := summary.byMonitor["mon_a"]
+	if a == nil || a.count != 1 || a.ongoing != 1 || a.downtime != time.Hour {
+		t.Errorf("mon_a = %+v", a)
+	}
+	if summary.span != 24*time.Hour {
+		t.Errorf("span = %s, want 24h", summary.span)
+	}
+}
+
+func TestSummarizeOutages_OpenWindow(t *testing.T) {
+	now := time.Date(2026, 3, 31, 0, 0, 0, 0, time.UTC)
+	outages := []hyperping.Outage{
+		testOutage("out_1", "mon_a", "2025-01-01T00:00:00Z", "2025-01-01T00:02:00Z"),
+	}
+	summary, _ := summarizeOutages(outages, outageWindow{}, now)
+
+	if summary.span != 0 {
+		t.Errorf("span = %s, want 0 without a window start", summary.span)
+	}
+	model := summary.monitors(nil)[0].toModel(summary.span)
+	if model.TotalDowntime.ValueInt64() != 120 || !model.Availability.IsNull() {
+		t.Errorf("model = %+v", model)
+	}
+}
+
+func TestOutageSummary_Monitors(t *testing.T) {
+	summary := outageSummary{byMonitor: map[string]*monitorOutageStats{
+		"mon_b": {uuid: "mon_b", name: "API", count: 1},
+		"mon_a": {uuid: "mon_a", name: "Web", count: 2},
+	}}
+
+	all := summary.monitors(nil)
+	if len(all) != 2 || all[0].uuid != "mon_b" || all[1].uuid != "mon_a" {
+		t.Errorf("monitors(nil) = %v, want sorted by name", all)
+	}
+
+	requested := summary.monitors([]string{"mon_a", "mon_c", "mon_a"})
+	if len(requested) != 2 || requested[0].uuid != "mon_a" || requested[1].uuid != "mon_c" || requested[1].count != 0 {
+		t.Errorf("monitors(requested) = %v", requested)
+	}
+	if model := requested[1].toModel(time.Hour); !model.Name.IsNull() || model.Availability.ValueFloat64() != 100 {
+		t.Errorf("model for a monitor without outages = %+v", model)
+	}
+}
+
+func TestAccOutageSummaryDataSource(t *testing.T) {
+	server := newMockOutageServer(t)
+	defer server.Close()
+
+	now := time.Now().UTC().Truncate(time.Second)
+	for i := 1; i <= 2; i++ {
+		id := fmt.Sprintf("out%d", i)
+		server.outages[id] = map[string]interface{}{
+			"uuid":       id,
+			"startDate":  now.Add(time.Duration(-i) * time.Hour).Format(time.RFC3339),
+			"endDate":    now.Add(time.Duration(-i)*time.Hour + 10*time.Minute).Format(time.RFC3339),
+			"outageType": "automatic",
+			"isResolved": true,
+			"monitor":    map[string]interface{}{"uuid": "mon_api", "name": "API"},
+		}
+	}
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccOutageSummaryDataSourceConfig(server.URL, now.Add(-24*time.Hour).Format(time.RFC3339), ""),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.hyperping_outage_summary.test", "monitors.#", "2"),
+					tfresource.TestCheckResourceAttr("data.hyperping_outage_summary.test", "monitors.0.monitor_uuid", "mon_api"),
+					tfresource.TestCheckResourceAttr("data.hyperping_outage_summary.test", "monitors.0.outage_count", "2"),
+					tfresource.TestCheckResourceAttr("data.hyperping_outage_summary.test", "monitors.0.total_downtime", "1200"),
+					tfresource.TestCheckResourceAttr("data.hyperping_outage_summary.test", "monitors.0.mttr", "600"),
+					tfresource.TestCheckResourceAttrSet("data.hyperping_outage_summary.test", "monitors.0.availability"),
+					tfresource.TestCheckResourceAttr("data.hyperping_outage_summary.test", "monitors.1.monitor_uuid", "mon_idle"),
+					tfresource.TestCheckResourceAttr("data.hyperping_outage_summary.test", "monitors.1.outage_count", "0"),
+					tfresource.TestCheckResourceAttr("data.hyperping_outage_summary.test", "monitors.1.availability", "100"),
+					tfresource.TestCheckResourceAttr("data.hyperping_outage_summary.test", "outage_count", "2"),
+				),
+			},
+			{
+				Config:      testAccOutageSummaryDataSourceConfig(server.URL, now.Format(time.RFC3339), now.Add(-time.Hour).Format(time.RFC3339)),
+				ExpectError: regexp.MustCompile(`must be before to`),
+			},
+		},
+	})
+}
+
+func testAccOutageSummaryDataSourceConfig(baseURL, from, to string) string {
+	toAttr := ""
+	if to != "" {
+		toAttr = fmt.Sprintf("to   = %q", to)
+	}
+	return fmt.Sprintf(`
+provider "hyperping" {
+  api_key  = "test_api_key"
+  base_url = %q
+}
+
+data "hyperping_outage_summary" "test" {
+  monitor_uuids = ["mon_api", "mon_idle"]
+  from = %q
+  %s
+}
+`, baseURL, from, toAttr)
+}
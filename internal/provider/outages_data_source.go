@@ -193,11 +193,14 @@ func (d *OutagesDataSource) Configure(_ context.Context, req datasource.Configur
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 // Read refreshes the Terraform state with the latest data.
 func (d *OutagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config OutagesDataSourceModel
 
 	// Get configuration (includes filter if provided)
@@ -210,7 +213,7 @@ func (d *OutagesDataSource) Read(ctx context.Context, req datasource.ReadRequest
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading outages",
-			fmt.Sprintf("Could not list outages: %s", err),
+			fmt.Sprintf("Could not list outages: %s", redactError(err)),
 		)
 		return
 	}
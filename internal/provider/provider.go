@@ -6,22 +6,32 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 
 	hyperping "github.com/develeap/hyperping-go"
 )
 
 // Ensure HyperpingProvider satisfies the provider.Provider interface.
-var _ provider.Provider = &HyperpingProvider{}
+var (
+	_ provider.Provider              = &HyperpingProvider{}
+	_ provider.ProviderWithFunctions = &HyperpingProvider{}
+)
 
 // HyperpingProvider defines the provider implementation.
 type HyperpingProvider struct {
@@ -29,13 +39,27 @@ type HyperpingProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+	// commit is the git commit the provider was built from, or empty when
+	// the build did not record it.
+	commit string
 }
 
 // HyperpingProviderModel describes the provider data model.
 type HyperpingProviderModel struct {
-	APIKey  types.String `tfsdk:"api_key"`
-	BaseURL types.String `tfsdk:"base_url"`
-	MCPURL  types.String `tfsdk:"mcp_url"`
+	APIKey               types.String `tfsdk:"api_key"`
+	BaseURL              types.String `tfsdk:"base_url"`
+	MCPURL               types.String `tfsdk:"mcp_url"`
+	ProxyURL             types.String `tfsdk:"proxy_url"`
+	CACertFile           types.String `tfsdk:"ca_cert_file"`
+	ClientCertFile       types.String `tfsdk:"client_cert_file"`
+	ClientKeyFile        types.String `tfsdk:"client_key_file"`
+	InsecureSkipVerify   types.Bool   `tfsdk:"insecure_skip_verify"`
+	AuditLogPath         types.String `tfsdk:"audit_log_path"`
+	LogDrift             types.Bool   `tfsdk:"log_drift"`
+	UsageTelemetry       types.Bool   `tfsdk:"usage_telemetry"`
+	JSONNumbers          types.String `tfsdk:"json_numbers"`
+	RetryPolicy          types.String `tfsdk:"retry_policy"`
+	SlowRequestThreshold types.String `tfsdk:"slow_request_threshold"`
 }
 
 // hyperpingClients holds both REST and MCP clients.
@@ -43,6 +67,41 @@ type hyperpingClients struct {
 	REST    *hyperping.Client
 	MCP     *hyperping.MCPClient
 	RESTAPI hyperping.HyperpingAPI
+
+	// Probe is a REST client without retries or a circuit breaker, used by
+	// hyperping_service_status to report an outage at once.
+	Probe hyperping.StatusPageAPI
+
+	// stats holds the connection, retry and rate limit stats of the REST
+	// clients.
+	stats *clientStats
+
+	// probeStats holds the rate limit reported by the latest Probe response.
+	// It is kept apart from stats so probes do not throttle the scheduler.
+	probeStats *clientStats
+
+	// maintenanceWindows is shared by monitor resources to report the
+	// maintenance window each monitor is in with one listing per refresh.
+	maintenanceWindows *maintenanceWindows
+
+	// driftLog is set when log_drift is enabled; resources pass it the
+	// prior and refreshed state at the end of Read.
+	driftLog *driftLog
+
+	// info describes the provider build for hyperping_provider_info.
+	info *providerInfo
+}
+
+// restAPI returns the REST client resources and data sources call through:
+// RESTAPI, which holds back reads while the API is rate limiting (see
+// call_priority.go) and records mutations when audit_log_path is set, or
+// REST when RESTAPI is not populated (as in unit tests that configure
+// resources directly).
+func (c *hyperpingClients) restAPI() hyperping.HyperpingAPI {
+	if c.RESTAPI != nil {
+		return c.RESTAPI
+	}
+	return c.REST
 }
 
 // Metadata returns the provider type name.
@@ -75,6 +134,84 @@ func (p *HyperpingProvider) Schema(_ context.Context, _ provider.SchemaRequest,
 				MarkdownDescription: "Hyperping MCP server URL. Defaults to `https://api.hyperping.io/v1/mcp`.",
 				Optional:            true,
 			},
+			"proxy_url": schema.StringAttribute{
+				MarkdownDescription: "HTTP(S) or SOCKS5 proxy for all API requests, e.g. `http://proxy.corp.example:3128`. " +
+					"Defaults to the `HTTPS_PROXY`, `HTTP_PROXY`, and `NO_PROXY` environment variables.",
+				Optional: true,
+			},
+			"ca_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM file of additional CA certificates to trust, for TLS-intercepting corporate proxies. " +
+					"The certificates are added to the system trust store.",
+				Optional: true,
+			},
+			"client_cert_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM client certificate presented to gateways that require mutual TLS in front of the " +
+					"Hyperping API. Requires `client_key_file`. The API key is still sent with every request.",
+				Optional: true,
+			},
+			"client_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to the PEM private key of `client_cert_file`.",
+				Optional:            true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "**Insecure.** Disables TLS certificate verification, exposing the API key to anyone who can " +
+					"intercept the connection. Use `ca_cert_file` instead; only set this for short-lived debugging. Defaults to `false`.",
+				Optional: true,
+			},
+			"audit_log_path": schema.StringAttribute{
+				MarkdownDescription: "Path of an append-only audit log. When set, every create, update, and delete the provider " +
+					"sends to the API is appended as one JSON line with a timestamp, the resource type, the Hyperping ID, the " +
+					"result, and a summary of the request with secrets redacted. The file is created with `0600` permissions " +
+					"if it does not exist. Can also be set via `HYPERPING_AUDIT_LOG_PATH` environment variable. Disabled by default.",
+				Optional: true,
+			},
+			"log_drift": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, every resource refresh that changes an attribute logs one INFO entry " +
+					"listing the changed attributes with their prior and refreshed values, e.g. " +
+					"`regions[2]: \"london\" → (none)`, so the attribute behind an unexpected plan change can be found " +
+					"with `TF_LOG=INFO` instead of trace logging. Computed-only attributes such as `status` are left out " +
+					"and sensitive values are masked. Can also be set via `HYPERPING_LOG_DRIFT` environment variable. " +
+					"Defaults to `false`.",
+				Optional: true,
+			},
+			"usage_telemetry": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the User-Agent of every API request also carries the Terraform CLI version, " +
+					"e.g. `Terraform/1.9.5`, so Hyperping can see which Terraform versions the provider runs under. No " +
+					"identifiers, configuration, or resource data are sent, and no requests are made beyond those the " +
+					"configuration needs. The provider version and commit are always included. Can also be set via " +
+					"`HYPERPING_USAGE_TELEMETRY` environment variable. Defaults to `false`.",
+				Optional: true,
+			},
+			"json_numbers": schema.StringAttribute{
+				MarkdownDescription: "How numeric fields the API returns as strings, e.g. `\"port\": \"443\"`, are handled. " +
+					"`lenient` converts them to numbers before decoding, logging each conversion at DEBUG level. " +
+					"`strict` fails the read instead, to surface API type changes. Can also be set via " +
+					"`HYPERPING_JSON_NUMBERS` environment variable. Defaults to `lenient`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(jsonNumbersLenient, jsonNumbersStrict),
+				},
+			},
+			"retry_policy": schema.StringAttribute{
+				MarkdownDescription: "Which failed API requests are sent again. Reads, updates (`PUT`) and deletes are " +
+					"idempotent and are retried on network errors, `429` and `5xx` responses under every policy but `none`. " +
+					"`safe` retries creates (`POST`) and partial updates (`PATCH`) only when the API cannot have processed " +
+					"them: the connection failed before the request was sent, or the API answered `429`. `all` also " +
+					"retries them on `5xx` responses and on network errors after the request was sent, which can leave " +
+					"a duplicate resource behind when the first attempt succeeded. `none` sends every request once. Can " +
+					"also be set via `HYPERPING_RETRY_POLICY` environment variable. Defaults to `safe`.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(retryPolicySafe, retryPolicyAll, retryPolicyNone),
+				},
+			},
+			"slow_request_threshold": schema.StringAttribute{
+				MarkdownDescription: "How long an API request may take before a warning is logged with its method, path, " +
+					"status, duration and retry count, to tell Hyperping API latency apart from provider issues when an " +
+					"apply is slow. Each retry is timed on its own. A duration such as `5s` or `1m`; `0s` disables the " +
+					"warnings. Can also be set via `HYPERPING_SLOW_REQUEST_THRESHOLD` environment variable. Defaults to `5s`.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -149,6 +286,131 @@ func (p *HyperpingProvider) Configure(ctx context.Context, req provider.Configur
 		return
 	}
 
+	auditLogPath := os.Getenv("HYPERPING_AUDIT_LOG_PATH")
+	if !config.AuditLogPath.IsNull() {
+		auditLogPath = config.AuditLogPath.ValueString()
+	}
+	var audit *auditLog
+	if auditLogPath != "" {
+		var err error
+		audit, err = newAuditLog(auditLogPath)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("audit_log_path"),
+				"Invalid Audit Log Path",
+				fmt.Sprintf("Cannot open audit log %q for appending: %s", auditLogPath, err),
+			)
+			return
+		}
+	}
+
+	logDrift, _ := strconv.ParseBool(os.Getenv("HYPERPING_LOG_DRIFT")) //nolint:errcheck // unset or invalid means disabled
+	if !config.LogDrift.IsNull() {
+		logDrift = config.LogDrift.ValueBool()
+	}
+
+	usageTelemetry, _ := strconv.ParseBool(os.Getenv("HYPERPING_USAGE_TELEMETRY")) //nolint:errcheck // unset or invalid means disabled
+	if !config.UsageTelemetry.IsNull() {
+		usageTelemetry = config.UsageTelemetry.ValueBool()
+	}
+	jsonNumbers := os.Getenv("HYPERPING_JSON_NUMBERS")
+	if !config.JSONNumbers.IsNull() {
+		jsonNumbers = config.JSONNumbers.ValueString()
+	}
+	switch jsonNumbers {
+	case "":
+		jsonNumbers = jsonNumbersLenient
+	case jsonNumbersLenient, jsonNumbersStrict:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("json_numbers"),
+			"Invalid JSON Number Handling",
+			fmt.Sprintf("HYPERPING_JSON_NUMBERS must be %q or %q, got %q.", jsonNumbersLenient, jsonNumbersStrict, jsonNumbers),
+		)
+		return
+	}
+
+	retryPolicy := os.Getenv("HYPERPING_RETRY_POLICY")
+	if !config.RetryPolicy.IsNull() {
+		retryPolicy = config.RetryPolicy.ValueString()
+	}
+	switch retryPolicy {
+	case "":
+		retryPolicy = retryPolicySafe
+	case retryPolicySafe, retryPolicyAll, retryPolicyNone:
+	default:
+		resp.Diagnostics.AddAttributeError(
+			path.Root("retry_policy"),
+			"Invalid Retry Policy",
+			fmt.Sprintf("HYPERPING_RETRY_POLICY must be %q, %q or %q, got %q.", retryPolicySafe, retryPolicyAll, retryPolicyNone, retryPolicy),
+		)
+		return
+	}
+
+	slowRequestValue := os.Getenv("HYPERPING_SLOW_REQUEST_THRESHOLD")
+	if !config.SlowRequestThreshold.IsNull() {
+		slowRequestValue = config.SlowRequestThreshold.ValueString()
+	}
+	slowRequestThreshold, err := parseSlowRequestThreshold(slowRequestValue)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("slow_request_threshold"),
+			"Invalid Slow Request Threshold",
+			fmt.Sprintf("HYPERPING_SLOW_REQUEST_THRESHOLD %s.", err),
+		)
+		return
+	}
+
+	build := BuildInfo{Version: p.version, Commit: p.commit}.resolved()
+	info := &providerInfo{
+		build:            build,
+		terraformVersion: req.TerraformVersion,
+		usageTelemetry:   usageTelemetry,
+		userAgent:        userAgentProduct(build, req.TerraformVersion, usageTelemetry),
+	}
+
+	transportCfg := transportConfig{
+		ProxyURL:           config.ProxyURL.ValueString(),
+		CACertFile:         config.CACertFile.ValueString(),
+		ClientCertFile:     config.ClientCertFile.ValueString(),
+		ClientKeyFile:      config.ClientKeyFile.ValueString(),
+		InsecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+	}
+	if transportCfg.InsecureSkipVerify {
+		resp.Diagnostics.AddAttributeWarning(
+			path.Root("insecure_skip_verify"),
+			"TLS Certificate Verification Disabled",
+			"insecure_skip_verify = true disables TLS certificate verification for all Hyperping API requests. "+
+				"Anyone able to intercept the connection can read your API key and tamper with responses. "+
+				"Trust your proxy's CA with ca_cert_file instead, and remove insecure_skip_verify as soon as possible.",
+		)
+	}
+
+	// One REST client per provider instance is shared by every resource and
+	// data source, so Terraform's parallel operations draw from a single
+	// connection pool and circuit breaker. hyperping.Client is safe for
+	// concurrent use.
+	stats := newClientStats()
+	scheduler := newCallScheduler()
+	stats.onRateLimit = scheduler.rateLimited
+	restTransportCfg := transportCfg
+	restTransportCfg.Stats = stats
+
+	// REST requests are bounded by per-operation context deadlines (resource
+	// timeouts blocks, see timeouts.go) rather than an HTTP client timeout,
+	// which would cap every request at the same value regardless of the
+	// configured timeouts.
+	restHTTPClient, diags := configureHTTPClient(restTransportCfg, 0)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	mcpHTTPClient, diags := configureHTTPClient(transportCfg, hyperping.DefaultMCPTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Note: sensitive-field masking is applied per Debug call inside
 	// TFLogAdapter (see internal/provider/logging.go). A masked context built
 	// here would not survive into the per-operation contexts the Terraform
@@ -156,15 +418,44 @@ func (p *HyperpingProvider) Configure(ctx context.Context, req provider.Configur
 	// Configure-time masking alone.
 
 	// Create REST client
-	restClient := hyperping.NewClient(
-		apiKey,
+	restOpts := []hyperping.Option{
 		hyperping.WithBaseURL(baseURL),
+		hyperping.WithHTTPClient(restHTTPClient),
 		hyperping.WithLogger(NewTFLogAdapter()),
+		hyperping.WithMetrics(stats),
 		hyperping.WithVersion(p.version),
-	)
+	}
+	if retryPolicy == retryPolicyNone {
+		restOpts = append(restOpts, hyperping.WithMaxRetries(0))
+	}
+	restClient := hyperping.NewClient(apiKey, restOpts...)
+
+	probeStats := newClientStats()
+	probeClient, diags := newProbeClient(apiKey, baseURL, p.version, info.userAgent, transportCfg, probeStats)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// hyperping-go has built its transport chain into restHTTPClient by now;
+	// wrapping the result records rate limit headers, adds the provider to
+	// the User-Agent, converts numeric strings in responses, warns about slow
+	// requests, and retries the creates the retry policy allows without
+	// bypassing it.
+	if jsonNumbers == jsonNumbersLenient {
+		restHTTPClient.Transport = newJSONNumberTransport(restHTTPClient.Transport)
+	}
+	restHTTPClient.Transport = newRateLimitTransport(
+		newUserAgentTransport(restHTTPClient.Transport, info.userAgent), stats)
+	if slowRequestThreshold > 0 {
+		restHTTPClient.Transport = newSlowRequestTransport(restHTTPClient.Transport, slowRequestThreshold, stats)
+	}
+	if retryPolicy != retryPolicyNone {
+		restHTTPClient.Transport = newMutationRetryTransport(restHTTPClient.Transport, retryPolicy, stats)
+	}
 
 	// Create MCP client
-	mcpTransport, err := hyperping.NewMcpTransport(apiKey, mcpURL)
+	mcpTransport, err := hyperping.NewMcpTransport(apiKey, mcpURL, hyperping.WithMCPHTTPClient(mcpHTTPClient))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid MCP URL",
@@ -172,12 +463,27 @@ func (p *HyperpingProvider) Configure(ctx context.Context, req provider.Configur
 		)
 		return
 	}
+	mcpHTTPClient.Transport = newUserAgentTransport(mcpHTTPClient.Transport, info.userAgent)
 	mcpClient := hyperping.NewMCPClient(mcpTransport)
 
+	var restAPI hyperping.HyperpingAPI = newScheduledClient(restClient, scheduler)
+	if audit != nil {
+		restAPI = newAuditedClient(restAPI, audit)
+	}
+
 	clients := &hyperpingClients{
 		REST:    restClient,
 		MCP:     mcpClient,
-		RESTAPI: restClient,
+		RESTAPI: restAPI,
+		Probe:   probeClient,
+
+		stats:              stats,
+		probeStats:         probeStats,
+		maintenanceWindows: newMaintenanceWindows(restAPI),
+		info:               info,
+	}
+	if logDrift {
+		clients.driftLog = &driftLog{}
 	}
 
 	// Make the clients available to data sources and resources
@@ -185,6 +491,67 @@ func (p *HyperpingProvider) Configure(ctx context.Context, req provider.Configur
 	resp.ResourceData = clients
 }
 
+// configureHTTPClient builds an HTTP client from the transport attributes,
+// reporting invalid values against the attribute that caused them.
+func configureHTTPClient(cfg transportConfig, timeout time.Duration) (*http.Client, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if cfg.ProxyURL != "" {
+		if _, err := parseProxyURL(cfg.ProxyURL); err != nil {
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL", err.Error())
+			return nil, diags
+		}
+	}
+
+	if cfg.ClientCertFile != "" || cfg.ClientKeyFile != "" {
+		if _, err := loadClientCertificate(cfg.ClientCertFile, cfg.ClientKeyFile); err != nil {
+			attr := path.Root("client_cert_file")
+			if cfg.ClientKeyFile == "" {
+				attr = path.Root("client_key_file")
+			}
+			diags.AddAttributeError(attr, "Invalid Client Certificate",
+				fmt.Sprintf("Failed to load the client certificate %q with key %q: %s", cfg.ClientCertFile, cfg.ClientKeyFile, err))
+			return nil, diags
+		}
+	}
+
+	client, err := newHTTPClient(cfg, timeout)
+	if err != nil {
+		diags.AddAttributeError(
+			path.Root("ca_cert_file"),
+			"Invalid CA Certificate File",
+			fmt.Sprintf("Failed to load CA certificates from %q: %s", cfg.CACertFile, err),
+		)
+		return nil, diags
+	}
+	return client, diags
+}
+
+// newProbeClient builds the REST client used by hyperping_service_status. It
+// gets its own HTTP client: hyperping.NewClient wraps the transport of the
+// client it is given, so sharing the REST client's would route every REST
+// request through the authentication transport twice, and probes would go
+// through the slow request and retry transports and update the rate limit
+// state the scheduler reads. Rate limit headers of probe responses are
+// recorded in stats instead.
+func newProbeClient(apiKey, baseURL, version, userAgent string, cfg transportConfig, stats *clientStats) (*hyperping.Client, diag.Diagnostics) {
+	httpClient, diags := configureHTTPClient(cfg, 0)
+	if diags.HasError() {
+		return nil, diags
+	}
+	client := hyperping.NewClient(
+		apiKey,
+		hyperping.WithBaseURL(baseURL),
+		hyperping.WithHTTPClient(httpClient),
+		hyperping.WithLogger(NewTFLogAdapter()),
+		hyperping.WithMaxRetries(0),
+		hyperping.WithNoCircuitBreaker(),
+		hyperping.WithVersion(version),
+	)
+	httpClient.Transport = newRateLimitTransport(newUserAgentTransport(httpClient.Transport, userAgent), stats)
+	return client, diags
+}
+
 // Resources defines the resources implemented in the provider.
 func (p *HyperpingProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
@@ -210,8 +577,10 @@ func (p *HyperpingProvider) DataSources(_ context.Context) []func() datasource.D
 		NewMaintenanceWindowsDataSource,
 		NewMonitorReportDataSource,
 		NewMonitorReportsDataSource,
+		NewMonitorCheckResultDataSource,
 		NewOutageDataSource,
 		NewOutagesDataSource,
+		NewOutageSummaryDataSource,
 		NewHealthcheckDataSource,
 		NewHealthchecksDataSource,
 		NewStatusPageDataSource,
@@ -223,6 +592,15 @@ func (p *HyperpingProvider) DataSources(_ context.Context) []func() datasource.D
 		NewOnCallSchedulesDataSource,
 		NewOnCallScheduleDataSource,
 		NewIntegrationsDataSource,
+		NewServiceStatusDataSource,
+		NewProviderInfoDataSource,
+	}
+}
+
+// Functions defines the provider functions implemented in the provider.
+func (p *HyperpingProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewNormalizeURLFunction,
 	}
 }
 
@@ -261,9 +639,16 @@ func isAllowedBaseURL(baseURL string, allowLocal bool) bool {
 
 // New creates a new provider factory function.
 func New(version string) func() provider.Provider {
+	return NewWithBuildInfo(BuildInfo{Version: version})
+}
+
+// NewWithBuildInfo creates a provider factory function for a build with
+// known metadata, reported by hyperping_provider_info and in the User-Agent.
+func NewWithBuildInfo(build BuildInfo) func() provider.Provider {
 	return func() provider.Provider {
 		return &HyperpingProvider{
-			version: version,
+			version: build.Version,
+			commit:  build.Commit,
 		}
 	}
 }
@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"runtime"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ProviderInfoDataSource{}
+	_ datasource.DataSourceWithConfigure = &ProviderInfoDataSource{}
+)
+
+// NewProviderInfoDataSource creates a new provider info data source.
+func NewProviderInfoDataSource() datasource.DataSource {
+	return &ProviderInfoDataSource{}
+}
+
+// ProviderInfoDataSource reports the build of the running provider, for
+// issue reports and debugging. It does not call the API.
+type ProviderInfoDataSource struct {
+	info *providerInfo
+}
+
+// ProviderInfoDataSourceModel describes the data source data model.
+type ProviderInfoDataSourceModel struct {
+	Version              types.String `tfsdk:"version"`
+	Commit               types.String `tfsdk:"commit"`
+	GoVersion            types.String `tfsdk:"go_version"`
+	Platform             types.String `tfsdk:"platform"`
+	ClientLibraryVersion types.String `tfsdk:"client_library_version"`
+	APIVersions          types.Map    `tfsdk:"api_versions"`
+	TerraformVersion     types.String `tfsdk:"terraform_version"`
+	UserAgent            types.String `tfsdk:"user_agent"`
+	UsageTelemetry       types.Bool   `tfsdk:"usage_telemetry"`
+}
+
+// Metadata returns the data source type name.
+func (d *ProviderInfoDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_provider_info"
+}
+
+// Schema defines the schema for the data source.
+func (d *ProviderInfoDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Reports the build of the running provider: version, commit, the hyperping-go client version, " +
+			"and the API version of each endpoint. Include the output in issue reports. " +
+			"This data source does not require an API call.",
+
+		Attributes: map[string]schema.Attribute{
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Provider version, or `dev` for local builds.",
+				Computed:            true,
+			},
+			"commit": schema.StringAttribute{
+				MarkdownDescription: "Git commit the provider was built from, or `unknown` when the build did not record it.",
+				Computed:            true,
+			},
+			"go_version": schema.StringAttribute{
+				MarkdownDescription: "Go version the provider was built with, e.g. `go1.26.1`.",
+				Computed:            true,
+			},
+			"platform": schema.StringAttribute{
+				MarkdownDescription: "Operating system and architecture of the provider binary, e.g. `linux/amd64`.",
+				Computed:            true,
+			},
+			"client_library_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the hyperping-go API client compiled into the provider.",
+				Computed:            true,
+			},
+			"api_versions": schema.MapAttribute{
+				MarkdownDescription: "REST API version each endpoint family is pinned to, keyed by family " +
+					"(`monitors`, `healthchecks`, `statuspages`, `incidents`, `maintenance`, `outages`, `reports`), e.g. `v1`.",
+				Computed:    true,
+				ElementType: types.StringType,
+			},
+			"terraform_version": schema.StringAttribute{
+				MarkdownDescription: "Version of the Terraform CLI running the provider, when Terraform reports it.",
+				Computed:            true,
+			},
+			"user_agent": schema.StringAttribute{
+				MarkdownDescription: "Product token the provider appends to the User-Agent of every API request.",
+				Computed:            true,
+			},
+			"usage_telemetry": schema.BoolAttribute{
+				MarkdownDescription: "Whether the provider's `usage_telemetry` setting is enabled.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+// Configure stores the build information of the configured provider.
+func (d *ProviderInfoDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*hyperpingClients)
+	if !ok {
+		resp.Diagnostics.Append(newUnexpectedConfigTypeError("*hyperpingClients", req.ProviderData))
+		return
+	}
+	d.info = clients.info
+}
+
+// Read populates the data source model with the build information.
+func (d *ProviderInfoDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+	info := d.info
+	if info == nil {
+		build := BuildInfo{}.resolved()
+		info = &providerInfo{build: build, userAgent: userAgentProduct(build, "", false)}
+	}
+
+	commit := info.build.Commit
+	if commit == "" {
+		commit = unknownBuildValue
+	}
+	terraformVersion := types.StringNull()
+	if info.terraformVersion != "" {
+		terraformVersion = types.StringValue(info.terraformVersion)
+	}
+
+	versions, diags := types.MapValueFrom(ctx, types.StringType, apiVersions())
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	model := ProviderInfoDataSourceModel{
+		Version:              types.StringValue(info.build.Version),
+		Commit:               types.StringValue(commit),
+		GoVersion:            types.StringValue(runtime.Version()),
+		Platform:             types.StringValue(runtime.GOOS + "/" + runtime.GOARCH),
+		ClientLibraryVersion: types.StringValue(clientLibraryVersion()),
+		APIVersions:          versions,
+		TerraformVersion:     terraformVersion,
+		UserAgent:            types.StringValue(info.userAgent),
+		UsageTelemetry:       types.BoolValue(info.usageTelemetry),
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
@@ -0,0 +1,163 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestProviderInfoDataSource_Metadata(t *testing.T) {
+	d := NewProviderInfoDataSource()
+	resp := &datasource.MetadataResponse{}
+	d.Metadata(context.Background(), datasource.MetadataRequest{ProviderTypeName: "hyperping"}, resp)
+
+	if resp.TypeName != "hyperping_provider_info" {
+		t.Errorf("expected type name hyperping_provider_info, got %s", resp.TypeName)
+	}
+}
+
+func TestProviderInfoDataSource_Read(t *testing.T) {
+	d := &ProviderInfoDataSource{info: &providerInfo{
+		build:            BuildInfo{Version: "1.9.0", Commit: "0123456789abcdef"},
+		terraformVersion: "1.9.5",
+		usageTelemetry:   true,
+		userAgent:        "terraform-provider-hyperping/1.9.0 (commit 0123456789ab) Terraform/1.9.5",
+	}}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	resp := &datasource.ReadResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+	}}
+	d.Read(context.Background(), datasource.ReadRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+
+	var model ProviderInfoDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &model)...)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("State.Get: %v", resp.Diagnostics)
+	}
+	if model.Version.ValueString() != "1.9.0" || model.Commit.ValueString() != "0123456789abcdef" {
+		t.Errorf("version/commit = %s/%s", model.Version, model.Commit)
+	}
+	if model.TerraformVersion.ValueString() != "1.9.5" || !model.UsageTelemetry.ValueBool() {
+		t.Errorf("terraform_version/usage_telemetry = %s/%s", model.TerraformVersion, model.UsageTelemetry)
+	}
+	if v, ok := model.APIVersions.Elements()["incidents"]; !ok || v.String() != `"v3"` {
+		t.Errorf("api_versions = %s", model.APIVersions)
+	}
+}
+
+func TestProviderInfoDataSource_ReadUnconfigured(t *testing.T) {
+	stubBuildInfo(t, nil)
+	d := &ProviderInfoDataSource{}
+
+	schemaResp := &datasource.SchemaResponse{}
+	d.Schema(context.Background(), datasource.SchemaRequest{}, schemaResp)
+	resp := &datasource.ReadResponse{State: tfsdk.State{
+		Schema: schemaResp.Schema,
+		Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(context.Background()), nil),
+	}}
+	d.Read(context.Background(), datasource.ReadRequest{}, resp)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("Read: %v", resp.Diagnostics)
+	}
+
+	var model ProviderInfoDataSourceModel
+	resp.Diagnostics.Append(resp.State.Get(context.Background(), &model)...)
+	if model.Commit.ValueString() != unknownBuildValue || !model.TerraformVersion.IsNull() {
+		t.Errorf("commit = %s, terraform_version = %s", model.Commit, model.TerraformVersion)
+	}
+}
+
+func TestAccProviderInfoDataSource(t *testing.T) {
+	var mu sync.Mutex
+	var userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mu.Lock()
+		userAgent = r.Header.Get("User-Agent")
+		mu.Unlock()
+		w.Header().Set(hyperping.HeaderContentType, hyperping.ContentTypeJSON)
+		w.Write([]byte(`{"statuspages": [], "hasNextPage": false}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccProviderInfoDataSourceConfig(server.URL, false),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.hyperping_provider_info.test", "version", "test"),
+					tfresource.TestCheckResourceAttrSet("data.hyperping_provider_info.test", "commit"),
+					tfresource.TestCheckResourceAttrSet("data.hyperping_provider_info.test", "go_version"),
+					tfresource.TestCheckResourceAttrSet("data.hyperping_provider_info.test", "terraform_version"),
+					tfresource.TestCheckResourceAttr("data.hyperping_provider_info.test", "api_versions.monitors", "v1"),
+					tfresource.TestCheckResourceAttr("data.hyperping_provider_info.test", "usage_telemetry", "false"),
+					tfresource.TestMatchResourceAttr("data.hyperping_provider_info.test", "user_agent",
+						regexp.MustCompile(`^terraform-provider-hyperping/test( \(commit [0-9a-f]+\))?$`)),
+					checkUserAgent(&mu, &userAgent, false),
+				),
+			},
+			{
+				Config: testAccProviderInfoDataSourceConfig(server.URL, true),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.hyperping_provider_info.test", "usage_telemetry", "true"),
+					tfresource.TestMatchResourceAttr("data.hyperping_provider_info.test", "user_agent",
+						regexp.MustCompile(` Terraform/\d+\.\d+`)),
+					checkUserAgent(&mu, &userAgent, true),
+				),
+			},
+		},
+	})
+}
+
+// checkUserAgent checks the User-Agent of the last request the mock server
+// received.
+func checkUserAgent(mu *sync.Mutex, userAgent *string, telemetry bool) func(*terraform.State) error {
+	return func(*terraform.State) error {
+		mu.Lock()
+		defer mu.Unlock()
+		if !strings.Contains(*userAgent, " terraform-provider-hyperping/test") {
+			return fmt.Errorf("User-Agent %q lacks the provider product token", *userAgent)
+		}
+		if strings.Contains(*userAgent, " Terraform/") != telemetry {
+			return fmt.Errorf("User-Agent %q: Terraform version present = %t, want %t", *userAgent, !telemetry, telemetry)
+		}
+		return nil
+	}
+}
+
+func testAccProviderInfoDataSourceConfig(baseURL string, telemetry bool) string {
+	return fmt.Sprintf(`
+provider "hyperping" {
+  api_key         = "test_api_key"
+  base_url        = %[1]q
+  usage_telemetry = %[2]t
+}
+
+data "hyperping_provider_info" "test" {}
+
+# Makes a request so the User-Agent reaches the mock server.
+data "hyperping_service_status" "test" {}
+`, baseURL, telemetry)
+}
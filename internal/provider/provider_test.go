@@ -61,6 +61,11 @@ func TestProvider_Schema(t *testing.T) {
 	if _, ok := resp.Schema.Attributes["base_url"]; !ok {
 		t.Error("expected base_url attribute in schema")
 	}
+	for _, name := range []string{"proxy_url", "ca_cert_file", "insecure_skip_verify"} {
+		if _, ok := resp.Schema.Attributes[name]; !ok {
+			t.Errorf("expected %s attribute in schema", name)
+		}
+	}
 }
 
 func TestProvider_Resources(t *testing.T) {
@@ -79,9 +84,10 @@ func TestProvider_DataSources(t *testing.T) {
 
 	// 16 original + 5 new:
 	// EscalationPolicies, EscalationPolicy, OnCallSchedules, OnCallSchedule, Integrations
-	// 16 + 5 = 21
-	if len(dataSources) != 21 {
-		t.Errorf("expected 21 data sources, got %d", len(dataSources))
+	// + MonitorCheckResult
+	// 16 + 5 + 1 = 22
+	if len(dataSources) != 25 {
+		t.Errorf("expected 25 data sources, got %d", len(dataSources))
 	}
 }
 
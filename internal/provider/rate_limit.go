@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// resetEpochThreshold separates the two forms of a rate limit reset header:
+// values above it are Unix timestamps, values below it are seconds from now.
+const resetEpochThreshold = 1_000_000_000
+
+// rateLimitStatus is the rate limit quota reported by the most recent API
+// response that carried rate limit headers.
+type rateLimitStatus struct {
+	// Limit is the request quota of the current window, or -1 when the
+	// response did not include it.
+	Limit int
+	// Remaining is the number of requests left in the current window.
+	Remaining int
+	// Reset is when the window resets, or zero when it was not reported.
+	Reset time.Time
+	// ObservedAt is when the response was received.
+	ObservedAt time.Time
+}
+
+// parseRateLimitHeaders reads X-RateLimit-Limit, X-RateLimit-Remaining and
+// X-RateLimit-Reset, or their unprefixed RateLimit-* equivalents. It returns
+// false when the response has no remaining count.
+func parseRateLimitHeaders(h http.Header, now time.Time) (rateLimitStatus, bool) {
+	remaining, ok := rateLimitHeader(h, "Remaining")
+	if !ok {
+		return rateLimitStatus{}, false
+	}
+
+	status := rateLimitStatus{Limit: -1, Remaining: remaining, ObservedAt: now}
+	if limit, ok := rateLimitHeader(h, "Limit"); ok {
+		status.Limit = limit
+	}
+	if reset, ok := rateLimitHeader(h, "Reset"); ok {
+		if reset > resetEpochThreshold {
+			status.Reset = time.Unix(int64(reset), 0)
+		} else {
+			status.Reset = now.Add(time.Duration(reset) * time.Second)
+		}
+	}
+	return status, true
+}
+
+// rateLimitHeader returns the integer value of the X-RateLimit-<name> or
+// RateLimit-<name> header.
+func rateLimitHeader(h http.Header, name string) (int, bool) {
+	for _, key := range []string{"X-RateLimit-" + name, "RateLimit-" + name} {
+		v := strings.TrimSpace(h.Get(key))
+		if v == "" {
+			continue
+		}
+		n, err := strconv.Atoi(v)
+		if err != nil || n < 0 {
+			continue
+		}
+		return n, true
+	}
+	return 0, false
+}
+
+// rateLimitTransport records the rate limit headers of every response in
+// stats. It wraps the transport chain hyperping-go builds, so the TLS
+// hardening and authentication applied to the base transport are kept.
+type rateLimitTransport struct {
+	next  http.RoundTripper
+	stats *clientStats
+	now   func() time.Time
+}
+
+func newRateLimitTransport(next http.RoundTripper, stats *clientStats) *rateLimitTransport {
+	return &rateLimitTransport{next: next, stats: stats, now: time.Now}
+}
+
+func (t *rateLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err == nil && resp != nil {
+		if status, ok := parseRateLimitHeaders(resp.Header, t.now()); ok {
+			t.stats.recordRateLimit(status)
+		}
+	}
+	return resp, err
+}
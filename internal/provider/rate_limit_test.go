@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"testing"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestParseRateLimitHeaders(t *testing.T) {
+	now := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name    string
+		headers map[string]string
+		want    rateLimitStatus
+		wantOK  bool
+	}{
+		{
+			name:    "x-ratelimit with epoch reset",
+			headers: map[string]string{"X-RateLimit-Limit": "600", "X-RateLimit-Remaining": "12", "X-RateLimit-Reset": "1772366460"},
+			want:    rateLimitStatus{Limit: 600, Remaining: 12, Reset: time.Unix(1772366460, 0), ObservedAt: now},
+			wantOK:  true,
+		},
+		{
+			name:    "ratelimit with delta reset",
+			headers: map[string]string{"RateLimit-Remaining": "0", "RateLimit-Reset": "30"},
+			want:    rateLimitStatus{Limit: -1, Remaining: 0, Reset: now.Add(30 * time.Second), ObservedAt: now},
+			wantOK:  true,
+		},
+		{
+			name:    "remaining only",
+			headers: map[string]string{"X-RateLimit-Remaining": " 7 "},
+			want:    rateLimitStatus{Limit: -1, Remaining: 7, ObservedAt: now},
+			wantOK:  true,
+		},
+		{
+			name:    "no headers",
+			headers: map[string]string{},
+		},
+		{
+			name:    "malformed remaining",
+			headers: map[string]string{"X-RateLimit-Limit": "600", "X-RateLimit-Remaining": "lots"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := http.Header{}
+			for k, v := range tt.headers {
+				h.Set(k, v)
+			}
+			got, ok := parseRateLimitHeaders(h, now)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && (got.Limit != tt.want.Limit || got.Remaining != tt.want.Remaining ||
+				!got.Reset.Equal(tt.want.Reset) || !got.ObservedAt.Equal(tt.want.ObservedAt)) {
+				t.Errorf("got %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestRateLimitTransport_WrapsClientChain wraps the transport chain of a
+// configured hyperping client, as the provider does, and checks that the
+// rate limit headers of each response reach the stats while requests are
+// still authenticated.
+func TestRateLimitTransport_WrapsClientChain(t *testing.T) {
+	remaining := 100
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get(hyperping.HeaderAuthorization) != hyperping.BearerPrefix+"sk_test_key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		remaining--
+		w.Header().Set("X-RateLimit-Limit", "100")
+		w.Header().Set("X-RateLimit-Remaining", strconv.Itoa(remaining))
+		w.Header().Set(hyperping.HeaderContentType, hyperping.ContentTypeJSON)
+		w.Write([]byte(`{"uuid": "mon_1", "name": "Monitor", "url": "https://example.com", "protocol": "http"}`)) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	stats := newClientStats()
+	if _, ok := stats.RateLimitStatus(); ok {
+		t.Fatal("rate limit status should be unknown before any response")
+	}
+
+	httpClient, err := newHTTPClient(transportConfig{Stats: stats}, 5*time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := hyperping.NewClient("sk_test_key", hyperping.WithBaseURL(server.URL), hyperping.WithHTTPClient(httpClient))
+	httpClient.Transport = newRateLimitTransport(httpClient.Transport, stats)
+
+	for range 2 {
+		if _, err := client.GetMonitor(context.Background(), "mon_1"); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	status, ok := stats.RateLimitStatus()
+	if !ok || status.Limit != 100 || status.Remaining != 98 {
+		t.Errorf("RateLimitStatus() = %+v, %v, want limit 100 and 98 remaining", status, ok)
+	}
+	snapshot := stats.snapshot()
+	if snapshot["rate_limit_remaining"] != 98 || snapshot["rate_limit_limit"] != 100 {
+		t.Errorf("snapshot = %v", snapshot)
+	}
+	if _, ok := snapshot["rate_limit_reset"]; ok {
+		t.Error("rate_limit_reset should be omitted when the response has no reset header")
+	}
+}
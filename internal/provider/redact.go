@@ -0,0 +1,92 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+const redacted = "[REDACTED]"
+
+var (
+	// bearerTokenPattern matches the credential of an Authorization value,
+	// such as a bearer token configured in a monitor's request_headers.
+	bearerTokenPattern = regexp.MustCompile(`(?i)\b(Bearer|Basic|Token)\s+[A-Za-z0-9\-._~+/]{6,}=*`)
+
+	// sensitiveHeaderPattern matches credential headers written as
+	// "Name: value" lines.
+	sensitiveHeaderPattern = regexp.MustCompile(`(?i)\b(Authorization|Proxy-Authorization|Cookie|Set-Cookie|X-Api-Key|X-Auth-Token|X-Access-Token):[ \t]*[^\r\n"]+`)
+
+	// sensitiveJSONFieldPattern matches the string value of a JSON field
+	// whose name is in auditRedactedFields, as echoed back in the body of an
+	// API error: {"name":"Authorization","value":"Bearer ..."} or
+	// {"email":"..."}.
+	sensitiveJSONFieldPattern = regexp.MustCompile(`(?i)"(` + redactedFieldNames() + `)"\s*:\s*"(?:[^"\\]|\\.)*"`)
+)
+
+// secretPatterns are matched against structured log fields, so values that
+// carry a secret are masked whatever their field name.
+var secretPatterns = []*regexp.Regexp{
+	hyperping.APIKeyPattern,
+	bearerTokenPattern,
+	sensitiveHeaderPattern,
+	sensitiveJSONFieldPattern,
+}
+
+// redactedFieldNames returns the audit log's redacted field names as a regexp
+// alternation, longest first so that a name is not cut short by its prefix.
+func redactedFieldNames() string {
+	names := make([]string, 0, len(auditRedactedFields))
+	for name := range auditRedactedFields {
+		names = append(names, regexp.QuoteMeta(name))
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if len(names[i]) != len(names[j]) {
+			return len(names[i]) > len(names[j])
+		}
+		return names[i] < names[j]
+	})
+	return strings.Join(names, "|")
+}
+
+// redactSecrets replaces API keys, authorization credentials, and the values
+// of sensitive request fields in msg. The API sometimes echoes the request
+// body in error messages, and a monitor's request_headers can hold the
+// credentials of the monitored endpoint. hyperping-go already sanitizes the
+// top-level error message; this also covers validation details and errors
+// that do not come from the API.
+func redactSecrets(msg string) string {
+	msg = hyperping.APIKeyPattern.ReplaceAllString(msg, redacted)
+	msg = sensitiveJSONFieldPattern.ReplaceAllString(msg, `"$1":"`+redacted+`"`)
+	msg = sensitiveHeaderPattern.ReplaceAllString(msg, "$1: "+redacted)
+	msg = bearerTokenPattern.ReplaceAllString(msg, "$1 "+redacted)
+	return msg
+}
+
+// redactedError is an error whose message has passed through redactSecrets.
+// It unwraps to the original error, so errors.Is and errors.As still see the
+// *hyperping.APIError.
+type redactedError struct {
+	err error
+}
+
+func (e *redactedError) Error() string { return redactSecrets(e.err.Error()) }
+
+func (e *redactedError) Unwrap() error { return e.err }
+
+// redactError wraps err so that its message is redacted wherever it is
+// surfaced: diagnostics, logs, and the audit log. It returns nil for nil.
+func redactError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := err.(*redactedError); ok {
+		return err
+	}
+	return &redactedError{err: err}
+}
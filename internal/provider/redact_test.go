@@ -0,0 +1,140 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestRedactSecrets(t *testing.T) {
+	tests := []struct {
+		name   string
+		msg    string
+		want   string
+		secret string
+	}{
+		{
+			name:   "request header echoed as JSON",
+			msg:    `invalid request_headers: [{"name":"Authorization","value":"Bearer eyJhbGciOi.payload"}]`,
+			want:   `invalid request_headers: [{"name":"Authorization","value":"[REDACTED]"}]`,
+			secret: "eyJhbGciOi.payload",
+		},
+		{
+			name:   "escaped quotes in value",
+			msg:    `{"password": "pa\"ss\"word", "name": "Status"}`,
+			want:   `{"password":"[REDACTED]", "name": "Status"}`,
+			secret: `ss\"word`,
+		},
+		{
+			name:   "subscriber contact",
+			msg:    `duplicate subscriber {"type":"email","email":"ops@example.com"}`,
+			want:   `duplicate subscriber {"type":"email","email":"[REDACTED]"}`,
+			secret: "ops@example.com",
+		},
+		{
+			name:   "header line",
+			msg:    "upstream said: X-Api-Key: abc123secret\nretry later",
+			want:   "upstream said: X-Api-Key: [REDACTED]\nretry later",
+			secret: "abc123secret",
+		},
+		{
+			name:   "bearer token in text",
+			msg:    "header value Bearer abcdef123456 is too long",
+			want:   "header value Bearer [REDACTED] is too long",
+			secret: "abcdef123456",
+		},
+		{
+			name:   "api key",
+			msg:    "key sk_live_0123456789abcdef rejected",
+			secret: "sk_live_0123456789abcdef",
+		},
+		{
+			name: "nothing sensitive",
+			msg:  `API error (status 422): {"name":"API","check_frequency":7}`,
+			want: `API error (status 422): {"name":"API","check_frequency":7}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := redactSecrets(tt.msg)
+			if tt.want != "" && got != tt.want {
+				t.Errorf("redactSecrets() = %q, want %q", got, tt.want)
+			}
+			if tt.secret != "" && strings.Contains(got, tt.secret) {
+				t.Errorf("secret %q leaked: %q", tt.secret, got)
+			}
+		})
+	}
+}
+
+func TestRedactError(t *testing.T) {
+	if redactError(nil) != nil {
+		t.Error("redactError(nil) should be nil")
+	}
+
+	apiErr := hyperping.NewAPIError(400, "bad request")
+	err := redactError(fmt.Errorf(`create failed {"value":"Bearer topsecret-token"}: %w`, apiErr))
+	if strings.Contains(err.Error(), "topsecret-token") {
+		t.Errorf("secret leaked: %s", err)
+	}
+	var target *hyperping.APIError
+	if !errors.As(err, &target) || target.StatusCode != 400 {
+		t.Error("redacted error should unwrap to the API error")
+	}
+	if redactError(err) != err {
+		t.Error("redactError should not wrap an already redacted error")
+	}
+}
+
+func TestValidationDetailsRedacted(t *testing.T) {
+	err := hyperping.NewValidationError(422, "Validation failed", []hyperping.ValidationDetail{
+		{Field: "request_headers", Message: `header {"name":"Authorization","value":"Bearer s3cr3t-token"} is invalid`},
+		{Field: "unknown_field", Message: "Bearer s3cr3t-token rejected"},
+	})
+
+	diags := newValidationDetailDiagnostics("Monitor", "create", err)
+	text := validationDetailsText("Monitor", err)
+	for _, d := range diags {
+		if strings.Contains(d.Detail(), "s3cr3t-token") {
+			t.Errorf("secret leaked to attribute diagnostic: %s", d.Detail())
+		}
+	}
+	if strings.Contains(text, "s3cr3t-token") {
+		t.Errorf("secret leaked to details text: %s", text)
+	}
+}
+
+func TestNewCreateErrorWithContext_Redacted(t *testing.T) {
+	err := fmt.Errorf(`request rejected {"email":"ops@example.com"}: %w`, hyperping.NewAPIError(409, "conflict"))
+	d := NewCreateErrorWithContext("Subscriber", err)
+	if strings.Contains(d.Detail(), "ops@example.com") {
+		t.Errorf("secret leaked to diagnostic: %s", d.Detail())
+	}
+}
+
+func TestTFLogAdapter_MasksErrorBody(t *testing.T) {
+	var buf bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &buf)
+
+	NewTFLogAdapter().Debug(ctx, `response {"value":"Bearer body-secret-token"}`, map[string]interface{}{
+		"body": `{"name":"Authorization","value":"Bearer field-secret-token"}`,
+	})
+
+	logged := buf.String()
+	for _, secret := range []string{"body-secret-token", "field-secret-token"} {
+		if strings.Contains(logged, secret) {
+			t.Errorf("secret leaked to log output:\n%s", logged)
+		}
+	}
+}
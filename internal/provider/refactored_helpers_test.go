@@ -510,7 +510,7 @@ func TestApplyMonitoringFieldChanges_unchangedFieldsOmitted(t *testing.T) {
 func TestApplyHealthcheckTimingFields_changedCronIncluded(t *testing.T) {
 	plan := HealthcheckResourceModel{
 		Cron:             types.StringValue("0 0 * * *"),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(5),
@@ -518,7 +518,7 @@ func TestApplyHealthcheckTimingFields_changedCronIncluded(t *testing.T) {
 	}
 	state := HealthcheckResourceModel{
 		Cron:             types.StringValue("0 12 * * *"),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(5),
@@ -542,7 +542,7 @@ func TestApplyHealthcheckTimingFields_changedCronIncluded(t *testing.T) {
 func TestApplyHealthcheckTimingFields_nullCronClearsToEmpty(t *testing.T) {
 	plan := HealthcheckResourceModel{
 		Cron:             types.StringNull(),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(5),
@@ -550,7 +550,7 @@ func TestApplyHealthcheckTimingFields_nullCronClearsToEmpty(t *testing.T) {
 	}
 	state := HealthcheckResourceModel{
 		Cron:             types.StringValue("0 0 * * *"),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(5),
@@ -574,7 +574,7 @@ func TestApplyHealthcheckTimingFields_nullCronClearsToEmpty(t *testing.T) {
 func TestApplyHealthcheckTimingFields_changedGracePeriodIncluded(t *testing.T) {
 	plan := HealthcheckResourceModel{
 		Cron:             types.StringNull(),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(10),
@@ -582,7 +582,7 @@ func TestApplyHealthcheckTimingFields_changedGracePeriodIncluded(t *testing.T) {
 	}
 	state := HealthcheckResourceModel{
 		Cron:             types.StringNull(),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(5),
@@ -606,7 +606,7 @@ func TestApplyHealthcheckTimingFields_changedGracePeriodIncluded(t *testing.T) {
 func TestApplyHealthcheckTimingFields_noChangesReturnsFalse(t *testing.T) {
 	plan := HealthcheckResourceModel{
 		Cron:             types.StringValue("0 0 * * *"),
-		Timezone:         types.StringValue("UTC"),
+		Timezone:         NewTimezoneValue("UTC"),
 		PeriodValue:      types.Int64Null(),
 		PeriodType:       types.StringNull(),
 		GracePeriodValue: types.Int64Value(5),
@@ -628,7 +628,7 @@ func TestApplyHealthcheckTimingFields_noChangesReturnsFalse(t *testing.T) {
 func TestApplyHealthcheckTimingFields_changedPeriodValueIncluded(t *testing.T) {
 	plan := HealthcheckResourceModel{
 		Cron:             types.StringNull(),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Value(30),
 		PeriodType:       types.StringValue("minutes"),
 		GracePeriodValue: types.Int64Value(5),
@@ -636,7 +636,7 @@ func TestApplyHealthcheckTimingFields_changedPeriodValueIncluded(t *testing.T) {
 	}
 	state := HealthcheckResourceModel{
 		Cron:             types.StringNull(),
-		Timezone:         types.StringNull(),
+		Timezone:         NewTimezoneNull(),
 		PeriodValue:      types.Int64Value(15),
 		PeriodType:       types.StringValue("minutes"),
 		GracePeriodValue: types.Int64Value(5),
@@ -0,0 +1,160 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Resource identities (Terraform 1.12+) let import blocks address a resource
+// by attributes instead of a provider-specific ID string:
+//
+//	import {
+//	  to       = hyperping_monitor.api
+//	  identity = { id = "mon_abc123" }
+//	}
+//
+// Identities are set on create, read and update. Reads set them from the
+// prior state before calling the API, so state written by older provider
+// versions gains an identity on the next refresh, even when the resource
+// turns out to be gone. ImportState converts an identity back into the
+// legacy import ID so both import paths share the same validation.
+//
+// resp.Identity is nil when Terraform does not support identities, so every
+// setter tolerates a nil target.
+
+// idIdentityModel is the identity of resources addressed by one UUID.
+type idIdentityModel struct {
+	ID types.String `tfsdk:"id"`
+}
+
+// idIdentitySchema returns the identity schema for a resource addressed by
+// its UUID.
+func idIdentitySchema(description string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       description,
+			},
+		},
+	}
+}
+
+// setIDIdentity records id as the resource identity.
+func setIDIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil || id.IsNull() || id.IsUnknown() {
+		return nil
+	}
+	return identity.Set(ctx, idIdentityModel{ID: id})
+}
+
+// importIDFromIdentity returns the import ID, read from the identity
+// attribute of an import block when no ID was given.
+func importIDFromIdentity(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+	var identity idIdentityModel
+	diags.Append(req.Identity.Get(ctx, &identity)...)
+	return identity.ID.ValueString()
+}
+
+// incidentUpdateIdentityModel is the identity of hyperping_incident_update,
+// whose import ID is "incident_id/update_id".
+type incidentUpdateIdentityModel struct {
+	IncidentID types.String `tfsdk:"incident_id"`
+	UpdateID   types.String `tfsdk:"update_id"`
+}
+
+func incidentUpdateIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"incident_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "UUID of the incident the update belongs to.",
+			},
+			"update_id": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "UUID of the incident update.",
+			},
+		},
+	}
+}
+
+// setIncidentUpdateIdentity records the parts of a composite incident update
+// ID as the resource identity.
+func setIncidentUpdateIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id types.String) diag.Diagnostics {
+	if identity == nil || id.IsNull() || id.IsUnknown() {
+		return nil
+	}
+	incidentID, updateID := parseIncidentUpdateID(id.ValueString())
+	if incidentID == "" || updateID == "" {
+		return nil
+	}
+	return identity.Set(ctx, incidentUpdateIdentityModel{
+		IncidentID: types.StringValue(incidentID),
+		UpdateID:   types.StringValue(updateID),
+	})
+}
+
+// subscriberIdentityModel is the identity of hyperping_statuspage_subscriber,
+// whose import ID is "statuspage_uuid:subscriber_id".
+type subscriberIdentityModel struct {
+	StatusPageUUID types.String `tfsdk:"statuspage_uuid"`
+	ID             types.Int64  `tfsdk:"id"`
+}
+
+func subscriberIdentitySchema() identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"statuspage_uuid": identityschema.StringAttribute{
+				RequiredForImport: true,
+				Description:       "UUID of the status page the subscriber belongs to.",
+			},
+			"id": identityschema.Int64Attribute{
+				RequiredForImport: true,
+				Description:       "Numeric ID of the subscriber.",
+			},
+		},
+	}
+}
+
+// setSubscriberIdentity records the status page and subscriber IDs as the
+// resource identity.
+func setSubscriberIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, statusPageUUID types.String, id types.Int64) diag.Diagnostics {
+	if identity == nil || statusPageUUID.IsNull() || statusPageUUID.IsUnknown() || id.IsNull() || id.IsUnknown() {
+		return nil
+	}
+	return identity.Set(ctx, subscriberIdentityModel{StatusPageUUID: statusPageUUID, ID: id})
+}
+
+// incidentUpdateImportID returns the import ID, built from the identity of
+// an import block when no ID was given.
+func incidentUpdateImportID(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+	var identity incidentUpdateIdentityModel
+	diags.Append(req.Identity.Get(ctx, &identity)...)
+	return identity.IncidentID.ValueString() + "/" + identity.UpdateID.ValueString()
+}
+
+// subscriberImportID returns the import ID, built from the identity of an
+// import block when no ID was given.
+func subscriberImportID(ctx context.Context, req resource.ImportStateRequest, diags *diag.Diagnostics) string {
+	if req.ID != "" || req.Identity == nil {
+		return req.ID
+	}
+	var identity subscriberIdentityModel
+	diags.Append(req.Identity.Get(ctx, &identity)...)
+	return fmt.Sprintf("%s:%d", identity.StatusPageUUID.ValueString(), identity.ID.ValueInt64())
+}
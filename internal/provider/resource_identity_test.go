@@ -0,0 +1,167 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
+)
+
+// identityResource is a resource that supports import by identity.
+type identityResource interface {
+	resource.ResourceWithImportState
+	resource.ResourceWithIdentity
+}
+
+// importByIdentity runs r.ImportState with an import block identity and no
+// import ID, as Terraform does for `import { identity = {...} }`.
+func importByIdentity(t *testing.T, r identityResource, identity map[string]tftypes.Value) *resource.ImportStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	var identityResp resource.IdentitySchemaResponse
+	r.IdentitySchema(ctx, resource.IdentitySchemaRequest{}, &identityResp)
+
+	identityType := identityResp.IdentitySchema.Type().TerraformType(ctx)
+	req := resource.ImportStateRequest{
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identityResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identityType, identity),
+		},
+	}
+	resp := &resource.ImportStateResponse{
+		State: tfsdk.State{
+			Schema: schemaResp.Schema,
+			Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+		},
+		Identity: &tfsdk.ResourceIdentity{
+			Schema: identityResp.IdentitySchema,
+			Raw:    tftypes.NewValue(identityType, identity),
+		},
+	}
+	r.ImportState(ctx, req, resp)
+	return resp
+}
+
+func TestImportState_identity(t *testing.T) {
+	simple := map[string]identityResource{
+		"hyperping_monitor":     &MonitorResource{},
+		"hyperping_healthcheck": &HealthcheckResource{},
+		"hyperping_incident":    &IncidentResource{},
+		"hyperping_maintenance": &MaintenanceResource{},
+		"hyperping_outage":      &OutageResource{},
+		"hyperping_statuspage":  &StatusPageResource{},
+	}
+	for name, r := range simple {
+		t.Run(name, func(t *testing.T) {
+			resp := importByIdentity(t, r, map[string]tftypes.Value{
+				"id": tftypes.NewValue(tftypes.String, "res_abc123"),
+			})
+			if resp.Diagnostics.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+			}
+			var id types.String
+			resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &id)...)
+			if id.ValueString() != "res_abc123" {
+				t.Errorf("id = %s, want res_abc123", id)
+			}
+		})
+	}
+
+	t.Run("invalid id", func(t *testing.T) {
+		resp := importByIdentity(t, &MonitorResource{}, map[string]tftypes.Value{
+			"id": tftypes.NewValue(tftypes.String, "../monitors"),
+		})
+		if !resp.Diagnostics.HasError() {
+			t.Error("expected the identity ID to be validated like an import ID")
+		}
+	})
+
+	t.Run("hyperping_incident_update", func(t *testing.T) {
+		resp := importByIdentity(t, &IncidentUpdateResource{}, map[string]tftypes.Value{
+			"incident_id": tftypes.NewValue(tftypes.String, "inci_abc123"),
+			"update_id":   tftypes.NewValue(tftypes.String, "upd_def456"),
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		var id, incidentID types.String
+		resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &id)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("incident_id"), &incidentID)...)
+		if id.ValueString() != "inci_abc123/upd_def456" || incidentID.ValueString() != "inci_abc123" {
+			t.Errorf("id = %s, incident_id = %s", id, incidentID)
+		}
+	})
+
+	t.Run("hyperping_statuspage_subscriber", func(t *testing.T) {
+		resp := importByIdentity(t, &StatusPageSubscriberResource{}, map[string]tftypes.Value{
+			"statuspage_uuid": tftypes.NewValue(tftypes.String, "sp_abc123"),
+			"id":              tftypes.NewValue(tftypes.Number, 42),
+		})
+		if resp.Diagnostics.HasError() {
+			t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+		}
+		var statusPageUUID types.String
+		var id types.Int64
+		resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("statuspage_uuid"), &statusPageUUID)...)
+		resp.Diagnostics.Append(resp.State.GetAttribute(context.Background(), path.Root("id"), &id)...)
+		if statusPageUUID.ValueString() != "sp_abc123" || id.ValueInt64() != 42 {
+			t.Errorf("statuspage_uuid = %s, id = %s", statusPageUUID, id)
+		}
+	})
+}
+
+func TestSetIdentity_nilIdentity(t *testing.T) {
+	ctx := context.Background()
+	if diags := setIDIdentity(ctx, nil, types.StringValue("mon_abc123")); diags.HasError() {
+		t.Errorf("setIDIdentity: %v", diags)
+	}
+	if diags := setIncidentUpdateIdentity(ctx, nil, types.StringValue("inci_1/upd_2")); diags.HasError() {
+		t.Errorf("setIncidentUpdateIdentity: %v", diags)
+	}
+	if diags := setSubscriberIdentity(ctx, nil, types.StringValue("sp_abc123"), types.Int64Value(1)); diags.HasError() {
+		t.Errorf("setSubscriberIdentity: %v", diags)
+	}
+}
+
+func TestAccMonitorResource_identity(t *testing.T) {
+	server := newMockHyperpingServer(t)
+	defer server.Close()
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_12_0),
+		},
+		Steps: []tfresource.TestStep{
+			{
+				Config:           testAccMonitorResourceConfigBasic(server.URL, "test-identity"),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("hyperping_monitor.test", map[string]knownvalue.Check{
+						"id": knownvalue.NotNull(),
+					}),
+					statecheck.ExpectIdentityValueMatchesState("hyperping_monitor.test", tfjsonpath.New("id")),
+				},
+			},
+			// Import with an identity import block and expect no changes
+			testutil.NoOpIdentityImportStep("hyperping_monitor.test"),
+		},
+	})
+}
@@ -0,0 +1,154 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// Retry policies, set by the provider's retry_policy attribute. They decide
+// which failed requests are sent again.
+//
+// hyperping-go retries GET, PUT, and DELETE requests on network errors, 429,
+// and 5xx responses, and never retries POST or PATCH: a create or partial
+// update that failed ambiguously may already have been applied, and sending
+// it again can create a duplicate monitor or apply the update twice. The
+// provider layers mutationRetryTransport on top for the POST and PATCH
+// failures the policy allows.
+const (
+	// retryPolicySafe retries POST and PATCH only when the API cannot have
+	// processed the request: the connection failed before the request was
+	// sent, or the API answered 429 Too Many Requests, which it sends before
+	// handling the request.
+	retryPolicySafe = "safe"
+	// retryPolicyAll also retries POST and PATCH on 5xx responses and on
+	// network errors after the request was sent, like the other methods.
+	// A retried create can then leave a duplicate resource behind.
+	retryPolicyAll = "all"
+	// retryPolicyNone sends every request once.
+	retryPolicyNone = "none"
+)
+
+// mutationRetryTransport retries the POST and PATCH requests hyperping-go
+// does not, as allowed by the retry policy. Other methods pass through: the
+// client already retries them. It wraps the transport chain hyperping-go
+// builds, so each attempt keeps the TLS hardening and authentication.
+type mutationRetryTransport struct {
+	next       http.RoundTripper
+	policy     string
+	maxRetries int
+	waitMin    time.Duration
+	waitMax    time.Duration
+	stats      *clientStats
+	sleep      func(ctx context.Context, d time.Duration) error
+}
+
+func newMutationRetryTransport(next http.RoundTripper, policy string, stats *clientStats) *mutationRetryTransport {
+	return &mutationRetryTransport{
+		next:       next,
+		policy:     policy,
+		maxRetries: hyperping.DefaultMaxRetries,
+		waitMin:    hyperping.DefaultRetryWaitMin,
+		waitMax:    hyperping.DefaultRetryWaitMax,
+		stats:      stats,
+		sleep:      sleepContext,
+	}
+}
+
+func (t *mutationRetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if req.Method != http.MethodPost && req.Method != http.MethodPatch {
+		return t.next.RoundTrip(req)
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq := req
+		if attempt > 0 {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(req.Context())
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !t.retryable(resp, err) || (req.Body != nil && req.GetBody == nil) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10)) //nolint:errcheck // #nosec G104 -- drained only to reuse the connection
+			_ = resp.Body.Close()                                         //nolint:errcheck // #nosec G104 -- the response is discarded
+		}
+		if t.stats != nil {
+			t.stats.RecordRetry(req.Context(), req.Method, req.URL.Path, attempt+1)
+		}
+		if err := t.sleep(req.Context(), wait); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// retryable reports whether the policy allows sending a POST or PATCH again
+// after the given outcome.
+func (t *mutationRetryTransport) retryable(resp *http.Response, err error) bool {
+	if err != nil {
+		if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+			return false
+		}
+		return t.policy == retryPolicyAll || requestNotSent(err)
+	}
+	switch resp.StatusCode {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return t.policy == retryPolicyAll
+	}
+	return false
+}
+
+// backoff returns how long to wait before the next attempt: the Retry-After
+// of a 429 response when it has one, and exponential backoff otherwise, both
+// capped at waitMax.
+func (t *mutationRetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	wait := t.waitMin << min(attempt, 16)
+	if resp != nil && resp.StatusCode == http.StatusTooManyRequests {
+		if seconds, err := strconv.Atoi(strings.TrimSpace(resp.Header.Get("Retry-After"))); err == nil && seconds > 0 {
+			wait = time.Duration(seconds) * time.Second
+		}
+	}
+	if wait <= 0 || wait > t.waitMax {
+		wait = t.waitMax
+	}
+	return wait
+}
+
+// requestNotSent reports whether err happened while connecting, before any
+// of the request was written, so the API never saw it.
+func requestNotSent(err error) bool {
+	var opErr *net.OpError
+	return errors.As(err, &opErr) && opErr.Op == "dial"
+}
+
+// sleepContext waits for d, or returns the context error if ctx ends first.
+func sleepContext(ctx context.Context, d time.Duration) error {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
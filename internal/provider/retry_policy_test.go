@@ -0,0 +1,162 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+)
+
+// scriptedTransport answers each attempt with the next outcome and records
+// the bodies it was sent.
+type scriptedTransport struct {
+	outcomes []func() (*http.Response, error)
+	bodies   []string
+}
+
+func (s *scriptedTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	body := ""
+	if req.Body != nil {
+		b, _ := io.ReadAll(req.Body) //nolint:errcheck // test transport
+		body = string(b)
+	}
+	s.bodies = append(s.bodies, body)
+	outcome := s.outcomes[min(len(s.bodies), len(s.outcomes))-1]
+	return outcome()
+}
+
+func respondWith(code int) func() (*http.Response, error) {
+	return func() (*http.Response, error) {
+		return &http.Response{StatusCode: code, Header: http.Header{}, Body: io.NopCloser(strings.NewReader("{}"))}, nil
+	}
+}
+
+func failWith(err error) func() (*http.Response, error) {
+	return func() (*http.Response, error) { return nil, err }
+}
+
+var (
+	errDial = &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+	errRead = &net.OpError{Op: "read", Net: "tcp", Err: errors.New("connection reset by peer")}
+)
+
+func TestMutationRetryTransport(t *testing.T) {
+	tests := []struct {
+		name         string
+		policy       string
+		method       string
+		outcomes     []func() (*http.Response, error)
+		wantAttempts int
+		wantStatus   int
+		wantErr      bool
+	}{
+		{name: "safe retries POST on 429", policy: retryPolicySafe, method: http.MethodPost,
+			outcomes: []func() (*http.Response, error){respondWith(429), respondWith(201)}, wantAttempts: 2, wantStatus: 201},
+		{name: "safe retries PATCH on dial error", policy: retryPolicySafe, method: http.MethodPatch,
+			outcomes: []func() (*http.Response, error){failWith(errDial), respondWith(200)}, wantAttempts: 2, wantStatus: 200},
+		{name: "safe does not retry POST on 500", policy: retryPolicySafe, method: http.MethodPost,
+			outcomes: []func() (*http.Response, error){respondWith(500), respondWith(201)}, wantAttempts: 1, wantStatus: 500},
+		{name: "safe does not retry POST after the request was sent", policy: retryPolicySafe, method: http.MethodPost,
+			outcomes: []func() (*http.Response, error){failWith(errRead), respondWith(201)}, wantAttempts: 1, wantErr: true},
+		{name: "all retries POST on 503", policy: retryPolicyAll, method: http.MethodPost,
+			outcomes: []func() (*http.Response, error){respondWith(503), respondWith(201)}, wantAttempts: 2, wantStatus: 201},
+		{name: "all retries POST after the request was sent", policy: retryPolicyAll, method: http.MethodPost,
+			outcomes: []func() (*http.Response, error){failWith(errRead), respondWith(201)}, wantAttempts: 2, wantStatus: 201},
+		{name: "client errors are not retried", policy: retryPolicyAll, method: http.MethodPost,
+			outcomes: []func() (*http.Response, error){respondWith(400), respondWith(201)}, wantAttempts: 1, wantStatus: 400},
+		{name: "gives up after max retries", policy: retryPolicySafe, method: http.MethodPost,
+			outcomes: []func() (*http.Response, error){respondWith(429)}, wantAttempts: 4, wantStatus: 429},
+		{name: "GET is left to the client", policy: retryPolicyAll, method: http.MethodGet,
+			outcomes: []func() (*http.Response, error){respondWith(503), respondWith(200)}, wantAttempts: 1, wantStatus: 503},
+		{name: "canceled requests are not retried", policy: retryPolicyAll, method: http.MethodPost,
+			outcomes: []func() (*http.Response, error){failWith(context.Canceled), respondWith(201)}, wantAttempts: 1, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			next := &scriptedTransport{outcomes: tt.outcomes}
+			var waits []time.Duration
+			transport := newMutationRetryTransport(next, tt.policy, newClientStats())
+			transport.sleep = func(_ context.Context, d time.Duration) error {
+				waits = append(waits, d)
+				return nil
+			}
+
+			var body io.Reader
+			if tt.method != http.MethodGet {
+				body = strings.NewReader(`{"name":"api"}`)
+			}
+			req, err := http.NewRequestWithContext(context.Background(), tt.method, "https://api.hyperping.io/v1/monitors", body)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			resp, err := transport.RoundTrip(req)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RoundTrip() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if resp != nil {
+				defer resp.Body.Close()
+				if resp.StatusCode != tt.wantStatus {
+					t.Errorf("status = %d, want %d", resp.StatusCode, tt.wantStatus)
+				}
+			}
+			if len(next.bodies) != tt.wantAttempts {
+				t.Fatalf("attempts = %d, want %d", len(next.bodies), tt.wantAttempts)
+			}
+			if len(waits) != tt.wantAttempts-1 {
+				t.Errorf("waits = %v, want %d", waits, tt.wantAttempts-1)
+			}
+			for i, b := range next.bodies {
+				if body != nil && b != `{"name":"api"}` {
+					t.Errorf("attempt %d body = %q, want the original body", i+1, b)
+				}
+			}
+		})
+	}
+}
+
+func TestMutationRetryTransport_Backoff(t *testing.T) {
+	transport := newMutationRetryTransport(http.DefaultTransport, retryPolicySafe, nil)
+
+	retryAfter := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"5"}}}
+	if got := transport.backoff(0, retryAfter); got != 5*time.Second {
+		t.Errorf("backoff with Retry-After = %v, want 5s", got)
+	}
+	tooLong := &http.Response{StatusCode: http.StatusTooManyRequests, Header: http.Header{"Retry-After": []string{"3600"}}}
+	if got := transport.backoff(0, tooLong); got != transport.waitMax {
+		t.Errorf("backoff with long Retry-After = %v, want %v", got, transport.waitMax)
+	}
+	if got := transport.backoff(2, nil); got != 4*transport.waitMin {
+		t.Errorf("backoff(2) = %v, want %v", got, 4*transport.waitMin)
+	}
+	if got := transport.backoff(40, nil); got != transport.waitMax {
+		t.Errorf("backoff(40) = %v, want %v", got, transport.waitMax)
+	}
+}
+
+func TestMutationRetryTransport_StopsWhenContextEnds(t *testing.T) {
+	next := &scriptedTransport{outcomes: []func() (*http.Response, error){respondWith(429)}}
+	transport := newMutationRetryTransport(next, retryPolicySafe, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "https://api.hyperping.io/v1/monitors", strings.NewReader("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := transport.RoundTrip(req); !errors.Is(err, context.Canceled) {
+		t.Errorf("RoundTrip() error = %v, want context.Canceled", err)
+	}
+	if len(next.bodies) != 1 {
+		t.Errorf("attempts = %d, want 1", len(next.bodies))
+	}
+}
@@ -0,0 +1,279 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+const (
+	// hyperpingStatusPageURL is Hyperping's public status page.
+	hyperpingStatusPageURL = "https://status.hyperping.app"
+
+	// serviceStatusTimeout bounds the probe request.
+	serviceStatusTimeout = 10 * time.Second
+)
+
+// Service status values reported by hyperping_service_status.
+const (
+	serviceStatusOperational  = "operational"
+	serviceStatusRateLimited  = "rate_limited"
+	serviceStatusUnauthorized = "unauthorized"
+	serviceStatusDegraded     = "degraded"
+	serviceStatusOutage       = "outage"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &ServiceStatusDataSource{}
+var _ datasource.DataSourceWithConfigure = &ServiceStatusDataSource{}
+
+// NewServiceStatusDataSource creates a new Hyperping service status data source.
+func NewServiceStatusDataSource() datasource.DataSource {
+	return &ServiceStatusDataSource{}
+}
+
+// statusPageLister is the API call used to probe the Hyperping API.
+type statusPageLister interface {
+	ListStatusPages(ctx context.Context, page *int, search *string) (*hyperping.StatusPagePaginatedResponse, error)
+}
+
+// ServiceStatusDataSource reports whether the Hyperping API is healthy. It
+// sends one lightweight request through a client without retries or a
+// circuit breaker, so an outage is reported at once with a clear message
+// instead of surfacing as retried 5xx errors on the next resource.
+type ServiceStatusDataSource struct {
+	client statusPageLister
+	now    func() time.Time
+	// rateLimit returns the quota reported by the latest API response.
+	rateLimit func() (rateLimitStatus, bool)
+}
+
+// ServiceStatusDataSourceModel describes the data source data model.
+type ServiceStatusDataSourceModel struct {
+	FailOnUnhealthy    types.Bool   `tfsdk:"fail_on_unhealthy"`
+	Healthy            types.Bool   `tfsdk:"healthy"`
+	Status             types.String `tfsdk:"status"`
+	Message            types.String `tfsdk:"message"`
+	HTTPStatus         types.Int64  `tfsdk:"http_status"`
+	LatencyMs          types.Int64  `tfsdk:"latency_ms"`
+	StatusPageURL      types.String `tfsdk:"status_page_url"`
+	CheckedAt          types.String `tfsdk:"checked_at"`
+	RateLimitLimit     types.Int64  `tfsdk:"rate_limit_limit"`
+	RateLimitRemaining types.Int64  `tfsdk:"rate_limit_remaining"`
+	RateLimitReset     types.String `tfsdk:"rate_limit_reset"`
+}
+
+// Metadata returns the data source type name.
+func (d *ServiceStatusDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_service_status"
+}
+
+// Schema defines the schema for the data source.
+func (d *ServiceStatusDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Checks that the Hyperping API is healthy, so pipelines can gate applies on it and get a clear " +
+			"message when Hyperping itself is having an incident. The data source sends one lightweight authenticated " +
+			"request (the first page of status pages) to the configured `base_url` without retries. Hyperping's public " +
+			"status page has no machine-readable endpoint, so its URL is returned for operators to follow up.",
+
+		Attributes: map[string]schema.Attribute{
+			"fail_on_unhealthy": schema.BoolAttribute{
+				MarkdownDescription: "When `true`, the read fails with the status message if `healthy` is `false`, " +
+					"stopping the plan before any resource is refreshed. Defaults to `false`.",
+				Optional: true,
+			},
+			"healthy": schema.BoolAttribute{
+				MarkdownDescription: "Whether the Hyperping API is serving requests. `true` for the `operational`, " +
+					"`rate_limited`, and `unauthorized` statuses, where the API answered as designed.",
+				Computed: true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "One of `operational`, `rate_limited` (the account is being throttled), " +
+					"`unauthorized` (the API key was rejected), `degraded` (an unexpected client error), or `outage` " +
+					"(a 5xx response, timeout, or connection failure).",
+				Computed: true,
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "Human-readable explanation of `status` with next steps.",
+				Computed:            true,
+			},
+			"http_status": schema.Int64Attribute{
+				MarkdownDescription: "HTTP status code of the probe response, or `0` when no response was received.",
+				Computed:            true,
+			},
+			"latency_ms": schema.Int64Attribute{
+				MarkdownDescription: "Round-trip time of the probe request in milliseconds.",
+				Computed:            true,
+			},
+			"status_page_url": schema.StringAttribute{
+				MarkdownDescription: "Hyperping's public status page, for incident details.",
+				Computed:            true,
+			},
+			"checked_at": schema.StringAttribute{
+				MarkdownDescription: "When the probe was sent, in RFC 3339 format.",
+				Computed:            true,
+			},
+			"rate_limit_limit": schema.Int64Attribute{
+				MarkdownDescription: "Request quota of the current rate limit window, from the `X-RateLimit-Limit` " +
+					"header of the probe response. Null when the API did not report it.",
+				Computed: true,
+			},
+			"rate_limit_remaining": schema.Int64Attribute{
+				MarkdownDescription: "Requests left in the current rate limit window, from the `X-RateLimit-Remaining` " +
+					"header of the probe response. Null when the API did not report it.",
+				Computed: true,
+			},
+			"rate_limit_reset": schema.StringAttribute{
+				MarkdownDescription: "When the rate limit window resets, in RFC 3339 format, from the `X-RateLimit-Reset` " +
+					"header of the probe response. Null when the API did not report it.",
+				Computed: true,
+			},
+		},
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *ServiceStatusDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	clients, ok := req.ProviderData.(*hyperpingClients)
+	if !ok {
+		resp.Diagnostics.Append(newUnexpectedConfigTypeError("*hyperpingClients", req.ProviderData))
+		return
+	}
+
+	d.client = clients.Probe
+	if d.client == nil {
+		d.client = clients.restAPI()
+	}
+	if clients.probeStats != nil {
+		d.rateLimit = clients.probeStats.RateLimitStatus
+	}
+}
+
+// Read probes the Hyperping API and reports its status.
+func (d *ServiceStatusDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var model ServiceStatusDataSourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &model)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	now := time.Now
+	if d.now != nil {
+		now = d.now
+	}
+
+	probeCtx, cancel := context.WithTimeout(ctx, serviceStatusTimeout)
+	defer cancel()
+
+	start := now()
+	page := 0
+	_, err := d.client.ListStatusPages(probeCtx, &page, nil)
+	latency := now().Sub(start)
+
+	result := classifyServiceStatus(err)
+	model.Healthy = types.BoolValue(result.healthy)
+	model.Status = types.StringValue(result.status)
+	model.Message = types.StringValue(result.message)
+	model.HTTPStatus = types.Int64Value(int64(result.httpStatus))
+	model.LatencyMs = types.Int64Value(latency.Milliseconds())
+	model.StatusPageURL = types.StringValue(hyperpingStatusPageURL)
+	model.CheckedAt = types.StringValue(start.UTC().Format(time.RFC3339))
+	model.RateLimitLimit, model.RateLimitRemaining, model.RateLimitReset = d.rateLimitValues()
+
+	if !result.healthy && model.FailOnUnhealthy.ValueBool() {
+		resp.Diagnostics.AddError("Hyperping Unavailable", result.message)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &model)...)
+}
+
+// rateLimitValues returns the rate_limit_* attributes, null for any value the
+// latest probe response did not report.
+func (d *ServiceStatusDataSource) rateLimitValues() (types.Int64, types.Int64, types.String) {
+	limit, remaining, reset := types.Int64Null(), types.Int64Null(), types.StringNull()
+	if d.rateLimit == nil {
+		return limit, remaining, reset
+	}
+	status, ok := d.rateLimit()
+	if !ok {
+		return limit, remaining, reset
+	}
+	remaining = types.Int64Value(int64(status.Remaining))
+	if status.Limit >= 0 {
+		limit = types.Int64Value(int64(status.Limit))
+	}
+	if !status.Reset.IsZero() {
+		reset = types.StringValue(status.Reset.UTC().Format(time.RFC3339))
+	}
+	return limit, remaining, reset
+}
+
+// serviceStatusResult is the outcome of a probe request.
+type serviceStatusResult struct {
+	status     string
+	healthy    bool
+	httpStatus int
+	message    string
+}
+
+// classifyServiceStatus maps the probe error to a service status.
+func classifyServiceStatus(err error) serviceStatusResult {
+	if err == nil {
+		return serviceStatusResult{
+			status:     serviceStatusOperational,
+			healthy:    true,
+			httpStatus: 200,
+			message:    "The Hyperping API is responding normally.",
+		}
+	}
+
+	var apiErr *hyperping.APIError
+	if !errors.As(err, &apiErr) {
+		return serviceStatusResult{
+			status: serviceStatusOutage,
+			message: fmt.Sprintf("The Hyperping API could not be reached: %s. Check %s for an ongoing incident, "+
+				"and the network and proxy settings of this machine.", redactSecrets(err.Error()), hyperpingStatusPageURL),
+		}
+	}
+
+	result := serviceStatusResult{httpStatus: apiErr.StatusCode}
+	switch code := apiErr.StatusCode; {
+	case code >= 500:
+		result.status = serviceStatusOutage
+		result.message = fmt.Sprintf("The Hyperping API returned HTTP %d. Hyperping may be having an incident: "+
+			"check %s before retrying.", code, hyperpingStatusPageURL)
+	case code == 429:
+		result.status = serviceStatusRateLimited
+		result.healthy = true
+		result.message = "The Hyperping API is up but is rate limiting this account. Reduce parallelism " +
+			"(terraform apply -parallelism=1) or retry later."
+		if apiErr.RetryAfter > 0 {
+			result.message = fmt.Sprintf("%s Retry after %d seconds.", result.message, apiErr.RetryAfter)
+		}
+	case code == 401 || code == 403:
+		result.status = serviceStatusUnauthorized
+		result.healthy = true
+		result.message = fmt.Sprintf("The Hyperping API is up but rejected the API key (HTTP %d). Check the api_key "+
+			"provider attribute or the HYPERPING_API_KEY environment variable.", code)
+	default:
+		result.status = serviceStatusDegraded
+		result.message = fmt.Sprintf("The Hyperping API returned an unexpected HTTP %d: %s. Check %s for an ongoing "+
+			"incident.", code, redactSecrets(apiErr.Message), hyperpingStatusPageURL)
+	}
+	return result
+}
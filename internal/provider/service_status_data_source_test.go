@@ -0,0 +1,275 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestClassifyServiceStatus(t *testing.T) {
+	tests := []struct {
+		name        string
+		err         error
+		wantStatus  string
+		wantHealthy bool
+		wantHTTP    int
+		wantMessage string
+	}{
+		{name: "ok", wantStatus: serviceStatusOperational, wantHealthy: true, wantHTTP: 200},
+		{
+			name:        "server error",
+			err:         hyperping.NewAPIError(503, "service unavailable"),
+			wantStatus:  serviceStatusOutage,
+			wantHTTP:    503,
+			wantMessage: hyperpingStatusPageURL,
+		},
+		{
+			name:        "network error",
+			err:         fmt.Errorf("request failed: %w", errors.New("dial tcp: connection refused")),
+			wantStatus:  serviceStatusOutage,
+			wantMessage: "connection refused",
+		},
+		{
+			name:        "rate limited",
+			err:         hyperping.NewRateLimitError(30),
+			wantStatus:  serviceStatusRateLimited,
+			wantHealthy: true,
+			wantHTTP:    429,
+			wantMessage: "Retry after 30 seconds",
+		},
+		{
+			name:        "bad key",
+			err:         hyperping.NewAPIError(401, "unauthorized"),
+			wantStatus:  serviceStatusUnauthorized,
+			wantHealthy: true,
+			wantHTTP:    401,
+			wantMessage: "HYPERPING_API_KEY",
+		},
+		{
+			name:       "unexpected client error",
+			err:        hyperping.NewAPIError(404, "not found"),
+			wantStatus: serviceStatusDegraded,
+			wantHTTP:   404,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifyServiceStatus(tt.err)
+			if got.status != tt.wantStatus || got.healthy != tt.wantHealthy || got.httpStatus != tt.wantHTTP {
+				t.Errorf("classifyServiceStatus() = %+v, want status %s, healthy %v, HTTP %d", got, tt.wantStatus, tt.wantHealthy, tt.wantHTTP)
+			}
+			if !strings.Contains(got.message, tt.wantMessage) {
+				t.Errorf("message = %q, want it to contain %q", got.message, tt.wantMessage)
+			}
+		})
+	}
+}
+
+// stubStatusPageLister returns err, or an empty page when err is nil.
+type stubStatusPageLister struct{ err error }
+
+func (s stubStatusPageLister) ListStatusPages(_ context.Context, _ *int, _ *string) (*hyperping.StatusPagePaginatedResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &hyperping.StatusPagePaginatedResponse{}, nil
+}
+
+func TestClassifyServiceStatus_RedactsSecrets(t *testing.T) {
+	got := classifyServiceStatus(errors.New("Authorization: Bearer sk_live_secret rejected by proxy"))
+	if strings.Contains(got.message, "sk_live_secret") {
+		t.Errorf("message leaks the API key: %s", got.message)
+	}
+}
+
+func TestServiceStatusDataSource_Read(t *testing.T) {
+	ctx := context.Background()
+	ds := &ServiceStatusDataSource{}
+	schemaResp := &datasource.SchemaResponse{}
+	ds.Schema(ctx, datasource.SchemaRequest{}, schemaResp)
+
+	var rateLimit func() (rateLimitStatus, bool)
+	read := func(client statusPageLister, failOnUnhealthy bool) (*datasource.ReadResponse, ServiceStatusDataSourceModel) {
+		t.Helper()
+		start := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+		calls := 0
+		ds := &ServiceStatusDataSource{client: client, rateLimit: rateLimit, now: func() time.Time {
+			calls++
+			return start.Add(time.Duration(calls-1) * 250 * time.Millisecond)
+		}}
+
+		objType := schemaResp.Schema.Type().TerraformType(ctx).(tftypes.Object)
+		vals := make(map[string]tftypes.Value)
+		for name, typ := range objType.AttributeTypes {
+			vals[name] = tftypes.NewValue(typ, nil)
+		}
+		vals["fail_on_unhealthy"] = tftypes.NewValue(tftypes.Bool, failOnUnhealthy)
+
+		req := datasource.ReadRequest{Config: tfsdk.Config{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, vals)}}
+		resp := &datasource.ReadResponse{State: tfsdk.State{Schema: schemaResp.Schema, Raw: tftypes.NewValue(objType, nil)}}
+		ds.Read(ctx, req, resp)
+
+		var model ServiceStatusDataSourceModel
+		if !resp.Diagnostics.HasError() {
+			resp.Diagnostics.Append(resp.State.Get(ctx, &model)...)
+		}
+		return resp, model
+	}
+
+	resp, model := read(stubStatusPageLister{}, true)
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if !model.Healthy.ValueBool() || model.LatencyMs.ValueInt64() != 250 || model.CheckedAt.ValueString() != "2026-03-01T12:00:00Z" {
+		t.Errorf("model = %+v", model)
+	}
+	if !model.RateLimitRemaining.IsNull() || !model.RateLimitLimit.IsNull() || !model.RateLimitReset.IsNull() {
+		t.Errorf("rate limit attributes should be null without rate limit headers, got %+v", model)
+	}
+
+	rateLimit = func() (rateLimitStatus, bool) {
+		return rateLimitStatus{Limit: -1, Remaining: 42, Reset: time.Date(2026, 3, 1, 12, 1, 0, 0, time.UTC)}, true
+	}
+	_, model = read(stubStatusPageLister{}, false)
+	if model.RateLimitRemaining.ValueInt64() != 42 || !model.RateLimitLimit.IsNull() || model.RateLimitReset.ValueString() != "2026-03-01T12:01:00Z" {
+		t.Errorf("rate limit attributes = %v/%v/%v, want 42/null/2026-03-01T12:01:00Z",
+			model.RateLimitRemaining, model.RateLimitLimit, model.RateLimitReset)
+	}
+	rateLimit = nil
+
+	_, model = read(stubStatusPageLister{err: hyperping.NewAPIError(502, "bad gateway")}, false)
+	if model.Healthy.ValueBool() || model.Status.ValueString() != serviceStatusOutage {
+		t.Errorf("outage without fail_on_unhealthy should be reported in state, got %+v", model)
+	}
+
+	resp, _ = read(stubStatusPageLister{err: hyperping.NewAPIError(502, "bad gateway")}, true)
+	if !resp.Diagnostics.HasError() || !strings.Contains(resp.Diagnostics.Errors()[0].Detail(), hyperpingStatusPageURL) {
+		t.Errorf("expected a Hyperping Unavailable error, got %v", resp.Diagnostics)
+	}
+}
+
+func TestNewProbeClient(t *testing.T) {
+	var requests int
+	var auth, userAgent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		auth, userAgent = r.Header.Get("Authorization"), r.Header.Get("User-Agent")
+		w.Header().Set(hyperping.HeaderContentType, hyperping.ContentTypeJSON)
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", "599")
+		json.NewEncoder(w).Encode(map[string]interface{}{"statuspages": []interface{}{}, "hasNextPage": false}) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	stats := newClientStats()
+	client, diags := newProbeClient("sk_test_key", server.URL, "test", "terraform-provider-hyperping/test", transportConfig{}, stats)
+	if diags.HasError() {
+		t.Fatalf("newProbeClient() diagnostics = %v", diags)
+	}
+
+	page := 0
+	if _, err := client.ListStatusPages(context.Background(), &page, nil); err != nil {
+		t.Fatalf("ListStatusPages() error = %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("requests = %d, want 1", requests)
+	}
+	if auth != "Bearer sk_test_key" {
+		t.Errorf("Authorization = %q", auth)
+	}
+	if !strings.HasSuffix(userAgent, " terraform-provider-hyperping/test") {
+		t.Errorf("User-Agent = %q, want the provider product token appended", userAgent)
+	}
+	if status, ok := stats.RateLimitStatus(); !ok || status.Limit != 600 || status.Remaining != 599 {
+		t.Errorf("probe stats rate limit = %+v (%v), want the probe response headers", status, ok)
+	}
+}
+
+func TestAccServiceStatusDataSource(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != hyperping.StatuspagesBasePath {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Header().Set(hyperping.HeaderContentType, hyperping.ContentTypeJSON)
+		w.Header().Set("X-RateLimit-Limit", "600")
+		w.Header().Set("X-RateLimit-Remaining", "599")
+		json.NewEncoder(w).Encode(map[string]interface{}{"statuspages": []interface{}{}, "hasNextPage": false}) //nolint:errcheck
+	}))
+	defer server.Close()
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccServiceStatusDataSourceConfig(server.URL, true),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.hyperping_service_status.test", "healthy", "true"),
+					tfresource.TestCheckResourceAttr("data.hyperping_service_status.test", "status", serviceStatusOperational),
+					tfresource.TestCheckResourceAttr("data.hyperping_service_status.test", "http_status", "200"),
+					tfresource.TestCheckResourceAttr("data.hyperping_service_status.test", "status_page_url", hyperpingStatusPageURL),
+					tfresource.TestCheckResourceAttrSet("data.hyperping_service_status.test", "checked_at"),
+					tfresource.TestCheckResourceAttr("data.hyperping_service_status.test", "rate_limit_limit", "600"),
+					tfresource.TestCheckResourceAttr("data.hyperping_service_status.test", "rate_limit_remaining", "599"),
+					tfresource.TestCheckNoResourceAttr("data.hyperping_service_status.test", "rate_limit_reset"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccServiceStatusDataSource_outage(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer server.Close()
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccServiceStatusDataSourceConfig(server.URL, false),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("data.hyperping_service_status.test", "healthy", "false"),
+					tfresource.TestCheckResourceAttr("data.hyperping_service_status.test", "status", serviceStatusOutage),
+					tfresource.TestCheckResourceAttr("data.hyperping_service_status.test", "http_status", "503"),
+				),
+			},
+			{
+				Config:      testAccServiceStatusDataSourceConfig(server.URL, true),
+				ExpectError: regexp.MustCompile(`Hyperping Unavailable`),
+			},
+		},
+	})
+}
+
+func testAccServiceStatusDataSourceConfig(baseURL string, failOnUnhealthy bool) string {
+	return fmt.Sprintf(`
+provider "hyperping" {
+  api_key  = "test_api_key"
+  base_url = %[1]q
+}
+
+data "hyperping_service_status" "test" {
+  fail_on_unhealthy = %[2]t
+}
+`, baseURL, failOnUnhealthy)
+}
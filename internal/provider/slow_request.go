@@ -0,0 +1,136 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// defaultSlowRequestThreshold is how long an API request may take before it
+// is logged as slow, unless slow_request_threshold says otherwise.
+const defaultSlowRequestThreshold = 5 * time.Second
+
+// parseSlowRequestThreshold parses a slow_request_threshold value. An empty
+// value selects the default and zero disables the warnings.
+func parseSlowRequestThreshold(value string) (time.Duration, error) {
+	if value == "" {
+		return defaultSlowRequestThreshold, nil
+	}
+	d, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("must be a duration such as \"5s\" or \"1m\", got %q", value)
+	}
+	if d < 0 {
+		return 0, fmt.Errorf("must not be negative, got %q", value)
+	}
+	return d, nil
+}
+
+// slowRequestTransport logs a warning for every request attempt that takes
+// longer than the threshold, so a crawling apply can be traced to Hyperping
+// API latency rather than the provider. The warning carries the method,
+// path, status, duration, and how many times the request had been retried.
+//
+// Retries are counted per context, method, and path: both hyperping-go and
+// mutationRetryTransport send a retry with the context of the first attempt.
+// It sits below mutationRetryTransport so each retried create is timed on
+// its own.
+type slowRequestTransport struct {
+	next      http.RoundTripper
+	threshold time.Duration
+	stats     *clientStats
+	now       func() time.Time
+	warn      func(ctx context.Context, msg string, fields ...map[string]interface{})
+
+	mu       sync.Mutex
+	attempts map[slowRequestKey]int
+}
+
+type slowRequestKey struct {
+	ctx    context.Context
+	method string
+	path   string
+}
+
+func newSlowRequestTransport(next http.RoundTripper, threshold time.Duration, stats *clientStats) *slowRequestTransport {
+	return &slowRequestTransport{
+		next:      next,
+		threshold: threshold,
+		stats:     stats,
+		now:       time.Now,
+		warn:      tflog.Warn,
+		attempts:  make(map[slowRequestKey]int),
+	}
+}
+
+func (t *slowRequestTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	key := slowRequestKey{ctx: req.Context(), method: req.Method, path: req.URL.Path}
+	retries := t.startAttempt(key)
+
+	start := t.now()
+	resp, err := t.next.RoundTrip(req)
+	duration := t.now().Sub(start)
+
+	if err != nil || resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500 {
+		// A retry may follow with the same context.
+		t.keepAttempts(key)
+	} else {
+		t.finishAttempts(key)
+	}
+
+	if duration < t.threshold {
+		return resp, err
+	}
+
+	if t.stats != nil {
+		t.stats.slowRequests.Add(1)
+	}
+	fields := map[string]interface{}{
+		"method":       req.Method,
+		"path":         req.URL.Path,
+		"duration":     duration.Round(time.Millisecond).String(),
+		"duration_ms":  duration.Milliseconds(),
+		"retries":      retries,
+		"threshold_ms": t.threshold.Milliseconds(),
+	}
+	if err != nil {
+		fields["error"] = err.Error()
+	} else {
+		fields["status_code"] = resp.StatusCode
+	}
+	t.warn(req.Context(), "Slow Hyperping API request", fields)
+	return resp, err
+}
+
+// startAttempt records an attempt and returns the number of attempts made
+// before it.
+func (t *slowRequestTransport) startAttempt(key slowRequestKey) int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.attempts[key]
+}
+
+// keepAttempts counts a failed attempt so that a retry reports it. The count
+// is dropped when the context ends, in case no retry follows.
+func (t *slowRequestTransport) keepAttempts(key slowRequestKey) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.attempts[key] == 0 {
+		context.AfterFunc(key.ctx, func() { t.finishAttempts(key) })
+	}
+	t.attempts[key]++
+}
+
+// finishAttempts forgets the attempts of a request that completed.
+func (t *slowRequestTransport) finishAttempts(key slowRequestKey) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.attempts, key)
+}
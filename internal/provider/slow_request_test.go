@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+)
+
+// slowRequestWarning is a warning captured from slowRequestTransport.
+type slowRequestWarning map[string]interface{}
+
+// newTestSlowRequestTransport returns a transport whose attempts take the
+// given durations, in order, and the warnings it logs.
+func newTestSlowRequestTransport(next http.RoundTripper, threshold time.Duration, durations ...time.Duration) (*slowRequestTransport, *[]slowRequestWarning) {
+	transport := newSlowRequestTransport(next, threshold, newClientStats())
+
+	var calls int
+	now := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	transport.now = func() time.Time {
+		// now is called before and after every attempt.
+		if calls%2 == 1 {
+			now = now.Add(durations[min(calls/2, len(durations)-1)])
+		}
+		calls++
+		return now
+	}
+
+	var warnings []slowRequestWarning
+	transport.warn = func(_ context.Context, _ string, fields ...map[string]interface{}) {
+		warnings = append(warnings, fields[0])
+	}
+	return transport, &warnings
+}
+
+func TestParseSlowRequestThreshold(t *testing.T) {
+	tests := []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "", want: defaultSlowRequestThreshold},
+		{value: "10s", want: 10 * time.Second},
+		{value: "1m30s", want: 90 * time.Second},
+		{value: "0s", want: 0},
+		{value: "-1s", wantErr: true},
+		{value: "5", wantErr: true},
+		{value: "slow", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		got, err := parseSlowRequestThreshold(tt.value)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("parseSlowRequestThreshold(%q) error = %v, wantErr %v", tt.value, err, tt.wantErr)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("parseSlowRequestThreshold(%q) = %v, want %v", tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestSlowRequestTransport(t *testing.T) {
+	next := &scriptedTransport{outcomes: []func() (*http.Response, error){respondWith(200)}}
+	transport, warnings := newTestSlowRequestTransport(next, 5*time.Second, 2*time.Second, 7*time.Second)
+
+	for range 2 {
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodGet, "https://api.hyperping.io/v1/monitors", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp, err := transport.RoundTrip(req)
+		if err != nil {
+			t.Fatalf("RoundTrip() error = %v", err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(*warnings) != 1 {
+		t.Fatalf("warnings = %v, want 1", *warnings)
+	}
+	w := (*warnings)[0]
+	want := slowRequestWarning{
+		"method":       http.MethodGet,
+		"path":         "/v1/monitors",
+		"duration":     "7s",
+		"duration_ms":  int64(7000),
+		"retries":      0,
+		"threshold_ms": int64(5000),
+		"status_code":  200,
+	}
+	for k, v := range want {
+		if w[k] != v {
+			t.Errorf("warning[%q] = %v, want %v", k, w[k], v)
+		}
+	}
+	if got := transport.stats.snapshot()["slow_requests"]; got != int64(1) {
+		t.Errorf("slow_requests = %v, want 1", got)
+	}
+}
+
+func TestSlowRequestTransport_CountsRetries(t *testing.T) {
+	next := &scriptedTransport{outcomes: []func() (*http.Response, error){
+		respondWith(503), failWith(errRead), respondWith(200),
+	}}
+	transport, warnings := newTestSlowRequestTransport(next, time.Second, 6*time.Second)
+
+	// Retries reuse the context of the first attempt.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for range 3 {
+		req, err := http.NewRequestWithContext(ctx, http.MethodPut, "https://api.hyperping.io/v1/monitors/mon_1", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp, err := transport.RoundTrip(req); err == nil {
+			resp.Body.Close()
+		}
+	}
+
+	if len(*warnings) != 3 {
+		t.Fatalf("warnings = %d, want 3", len(*warnings))
+	}
+	for i, w := range *warnings {
+		if w["retries"] != i {
+			t.Errorf("warning %d retries = %v, want %d", i, w["retries"], i)
+		}
+	}
+	if _, ok := (*warnings)[1]["error"]; !ok {
+		t.Errorf("warning for a network error has no error field: %v", (*warnings)[1])
+	}
+
+	// The request completed, so the next one starts counting again.
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, "https://api.hyperping.io/v1/monitors/mon_1", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	resp.Body.Close()
+	if got := (*warnings)[3]["retries"]; got != 0 {
+		t.Errorf("retries after a completed request = %v, want 0", got)
+	}
+}
+
+func TestSlowRequestTransport_ForgetsRetriesWhenContextEnds(t *testing.T) {
+	next := &scriptedTransport{outcomes: []func() (*http.Response, error){respondWith(503)}}
+	transport, _ := newTestSlowRequestTransport(next, time.Second, time.Millisecond)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, "https://api.hyperping.io/v1/monitors", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := transport.RoundTrip(req)
+	if err != nil {
+		t.Fatalf("RoundTrip() error = %v", err)
+	}
+	resp.Body.Close()
+	cancel()
+
+	deadline := time.Now().Add(time.Second)
+	for {
+		transport.mu.Lock()
+		pending := len(transport.attempts)
+		transport.mu.Unlock()
+		if pending == 0 {
+			return
+		}
+		if time.Now().After(deadline) {
+			t.Fatalf("attempts still tracked after the context ended: %d", pending)
+		}
+		time.Sleep(time.Millisecond)
+	}
+}
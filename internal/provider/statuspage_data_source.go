@@ -35,6 +35,9 @@ type StatusPageDataSourceModel struct {
 	URL             types.String `tfsdk:"url"`
 	Settings        types.Object `tfsdk:"settings"`
 	Sections        types.List   `tfsdk:"sections"`
+
+	CurrentStatus       types.String `tfsdk:"current_status"`
+	ActiveIncidentCount types.Int64  `tfsdk:"active_incident_count"`
 }
 
 func (d *StatusPageDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -68,6 +71,14 @@ func (d *StatusPageDataSource) Schema(ctx context.Context, req datasource.Schema
 				MarkdownDescription: "Public URL of the status page",
 				Computed:            true,
 			},
+			"current_status": schema.StringAttribute{
+				MarkdownDescription: statusPageCurrentStatusDescription,
+				Computed:            true,
+			},
+			"active_incident_count": schema.Int64Attribute{
+				MarkdownDescription: statusPageActiveIncidentCountDescription,
+				Computed:            true,
+			},
 			"settings": schema.SingleNestedAttribute{
 				MarkdownDescription: "Status page appearance and behavior settings",
 				Computed:            true,
@@ -206,6 +217,10 @@ func (d *StatusPageDataSource) Schema(ctx context.Context, req datasource.Schema
 							MarkdownDescription: "Services split into rows",
 							Computed:            true,
 						},
+						"position": schema.Int64Attribute{
+							MarkdownDescription: "Position of the section on the page, starting at 1",
+							Computed:            true,
+						},
 						"services": schema.ListNestedAttribute{
 							MarkdownDescription: "Services in section",
 							Computed:            true,
@@ -228,6 +243,10 @@ func (d *StatusPageDataSource) Schema(ctx context.Context, req datasource.Schema
 										MarkdownDescription: "Service is a group",
 										Computed:            true,
 									},
+									"position": schema.Int64Attribute{
+										MarkdownDescription: "Position of the service within its section, starting at 1",
+										Computed:            true,
+									},
 									"show_uptime": schema.BoolAttribute{
 										MarkdownDescription: "Show uptime",
 										Computed:            true,
@@ -300,10 +319,13 @@ func (d *StatusPageDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 func (d *StatusPageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config StatusPageDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -331,6 +353,17 @@ func (d *StatusPageDataSource) Read(ctx context.Context, req datasource.ReadRequ
 	// Map API response to data source model
 	d.mapStatusPageToModel(statusPage, &config, resp)
 
+	// The monitor list only refines the state; without it the page is judged
+	// by its incidents alone.
+	monitors, err := d.client.ListMonitors(ctx)
+	if err != nil {
+		resp.Diagnostics.AddWarning(
+			"Monitor status unavailable",
+			"current_status ignores monitor status because the monitors could not be fetched: "+redactSecrets(err.Error()),
+		)
+	}
+	config.CurrentStatus, config.ActiveIncidentCount = readStatusPageState(ctx, d.client.ListIncidents, statusPage, monitors, &resp.Diagnostics)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &config)...)
 }
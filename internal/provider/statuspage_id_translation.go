@@ -63,6 +63,8 @@ func isNumericString(s string) bool {
 type monitorIDMaps struct {
 	uuidToNumericID map[string]string // mon_xxx -> "117896"
 	numericIDToUUID map[string]string // "117896" -> mon_xxx
+	// monitors is the fetched list, reused to derive the page state.
+	monitors []hyperping.Monitor
 }
 
 // buildMonitorIDMaps fetches all monitors and builds bidirectional lookup maps.
@@ -78,6 +80,7 @@ func buildMonitorIDMaps(
 	maps := &monitorIDMaps{
 		uuidToNumericID: make(map[string]string, len(monitors)),
 		numericIDToUUID: make(map[string]string, len(monitors)),
+		monitors:        monitors,
 	}
 	for _, m := range monitors {
 		numericID := strconv.Itoa(m.ID)
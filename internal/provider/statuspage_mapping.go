@@ -312,6 +312,7 @@ func mapSectionsToTFWithFilter(sections []hyperping.StatusPageSection, configure
 			"name":     nameMap,
 			"is_split": types.BoolValue(section.IsSplit),
 			"services": servicesList,
+			"position": types.Int64Value(int64(i + 1)),
 		})
 		diags.Append(sectionDiags...)
 		values[i] = sectionObj
@@ -334,7 +335,7 @@ func mapServicesToTFWithFilter(services []hyperping.StatusPageService, configure
 
 	values := make([]attr.Value, len(services))
 	for i, service := range services {
-		values[i] = mapServiceToTFWithFilter(service, configuredLangs, diags)
+		values[i] = withPosition(mapServiceToTFWithFilter(service, configuredLangs, diags), attrs, i+1)
 	}
 
 	list, listDiags := types.ListValue(types.ObjectType{AttrTypes: attrs}, values)
@@ -391,6 +392,7 @@ func mapServiceToTFWithFilter(service hyperping.StatusPageService, configuredLan
 		"show_response_times": types.BoolValue(service.ShowResponseTimes),
 		"description":         descMap,
 		"services":            nestedServicesList,
+		"position":            types.Int64Null(),
 	})
 	diags.Append(serviceDiags...)
 
@@ -429,7 +431,8 @@ func mapNestedServicesToTF(services []hyperping.StatusPageService, configuredLan
 	return list
 }
 
-// mapTFToSections converts Terraform List to API sections array.
+// mapTFToSections converts Terraform List to API sections array, in
+// position order (see positionOrder).
 func mapTFToSections(list types.List, diags *diag.Diagnostics) []hyperping.CreateStatusPageSection {
 	if list.IsNull() || list.IsUnknown() {
 		return nil
@@ -438,7 +441,8 @@ func mapTFToSections(list types.List, diags *diag.Diagnostics) []hyperping.Creat
 	elements := list.Elements()
 	sections := make([]hyperping.CreateStatusPageSection, 0, len(elements))
 
-	for _, elem := range elements {
+	for _, i := range positionOrder(elements) {
+		elem := elements[i]
 		obj, ok := elem.(types.Object)
 		if !ok {
 			diags.AddError("Invalid section element", "Expected object type for section element")
@@ -483,7 +487,8 @@ func mapTFToSections(list types.List, diags *diag.Diagnostics) []hyperping.Creat
 	return sections
 }
 
-// mapTFToServices converts Terraform List to API services array (recursive).
+// mapTFToServices converts Terraform List to API services array (recursive),
+// in position order (see positionOrder).
 func mapTFToServices(list types.List, diags *diag.Diagnostics) []hyperping.CreateStatusPageService {
 	if list.IsNull() || list.IsUnknown() {
 		return nil
@@ -492,8 +497,8 @@ func mapTFToServices(list types.List, diags *diag.Diagnostics) []hyperping.Creat
 	elements := list.Elements()
 	services := make([]hyperping.CreateStatusPageService, 0, len(elements))
 
-	for i, elem := range elements {
-		service := mapTFToService(elem, diags)
+	for _, i := range positionOrder(elements) {
+		service := mapTFToService(elements[i], diags)
 
 		// Apply-time validation: non-group services must have a UUID.
 		if (service.IsGroup == nil || !*service.IsGroup) && service.MonitorUUID == nil {
@@ -666,6 +666,7 @@ func TestMapTFToSections_WithValues(t *testing.T) {
 		"show_response_times": types.BoolValue(true),
 		"description":         types.MapNull(types.StringType),
 		"services":            types.ListNull(types.ObjectType{AttrTypes: NestedServiceAttrTypes()}),
+		"position":            types.Int64Null(),
 	})
 
 	servicesList, _ := types.ListValue(types.ObjectType{AttrTypes: ServiceAttrTypes()}, []attr.Value{serviceObj})
@@ -677,6 +678,7 @@ func TestMapTFToSections_WithValues(t *testing.T) {
 		}),
 		"is_split": types.BoolValue(false),
 		"services": servicesList,
+		"position": types.Int64Null(),
 	})
 
 	sectionsList, _ := types.ListValue(types.ObjectType{AttrTypes: SectionAttrTypes()}, []attr.Value{sectionObj})
@@ -714,6 +716,7 @@ func TestMapTFToServices_WithValues(t *testing.T) {
 		"show_response_times": types.BoolValue(false),
 		"description":         types.MapNull(types.StringType),
 		"services":            types.ListNull(types.ObjectType{AttrTypes: NestedServiceAttrTypes()}),
+		"position":            types.Int64Null(),
 	})
 
 	nestedSvc, _ := types.ObjectValue(NestedServiceAttrTypes(), map[string]attr.Value{
@@ -740,6 +743,7 @@ func TestMapTFToServices_WithValues(t *testing.T) {
 		"show_response_times": types.BoolValue(true),
 		"description":         types.MapNull(types.StringType),
 		"services":            nestedSvcList,
+		"position":            types.Int64Null(),
 	})
 
 	servicesList, _ := types.ListValue(types.ObjectType{AttrTypes: ServiceAttrTypes()}, []attr.Value{service1, service2})
@@ -983,6 +987,7 @@ func TestMapTFToSections_NullAndUnknown(t *testing.T) {
 			}),
 			"is_split": types.BoolValue(false),
 			"services": types.ListNull(types.ObjectType{AttrTypes: ServiceAttrTypes()}),
+			"position": types.Int64Null(),
 		})
 
 		sectionsList, _ := types.ListValue(types.ObjectType{AttrTypes: SectionAttrTypes()}, []attr.Value{sectionObj})
@@ -1171,6 +1176,7 @@ func TestMapTFToServices_NonGroupWithoutUUID(t *testing.T) {
 		"show_response_times": types.BoolNull(),
 		"description":         types.MapNull(types.StringType),
 		"services":            types.ListNull(types.ObjectType{AttrTypes: NestedServiceAttrTypes()}),
+		"position":            types.Int64Null(),
 	})
 
 	list, _ := types.ListValue(types.ObjectType{AttrTypes: ServiceAttrTypes()}, []attr.Value{svc})
@@ -1198,6 +1204,7 @@ func TestMapTFToServices_GroupWithEmptyServices(t *testing.T) {
 		"show_response_times": types.BoolNull(),
 		"description":         types.MapNull(types.StringType),
 		"services":            types.ListNull(types.ObjectType{AttrTypes: NestedServiceAttrTypes()}),
+		"position":            types.Int64Null(),
 	})
 
 	list, _ := types.ListValue(types.ObjectType{AttrTypes: ServiceAttrTypes()}, []attr.Value{svc})
@@ -1330,7 +1337,7 @@ func TestIsAllowedBaseURL_PortVariants(t *testing.T) {
 
 func TestServiceAttrTypes_Count(t *testing.T) {
 	attrs := ServiceAttrTypes()
-	expectedKeys := []string{"id", "uuid", "name", "is_group", "show_uptime", "show_response_times", "description", "services"}
+	expectedKeys := []string{"id", "uuid", "name", "is_group", "show_uptime", "show_response_times", "description", "services", "position"}
 
 	if len(attrs) != len(expectedKeys) {
 		t.Errorf("expected %d keys, got %d: %v", len(expectedKeys), len(attrs), keysOf(attrs))
@@ -1594,6 +1601,7 @@ func TestMapTFToService_Description(t *testing.T) {
 				"en": types.StringValue("English desc"),
 			}),
 			"services": types.ListNull(types.ObjectType{AttrTypes: NestedServiceAttrTypes()}),
+			"position": types.Int64Null(),
 		})
 
 		var d diag.Diagnostics
@@ -1618,6 +1626,7 @@ func TestMapTFToService_Description(t *testing.T) {
 				"fr": types.StringValue("French desc"),
 			}),
 			"services": types.ListNull(types.ObjectType{AttrTypes: NestedServiceAttrTypes()}),
+			"position": types.Int64Null(),
 		})
 
 		var d diag.Diagnostics
@@ -1640,6 +1649,7 @@ func TestMapTFToService_Description(t *testing.T) {
 			"show_response_times": types.BoolNull(),
 			"description":         types.MapNull(types.StringType),
 			"services":            types.ListNull(types.ObjectType{AttrTypes: NestedServiceAttrTypes()}),
+			"position":            types.Int64Null(),
 		})
 
 		var d diag.Diagnostics
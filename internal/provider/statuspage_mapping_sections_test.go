@@ -160,6 +160,7 @@ func TestMapTFToSections(t *testing.T) {
 					}),
 					"is_split": types.BoolValue(true),
 					"services": types.ListNull(types.ObjectType{AttrTypes: ServiceAttrTypes()}),
+					"position": types.Int64Null(),
 				}),
 			}),
 			wantCount: 1,
@@ -272,6 +273,7 @@ func buildFullServiceObj() types.Object {
 		"is_group":            types.BoolValue(false),
 		"description":         types.MapNull(types.StringType),
 		"services":            types.ListNull(types.ObjectType{AttrTypes: NestedServiceAttrTypes()}),
+		"position":            types.Int64Null(),
 	})
 }
 
@@ -285,6 +287,7 @@ func buildMinimalServiceObj() types.Object {
 		"is_group":            types.BoolNull(),
 		"description":         types.MapNull(types.StringType),
 		"services":            types.ListNull(types.ObjectType{AttrTypes: NestedServiceAttrTypes()}),
+		"position":            types.Int64Null(),
 	})
 }
 
@@ -127,6 +127,7 @@ func SectionAttrTypes() map[string]attr.Type {
 		"name":     types.MapType{ElemType: types.StringType},
 		"is_split": types.BoolType,
 		"services": types.ListType{ElemType: types.ObjectType{AttrTypes: ServiceAttrTypes()}},
+		"position": types.Int64Type,
 	}
 }
 
@@ -156,5 +157,6 @@ func ServiceAttrTypes() map[string]attr.Type {
 		"show_response_times": types.BoolType,
 		"description":         types.MapType{ElemType: types.StringType},
 		"services":            types.ListType{ElemType: types.ObjectType{AttrTypes: NestedServiceAttrTypes()}},
+		"position":            types.Int64Type,
 	}
 }
@@ -4,6 +4,7 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
@@ -57,6 +58,37 @@ func TestAccStatusPageResource_withGroupedServices(t *testing.T) {
 	})
 }
 
+// TestAccStatusPageResource_groupDisplayToggles verifies that show_uptime and
+// show_response_times on a group entry are sent and read back independently
+// of the toggles on its nested services.
+func TestAccStatusPageResource_groupDisplayToggles(t *testing.T) {
+	server := newMockStatusPageServer(t)
+	defer server.Close()
+
+	tfresource.ParallelTest(t, tfresource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []tfresource.TestStep{
+			{
+				Config: testAccNestedGroupsConfig_groupToggles(server.URL, false, true),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "sections.0.services.0.show_uptime", "false"),
+					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "sections.0.services.0.show_response_times", "true"),
+					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "sections.0.services.0.services.0.show_uptime", "true"),
+					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "sections.0.services.0.services.0.show_response_times", "false"),
+				),
+			},
+			{
+				Config: testAccNestedGroupsConfig_groupToggles(server.URL, true, false),
+				Check: tfresource.ComposeAggregateTestCheckFunc(
+					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "sections.0.services.0.show_uptime", "true"),
+					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "sections.0.services.0.show_response_times", "false"),
+					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "sections.0.services.0.services.0.show_response_times", "false"),
+				),
+			},
+		},
+	})
+}
+
 // TestAccStatusPageResource_flatAndGroupMixed verifies a section with both a flat
 // service and a group service can be created without drift on re-plan.
 func TestAccStatusPageResource_flatAndGroupMixed(t *testing.T) {
@@ -359,3 +391,44 @@ resource "hyperping_statuspage" "test" {
 }
 `
 }
+
+func testAccNestedGroupsConfig_groupToggles(baseURL string, showUptime, showResponseTimes bool) string {
+	return testAccStatusPageProviderConfig(baseURL) + fmt.Sprintf(`
+resource "hyperping_statuspage" "test" {
+  name             = "Test Status Page"
+  hosted_subdomain = "test-group-toggles"
+
+  settings = {
+    name      = "Test Status Page"
+    languages = ["en"]
+  }
+
+  sections = [
+    {
+      name = {
+        en = "Infrastructure"
+      }
+      services = [
+        {
+          is_group            = true
+          show_uptime         = %t
+          show_response_times = %t
+          name = {
+            en = "Payment Processing"
+          }
+          services = [
+            {
+              uuid                = "mon_child_1"
+              show_response_times = false
+              name = {
+                en = "Payment API"
+              }
+            },
+          ]
+        }
+      ]
+    }
+  ]
+}
+`, showUptime, showResponseTimes)
+}
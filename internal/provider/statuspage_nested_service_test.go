@@ -629,6 +629,7 @@ func buildTopLevelServiceObj(
 		"show_response_times": types.BoolValue(false),
 		"description":         types.MapNull(types.StringType),
 		"services":            nestedServices,
+		"position":            types.Int64Null(),
 	})
 }
 
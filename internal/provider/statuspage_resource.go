@@ -7,6 +7,7 @@ import (
 	"context"
 	"fmt"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -18,9 +19,11 @@ import (
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
-	_ resource.Resource                = &StatusPageResource{}
-	_ resource.ResourceWithImportState = &StatusPageResource{}
-	_ resource.ResourceWithModifyPlan  = &StatusPageResource{}
+	_ resource.Resource                   = &StatusPageResource{}
+	_ resource.ResourceWithImportState    = &StatusPageResource{}
+	_ resource.ResourceWithIdentity       = &StatusPageResource{}
+	_ resource.ResourceWithModifyPlan     = &StatusPageResource{}
+	_ resource.ResourceWithValidateConfig = &StatusPageResource{}
 )
 
 func NewStatusPageResource() resource.Resource {
@@ -30,6 +33,7 @@ func NewStatusPageResource() resource.Resource {
 // StatusPageResource defines the resource implementation.
 type StatusPageResource struct {
 	client hyperping.HyperpingAPI
+	drift  *driftLog
 }
 
 // StatusPageResourceModel describes the resource data model.
@@ -42,6 +46,13 @@ type StatusPageResourceModel struct {
 	Password        types.String `tfsdk:"password"`
 	Settings        types.Object `tfsdk:"settings"`
 	Sections        types.List   `tfsdk:"sections"`
+
+	CurrentStatus       types.String `tfsdk:"current_status"`
+	ActiveIncidentCount types.Int64  `tfsdk:"active_incident_count"`
+
+	AllowIncompleteTranslations types.Bool `tfsdk:"allow_incomplete_translations"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 // ModifyPlan warns when description is set on nested services inside groups,
@@ -111,7 +122,8 @@ func (r *StatusPageResource) Configure(ctx context.Context, req resource.Configu
 		return
 	}
 
-	r.client = clients.RESTAPI
+	r.client = clients.restAPI()
+	r.drift = clients.driftLog
 }
 
 func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -123,6 +135,13 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build create request from plan
 	createReq := r.buildCreateRequest(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -132,7 +151,7 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 	// Translate mon_xxx -> numeric IDs for the uptime renderer
 	maps, err := buildMonitorIDMaps(ctx, r.client.ListMonitors)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to fetch monitors for ID translation", err.Error())
+		resp.Diagnostics.AddError("Failed to fetch monitors for ID translation", redactSecrets(err.Error()))
 		return
 	}
 	if createReq.Sections != nil {
@@ -145,7 +164,8 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 	// Create status page via API
 	statusPage, err := r.client.CreateStatusPage(ctx, *createReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error creating status page", err.Error())
+		resp.Diagnostics.Append(NewCreateErrorWithContext("Statuspage", err))
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Statuspage", "create", err)...)
 		return
 	}
 
@@ -158,13 +178,20 @@ func (r *StatusPageResource) Create(ctx context.Context, req resource.CreateRequ
 	// Map response to state
 	r.mapStatusPageToModel(ctx, statusPage, &plan, &resp.Diagnostics)
 
-	// Restore write-only fields on nested services that the API doesn't return.
-	// The API accepts description and show_response_times on write but may not
-	// return them (or returns defaults) on read for deeply nested services.
+	// Restore the configured section and service order, keep group children
+	// in the planned order, then restore write-only fields on nested services
+	// that the API doesn't return. The API accepts
+	// description and show_response_times on write but may not return them
+	// (or returns defaults) on read for deeply nested services.
+	plan.Sections = alignSectionPositions(planSections, plan.Sections)
+	plan.Sections = alignNestedServiceOrder(planSections, plan.Sections)
 	plan.Sections = preserveNestedServiceWriteOnlyFields(planSections, plan.Sections)
 
+	plan.CurrentStatus, plan.ActiveIncidentCount = readStatusPageState(ctx, r.client.ListIncidents, statusPage, maps.monitors, &resp.Diagnostics)
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -176,6 +203,14 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, state.ID)...)
+
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Preserve write-only fields not returned by the API
 	priorPassword := state.Password
 	priorSections := state.Sections
@@ -188,14 +223,14 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 			resp.State.RemoveResource(ctx)
 			return
 		}
-		resp.Diagnostics.AddError("Error reading status page", err.Error())
+		resp.Diagnostics.Append(NewReadErrorWithContext("Statuspage", state.ID.ValueString(), err))
 		return
 	}
 
 	// Translate numeric IDs to mon_xxx for state
 	maps, mapErr := buildMonitorIDMaps(ctx, r.client.ListMonitors)
 	if mapErr != nil {
-		resp.Diagnostics.AddError("Failed to fetch monitors for ID translation", mapErr.Error())
+		resp.Diagnostics.AddError("Failed to fetch monitors for ID translation", redactSecrets(mapErr.Error()))
 		return
 	}
 	translateResponseNumericIDsToUUIDs(statusPage, maps.numericIDToUUID, &resp.Diagnostics)
@@ -212,11 +247,17 @@ func (r *StatusPageResource) Read(ctx context.Context, req resource.ReadRequest,
 		state.Password = priorPassword
 	}
 
-	// Restore write-only fields on nested services
+	// Restore the configured order and positions, keep group children in the
+	// prior order, and restore write-only fields
+	state.Sections = alignSectionPositions(priorSections, state.Sections)
+	state.Sections = alignNestedServiceOrder(priorSections, state.Sections)
 	state.Sections = preserveNestedServiceWriteOnlyFields(priorSections, state.Sections)
 
+	state.CurrentStatus, state.ActiveIncidentCount = readStatusPageState(ctx, r.client.ListIncidents, statusPage, maps.monitors, &resp.Diagnostics)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	r.drift.log(ctx, "hyperping_statuspage", req.State, resp.State)
 }
 
 func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
@@ -230,6 +271,13 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Update, defaultUpdateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Build update request from plan
 	updateReq := r.buildUpdateRequest(ctx, &plan, &resp.Diagnostics)
 	if resp.Diagnostics.HasError() {
@@ -246,7 +294,7 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Translate mon_xxx -> numeric IDs for the uptime renderer
 	maps, err := buildMonitorIDMaps(ctx, r.client.ListMonitors)
 	if err != nil {
-		resp.Diagnostics.AddError("Failed to fetch monitors for ID translation", err.Error())
+		resp.Diagnostics.AddError("Failed to fetch monitors for ID translation", redactSecrets(err.Error()))
 		return
 	}
 	if updateReq.Sections != nil {
@@ -259,7 +307,8 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Update status page via API
 	statusPage, err := r.client.UpdateStatusPage(ctx, state.ID.ValueString(), *updateReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error updating status page", err.Error())
+		resp.Diagnostics.Append(NewUpdateErrorWithContext("Statuspage", state.ID.ValueString(), err))
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Statuspage", "update", err)...)
 		return
 	}
 
@@ -280,11 +329,17 @@ func (r *StatusPageResource) Update(ctx context.Context, req resource.UpdateRequ
 		plan.Password = planPassword
 	}
 
-	// Restore write-only fields on nested services
+	// Restore the configured order and positions, keep group children in the
+	// planned order, and restore write-only fields
+	plan.Sections = alignSectionPositions(planSections, plan.Sections)
+	plan.Sections = alignNestedServiceOrder(planSections, plan.Sections)
 	plan.Sections = preserveNestedServiceWriteOnlyFields(planSections, plan.Sections)
 
+	plan.CurrentStatus, plan.ActiveIncidentCount = readStatusPageState(ctx, r.client.ListIncidents, statusPage, maps.monitors, &resp.Diagnostics)
+
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setIDIdentity(ctx, resp.Identity, plan.ID)...)
 }
 
 func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -296,11 +351,18 @@ func (r *StatusPageResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete status page via API
 	err := r.client.DeleteStatusPage(ctx, state.ID.ValueString())
 	if err != nil {
 		if !hyperping.IsNotFound(err) {
-			resp.Diagnostics.AddError("Error deleting status page", err.Error())
+			resp.Diagnostics.Append(NewDeleteErrorWithContext("Statuspage", state.ID.ValueString(), err))
 			return
 		}
 		// Already deleted, continue
@@ -474,9 +536,19 @@ func preserveNestedServiceWriteOnlyFields(configured, fromAPI types.List) types.
 	return newList
 }
 
+// IdentitySchema defines the resource identity used by import blocks.
+func (r *StatusPageResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = idIdentitySchema("UUID of the status page (e.g. `sp_abc123`).")
+}
+
 func (r *StatusPageResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := importIDFromIdentity(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Validate UUID format
-	if err := hyperping.ValidateResourceID(req.ID); err != nil {
+	if err := hyperping.ValidateResourceID(id); err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Status Page ID",
 			fmt.Sprintf("Status page ID must be a valid UUID (e.g., sp_abc123): %s", err.Error()),
@@ -484,7 +556,7 @@ func (r *StatusPageResource) ImportState(ctx context.Context, req resource.Impor
 		return
 	}
 
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
 }
 
 // mapStatusPageToModel maps API response to Terraform model.
@@ -8,6 +8,8 @@ import (
 	"testing"
 
 	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
 )
 
 func TestAccStatusPageResource_import(t *testing.T) {
@@ -19,7 +21,8 @@ func TestAccStatusPageResource_import(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Step 1: Create the resource
 			{
-				Config: testAccStatusPageResourceConfig_basic(server.URL),
+				Config:           testAccStatusPageResourceConfig_basic(server.URL),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "name", "Test Status Page"),
 					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "hosted_subdomain", "test-status"),
@@ -33,6 +36,8 @@ func TestAccStatusPageResource_import(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_statuspage.test"),
 		},
 	})
 }
@@ -46,7 +51,8 @@ func TestAccStatusPageResource_importFull(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Create resource with all optional fields
 			{
-				Config: testAccStatusPageResourceConfig_full(server.URL),
+				Config:           testAccStatusPageResourceConfig_full(server.URL),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "name", "Production Status"),
 					tfresource.TestCheckResourceAttr("hyperping_statuspage.test", "hosted_subdomain", "prod-status"),
@@ -64,6 +70,8 @@ func TestAccStatusPageResource_importFull(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStep("hyperping_statuspage.test"),
 		},
 	})
 }
@@ -6,6 +6,7 @@ package provider
 import (
 	"context"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
@@ -55,12 +56,27 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 				MarkdownDescription: "Public URL of the status page (computed)",
 				Computed:            true,
 			},
+			"current_status": schema.StringAttribute{
+				MarkdownDescription: statusPageCurrentStatusDescription,
+				Computed:            true,
+			},
+			"active_incident_count": schema.Int64Attribute{
+				MarkdownDescription: statusPageActiveIncidentCountDescription,
+				Computed:            true,
+			},
 			"password": schema.StringAttribute{
 				MarkdownDescription: "Password for password-protected status pages. Set this along with " +
 					"`settings.authentication.password_protection = true` to require visitors to enter a password.",
 				Optional:  true,
 				Sensitive: true,
 			},
+			"allow_incomplete_translations": schema.BoolAttribute{
+				MarkdownDescription: "Skip the plan-time check that every language in `settings.languages` has a " +
+					"non-empty entry in each localized `name` map under `sections`. Without the " +
+					"check, a missing translation silently falls back to the default language. Map keys that are not page " +
+					"languages are still rejected. Defaults to `false`.",
+				Optional: true,
+			},
 			"settings": schema.SingleNestedAttribute{
 				MarkdownDescription: "Status page appearance and behavior settings",
 				Required:            true,
@@ -218,20 +234,28 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 								Computed:            true,
 							},
 							"saml_sso": schema.BoolAttribute{
-								MarkdownDescription: "Enable SAML SSO",
+								MarkdownDescription: "Enable SAML SSO. Requires an SSO connection; the plan warns when `sso_connection_uuid` is not set.",
 								Optional:            true,
 								Computed:            true,
 							},
 							"allowed_domains": schema.ListAttribute{
-								MarkdownDescription: "Allowed email domains for SSO",
+								MarkdownDescription: "Allowed email domains for SSO (bare domains such as `example.com`, without scheme or `@`)",
 								ElementType:         types.StringType,
 								Optional:            true,
 								Computed:            true,
+								Validators: []validator.List{
+									listvalidator.ValueStringsAre(DomainName()),
+								},
 							},
 							"sso_connection_uuid": schema.StringAttribute{
-								MarkdownDescription: "SSO connection UUID for SAML SSO integration",
-								Optional:            true,
-								Computed:            true,
+								MarkdownDescription: "SSO connection UUID for SAML SSO integration. The SAML identity provider " +
+									"metadata (metadata URL/XML, ACS URL, audience) is configured on the SSO connection in the " +
+									"Hyperping dashboard; the status page API only references the connection by UUID.",
+								Optional: true,
+								Computed: true,
+								Validators: []validator.String{
+									UUIDFormat(),
+								},
 							},
 						},
 					},
@@ -253,6 +277,10 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 							Optional:            true,
 							Computed:            true,
 						},
+						"position": schema.Int64Attribute{
+							MarkdownDescription: "Position of the section on the page, lowest first. The API has no position field: sections are sent sorted by position, and sections without one follow in list order. Use it to move a section without reordering the list.",
+							Optional:            true,
+						},
 						"services": schema.ListNestedAttribute{
 							MarkdownDescription: "Services/monitors in this section",
 							Optional:            true,
@@ -279,13 +307,17 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 										Optional:            true,
 										Computed:            true,
 									},
+									"position": schema.Int64Attribute{
+										MarkdownDescription: "Position of the service within its section, lowest first. Services are sent sorted by position, and services without one follow in list order. When no service in the section sets a position, services are matched by `uuid` and an API that returns them in a different order shows no diff. Nested services in a group keep their list order.",
+										Optional:            true,
+									},
 									"show_uptime": schema.BoolAttribute{
-										MarkdownDescription: "Show uptime percentage",
+										MarkdownDescription: "Show uptime percentage. On a group entry (is_group=true) this is the group's own toggle, set independently of its nested services.",
 										Optional:            true,
 										Computed:            true,
 									},
 									"show_response_times": schema.BoolAttribute{
-										MarkdownDescription: "Show response times",
+										MarkdownDescription: "Show the response time chart. On a group entry (is_group=true) this is the group's own toggle, set independently of its nested services.",
 										Optional:            true,
 										Computed:            true,
 									},
@@ -295,7 +327,7 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 										Optional:            true,
 									},
 									"services": schema.ListNestedAttribute{
-										MarkdownDescription: "Nested monitor services within this group. Required when is_group=true; must contain at least one entry. Ignored when is_group=false.",
+										MarkdownDescription: "Nested monitor services within this group. Required when is_group=true; must contain at least one entry. Ignored when is_group=false. Matched by `uuid`: if the API returns the same monitors in a different order, the configured order is kept and no diff is shown.",
 										Optional:            true,
 										Computed:            true,
 										NestedObject: schema.NestedAttributeObject{
@@ -345,5 +377,14 @@ func (r *StatusPageResource) Schema(ctx context.Context, req resource.SchemaRequ
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Update: true,
+				Delete: true,
+			}),
+		},
 	}
 }
@@ -0,0 +1,332 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"maps"
+	"slices"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// alignNestedServiceOrder reorders the nested services of each group in
+// fromAPI to match their order in configured (plan or prior state).
+//
+// Nested services have set semantics: the API identifies them by monitor UUID
+// and does not guarantee that a group's children come back in the order they
+// were written. Without this, a group whose children are returned in a
+// different order fails with "inconsistent result after apply" and then shows
+// a permanent diff. A group is only reordered when both sides hold exactly the
+// same UUIDs; adding, removing, or replacing a child is left as a real change.
+//
+// Sections are matched by index, as in preserveNestedServiceWriteOnlyFields,
+// which expects the aligned order. Top-level services are aligned by
+// alignSectionPositions, which runs first.
+func alignNestedServiceOrder(configured, fromAPI types.List) types.List {
+	if configured.IsNull() || configured.IsUnknown() || fromAPI.IsNull() || fromAPI.IsUnknown() {
+		return fromAPI
+	}
+
+	configuredElems := configured.Elements()
+	apiElems := fromAPI.Elements()
+	if len(configuredElems) != len(apiElems) {
+		return fromAPI
+	}
+
+	modified := false
+	newElems := make([]attr.Value, len(apiElems))
+	copy(newElems, apiElems)
+
+	for i := range apiElems {
+		configSection, ok1 := configuredElems[i].(types.Object)
+		apiSection, ok2 := apiElems[i].(types.Object)
+		if !ok1 || !ok2 {
+			continue
+		}
+
+		configServices, ok1 := configSection.Attributes()["services"].(types.List)
+		apiServices, ok2 := apiSection.Attributes()["services"].(types.List)
+		if !ok1 || !ok2 || configServices.IsNull() || apiServices.IsNull() {
+			continue
+		}
+
+		configSvcElems := configServices.Elements()
+		apiSvcElems := apiServices.Elements()
+		if len(configSvcElems) != len(apiSvcElems) {
+			continue
+		}
+
+		svcModified := false
+		newSvcElems := make([]attr.Value, len(apiSvcElems))
+		copy(newSvcElems, apiSvcElems)
+
+		for j := range apiSvcElems {
+			configSvc, ok1 := configSvcElems[j].(types.Object)
+			apiSvc, ok2 := apiSvcElems[j].(types.Object)
+			if !ok1 || !ok2 {
+				continue
+			}
+
+			configNested, ok1 := configSvc.Attributes()["services"].(types.List)
+			apiNested, ok2 := apiSvc.Attributes()["services"].(types.List)
+			if !ok1 || !ok2 {
+				continue
+			}
+
+			reordered, ok := reorderNestedServicesByUUID(configNested, apiNested)
+			if !ok {
+				continue
+			}
+
+			newAttrs := maps.Clone(apiSvc.Attributes())
+			newAttrs["services"] = reordered
+			newSvcObj, diags := types.ObjectValue(ServiceAttrTypes(), newAttrs)
+			if diags.HasError() {
+				continue
+			}
+			newSvcElems[j] = newSvcObj
+			svcModified = true
+		}
+
+		if !svcModified {
+			continue
+		}
+
+		newServices, diags := types.ListValue(types.ObjectType{AttrTypes: ServiceAttrTypes()}, newSvcElems)
+		if diags.HasError() {
+			continue
+		}
+		newSectionAttrs := maps.Clone(apiSection.Attributes())
+		newSectionAttrs["services"] = newServices
+		newSectionObj, diags := types.ObjectValue(SectionAttrTypes(), newSectionAttrs)
+		if diags.HasError() {
+			continue
+		}
+		newElems[i] = newSectionObj
+		modified = true
+	}
+
+	if !modified {
+		return fromAPI
+	}
+
+	result, diags := types.ListValue(types.ObjectType{AttrTypes: SectionAttrTypes()}, newElems)
+	if diags.HasError() {
+		return fromAPI
+	}
+	return result
+}
+
+// reorderNestedServicesByUUID returns the elements of fromAPI in the order
+// their UUIDs appear in configured. It returns false when the lists do not
+// hold the same UUIDs (including duplicates), when a configured UUID is not
+// known yet, or when the order already matches.
+func reorderNestedServicesByUUID(configured, fromAPI types.List) (types.List, bool) {
+	if configured.IsNull() || configured.IsUnknown() || fromAPI.IsNull() || fromAPI.IsUnknown() {
+		return fromAPI, false
+	}
+
+	ordered, ok := matchServicesByUUID(configured.Elements(), fromAPI.Elements())
+	if !ok || slices.EqualFunc(ordered, fromAPI.Elements(), attr.Value.Equal) {
+		return fromAPI, false
+	}
+
+	result, diags := types.ListValue(types.ObjectType{AttrTypes: NestedServiceAttrTypes()}, ordered)
+	if diags.HasError() {
+		return fromAPI, false
+	}
+	return result, true
+}
+
+// matchServicesByUUID returns the elements of fromAPI in the order their UUIDs
+// appear in configured. It returns false when the two do not hold the same
+// UUIDs (including duplicates) or when a UUID is not known yet.
+func matchServicesByUUID(configured, fromAPI []attr.Value) ([]attr.Value, bool) {
+	if len(configured) != len(fromAPI) {
+		return nil, false
+	}
+
+	positions := make(map[string][]int, len(fromAPI))
+	for i, elem := range fromAPI {
+		uuid, ok := nestedServiceUUID(elem)
+		if !ok {
+			return nil, false
+		}
+		positions[uuid] = append(positions[uuid], i)
+	}
+
+	ordered := make([]attr.Value, len(configured))
+	for i, elem := range configured {
+		uuid, ok := nestedServiceUUID(elem)
+		if !ok || len(positions[uuid]) == 0 {
+			return nil, false
+		}
+		ordered[i] = fromAPI[positions[uuid][0]]
+		positions[uuid] = positions[uuid][1:]
+	}
+	return ordered, true
+}
+
+// nestedServiceUUID returns the known, non-empty uuid of a service.
+func nestedServiceUUID(elem attr.Value) (string, bool) {
+	obj, ok := elem.(types.Object)
+	if !ok {
+		return "", false
+	}
+	uuid, ok := obj.Attributes()["uuid"].(types.String)
+	if !ok || uuid.IsNull() || uuid.IsUnknown() || uuid.ValueString() == "" {
+		return "", false
+	}
+	return uuid.ValueString(), true
+}
+
+// Sections and top-level services have no position in the API: the page shows
+// them in the order they are sent. The optional position attribute is applied
+// client-side, so a section or service moves by changing its position instead
+// of rewriting the list, and the list can be grouped in whatever order reads
+// best in the configuration.
+
+// positionOrder returns the indices of elems in the order they are sent to the
+// API: elements with a known position first, lowest first, then the others in
+// list order. Elements with equal positions keep their list order.
+func positionOrder(elems []attr.Value) []int {
+	order := make([]int, len(elems))
+	for i := range order {
+		order[i] = i
+	}
+	sort.SliceStable(order, func(a, b int) bool {
+		pa, okA := elementPosition(elems[order[a]])
+		pb, okB := elementPosition(elems[order[b]])
+		if okA != okB {
+			return okA
+		}
+		return okA && pa < pb
+	})
+	return order
+}
+
+// elementPosition returns the known position of a section or service.
+func elementPosition(elem attr.Value) (int64, bool) {
+	obj, ok := elem.(types.Object)
+	if !ok {
+		return 0, false
+	}
+	position, ok := obj.Attributes()["position"].(types.Int64)
+	if !ok || position.IsNull() || position.IsUnknown() {
+		return 0, false
+	}
+	return position.ValueInt64(), true
+}
+
+// alignSectionPositions returns the sections read back from the API in their
+// configured order, with position taken from configured (plan or prior
+// state). The API returns sections and their services in the order
+// positionOrder sent them, so the configured order is restored by undoing
+// that permutation. If the page was reordered outside Terraform, the restored
+// order no longer matches the configuration and the change shows as drift.
+//
+// Services of a section that sets no position are instead matched by monitor
+// UUID, like nested services: their order carries no meaning of its own, so
+// an API that returns them in another order shows no diff. Setting position
+// on any service of a section opts it into the explicit ordering above.
+//
+// Sections and services stay lists rather than sets keyed on uuid; see
+// docs/adr/0004-status-page-sections-stay-lists.md.
+//
+// It must run before alignNestedServiceOrder and
+// preserveNestedServiceWriteOnlyFields, which match by index.
+func alignSectionPositions(configured, fromAPI types.List) types.List {
+	return alignByPosition(configured, fromAPI, SectionAttrTypes(), alignServicePositions)
+}
+
+// alignServicePositions is alignSectionPositions for the services of a section.
+func alignServicePositions(configured, fromAPI types.List) types.List {
+	return alignByPosition(configured, fromAPI, ServiceAttrTypes(), nil)
+}
+
+func alignByPosition(configured, fromAPI types.List, attrTypes map[string]attr.Type, alignServices func(configured, fromAPI types.List) types.List) types.List {
+	if fromAPI.IsNull() || fromAPI.IsUnknown() {
+		return fromAPI
+	}
+
+	apiElems := fromAPI.Elements()
+	var configuredElems []attr.Value
+	if !configured.IsNull() && !configured.IsUnknown() && len(configured.Elements()) == len(apiElems) {
+		configuredElems = configured.Elements()
+	}
+
+	newElems := make([]attr.Value, len(apiElems))
+	copy(newElems, apiElems)
+	if configuredElems != nil {
+		byUUID, matched := []attr.Value(nil), false
+		if alignServices == nil && !anyPosition(configuredElems) {
+			byUUID, matched = matchServicesByUUID(configuredElems, apiElems)
+		}
+		if matched {
+			copy(newElems, byUUID)
+		} else {
+			for sent, i := range positionOrder(configuredElems) {
+				newElems[i] = apiElems[sent]
+			}
+		}
+	}
+
+	for i, elem := range newElems {
+		obj, ok := elem.(types.Object)
+		if !ok {
+			return fromAPI
+		}
+		var configuredObj types.Object
+		if configuredElems != nil {
+			configuredObj, _ = configuredElems[i].(types.Object)
+		}
+
+		attrs := maps.Clone(obj.Attributes())
+		attrs["position"] = types.Int64Null()
+		if position, ok := configuredObj.Attributes()["position"].(types.Int64); ok && !position.IsUnknown() {
+			attrs["position"] = position
+		}
+		if alignServices != nil {
+			if services, ok := attrs["services"].(types.List); ok {
+				configuredServices, _ := configuredObj.Attributes()["services"].(types.List)
+				attrs["services"] = alignServices(configuredServices, services)
+			}
+		}
+
+		newObj, diags := types.ObjectValue(attrTypes, attrs)
+		if diags.HasError() {
+			return fromAPI
+		}
+		newElems[i] = newObj
+	}
+
+	result, diags := types.ListValue(types.ObjectType{AttrTypes: attrTypes}, newElems)
+	if diags.HasError() {
+		return fromAPI
+	}
+	return result
+}
+
+// anyPosition reports whether any element has a known position.
+func anyPosition(elems []attr.Value) bool {
+	for _, elem := range elems {
+		if _, ok := elementPosition(elem); ok {
+			return true
+		}
+	}
+	return false
+}
+
+// withPosition returns obj with its position attribute set.
+func withPosition(obj types.Object, attrTypes map[string]attr.Type, position int) types.Object {
+	attrs := maps.Clone(obj.Attributes())
+	attrs["position"] = types.Int64Value(int64(position))
+	result, diags := types.ObjectValue(attrTypes, attrs)
+	if diags.HasError() {
+		return obj
+	}
+	return result
+}
@@ -0,0 +1,237 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func groupedSections(t *testing.T, children ...string) types.List {
+	t.Helper()
+	nested := make([]hyperping.StatusPageService, len(children))
+	for i, uuid := range children {
+		nested[i] = hyperping.StatusPageService{UUID: uuid, Name: map[string]string{"en": uuid}, ShowUptime: true}
+	}
+	var diags diag.Diagnostics
+	list := mapSectionsToTF([]hyperping.StatusPageSection{{
+		Name: map[string]string{"en": "Platform"},
+		Services: []hyperping.StatusPageService{
+			{UUID: "mon_flat", Name: map[string]string{"en": "Website"}},
+			{Name: map[string]string{"en": "Backend"}, IsGroup: true, Services: nested},
+		},
+	}}, &diags)
+	if diags.HasError() {
+		t.Fatalf("mapping sections: %v", diags)
+	}
+	return list
+}
+
+func nestedUUIDs(t *testing.T, sections types.List) []string {
+	t.Helper()
+	section := sections.Elements()[0].(types.Object)
+	group := section.Attributes()["services"].(types.List).Elements()[1].(types.Object)
+	var uuids []string
+	for _, elem := range group.Attributes()["services"].(types.List).Elements() {
+		uuid, _ := nestedServiceUUID(elem)
+		uuids = append(uuids, uuid)
+	}
+	return uuids
+}
+
+func TestAlignNestedServiceOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		configured []string
+		fromAPI    []string
+		want       []string
+	}{
+		{"reordered by API", []string{"mon_b", "mon_a", "mon_c"}, []string{"mon_a", "mon_b", "mon_c"}, []string{"mon_b", "mon_a", "mon_c"}},
+		{"same order", []string{"mon_a", "mon_b"}, []string{"mon_a", "mon_b"}, []string{"mon_a", "mon_b"}},
+		{"duplicates", []string{"mon_b", "mon_a", "mon_b"}, []string{"mon_a", "mon_b", "mon_b"}, []string{"mon_b", "mon_a", "mon_b"}},
+		{"child replaced", []string{"mon_b", "mon_x"}, []string{"mon_a", "mon_b"}, []string{"mon_a", "mon_b"}},
+		{"child added", []string{"mon_b", "mon_a"}, []string{"mon_a", "mon_b", "mon_c"}, []string{"mon_a", "mon_b", "mon_c"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := alignNestedServiceOrder(groupedSections(t, tt.configured...), groupedSections(t, tt.fromAPI...))
+			gotUUIDs := nestedUUIDs(t, got)
+			if len(gotUUIDs) != len(tt.want) {
+				t.Fatalf("got %v, want %v", gotUUIDs, tt.want)
+			}
+			for i := range tt.want {
+				if gotUUIDs[i] != tt.want[i] {
+					t.Fatalf("got %v, want %v", gotUUIDs, tt.want)
+				}
+			}
+		})
+	}
+}
+
+func TestAlignNestedServiceOrder_PreservesOtherFields(t *testing.T) {
+	configured := groupedSections(t, "mon_b", "mon_a")
+	fromAPI := groupedSections(t, "mon_a", "mon_b")
+
+	got := alignNestedServiceOrder(configured, fromAPI)
+	if !got.Equal(configured) {
+		t.Errorf("aligned sections differ from configured:\n got: %s\nwant: %s", got, configured)
+	}
+
+	nullList := types.ListNull(fromAPI.ElementType(nil))
+	if got := alignNestedServiceOrder(nullList, fromAPI); !got.Equal(fromAPI) {
+		t.Error("null configured sections must return the API value unchanged")
+	}
+}
+
+// positionedSections returns sections named after names with the given
+// positions (0 leaves the position null), each holding one service per entry
+// of services with the same positions.
+func positionedSections(t *testing.T, names []string, positions []int64, services []string, servicePositions []int64) types.List {
+	t.Helper()
+	svcs := make([]hyperping.StatusPageService, len(services))
+	for i, uuid := range services {
+		svcs[i] = hyperping.StatusPageService{UUID: uuid, Name: map[string]string{"en": uuid}}
+	}
+	apiSections := make([]hyperping.StatusPageSection, len(names))
+	for i, name := range names {
+		apiSections[i] = hyperping.StatusPageSection{Name: map[string]string{"en": name}, Services: svcs}
+	}
+	var diags diag.Diagnostics
+	list := alignSectionPositions(types.ListNull(types.ObjectType{AttrTypes: SectionAttrTypes()}), mapSectionsToTF(apiSections, &diags))
+	if diags.HasError() {
+		t.Fatalf("mapping sections: %v", diags)
+	}
+
+	elems := list.Elements()
+	for i, elem := range elems {
+		section := elem.(types.Object)
+		svcElems := section.Attributes()["services"].(types.List).Elements()
+		for j := range svcElems {
+			if servicePositions[j] != 0 {
+				svcElems[j] = withPosition(svcElems[j].(types.Object), ServiceAttrTypes(), int(servicePositions[j]))
+			}
+		}
+		attrs := section.Attributes()
+		attrs["services"] = types.ListValueMust(types.ObjectType{AttrTypes: ServiceAttrTypes()}, svcElems)
+		section = types.ObjectValueMust(SectionAttrTypes(), attrs)
+		if positions[i] != 0 {
+			section = withPosition(section, SectionAttrTypes(), int(positions[i]))
+		}
+		elems[i] = section
+	}
+	return types.ListValueMust(types.ObjectType{AttrTypes: SectionAttrTypes()}, elems)
+}
+
+func TestMapTFToSections_PositionOrder(t *testing.T) {
+	configured := positionedSections(t,
+		[]string{"Support", "Core", "Extras"}, []int64{2, 1, 0},
+		[]string{"mon_b", "mon_a", "mon_c"}, []int64{20, 10, 0})
+
+	var diags diag.Diagnostics
+	sections := mapTFToSections(configured, &diags)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	var names []string
+	for _, s := range sections {
+		names = append(names, s.Name)
+	}
+	if want := []string{"Core", "Support", "Extras"}; !slices.Equal(names, want) {
+		t.Errorf("sections sent as %v, want %v", names, want)
+	}
+	var uuids []string
+	for _, s := range sections[0].Services {
+		uuids = append(uuids, *s.MonitorUUID)
+	}
+	if want := []string{"mon_a", "mon_b", "mon_c"}; !slices.Equal(uuids, want) {
+		t.Errorf("services sent as %v, want %v", uuids, want)
+	}
+}
+
+func TestAlignSectionPositions(t *testing.T) {
+	configured := positionedSections(t,
+		[]string{"Support", "Core", "Extras"}, []int64{2, 1, 0},
+		[]string{"mon_b", "mon_a", "mon_c"}, []int64{20, 10, 0})
+
+	// The API returns sections and services in the order they were sent.
+	var diags diag.Diagnostics
+	svcs := []hyperping.StatusPageService{
+		{UUID: "mon_a", Name: map[string]string{"en": "mon_a"}},
+		{UUID: "mon_b", Name: map[string]string{"en": "mon_b"}},
+		{UUID: "mon_c", Name: map[string]string{"en": "mon_c"}},
+	}
+	fromAPI := mapSectionsToTF([]hyperping.StatusPageSection{
+		{Name: map[string]string{"en": "Core"}, Services: svcs},
+		{Name: map[string]string{"en": "Support"}, Services: svcs},
+		{Name: map[string]string{"en": "Extras"}, Services: svcs},
+	}, &diags)
+	if diags.HasError() {
+		t.Fatalf("mapping sections: %v", diags)
+	}
+
+	got := alignSectionPositions(configured, fromAPI)
+	if !got.Equal(configured) {
+		t.Errorf("aligned sections differ from configuration:\ngot:  %s\nwant: %s", got, configured)
+	}
+}
+
+func TestAlignSectionPositions_Unconfigured(t *testing.T) {
+	var diags diag.Diagnostics
+	fromAPI := mapSectionsToTF([]hyperping.StatusPageSection{
+		{Name: map[string]string{"en": "Core"}, Services: []hyperping.StatusPageService{{UUID: "mon_a"}}},
+	}, &diags)
+
+	section := fromAPI.Elements()[0].(types.Object)
+	if got := section.Attributes()["position"].(types.Int64); got.ValueInt64() != 1 {
+		t.Fatalf("data source position = %s, want 1", got)
+	}
+
+	got := alignSectionPositions(types.ListNull(types.ObjectType{AttrTypes: SectionAttrTypes()}), fromAPI)
+	section = got.Elements()[0].(types.Object)
+	if !section.Attributes()["position"].IsNull() {
+		t.Error("resource section position should be null when not configured")
+	}
+	service := section.Attributes()["services"].(types.List).Elements()[0].(types.Object)
+	if !service.Attributes()["position"].IsNull() {
+		t.Error("resource service position should be null when not configured")
+	}
+}
+
+func TestAlignSectionPositions_ServicesMatchedByUUID(t *testing.T) {
+	apiOrder := func() types.List {
+		var diags diag.Diagnostics
+		list := mapSectionsToTF([]hyperping.StatusPageSection{
+			{Name: map[string]string{"en": "Core"}, Services: []hyperping.StatusPageService{
+				{UUID: "mon_c", Name: map[string]string{"en": "mon_c"}},
+				{UUID: "mon_a", Name: map[string]string{"en": "mon_a"}},
+				{UUID: "mon_b", Name: map[string]string{"en": "mon_b"}},
+			}},
+		}, &diags)
+		if diags.HasError() {
+			t.Fatalf("mapping sections: %v", diags)
+		}
+		return list
+	}
+
+	// Without position, services reordered outside Terraform show no diff.
+	configured := positionedSections(t, []string{"Core"}, []int64{0},
+		[]string{"mon_a", "mon_b", "mon_c"}, []int64{0, 0, 0})
+	if got := alignSectionPositions(configured, apiOrder()); !got.Equal(configured) {
+		t.Errorf("aligned sections differ from configuration:\ngot:  %s\nwant: %s", got, configured)
+	}
+
+	// With position, the order is explicit and the reorder shows as drift.
+	configured = positionedSections(t, []string{"Core"}, []int64{0},
+		[]string{"mon_a", "mon_b", "mon_c"}, []int64{1, 2, 3})
+	if got := alignSectionPositions(configured, apiOrder()); got.Equal(configured) {
+		t.Error("reordered services with position should show as drift")
+	}
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"slices"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// Published status values reported by current_status on hyperping_statuspage.
+const (
+	statusPageOperational = "operational"
+	statusPageDegraded    = "degraded"
+	statusPageMajorOutage = "major_outage"
+)
+
+// Descriptions shared by the resource and data source schemas.
+const (
+	statusPageCurrentStatusDescription = "What the page currently shows: `operational`, `degraded`, or `major_outage`. " +
+		"Derived from the incidents posted to the page and the monitors it lists, since the API does not report it: " +
+		"`major_outage` while an unresolved incident of type `outage` is posted or every listed monitor that is not paused is down, " +
+		"`degraded` while another incident is unresolved or a listed monitor is down. Null when the incidents could not be fetched."
+	statusPageActiveIncidentCountDescription = "Number of incidents posted to the page whose latest update is not `resolved`. " +
+		"Null when the incidents could not be fetched."
+)
+
+// statusPageState is what a status page currently shows its visitors. The API
+// does not report it, so it is derived from the incidents posted to the page
+// and the monitors it lists.
+type statusPageState struct {
+	currentStatus       string
+	activeIncidentCount int
+}
+
+// deriveStatusPageState computes the state of sp. An incident is active on
+// the page while its latest update is not a resolution (see incidentState).
+// The page shows:
+//   - major_outage while an active incident has type outage, or every
+//     listed monitor that is not paused is down
+//   - degraded while another incident is active, or some listed monitor is
+//     down
+//   - operational otherwise
+func deriveStatusPageState(sp *hyperping.StatusPage, monitors []hyperping.Monitor, incidents []hyperping.Incident) statusPageState {
+	var state statusPageState
+	activeOutage := false
+	for i := range incidents {
+		if !slices.Contains(incidents[i].StatusPages, sp.UUID) || incidentState(&incidents[i]) != "ongoing" {
+			continue
+		}
+		state.activeIncidentCount++
+		if incidents[i].Type == "outage" {
+			activeOutage = true
+		}
+	}
+
+	// Services reference monitors by UUID, or by the numeric ID when the
+	// response has not been translated.
+	listed := make(map[string]bool)
+	collectServiceMonitorRefs(sp.Sections, listed)
+	checked, down := 0, 0
+	for _, m := range monitors {
+		if m.Paused || (!listed[m.UUID] && !listed[strconv.Itoa(m.ID)]) {
+			continue
+		}
+		checked++
+		if m.Status == "down" {
+			down++
+		}
+	}
+
+	switch {
+	case activeOutage || (checked > 0 && down == checked):
+		state.currentStatus = statusPageMajorOutage
+	case state.activeIncidentCount > 0 || down > 0:
+		state.currentStatus = statusPageDegraded
+	default:
+		state.currentStatus = statusPageOperational
+	}
+	return state
+}
+
+// collectServiceMonitorRefs adds the monitor references of every service,
+// including those nested in groups, to refs.
+func collectServiceMonitorRefs(sections []hyperping.StatusPageSection, refs map[string]bool) {
+	var walk func(services []hyperping.StatusPageService)
+	walk = func(services []hyperping.StatusPageService) {
+		for _, svc := range services {
+			if svc.UUID != "" {
+				refs[svc.UUID] = true
+			}
+			if id := serviceIDToString(svc.ID); id != "" {
+				refs[id] = true
+			}
+			walk(svc.Services)
+		}
+	}
+	for _, section := range sections {
+		walk(section.Services)
+	}
+}
+
+// readStatusPageState lists the incidents and derives the state of sp from
+// them and monitors. The state is informational, so a failed listing adds a
+// warning and leaves both values null instead of failing the read.
+func readStatusPageState(
+	ctx context.Context,
+	listIncidents func(context.Context) ([]hyperping.Incident, error),
+	sp *hyperping.StatusPage,
+	monitors []hyperping.Monitor,
+	diags *diag.Diagnostics,
+) (types.String, types.Int64) {
+	incidents, err := listIncidents(ctx)
+	if err != nil {
+		diags.AddWarning(
+			"Status page state unavailable",
+			"current_status and active_incident_count are derived from the incident list, which could not be fetched: "+
+				redactSecrets(err.Error()),
+		)
+		return types.StringNull(), types.Int64Null()
+	}
+	state := deriveStatusPageState(sp, monitors, incidents)
+	return types.StringValue(state.currentStatus), types.Int64Value(int64(state.activeIncidentCount))
+}
@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
+)
+
+func TestDeriveStatusPageState(t *testing.T) {
+	page := &hyperping.StatusPage{
+		UUID: "sp_main",
+		Sections: []hyperping.StatusPageSection{{
+			Services: []hyperping.StatusPageService{
+				{UUID: "mon_api"},
+				{IsGroup: true, Services: []hyperping.StatusPageService{
+					{ID: testutil.Ptr(hyperping.FlexibleString("117"))},
+				}},
+			},
+		}},
+	}
+	up := []hyperping.Monitor{
+		{UUID: "mon_api", ID: 116, Status: "up"},
+		{UUID: "mon_db", ID: 117, Status: "up"},
+		{UUID: "mon_other", ID: 118, Status: "down"},
+	}
+	resolved := []hyperping.IncidentUpdate{
+		{Date: "2026-01-01T10:00:00Z", Type: "investigating"},
+		{Date: "2026-01-01T11:00:00Z", Type: "resolved"},
+	}
+
+	tests := []struct {
+		name       string
+		monitors   []hyperping.Monitor
+		incidents  []hyperping.Incident
+		wantStatus string
+		wantCount  int
+	}{
+		{
+			name:       "operational when nothing is wrong",
+			monitors:   up,
+			incidents:  []hyperping.Incident{{Type: "outage", StatusPages: []string{"sp_other"}}},
+			wantStatus: statusPageOperational,
+		},
+		{
+			name:       "resolved incidents are not active",
+			monitors:   up,
+			incidents:  []hyperping.Incident{{Type: "outage", StatusPages: []string{"sp_main"}, Updates: resolved}},
+			wantStatus: statusPageOperational,
+		},
+		{
+			name:       "active incident degrades the page",
+			monitors:   up,
+			incidents:  []hyperping.Incident{{Type: "incident", StatusPages: []string{"sp_main"}}},
+			wantStatus: statusPageDegraded,
+			wantCount:  1,
+		},
+		{
+			name:     "active outage is a major outage",
+			monitors: up,
+			incidents: []hyperping.Incident{
+				{Type: "incident", StatusPages: []string{"sp_main"}},
+				{Type: "outage", StatusPages: []string{"sp_other", "sp_main"}},
+			},
+			wantStatus: statusPageMajorOutage,
+			wantCount:  2,
+		},
+		{
+			name: "monitor listed by numeric ID is down",
+			monitors: []hyperping.Monitor{
+				{UUID: "mon_api", ID: 116, Status: "up"},
+				{UUID: "mon_db", ID: 117, Status: "down"},
+			},
+			wantStatus: statusPageDegraded,
+		},
+		{
+			name: "every listed monitor down",
+			monitors: []hyperping.Monitor{
+				{UUID: "mon_api", ID: 116, Status: "down"},
+				{UUID: "mon_db", ID: 117, Status: "down"},
+			},
+			wantStatus: statusPageMajorOutage,
+		},
+		{
+			name: "paused monitors are ignored",
+			monitors: []hyperping.Monitor{
+				{UUID: "mon_api", ID: 116, Status: "down", Paused: true},
+				{UUID: "mon_db", ID: 117, Status: "up"},
+			},
+			wantStatus: statusPageOperational,
+		},
+		{
+			name:       "no monitor list",
+			wantStatus: statusPageOperational,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := deriveStatusPageState(page, tt.monitors, tt.incidents)
+			if got.currentStatus != tt.wantStatus || got.activeIncidentCount != tt.wantCount {
+				t.Errorf("deriveStatusPageState() = %s/%d, want %s/%d",
+					got.currentStatus, got.activeIncidentCount, tt.wantStatus, tt.wantCount)
+			}
+		})
+	}
+}
+
+func TestReadStatusPageState_ListError(t *testing.T) {
+	var diags diag.Diagnostics
+	listIncidents := func(context.Context) ([]hyperping.Incident, error) {
+		return nil, errors.New("boom")
+	}
+
+	status, count := readStatusPageState(context.Background(), listIncidents, &hyperping.StatusPage{UUID: "sp_main"}, nil, &diags)
+	if !status.IsNull() || !count.IsNull() {
+		t.Errorf("got %v/%v, want nulls", status, count)
+	}
+	if diags.HasError() || diags.WarningsCount() != 1 {
+		t.Errorf("diagnostics = %v, want one warning", diags)
+	}
+}
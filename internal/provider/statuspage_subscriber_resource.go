@@ -9,6 +9,7 @@ import (
 	"strconv"
 	"strings"
 
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -28,6 +29,7 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &StatusPageSubscriberResource{}
 var _ resource.ResourceWithImportState = &StatusPageSubscriberResource{}
+var _ resource.ResourceWithIdentity = &StatusPageSubscriberResource{}
 
 func NewStatusPageSubscriberResource() resource.Resource {
 	return &StatusPageSubscriberResource{}
@@ -36,6 +38,7 @@ func NewStatusPageSubscriberResource() resource.Resource {
 // StatusPageSubscriberResource defines the resource implementation.
 type StatusPageSubscriberResource struct {
 	client hyperping.HyperpingAPI
+	drift  *driftLog
 }
 
 // StatusPageSubscriberResourceModel describes the resource data model.
@@ -49,6 +52,8 @@ type StatusPageSubscriberResourceModel struct {
 	Language        types.String `tfsdk:"language"`
 	CreatedAt       types.String `tfsdk:"created_at"`
 	Value           types.String `tfsdk:"value"`
+
+	Timeouts timeouts.Value `tfsdk:"timeouts"`
 }
 
 func (r *StatusPageSubscriberResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -158,6 +163,14 @@ func (r *StatusPageSubscriberResource) Schema(ctx context.Context, req resource.
 				},
 			},
 		},
+
+		Blocks: map[string]schema.Block{
+			"timeouts": timeoutsBlock(ctx, timeouts.Opts{
+				Create: true,
+				Read:   true,
+				Delete: true,
+			}),
+		},
 	}
 }
 
@@ -172,7 +185,8 @@ func (r *StatusPageSubscriberResource) Configure(ctx context.Context, req resour
 		return
 	}
 
-	r.client = clients.RESTAPI
+	r.client = clients.restAPI()
+	r.drift = clients.driftLog
 }
 
 func (r *StatusPageSubscriberResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -184,6 +198,13 @@ func (r *StatusPageSubscriberResource) Create(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, plan.Timeouts.Create, defaultCreateTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// email/phone/teams_webhook_url are write-only (TF-09): their values exist only
 	// in the config, never the plan or state. Read them from the config for
 	// validation and the API request; they are nulled before being saved to state.
@@ -213,7 +234,8 @@ func (r *StatusPageSubscriberResource) Create(ctx context.Context, req resource.
 	// Add subscriber via API
 	subscriber, err := r.client.AddSubscriber(ctx, plan.StatusPageUUID.ValueString(), *addReq)
 	if err != nil {
-		resp.Diagnostics.AddError("Error adding subscriber", err.Error())
+		resp.Diagnostics.AddError("Error adding subscriber", redactSecrets(err.Error())+validationDetailsText("Subscriber", err))
+		resp.Diagnostics.Append(newValidationDetailDiagnostics("Subscriber", "create", err)...)
 		return
 	}
 
@@ -222,6 +244,7 @@ func (r *StatusPageSubscriberResource) Create(ctx context.Context, req resource.
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(setSubscriberIdentity(ctx, resp.Identity, plan.StatusPageUUID, plan.ID)...)
 }
 
 func (r *StatusPageSubscriberResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -233,6 +256,14 @@ func (r *StatusPageSubscriberResource) Read(ctx context.Context, req resource.Re
 		return
 	}
 
+	resp.Diagnostics.Append(setSubscriberIdentity(ctx, resp.Identity, state.StatusPageUUID, state.ID)...)
+
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Read, defaultReadTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// List subscribers to find this one (API doesn't have GetSubscriber endpoint).
 	// Use the subscriber type as a server-side filter to reduce the number of pages
 	// fetched. The API does not support filtering by ID or email, so we still need
@@ -254,7 +285,7 @@ func (r *StatusPageSubscriberResource) Read(ctx context.Context, req resource.Re
 				resp.State.RemoveResource(ctx)
 				return
 			}
-			resp.Diagnostics.AddError("Error reading subscriber", err.Error())
+			resp.Diagnostics.AddError("Error reading subscriber", redactSecrets(err.Error()))
 			return
 		}
 
@@ -282,17 +313,23 @@ func (r *StatusPageSubscriberResource) Read(ctx context.Context, req resource.Re
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	r.drift.log(ctx, "hyperping_statuspage_subscriber", req.State, resp.State)
 }
 
 func (r *StatusPageSubscriberResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	// All fields are ForceNew, so Update should never be called
-	// If it is called, just read the state back (no-op)
+	// All fields other than timeouts are ForceNew, so Update only stores the
+	// new timeouts block.
+	var plan StatusPageSubscriberResourceModel
 	var state StatusPageSubscriberResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
+
+	state.Timeouts = plan.Timeouts
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+	resp.Diagnostics.Append(setSubscriberIdentity(ctx, resp.Identity, state.StatusPageUUID, state.ID)...)
 }
 
 func (r *StatusPageSubscriberResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -304,25 +341,42 @@ func (r *StatusPageSubscriberResource) Delete(ctx context.Context, req resource.
 		return
 	}
 
+	ctx = withCallPriority(ctx, priorityMutation)
+	ctx, cancel := withOperationTimeout(ctx, state.Timeouts.Delete, defaultDeleteTimeout, &resp.Diagnostics)
+	defer cancel()
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Delete subscriber via API
 	subscriberID := int(state.ID.ValueInt64())
 	err := r.client.DeleteSubscriber(ctx, state.StatusPageUUID.ValueString(), subscriberID)
 	if err != nil {
 		if !hyperping.IsNotFound(err) {
-			resp.Diagnostics.AddError("Error deleting subscriber", err.Error())
+			resp.Diagnostics.AddError("Error deleting subscriber", redactSecrets(err.Error()))
 			return
 		}
 		// Already deleted, continue
 	}
 }
 
+// IdentitySchema defines the resource identity used by import blocks.
+func (r *StatusPageSubscriberResource) IdentitySchema(_ context.Context, _ resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = subscriberIdentitySchema()
+}
+
 func (r *StatusPageSubscriberResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	id := subscriberImportID(ctx, req, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Import ID format: statuspage_uuid:subscriber_id
-	parts := strings.Split(req.ID, ":")
+	parts := strings.Split(id, ":")
 	if len(parts) != 2 {
 		resp.Diagnostics.AddError(
 			"Invalid Import ID",
-			fmt.Sprintf("Import ID must be in format 'statuspage_uuid:subscriber_id', got: %s", req.ID),
+			fmt.Sprintf("Import ID must be in format 'statuspage_uuid:subscriber_id', got: %s", id),
 		)
 		return
 	}
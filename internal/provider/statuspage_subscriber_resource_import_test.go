@@ -10,6 +10,8 @@ import (
 
 	tfresource "github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/develeap/terraform-provider-hyperping/internal/provider/testutil"
 )
 
 func TestAccStatusPageSubscriberResource_import(t *testing.T) {
@@ -21,7 +23,8 @@ func TestAccStatusPageSubscriberResource_import(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Step 1: Create the resource
 			{
-				Config: testAccStatusPageSubscriberResourceConfig_email(server.URL),
+				Config:           testAccStatusPageSubscriberResourceConfig_email(server.URL),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_statuspage_subscriber.email", "type", "email"),
 					tfresource.TestCheckNoResourceAttr("hyperping_statuspage_subscriber.email", "email"),
@@ -40,6 +43,11 @@ func TestAccStatusPageSubscriberResource_import(t *testing.T) {
 					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["statuspage_uuid"], rs.Primary.Attributes["id"]), nil
 				},
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStepWithID("hyperping_statuspage_subscriber.email", func(s *terraform.State) (string, error) {
+				rs := s.RootModule().Resources["hyperping_statuspage_subscriber.email"]
+				return fmt.Sprintf("%s:%s", rs.Primary.Attributes["statuspage_uuid"], rs.Primary.Attributes["id"]), nil
+			}),
 		},
 	})
 }
@@ -53,7 +61,8 @@ func TestAccStatusPageSubscriberResource_importSMS(t *testing.T) {
 		Steps: []tfresource.TestStep{
 			// Create resource
 			{
-				Config: testAccStatusPageSubscriberResourceConfig_sms(server.URL),
+				Config:           testAccStatusPageSubscriberResourceConfig_sms(server.URL),
+				ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
 				Check: tfresource.ComposeTestCheckFunc(
 					tfresource.TestCheckResourceAttr("hyperping_statuspage_subscriber.sms", "type", "sms"),
 					tfresource.TestCheckNoResourceAttr("hyperping_statuspage_subscriber.sms", "phone"),
@@ -70,6 +79,11 @@ func TestAccStatusPageSubscriberResource_importSMS(t *testing.T) {
 					return fmt.Sprintf("%s:%s", rs.Primary.Attributes["statuspage_uuid"], rs.Primary.Attributes["id"]), nil
 				},
 			},
+			// Import with an import block and expect no changes
+			testutil.NoOpImportStepWithID("hyperping_statuspage_subscriber.sms", func(s *terraform.State) (string, error) {
+				rs := s.RootModule().Resources["hyperping_statuspage_subscriber.sms"]
+				return fmt.Sprintf("%s:%s", rs.Primary.Attributes["statuspage_uuid"], rs.Primary.Attributes["id"]), nil
+			}),
 		},
 	})
 }
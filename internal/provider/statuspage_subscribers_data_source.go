@@ -130,10 +130,13 @@ func (d *StatusPageSubscribersDataSource) Configure(ctx context.Context, req dat
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 func (d *StatusPageSubscribersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config StatusPageSubscribersDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -170,7 +173,7 @@ func (d *StatusPageSubscribersDataSource) Read(ctx context.Context, req datasour
 		resp.Diagnostics.AddError(
 			"Error reading subscribers",
 			fmt.Sprintf("Could not list subscribers for status page %s: %s",
-				config.StatusPageUUID.ValueString(), err.Error()),
+				config.StatusPageUUID.ValueString(), redactSecrets(err.Error())),
 		)
 		return
 	}
@@ -0,0 +1,259 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// ValidateConfig implements resource.ResourceWithValidateConfig for cross-field
+// validation of status page settings. This runs at plan time, before any API
+// call, giving users immediate feedback on invalid configurations.
+func (r *StatusPageResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	validateSAMLConnection(ctx, req, resp)
+	validateTranslations(ctx, req, resp)
+}
+
+// validateSAMLConnection warns when saml_sso is enabled without
+// sso_connection_uuid in the config. Without a connection the API accepts the
+// flag but the page has no identity provider to redirect visitors to, locking
+// everyone out. It is a warning rather than an error because
+// sso_connection_uuid is Optional+Computed: an unset value keeps the
+// connection already on the page, which the config cannot see.
+func validateSAMLConnection(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	authPath := path.Root("settings").AtName("authentication")
+
+	var samlSSO types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, authPath.AtName("saml_sso"), &samlSSO)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Skip validation when the flag is unknown (module composition support).
+	if samlSSO.IsNull() || samlSSO.IsUnknown() || !samlSSO.ValueBool() {
+		return
+	}
+
+	var connection types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, authPath.AtName("sso_connection_uuid"), &connection)...)
+	if resp.Diagnostics.HasError() || connection.IsUnknown() {
+		return
+	}
+
+	if connection.IsNull() || connection.ValueString() == "" {
+		resp.Diagnostics.AddAttributeWarning(
+			authPath.AtName("sso_connection_uuid"),
+			"Missing SSO Connection",
+			"saml_sso is true but sso_connection_uuid is not set. The page keeps the SSO connection it already "+
+				"has, if any; without one, visitors have no identity provider to sign in with. Configure the "+
+				"SAML identity provider in the Hyperping dashboard and reference its SSO connection UUID here.",
+		)
+	}
+}
+
+// validateTranslations checks the localized name and description maps under
+// sections against settings.languages. Every map key must be a configured
+// language (or settings.default_language): the API stores any key it is
+// given, so a typo such as "enn" silently becomes an extra language on the
+// page. Every language must also have a non-empty entry. The rendered page
+// falls back to the default language for a missing entry, so a partially
+// translated page otherwise goes unnoticed. allow_incomplete_translations =
+// true opts out of the second check only.
+//
+// Service descriptions are not checked for missing entries: the API stores a
+// single plain string for them, not one entry per language.
+func validateTranslations(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var languageList types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("settings").AtName("languages"), &languageList)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	languages, ok := knownStrings(languageList)
+	if !ok || len(languages) == 0 {
+		return
+	}
+
+	var defaultLanguage types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("settings").AtName("default_language"), &defaultLanguage)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkKeys := !defaultLanguage.IsUnknown()
+	allowed := languages
+	if checkKeys && !defaultLanguage.IsNull() && !slices.Contains(languages, defaultLanguage.ValueString()) {
+		allowed = append(slices.Clone(languages), defaultLanguage.ValueString())
+	}
+
+	var allowIncomplete types.Bool
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("allow_incomplete_translations"), &allowIncomplete)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	checkComplete := !allowIncomplete.IsUnknown() && !allowIncomplete.ValueBool()
+
+	var sections types.List
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, path.Root("sections"), &sections)...)
+	if resp.Diagnostics.HasError() || sections.IsNull() || sections.IsUnknown() {
+		return
+	}
+
+	for _, m := range localizedMaps(sections) {
+		if checkKeys {
+			checkTranslationKeys(m.value, m.path, allowed, resp)
+		}
+		if checkComplete && m.translated {
+			checkTranslations(m.value, m.path, languages, resp)
+		}
+	}
+}
+
+// localizedMap is a localized name or description map under sections.
+type localizedMap struct {
+	path  path.Path
+	value attr.Value
+	// translated is false for service descriptions. mapTFToService sends
+	// one plain string (the "en" entry, or the first non-empty one), and
+	// nested service descriptions are not stored at all, so there is no
+	// per-language text to complete.
+	translated bool
+}
+
+// localizedMaps returns the localized maps of the known sections and
+// services, in configuration order.
+func localizedMaps(sections types.List) []localizedMap {
+	var result []localizedMap
+	for i, secElem := range sections.Elements() {
+		secObj, ok := secElem.(types.Object)
+		if !ok || secObj.IsNull() || secObj.IsUnknown() {
+			continue
+		}
+		secPath := path.Root("sections").AtListIndex(i)
+		secAttrs := secObj.Attributes()
+		result = append(result, localizedMap{secPath.AtName("name"), secAttrs["name"], true})
+
+		services := knownObjects(secAttrs["services"])
+		for _, j := range slices.Sorted(maps.Keys(services)) {
+			svcAttrs := services[j].Attributes()
+			svcPath := secPath.AtName("services").AtListIndex(j)
+			result = append(result,
+				localizedMap{svcPath.AtName("name"), svcAttrs["name"], true},
+				localizedMap{svcPath.AtName("description"), svcAttrs["description"], false})
+
+			nested := knownObjects(svcAttrs["services"])
+			for _, k := range slices.Sorted(maps.Keys(nested)) {
+				nestedPath := svcPath.AtName("services").AtListIndex(k)
+				nestedAttrs := nested[k].Attributes()
+				result = append(result,
+					localizedMap{nestedPath.AtName("name"), nestedAttrs["name"], true},
+					localizedMap{nestedPath.AtName("description"), nestedAttrs["description"], false})
+			}
+		}
+	}
+	return result
+}
+
+// checkTranslationKeys reports the keys of a localized map that are not
+// allowed languages. Null and unknown maps are not checked.
+func checkTranslationKeys(value attr.Value, p path.Path, allowed []string, resp *resource.ValidateConfigResponse) {
+	m, ok := value.(types.Map)
+	if !ok || m.IsNull() || m.IsUnknown() {
+		return
+	}
+
+	var unknown []string
+	for lang := range m.Elements() {
+		if !slices.Contains(allowed, lang) {
+			unknown = append(unknown, lang)
+		}
+	}
+	if len(unknown) == 0 {
+		return
+	}
+	sort.Strings(unknown)
+
+	resp.Diagnostics.AddAttributeError(
+		p,
+		"Unknown Translation Language",
+		fmt.Sprintf("Unknown language keys: %s. Allowed keys are the page languages: %s. The API stores "+
+			"any key, so it would show on the page as an extra language. Fix the key, or add the language "+
+			"to settings.languages.",
+			strings.Join(unknown, ", "), strings.Join(allowed, ", ")),
+	)
+}
+
+// checkTranslations reports the languages missing from a localized map, or
+// mapped to an empty string. Null and unknown maps are not checked.
+func checkTranslations(value attr.Value, p path.Path, languages []string, resp *resource.ValidateConfigResponse) {
+	m, ok := value.(types.Map)
+	if !ok || m.IsNull() || m.IsUnknown() {
+		return
+	}
+
+	elements := m.Elements()
+	var missing []string
+	for _, lang := range languages {
+		text, ok := elements[lang].(types.String)
+		if !ok || (!text.IsUnknown() && text.ValueString() == "") {
+			missing = append(missing, lang)
+		}
+	}
+	if len(missing) == 0 {
+		return
+	}
+
+	resp.Diagnostics.AddAttributeError(
+		p,
+		"Incomplete Translations",
+		fmt.Sprintf("Missing translations for: %s. settings.languages is [%s], and visitors using a missing "+
+			"language see the default language text instead. Add the missing keys, or set "+
+			"allow_incomplete_translations = true to skip this check.",
+			strings.Join(missing, ", "), strings.Join(languages, ", ")),
+	)
+}
+
+// knownStrings returns the values of a list of strings, or false if the list
+// or any element is unknown.
+func knownStrings(list types.List) ([]string, bool) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, false
+	}
+	values := make([]string, 0, len(list.Elements()))
+	for _, elem := range list.Elements() {
+		s, ok := elem.(types.String)
+		if !ok || s.IsUnknown() {
+			return nil, false
+		}
+		if !s.IsNull() {
+			values = append(values, s.ValueString())
+		}
+	}
+	return values, true
+}
+
+// knownObjects returns the elements of a list of objects, keyed by list
+// index, skipping null and unknown elements. A null or unknown list yields
+// no elements.
+func knownObjects(value attr.Value) map[int]types.Object {
+	list, ok := value.(types.List)
+	if !ok || list.IsNull() || list.IsUnknown() {
+		return nil
+	}
+	objects := make(map[int]types.Object, len(list.Elements()))
+	for i, elem := range list.Elements() {
+		if obj, ok := elem.(types.Object); ok && !obj.IsNull() && !obj.IsUnknown() {
+			objects[i] = obj
+		}
+	}
+	return objects
+}
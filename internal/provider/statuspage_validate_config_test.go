@@ -0,0 +1,498 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// statusPageConfigBuilder constructs tftypes.Value objects for ValidateConfig tests.
+// Only the settings attributes that validation reads are configurable; everything
+// else is null.
+type statusPageConfigBuilder struct {
+	samlSSO           interface{} // bool, nil (null), or tftypes.UnknownValue
+	ssoConnectionUUID interface{} // string, nil (null), or tftypes.UnknownValue
+
+	languages       interface{}   // []string, nil (["en"]), or tftypes.UnknownValue
+	defaultLanguage interface{}   // string, nil (null), or tftypes.UnknownValue
+	sections        []testSection // nil leaves sections null
+	allowIncomplete interface{}   // bool, nil (null), or tftypes.UnknownValue
+}
+
+// testSection and testService describe localized maps for translation
+// validation tests. A nil map is null; a map value of nil is unknown.
+type testSection struct {
+	name     map[string]interface{}
+	services []testService
+}
+
+type testService struct {
+	name        map[string]interface{}
+	description map[string]interface{}
+	nested      []testService
+}
+
+func (b *statusPageConfigBuilder) buildConfigValue(s schema.Schema) tftypes.Value {
+	ctx := context.Background()
+	objType := s.Type().TerraformType(ctx).(tftypes.Object)
+
+	vals := nullObjectAttributes(objType)
+	vals["name"] = tftypes.NewValue(tftypes.String, "Test Status Page")
+
+	settingsType := objType.AttributeTypes["settings"].(tftypes.Object)
+	settings := nullObjectAttributes(settingsType)
+	settings["name"] = tftypes.NewValue(tftypes.String, "Test Status Page")
+	settings["languages"] = buildLanguagesTFValue(b.languages)
+	settings["default_language"] = buildStatusPageTFValue(b.defaultLanguage, tftypes.String)
+
+	authType := settingsType.AttributeTypes["authentication"].(tftypes.Object)
+	auth := nullObjectAttributes(authType)
+	auth["saml_sso"] = buildStatusPageTFValue(b.samlSSO, tftypes.Bool)
+	auth["sso_connection_uuid"] = buildStatusPageTFValue(b.ssoConnectionUUID, tftypes.String)
+	settings["authentication"] = tftypes.NewValue(authType, auth)
+
+	vals["settings"] = tftypes.NewValue(settingsType, settings)
+	vals["allow_incomplete_translations"] = buildStatusPageTFValue(b.allowIncomplete, tftypes.Bool)
+
+	if b.sections != nil {
+		sectionsType := objType.AttributeTypes["sections"].(tftypes.List)
+		sectionType := sectionsType.ElementType.(tftypes.Object)
+		sections := make([]tftypes.Value, 0, len(b.sections))
+		for _, sec := range b.sections {
+			attrs := nullObjectAttributes(sectionType)
+			attrs["name"] = buildLocalizedTFValue(sec.name)
+			attrs["services"] = buildServicesTFValue(sectionType.AttributeTypes["services"].(tftypes.List), sec.services)
+			sections = append(sections, tftypes.NewValue(sectionType, attrs))
+		}
+		vals["sections"] = tftypes.NewValue(sectionsType, sections)
+	}
+
+	return tftypes.NewValue(objType, vals)
+}
+
+func buildLanguagesTFValue(v interface{}) tftypes.Value {
+	listType := tftypes.List{ElementType: tftypes.String}
+	if v == nil {
+		v = []string{"en"}
+	}
+	switch val := v.(type) {
+	case []string:
+		elems := make([]tftypes.Value, 0, len(val))
+		for _, lang := range val {
+			elems = append(elems, tftypes.NewValue(tftypes.String, lang))
+		}
+		return tftypes.NewValue(listType, elems)
+	default:
+		return tftypes.NewValue(listType, tftypes.UnknownValue)
+	}
+}
+
+func buildLocalizedTFValue(m map[string]interface{}) tftypes.Value {
+	mapType := tftypes.Map{ElementType: tftypes.String}
+	if m == nil {
+		return tftypes.NewValue(mapType, nil)
+	}
+	elems := make(map[string]tftypes.Value, len(m))
+	for lang, text := range m {
+		if text == nil {
+			elems[lang] = tftypes.NewValue(tftypes.String, tftypes.UnknownValue)
+			continue
+		}
+		elems[lang] = tftypes.NewValue(tftypes.String, text)
+	}
+	return tftypes.NewValue(mapType, elems)
+}
+
+func buildServicesTFValue(listType tftypes.List, services []testService) tftypes.Value {
+	if services == nil {
+		return tftypes.NewValue(listType, nil)
+	}
+	serviceType := listType.ElementType.(tftypes.Object)
+	elems := make([]tftypes.Value, 0, len(services))
+	for _, svc := range services {
+		attrs := nullObjectAttributes(serviceType)
+		attrs["name"] = buildLocalizedTFValue(svc.name)
+		attrs["description"] = buildLocalizedTFValue(svc.description)
+		if nestedType, ok := serviceType.AttributeTypes["services"].(tftypes.List); ok {
+			attrs["services"] = buildServicesTFValue(nestedType, svc.nested)
+		}
+		elems = append(elems, tftypes.NewValue(serviceType, attrs))
+	}
+	return tftypes.NewValue(listType, elems)
+}
+
+func buildStatusPageTFValue(v interface{}, tfType tftypes.Type) tftypes.Value {
+	switch val := v.(type) {
+	case string, bool:
+		return tftypes.NewValue(tfType, val)
+	case nil:
+		return tftypes.NewValue(tfType, nil)
+	default:
+		return tftypes.NewValue(tfType, tftypes.UnknownValue)
+	}
+}
+
+// nullObjectAttributes returns a value map with every attribute of objType set to null.
+func nullObjectAttributes(objType tftypes.Object) map[string]tftypes.Value {
+	vals := make(map[string]tftypes.Value, len(objType.AttributeTypes))
+	for name, attrType := range objType.AttributeTypes {
+		vals[name] = tftypes.NewValue(attrType, nil)
+	}
+	return vals
+}
+
+func runStatusPageValidateConfig(t *testing.T, b *statusPageConfigBuilder) *resource.ValidateConfigResponse {
+	t.Helper()
+
+	r := &StatusPageResource{}
+	ctx := context.Background()
+
+	schemaResp := &resource.SchemaResponse{}
+	r.Schema(ctx, resource.SchemaRequest{}, schemaResp)
+
+	config := tfsdk.Config{
+		Schema: schemaResp.Schema,
+		Raw:    b.buildConfigValue(schemaResp.Schema),
+	}
+
+	req := resource.ValidateConfigRequest{Config: config}
+	resp := &resource.ValidateConfigResponse{}
+	r.ValidateConfig(ctx, req, resp)
+
+	return resp
+}
+
+func TestStatusPageValidateConfig_SAMLConnection(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		config      statusPageConfigBuilder
+		wantWarning bool
+	}{
+		{
+			name:   "saml disabled without connection is valid",
+			config: statusPageConfigBuilder{samlSSO: false},
+		},
+		{
+			name:   "saml null without connection is valid",
+			config: statusPageConfigBuilder{},
+		},
+		{
+			name:   "saml enabled with connection is valid",
+			config: statusPageConfigBuilder{samlSSO: true, ssoConnectionUUID: "sso_abc123"},
+		},
+		{
+			name:        "saml enabled without connection warns",
+			config:      statusPageConfigBuilder{samlSSO: true},
+			wantWarning: true,
+		},
+		{
+			name:        "saml enabled with empty connection warns",
+			config:      statusPageConfigBuilder{samlSSO: true, ssoConnectionUUID: ""},
+			wantWarning: true,
+		},
+		{
+			name:   "saml unknown skips validation",
+			config: statusPageConfigBuilder{samlSSO: tftypes.UnknownValue},
+		},
+		{
+			name:   "connection unknown skips validation",
+			config: statusPageConfigBuilder{samlSSO: true, ssoConnectionUUID: tftypes.UnknownValue},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp := runStatusPageValidateConfig(t, &tt.config)
+
+			if resp.Diagnostics.HasError() {
+				t.Errorf("unexpected validation error: %v", resp.Diagnostics)
+			}
+
+			found := false
+			for _, d := range resp.Diagnostics.Warnings() {
+				if strings.Contains(d.Detail(), "sso_connection_uuid is not set") {
+					found = true
+					break
+				}
+			}
+			if found != tt.wantWarning {
+				t.Errorf("sso_connection_uuid warning = %v, want %v: %v", found, tt.wantWarning, resp.Diagnostics)
+			}
+		})
+	}
+}
+
+func TestStatusPageValidateConfig_Translations(t *testing.T) {
+	t.Parallel()
+
+	enFr := []string{"en", "fr"}
+	complete := map[string]interface{}{"en": "API", "fr": "API"}
+	enOnly := map[string]interface{}{"en": "API"}
+
+	tests := []struct {
+		name       string
+		config     statusPageConfigBuilder
+		wantErrors int
+		errMatch   string
+	}{
+		{
+			name:   "no sections is valid",
+			config: statusPageConfigBuilder{languages: enFr},
+		},
+		{
+			name: "complete translations are valid",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections: []testSection{{
+					name:     complete,
+					services: []testService{{name: complete, description: complete}},
+				}},
+			},
+		},
+		{
+			name: "missing section name language",
+			config: statusPageConfigBuilder{
+				languages: []string{"en", "fr", "de"},
+				sections:  []testSection{{name: enOnly}},
+			},
+			wantErrors: 1,
+			errMatch:   "Missing translations for: fr, de.",
+		},
+		{
+			name: "missing service name language",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections: []testSection{{
+					name:     complete,
+					services: []testService{{name: enOnly, description: complete}},
+				}},
+			},
+			wantErrors: 1,
+			errMatch:   "Missing translations for: fr.",
+		},
+		{
+			name: "empty translation counts as missing",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections:  []testSection{{name: map[string]interface{}{"en": "API", "fr": ""}}},
+			},
+			wantErrors: 1,
+			errMatch:   "Missing translations for: fr.",
+		},
+		{
+			name: "missing nested service name language",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections: []testSection{{
+					name: complete,
+					services: []testService{{
+						name:   complete,
+						nested: []testService{{name: enOnly}},
+					}},
+				}},
+			},
+			wantErrors: 1,
+			errMatch:   "Missing translations for: fr.",
+		},
+		{
+			name: "service description is not checked",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections: []testSection{{
+					name:     complete,
+					services: []testService{{name: complete, description: enOnly}},
+				}},
+			},
+		},
+		{
+			name: "nested service description is not checked",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections: []testSection{{
+					name: complete,
+					services: []testService{{
+						name:   complete,
+						nested: []testService{{name: complete, description: enOnly}},
+					}},
+				}},
+			},
+		},
+		{
+			name: "unknown translation is not missing",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections:  []testSection{{name: map[string]interface{}{"en": "API", "fr": nil}}},
+			},
+		},
+		{
+			name: "unknown languages skip validation",
+			config: statusPageConfigBuilder{
+				languages: tftypes.UnknownValue,
+				sections:  []testSection{{name: enOnly}},
+			},
+		},
+		{
+			name: "opt-out skips validation",
+			config: statusPageConfigBuilder{
+				languages:       enFr,
+				sections:        []testSection{{name: enOnly}},
+				allowIncomplete: true,
+			},
+		},
+		{
+			name: "unknown opt-out skips validation",
+			config: statusPageConfigBuilder{
+				languages:       enFr,
+				sections:        []testSection{{name: enOnly}},
+				allowIncomplete: tftypes.UnknownValue,
+			},
+		},
+		{
+			name: "explicit false opt-out still validates",
+			config: statusPageConfigBuilder{
+				languages:       enFr,
+				sections:        []testSection{{name: enOnly}},
+				allowIncomplete: false,
+			},
+			wantErrors: 1,
+			errMatch:   "allow_incomplete_translations = true",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp := runStatusPageValidateConfig(t, &tt.config)
+
+			errs := resp.Diagnostics.Errors()
+			if len(errs) != tt.wantErrors {
+				t.Fatalf("got %d errors, want %d: %v", len(errs), tt.wantErrors, resp.Diagnostics)
+			}
+			for _, d := range errs {
+				if d.Summary() != "Incomplete Translations" {
+					t.Errorf("unexpected summary %q", d.Summary())
+				}
+				if !strings.Contains(d.Detail(), tt.errMatch) {
+					t.Errorf("expected error containing %q, got: %s", tt.errMatch, d.Detail())
+				}
+			}
+		})
+	}
+}
+
+func TestStatusPageValidateConfig_TranslationKeys(t *testing.T) {
+	t.Parallel()
+
+	enFr := []string{"en", "fr"}
+	complete := map[string]interface{}{"en": "API", "fr": "API"}
+
+	tests := []struct {
+		name       string
+		config     statusPageConfigBuilder
+		wantErrors int
+		errMatch   string
+	}{
+		{
+			name: "configured languages are valid",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections:  []testSection{{name: complete}},
+			},
+		},
+		{
+			name: "typo in section name key",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections:  []testSection{{name: map[string]interface{}{"en": "API", "fr": "API", "enn": "API"}}},
+			},
+			wantErrors: 1,
+			errMatch:   "Unknown language keys: enn. Allowed keys are the page languages: en, fr.",
+		},
+		{
+			name: "unknown keys are listed in order",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections: []testSection{{
+					name:     complete,
+					services: []testService{{name: map[string]interface{}{"en": "API", "fr": "API", "it": "API", "de": "API"}}},
+				}},
+			},
+			wantErrors: 1,
+			errMatch:   "Unknown language keys: de, it.",
+		},
+		{
+			name: "nested service description keys are checked",
+			config: statusPageConfigBuilder{
+				languages: enFr,
+				sections: []testSection{{
+					name: complete,
+					services: []testService{{
+						name:   complete,
+						nested: []testService{{name: complete, description: map[string]interface{}{"fre": "API"}}},
+					}},
+				}},
+			},
+			wantErrors: 1,
+			errMatch:   "Unknown language keys: fre.",
+		},
+		{
+			name: "default language is allowed",
+			config: statusPageConfigBuilder{
+				languages:       []string{"fr"},
+				defaultLanguage: "en",
+				sections:        []testSection{{name: map[string]interface{}{"en": "API", "fr": "API"}}},
+			},
+		},
+		{
+			name: "opt-out still checks keys",
+			config: statusPageConfigBuilder{
+				languages:       enFr,
+				sections:        []testSection{{name: map[string]interface{}{"enn": "API"}}},
+				allowIncomplete: true,
+			},
+			wantErrors: 1,
+			errMatch:   "Unknown language keys: enn.",
+		},
+		{
+			name: "unknown default language skips the key check",
+			config: statusPageConfigBuilder{
+				languages:       enFr,
+				defaultLanguage: tftypes.UnknownValue,
+				sections:        []testSection{{name: map[string]interface{}{"enn": "API"}}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			resp := runStatusPageValidateConfig(t, &tt.config)
+
+			var errs []string
+			for _, d := range resp.Diagnostics.Errors() {
+				if d.Summary() == "Unknown Translation Language" {
+					errs = append(errs, d.Detail())
+				}
+			}
+			if len(errs) != tt.wantErrors {
+				t.Fatalf("got %d key errors, want %d: %v", len(errs), tt.wantErrors, resp.Diagnostics)
+			}
+			for _, detail := range errs {
+				if !strings.Contains(detail, tt.errMatch) {
+					t.Errorf("expected error containing %q, got: %s", tt.errMatch, detail)
+				}
+			}
+		})
+	}
+}
@@ -210,6 +210,10 @@ func (d *StatusPagesDataSource) Schema(ctx context.Context, req datasource.Schem
 										MarkdownDescription: "Split services into separate rows",
 										Computed:            true,
 									},
+									"position": schema.Int64Attribute{
+										MarkdownDescription: "Position of the section on the page, starting at 1",
+										Computed:            true,
+									},
 									"services": schema.ListNestedAttribute{
 										MarkdownDescription: "Services/monitors in this section",
 										Computed:            true,
@@ -232,6 +236,10 @@ func (d *StatusPagesDataSource) Schema(ctx context.Context, req datasource.Schem
 													MarkdownDescription: "Whether this is a group",
 													Computed:            true,
 												},
+												"position": schema.Int64Attribute{
+													MarkdownDescription: "Position of the service within its section, starting at 1",
+													Computed:            true,
+												},
 												"show_uptime": schema.BoolAttribute{
 													MarkdownDescription: "Show uptime percentage",
 													Computed:            true,
@@ -332,10 +340,13 @@ func (d *StatusPagesDataSource) Configure(ctx context.Context, req datasource.Co
 		return
 	}
 
-	d.client = clients.REST
+	d.client = clients.restAPI()
 }
 
 func (d *StatusPagesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, cancel := context.WithTimeout(ctx, defaultReadTimeout)
+	defer cancel()
+
 	var config StatusPagesDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -362,7 +373,7 @@ func (d *StatusPagesDataSource) Read(ctx context.Context, req datasource.ReadReq
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error reading status pages",
-			fmt.Sprintf("Could not list status pages: %s", err.Error()),
+			fmt.Sprintf("Could not list status pages: %s", redactSecrets(err.Error())),
 		)
 		return
 	}
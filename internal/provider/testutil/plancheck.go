@@ -0,0 +1,70 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package testutil
+
+import (
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+// No-op plan assertions
+//
+// Most drift bugs (booleans the API echoes differently, localized fields
+// returned with extra languages) surface as a plan that is not empty right
+// after an apply or an import. These helpers make that guarantee explicit in
+// acceptance tests, and the plan checks name the resource and attribute that
+// changed when it fails:
+//
+//	Steps: []resource.TestStep{
+//	    {
+//	        Config:           config,
+//	        ConfigPlanChecks: testutil.ExpectNoOpAfterApply(),
+//	    },
+//	    testutil.NoOpImportStep("hyperping_monitor.test"),
+//	}
+
+// ExpectNoOpAfterApply returns plan checks asserting that the plan is empty
+// after the step's configuration is applied and refreshed.
+func ExpectNoOpAfterApply() resource.ConfigPlanChecks {
+	return resource.ConfigPlanChecks{
+		PostApplyPreRefresh:  []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+		PostApplyPostRefresh: []plancheck.PlanCheck{plancheck.ExpectEmptyPlan()},
+	}
+}
+
+// NoOpImportStep returns a step that imports resourceName with an import
+// block into the previous step's configuration and asserts that the import
+// plans no changes, so a resource imported from the API matches the
+// configuration that created it. It requires Terraform 1.5 or later.
+func NoOpImportStep(resourceName string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:    resourceName,
+		ImportState:     true,
+		ImportStateKind: resource.ImportBlockWithID,
+		ImportPlanChecks: resource.ImportPlanChecks{
+			PreApply: []plancheck.PlanCheck{
+				plancheck.ExpectResourceAction(resourceName, plancheck.ResourceActionNoop),
+				plancheck.ExpectEmptyPlan(),
+			},
+		},
+	}
+}
+
+// NoOpImportStepWithID is NoOpImportStep for resources whose import ID is
+// built from state, such as the "statuspage_uuid:id" of subscribers.
+func NoOpImportStepWithID(resourceName string, idFunc resource.ImportStateIdFunc) resource.TestStep {
+	step := NoOpImportStep(resourceName)
+	step.ImportStateIdFunc = idFunc
+	return step
+}
+
+// NoOpIdentityImportStep is NoOpImportStep for an import block that
+// addresses the resource by its identity instead of an import ID. Resource
+// identity requires Terraform 1.12 or later, so test cases using it need a
+// tfversion.SkipBelow(tfversion.Version1_12_0) check.
+func NoOpIdentityImportStep(resourceName string) resource.TestStep {
+	step := NoOpImportStep(resourceName)
+	step.ImportStateKind = resource.ImportBlockWithResourceIdentity
+	return step
+}
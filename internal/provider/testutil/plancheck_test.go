@@ -0,0 +1,55 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package testutil
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestExpectNoOpAfterApply(t *testing.T) {
+	checks := ExpectNoOpAfterApply()
+	if len(checks.PreApply) != 0 {
+		t.Errorf("PreApply has %d checks, want none: the first plan of a step is expected to change", len(checks.PreApply))
+	}
+	if len(checks.PostApplyPreRefresh) != 1 || len(checks.PostApplyPostRefresh) != 1 {
+		t.Errorf("expected one post-apply check before and after refresh, got %d and %d",
+			len(checks.PostApplyPreRefresh), len(checks.PostApplyPostRefresh))
+	}
+}
+
+func TestNoOpImportStep(t *testing.T) {
+	step := NoOpImportStep("hyperping_monitor.test")
+	if step.ResourceName != "hyperping_monitor.test" || !step.ImportState {
+		t.Errorf("unexpected step: %+v", step)
+	}
+	if step.ImportStateKind != resource.ImportBlockWithID {
+		t.Errorf("ImportStateKind = %v, want ImportBlockWithID", step.ImportStateKind)
+	}
+	// ImportStateVerify and ExpectNonEmptyPlan are rejected by or defeat
+	// plannable import.
+	if step.ImportStateVerify || step.ExpectNonEmptyPlan {
+		t.Error("plannable import step must not set ImportStateVerify or ExpectNonEmptyPlan")
+	}
+	if len(step.ImportPlanChecks.PreApply) != 2 {
+		t.Errorf("expected 2 import plan checks, got %d", len(step.ImportPlanChecks.PreApply))
+	}
+}
+
+func TestNoOpImportStepWithID(t *testing.T) {
+	step := NoOpImportStepWithID("hyperping_statuspage_subscriber.email", func(*terraform.State) (string, error) {
+		return "sp_test:42", nil
+	})
+	if step.ImportStateIdFunc == nil {
+		t.Fatal("ImportStateIdFunc not set")
+	}
+	if id, _ := step.ImportStateIdFunc(nil); id != "sp_test:42" {
+		t.Errorf("ImportStateIdFunc() = %q", id)
+	}
+	if step.ImportStateKind != resource.ImportBlockWithID {
+		t.Errorf("ImportStateKind = %v, want ImportBlockWithID", step.ImportStateKind)
+	}
+}
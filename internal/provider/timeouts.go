@@ -0,0 +1,62 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// Default operation timeouts, used when a resource has no timeouts block and
+// for data source reads. A timeout bounds the whole operation, retries and
+// follow-up requests (such as monitor ID translation) included.
+//
+// The REST client has no per-request HTTP timeout: these context deadlines
+// are what stop a request, so a timeouts block can give a slow operation more
+// time than the defaults.
+const (
+	defaultCreateTimeout = 5 * time.Minute
+	defaultReadTimeout   = 2 * time.Minute
+	defaultUpdateTimeout = 5 * time.Minute
+	defaultDeleteTimeout = 5 * time.Minute
+)
+
+// timeoutGetter is the signature of the timeouts.Value accessors (Create,
+// Read, Update, Delete).
+type timeoutGetter func(ctx context.Context, defaultTimeout time.Duration) (time.Duration, diag.Diagnostics)
+
+// withOperationTimeout returns ctx bounded by the timeout configured for an
+// operation, or by defaultTimeout when the timeouts block does not set it.
+// Callers must defer the returned cancel func and return early if diags has
+// errors.
+func withOperationTimeout(ctx context.Context, get timeoutGetter, defaultTimeout time.Duration, diags *diag.Diagnostics) (context.Context, context.CancelFunc) {
+	timeout, d := get(ctx, defaultTimeout)
+	diags.Append(d...)
+	if d.HasError() {
+		timeout = defaultTimeout
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+// timeoutsBlock returns the timeouts block for the operations enabled in opts,
+// documenting the default of each.
+func timeoutsBlock(ctx context.Context, opts timeouts.Opts) schema.Block {
+	opts.CreateDescription = timeoutDescription("create", defaultCreateTimeout)
+	opts.ReadDescription = timeoutDescription("read", defaultReadTimeout) +
+		" Reads run during any refresh or plan with refresh enabled."
+	opts.UpdateDescription = timeoutDescription("update", defaultUpdateTimeout)
+	opts.DeleteDescription = timeoutDescription("delete", defaultDeleteTimeout)
+	return timeouts.Block(ctx, opts)
+}
+
+func timeoutDescription(operation string, defaultTimeout time.Duration) string {
+	return fmt.Sprintf("How long the %s operation may take, including retries, as a "+
+		"[duration](https://pkg.go.dev/time#ParseDuration) such as `30s` or `10m`. Defaults to `%dm`.",
+		operation, int(defaultTimeout.Minutes()))
+}
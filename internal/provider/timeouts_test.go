@@ -0,0 +1,103 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func timeoutsValue(create string) timeouts.Value {
+	return timeouts.Value{Object: types.ObjectValueMust(
+		map[string]attr.Type{"create": types.StringType},
+		map[string]attr.Value{"create": types.StringValue(create)},
+	)}
+}
+
+func TestWithOperationTimeout(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    timeouts.Value
+		want     time.Duration
+		wantDiag bool
+	}{
+		{"configured", timeoutsValue("45m"), 45 * time.Minute, false},
+		{"no timeouts block", timeouts.Value{}, defaultCreateTimeout, false},
+		{"invalid duration falls back", timeoutsValue("soon"), defaultCreateTimeout, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			start := time.Now()
+			ctx, cancel := withOperationTimeout(context.Background(), tt.value.Create, defaultCreateTimeout, &diags)
+			defer cancel()
+
+			if diags.HasError() != tt.wantDiag {
+				t.Errorf("diags.HasError() = %v, want %v: %v", diags.HasError(), tt.wantDiag, diags)
+			}
+			deadline, ok := ctx.Deadline()
+			if !ok {
+				t.Fatal("expected a context deadline")
+			}
+			if got := deadline.Sub(start); got < tt.want || got > tt.want+time.Second {
+				t.Errorf("deadline in %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+// TestOperationTimeout_BoundsRESTRequests checks that with no HTTP client
+// timeout, the operation's context deadline alone decides how long a slow
+// request may take.
+func TestOperationTimeout_BoundsRESTRequests(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-time.After(200 * time.Millisecond):
+		case <-r.Context().Done():
+			return
+		}
+		w.Header().Set(hyperping.HeaderContentType, hyperping.ContentTypeJSON)
+		w.Write([]byte(`{"uuid": "sp_123", "name": "Status"}`))
+	}))
+	defer server.Close()
+
+	httpClient, err := newHTTPClient(transportConfig{}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client := hyperping.NewClient("sk_test_key",
+		hyperping.WithBaseURL(server.URL),
+		hyperping.WithHTTPClient(httpClient),
+		hyperping.WithMaxRetries(0),
+		hyperping.WithNoCircuitBreaker(),
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err = client.GetStatusPage(ctx, "sp_123")
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("err = %v, want context.DeadlineExceeded", err)
+	}
+	if got := DetectErrorContext("Statuspage", "sp_123", "update", err).Type; got != "timeout" {
+		t.Errorf("error context type = %q, want timeout", got)
+	}
+
+	ctx, cancel = context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := client.GetStatusPage(ctx, "sp_123"); err != nil {
+		t.Errorf("GetStatusPage() with a longer timeout error = %v", err)
+	}
+}
@@ -0,0 +1,164 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+	_ "time/tzdata" // embed the IANA database so validation does not depend on the host
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the timezone types satisfy the framework interfaces.
+var (
+	_ basetypes.StringTypable                    = TimezoneType{}
+	_ basetypes.StringValuableWithSemanticEquals = TimezoneValue{}
+)
+
+// TimezoneType is a string type for IANA timezone names whose values compare
+// semantically: two names that have observed the same UTC offsets since 1970,
+// such as "UTC" and "Etc/UTC" or a zone and its backward-compatible alias, are
+// treated as equal. The API may store a different spelling than the one
+// configured, and this keeps that from showing up as a diff.
+type TimezoneType struct {
+	basetypes.StringType
+}
+
+func (t TimezoneType) String() string {
+	return "TimezoneType"
+}
+
+func (t TimezoneType) Equal(o attr.Type) bool {
+	other, ok := o.(TimezoneType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t TimezoneType) ValueFromString(_ context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return TimezoneValue{StringValue: in}, nil
+}
+
+func (t TimezoneType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+	return TimezoneValue{StringValue: stringValue}, nil
+}
+
+func (t TimezoneType) ValueType(_ context.Context) attr.Value {
+	return TimezoneValue{}
+}
+
+// TimezoneValue is a value of TimezoneType.
+type TimezoneValue struct {
+	basetypes.StringValue
+}
+
+// NewTimezoneValue returns a known timezone value.
+func NewTimezoneValue(value string) TimezoneValue {
+	return TimezoneValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+// NewTimezoneNull returns a null timezone value.
+func NewTimezoneNull() TimezoneValue {
+	return TimezoneValue{StringValue: basetypes.NewStringNull()}
+}
+
+func (v TimezoneValue) Type(_ context.Context) attr.Type {
+	return TimezoneType{}
+}
+
+func (v TimezoneValue) Equal(o attr.Value) bool {
+	other, ok := o.(TimezoneValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+// StringSemanticEquals reports whether both values name timezones with the
+// same offsets, so the prior value is kept when the API returns an alias.
+func (v TimezoneValue) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(TimezoneValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("An unexpected value type was received while performing semantic equality checks. "+
+				"Please report this to the provider developers.\n\nExpected Value Type: %T\nGot Value Type: %T", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return equivalentTimezones(v.ValueString(), newValue.ValueString()), diags
+}
+
+// timezoneCompareFrom and timezoneCompareUntil bound the comparison in
+// equivalentTimezones. The tz database merges zones that agree since 1970
+// into links, so that is the point from which two names are interchangeable.
+var (
+	timezoneCompareFrom  = time.Date(1970, 1, 1, 0, 0, 0, 0, time.UTC)
+	timezoneCompareUntil = time.Date(2038, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+// equivalentTimezones reports whether a and b are valid IANA names whose UTC
+// offsets agree at every instant from 1970 to 2038, walking the zone
+// transitions of both.
+func equivalentTimezones(a, b string) bool {
+	if a == b {
+		return true
+	}
+	if !isValidTimezoneName(a) || !isValidTimezoneName(b) {
+		return false
+	}
+	locA, errA := time.LoadLocation(a)
+	locB, errB := time.LoadLocation(b)
+	if errA != nil || errB != nil {
+		return false
+	}
+
+	for t := timezoneCompareFrom; t.Before(timezoneCompareUntil); {
+		_, offsetA := t.In(locA).Zone()
+		_, offsetB := t.In(locB).Zone()
+		if offsetA != offsetB {
+			return false
+		}
+		_, endA := t.In(locA).ZoneBounds()
+		_, endB := t.In(locB).ZoneBounds()
+		next := earliestZoneEnd(endA, endB)
+		if next.IsZero() {
+			return true
+		}
+		t = next
+	}
+	return true
+}
+
+// earliestZoneEnd returns the earlier of two ZoneBounds end times, where the
+// zero time means the zone never ends.
+func earliestZoneEnd(a, b time.Time) time.Time {
+	switch {
+	case a.IsZero():
+		return b
+	case b.IsZero():
+		return a
+	case a.Before(b):
+		return a
+	default:
+		return b
+	}
+}
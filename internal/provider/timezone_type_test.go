@@ -0,0 +1,69 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestTimezoneValue_StringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		prior, next string
+		want        bool
+	}{
+		{"UTC", "UTC", true},
+		{"UTC", "Etc/UTC", true},
+		{"Etc/UTC", "Etc/Zulu", true},
+		{"Asia/Calcutta", "Asia/Kolkata", true},
+		{"US/Eastern", "America/New_York", true},
+		{"America/New_York", "America/Chicago", false},
+		{"Europe/London", "UTC", false},
+		{"Asia/Kolkata", "Asia/Colombo", false},
+		{"UTC", "Local", false},
+		{"UTC", "", false},
+		{"UTC", "Not/AZone", false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.prior+"="+tt.next, func(t *testing.T) {
+			t.Parallel()
+			got, diags := NewTimezoneValue(tt.prior).StringSemanticEquals(context.Background(), NewTimezoneValue(tt.next))
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			if got != tt.want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", tt.prior, tt.next, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTimezoneType_ValueFromTerraform(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	v, err := TimezoneType{}.ValueFromTerraform(ctx, tftypes.NewValue(tftypes.String, "Europe/Paris"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tz, ok := v.(TimezoneValue)
+	if !ok || tz.ValueString() != "Europe/Paris" {
+		t.Fatalf("got %#v, want TimezoneValue(Europe/Paris)", v)
+	}
+	if !tz.Type(ctx).Equal(TimezoneType{}) {
+		t.Error("value type should be TimezoneType")
+	}
+	if tz.Equal(types.StringValue("Europe/Paris")) {
+		t.Error("TimezoneValue must not equal a plain string value")
+	}
+	if !NewTimezoneNull().IsNull() {
+		t.Error("NewTimezoneNull should be null")
+	}
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Connection pool limits. Terraform runs 10 operations in parallel by default
+// and each may retry, so the per-host limit leaves headroom above that. Idle
+// connections are kept up to the same limit so that a burst of operations
+// reuses connections instead of repeating the TCP and TLS handshakes.
+const (
+	maxConnsPerHost     = 32
+	maxIdleConnsPerHost = maxConnsPerHost
+	idleConnTimeout     = 90 * time.Second
+	tlsHandshakeTimeout = 10 * time.Second
+	dialTimeout         = 30 * time.Second
+)
+
+// transportConfig holds the provider attributes that shape the HTTP transport
+// shared by the REST and MCP clients.
+type transportConfig struct {
+	ProxyURL           string
+	CACertFile         string
+	ClientCertFile     string
+	ClientKeyFile      string
+	InsecureSkipVerify bool
+
+	// Stats, when set, counts the connections opened by the transport.
+	Stats *clientStats
+}
+
+// errNoCertificates is returned when ca_cert_file contains no PEM certificates.
+var errNoCertificates = errors.New("no PEM certificates found")
+
+// errIncompleteClientCertificate is returned when only one of
+// client_cert_file and client_key_file is set.
+var errIncompleteClientCertificate = errors.New("client_cert_file and client_key_file must be set together")
+
+// newHTTPClient builds the *http.Client handed to hyperping-go. Only the base
+// transport is configured here: hyperping-go wraps it with its TLS hardening
+// (TLS 1.2+, AEAD cipher suites) and the Authorization header transport, so
+// the proxy, CA pool, and skip-verify setting compose with the auth chain
+// rather than replacing it. The same holds for a client certificate: it is
+// presented during the TLS handshake, and the API key is still sent as a
+// bearer token on every request.
+//
+// Each client needs its own *http.Client because hyperping-go replaces the
+// Transport field of the client it is given. A zero timeout leaves requests
+// bounded only by their context.
+func newHTTPClient(cfg transportConfig, timeout time.Duration) (*http.Client, error) {
+	transport, err := newHTTPTransport(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Timeout: timeout, Transport: transport}, nil
+}
+
+// newHTTPTransport returns a transport with the provider's connection pool
+// limits. Without proxy_url, proxies are taken from HTTPS_PROXY, HTTP_PROXY,
+// and NO_PROXY.
+//
+// Connection counting hooks DialContext rather than wrapping the transport:
+// hyperping-go only applies its TLS hardening and HTTP/2 setup to a plain
+// *http.Transport, and DialContext is preserved when it clones one.
+func newHTTPTransport(cfg transportConfig) (*http.Transport, error) {
+	dialer := &net.Dialer{Timeout: dialTimeout, KeepAlive: 30 * time.Second}
+	transport := &http.Transport{
+		Proxy:               http.ProxyFromEnvironment,
+		DialContext:         dialer.DialContext,
+		MaxIdleConns:        100,
+		MaxIdleConnsPerHost: maxIdleConnsPerHost,
+		MaxConnsPerHost:     maxConnsPerHost,
+		IdleConnTimeout:     idleConnTimeout,
+		TLSHandshakeTimeout: tlsHandshakeTimeout,
+	}
+	if cfg.Stats != nil {
+		transport.DialContext = cfg.Stats.dialContext(dialer.DialContext)
+	}
+
+	if cfg.ProxyURL != "" {
+		proxyURL, err := parseProxyURL(cfg.ProxyURL)
+		if err != nil {
+			return nil, err
+		}
+		transport.Proxy = http.ProxyURL(proxyURL)
+	}
+
+	if cfg.CACertFile == "" && cfg.ClientCertFile == "" && cfg.ClientKeyFile == "" && !cfg.InsecureSkipVerify {
+		return transport, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: cfg.InsecureSkipVerify, // #nosec G402 -- explicit opt-in via insecure_skip_verify, surfaced as a provider warning
+	}
+	if cfg.CACertFile != "" {
+		pool, err := loadCertPool(cfg.CACertFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if cfg.ClientCertFile != "" || cfg.ClientKeyFile != "" {
+		cert, err := loadClientCertificate(cfg.ClientCertFile, cfg.ClientKeyFile)
+		if err != nil {
+			return nil, err
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// parseProxyURL accepts http, https, and socks5 proxy URLs with a host.
+func parseProxyURL(raw string) (*url.URL, error) {
+	parsed, err := url.Parse(raw)
+	if err != nil {
+		return nil, fmt.Errorf("invalid proxy URL: %w", err)
+	}
+	switch parsed.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("proxy URL scheme must be http, https, or socks5, got %q", parsed.Scheme)
+	}
+	if parsed.Host == "" {
+		return nil, errors.New("proxy URL must include a host")
+	}
+	return parsed, nil
+}
+
+// loadCertPool returns the system certificate pool extended with the PEM
+// certificates in path, so a corporate CA is trusted in addition to the
+// public roots rather than instead of them.
+func loadCertPool(path string) (*x509.CertPool, error) {
+	pem, err := os.ReadFile(filepath.Clean(path)) // #nosec G304 -- path is operator-supplied provider configuration
+	if err != nil {
+		return nil, fmt.Errorf("failed to read CA certificate file: %w", err)
+	}
+
+	pool, err := x509.SystemCertPool()
+	if err != nil || pool == nil {
+		pool = x509.NewCertPool()
+	}
+	if !pool.AppendCertsFromPEM(pem) {
+		return nil, fmt.Errorf("%s: %w", path, errNoCertificates)
+	}
+	return pool, nil
+}
+
+// loadClientCertificate reads the PEM certificate and private key presented to
+// gateways that require mutual TLS in front of the API.
+func loadClientCertificate(certFile, keyFile string) (tls.Certificate, error) {
+	if certFile == "" || keyFile == "" {
+		return tls.Certificate{}, errIncompleteClientCertificate
+	}
+	cert, err := tls.LoadX509KeyPair(filepath.Clean(certFile), filepath.Clean(keyFile))
+	if err != nil {
+		return tls.Certificate{}, fmt.Errorf("failed to load client certificate: %w", err)
+	}
+	return cert, nil
+}
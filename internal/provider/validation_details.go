@@ -0,0 +1,211 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// apiFieldPaths maps the API field names reported in validation error details
+// to Terraform attribute paths, per resource type (the names passed to the
+// New*ErrorWithContext helpers). Keys are normalized by normalizeAPIField, so
+// both snake_case and camelCase spellings of a field match.
+var apiFieldPaths = map[string]map[string]path.Path{
+	"Monitor": newAPIFieldTable(map[string]path.Path{
+		"name":                 path.Root("name"),
+		"url":                  path.Root("url"),
+		"protocol":             path.Root("protocol"),
+		"projectUuid":          path.Root("project_uuid"),
+		"http_method":          path.Root("http_method"),
+		"check_frequency":      path.Root("check_frequency"),
+		"regions":              path.Root("regions"),
+		"request_headers":      path.Root("request_headers"),
+		"request_body":         path.Root("request_body"),
+		"follow_redirects":     path.Root("follow_redirects"),
+		"expected_status_code": path.Root("expected_status_code"),
+		"required_keyword":     path.Root("required_keyword"),
+		"paused":               path.Root("paused"),
+		"port":                 path.Root("port"),
+		"alerts_wait":          path.Root("alerts_wait"),
+		"escalation_policy":    path.Root("escalation_policy"),
+		"dns_record_type":      path.Root("dns_record_type"),
+		"dns_nameserver":       path.Root("dns_nameserver"),
+		"dns_expected_answer":  path.Root("dns_expected_answer"),
+	}),
+	"Healthcheck": newAPIFieldTable(map[string]path.Path{
+		"name":               path.Root("name"),
+		"cron":               path.Root("cron"),
+		"timezone":           path.Root("timezone"),
+		"period_value":       path.Root("period_value"),
+		"period_type":        path.Root("period_type"),
+		"grace_period_value": path.Root("grace_period_value"),
+		"grace_period_type":  path.Root("grace_period_type"),
+		"escalation_policy":  path.Root("escalation_policy"),
+	}),
+	"Incident": newAPIFieldTable(map[string]path.Path{
+		"title":              path.Root("title"),
+		"text":               path.Root("text"),
+		"type":               path.Root("type"),
+		"affectedComponents": path.Root("affected_components"),
+		"statuspages":        path.Root("status_pages"),
+		"date":               path.Root("date"),
+	}),
+	"Incident Update": newAPIFieldTable(map[string]path.Path{
+		"text": path.Root("text"),
+		"type": path.Root("type"),
+		"date": path.Root("date"),
+	}),
+	"Maintenance Window": newAPIFieldTable(map[string]path.Path{
+		"name":                path.Root("name"),
+		"title":               path.Root("title"),
+		"text":                path.Root("text"),
+		"start_date":          path.Root("start_date"),
+		"end_date":            path.Root("end_date"),
+		"monitors":            path.Root("monitors"),
+		"statuspages":         path.Root("status_pages"),
+		"notificationOption":  path.Root("notification_option"),
+		"notificationMinutes": path.Root("notification_minutes"),
+	}),
+	"Outage": newAPIFieldTable(map[string]path.Path{
+		"monitorUuid":          path.Root("monitor_uuid"),
+		"startDate":            path.Root("start_date"),
+		"endDate":              path.Root("end_date"),
+		"statusCode":           path.Root("status_code"),
+		"description":          path.Root("description"),
+		"outageType":           path.Root("outage_type"),
+		"escalationPolicyUuid": path.Root("escalation_policy_uuid"),
+		"severity":             path.Root("severity"),
+		"summary":              path.Root("summary"),
+	}),
+	"Statuspage": newAPIFieldTable(map[string]path.Path{
+		"name":                     path.Root("name"),
+		"subdomain":                path.Root("hosted_subdomain"),
+		"hostname":                 path.Root("hostname"),
+		"password":                 path.Root("password"),
+		"sections":                 path.Root("sections"),
+		"website":                  path.Root("settings").AtName("website"),
+		"description":              path.Root("settings").AtName("description"),
+		"languages":                path.Root("settings").AtName("languages"),
+		"default_language":         path.Root("settings").AtName("default_language"),
+		"theme":                    path.Root("settings").AtName("theme"),
+		"font":                     path.Root("settings").AtName("font"),
+		"accent_color":             path.Root("settings").AtName("accent_color"),
+		"auto_refresh":             path.Root("settings").AtName("auto_refresh"),
+		"banner_header":            path.Root("settings").AtName("banner_header"),
+		"logo":                     path.Root("settings").AtName("logo"),
+		"logo_height":              path.Root("settings").AtName("logo_height"),
+		"favicon":                  path.Root("settings").AtName("favicon"),
+		"hide_powered_by":          path.Root("settings").AtName("hide_powered_by"),
+		"hide_from_search_engines": path.Root("settings").AtName("hide_from_search_engines"),
+		"google_analytics":         path.Root("settings").AtName("google_analytics"),
+		"subscribe":                path.Root("settings").AtName("subscribe"),
+		"authentication":           path.Root("settings").AtName("authentication"),
+	}),
+	"Subscriber": newAPIFieldTable(map[string]path.Path{
+		"type":              path.Root("type"),
+		"email":             path.Root("email"),
+		"phone":             path.Root("phone"),
+		"teams_webhook_url": path.Root("teams_webhook_url"),
+		"language":          path.Root("language"),
+	}),
+}
+
+// newAPIFieldTable returns fields with normalized keys.
+func newAPIFieldTable(fields map[string]path.Path) map[string]path.Path {
+	table := make(map[string]path.Path, len(fields))
+	for field, p := range fields {
+		table[normalizeAPIField(field)] = p
+	}
+	return table
+}
+
+// normalizeAPIField lowercases field and drops underscores, so "check_frequency"
+// and "checkFrequency" compare equal.
+func normalizeAPIField(field string) string {
+	return strings.ReplaceAll(strings.ToLower(field), "_", "")
+}
+
+// apiFieldPath returns the attribute path for a validation detail field of
+// resourceType. Only the leading field name is matched, so a nested field such
+// as "sections[0].name" or "regions[2]" maps to the attribute that holds it.
+func apiFieldPath(resourceType, field string) (path.Path, bool) {
+	field = strings.TrimSpace(field)
+	// Status page reads nest appearance fields under "settings", as the
+	// schema does, while writes send them at the top level. Accept both.
+	if resourceType == "Statuspage" {
+		if field == "settings" {
+			return path.Root("settings"), true
+		}
+		field = strings.TrimPrefix(field, "settings.")
+	}
+	if i := strings.IndexAny(field, ".["); i >= 0 {
+		field = field[:i]
+	}
+	p, ok := apiFieldPaths[resourceType][normalizeAPIField(field)]
+	return p, ok
+}
+
+// newValidationDetailDiagnostics returns an attribute error for each
+// validation detail in err whose field maps to an attribute of resourceType,
+// so Terraform points at the offending argument instead of only the resource.
+// Details that do not map are listed by validationDetailsText.
+func newValidationDetailDiagnostics(resourceType, operation string, err error) diag.Diagnostics {
+	var diags diag.Diagnostics
+	for _, detail := range validationDetails(err) {
+		p, ok := apiFieldPath(resourceType, detail.Field)
+		if !ok {
+			continue
+		}
+		diags.AddAttributeError(p, "Invalid Attribute Value",
+			fmt.Sprintf("The Hyperping API rejected this value while trying to %s the %s: %s",
+				operation, strings.ToLower(resourceType), detail.Message))
+	}
+	return diags
+}
+
+// validationDetailsText lists the validation details in err that do not map
+// to an attribute of resourceType, for the resource-level error message. It
+// returns "" when there are none.
+func validationDetailsText(resourceType string, err error) string {
+	var b strings.Builder
+	for _, detail := range validationDetails(err) {
+		if _, ok := apiFieldPath(resourceType, detail.Field); ok {
+			continue
+		}
+		if b.Len() == 0 {
+			b.WriteString("\n\nValidation details:")
+		}
+		if detail.Field == "" {
+			fmt.Fprintf(&b, "\n  - %s", detail.Message)
+			continue
+		}
+		fmt.Fprintf(&b, "\n  - %s: %s", detail.Field, detail.Message)
+	}
+	return b.String()
+}
+
+// validationDetails returns the validation details of err with secrets
+// redacted. hyperping-go sanitizes the error message but not the details,
+// which can quote the rejected value.
+func validationDetails(err error) []hyperping.ValidationDetail {
+	var apiErr *hyperping.APIError
+	if !errors.As(err, &apiErr) {
+		return nil
+	}
+	details := make([]hyperping.ValidationDetail, len(apiErr.Details))
+	for i, detail := range apiErr.Details {
+		details[i] = hyperping.ValidationDetail{
+			Field:   detail.Field,
+			Message: redactSecrets(detail.Message),
+		}
+	}
+	return details
+}
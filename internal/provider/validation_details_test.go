@@ -0,0 +1,112 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestAPIFieldPath(t *testing.T) {
+	tests := []struct {
+		resourceType string
+		field        string
+		want         path.Path
+		wantOK       bool
+	}{
+		{"Monitor", "check_frequency", path.Root("check_frequency"), true},
+		{"Monitor", "checkFrequency", path.Root("check_frequency"), true},
+		{"Monitor", " regions[2] ", path.Root("regions"), true},
+		{"Monitor", "request_headers.0.name", path.Root("request_headers"), true},
+		{"Monitor", "monitors", path.Empty(), false},
+		{"Outage", "monitorUuid", path.Root("monitor_uuid"), true},
+		{"Statuspage", "subdomain", path.Root("hosted_subdomain"), true},
+		{"Statuspage", "accentColor", path.Root("settings").AtName("accent_color"), true},
+		{"Statuspage", "settings.theme", path.Root("settings").AtName("theme"), true},
+		{"Statuspage", "settings", path.Root("settings"), true},
+		{"Statuspage", "sections[0].name", path.Root("sections"), true},
+		{"Unknown", "name", path.Empty(), false},
+		{"Monitor", "", path.Empty(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.resourceType+"/"+tt.field, func(t *testing.T) {
+			got, ok := apiFieldPath(tt.resourceType, tt.field)
+			if ok != tt.wantOK {
+				t.Fatalf("ok = %v, want %v", ok, tt.wantOK)
+			}
+			if ok && !got.Equal(tt.want) {
+				t.Errorf("path = %s, want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewValidationDetailDiagnostics(t *testing.T) {
+	err := fmt.Errorf("creating monitor: %w", &hyperping.APIError{
+		StatusCode: 422,
+		Message:    "Validation failed",
+		Details: []hyperping.ValidationDetail{
+			{Field: "url", Message: "must be a valid URL"},
+			{Field: "check_frequency", Message: "must be one of 10, 20, 30"},
+			{Field: "workspace", Message: "is read-only"},
+		},
+	})
+
+	diags := newValidationDetailDiagnostics("Monitor", "create", err)
+	if len(diags) != 2 {
+		t.Fatalf("got %d diagnostics, want 2: %v", len(diags), diags)
+	}
+
+	wantPaths := []path.Path{path.Root("url"), path.Root("check_frequency")}
+	for i, d := range diags {
+		withPath, ok := d.(interface{ Path() path.Path })
+		if !ok {
+			t.Fatalf("diagnostic %d has no attribute path", i)
+		}
+		if !withPath.Path().Equal(wantPaths[i]) {
+			t.Errorf("diagnostic %d path = %s, want %s", i, withPath.Path(), wantPaths[i])
+		}
+		if d.Summary() != "Invalid Attribute Value" {
+			t.Errorf("diagnostic %d summary = %q", i, d.Summary())
+		}
+	}
+	if !strings.Contains(diags[0].Detail(), "while trying to create the monitor: must be a valid URL") {
+		t.Errorf("unexpected detail: %s", diags[0].Detail())
+	}
+
+	if got := newValidationDetailDiagnostics("Monitor", "create", fmt.Errorf("boom")); got != nil {
+		t.Errorf("non-API error: got %v, want no diagnostics", got)
+	}
+}
+
+func TestValidationDetailsText(t *testing.T) {
+	err := &hyperping.APIError{
+		StatusCode: 400,
+		Details: []hyperping.ValidationDetail{
+			{Field: "name", Message: "is required"},
+			{Field: "workspace", Message: "is read-only"},
+			{Message: "request body is too large"},
+		},
+	}
+
+	got := validationDetailsText("Monitor", err)
+	want := "\n\nValidation details:\n  - workspace: is read-only\n  - request body is too large"
+	if got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+
+	mapped := &hyperping.APIError{Details: []hyperping.ValidationDetail{{Field: "name", Message: "is required"}}}
+	if got := validationDetailsText("Monitor", mapped); got != "" {
+		t.Errorf("all details mapped: got %q, want empty", got)
+	}
+	if got := validationDetailsText("Monitor", fmt.Errorf("boom")); got != "" {
+		t.Errorf("non-API error: got %q, want empty", got)
+	}
+}
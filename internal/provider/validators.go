@@ -349,8 +349,7 @@ func (v timezoneValidator) ValidateString(_ context.Context, req validator.Strin
 	}
 
 	value := req.ConfigValue.ValueString()
-	_, err := time.LoadLocation(value)
-	if err != nil {
+	if !isValidTimezoneName(value) {
 		resp.Diagnostics.AddAttributeError(
 			req.Path,
 			"Invalid Timezone",
@@ -362,6 +361,25 @@ func (v timezoneValidator) ValidateString(_ context.Context, req validator.Strin
 	}
 }
 
+// isValidTimezoneName reports whether name is in the IANA timezone database
+// (embedded in the provider binary, so the result does not depend on the host).
+// time.LoadLocation alone also accepts "" and "Local", which silently mean
+// UTC or the machine's zone, and on case-insensitive filesystems accepts
+// miscapitalized names such as "europe/london" that the API rejects; every
+// IANA name segment starts with an uppercase letter.
+func isValidTimezoneName(name string) bool {
+	if name == "" || name == "Local" {
+		return false
+	}
+	for _, segment := range strings.Split(name, "/") {
+		if segment == "" || segment[0] < 'A' || segment[0] > 'Z' {
+			return false
+		}
+	}
+	_, err := time.LoadLocation(name)
+	return err == nil
+}
+
 // Timezone returns a validator that checks for valid IANA timezones.
 func Timezone() validator.String {
 	return timezoneValidator{}
@@ -484,6 +502,46 @@ func EmailFormat() validator.String {
 	return emailFormatValidator{}
 }
 
+// domainNamePattern matches a bare DNS domain (no scheme, port, path, or "@"):
+// one or more dot-separated labels of alphanumerics and inner hyphens, ending
+// in an alphabetic TLD of at least 2 characters.
+var domainNamePattern = regexp.MustCompile(`^([a-zA-Z0-9]([a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?\.)+[a-zA-Z]{2,63}$`)
+
+// domainNameValidator validates that a string is a bare email domain such as
+// "example.com". Used for SSO allowlists, where a pasted URL or full email
+// address would otherwise be accepted by Terraform and rejected (or silently
+// ignored) by the API.
+type domainNameValidator struct{}
+
+func (v domainNameValidator) Description(_ context.Context) string {
+	return "value must be a bare domain name (e.g., \"example.com\")"
+}
+
+func (v domainNameValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v domainNameValidator) ValidateString(_ context.Context, req validator.StringRequest, resp *validator.StringResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	value := req.ConfigValue.ValueString()
+	if len(value) > 253 || !domainNamePattern.MatchString(value) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid Domain Name",
+			fmt.Sprintf("The value %q is not a valid domain name. Use the bare domain without scheme, "+
+				"path, or \"@\" (e.g., \"example.com\").", value),
+		)
+	}
+}
+
+// DomainName returns a validator that checks for a bare domain name.
+func DomainName() validator.String {
+	return domainNameValidator{}
+}
+
 // validAlertsWaitValues is the set of values the Hyperping API accepts for alerts_wait (in minutes).
 var validAlertsWaitValues = map[int64]bool{
 	-1: true, 0: true, 1: true, 2: true, 3: true,
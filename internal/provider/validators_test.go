@@ -338,7 +338,12 @@ func TestTimezoneValidator(t *testing.T) {
 		{"valid Australia/Sydney", types.StringValue("Australia/Sydney"), false},
 		{"valid Africa/Cairo", types.StringValue("Africa/Cairo"), false},
 		{"valid EST", types.StringValue("EST"), false},
-		{"valid Local", types.StringValue("Local"), false},
+		{"valid Etc/UTC", types.StringValue("Etc/UTC"), false},
+		{"valid America/Argentina/Buenos_Aires", types.StringValue("America/Argentina/Buenos_Aires"), false},
+		{"invalid Local", types.StringValue("Local"), true},
+		{"invalid empty", types.StringValue(""), true},
+		{"invalid lowercase", types.StringValue("europe/london"), true},
+		{"invalid trailing slash", types.StringValue("Europe/"), true},
 		{"invalid New York", types.StringValue("New York"), true},
 		{"invalid random", types.StringValue("RandomTimezone"), true},
 		{"invalid number", types.StringValue("12345"), true},
@@ -516,6 +521,50 @@ func TestEmailFormatValidator(t *testing.T) {
 	}
 }
 
+func TestDomainNameValidator(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		value     types.String
+		wantError bool
+	}{
+		{"valid simple", types.StringValue("example.com"), false},
+		{"valid subdomain", types.StringValue("corp.example.co.uk"), false},
+		{"valid hyphen", types.StringValue("my-company.io"), false},
+		{"valid uppercase", types.StringValue("Example.COM"), false},
+		{"invalid scheme", types.StringValue("https://example.com"), true},
+		{"invalid email", types.StringValue("user@example.com"), true},
+		{"invalid leading @", types.StringValue("@example.com"), true},
+		{"invalid path", types.StringValue("example.com/login"), true},
+		{"invalid port", types.StringValue("example.com:443"), true},
+		{"invalid no tld", types.StringValue("localhost"), true},
+		{"invalid leading hyphen", types.StringValue("-example.com"), true},
+		{"invalid wildcard", types.StringValue("*.example.com"), true},
+		{"invalid empty", types.StringValue(""), true},
+		{"null value", types.StringNull(), false},
+		{"unknown value", types.StringUnknown(), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			v := DomainName()
+			req := validator.StringRequest{
+				Path:        path.Root("allowed_domains"),
+				ConfigValue: tt.value,
+			}
+			resp := &validator.StringResponse{}
+			v.ValidateString(context.Background(), req, resp)
+
+			if resp.Diagnostics.HasError() != tt.wantError {
+				t.Errorf("DomainName(%v): got error=%v, want error=%v",
+					tt.value, resp.Diagnostics.HasError(), tt.wantError)
+			}
+		})
+	}
+}
+
 func TestAlertsWaitValidator(t *testing.T) {
 	t.Parallel()
 
@@ -661,6 +710,7 @@ func TestStringValidatorDescriptions(t *testing.T) {
 		{"Timezone", Timezone(), false},
 		{"HexColor", HexColor(), false},
 		{"EmailFormat", EmailFormat(), true},
+		{"DomainName", DomainName(), true},
 		{"StatusCodePattern", StatusCodePattern(), false},
 	}
 
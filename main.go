@@ -18,8 +18,9 @@ var (
 	// to appropriate values for the compiled binary.
 	version = "dev"
 
-	// goreleaser can pass other information to the main package, such as the specific commit
+	// commit is the git commit of the release, passed by goreleaser.
 	// https://goreleaser.com/cookbooks/using-main.version/
+	commit = ""
 )
 
 func main() {
@@ -33,7 +34,7 @@ func main() {
 		Debug:   debug,
 	}
 
-	err := providerserver.Serve(context.Background(), provider.New(version), opts)
+	err := providerserver.Serve(context.Background(), provider.NewWithBuildInfo(provider.BuildInfo{Version: version, Commit: commit}), opts)
 
 	if err != nil {
 		log.Fatal(err.Error())
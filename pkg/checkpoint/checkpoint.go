@@ -10,6 +10,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"slices"
 	"time"
 )
 
@@ -35,6 +36,10 @@ type CreatedResource struct {
 	UUID string `json:"uuid"`
 	// Type identifies the Hyperping resource kind: "monitor" or "healthcheck".
 	Type string `json:"type"`
+	// Address is the Terraform address the generated import script imports
+	// the resource to, e.g. hyperping_monitor.api. Empty until the script is
+	// written, and in checkpoints saved by older versions.
+	Address string `json:"address,omitempty"`
 }
 
 // Checkpoint represents the state of a migration at a point in time
@@ -49,6 +54,7 @@ type Checkpoint struct {
 	ProcessedIDs     []string          `json:"processed_ids"`
 	FailedResources  []FailedResource  `json:"failed_resources"`
 	HyperpingCreated []CreatedResource `json:"hyperping_created,omitempty"`
+	GeneratedFiles   []string          `json:"generated_files,omitempty"`
 	Metadata         map[string]string `json:"metadata,omitempty"`
 
 	// processedSet is an in-memory index for O(1) lookups (not serialized to JSON)
@@ -142,6 +148,7 @@ func unmarshalCheckpoint(data []byte) (*Checkpoint, error) {
 		ProcessedIDs     []string          `json:"processed_ids"`
 		FailedResources  []FailedResource  `json:"failed_resources"`
 		HyperpingCreated json.RawMessage   `json:"hyperping_created,omitempty"`
+		GeneratedFiles   []string          `json:"generated_files,omitempty"`
 		Metadata         map[string]string `json:"metadata,omitempty"`
 	}
 
@@ -159,6 +166,7 @@ func unmarshalCheckpoint(data []byte) (*Checkpoint, error) {
 		Failed:          raw.Failed,
 		ProcessedIDs:    raw.ProcessedIDs,
 		FailedResources: raw.FailedResources,
+		GeneratedFiles:  raw.GeneratedFiles,
 		Metadata:        raw.Metadata,
 	}
 
@@ -318,3 +326,26 @@ func (c *Checkpoint) AddHyperpingResource(uuid, resourceType string) {
 		Type: resourceType,
 	})
 }
+
+// SetResourceAddress records the Terraform address a created resource is
+// imported to, so rollback can remove it from the state.
+func (c *Checkpoint) SetResourceAddress(uuid, address string) {
+	for i := range c.HyperpingCreated {
+		if c.HyperpingCreated[i].UUID == uuid {
+			c.HyperpingCreated[i].Address = address
+		}
+	}
+}
+
+// AddGeneratedFile records a file written by the migration. The path is made
+// absolute so rollback finds it from any working directory; a file recorded
+// twice is kept once.
+func (c *Checkpoint) AddGeneratedFile(path string) {
+	if abs, err := filepath.Abs(path); err == nil {
+		path = abs
+	}
+	if slices.Contains(c.GeneratedFiles, path) {
+		return
+	}
+	c.GeneratedFiles = append(c.GeneratedFiles, path)
+}
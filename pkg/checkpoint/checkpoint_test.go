@@ -46,7 +46,8 @@ func TestSaveAndLoad(t *testing.T) {
 		FailedResources: []FailedResource{
 			{ID: "fail1", Type: "monitor", Error: "test error"},
 		},
-		HyperpingCreated: []CreatedResource{{UUID: "uuid1", Type: "monitor"}, {UUID: "uuid2", Type: "healthcheck"}},
+		HyperpingCreated: []CreatedResource{{UUID: "uuid1", Type: "monitor", Address: "hyperping_monitor.api"}, {UUID: "uuid2", Type: "healthcheck"}},
+		GeneratedFiles:   []string{"/tmp/migration/monitors.tf"},
 		Metadata:         map[string]string{"key": "value"},
 	}
 
@@ -83,6 +84,11 @@ func TestSaveAndLoad(t *testing.T) {
 	}
 	if len(loaded.HyperpingCreated) != len(cp.HyperpingCreated) {
 		t.Errorf("Expected %d HyperpingCreated, got %d", len(cp.HyperpingCreated), len(loaded.HyperpingCreated))
+	} else if loaded.HyperpingCreated[0].Address != "hyperping_monitor.api" {
+		t.Errorf("Expected Address hyperping_monitor.api, got %q", loaded.HyperpingCreated[0].Address)
+	}
+	if len(loaded.GeneratedFiles) != 1 || loaded.GeneratedFiles[0] != "/tmp/migration/monitors.tf" {
+		t.Errorf("Expected GeneratedFiles %v, got %v", cp.GeneratedFiles, loaded.GeneratedFiles)
 	}
 
 	// Cleanup
@@ -309,6 +315,38 @@ func TestMarkFailed(t *testing.T) {
 	}
 }
 
+func TestSetResourceAddress(t *testing.T) {
+	cp := &Checkpoint{}
+	cp.AddHyperpingResource("uuid1", "monitor")
+	cp.AddHyperpingResource("uuid2", "monitor")
+
+	cp.SetResourceAddress("uuid2", "hyperping_monitor.db")
+	cp.SetResourceAddress("unknown", "hyperping_monitor.other")
+
+	if cp.HyperpingCreated[0].Address != "" {
+		t.Errorf("Expected no address for uuid1, got %q", cp.HyperpingCreated[0].Address)
+	}
+	if cp.HyperpingCreated[1].Address != "hyperping_monitor.db" {
+		t.Errorf("Expected address hyperping_monitor.db for uuid2, got %q", cp.HyperpingCreated[1].Address)
+	}
+}
+
+func TestAddGeneratedFile(t *testing.T) {
+	cp := &Checkpoint{}
+	cp.AddGeneratedFile("migration/monitors.tf")
+	cp.AddGeneratedFile("migration/monitors.tf")
+	cp.AddGeneratedFile("migration/import.sh")
+
+	if len(cp.GeneratedFiles) != 2 {
+		t.Fatalf("Expected 2 GeneratedFiles, got %v", cp.GeneratedFiles)
+	}
+	for _, path := range cp.GeneratedFiles {
+		if !filepath.IsAbs(path) {
+			t.Errorf("Expected an absolute path, got %s", path)
+		}
+	}
+}
+
 func TestGenerateMigrationID(t *testing.T) {
 	id1 := GenerateMigrationID("test-tool")
 	time.Sleep(1100 * time.Millisecond) // Sleep longer than 1 second to ensure different timestamps
@@ -0,0 +1,119 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// Package clientenv builds Hyperping API clients whose defaults can be tuned
+// site-wide through environment variables, so every tool built on
+// hyperping-go honors the same timeout and retry settings without growing
+// its own flags.
+package clientenv
+
+import (
+	"fmt"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+const (
+	// TimeoutEnv overrides the per-request HTTP timeout. It accepts a Go
+	// duration ("45s", "2m") or a whole number of seconds; 0 disables the
+	// timeout.
+	TimeoutEnv = "HYPERPING_TIMEOUT"
+	// MaxRetriesEnv overrides the number of retry attempts for failed
+	// requests. 0 disables retries.
+	MaxRetriesEnv = "HYPERPING_MAX_RETRIES"
+)
+
+// Settings holds the client defaults read from the environment. A nil field
+// means the variable is unset and the hyperping-go default applies.
+type Settings struct {
+	Timeout    *time.Duration
+	MaxRetries *int
+}
+
+// Load reads TimeoutEnv and MaxRetriesEnv. It returns an error naming the
+// variable when a value is set but cannot be parsed.
+func Load() (Settings, error) {
+	var s Settings
+
+	if raw := strings.TrimSpace(os.Getenv(TimeoutEnv)); raw != "" {
+		timeout, err := parseTimeout(raw)
+		if err != nil {
+			return Settings{}, fmt.Errorf("%s: %w", TimeoutEnv, err)
+		}
+		s.Timeout = &timeout
+	}
+
+	if raw := strings.TrimSpace(os.Getenv(MaxRetriesEnv)); raw != "" {
+		retries, err := strconv.Atoi(raw)
+		if err != nil || retries < 0 {
+			return Settings{}, fmt.Errorf("%s: must be a non-negative integer, got %q", MaxRetriesEnv, raw)
+		}
+		s.MaxRetries = &retries
+	}
+
+	return s, nil
+}
+
+// Validate reports whether the environment holds usable client settings.
+// Tools call it at startup so a typo fails fast instead of being ignored by
+// NewClient.
+func Validate() error {
+	_, err := Load()
+	return err
+}
+
+// Options returns the hyperping-go options for s.
+func (s Settings) Options() []hyperping.Option {
+	var opts []hyperping.Option
+	if s.Timeout != nil {
+		opts = append(opts, hyperping.WithHTTPClient(&http.Client{
+			Timeout:   *s.Timeout,
+			Transport: defaultTransport(),
+		}))
+	}
+	if s.MaxRetries != nil {
+		opts = append(opts, hyperping.WithMaxRetries(*s.MaxRetries))
+	}
+	return opts
+}
+
+// NewClient creates a Hyperping client with the environment defaults
+// applied before opts, so explicit options always win. Invalid environment
+// values are ignored here; call Validate to surface them.
+func NewClient(apiKey string, opts ...hyperping.Option) *hyperping.Client {
+	settings, _ := Load() //nolint:errcheck // invalid values fall back to the library defaults
+	return hyperping.NewClient(apiKey, append(settings.Options(), opts...)...)
+}
+
+// parseTimeout accepts a Go duration or a whole number of seconds.
+func parseTimeout(raw string) (time.Duration, error) {
+	if seconds, err := strconv.Atoi(raw); err == nil {
+		if seconds < 0 {
+			return 0, fmt.Errorf("must not be negative, got %q", raw)
+		}
+		return time.Duration(seconds) * time.Second, nil
+	}
+	timeout, err := time.ParseDuration(raw)
+	if err != nil {
+		return 0, fmt.Errorf("must be a duration such as 45s or a number of seconds, got %q", raw)
+	}
+	if timeout < 0 {
+		return 0, fmt.Errorf("must not be negative, got %q", raw)
+	}
+	return timeout, nil
+}
+
+// defaultTransport mirrors the connection pool limits hyperping-go uses for
+// its own default HTTP client, which is replaced when a timeout is set.
+func defaultTransport() *http.Transport {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConnsPerHost = 10
+	transport.MaxConnsPerHost = 20
+	transport.IdleConnTimeout = 90 * time.Second
+	return transport
+}
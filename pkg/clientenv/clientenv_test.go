@@ -0,0 +1,149 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package clientenv
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name        string
+		timeout     string
+		maxRetries  string
+		wantTimeout *time.Duration
+		wantRetries *int
+		wantErr     bool
+	}{
+		{name: "unset"},
+		{name: "duration", timeout: "45s", wantTimeout: durationPtr(45 * time.Second)},
+		{name: "seconds", timeout: "90", wantTimeout: durationPtr(90 * time.Second)},
+		{name: "zero timeout", timeout: "0", wantTimeout: durationPtr(0)},
+		{name: "retries", maxRetries: "5", wantRetries: intPtr(5)},
+		{name: "zero retries", maxRetries: " 0 ", wantRetries: intPtr(0)},
+		{name: "invalid timeout", timeout: "soon", wantErr: true},
+		{name: "negative timeout", timeout: "-5s", wantErr: true},
+		{name: "negative seconds", timeout: "-5", wantErr: true},
+		{name: "invalid retries", maxRetries: "many", wantErr: true},
+		{name: "negative retries", maxRetries: "-1", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv(TimeoutEnv, tt.timeout)
+			t.Setenv(MaxRetriesEnv, tt.maxRetries)
+
+			got, err := Load()
+			if tt.wantErr {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				if Validate() == nil {
+					t.Error("Validate() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !equalPtr(got.Timeout, tt.wantTimeout) {
+				t.Errorf("Timeout = %v, want %v", deref(got.Timeout), deref(tt.wantTimeout))
+			}
+			if !equalPtr(got.MaxRetries, tt.wantRetries) {
+				t.Errorf("MaxRetries = %v, want %v", deref(got.MaxRetries), deref(tt.wantRetries))
+			}
+		})
+	}
+}
+
+func TestNewClient_MaxRetries(t *testing.T) {
+	tests := []struct {
+		name         string
+		env          string
+		opts         []hyperping.Option
+		wantRequests int32
+	}{
+		{name: "env disables retries", env: "0", wantRequests: 1},
+		{name: "env sets retries", env: "2", wantRequests: 3},
+		{name: "option overrides env", env: "0", opts: []hyperping.Option{hyperping.WithMaxRetries(1)}, wantRequests: 2},
+		{name: "invalid env falls back to default", env: "many", wantRequests: int32(hyperping.DefaultMaxRetries) + 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests atomic.Int32
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+				requests.Add(1)
+				w.WriteHeader(http.StatusServiceUnavailable)
+			}))
+			defer server.Close()
+
+			t.Setenv(TimeoutEnv, "")
+			t.Setenv(MaxRetriesEnv, tt.env)
+
+			opts := append([]hyperping.Option{
+				hyperping.WithBaseURL(server.URL),
+				hyperping.WithRetryWait(time.Millisecond, time.Millisecond),
+				hyperping.WithNoCircuitBreaker(),
+			}, tt.opts...)
+			client := NewClient("sk_test", opts...)
+
+			if _, err := client.ListMonitors(context.Background()); err == nil {
+				t.Fatal("expected an error from a failing server")
+			}
+			if got := requests.Load(); got != tt.wantRequests {
+				t.Errorf("requests = %d, want %d", got, tt.wantRequests)
+			}
+		})
+	}
+}
+
+func TestNewClient_Timeout(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-time.After(2 * time.Second):
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	t.Setenv(TimeoutEnv, "50ms")
+	t.Setenv(MaxRetriesEnv, "0")
+
+	client := NewClient("sk_test", hyperping.WithBaseURL(server.URL), hyperping.WithNoCircuitBreaker())
+
+	start := time.Now()
+	if _, err := client.ListMonitors(context.Background()); err == nil {
+		t.Fatal("expected a timeout error")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("request took %v, want it bounded by %s", elapsed, TimeoutEnv)
+	}
+}
+
+func durationPtr(d time.Duration) *time.Duration { return &d }
+
+func intPtr(i int) *int { return &i }
+
+func equalPtr[T comparable](a, b *T) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return *a == *b
+}
+
+func deref[T any](p *T) any {
+	if p == nil {
+		return nil
+	}
+	return *p
+}
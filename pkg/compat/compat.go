@@ -0,0 +1,143 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// Package compat adds lifecycle ignore_changes blocks to generated resources
+// for attributes the provider restores from configuration because the
+// Hyperping API does not return them faithfully.
+//
+// The provider already keeps these attributes stable once a resource is
+// managed, but a fresh import or a state recovered from the API can still
+// disagree with the configuration until the next apply. Generators call the
+// functions here with --compat-mode=ignore-changes so configurations plan
+// cleanly on day one; each ignored attribute is listed with a comment naming
+// the API behavior, so the block can be removed once the API is fixed.
+package compat
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
+)
+
+// Mode selects whether generated resources carry compatibility blocks.
+type Mode string
+
+// Supported modes.
+const (
+	// None generates no lifecycle blocks.
+	None Mode = "none"
+	// IgnoreChanges adds lifecycle ignore_changes for the affected attributes.
+	IgnoreChanges Mode = "ignore-changes"
+)
+
+// Modes lists the supported modes in the order they are documented.
+var Modes = []Mode{None, IgnoreChanges}
+
+// ParseMode returns the mode named s. The empty string selects None.
+func ParseMode(s string) (Mode, error) {
+	if s == "" {
+		return None, nil
+	}
+	for _, m := range Modes {
+		if string(m) == s {
+			return m, nil
+		}
+	}
+	names := make([]string, len(Modes))
+	for i, m := range Modes {
+		names[i] = string(m)
+	}
+	return "", fmt.Errorf("unknown compat mode %q (valid: %s)", s, strings.Join(names, ", "))
+}
+
+// RegisterFlag registers --compat-mode on fs.
+func RegisterFlag(fs *flag.FlagSet) *string {
+	return fs.String("compat-mode", string(None),
+		"Provider workarounds in generated configuration: none, or ignore-changes (lifecycle ignore_changes for attributes the API does not return faithfully)")
+}
+
+// CheckDialect returns an error when m cannot be rendered in dialect d. CDKTF
+// output has no equivalent for the lifecycle blocks.
+func (m Mode) CheckDialect(d dialect.Dialect) error {
+	if m == IgnoreChanges && d != dialect.Terraform && d != dialect.Terragrunt {
+		return fmt.Errorf("--compat-mode=%s is not supported with --output-dialect=%s", m, d)
+	}
+	return nil
+}
+
+// Field is an attribute whose changes are ignored, with the API behavior
+// that makes it necessary.
+type Field struct {
+	Path   string
+	Reason string
+}
+
+// Reasons for ignoring attributes, one per API behavior.
+const (
+	reasonNotReturned      = "the API accepts it on write but does not return it"
+	reasonNonHTTP          = "the API returns no value for non-HTTP monitors"
+	reasonSettingsName     = "the API returns the page name instead of the configured value"
+	reasonNestedServiceSRT = "the API may return the default for services inside a group"
+)
+
+// MonitorFields returns the fields of a hyperping_monitor block r, limited to
+// the attributes r sets.
+func MonitorFields(r *hclgen.Body, protocol string) []Field {
+	var fields []Field
+	if r.Has("required_keyword") {
+		fields = append(fields, Field{Path: "required_keyword", Reason: reasonNotReturned})
+	}
+	if protocol != "http" {
+		for _, name := range []string{"http_method", "expected_status_code", "follow_redirects"} {
+			if r.Has(name) {
+				fields = append(fields, Field{Path: name, Reason: reasonNonHTTP})
+			}
+		}
+	}
+	return fields
+}
+
+// StatusPageFields returns the fields of a hyperping_statuspage block
+// generated from sp: settings.name and the show_response_times of every
+// service inside a group.
+func StatusPageFields(sp hyperping.StatusPage) []Field {
+	fields := []Field{{Path: "settings.name", Reason: reasonSettingsName}}
+	for i, section := range sp.Sections {
+		for j, svc := range section.Services {
+			if !svc.IsGroup {
+				continue
+			}
+			for k := range svc.Services {
+				fields = append(fields, Field{
+					Path:   fmt.Sprintf("sections[%d].services[%d].services[%d].show_response_times", i, j, k),
+					Reason: reasonNestedServiceSRT,
+				})
+			}
+		}
+	}
+	return fields
+}
+
+// WriteLifecycle appends a lifecycle block ignoring fields to the resource
+// body r. Nothing is written in None mode or when fields is empty.
+func WriteLifecycle(r *hclgen.Body, m Mode, fields []Field) {
+	if m != IgnoreChanges || len(fields) == 0 {
+		return
+	}
+
+	paths := make([]string, len(fields))
+	r.Newline()
+	lifecycle := r.Block("lifecycle")
+	lifecycle.Comment("Generated by --compat-mode=%s. The provider restores these attributes", m)
+	lifecycle.Comment("from configuration; remove an entry once the API behavior is fixed.")
+	for i, f := range fields {
+		lifecycle.Comment("%s: %s", f.Path, f.Reason)
+		paths[i] = f.Path
+	}
+	_ = lifecycle.SetTraversalList("ignore_changes", paths) //nolint:errcheck // paths are built from attribute names and indexes
+}
@@ -0,0 +1,118 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package compat
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/dialect"
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
+)
+
+func TestParseMode(t *testing.T) {
+	for _, tt := range []struct {
+		in   string
+		want Mode
+	}{
+		{"", None},
+		{"none", None},
+		{"ignore-changes", IgnoreChanges},
+	} {
+		got, err := ParseMode(tt.in)
+		if err != nil || got != tt.want {
+			t.Errorf("ParseMode(%q) = %q, %v, want %q", tt.in, got, err, tt.want)
+		}
+	}
+	if _, err := ParseMode("lifecycle"); err == nil || !strings.Contains(err.Error(), "none, ignore-changes") {
+		t.Errorf("ParseMode(lifecycle) error = %v, want the valid modes listed", err)
+	}
+}
+
+func TestCheckDialect(t *testing.T) {
+	for _, d := range dialect.Dialects {
+		if err := None.CheckDialect(d); err != nil {
+			t.Errorf("None.CheckDialect(%s) = %v", d, err)
+		}
+	}
+	for _, d := range []dialect.Dialect{dialect.Terraform, dialect.Terragrunt} {
+		if err := IgnoreChanges.CheckDialect(d); err != nil {
+			t.Errorf("IgnoreChanges.CheckDialect(%s) = %v", d, err)
+		}
+	}
+	if err := IgnoreChanges.CheckDialect(dialect.CDKTFPython); err == nil {
+		t.Error("IgnoreChanges.CheckDialect(cdktf-python) should fail")
+	}
+}
+
+func TestMonitorFields(t *testing.T) {
+	r := hclgen.NewFile().Body()
+	r.SetString("protocol", "icmp")
+	r.SetString("required_keyword", "ok")
+	r.SetBool("follow_redirects", false)
+
+	got := paths(MonitorFields(r, "icmp"))
+	if want := []string{"required_keyword", "follow_redirects"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MonitorFields(icmp) = %v, want %v", got, want)
+	}
+	got = paths(MonitorFields(r, "http"))
+	if want := []string{"required_keyword"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("MonitorFields(http) = %v, want %v", got, want)
+	}
+}
+
+func TestStatusPageFields(t *testing.T) {
+	sp := hyperping.StatusPage{
+		Sections: []hyperping.StatusPageSection{
+			{Services: []hyperping.StatusPageService{{UUID: "mon_a"}}},
+			{Services: []hyperping.StatusPageService{
+				{UUID: "mon_b"},
+				{IsGroup: true, Services: []hyperping.StatusPageService{{UUID: "mon_c"}, {UUID: "mon_d"}}},
+			}},
+		},
+	}
+	want := []string{
+		"settings.name",
+		"sections[1].services[1].services[0].show_response_times",
+		"sections[1].services[1].services[1].show_response_times",
+	}
+	if got := paths(StatusPageFields(sp)); !reflect.DeepEqual(got, want) {
+		t.Errorf("StatusPageFields = %v, want %v", got, want)
+	}
+}
+
+func TestWriteLifecycle(t *testing.T) {
+	fields := []Field{{Path: "required_keyword", Reason: "not returned"}}
+
+	f := hclgen.NewFile()
+	WriteLifecycle(f.Body(), None, fields)
+	WriteLifecycle(f.Body(), IgnoreChanges, nil)
+	if got := f.String(); strings.TrimSpace(got) != "" {
+		t.Errorf("expected no output, got:\n%s", got)
+	}
+
+	f = hclgen.NewFile()
+	WriteLifecycle(f.Body(), IgnoreChanges, fields)
+	got := f.String()
+	for _, want := range []string{
+		"lifecycle {\n",
+		"# required_keyword: not returned\n",
+		"ignore_changes = [\n    required_keyword,\n  ]\n",
+	} {
+		if !strings.Contains(got, want) {
+			t.Errorf("output missing %q:\n%s", want, got)
+		}
+	}
+}
+
+func paths(fields []Field) []string {
+	out := make([]string, len(fields))
+	for i, f := range fields {
+		out[i] = f.Path
+	}
+	return out
+}
@@ -0,0 +1,180 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// Package credentials stores source platform API tokens for the migration
+// tools in the OS keychain and runs the browser-based --login flow that
+// captures them.
+package credentials
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"runtime"
+	"strings"
+
+	"github.com/zalando/go-keyring"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/interactive"
+)
+
+// KeychainService is the service name tokens are stored under in the OS
+// keychain (macOS Keychain, Windows Credential Manager, or the Secret Service
+// on Linux). Each platform is stored as a separate account.
+const KeychainService = "hyperping-migrate"
+
+// Platform describes a source platform whose API token can be captured with
+// --login.
+type Platform struct {
+	// Name is the display name, e.g. "Better Stack".
+	Name string
+	// Account is the keychain account and validator key, e.g. "betterstack".
+	Account string
+	// TokenURL is the page where a signed-in user can create or copy a token.
+	TokenURL string
+	// Verify checks a token against the platform API before it is stored.
+	// It may be nil.
+	Verify func(ctx context.Context, token string) error
+}
+
+// Keychain reads and writes tokens by account.
+type Keychain interface {
+	Get(account string) (string, error)
+	Set(account, token string) error
+}
+
+// ErrNotFound is returned by a Keychain when no token is stored.
+var ErrNotFound = errors.New("no token stored in keychain")
+
+// osKeychain stores tokens in the OS keychain under KeychainService.
+type osKeychain struct{}
+
+// OSKeychain returns the Keychain backed by the OS credential store.
+func OSKeychain() Keychain {
+	return osKeychain{}
+}
+
+func (osKeychain) Get(account string) (string, error) {
+	token, err := keyring.Get(KeychainService, account)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return "", ErrNotFound
+	}
+	return token, err
+}
+
+func (osKeychain) Set(account, token string) error {
+	return keyring.Set(KeychainService, account, token)
+}
+
+// Lookup returns the token stored for p, or "" when there is none or the
+// keychain is unavailable (for example on a headless Linux host without a
+// Secret Service). A missing keychain is not an error here because flags and
+// environment variables take precedence and remain the primary mechanism.
+func Lookup(kc Keychain, p Platform) string {
+	if kc == nil {
+		return ""
+	}
+	token, err := kc.Get(p.Account)
+	if err != nil {
+		return ""
+	}
+	return token
+}
+
+// LoginOptions configures Login. Zero values use the OS keychain, the system
+// browser, a masked terminal prompt, and stderr.
+type LoginOptions struct {
+	Keychain    Keychain
+	OpenBrowser func(url string) error
+	ReadToken   func(p Platform) (string, error)
+	Out         io.Writer
+}
+
+// Login runs the browser-based credential flow for p: it opens the platform's
+// token page in the user's browser, where they sign in as usual (including
+// SSO and two-factor authentication), then reads the token they paste, checks
+// it with p.Verify, and stores it in the keychain for subsequent runs.
+func Login(ctx context.Context, p Platform, opts LoginOptions) (string, error) {
+	if opts.Keychain == nil {
+		opts.Keychain = OSKeychain()
+	}
+	if opts.OpenBrowser == nil {
+		opts.OpenBrowser = OpenBrowser
+	}
+	if opts.ReadToken == nil {
+		if !interactive.IsInteractive() {
+			return "", errors.New("--login requires an interactive terminal")
+		}
+		opts.ReadToken = promptToken
+	}
+	if opts.Out == nil {
+		opts.Out = os.Stderr
+	}
+
+	fmt.Fprintf(opts.Out, "Opening %s in your browser.\n", p.TokenURL)
+	fmt.Fprintf(opts.Out, "Sign in to %s, then create or copy an API token and paste it below.\n", p.Name)
+	if err := opts.OpenBrowser(p.TokenURL); err != nil {
+		fmt.Fprintf(opts.Out, "Could not open a browser (%v); open the URL above manually.\n", err)
+	}
+
+	token, err := opts.ReadToken(p)
+	if err != nil {
+		return "", fmt.Errorf("failed to read %s token: %w", p.Name, err)
+	}
+	token = strings.TrimSpace(token)
+	if token == "" {
+		return "", fmt.Errorf("no %s token entered", p.Name)
+	}
+
+	if p.Verify != nil {
+		if err := p.Verify(ctx, token); err != nil {
+			return "", fmt.Errorf("%s rejected the token: %w", p.Name, err)
+		}
+	}
+
+	if err := opts.Keychain.Set(p.Account, token); err != nil {
+		return "", fmt.Errorf("failed to store %s token in the OS keychain: %w", p.Name, err)
+	}
+	fmt.Fprintf(opts.Out, "%s token stored in the OS keychain (service %q, account %q).\n", p.Name, KeychainService, p.Account)
+	return token, nil
+}
+
+// StoredTokenHint returns the note printed after --login, which tells the
+// user that the stored token is used unless the token flag (without its
+// leading dashes) or environment variable is set.
+func StoredTokenHint(flagName, envVar string) string {
+	return fmt.Sprintf("Later runs use the stored token when neither --%s nor %s is set.", flagName, envVar)
+}
+
+// promptToken reads a token with a masked terminal prompt.
+func promptToken(p Platform) (string, error) {
+	prompter := interactive.NewPrompter(interactive.DefaultConfig())
+	return prompter.AskPassword(
+		fmt.Sprintf("Paste your %s API token:", p.Name),
+		"Get it from: "+p.TokenURL,
+		interactive.SourceAPIKeyValidator(p.Account),
+	)
+}
+
+// OpenBrowser opens url in the user's default browser.
+func OpenBrowser(url string) error {
+	var cmd *exec.Cmd
+	switch runtime.GOOS {
+	case "darwin":
+		cmd = exec.Command("open", url)
+	case "windows":
+		cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
+	default:
+		cmd = exec.Command("xdg-open", url)
+	}
+	return cmd.Start()
+}
+
+// RegisterLoginFlag registers --login on fs.
+func RegisterLoginFlag(fs *flag.FlagSet, p Platform) *bool {
+	return fs.Bool("login", false, fmt.Sprintf("Sign in to %s in the browser, paste an API token, and store it in the OS keychain for later runs", p.Name))
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+)
+
+// memKeychain is an in-memory Keychain.
+type memKeychain struct {
+	tokens map[string]string
+	setErr error
+}
+
+func (m *memKeychain) Get(account string) (string, error) {
+	token, ok := m.tokens[account]
+	if !ok {
+		return "", ErrNotFound
+	}
+	return token, nil
+}
+
+func (m *memKeychain) Set(account, token string) error {
+	if m.setErr != nil {
+		return m.setErr
+	}
+	if m.tokens == nil {
+		m.tokens = map[string]string{}
+	}
+	m.tokens[account] = token
+	return nil
+}
+
+func testPlatform(verifyErr error) Platform {
+	return Platform{
+		Name:     "Better Stack",
+		Account:  "betterstack",
+		TokenURL: "https://betterstack.com/team/api-tokens",
+		Verify: func(_ context.Context, token string) error {
+			if token != "bs_token_1234567890" {
+				return errors.New("unexpected token " + token)
+			}
+			return verifyErr
+		},
+	}
+}
+
+func TestLogin(t *testing.T) {
+	kc := &memKeychain{}
+	var opened string
+	var out bytes.Buffer
+
+	token, err := Login(context.Background(), testPlatform(nil), LoginOptions{
+		Keychain:    kc,
+		OpenBrowser: func(url string) error { opened = url; return nil },
+		ReadToken:   func(Platform) (string, error) { return "  bs_token_1234567890\n", nil },
+		Out:         &out,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if token != "bs_token_1234567890" {
+		t.Errorf("token = %q, want it trimmed", token)
+	}
+	if opened != "https://betterstack.com/team/api-tokens" {
+		t.Errorf("opened %q, want the token page", opened)
+	}
+	if got := Lookup(kc, testPlatform(nil)); got != token {
+		t.Errorf("stored token = %q, want %q", got, token)
+	}
+	if strings.Contains(out.String(), token) {
+		t.Error("login output must not echo the token")
+	}
+}
+
+func TestLogin_BrowserFailureStillPrompts(t *testing.T) {
+	var out bytes.Buffer
+	_, err := Login(context.Background(), testPlatform(nil), LoginOptions{
+		Keychain:    &memKeychain{},
+		OpenBrowser: func(string) error { return errors.New("no display") },
+		ReadToken:   func(Platform) (string, error) { return "bs_token_1234567890", nil },
+		Out:         &out,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "open the URL above manually") {
+		t.Errorf("expected manual fallback hint, got:\n%s", out.String())
+	}
+}
+
+func TestLogin_Errors(t *testing.T) {
+	tests := []struct {
+		name      string
+		verifyErr error
+		setErr    error
+		token     string
+		wantErr   string
+	}{
+		{name: "empty token", token: "  ", wantErr: "no Better Stack token entered"},
+		{name: "rejected token", token: "bs_token_1234567890", verifyErr: errors.New("401 unauthorized"), wantErr: "Better Stack rejected the token"},
+		{name: "keychain unavailable", token: "bs_token_1234567890", setErr: errors.New("no secret service"), wantErr: "failed to store Better Stack token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			kc := &memKeychain{setErr: tt.setErr}
+			_, err := Login(context.Background(), testPlatform(tt.verifyErr), LoginOptions{
+				Keychain:    kc,
+				OpenBrowser: func(string) error { return nil },
+				ReadToken:   func(Platform) (string, error) { return tt.token, nil },
+				Out:         &bytes.Buffer{},
+			})
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("err = %v, want it to contain %q", err, tt.wantErr)
+			}
+			if len(kc.tokens) != 0 {
+				t.Error("a failed login must not store a token")
+			}
+		})
+	}
+}
+
+func TestLookup(t *testing.T) {
+	p := testPlatform(nil)
+	if got := Lookup(nil, p); got != "" {
+		t.Errorf("nil keychain = %q, want empty", got)
+	}
+	if got := Lookup(&memKeychain{}, p); got != "" {
+		t.Errorf("missing token = %q, want empty", got)
+	}
+	kc := &memKeychain{tokens: map[string]string{"betterstack": "stored"}}
+	if got := Lookup(kc, p); got != "stored" {
+		t.Errorf("stored token = %q, want %q", got, "stored")
+	}
+}
+
+func TestStoredTokenHint(t *testing.T) {
+	want := "Later runs use the stored token when neither --pingdom-api-key nor PINGDOM_API_KEY is set."
+	if got := StoredTokenHint("pingdom-api-key", "PINGDOM_API_KEY"); got != want {
+		t.Errorf("StoredTokenHint() = %q, want %q", got, want)
+	}
+}
@@ -0,0 +1,624 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package dialect
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/zclconf/go-cty/cty"
+)
+
+// CDKTFConfigFile is the name of the CDK for Terraform project file.
+const CDKTFConfigFile = "cdktf.json"
+
+// providerPrefix is stripped from resource types to build CDKTF class and
+// module names, matching the bindings generated by `cdktf get`.
+const providerPrefix = "hyperping_"
+
+// defaultProviderVersion is used when the configuration does not pin the
+// provider in a terraform block, as import-generator output does not.
+const defaultProviderVersion = "~> 1.0"
+
+// language holds what differs between the CDKTF TypeScript and Python output.
+type language struct {
+	name       string
+	mainFile   string
+	app        string
+	comment    string
+	indent     string
+	self       string
+	trueLit    string
+	falseLit   string
+	rawString  string
+	camelCase  bool
+	quoteKeys  bool
+	endLine    string
+	valueProps map[string]string
+}
+
+var (
+	typeScript = language{
+		name:      "typescript",
+		mainFile:  "main.ts",
+		app:       "npx ts-node main.ts",
+		comment:   "//",
+		indent:    "  ",
+		self:      "this",
+		trueLit:   "true",
+		falseLit:  "false",
+		rawString: "Fn.rawString",
+		camelCase: true,
+		endLine:   ";",
+		valueProps: map[string]string{
+			"string":       "stringValue",
+			"number":       "numberValue",
+			"bool":         "booleanValue",
+			"list(string)": "listValue",
+		},
+	}
+	python = language{
+		name:      "python",
+		mainFile:  "main.py",
+		app:       "pipenv run python main.py",
+		comment:   "#",
+		indent:    "    ",
+		self:      "self",
+		trueLit:   "True",
+		falseLit:  "False",
+		rawString: "Fn.raw_string",
+		quoteKeys: true,
+		valueProps: map[string]string{
+			"string":       "string_value",
+			"number":       "number_value",
+			"bool":         "boolean_value",
+			"list(string)": "list_value",
+		},
+	}
+)
+
+// jsIdentifier matches object keys that need no quotes in TypeScript.
+var jsIdentifier = regexp.MustCompile(`^[A-Za-z_$][A-Za-z0-9_$]*$`)
+
+// pythonKeywords are renamed with a trailing underscore, as jsii does for
+// keyword arguments.
+var pythonKeywords = map[string]bool{
+	"and": true, "as": true, "assert": true, "async": true, "await": true, "break": true,
+	"class": true, "continue": true, "def": true, "del": true, "elif": true, "else": true,
+	"except": true, "finally": true, "for": true, "from": true, "global": true, "if": true,
+	"import": true, "in": true, "is": true, "lambda": true, "nonlocal": true, "not": true,
+	"or": true, "pass": true, "raise": true, "return": true, "try": true, "while": true,
+	"with": true, "yield": true,
+}
+
+// cdktfWriter renders a Config as a CDKTF stack in one language.
+type cdktfWriter struct {
+	lang language
+	buf  bytes.Buffer
+
+	// locals maps resource addresses ("hyperping_monitor.api") and variable
+	// references ("var.escalation_policy") to the expression that reads them.
+	locals map[string]string
+	// referenced holds the addresses that are read somewhere, so only those
+	// constructs are assigned to a local (unused locals fail tsc).
+	referenced map[string]bool
+	usesFn     bool
+}
+
+// renderCDKTF renders the configuration as a CDKTF app in lang.
+func renderCDKTF(cfg *Config, lang language) ([]File, error) {
+	w := &cdktfWriter{lang: lang, locals: map[string]string{}, referenced: map[string]bool{}}
+	for _, b := range cfg.Blocks {
+		collectRefs(b.Attributes, w.referenced)
+	}
+
+	// The stack is written first so the preamble knows which cdktf imports
+	// (such as Fn) it needs.
+	if err := w.writeStack(cfg); err != nil {
+		return nil, err
+	}
+	stack := bytes.Clone(w.buf.Bytes())
+	w.buf.Reset()
+	w.writePreamble(cfg)
+	w.buf.Write(stack)
+
+	project, err := cdktfProject(cfg, lang)
+	if err != nil {
+		return nil, err
+	}
+	return []File{
+		{Name: lang.mainFile, Content: w.buf.Bytes()},
+		{Name: CDKTFConfigFile, Content: project},
+	}, nil
+}
+
+// cdktfProject returns cdktf.json, pinning the provider to the version the
+// Terraform configuration requires.
+func cdktfProject(cfg *Config, lang language) ([]byte, error) {
+	project := struct {
+		Language           string            `json:"language"`
+		App                string            `json:"app"`
+		TerraformProviders []string          `json:"terraformProviders"`
+		TerraformModules   []string          `json:"terraformModules"`
+		Context            map[string]string `json:"context"`
+	}{
+		Language:           lang.name,
+		App:                lang.app,
+		TerraformProviders: []string{"develeap/hyperping@" + providerVersion(cfg)},
+		TerraformModules:   []string{},
+		Context:            map[string]string{},
+	}
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(project); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// providerVersion returns the hyperping version constraint from the
+// terraform block, or defaultProviderVersion.
+func providerVersion(cfg *Config) string {
+	for _, b := range cfg.Blocks {
+		if b.Type != "terraform" {
+			continue
+		}
+		for _, rp := range b.Blocks {
+			if rp.Type != "required_providers" {
+				continue
+			}
+			if provider, ok := rp.Attr("hyperping"); ok {
+				if version, ok := provider.Field("version"); ok && version.Literal.Type() == cty.String {
+					return version.Literal.AsString()
+				}
+			}
+		}
+	}
+	return defaultProviderVersion
+}
+
+func (w *cdktfWriter) writePreamble(cfg *Config) {
+	w.comments(0, cfg.Header)
+	if len(cfg.Header) > 0 {
+		w.line(0, "%s", w.lang.comment)
+	}
+	w.comments(0, []string{
+		"CDK for Terraform app. Run `cdktf get` to generate the provider bindings,",
+		"then `cdktf synth`. Existing resources can be imported with the generated",
+		"import script from cdktf.out/stacks/hyperping, where construct IDs match",
+		"the Terraform resource names.",
+	})
+	w.line(0, "")
+
+	cdktf := []string{"App", "TerraformStack"}
+	classes := map[string]bool{}
+	for _, b := range cfg.Blocks {
+		switch b.Type {
+		case "variable":
+			cdktf = append(cdktf, "TerraformVariable")
+		case "output":
+			cdktf = append(cdktf, "TerraformOutput")
+		case "resource":
+			classes[b.Labels[0]] = true
+		}
+	}
+	if w.usesFn {
+		cdktf = append(cdktf, "Fn")
+	}
+	cdktf = uniqueSorted(cdktf)
+	resourceTypes := make([]string, 0, len(classes))
+	for t := range classes {
+		resourceTypes = append(resourceTypes, t)
+	}
+	sort.Strings(resourceTypes)
+
+	switch w.lang.name {
+	case "typescript":
+		w.line(0, `import { Construct } from "constructs";`)
+		w.line(0, "import { %s } from \"cdktf\";", strings.Join(cdktf, ", "))
+		w.line(0, `import { HyperpingProvider } from "./.gen/providers/hyperping/provider";`)
+		for _, t := range resourceTypes {
+			module := strings.ReplaceAll(strings.TrimPrefix(t, providerPrefix), "_", "-")
+			w.line(0, "import { %s } from \"./.gen/providers/hyperping/%s\";", className(t), module)
+		}
+	default:
+		w.line(0, "from constructs import Construct")
+		w.line(0, "from cdktf import %s", strings.Join(cdktf, ", "))
+		w.line(0, "from imports.hyperping.provider import HyperpingProvider")
+		for _, t := range resourceTypes {
+			w.line(0, "from imports.hyperping.%s import %s", strings.TrimPrefix(t, providerPrefix), className(t))
+		}
+	}
+	w.line(0, "")
+}
+
+func (w *cdktfWriter) writeStack(cfg *Config) error {
+	ts := w.lang.name == "typescript"
+	if ts {
+		w.line(0, "class HyperpingStack extends TerraformStack {")
+		w.line(1, "constructor(scope: Construct, id: string) {")
+		w.line(2, "super(scope, id);")
+	} else {
+		w.line(0, "")
+		w.line(0, "class HyperpingStack(TerraformStack):")
+		w.line(1, "def __init__(self, scope: Construct, id: str):")
+		w.line(2, "super().__init__(scope, id)")
+	}
+
+	for _, b := range cfg.Blocks {
+		if b.Type == "terraform" {
+			continue
+		}
+		w.line(0, "")
+		w.comments(2, b.Comments)
+		var err error
+		switch b.Type {
+		case "provider":
+			w.construct("HyperpingProvider", "hyperping", "", nil)
+		case "variable":
+			err = w.variable(b)
+		case "output":
+			err = w.output(b)
+		case "resource":
+			err = w.resource(b)
+		default:
+			err = fmt.Errorf("%s blocks are not supported in CDKTF output", b.Type)
+		}
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(cfg.Trailer) > 0 {
+		w.line(0, "")
+		w.comments(2, cfg.Trailer)
+	}
+
+	if ts {
+		w.line(1, "}")
+		w.line(0, "}")
+		w.line(0, "")
+		w.line(0, "const app = new App();")
+		w.line(0, `new HyperpingStack(app, "hyperping");`)
+		w.line(0, "app.synth();")
+	} else {
+		w.line(0, "")
+		w.line(0, "")
+		w.line(0, "app = App()")
+		w.line(0, `HyperpingStack(app, "hyperping")`)
+		w.line(0, "app.synth()")
+	}
+	return nil
+}
+
+func (w *cdktfWriter) variable(b Block) error {
+	if len(b.Labels) != 1 {
+		return fmt.Errorf("variable block needs one label")
+	}
+	name := b.Labels[0]
+	local := w.localName(name)
+	prop := "value"
+	var attrs []Attribute
+	for _, a := range b.Attributes {
+		if a.Value.Type != "" {
+			if p, ok := w.lang.valueProps[a.Value.Type]; ok {
+				prop = p
+			}
+			// Type constraints are expressions in HCL but strings in CDKTF.
+			a.Value = Value{Literal: cty.StringVal(a.Value.Type)}
+		}
+		attrs = append(attrs, a)
+	}
+	w.locals["var."+name] = local + "." + prop
+	return w.construct("TerraformVariable", name, w.assign("var."+name, local), attrs)
+}
+
+func (w *cdktfWriter) output(b Block) error {
+	if len(b.Labels) != 1 {
+		return fmt.Errorf("output block needs one label")
+	}
+	return w.construct("TerraformOutput", b.Labels[0], "", b.Attributes)
+}
+
+func (w *cdktfWriter) resource(b Block) error {
+	if len(b.Labels) != 2 {
+		return fmt.Errorf("resource block needs two labels")
+	}
+	address := b.Labels[0] + "." + b.Labels[1]
+	if len(b.Blocks) > 0 {
+		return fmt.Errorf("%s: %s blocks are not supported in CDKTF output", address, b.Blocks[0].Type)
+	}
+	local := w.localName(strings.TrimPrefix(b.Labels[0], providerPrefix) + "_" + b.Labels[1])
+	w.locals[address] = local
+	return w.construct(className(b.Labels[0]), b.Labels[1], w.assign(address, local), b.Attributes)
+}
+
+// assign returns the local a construct is assigned to, or "" when nothing
+// reads it.
+func (w *cdktfWriter) assign(address, local string) string {
+	if w.referenced[address] {
+		return local
+	}
+	return ""
+}
+
+// construct writes a construct instantiation with the given attributes.
+func (w *cdktfWriter) construct(class, id, local string, attrs []Attribute) error {
+	idLit := jsonString(id)
+	ts := w.lang.name == "typescript"
+
+	var prefix string
+	switch {
+	case ts && local != "":
+		prefix = "const " + local + " = new "
+	case ts:
+		prefix = "new "
+	case local != "":
+		prefix = local + " = "
+	}
+
+	props := make([]string, 0, len(attrs))
+	for _, a := range attrs {
+		if a.Value.Ref == "" && !a.Value.IsList && !a.Value.IsObject && a.Value.Literal.IsNull() {
+			continue
+		}
+		value, err := w.value(a.Value, 3)
+		if err != nil {
+			return fmt.Errorf("%s %q: %s: %w", class, id, a.Name, err)
+		}
+		if ts {
+			props = append(props, w.key(a.Name)+": "+value+",")
+		} else {
+			props = append(props, w.kwarg(a.Name)+"="+value+",")
+		}
+	}
+
+	if len(props) == 0 {
+		w.line(2, "%s%s(%s, %s)%s", prefix, class, w.lang.self, idLit, w.lang.endLine)
+		return nil
+	}
+	if ts {
+		w.line(2, "%s%s(this, %s, {", prefix, class, idLit)
+	} else {
+		w.line(2, "%s%s(", prefix, class)
+		w.line(3, "self,")
+		w.line(3, "%s,", idLit)
+	}
+	for _, p := range props {
+		w.line(3, "%s", p)
+	}
+	if ts {
+		w.line(2, "});")
+	} else {
+		w.line(2, ")")
+	}
+	return nil
+}
+
+// value renders v at the given indentation depth.
+func (w *cdktfWriter) value(v Value, depth int) (string, error) {
+	switch {
+	case v.Ref != "":
+		return w.ref(v.Ref)
+	case v.IsList:
+		items := make([]string, len(v.List))
+		simple := true
+		for i, item := range v.List {
+			rendered, err := w.value(item, depth+1)
+			if err != nil {
+				return "", err
+			}
+			items[i] = rendered
+			simple = simple && !item.IsObject && !item.IsList
+		}
+		if simple {
+			return "[" + strings.Join(items, ", ") + "]", nil
+		}
+		var sb strings.Builder
+		sb.WriteString("[\n")
+		for _, item := range items {
+			sb.WriteString(strings.Repeat(w.lang.indent, depth+1) + item + ",\n")
+		}
+		sb.WriteString(strings.Repeat(w.lang.indent, depth) + "]")
+		return sb.String(), nil
+	case v.IsObject:
+		if len(v.Object) == 0 {
+			return "{}", nil
+		}
+		var sb strings.Builder
+		sb.WriteString("{\n")
+		for _, a := range v.Object {
+			rendered, err := w.value(a.Value, depth+1)
+			if err != nil {
+				return "", err
+			}
+			sb.WriteString(strings.Repeat(w.lang.indent, depth+1) + w.key(a.Name) + ": " + rendered + ",\n")
+		}
+		sb.WriteString(strings.Repeat(w.lang.indent, depth) + "}")
+		return sb.String(), nil
+	default:
+		return w.literal(v.Literal)
+	}
+}
+
+func (w *cdktfWriter) literal(v cty.Value) (string, error) {
+	if v.IsNull() {
+		if w.lang.name == "typescript" {
+			return "undefined", nil
+		}
+		return "None", nil
+	}
+	switch v.Type() {
+	case cty.String:
+		s := v.AsString()
+		if strings.Contains(s, "${") || strings.Contains(s, "%{") {
+			// CDKTF passes strings through to Terraform, which would
+			// otherwise evaluate the sequence as a template.
+			w.usesFn = true
+			return w.lang.rawString + "(" + jsonString(s) + ")", nil
+		}
+		return jsonString(s), nil
+	case cty.Number:
+		return v.AsBigFloat().Text('f', -1), nil
+	case cty.Bool:
+		if v.True() {
+			return w.lang.trueLit, nil
+		}
+		return w.lang.falseLit, nil
+	default:
+		return "", fmt.Errorf("unsupported value of type %s", v.Type().FriendlyName())
+	}
+}
+
+// ref renders a reference to a variable or to a resource attribute.
+func (w *cdktfWriter) ref(ref string) (string, error) {
+	parts := strings.Split(ref, ".")
+	if parts[0] == "var" && len(parts) == 2 {
+		if local, ok := w.locals[ref]; ok {
+			return local, nil
+		}
+		return "", fmt.Errorf("reference to undeclared variable %q", ref)
+	}
+	if len(parts) >= 3 {
+		if local, ok := w.locals[parts[0]+"."+parts[1]]; ok {
+			attrs := parts[2:]
+			for i, a := range attrs {
+				attrs[i] = w.attrName(a)
+			}
+			return local + "." + strings.Join(attrs, "."), nil
+		}
+	}
+	return "", fmt.Errorf("unsupported reference %q", ref)
+}
+
+// key renders an object key: camelCase in TypeScript, where nested
+// attributes become struct properties, and a quoted snake_case string in
+// Python, where jsii accepts dicts keyed by Python property names.
+func (w *cdktfWriter) key(name string) string {
+	if w.lang.quoteKeys {
+		return jsonString(name)
+	}
+	name = w.attrName(name)
+	if jsIdentifier.MatchString(name) {
+		return name
+	}
+	return jsonString(name)
+}
+
+func (w *cdktfWriter) kwarg(name string) string {
+	if pythonKeywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+func (w *cdktfWriter) attrName(name string) string {
+	if w.lang.camelCase {
+		return lowerCamel(name)
+	}
+	return name
+}
+
+func (w *cdktfWriter) localName(name string) string {
+	if w.lang.camelCase {
+		return lowerCamel(name)
+	}
+	name = strings.ReplaceAll(name, "-", "_")
+	if pythonKeywords[name] {
+		return name + "_"
+	}
+	return name
+}
+
+func (w *cdktfWriter) comments(depth int, lines []string) {
+	for _, c := range lines {
+		if c == "" {
+			w.line(depth, "%s", w.lang.comment)
+			continue
+		}
+		w.line(depth, "%s %s", w.lang.comment, singleLine(c))
+	}
+}
+
+func (w *cdktfWriter) line(depth int, format string, args ...any) {
+	text := fmt.Sprintf(format, args...)
+	if text != "" {
+		w.buf.WriteString(strings.Repeat(w.lang.indent, depth))
+	}
+	w.buf.WriteString(text)
+	w.buf.WriteByte('\n')
+}
+
+// collectRefs marks the resource addresses and variables read by attrs.
+func collectRefs(attrs []Attribute, into map[string]bool) {
+	var walk func(v Value)
+	walk = func(v Value) {
+		if v.Ref != "" {
+			parts := strings.Split(v.Ref, ".")
+			if len(parts) >= 2 {
+				into[parts[0]+"."+parts[1]] = true
+			}
+		}
+		for _, item := range v.List {
+			walk(item)
+		}
+		for _, a := range v.Object {
+			walk(a.Value)
+		}
+	}
+	for _, a := range attrs {
+		walk(a.Value)
+	}
+}
+
+// className returns the CDKTF class for a resource type, e.g.
+// hyperping_statuspage_subscriber -> StatuspageSubscriber.
+func className(resourceType string) string {
+	camel := lowerCamel(strings.TrimPrefix(resourceType, providerPrefix))
+	if camel == "" {
+		return camel
+	}
+	return strings.ToUpper(camel[:1]) + camel[1:]
+}
+
+// lowerCamel converts snake_case or kebab-case to lowerCamelCase.
+func lowerCamel(s string) string {
+	parts := strings.FieldsFunc(s, func(r rune) bool { return r == '_' || r == '-' })
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+	return strings.Join(parts, "")
+}
+
+// jsonString quotes s as a JSON string, which is also a valid TypeScript and
+// Python string literal.
+func jsonString(s string) string {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	_ = enc.Encode(s) //nolint:errcheck // encoding a string cannot fail
+	return strings.TrimSuffix(buf.String(), "\n")
+}
+
+// singleLine collapses line breaks so text stays inside a line comment.
+func singleLine(s string) string {
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(s)
+}
+
+func uniqueSorted(values []string) []string {
+	sort.Strings(values)
+	out := values[:0]
+	for i, v := range values {
+		if i == 0 || v != values[i-1] {
+			out = append(out, v)
+		}
+	}
+	return out
+}
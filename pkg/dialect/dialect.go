@@ -0,0 +1,130 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// Package dialect renders generated Terraform configuration in the formats
+// consumed by teams that do not use raw Terraform: Terragrunt
+// (terragrunt.hcl with inputs) and CDK for Terraform (TypeScript or Python
+// constructs).
+//
+// Generators keep producing HCL through hclgen. That output is parsed into
+// the canonical Config model, and every dialect is rendered from the model,
+// so resources, references, and migration comments are the same whichever
+// dialect is selected.
+package dialect
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Dialect is an output format for generated configuration.
+type Dialect string
+
+// Supported dialects.
+const (
+	Terraform       Dialect = "terraform"
+	Terragrunt      Dialect = "terragrunt"
+	CDKTFTypeScript Dialect = "cdktf-typescript"
+	CDKTFPython     Dialect = "cdktf-python"
+)
+
+// Dialects lists the supported dialects in the order they are documented.
+var Dialects = []Dialect{Terraform, Terragrunt, CDKTFTypeScript, CDKTFPython}
+
+// ParseDialect returns the dialect named s. The empty string selects
+// Terraform.
+func ParseDialect(s string) (Dialect, error) {
+	if s == "" {
+		return Terraform, nil
+	}
+	for _, d := range Dialects {
+		if string(d) == s {
+			return d, nil
+		}
+	}
+	names := make([]string, len(Dialects))
+	for i, d := range Dialects {
+		names[i] = string(d)
+	}
+	return "", fmt.Errorf("unknown output dialect %q (valid: %s)", s, strings.Join(names, ", "))
+}
+
+// RegisterFlag registers --output-dialect on fs.
+func RegisterFlag(fs *flag.FlagSet) *string {
+	return fs.String("output-dialect", string(Terraform),
+		"Configuration format: terraform, terragrunt (terragrunt.hcl + inputs), cdktf-typescript, or cdktf-python")
+}
+
+// File is a rendered output file, named relative to the output directory.
+type File struct {
+	Name    string
+	Content []byte
+}
+
+// Render converts a generated Terraform configuration, which would have been
+// written to tfName, into the files of dialect d:
+//
+//   - terraform: tfName unchanged.
+//   - terragrunt: tfName without the terraform and provider blocks, and a
+//     terragrunt.hcl that generates them and sets the variables as inputs.
+//   - cdktf-typescript: main.ts and cdktf.json.
+//   - cdktf-python: main.py and cdktf.json.
+func Render(d Dialect, src []byte, tfName string) ([]File, error) {
+	if d == Terraform || d == "" {
+		return []File{{Name: tfName, Content: src}}, nil
+	}
+
+	cfg, err := Parse(src, tfName)
+	if err != nil {
+		return nil, err
+	}
+
+	switch d {
+	case Terragrunt:
+		return renderTerragrunt(cfg, tfName)
+	case CDKTFTypeScript:
+		return renderCDKTF(cfg, typeScript)
+	case CDKTFPython:
+		return renderCDKTF(cfg, python)
+	default:
+		return nil, fmt.Errorf("unknown output dialect %q", d)
+	}
+}
+
+// FileNames returns the names of the files Render produces for dialect d,
+// for summaries printed by the generators.
+func FileNames(d Dialect, tfName string) []string {
+	switch d {
+	case Terragrunt:
+		return []string{tfName, TerragruntFile}
+	case CDKTFTypeScript:
+		return []string{typeScript.mainFile, CDKTFConfigFile}
+	case CDKTFPython:
+		return []string{python.mainFile, CDKTFConfigFile}
+	default:
+		return []string{tfName}
+	}
+}
+
+// WriteConfig renders src in dialect d and writes the files into the
+// directory of tfPath, the path the Terraform file is written to, and returns
+// the paths written.
+func WriteConfig(d Dialect, src []byte, tfPath string) ([]string, error) {
+	files, err := Render(d, src, filepath.Base(tfPath))
+	if err != nil {
+		return nil, err
+	}
+	dir := filepath.Dir(tfPath)
+	paths := make([]string, 0, len(files))
+	for _, f := range files {
+		path := filepath.Join(dir, f.Name)
+		if err := os.WriteFile(path, f.Content, 0o600); err != nil {
+			return paths, fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		paths = append(paths, path)
+	}
+	return paths, nil
+}
@@ -0,0 +1,188 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package dialect
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "rewrite testdata/*.golden files with current output")
+
+// goldenAssert compares got to the contents of testdata/<name>. With
+// -update-golden, the file is rewritten instead.
+func goldenAssert(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil { //nolint:gosec // testdata only
+			t.Fatalf("write %s: %v", path, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v (run: go test ./pkg/dialect -update-golden)", path, err)
+	}
+	if got != string(want) {
+		t.Errorf("%s mismatch (run: go test ./pkg/dialect -update-golden)\n--- got ---\n%s", name, got)
+	}
+}
+
+func readInput(t *testing.T) []byte {
+	t.Helper()
+	src, err := os.ReadFile(filepath.Join("testdata", "input.tf"))
+	if err != nil {
+		t.Fatalf("read input: %v", err)
+	}
+	return src
+}
+
+func TestParseDialect(t *testing.T) {
+	for _, tc := range []struct {
+		in   string
+		want Dialect
+	}{
+		{"", Terraform},
+		{"terraform", Terraform},
+		{"terragrunt", Terragrunt},
+		{"cdktf-typescript", CDKTFTypeScript},
+		{"cdktf-python", CDKTFPython},
+	} {
+		got, err := ParseDialect(tc.in)
+		if err != nil {
+			t.Errorf("ParseDialect(%q): %v", tc.in, err)
+			continue
+		}
+		if got != tc.want {
+			t.Errorf("ParseDialect(%q) = %q, want %q", tc.in, got, tc.want)
+		}
+	}
+
+	if _, err := ParseDialect("pulumi"); err == nil || !strings.Contains(err.Error(), "cdktf-python") {
+		t.Errorf("ParseDialect(pulumi) error = %v, want list of valid dialects", err)
+	}
+}
+
+func TestParse(t *testing.T) {
+	cfg, err := Parse(readInput(t), "input.tf")
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	if len(cfg.Header) != 2 || cfg.Header[0] != "Auto-generated from Better Stack migration" {
+		t.Errorf("Header = %q", cfg.Header)
+	}
+	if len(cfg.Blocks) != 8 {
+		t.Fatalf("got %d blocks, want 8", len(cfg.Blocks))
+	}
+
+	monitor := cfg.Blocks[5]
+	if monitor.Type != "resource" || strings.Join(monitor.Labels, ".") != "hyperping_monitor.api_health" {
+		t.Fatalf("block 5 = %s %v", monitor.Type, monitor.Labels)
+	}
+	if len(monitor.Comments) != 3 || monitor.Comments[2] != "- Frequency rounded from 240s to 300s" {
+		t.Errorf("Comments = %q", monitor.Comments)
+	}
+	if v, _ := monitor.Attr("regions"); v.Ref != "var.regions" {
+		t.Errorf("regions = %+v, want reference", v)
+	}
+	if v, _ := monitor.Attr("request_body"); v.Literal.AsString() != "{\n  \"ping\": true\n}" {
+		t.Errorf("request_body = %q", v.Literal.AsString())
+	}
+	headers, _ := monitor.Attr("request_headers")
+	if value, _ := headers.List[0].Field("value"); value.Literal.AsString() != "Bearer ${TOKEN}" {
+		t.Errorf("header value = %q", value.Literal.AsString())
+	}
+
+	provider := cfg.Blocks[1]
+	if len(provider.Comments) != 1 || provider.Comments[0] != "API key from HYPERPING_API_KEY environment variable" {
+		t.Errorf("provider comments = %q", provider.Comments)
+	}
+}
+
+func TestRender(t *testing.T) {
+	src := readInput(t)
+	for _, d := range Dialects {
+		t.Run(string(d), func(t *testing.T) {
+			files, err := Render(d, src, "main.tf")
+			if err != nil {
+				t.Fatalf("Render: %v", err)
+			}
+			names := FileNames(d, "main.tf")
+			if len(files) != len(names) {
+				t.Fatalf("got %d files, want %v", len(files), names)
+			}
+			for i, f := range files {
+				if f.Name != names[i] {
+					t.Errorf("file %d = %s, want %s", i, f.Name, names[i])
+				}
+				if d == Terraform {
+					if string(f.Content) != string(src) {
+						t.Errorf("terraform output differs from input")
+					}
+					continue
+				}
+				goldenAssert(t, string(d)+"."+f.Name+".golden", string(f.Content))
+			}
+		})
+	}
+}
+
+func TestRender_TerragruntDefaultProvider(t *testing.T) {
+	src := []byte("resource \"hyperping_monitor\" \"a\" {\n  name = \"A\"\n}\n")
+	files, err := Render(Terragrunt, src, "main.tf")
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	tg := string(files[1].Content)
+	if !strings.Contains(tg, `source  = "develeap/hyperping"`) {
+		t.Errorf("terragrunt.hcl does not generate the provider:\n%s", tg)
+	}
+	if !strings.Contains(tg, "inputs = {\n}") {
+		t.Errorf("terragrunt.hcl inputs not empty:\n%s", tg)
+	}
+}
+
+func TestRender_Lifecycle(t *testing.T) {
+	src := []byte("resource \"hyperping_statuspage\" \"a\" {\n  name = \"A\"\n\n  lifecycle {\n    ignore_changes = [\n      settings.name,\n      sections[0].services[1].show_response_times,\n    ]\n  }\n}\n")
+
+	files, err := Render(Terragrunt, src, "main.tf")
+	if err != nil {
+		t.Fatalf("Render: %v", err)
+	}
+	if !strings.Contains(string(files[0].Content), "sections[0].services[1].show_response_times,") {
+		t.Errorf("lifecycle block not kept in the resources file:\n%s", files[0].Content)
+	}
+
+	if _, err := Render(CDKTFTypeScript, src, "main.tf"); err == nil || !strings.Contains(err.Error(), "lifecycle blocks are not supported") {
+		t.Errorf("Render(cdktf) error = %v, want lifecycle not supported", err)
+	}
+}
+
+func TestRender_InvalidHCL(t *testing.T) {
+	if _, err := Render(Terragrunt, []byte("resource {"), "main.tf"); err == nil {
+		t.Fatal("expected parse error")
+	}
+}
+
+func TestWriteConfig(t *testing.T) {
+	dir := t.TempDir()
+	paths, err := WriteConfig(CDKTFTypeScript, readInput(t), filepath.Join(dir, "main.tf"))
+	if err != nil {
+		t.Fatalf("WriteConfig: %v", err)
+	}
+	want := []string{filepath.Join(dir, "main.ts"), filepath.Join(dir, "cdktf.json")}
+	if strings.Join(paths, ",") != strings.Join(want, ",") {
+		t.Fatalf("paths = %v, want %v", paths, want)
+	}
+	for _, path := range paths {
+		if _, err := os.Stat(path); err != nil {
+			t.Errorf("stat %s: %v", path, err)
+		}
+	}
+}
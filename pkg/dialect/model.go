@@ -0,0 +1,297 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package dialect
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+// Config is the canonical model of a generated configuration: the blocks of
+// a Terraform file in source order, each with the comments written above or
+// inside it. Every dialect is rendered from this model, so the emitters stay
+// in step with the HCL the generators produce.
+type Config struct {
+	// Header holds the comments before the first block.
+	Header []string
+	Blocks []Block
+	// Trailer holds the comments after the last block.
+	Trailer []string
+
+	// source is the original file, for dialects that keep parts verbatim.
+	source []byte
+}
+
+// Block is a top-level or nested block such as
+// resource "hyperping_monitor" "api" { ... }.
+type Block struct {
+	Type       string
+	Labels     []string
+	Comments   []string
+	Attributes []Attribute
+	Blocks     []Block
+}
+
+// Attribute is a named value of a block or object.
+type Attribute struct {
+	Name  string
+	Value Value
+}
+
+// Value is an attribute value. Exactly one of its forms is set: a reference
+// such as var.escalation_policy, a variable type constraint, a list, an
+// object, or a literal.
+type Value struct {
+	// Ref is a dotted reference such as "hyperping_healthcheck.api.ping_url".
+	Ref string
+	// Type is the type constraint of a variable as written, such as
+	// "list(string)".
+	Type string
+	// List holds list elements when IsList is set.
+	List   []Value
+	IsList bool
+	// Object holds object attributes in source order when IsObject is set.
+	Object   []Attribute
+	IsObject bool
+	// Literal is a known primitive value otherwise.
+	Literal cty.Value
+}
+
+// Attr returns the attribute named name and whether it exists.
+func (b Block) Attr(name string) (Value, bool) {
+	for _, a := range b.Attributes {
+		if a.Name == name {
+			return a.Value, true
+		}
+	}
+	return Value{}, false
+}
+
+// Field returns the object attribute named name and whether it exists.
+func (v Value) Field(name string) (Value, bool) {
+	for _, a := range v.Object {
+		if a.Name == name {
+			return a.Value, true
+		}
+	}
+	return Value{}, false
+}
+
+// evalContext provides the functions generated files may call on literal
+// values, such as chomp() around heredoc request bodies.
+var evalContext = &hcl.EvalContext{
+	Functions: map[string]function.Function{
+		"chomp": stdlib.ChompFunc,
+	},
+}
+
+// Parse reads a generated Terraform configuration into a Config.
+func Parse(src []byte, filename string) (*Config, error) {
+	file, diags := hclsyntax.ParseConfig(src, filename, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("parse %s: %s", filename, diags.Error())
+	}
+	body, ok := file.Body.(*hclsyntax.Body)
+	if !ok {
+		return nil, fmt.Errorf("parse %s: unexpected body type %T", filename, file.Body)
+	}
+	if len(body.Attributes) > 0 {
+		return nil, fmt.Errorf("parse %s: top-level attributes are not supported", filename)
+	}
+
+	lines := bytes.Split(src, []byte("\n"))
+	cfg := &Config{source: src}
+	prevEnd := 0 // line index after the previous block
+	for i, b := range body.Blocks {
+		block, err := parseBlock(b, src, lines)
+		if err != nil {
+			return nil, fmt.Errorf("parse %s: %w", filename, err)
+		}
+		start := b.Range().Start.Line - 1
+		leading := commentLines(lines, prevEnd, start)
+		if i == 0 {
+			detached := start > 0 && len(bytes.TrimSpace(lines[start-1])) == 0
+			cfg.Header, leading = splitHeader(leading, detached)
+		}
+		block.Comments = append(leading, block.Comments...)
+		cfg.Blocks = append(cfg.Blocks, block)
+		prevEnd = b.Range().End.Line
+	}
+	if len(body.Blocks) == 0 {
+		cfg.Header = commentLines(lines, 0, len(lines))
+	} else {
+		cfg.Trailer = commentLines(lines, prevEnd, len(lines))
+	}
+	return cfg, nil
+}
+
+// splitHeader separates the file header from the comments of the first block:
+// the header ends at the last blank line before the block. When a blank line
+// separates the comments from the block, they are all header.
+func splitHeader(comments []string, detached bool) (header, rest []string) {
+	if detached {
+		return comments, nil
+	}
+	for i := len(comments) - 1; i >= 0; i-- {
+		if comments[i] == "" {
+			return trimBlank(comments[:i]), trimBlank(comments[i+1:])
+		}
+	}
+	return nil, comments
+}
+
+func parseBlock(b *hclsyntax.Block, src []byte, lines [][]byte) (Block, error) {
+	block := Block{Type: b.Type, Labels: b.Labels}
+
+	attrs := make([]*hclsyntax.Attribute, 0, len(b.Body.Attributes))
+	for _, a := range b.Body.Attributes {
+		attrs = append(attrs, a)
+	}
+	sort.Slice(attrs, func(i, j int) bool { return attrs[i].SrcRange.Start.Byte < attrs[j].SrcRange.Start.Byte })
+
+	// Comments inside the block are kept when they stand on their own lines
+	// between attributes; trailing comments after a value are dropped.
+	covered := make(map[int]bool)
+	for _, a := range attrs {
+		value, err := parseExpr(a.Expr)
+		if b.Type == "variable" && a.Name == "type" {
+			// Type constraints such as list(string) are not values.
+			value, err = Value{Type: string(a.Expr.Range().SliceBytes(src))}, nil
+		}
+		if err != nil {
+			return Block{}, fmt.Errorf("%s.%s: %w", strings.Join(append([]string{b.Type}, b.Labels...), "."), a.Name, err)
+		}
+		block.Attributes = append(block.Attributes, Attribute{Name: a.Name, Value: value})
+		for line := a.SrcRange.Start.Line; line <= a.SrcRange.End.Line; line++ {
+			covered[line] = true
+		}
+	}
+	for _, nested := range b.Body.Blocks {
+		child, err := parseBlock(nested, src, lines)
+		if err != nil {
+			return Block{}, err
+		}
+		block.Blocks = append(block.Blocks, child)
+		for line := nested.Range().Start.Line; line <= nested.Range().End.Line; line++ {
+			covered[line] = true
+		}
+	}
+	for line := b.OpenBraceRange.End.Line + 1; line < b.CloseBraceRange.Start.Line; line++ {
+		if covered[line] {
+			continue
+		}
+		if text, ok := commentText(lines[line-1]); ok && text != "" {
+			block.Comments = append(block.Comments, text)
+		}
+	}
+	return block, nil
+}
+
+func parseExpr(expr hclsyntax.Expression) (Value, error) {
+	switch e := expr.(type) {
+	case *hclsyntax.ScopeTraversalExpr:
+		parts := make([]string, 0, len(e.Traversal))
+		for _, step := range e.Traversal {
+			switch s := step.(type) {
+			case hcl.TraverseRoot:
+				parts = append(parts, s.Name)
+			case hcl.TraverseAttr:
+				parts = append(parts, s.Name)
+			case hcl.TraverseIndex:
+				// Index steps appear in attribute paths such as
+				// lifecycle ignore_changes entries.
+				if s.Key.Type() != cty.Number || !s.Key.IsKnown() || s.Key.IsNull() {
+					return Value{}, fmt.Errorf("unsupported index %s", s.Key.GoString())
+				}
+				parts[len(parts)-1] += "[" + s.Key.AsBigFloat().Text('f', -1) + "]"
+			default:
+				return Value{}, fmt.Errorf("unsupported reference %T", step)
+			}
+		}
+		return Value{Ref: strings.Join(parts, ".")}, nil
+	case *hclsyntax.TupleConsExpr:
+		v := Value{IsList: true}
+		for _, item := range e.Exprs {
+			elem, err := parseExpr(item)
+			if err != nil {
+				return Value{}, err
+			}
+			v.List = append(v.List, elem)
+		}
+		return v, nil
+	case *hclsyntax.ObjectConsExpr:
+		v := Value{IsObject: true}
+		for _, item := range e.Items {
+			key, diags := item.KeyExpr.Value(nil)
+			if diags.HasErrors() || key.Type() != cty.String || !key.IsKnown() || key.IsNull() {
+				return Value{}, fmt.Errorf("unsupported object key")
+			}
+			elem, err := parseExpr(item.ValueExpr)
+			if err != nil {
+				return Value{}, err
+			}
+			v.Object = append(v.Object, Attribute{Name: key.AsString(), Value: elem})
+		}
+		return v, nil
+	default:
+		literal, diags := expr.Value(evalContext)
+		if diags.HasErrors() {
+			return Value{}, fmt.Errorf("unsupported expression: %s", diags.Error())
+		}
+		if !literal.Type().IsPrimitiveType() && !literal.IsNull() {
+			return Value{}, fmt.Errorf("unsupported literal of type %s", literal.Type().FriendlyName())
+		}
+		return Value{Literal: literal}, nil
+	}
+}
+
+// commentLines returns the text of the "#" comment lines between the
+// zero-based line indexes from and to, keeping a single "" for each run of
+// blank lines so that sections stay separated.
+func commentLines(lines [][]byte, from, to int) []string {
+	var out []string
+	for i := from; i < to && i < len(lines); i++ {
+		line := bytes.TrimSpace(lines[i])
+		if len(line) == 0 {
+			if len(out) > 0 && out[len(out)-1] != "" {
+				out = append(out, "")
+			}
+			continue
+		}
+		if text, ok := commentText(line); ok {
+			out = append(out, text)
+		}
+	}
+	return trimBlank(out)
+}
+
+// commentText returns the text of a "#" or "//" comment line.
+func commentText(line []byte) (string, bool) {
+	line = bytes.TrimSpace(line)
+	for _, prefix := range []string{"#", "//"} {
+		if bytes.HasPrefix(line, []byte(prefix)) {
+			// Keep indentation after the comment marker, which lays out lists.
+			return strings.TrimPrefix(string(line[len(prefix):]), " "), true
+		}
+	}
+	return "", false
+}
+
+func trimBlank(lines []string) []string {
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
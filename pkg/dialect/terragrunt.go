@@ -0,0 +1,125 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package dialect
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
+)
+
+// TerragruntFile is the name of the Terragrunt configuration file.
+const TerragruntFile = "terragrunt.hcl"
+
+// extraBlankLines matches the runs of blank lines left behind when blocks are
+// removed from a file.
+var extraBlankLines = regexp.MustCompile(`\n{3,}`)
+
+// renderTerragrunt splits the configuration into the resources, kept in
+// tfName, and a terragrunt.hcl that generates the terraform and provider
+// blocks and passes variable defaults as inputs. Generating the provider lets
+// a parent terragrunt.hcl override it for every migrated unit at once.
+func renderTerragrunt(cfg *Config, tfName string) ([]File, error) {
+	wf, diags := hclwrite.ParseConfig(cfg.source, tfName, hcl.InitialPos)
+	if diags.HasErrors() {
+		return nil, fmt.Errorf("parse %s: %s", tfName, diags.Error())
+	}
+
+	var provider [][]byte
+	for _, b := range wf.Body().Blocks() {
+		if b.Type() == "terraform" || b.Type() == "provider" {
+			provider = append(provider, bytes.TrimSpace(hclwrite.Format(b.BuildTokens(nil).Bytes())))
+			wf.Body().RemoveBlock(b)
+		}
+	}
+	providerConfig := string(bytes.Join(provider, []byte("\n\n"))) + "\n"
+	if len(provider) == 0 {
+		pf := hclgen.NewFile()
+		hclgen.AppendProviderConfig(pf.Body(), "", "API key from HYPERPING_API_KEY environment variable")
+		providerConfig = pf.String()
+	}
+
+	resources := extraBlankLines.ReplaceAll(hclwrite.Format(wf.Bytes()), []byte("\n\n"))
+
+	f := hclgen.NewFile()
+	root := f.Body()
+	root.Comment("Terragrunt configuration for the resources in %s.", tfName)
+	root.Comment("The provider configuration is generated so a parent terragrunt.hcl can override it,")
+	root.Comment("and inputs set the variables declared in %s.", tfName)
+	root.Newline()
+
+	gen := root.Block("generate", "provider")
+	gen.SetString("path", "provider.tf")
+	gen.SetString("if_exists", "overwrite_terragrunt")
+	gen.SetHeredoc("contents", providerConfig)
+	root.Newline()
+
+	root.SetNestedObject("inputs", func(inputs *hclgen.Body) {
+		for _, b := range cfg.Blocks {
+			if b.Type != "variable" || len(b.Labels) != 1 {
+				continue
+			}
+			name := b.Labels[0]
+			def, ok := b.Attr("default")
+			if !ok {
+				inputs.Comment("%s is required", name)
+				continue
+			}
+			value, ok := ctyValue(def)
+			if !ok {
+				inputs.Comment("%s: set a value (the default could not be copied)", name)
+				continue
+			}
+			inputs.SetValue(name, value)
+		}
+	})
+
+	return []File{
+		{Name: tfName, Content: bytes.TrimLeft(resources, "\n")},
+		{Name: TerragruntFile, Content: f.Bytes()},
+	}, nil
+}
+
+// ctyValue converts a literal model value back to cty. It returns false for
+// values containing references or type constraints.
+func ctyValue(v Value) (cty.Value, bool) {
+	switch {
+	case v.Ref != "" || v.Type != "":
+		return cty.NilVal, false
+	case v.IsList:
+		if len(v.List) == 0 {
+			return cty.EmptyTupleVal, true
+		}
+		items := make([]cty.Value, len(v.List))
+		for i, item := range v.List {
+			value, ok := ctyValue(item)
+			if !ok {
+				return cty.NilVal, false
+			}
+			items[i] = value
+		}
+		return cty.TupleVal(items), true
+	case v.IsObject:
+		if len(v.Object) == 0 {
+			return cty.EmptyObjectVal, true
+		}
+		attrs := make(map[string]cty.Value, len(v.Object))
+		for _, a := range v.Object {
+			value, ok := ctyValue(a.Value)
+			if !ok {
+				return cty.NilVal, false
+			}
+			attrs[a.Name] = value
+		}
+		return cty.ObjectVal(attrs), true
+	default:
+		return v.Literal, v.Literal != cty.NilVal
+	}
+}
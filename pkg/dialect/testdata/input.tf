@@ -0,0 +1,82 @@
+# Auto-generated from Better Stack migration
+# Review and customize before applying
+
+terraform {
+  required_version = ">= 1.8"
+
+  required_providers {
+    hyperping = {
+      source  = "develeap/hyperping"
+      version = "~> 1.0"
+    }
+  }
+}
+
+provider "hyperping" {
+  # API key from HYPERPING_API_KEY environment variable
+}
+
+variable "escalation_policy" {
+  description = "Escalation policy UUID for migrated monitors"
+  type        = string
+  default     = "ep_123"
+}
+
+variable "regions" {
+  type    = list(string)
+  default = ["london", "virginia"]
+}
+
+variable "notify_email" {
+  type = string
+}
+
+# Original Better Stack Monitor ID: 123456
+# MIGRATION NOTES:
+# - Frequency rounded from 240s to 300s
+resource "hyperping_monitor" "api_health" {
+  name              = "API Health"
+  url               = "https://api.example.com/health"
+  http_method       = "POST"
+  check_frequency   = 300
+  regions           = var.regions
+  escalation_policy = var.escalation_policy
+
+  request_headers = [
+    {
+      name  = "Authorization"
+      value = "Bearer $${TOKEN}"
+    },
+  ]
+
+  request_body = chomp(<<EOT
+{
+  "ping": true
+}
+EOT
+  )
+}
+
+resource "hyperping_healthcheck" "nightly_backup" {
+  name               = "Nightly Backup"
+  cron               = "0 0 * * *"
+  timezone           = "UTC"
+  grace_period_value = 1
+  grace_period_type  = "hours"
+}
+
+resource "hyperping_statuspage" "main" {
+  name = "Status"
+
+  settings = {
+    name      = "Status"
+    languages = ["en"]
+  }
+
+  sections = [{
+    name = { en = "API" }
+    services = [{
+      monitor_uuid = hyperping_monitor.api_health.id
+    }]
+  }]
+}
@@ -0,0 +1,417 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// Package hclgen builds Terraform configuration on top of hclwrite so that
+// generated files are always syntactically valid and canonically formatted.
+//
+// String values are emitted through cty, which escapes quotes, control
+// characters, and template sequences (${...} and %{...}). Untrusted data
+// (monitor names, URLs, header values) can therefore never be evaluated by
+// Terraform. Multi-line JSON values, such as pretty-printed request bodies,
+// are written as heredocs with template sequences escaped the same way.
+// Comments are forced onto a single line so embedded newlines cannot break
+// out of the comment into live configuration.
+package hclgen
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/hashicorp/hcl/v2/hclwrite"
+	"github.com/zclconf/go-cty/cty"
+)
+
+// File is a Terraform configuration file under construction.
+type File struct {
+	file *hclwrite.File
+}
+
+// NewFile creates an empty configuration file.
+func NewFile() *File {
+	return &File{file: hclwrite.NewEmptyFile()}
+}
+
+// Body returns the top-level body of the file.
+func (f *File) Body() *Body {
+	return &Body{body: f.file.Body()}
+}
+
+// Bytes returns the file formatted as terraform fmt would.
+func (f *File) Bytes() []byte {
+	return hclwrite.Format(f.file.Bytes())
+}
+
+// String returns the formatted file contents.
+func (f *File) String() string {
+	return string(f.Bytes())
+}
+
+// Body is the body of a file or block. Attributes, blocks, and comments are
+// emitted in the order they are added.
+type Body struct {
+	body *hclwrite.Body
+}
+
+// Attr is a string-valued attribute of an object expression.
+type Attr struct {
+	Name  string
+	Value string
+}
+
+// Comment appends a "# ..." line comment. Line breaks in the formatted text
+// are replaced with spaces.
+func (b *Body) Comment(format string, args ...any) {
+	text := fmt.Sprintf(format, args...)
+	if text == "" {
+		b.appendRaw("#\n")
+		return
+	}
+	b.appendRaw("# " + singleLine(text) + "\n")
+}
+
+// Newline appends a blank line.
+func (b *Body) Newline() {
+	b.body.AppendNewline()
+}
+
+// Block appends a nested block and returns its body. Labels are quoted.
+func (b *Body) Block(typeName string, labels ...string) *Body {
+	return &Body{body: b.body.AppendNewBlock(typeName, labels).Body()}
+}
+
+// SetString sets a quoted string attribute. Multi-line JSON documents are
+// written as a heredoc instead when they can be represented exactly (see
+// heredocTokens), so request bodies stay readable in generated files.
+func (b *Body) SetString(name, value string) {
+	if strings.Contains(value, "\n") && json.Valid([]byte(value)) {
+		if tokens := heredocTokens(value); tokens != nil {
+			b.body.SetAttributeRaw(name, tokens)
+			return
+		}
+	}
+	b.body.SetAttributeValue(name, cty.StringVal(value))
+}
+
+// SetInt sets a number attribute.
+func (b *Body) SetInt(name string, value int) {
+	b.body.SetAttributeValue(name, cty.NumberIntVal(int64(value)))
+}
+
+// SetBool sets a bool attribute.
+func (b *Body) SetBool(name string, value bool) {
+	b.body.SetAttributeValue(name, cty.BoolVal(value))
+}
+
+// SetValue sets an attribute to an arbitrary literal value, such as a
+// variable default carried over from another configuration.
+func (b *Body) SetValue(name string, value cty.Value) {
+	b.body.SetAttributeValue(name, value)
+}
+
+// SetHeredoc sets a string attribute written as a heredoc, for embedded
+// documents such as the contents of a Terragrunt generate block. Values that
+// cannot be represented exactly as a heredoc (see heredocTokens) are quoted.
+func (b *Body) SetHeredoc(name, value string) {
+	if tokens := heredocTokens(value); tokens != nil {
+		b.body.SetAttributeRaw(name, tokens)
+		return
+	}
+	b.body.SetAttributeValue(name, cty.StringVal(value))
+}
+
+// SetType sets a variable type constraint such as string or list(string).
+// Only primitive types and lists, sets, and maps of them are supported.
+func (b *Body) SetType(name string, ty cty.Type) {
+	b.body.SetAttributeRaw(name, typeTokens(ty))
+}
+
+// SetStringList sets a single-line list of strings.
+func (b *Body) SetStringList(name string, values []string) {
+	if len(values) == 0 {
+		b.body.SetAttributeValue(name, cty.ListValEmpty(cty.String))
+		return
+	}
+	items := make([]cty.Value, len(values))
+	for i, v := range values {
+		items[i] = cty.StringVal(v)
+	}
+	b.body.SetAttributeValue(name, cty.ListVal(items))
+}
+
+// SetObject sets an object attribute with the attributes in the given order.
+func (b *Body) SetObject(name string, attrs ...Attr) {
+	b.body.SetAttributeRaw(name, objectTokens(attrs))
+}
+
+// SetNestedObject sets an object attribute whose contents are written by fn,
+// for nested attributes such as "settings = { ... }". fn may set attributes
+// and comments but must not add blocks, which are not valid inside an object.
+func (b *Body) SetNestedObject(name string, fn func(*Body)) {
+	b.body.SetAttributeRaw(name, nestedObjectTokens(fn))
+}
+
+// SetNestedObjectList sets a list of n objects, each written by calling fn
+// with its index, for nested list attributes such as "sections = [{ ... }]".
+// The same restrictions as SetNestedObject apply to fn. Objects may nest
+// further lists, so a tree of groups can be written recursively.
+func (b *Body) SetNestedObjectList(name string, n int, fn func(i int, obj *Body)) {
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")},
+		{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+	}
+	for i := 0; i < n; i++ {
+		tokens = append(tokens, nestedObjectTokens(func(obj *Body) { fn(i, obj) })...)
+		tokens = append(tokens,
+			&hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")},
+			&hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+		)
+	}
+	tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+	b.body.SetAttributeRaw(name, tokens)
+}
+
+// SetObjectList sets a list of objects, one object per line group:
+//
+//	name = [
+//	  {
+//	    key = "value"
+//	  },
+//	]
+func (b *Body) SetObjectList(name string, objects [][]Attr) {
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")},
+		{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+	}
+	for _, obj := range objects {
+		tokens = append(tokens, objectTokens(obj)...)
+		tokens = append(tokens,
+			&hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")},
+			&hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+		)
+	}
+	tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+	b.body.SetAttributeRaw(name, tokens)
+}
+
+// SetReference sets an attribute to an unquoted reference such as
+// "var.escalation_policy" or "hyperping_healthcheck.api.ping_url". It
+// returns an error if any part of ref is not a valid identifier, which would
+// otherwise produce configuration that does not parse.
+func (b *Body) SetReference(name, ref string) error {
+	traversal, err := referenceTraversal(ref)
+	if err != nil {
+		return err
+	}
+	b.body.SetAttributeTraversal(name, traversal)
+	return nil
+}
+
+// SetEquals sets a condition comparing a reference with a literal value, for
+// the assert blocks of Terraform test files:
+//
+//	condition = hyperping_monitor.example.name == "Checkout API"
+//
+// ref is validated as in SetReference.
+func (b *Body) SetEquals(name, ref string, value cty.Value) error {
+	traversal, err := referenceTraversal(ref)
+	if err != nil {
+		return err
+	}
+	tokens := hclwrite.TokensForTraversal(traversal)
+	tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenEqualOp, Bytes: []byte("==")})
+	tokens = append(tokens, hclwrite.TokensForValue(value)...)
+	b.body.SetAttributeRaw(name, tokens)
+	return nil
+}
+
+func referenceTraversal(ref string) (hcl.Traversal, error) {
+	parts := strings.Split(ref, ".")
+	traversal := make(hcl.Traversal, 0, len(parts))
+	for i, part := range parts {
+		if !hclsyntax.ValidIdentifier(part) {
+			return nil, fmt.Errorf("invalid reference %q: %q is not a valid identifier", ref, part)
+		}
+		if i == 0 {
+			traversal = append(traversal, hcl.TraverseRoot{Name: part})
+		} else {
+			traversal = append(traversal, hcl.TraverseAttr{Name: part})
+		}
+	}
+	return traversal, nil
+}
+
+// SetTraversalList sets a list of attribute paths, one per line, for
+// meta-arguments such as lifecycle ignore_changes:
+//
+//	ignore_changes = [
+//	  settings.name,
+//	  sections[0].services[1].show_response_times,
+//	]
+//
+// It returns an error if a path is not a valid traversal.
+func (b *Body) SetTraversalList(name string, paths []string) error {
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOBrack, Bytes: []byte("[")},
+		{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+	}
+	for _, p := range paths {
+		traversal, diags := hclsyntax.ParseTraversalAbs([]byte(p), "", hcl.InitialPos)
+		if diags.HasErrors() {
+			return fmt.Errorf("invalid attribute path %q: %s", p, diags.Error())
+		}
+		tokens = append(tokens, hclwrite.TokensForTraversal(traversal)...)
+		tokens = append(tokens,
+			&hclwrite.Token{Type: hclsyntax.TokenComma, Bytes: []byte(",")},
+			&hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+		)
+	}
+	tokens = append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrack, Bytes: []byte("]")})
+	b.body.SetAttributeRaw(name, tokens)
+	return nil
+}
+
+// Has reports whether an attribute has been set in the body.
+func (b *Body) Has(name string) bool {
+	return b.body.GetAttribute(name) != nil
+}
+
+func typeTokens(ty cty.Type) hclwrite.Tokens {
+	switch {
+	case ty.IsListType():
+		return hclwrite.TokensForFunctionCall("list", typeTokens(ty.ElementType()))
+	case ty.IsSetType():
+		return hclwrite.TokensForFunctionCall("set", typeTokens(ty.ElementType()))
+	case ty.IsMapType():
+		return hclwrite.TokensForFunctionCall("map", typeTokens(ty.ElementType()))
+	case ty == cty.DynamicPseudoType:
+		return hclwrite.TokensForIdentifier("any")
+	default:
+		return hclwrite.TokensForIdentifier(ty.FriendlyNameForConstraint())
+	}
+}
+
+func (b *Body) appendRaw(s string) {
+	b.body.AppendUnstructuredTokens(hclwrite.Tokens{
+		{Type: hclsyntax.TokenComment, Bytes: []byte(s)},
+	})
+}
+
+func nestedObjectTokens(fn func(*Body)) hclwrite.Tokens {
+	inner := hclwrite.NewEmptyFile().Body()
+	fn(&Body{body: inner})
+
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOBrace, Bytes: []byte("{")},
+		{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+	}
+	tokens = append(tokens, inner.BuildTokens(nil)...)
+	return append(tokens, &hclwrite.Token{Type: hclsyntax.TokenCBrace, Bytes: []byte("}")})
+}
+
+func objectTokens(attrs []Attr) hclwrite.Tokens {
+	items := make([]hclwrite.ObjectAttrTokens, len(attrs))
+	for i, a := range attrs {
+		items[i] = hclwrite.ObjectAttrTokens{
+			Name:  hclwrite.TokensForIdentifier(a.Name),
+			Value: hclwrite.TokensForValue(cty.StringVal(a.Value)),
+		}
+	}
+	return hclwrite.TokensForObject(items)
+}
+
+// heredocDelimiters are tried in order; the first that does not appear as a
+// line of the value is used.
+var heredocDelimiters = []string{"EOT", "EOF", "END", "JSON", "BODY"}
+
+// heredocTokens returns value as a heredoc expression, or nil when value is
+// a single line or cannot be written as a heredoc: heredoc content is taken
+// literally, so carriage returns and other control characters other than tab
+// would not round-trip.
+//
+// A heredoc always ends in a newline. A value without a trailing newline is
+// wrapped in chomp() so that Terraform sees exactly the original string.
+func heredocTokens(value string) hclwrite.Tokens {
+	// cty normalizes strings to NFC; match what SetAttributeValue would store.
+	value = cty.StringVal(value).AsString()
+	if !strings.Contains(value, "\n") {
+		return nil
+	}
+	for _, r := range value {
+		if r < 0x20 && r != '\n' && r != '\t' || r == 0x7f {
+			return nil
+		}
+	}
+
+	content := value
+	chomp := !strings.HasSuffix(content, "\n")
+	if chomp {
+		content += "\n"
+	}
+	lines := strings.Split(strings.TrimSuffix(content, "\n"), "\n")
+
+	delimiter := ""
+	for _, candidate := range heredocDelimiters {
+		used := false
+		for _, line := range lines {
+			if strings.TrimSpace(line) == candidate {
+				used = true
+				break
+			}
+		}
+		if !used {
+			delimiter = candidate
+			break
+		}
+	}
+	if delimiter == "" {
+		return nil
+	}
+
+	escaped := strings.NewReplacer("${", "$${", "%{", "%%{").Replace(content)
+	heredoc := hclwrite.Tokens{
+		{Type: hclsyntax.TokenOHeredoc, Bytes: []byte("<<" + delimiter + "\n")},
+		{Type: hclsyntax.TokenStringLit, Bytes: []byte(escaped)},
+		{Type: hclsyntax.TokenCHeredoc, Bytes: []byte(delimiter)},
+	}
+	if !chomp {
+		return heredoc
+	}
+
+	tokens := hclwrite.Tokens{
+		{Type: hclsyntax.TokenIdent, Bytes: []byte("chomp")},
+		{Type: hclsyntax.TokenOParen, Bytes: []byte("(")},
+	}
+	tokens = append(tokens, heredoc...)
+	return append(tokens,
+		&hclwrite.Token{Type: hclsyntax.TokenNewline, Bytes: []byte("\n")},
+		&hclwrite.Token{Type: hclsyntax.TokenCParen, Bytes: []byte(")")},
+	)
+}
+
+// singleLine collapses line breaks so text stays inside a line comment.
+func singleLine(s string) string {
+	return strings.NewReplacer("\r\n", " ", "\n", " ", "\r", " ").Replace(s)
+}
+
+// AppendProviderConfig appends the terraform block pinning the Hyperping
+// provider and an empty provider block. requiredVersion is omitted when empty.
+func AppendProviderConfig(b *Body, requiredVersion, providerComment string) {
+	tf := b.Block("terraform")
+	if requiredVersion != "" {
+		tf.SetString("required_version", requiredVersion)
+		tf.Newline()
+	}
+	tf.Block("required_providers").SetObject("hyperping",
+		Attr{Name: "source", Value: "develeap/hyperping"},
+		Attr{Name: "version", Value: "~> 1.0"},
+	)
+	b.Newline()
+
+	provider := b.Block("provider", "hyperping")
+	if providerComment != "" {
+		provider.Comment("%s", providerComment)
+	}
+}
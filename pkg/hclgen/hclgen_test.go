@@ -0,0 +1,396 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package hclgen
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"unicode/utf8"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/zclconf/go-cty/cty"
+	"github.com/zclconf/go-cty/cty/function"
+	"github.com/zclconf/go-cty/cty/function/stdlib"
+)
+
+var updateGolden = flag.Bool("update-golden", false, "rewrite testdata/*.golden files with current output")
+
+// goldenAssert compares got to the contents of testdata/<name>. With
+// -update-golden, the file is rewritten instead.
+func goldenAssert(t *testing.T, name, got string) {
+	t.Helper()
+	path := filepath.Join("testdata", name)
+	if *updateGolden {
+		if err := os.MkdirAll("testdata", 0o755); err != nil {
+			t.Fatalf("mkdir testdata: %v", err)
+		}
+		if err := os.WriteFile(path, []byte(got), 0o644); err != nil { //nolint:gosec // testdata only
+			t.Fatalf("write %s: %v", path, err)
+		}
+		return
+	}
+	want, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("read %s: %v (run: go test ./pkg/hclgen -update-golden)", path, err)
+	}
+	if got != string(want) {
+		t.Errorf("output does not match %s\nrun -update-golden after intentional changes\n--- got ---\n%s\n--- want ---\n%s", name, got, string(want))
+	}
+}
+
+// parseHCL fails the test if src is not valid HCL.
+func parseHCL(t *testing.T, src string) *hclsyntax.Body {
+	t.Helper()
+	f, diags := hclsyntax.ParseConfig([]byte(src), "test.tf", hcl.InitialPos)
+	if diags.HasErrors() {
+		t.Fatalf("generated HCL does not parse: %s\n%s", diags.Error(), src)
+	}
+	return f.Body.(*hclsyntax.Body)
+}
+
+// evalContext provides the functions generated configuration may call.
+var evalContext = &hcl.EvalContext{
+	Functions: map[string]function.Function{"chomp": stdlib.ChompFunc},
+}
+
+// evalString evaluates a generated string attribute. It fails the test if the
+// expression references any variable, which would mean untrusted data was
+// emitted as a live template interpolation.
+func evalString(t *testing.T, attr *hclsyntax.Attribute) string {
+	t.Helper()
+	if vars := attr.Expr.Variables(); len(vars) > 0 {
+		t.Fatalf("attribute %s references variables: %v", attr.Name, vars)
+	}
+	val, diags := attr.Expr.Value(evalContext)
+	if diags.HasErrors() {
+		t.Fatalf("evaluating %s: %s", attr.Name, diags.Error())
+	}
+	return val.AsString()
+}
+
+func TestFile_Golden(t *testing.T) {
+	f := NewFile()
+	root := f.Body()
+	root.Comment("Generated for tests")
+	root.Newline()
+	AppendProviderConfig(root, ">= 1.8", "API key from HYPERPING_API_KEY environment variable")
+	root.Newline()
+
+	m := root.Block("resource", "hyperping_monitor", "api")
+	m.SetString("name", "API")
+	m.SetString("url", "https://api.example.com/health")
+	m.SetInt("check_frequency", 60)
+	m.SetBool("follow_redirects", false)
+	m.SetStringList("regions", []string{"london", "virginia"})
+	m.SetString("request_body", "{\n  \"query\": \"${var}\"\n}")
+	m.Newline()
+	m.SetObjectList("request_headers", [][]Attr{
+		{{Name: "name", Value: "Authorization"}, {Name: "value", Value: "Bearer x"}},
+		{{Name: "name", Value: "Accept"}, {Name: "value", Value: "application/json"}},
+	})
+	m.Newline()
+	m.SetNestedObject("settings", func(settings *Body) {
+		settings.SetString("theme", "dark")
+		settings.SetStringList("languages", []string{"en", "fr"})
+		settings.Comment("accent_color = \"#36b27e\"")
+	})
+	m.Newline()
+	m.SetNestedObjectList("sections", 1, func(_ int, section *Body) {
+		section.SetObject("name", Attr{Name: "en", Value: "Platform"})
+		section.SetNestedObjectList("services", 2, func(i int, service *Body) {
+			if i == 0 {
+				service.SetString("uuid", "mon_abc")
+				return
+			}
+			service.SetBool("is_group", true)
+			service.SetNestedObjectList("services", 1, func(_ int, child *Body) {
+				child.SetString("uuid", "mon_def")
+			})
+		})
+	})
+	m.Newline()
+	m.Comment("escalation_policy = var.escalation_policy")
+	root.Newline()
+
+	out := root.Block("output", "api_url")
+	if err := out.SetReference("value", "hyperping_monitor.api.url"); err != nil {
+		t.Fatal(err)
+	}
+
+	got := f.String()
+	parseHCL(t, got)
+	goldenAssert(t, "file.tf.golden", got)
+}
+
+func TestSetString_NeutralizesTemplates(t *testing.T) {
+	f := NewFile()
+	r := f.Body().Block("resource", "hyperping_monitor", "x")
+	r.SetString("name", `${file("/etc/passwd")} %{ if true }x%{ endif } "quoted" \ `+"\nnext")
+
+	got := f.String()
+	body := parseHCL(t, got)
+
+	got = evalString(t, body.Blocks[0].Body.Attributes["name"])
+	want := `${file("/etc/passwd")} %{ if true }x%{ endif } "quoted" \ ` + "\nnext"
+	if got != want {
+		t.Errorf("round-trip value = %q, want %q", got, want)
+	}
+}
+
+func TestSetString_JSONHeredoc(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		wantPrefix string // start of the emitted expression, after "v = "
+	}{
+		{"multi-line JSON", "{\n  \"a\": \"${x}\"\n}", "chomp(<<EOT\n{\n  \"a\": \"$${x}\""},
+		{"multi-line JSON with trailing newline", "[\n  1\n]\n", "<<EOT\n"},
+		{"single-line JSON stays quoted", `{"a": 1}`, `"{`},
+		{"multi-line text stays quoted", "line one\nline two", `"line one\nline two"`},
+		{"multi-line JSON with CRLF stays quoted", "{\r\n  \"a\": 1\r\n}", `"{\r\n`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := NewFile()
+			f.Body().SetString("v", tt.value)
+			src := f.String()
+
+			if !strings.HasPrefix(src, "v = "+tt.wantPrefix) {
+				t.Errorf("unexpected encoding:\n%s", src)
+			}
+			if got := evalString(t, parseHCL(t, src).Attributes["v"]); got != tt.value {
+				t.Errorf("round-trip value = %q, want %q\n%s", got, tt.value, src)
+			}
+		})
+	}
+}
+
+func TestHeredocTokens(t *testing.T) {
+	tests := []struct {
+		name       string
+		value      string
+		wantPrefix string // "" when no heredoc is possible
+	}{
+		{"single line", "plain", ""},
+		{"trailing newline", "a\nb\n", "<<EOT\n"},
+		{"no trailing newline is chomped", "a\nb", "chomp(<<EOT\n"},
+		{"multiple trailing newlines", "a\nb\n\n", "<<EOT\n"},
+		{"only a newline", "\n", "<<EOT\n"},
+		{"delimiter in content", "a\n  EOT\nb", "chomp(<<EOF\n"},
+		{"every delimiter in content", strings.Join(heredocDelimiters, "\n"), ""},
+		{"tabs are kept literally", "a\n\tb\\t\n", "<<EOT\n"},
+		{"templates are escaped", "${file(\"x\")}\n%{ if true }y%{ endif }\n", "<<EOT\n$${file"},
+		{"carriage return", "a\r\nb", ""},
+		{"control character", "a\x00\nb", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tokens := heredocTokens(tt.value)
+			if tt.wantPrefix == "" {
+				if tokens != nil {
+					t.Errorf("expected no heredoc, got %s", tokens.Bytes())
+				}
+				return
+			}
+			if tokens == nil {
+				t.Fatal("expected heredoc, got nil")
+			}
+
+			f := NewFile()
+			f.Body().body.SetAttributeRaw("v", tokens)
+			src := f.String()
+			if !strings.HasPrefix(src, "v = "+tt.wantPrefix) {
+				t.Errorf("unexpected encoding:\n%s", src)
+			}
+			if got := evalString(t, parseHCL(t, src).Attributes["v"]); got != tt.value {
+				t.Errorf("round-trip value = %q, want %q\n%s", got, tt.value, src)
+			}
+		})
+	}
+}
+
+// FuzzSetString checks that any string round-trips through generated HCL
+// unchanged and is never evaluated as a template, whether it is emitted as
+// a quoted string or a heredoc.
+func FuzzSetString(f *testing.F) {
+	for _, seed := range []string{
+		"",
+		"plain",
+		`${file("/etc/passwd")}`,
+		"%{ for x in y }${x}%{ endfor }",
+		"$${already escaped}",
+		"{\n  \"query\": \"${var.x}\"\n}",
+		"line\nEOT\nEOF\n",
+		"tab\there\n",
+		"crlf\r\nline",
+		"\\\"quote\"\\",
+		"unicode ✓ \u00e9\n",
+		"trailing\n\n\n",
+		"$\n{",
+		"%\n{\n",
+	} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, value string) {
+		if !utf8.ValidString(value) {
+			t.Skip("cty strings must be valid UTF-8")
+		}
+		// cty normalizes strings to NFC.
+		want := cty.StringVal(value).AsString()
+
+		file := NewFile()
+		r := file.Body().Block("resource", "hyperping_monitor", "x")
+		r.SetString("v", value)
+		src := file.String()
+
+		parsed, diags := hclsyntax.ParseConfig([]byte(src), "fuzz.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatalf("generated HCL does not parse: %s\n%s", diags.Error(), src)
+		}
+		body := parsed.Body.(*hclsyntax.Body)
+		if len(body.Attributes) != 0 || len(body.Blocks) != 1 {
+			t.Fatalf("value escaped its attribute:\n%s", src)
+		}
+		attrs := body.Blocks[0].Body.Attributes
+		if len(attrs) != 1 || len(body.Blocks[0].Body.Blocks) != 0 {
+			t.Fatalf("value escaped its attribute:\n%s", src)
+		}
+		if got := evalString(t, attrs["v"]); got != want {
+			t.Fatalf("round-trip value = %q, want %q\n%s", got, want, src)
+		}
+
+		// Exercise the heredoc encoding for every value, not only JSON.
+		tokens := heredocTokens(value)
+		if tokens == nil {
+			return
+		}
+		file = NewFile()
+		file.Body().body.SetAttributeRaw("v", tokens)
+		src = file.String()
+		parsed, diags = hclsyntax.ParseConfig([]byte(src), "fuzz.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatalf("generated heredoc does not parse: %s\n%s", diags.Error(), src)
+		}
+		body = parsed.Body.(*hclsyntax.Body)
+		if len(body.Attributes) != 1 || len(body.Blocks) != 0 {
+			t.Fatalf("heredoc value escaped its attribute:\n%s", src)
+		}
+		if got := evalString(t, body.Attributes["v"]); got != want {
+			t.Fatalf("heredoc round-trip value = %q, want %q\n%s", got, want, src)
+		}
+	})
+}
+
+// FuzzComment checks that comment text can never produce configuration.
+func FuzzComment(f *testing.F) {
+	for _, seed := range []string{"", "note", "x\nresource \"a\" \"b\" {}", "a\r\nb = 1", "*/ b = 1 /*"} {
+		f.Add(seed)
+	}
+
+	f.Fuzz(func(t *testing.T, text string) {
+		file := NewFile()
+		file.Body().Comment("%s", text)
+		src := file.String()
+
+		parsed, diags := hclsyntax.ParseConfig([]byte(src), "fuzz.tf", hcl.InitialPos)
+		if diags.HasErrors() {
+			t.Fatalf("generated HCL does not parse: %s\n%q", diags.Error(), src)
+		}
+		body := parsed.Body.(*hclsyntax.Body)
+		if len(body.Attributes) != 0 || len(body.Blocks) != 0 {
+			t.Fatalf("comment text escaped into configuration:\n%s", src)
+		}
+	})
+}
+
+func TestComment_SingleLine(t *testing.T) {
+	f := NewFile()
+	f.Body().Comment("name: %s", "evil\nresource \"x\" \"y\" {}\r\n")
+
+	got := f.String()
+	body := parseHCL(t, got)
+	if len(body.Blocks) != 0 {
+		t.Errorf("comment text escaped into configuration:\n%s", got)
+	}
+	if strings.Count(got, "\n") != 1 {
+		t.Errorf("expected a single comment line, got %q", got)
+	}
+}
+
+func TestSetReference_Invalid(t *testing.T) {
+	b := NewFile().Body()
+	if err := b.SetReference("value", "hyperping_monitor.bad name.id"); err == nil {
+		t.Error("expected error for invalid identifier")
+	}
+	if err := b.SetReference("type", "string"); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestSetEquals(t *testing.T) {
+	f := NewFile()
+	b := f.Body()
+	if err := b.SetEquals("condition", "hyperping_monitor.example.name", cty.StringVal("API ${x}")); err != nil {
+		t.Fatal(err)
+	}
+	if got, want := f.String(), "condition = hyperping_monitor.example.name == \"API $${x}\"\n"; got != want {
+		t.Errorf("got %q, want %q", got, want)
+	}
+	if err := b.SetEquals("condition", "hyperping_monitor.bad name.id", cty.True); err == nil {
+		t.Error("expected error for invalid identifier")
+	}
+}
+
+func TestSetStringList_Empty(t *testing.T) {
+	f := NewFile()
+	f.Body().SetStringList("regions", nil)
+	if got := f.String(); got != "regions = []\n" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestSetType(t *testing.T) {
+	tests := []struct {
+		ty   cty.Type
+		want string
+	}{
+		{cty.String, "type = string\n"},
+		{cty.Number, "type = number\n"},
+		{cty.List(cty.String), "type = list(string)\n"},
+		{cty.Map(cty.List(cty.Number)), "type = map(list(number))\n"},
+	}
+	for _, tt := range tests {
+		f := NewFile()
+		f.Body().SetType("type", tt.ty)
+		if got := f.String(); got != tt.want {
+			t.Errorf("SetType(%s) = %q, want %q", tt.ty.FriendlyName(), got, tt.want)
+		}
+	}
+}
+
+func TestSetTraversalList(t *testing.T) {
+	f := NewFile()
+	lifecycle := f.Body().Block("lifecycle")
+	if err := lifecycle.SetTraversalList("ignore_changes", []string{"settings.name", "sections[0].services[1].show_response_times"}); err != nil {
+		t.Fatal(err)
+	}
+	want := "lifecycle {\n  ignore_changes = [\n    settings.name,\n    sections[0].services[1].show_response_times,\n  ]\n}\n"
+	if got := f.String(); got != want {
+		t.Errorf("got:\n%s\nwant:\n%s", got, want)
+	}
+	if !lifecycle.Has("ignore_changes") || lifecycle.Has("create_before_destroy") {
+		t.Error("Has does not reflect the attributes set")
+	}
+
+	if err := lifecycle.SetTraversalList("ignore_changes", []string{"settings.bad name"}); err == nil {
+		t.Error("expected error for invalid path")
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// Package mapping records which source-platform resource each migrated
+// Hyperping resource came from, so audits can trace a monitor back to its
+// origin long after the migration ran.
+//
+// A migration run writes the mapping; --verify fills in the Hyperping UUIDs
+// of resources the tool did not create itself, and --rollback uses it to find
+// the Terraform addresses of deleted resources and marks them rolled back.
+package mapping
+
+import (
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DefaultFileName is the name of the mapping file written with the other
+// migration outputs.
+const DefaultFileName = "mapping.json"
+
+// Terraform resource types recorded in Entry.ResourceType.
+const (
+	ResourceTypeMonitor     = "hyperping_monitor"
+	ResourceTypeHealthcheck = "hyperping_healthcheck"
+)
+
+// Entry links one source resource to the Hyperping resource it became.
+type Entry struct {
+	SourceID     string `json:"source_id"`
+	ResourceType string `json:"resource_type"`
+	Name         string `json:"name"`    // name of the Hyperping resource
+	Address      string `json:"address"` // Terraform address in the generated configuration
+	// UUID is empty until the resource exists in Hyperping: tools that only
+	// generate configuration leave it to --verify to fill in.
+	UUID         string     `json:"uuid,omitempty"`
+	RolledBackAt *time.Time `json:"rolled_back_at,omitempty"`
+}
+
+// Mapping is the mapping file of one migration run.
+type Mapping struct {
+	Source      string    `json:"source"` // source platform, e.g. "Pingdom"
+	Tool        string    `json:"tool"`
+	MigrationID string    `json:"migration_id,omitempty"`
+	GeneratedAt time.Time `json:"generated_at"`
+	Resources   []Entry   `json:"resources"`
+}
+
+// New returns an empty mapping for a run of tool.
+func New(source, tool, migrationID string) *Mapping {
+	return &Mapping{
+		Source:      source,
+		Tool:        tool,
+		MigrationID: migrationID,
+		GeneratedAt: time.Now().UTC(),
+		Resources:   []Entry{},
+	}
+}
+
+// RegisterFlag registers --mapping on fs with the given default path.
+func RegisterFlag(fs *flag.FlagSet, defaultPath string) *string {
+	return fs.String("mapping", defaultPath,
+		"Mapping file linking source IDs to Hyperping UUIDs and Terraform addresses: written by a migration run, read by --verify and --rollback")
+}
+
+// Add appends an entry.
+func (m *Mapping) Add(e Entry) {
+	m.Resources = append(m.Resources, e)
+}
+
+// Lookup returns the entry for a source resource of the given type.
+func (m *Mapping) Lookup(resourceType, sourceID string) (Entry, bool) {
+	if m == nil {
+		return Entry{}, false
+	}
+	for _, e := range m.Resources {
+		if e.ResourceType == resourceType && e.SourceID == sourceID {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// LookupUUID returns the entry for a Hyperping resource.
+func (m *Mapping) LookupUUID(uuid string) (Entry, bool) {
+	if m == nil || uuid == "" {
+		return Entry{}, false
+	}
+	for _, e := range m.Resources {
+		if e.UUID == uuid {
+			return e, true
+		}
+	}
+	return Entry{}, false
+}
+
+// SetUUID records the Hyperping UUID of a source resource whose entry has
+// none yet. It reports whether the mapping changed.
+func (m *Mapping) SetUUID(resourceType, sourceID, uuid string) bool {
+	if m == nil || uuid == "" {
+		return false
+	}
+	for i, e := range m.Resources {
+		if e.ResourceType == resourceType && e.SourceID == sourceID && e.UUID == "" {
+			m.Resources[i].UUID = uuid
+			return true
+		}
+	}
+	return false
+}
+
+// MarkRolledBack records that the Hyperping resource was deleted by a
+// rollback at the given time. It reports whether the mapping changed.
+func (m *Mapping) MarkRolledBack(uuid string, at time.Time) bool {
+	if m == nil || uuid == "" {
+		return false
+	}
+	changed := false
+	for i, e := range m.Resources {
+		if e.UUID == uuid && e.RolledBackAt == nil {
+			rolledBackAt := at.UTC()
+			m.Resources[i].RolledBackAt = &rolledBackAt
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Write writes the mapping as indented JSON to path.
+func (m *Mapping) Write(path string) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal mapping: %w", err)
+	}
+	if err := os.WriteFile(filepath.Clean(path), append(data, '\n'), 0o600); err != nil {
+		return fmt.Errorf("failed to write mapping: %w", err)
+	}
+	return nil
+}
+
+// Load reads a mapping file. It returns nil and no error when path is empty
+// or the file does not exist, so runs from before mappings existed still
+// verify and roll back.
+func Load(path string) (*Mapping, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(filepath.Clean(path))
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read mapping %s: %w", path, err)
+	}
+	var m Mapping
+	if err := json.Unmarshal(data, &m); err != nil {
+		return nil, fmt.Errorf("failed to parse mapping %s: %w", path, err)
+	}
+	return &m, nil
+}
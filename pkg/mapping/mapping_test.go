@@ -0,0 +1,105 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package mapping
+
+import (
+	"flag"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func testMapping() *Mapping {
+	m := New("Pingdom", "pingdom", "pingdom-20260213-120000.000")
+	m.Add(Entry{SourceID: "101", ResourceType: ResourceTypeMonitor, Name: "API", Address: "hyperping_monitor.api", UUID: "mon_api"})
+	m.Add(Entry{SourceID: "102", ResourceType: ResourceTypeMonitor, Name: "Web", Address: "hyperping_monitor.web"})
+	m.Add(Entry{SourceID: "101", ResourceType: ResourceTypeHealthcheck, Name: "Cron", Address: "hyperping_healthcheck.cron"})
+	return m
+}
+
+func TestMapping_Lookup(t *testing.T) {
+	m := testMapping()
+
+	entry, ok := m.Lookup(ResourceTypeHealthcheck, "101")
+	require.True(t, ok)
+	assert.Equal(t, "hyperping_healthcheck.cron", entry.Address, "source IDs are scoped by resource type")
+
+	entry, ok = m.LookupUUID("mon_api")
+	require.True(t, ok)
+	assert.Equal(t, "101", entry.SourceID)
+
+	_, ok = m.LookupUUID("")
+	assert.False(t, ok)
+
+	var none *Mapping
+	_, ok = none.Lookup(ResourceTypeMonitor, "101")
+	assert.False(t, ok, "a nil mapping maps nothing")
+}
+
+func TestMapping_SetUUID(t *testing.T) {
+	m := testMapping()
+
+	assert.True(t, m.SetUUID(ResourceTypeMonitor, "102", "mon_web"))
+	assert.False(t, m.SetUUID(ResourceTypeMonitor, "101", "mon_other"), "recorded UUIDs are not overwritten")
+	assert.False(t, m.SetUUID(ResourceTypeMonitor, "999", "mon_x"))
+
+	entry, _ := m.Lookup(ResourceTypeMonitor, "102")
+	assert.Equal(t, "mon_web", entry.UUID)
+}
+
+func TestMapping_MarkRolledBack(t *testing.T) {
+	m := testMapping()
+	at := time.Date(2026, 2, 14, 9, 0, 0, 0, time.UTC)
+
+	assert.True(t, m.MarkRolledBack("mon_api", at))
+	assert.False(t, m.MarkRolledBack("mon_api", at.Add(time.Hour)), "the first rollback time is kept")
+	assert.False(t, m.MarkRolledBack("", at))
+
+	entry, _ := m.LookupUUID("mon_api")
+	require.NotNil(t, entry.RolledBackAt)
+	assert.Equal(t, at, *entry.RolledBackAt)
+}
+
+func TestMapping_WriteLoad(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultFileName)
+	m := testMapping()
+	require.NoError(t, m.Write(path))
+
+	loaded, err := Load(path)
+	require.NoError(t, err)
+	assert.Equal(t, m.Source, loaded.Source)
+	assert.Equal(t, m.MigrationID, loaded.MigrationID)
+	assert.Equal(t, m.Resources, loaded.Resources)
+}
+
+func TestLoad_Missing(t *testing.T) {
+	m, err := Load(filepath.Join(t.TempDir(), DefaultFileName))
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+
+	m, err = Load("")
+	assert.NoError(t, err)
+	assert.Nil(t, m)
+}
+
+func TestLoad_Invalid(t *testing.T) {
+	path := filepath.Join(t.TempDir(), DefaultFileName)
+	require.NoError(t, os.WriteFile(path, []byte("{not json"), 0o600))
+
+	_, err := Load(path)
+	assert.Error(t, err)
+}
+
+func TestRegisterFlag(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	path := RegisterFlag(fs, DefaultFileName)
+	assert.Equal(t, DefaultFileName, *path)
+
+	require.NoError(t, fs.Parse([]string{"--mapping=audit/mapping.json"}))
+	assert.Equal(t, "audit/mapping.json", *path)
+}
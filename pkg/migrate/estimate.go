@@ -0,0 +1,142 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// checksMonthSeconds is the length of the month used for check volume
+// projections: 30 days.
+const checksMonthSeconds = 30 * 24 * 60 * 60
+
+// MonitorLoad is what a migrated monitor contributes to the check volume.
+type MonitorLoad struct {
+	// CheckFrequency is the interval between checks in seconds.
+	CheckFrequency int
+	// Regions is the number of regions the monitor is checked from.
+	Regions int
+	// Paused monitors are counted but run no checks.
+	Paused bool
+}
+
+// Estimate projects the Hyperping usage of a migration, so teams can compare
+// it with their plan limits before creating anything.
+type Estimate struct {
+	Monitors       int `json:"monitors"`
+	PausedMonitors int `json:"paused_monitors"`
+	Healthchecks   int `json:"healthchecks"`
+
+	// Frequencies counts monitors per check frequency, most frequent first.
+	Frequencies []FrequencyCount `json:"check_frequency_distribution"`
+	// RegionCounts counts monitors per number of regions, fewest first.
+	RegionCounts []RegionCount `json:"regions_per_monitor"`
+
+	// MonthlyChecks is the projected number of checks per 30 days across
+	// all active monitors and regions. Healthchecks are pinged by the
+	// monitored jobs and are not included.
+	MonthlyChecks int64 `json:"projected_monthly_checks"`
+}
+
+// FrequencyCount is the number of monitors checked at a frequency.
+type FrequencyCount struct {
+	FrequencySeconds int `json:"frequency_seconds"`
+	Monitors         int `json:"monitors"`
+}
+
+// RegionCount is the number of monitors checked from a number of regions.
+type RegionCount struct {
+	Regions  int `json:"regions"`
+	Monitors int `json:"monitors"`
+}
+
+// NewEstimate builds the usage estimate of the given monitors and number of
+// healthchecks. Monitors without regions are counted as checked from one
+// region, and monitors without a frequency as checked every 60 seconds, the
+// Hyperping default.
+func NewEstimate(monitors []MonitorLoad, healthchecks int) *Estimate {
+	e := &Estimate{Monitors: len(monitors), Healthchecks: healthchecks}
+
+	frequencies := make(map[int]int)
+	regions := make(map[int]int)
+	for _, m := range monitors {
+		frequency := m.CheckFrequency
+		if frequency <= 0 {
+			frequency = 60
+		}
+		regionCount := max(m.Regions, 1)
+		frequencies[frequency]++
+		regions[regionCount]++
+
+		if m.Paused {
+			e.PausedMonitors++
+			continue
+		}
+		e.MonthlyChecks += int64(checksMonthSeconds/frequency) * int64(regionCount)
+	}
+
+	for frequency, n := range frequencies {
+		e.Frequencies = append(e.Frequencies, FrequencyCount{FrequencySeconds: frequency, Monitors: n})
+	}
+	sort.Slice(e.Frequencies, func(i, j int) bool {
+		return e.Frequencies[i].FrequencySeconds < e.Frequencies[j].FrequencySeconds
+	})
+	for regionCount, n := range regions {
+		e.RegionCounts = append(e.RegionCounts, RegionCount{Regions: regionCount, Monitors: n})
+	}
+	sort.Slice(e.RegionCounts, func(i, j int) bool {
+		return e.RegionCounts[i].Regions < e.RegionCounts[j].Regions
+	})
+
+	return e
+}
+
+// WriteText writes the estimate as a report section.
+func (e *Estimate) WriteText(w io.Writer) {
+	fmt.Fprintln(w, "Plan Impact Estimate")
+	fmt.Fprintln(w, "--------------------")
+	fmt.Fprintf(w, "Monitors to create:     %d", e.Monitors)
+	if e.PausedMonitors > 0 {
+		fmt.Fprintf(w, " (%d paused)", e.PausedMonitors)
+	}
+	fmt.Fprintln(w)
+	fmt.Fprintf(w, "Healthchecks to create: %d\n", e.Healthchecks)
+
+	if len(e.Frequencies) > 0 {
+		fmt.Fprintln(w, "Check frequency:")
+		for _, f := range e.Frequencies {
+			fmt.Fprintf(w, "  %-8s %d monitor(s)\n", formatFrequency(f.FrequencySeconds), f.Monitors)
+		}
+	}
+	if len(e.RegionCounts) > 0 {
+		fmt.Fprintln(w, "Regions per monitor:")
+		for _, r := range e.RegionCounts {
+			fmt.Fprintf(w, "  %-8d %d monitor(s)\n", r.Regions, r.Monitors)
+		}
+	}
+	fmt.Fprintf(w, "Projected monthly checks: %s (30 days, all active monitors and regions)\n", formatCount(e.MonthlyChecks))
+}
+
+// formatFrequency formats a check frequency in seconds as 30s, 5m, or 1h.
+func formatFrequency(seconds int) string {
+	switch {
+	case seconds >= 3600 && seconds%3600 == 0:
+		return fmt.Sprintf("%dh", seconds/3600)
+	case seconds >= 60 && seconds%60 == 0:
+		return fmt.Sprintf("%dm", seconds/60)
+	default:
+		return fmt.Sprintf("%ds", seconds)
+	}
+}
+
+// formatCount formats n with thousands separators.
+func formatCount(n int64) string {
+	s := fmt.Sprintf("%d", n)
+	for i := len(s) - 3; i > 0; i -= 3 {
+		s = s[:i] + "," + s[i:]
+	}
+	return s
+}
@@ -0,0 +1,76 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewEstimate(t *testing.T) {
+	e := NewEstimate([]MonitorLoad{
+		{CheckFrequency: 60, Regions: 3},
+		{CheckFrequency: 60, Regions: 1},
+		{CheckFrequency: 300, Regions: 3},
+		{CheckFrequency: 30, Regions: 2, Paused: true},
+		{}, // defaults: every 60 seconds from one region
+	}, 2)
+
+	assert.Equal(t, 5, e.Monitors)
+	assert.Equal(t, 1, e.PausedMonitors)
+	assert.Equal(t, 2, e.Healthchecks)
+	assert.Equal(t, []FrequencyCount{
+		{FrequencySeconds: 30, Monitors: 1},
+		{FrequencySeconds: 60, Monitors: 3},
+		{FrequencySeconds: 300, Monitors: 1},
+	}, e.Frequencies)
+	assert.Equal(t, []RegionCount{
+		{Regions: 1, Monitors: 2},
+		{Regions: 2, Monitors: 1},
+		{Regions: 3, Monitors: 2},
+	}, e.RegionCounts)
+
+	// 43,200 checks per region per month at 60s and 8,640 at 300s; the
+	// paused monitor adds nothing.
+	assert.Equal(t, int64(43200*3+43200+8640*3+43200), e.MonthlyChecks)
+}
+
+func TestNewEstimate_Empty(t *testing.T) {
+	e := NewEstimate(nil, 0)
+
+	assert.Zero(t, e.Monitors)
+	assert.Zero(t, e.MonthlyChecks)
+	assert.Empty(t, e.Frequencies)
+}
+
+func TestEstimate_WriteText(t *testing.T) {
+	e := NewEstimate([]MonitorLoad{
+		{CheckFrequency: 60, Regions: 3},
+		{CheckFrequency: 3600, Regions: 1, Paused: true},
+	}, 1)
+
+	var sb strings.Builder
+	e.WriteText(&sb)
+	got := sb.String()
+
+	for _, want := range []string{
+		"Monitors to create:     2 (1 paused)",
+		"Healthchecks to create: 1",
+		"  1m       1 monitor(s)",
+		"  1h       1 monitor(s)",
+		"  3        1 monitor(s)",
+		"Projected monthly checks: 129,600",
+	} {
+		assert.Contains(t, got, want)
+	}
+}
+
+func TestFormatCount(t *testing.T) {
+	assert.Equal(t, "0", formatCount(0))
+	assert.Equal(t, "999", formatCount(999))
+	assert.Equal(t, "1,000", formatCount(1000))
+	assert.Equal(t, "12,345,678", formatCount(12345678))
+}
@@ -3,6 +3,11 @@
 
 package migrate
 
+import (
+	"fmt"
+	"slices"
+)
+
 // AllowedFrequencies lists the check frequencies (in seconds) supported by Hyperping.
 var AllowedFrequencies = []int{10, 20, 30, 60, 120, 180, 300, 600, 1800, 3600, 21600, 43200, 86400}
 
@@ -23,6 +28,90 @@ func MapFrequency(interval int) int {
 	return closest
 }
 
+// FrequencyPolicy selects how a source interval that Hyperping does not
+// support is snapped to an allowed check frequency.
+type FrequencyPolicy string
+
+const (
+	// FrequencyNearest picks the nearest allowed frequency. It is the default.
+	FrequencyNearest FrequencyPolicy = "nearest"
+	// FrequencyRoundUp picks the next longer allowed frequency, so checks never
+	// run more often than at the source.
+	FrequencyRoundUp FrequencyPolicy = "round-up"
+	// FrequencyRoundDown picks the next shorter allowed frequency, so checks
+	// never run less often than at the source.
+	FrequencyRoundDown FrequencyPolicy = "round-down"
+	// FrequencyFail rejects the resource instead of changing its interval.
+	FrequencyFail FrequencyPolicy = "fail"
+)
+
+// FrequencyPolicies lists the accepted --frequency-policy values.
+var FrequencyPolicies = []FrequencyPolicy{FrequencyNearest, FrequencyRoundUp, FrequencyRoundDown, FrequencyFail}
+
+// ParseFrequencyPolicy parses a --frequency-policy value. An empty value
+// selects FrequencyNearest.
+func ParseFrequencyPolicy(s string) (FrequencyPolicy, error) {
+	if s == "" {
+		return FrequencyNearest, nil
+	}
+	policy := FrequencyPolicy(s)
+	if !slices.Contains(FrequencyPolicies, policy) {
+		return "", fmt.Errorf("invalid --frequency-policy %q (allowed: nearest, round-up, round-down, fail)", s)
+	}
+	return policy, nil
+}
+
+// Snap returns the allowed frequency for interval under the policy. Intervals
+// outside the allowed range snap to the shortest or longest frequency, even
+// under round-up or round-down. Under FrequencyFail, an interval that is not
+// an allowed frequency returns an error.
+func (p FrequencyPolicy) Snap(interval int) (int, error) {
+	if slices.Contains(AllowedFrequencies, interval) {
+		return interval, nil
+	}
+
+	shortest := AllowedFrequencies[0]
+	longest := AllowedFrequencies[len(AllowedFrequencies)-1]
+
+	switch p {
+	case FrequencyRoundUp:
+		for _, freq := range AllowedFrequencies {
+			if freq >= interval {
+				return freq, nil
+			}
+		}
+		return longest, nil
+	case FrequencyRoundDown:
+		for i := len(AllowedFrequencies) - 1; i >= 0; i-- {
+			if AllowedFrequencies[i] <= interval {
+				return AllowedFrequencies[i], nil
+			}
+		}
+		return shortest, nil
+	case FrequencyFail:
+		return 0, fmt.Errorf("check frequency %ds is not supported by Hyperping (allowed: %s); "+
+			"set it with a mapping override or choose another --frequency-policy", interval, joinInts(AllowedFrequencies))
+	default:
+		return MapFrequency(interval), nil
+	}
+}
+
+// FrequencyAdjustment records a source interval that was snapped to a
+// different Hyperping check frequency. Migration reports list every
+// adjustment so the change is reviewed rather than silently applied.
+type FrequencyAdjustment struct {
+	SourceID string          `json:"source_id"`
+	Name     string          `json:"name"`
+	From     int             `json:"from_seconds"`
+	To       int             `json:"to_seconds"`
+	Policy   FrequencyPolicy `json:"policy"`
+}
+
+// String describes the adjustment for warnings and text reports.
+func (a FrequencyAdjustment) String() string {
+	return fmt.Sprintf("Check frequency adjusted from %ds to %ds (--frequency-policy=%s)", a.From, a.To, a.Policy)
+}
+
 func abs(n int) int {
 	if n < 0 {
 		return -n
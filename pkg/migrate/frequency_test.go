@@ -61,3 +61,56 @@ func TestAbs(t *testing.T) {
 	assert.Equal(t, 5, abs(-5))
 	assert.Equal(t, 0, abs(0))
 }
+
+func TestParseFrequencyPolicy(t *testing.T) {
+	policy, err := ParseFrequencyPolicy("")
+	assert.NoError(t, err)
+	assert.Equal(t, FrequencyNearest, policy)
+
+	for _, p := range FrequencyPolicies {
+		policy, err := ParseFrequencyPolicy(string(p))
+		assert.NoError(t, err)
+		assert.Equal(t, p, policy)
+	}
+
+	_, err = ParseFrequencyPolicy("ceil")
+	assert.ErrorContains(t, err, `invalid --frequency-policy "ceil"`)
+}
+
+func TestFrequencyPolicy_Snap(t *testing.T) {
+	tests := []struct {
+		name     string
+		policy   FrequencyPolicy
+		input    int
+		expected int
+		wantErr  bool
+	}{
+		{"nearest 45", FrequencyNearest, 45, 30, false},
+		{"nearest 900", FrequencyNearest, 900, 600, false},
+		{"round-up 45", FrequencyRoundUp, 45, 60, false},
+		{"round-up 900", FrequencyRoundUp, 900, 1800, false},
+		{"round-up beyond longest", FrequencyRoundUp, 100000, 86400, false},
+		{"round-down 45", FrequencyRoundDown, 45, 30, false},
+		{"round-down 900", FrequencyRoundDown, 900, 600, false},
+		{"round-down below shortest", FrequencyRoundDown, 5, 10, false},
+		{"fail on exact", FrequencyFail, 120, 120, false},
+		{"fail on unsupported", FrequencyFail, 900, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			result, err := tt.policy.Snap(tt.input)
+			if tt.wantErr {
+				assert.ErrorContains(t, err, "check frequency 900s is not supported")
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+		})
+	}
+}
+
+func TestFrequencyAdjustment_String(t *testing.T) {
+	a := FrequencyAdjustment{SourceID: "1", Name: "API", From: 900, To: 1800, Policy: FrequencyRoundUp}
+	assert.Equal(t, "Check frequency adjusted from 900s to 1800s (--frequency-policy=round-up)", a.String())
+}
@@ -0,0 +1,158 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Override corrects the conversion of one source resource. Unset fields keep
+// the converter's mapping.
+type Override struct {
+	Name      string   `yaml:"name"`      // Hyperping name; takes precedence over --name-template
+	Regions   []string `yaml:"regions"`   // Hyperping region names
+	Frequency int      `yaml:"frequency"` // seconds, one of AllowedFrequencies
+	Skip      bool     `yaml:"skip"`      // leave the resource out of the migration
+}
+
+// Overrides holds the mapping overrides read from an --overrides file, keyed
+// by source resource ID. The file looks like:
+//
+//	overrides:
+//	  "123456":
+//	    name: Checkout API
+//	    regions: [london, frankfurt]
+//	    frequency: 60
+//	  "789012":
+//	    skip: true
+//
+// A nil *Overrides has no entries.
+type Overrides struct {
+	byID map[string]Override
+}
+
+type overridesFile struct {
+	Overrides map[string]Override `yaml:"overrides"`
+}
+
+// LoadOverrides reads and validates the overrides file at path. An empty path
+// returns nil overrides.
+func LoadOverrides(path string) (*Overrides, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path) // #nosec G304 -- path is supplied by the operator via --overrides
+	if err != nil {
+		return nil, fmt.Errorf("reading overrides file: %w", err)
+	}
+	o, err := ParseOverrides(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return o, nil
+}
+
+// ParseOverrides parses and validates overrides YAML. Unknown fields are
+// rejected so a misspelled key does not silently leave a default in place.
+// Region names are lowercased and deduplicated.
+func ParseOverrides(data []byte) (*Overrides, error) {
+	var file overridesFile
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid overrides: %w", err)
+	}
+
+	o := &Overrides{byID: make(map[string]Override, len(file.Overrides))}
+	for id, override := range file.Overrides {
+		id = strings.TrimSpace(id)
+		if id == "" {
+			return nil, fmt.Errorf("invalid overrides: empty source ID")
+		}
+		normalized, err := normalizeOverride(override)
+		if err != nil {
+			return nil, fmt.Errorf("invalid override for %q: %w", id, err)
+		}
+		o.byID[id] = normalized
+	}
+	return o, nil
+}
+
+func normalizeOverride(o Override) (Override, error) {
+	o.Name = strings.TrimSpace(o.Name)
+	if o.Skip && (o.Name != "" || len(o.Regions) > 0 || o.Frequency != 0) {
+		return o, fmt.Errorf("skip cannot be combined with other fields")
+	}
+
+	if o.Frequency != 0 && !slices.Contains(AllowedFrequencies, o.Frequency) {
+		return o, fmt.Errorf("frequency %d is not supported (allowed: %s)", o.Frequency, joinInts(AllowedFrequencies))
+	}
+
+	regions, err := normalizeHyperpingRegions(o.Regions)
+	if err != nil {
+		return o, err
+	}
+	o.Regions = regions
+	return o, nil
+}
+
+// Lookup returns the override for a source resource ID.
+func (o *Overrides) Lookup(id string) (Override, bool) {
+	if o == nil {
+		return Override{}, false
+	}
+	override, ok := o.byID[id]
+	return override, ok
+}
+
+// Skipped reports whether the override for id sets skip.
+func (o *Overrides) Skipped(id string) bool {
+	override, _ := o.Lookup(id)
+	return override.Skip
+}
+
+// Len returns the number of overrides.
+func (o *Overrides) Len() int {
+	if o == nil {
+		return 0
+	}
+	return len(o.byID)
+}
+
+// Unknown returns the override IDs, sorted, that are not in sourceIDs. These
+// are usually typos or resources deleted at the source.
+func (o *Overrides) Unknown(sourceIDs []string) []string {
+	if o == nil {
+		return nil
+	}
+	known := make(map[string]bool, len(sourceIDs))
+	for _, id := range sourceIDs {
+		known[id] = true
+	}
+	var unknown []string
+	for id := range o.byID {
+		if !known[id] {
+			unknown = append(unknown, id)
+		}
+	}
+	sort.Strings(unknown)
+	return unknown
+}
+
+func joinInts(values []int) string {
+	parts := make([]string, len(values))
+	for i, v := range values {
+		parts[i] = fmt.Sprint(v)
+	}
+	return strings.Join(parts, ", ")
+}
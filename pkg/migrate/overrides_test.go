@@ -0,0 +1,87 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseOverrides(t *testing.T) {
+	o, err := ParseOverrides([]byte(`
+overrides:
+  "123":
+    name: " Checkout API "
+    regions: [London, frankfurt, london]
+    frequency: 60
+  456:
+    skip: true
+`))
+	require.NoError(t, err)
+	assert.Equal(t, 2, o.Len())
+
+	override, ok := o.Lookup("123")
+	require.True(t, ok)
+	assert.Equal(t, Override{Name: "Checkout API", Regions: []string{"london", "frankfurt"}, Frequency: 60}, override)
+
+	assert.True(t, o.Skipped("456"), "numeric YAML keys are read as IDs")
+	assert.False(t, o.Skipped("123"))
+
+	_, ok = o.Lookup("789")
+	assert.False(t, ok)
+}
+
+func TestParseOverrides_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{"unknown field", "overrides:\n  \"1\":\n    frequncy: 60\n", "field frequncy not found"},
+		{"unsupported frequency", "overrides:\n  \"1\":\n    frequency: 45\n", "frequency 45 is not supported"},
+		{"unknown region", "overrides:\n  \"1\":\n    regions: [mars]\n", `unknown region "mars"`},
+		{"skip with fields", "overrides:\n  \"1\":\n    skip: true\n    name: x\n", "skip cannot be combined"},
+		{"unknown top-level key", "monitors: {}\n", "field monitors not found"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseOverrides([]byte(tt.yaml))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoadOverrides(t *testing.T) {
+	o, err := LoadOverrides("")
+	require.NoError(t, err)
+	assert.Nil(t, o)
+	assert.Equal(t, 0, o.Len())
+	assert.False(t, o.Skipped("1"), "nil overrides skip nothing")
+
+	path := filepath.Join(t.TempDir(), "overrides.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("overrides:\n  \"1\":\n    frequency: 7\n"), 0o600))
+	_, err = LoadOverrides(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), path)
+
+	empty := filepath.Join(t.TempDir(), "empty.yaml")
+	require.NoError(t, os.WriteFile(empty, nil, 0o600))
+	o, err = LoadOverrides(empty)
+	require.NoError(t, err)
+	assert.Equal(t, 0, o.Len())
+}
+
+func TestOverrides_Unknown(t *testing.T) {
+	o, err := ParseOverrides([]byte("overrides:\n  \"3\": {skip: true}\n  \"1\": {skip: true}\n  \"2\": {skip: true}\n"))
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"1", "3"}, o.Unknown([]string{"2", "4"}))
+	assert.Nil(t, (*Overrides)(nil).Unknown([]string{"1"}))
+}
@@ -0,0 +1,135 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+	"gopkg.in/yaml.v3"
+)
+
+// RegionMap holds the region mappings read from a --region-map file. They
+// take precedence over the built-in mappings and the nearest-region
+// fallback. The file looks like:
+//
+//	regions:
+//	  us: [virginia, nyc]
+//	  "region:NA": [virginia, california, toronto]
+//	  eu-north-1: [amsterdam]
+//	# Regions for resources whose source sets none, or none that map.
+//	default: [london, frankfurt]
+//
+// Source region identifiers match case-insensitively. A nil *RegionMap has
+// no entries.
+type RegionMap struct {
+	bySource map[string][]string
+	defaults []string
+}
+
+type regionMapFile struct {
+	Regions map[string][]string `yaml:"regions"`
+	Default []string            `yaml:"default"`
+}
+
+// LoadRegionMap reads and validates the region map file at path. An empty
+// path returns a nil region map.
+func LoadRegionMap(path string) (*RegionMap, error) {
+	if path == "" {
+		return nil, nil
+	}
+	data, err := os.ReadFile(path) // #nosec G304 -- path is supplied by the operator via --region-map
+	if err != nil {
+		return nil, fmt.Errorf("reading region map file: %w", err)
+	}
+	rm, err := ParseRegionMap(data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return rm, nil
+}
+
+// ParseRegionMap parses and validates region map YAML. Unknown fields and
+// regions Hyperping does not have are rejected.
+func ParseRegionMap(data []byte) (*RegionMap, error) {
+	var file regionMapFile
+	dec := yaml.NewDecoder(bytes.NewReader(data))
+	dec.KnownFields(true)
+	if err := dec.Decode(&file); err != nil && !errors.Is(err, io.EOF) {
+		return nil, fmt.Errorf("invalid region map: %w", err)
+	}
+
+	rm := &RegionMap{bySource: make(map[string][]string, len(file.Regions))}
+	for source, regions := range file.Regions {
+		key := normalizeRegion(source)
+		if key == "" {
+			return nil, fmt.Errorf("invalid region map: empty source region")
+		}
+		if _, dup := rm.bySource[key]; dup {
+			return nil, fmt.Errorf("invalid region map: %q is mapped more than once", source)
+		}
+		normalized, err := normalizeHyperpingRegions(regions)
+		if err != nil {
+			return nil, fmt.Errorf("invalid region map for %q: %w", source, err)
+		}
+		if len(normalized) == 0 {
+			return nil, fmt.Errorf("invalid region map for %q: no regions", source)
+		}
+		rm.bySource[key] = normalized
+	}
+
+	defaults, err := normalizeHyperpingRegions(file.Default)
+	if err != nil {
+		return nil, fmt.Errorf("invalid region map default: %w", err)
+	}
+	rm.defaults = defaults
+	return rm, nil
+}
+
+// normalizeHyperpingRegions lowercases and deduplicates regions, and rejects
+// any that are not in hyperping.AllowedRegions.
+func normalizeHyperpingRegions(regions []string) ([]string, error) {
+	var normalized []string
+	for _, region := range regions {
+		region = normalizeRegion(region)
+		if !slices.Contains(hyperping.AllowedRegions, region) {
+			return nil, fmt.Errorf("unknown region %q (allowed: %s)", region, strings.Join(hyperping.AllowedRegions, ", "))
+		}
+		if !slices.Contains(normalized, region) {
+			normalized = append(normalized, region)
+		}
+	}
+	return normalized, nil
+}
+
+// Lookup returns the Hyperping regions mapped to a source region identifier.
+func (rm *RegionMap) Lookup(source string) ([]string, bool) {
+	if rm == nil {
+		return nil, false
+	}
+	regions, ok := rm.bySource[normalizeRegion(source)]
+	return regions, ok
+}
+
+// Defaults returns the default regions, or nil when the file sets none.
+func (rm *RegionMap) Defaults() []string {
+	if rm == nil {
+		return nil
+	}
+	return rm.defaults
+}
+
+// Len returns the number of source region mappings.
+func (rm *RegionMap) Len() int {
+	if rm == nil {
+		return 0
+	}
+	return len(rm.bySource)
+}
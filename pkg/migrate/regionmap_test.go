@@ -0,0 +1,73 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseRegionMap(t *testing.T) {
+	rm, err := ParseRegionMap([]byte(`
+regions:
+  "region:NA": [Virginia, california, virginia]
+  eu-north-1: [amsterdam]
+default: [london]
+`))
+	require.NoError(t, err)
+	assert.Equal(t, 2, rm.Len())
+
+	regions, ok := rm.Lookup("REGION:na")
+	require.True(t, ok, "source regions match case-insensitively")
+	assert.Equal(t, []string{"virginia", "california"}, regions)
+
+	_, ok = rm.Lookup("us")
+	assert.False(t, ok)
+	assert.Equal(t, []string{"london"}, rm.Defaults())
+}
+
+func TestParseRegionMap_Invalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		yaml    string
+		wantErr string
+	}{
+		{"unknown field", "region:\n  us: [virginia]\n", "field region not found"},
+		{"unknown region", "regions:\n  us: [oregon]\n", `unknown region "oregon"`},
+		{"unknown default region", "default: [mars]\n", `unknown region "mars"`},
+		{"no regions", "regions:\n  us: []\n", "no regions"},
+		{"duplicate source", "regions:\n  us: [virginia]\n  US: [nyc]\n", "mapped more than once"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := ParseRegionMap([]byte(tt.yaml))
+			require.Error(t, err)
+			assert.Contains(t, err.Error(), tt.wantErr)
+		})
+	}
+}
+
+func TestLoadRegionMap(t *testing.T) {
+	rm, err := LoadRegionMap("")
+	require.NoError(t, err)
+	assert.Nil(t, rm)
+	assert.Equal(t, 0, rm.Len())
+	assert.Nil(t, rm.Defaults())
+	_, ok := rm.Lookup("us")
+	assert.False(t, ok, "nil region map maps nothing")
+
+	path := filepath.Join(t.TempDir(), "regions.yaml")
+	require.NoError(t, os.WriteFile(path, []byte("regions:\n  us: [mars]\n"), 0o600))
+	_, err = LoadRegionMap(path)
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), path)
+
+	_, err = LoadRegionMap(filepath.Join(t.TempDir(), "missing.yaml"))
+	require.Error(t, err)
+}
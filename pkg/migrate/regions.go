@@ -3,7 +3,15 @@
 
 package migrate
 
-import "strings"
+import (
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
 
 // DefaultRegions returns the default set of regions used when no mapping is available.
 func DefaultRegions() []string {
@@ -15,13 +23,14 @@ var RegionAliases = map[string]string{
 	"us":             "virginia",
 	"us-east":        "virginia",
 	"us-east-1":      "virginia",
-	"us-west":        "oregon",
-	"us-west-1":      "oregon",
+	"us-west":        "california",
+	"us-west-1":      "california",
 	"eu":             "london",
 	"eu-west":        "london",
 	"eu-west-1":      "london",
 	"eu-central":     "frankfurt",
 	"eu-central-1":   "frankfurt",
+	"as":             "singapore",
 	"asia":           "singapore",
 	"ap-southeast":   "singapore",
 	"ap-southeast-1": "singapore",
@@ -36,21 +45,303 @@ var RegionAliases = map[string]string{
 	"me-south-1":     "bahrain",
 }
 
-// MapRegions converts a list of source region identifiers to Hyperping region names.
+// coordinates is a latitude and longitude in degrees.
+type coordinates struct {
+	lat, lon float64
+}
+
+// regionCoordinates locates each of hyperping.AllowedRegions.
+var regionCoordinates = map[string]coordinates{
+	"london":       {51.51, -0.13},
+	"frankfurt":    {50.11, 8.68},
+	"paris":        {48.86, 2.35},
+	"amsterdam":    {52.37, 4.90},
+	"singapore":    {1.35, 103.82},
+	"sydney":       {-33.87, 151.21},
+	"tokyo":        {35.68, 139.69},
+	"seoul":        {37.57, 126.98},
+	"mumbai":       {19.08, 72.88},
+	"bangalore":    {12.97, 77.59},
+	"virginia":     {39.04, -77.49},
+	"california":   {34.05, -118.24},
+	"sanfrancisco": {37.77, -122.42},
+	"nyc":          {40.71, -74.01},
+	"toronto":      {43.65, -79.38},
+	"saopaulo":     {-23.55, -46.63},
+	"bahrain":      {26.07, 50.56},
+	"capetown":     {-33.92, 18.42},
+}
+
+// placeCoordinates locates the source region identifiers, cities, countries,
+// and continents that have no exact Hyperping region, so they can be mapped
+// to the nearest one. Multi-word names are joined with hyphens.
+var placeCoordinates = map[string]coordinates{
+	// Continents and areas
+	"na":            {39.83, -98.58},
+	"north-america": {39.83, -98.58},
+	"europe":        {50.11, 8.68},
+	"apac":          {1.35, 103.82},
+	"oceania":       {-33.87, 151.21},
+	"latam":         {-23.55, -46.63},
+	"south-america": {-23.55, -46.63},
+	"middle-east":   {26.07, 50.56},
+	"af":            {-33.92, 18.42},
+	"africa":        {-33.92, 18.42},
+
+	// Cloud provider region prefixes
+	"us-central":     {41.26, -95.86},
+	"us-east-2":      {39.96, -83.00},
+	"us-west-2":      {45.60, -121.18},
+	"ca":             {45.50, -73.57},
+	"ca-central":     {45.50, -73.57},
+	"ca-west":        {51.05, -114.07},
+	"mx-central":     {20.59, -100.39},
+	"eu-west-2":      {51.51, -0.13},
+	"eu-west-3":      {48.86, 2.35},
+	"eu-north":       {59.33, 18.07},
+	"eu-south":       {45.46, 9.19},
+	"eu-central-2":   {47.38, 8.54},
+	"ap-south":       {19.08, 72.88},
+	"ap-east":        {22.32, 114.17},
+	"ap-southeast-2": {-33.87, 151.21},
+	"ap-southeast-3": {-6.21, 106.85},
+	"ap-northeast-2": {37.57, 126.98},
+	"ap-northeast-3": {34.69, 135.50},
+	"me-central":     {25.20, 55.27},
+	"il-central":     {32.09, 34.78},
+	"af-south":       {-33.92, 18.42},
+
+	// Cities
+	"new-york":     {40.71, -74.01},
+	"washington":   {38.91, -77.04},
+	"atlanta":      {33.75, -84.39},
+	"miami":        {25.76, -80.19},
+	"chicago":      {41.88, -87.63},
+	"dallas":       {32.78, -96.80},
+	"denver":       {39.74, -104.99},
+	"seattle":      {47.61, -122.33},
+	"los-angeles":  {34.05, -118.24},
+	"montreal":     {45.50, -73.57},
+	"vancouver":    {49.28, -123.12},
+	"mexico":       {19.43, -99.13},
+	"dublin":       {53.35, -6.26},
+	"stockholm":    {59.33, 18.07},
+	"madrid":       {40.42, -3.70},
+	"milan":        {45.46, 9.19},
+	"zurich":       {47.38, 8.54},
+	"warsaw":       {52.23, 21.01},
+	"hong-kong":    {22.32, 114.17},
+	"osaka":        {34.69, 135.50},
+	"jakarta":      {-6.21, 106.85},
+	"melbourne":    {-37.81, 144.96},
+	"auckland":     {-36.85, 174.76},
+	"johannesburg": {-26.20, 28.05},
+	"dubai":        {25.20, 55.27},
+	"tel-aviv":     {32.09, 34.78},
+
+	// Countries
+	"uk": {51.51, -0.13},
+	"gb": {51.51, -0.13},
+	"ie": {53.35, -6.26},
+	"fr": {48.86, 2.35},
+	"de": {50.11, 8.68},
+	"nl": {52.37, 4.90},
+	"se": {59.33, 18.07},
+	"es": {40.42, -3.70},
+	"it": {45.46, 9.19},
+	"ch": {47.38, 8.54},
+	"pl": {52.23, 21.01},
+	"in": {19.08, 72.88},
+	"sg": {1.35, 103.82},
+	"jp": {35.68, 139.69},
+	"kr": {37.57, 126.98},
+	"hk": {22.32, 114.17},
+	"id": {-6.21, 106.85},
+	"nz": {-36.85, 174.76},
+	"br": {-23.55, -46.63},
+	"za": {-33.92, 18.42},
+	"ae": {25.20, 55.27},
+	"il": {32.09, 34.78},
+	"mx": {19.43, -99.13},
+}
+
+// RegionMapper maps source platform region identifiers to Hyperping regions.
+// Each identifier is looked up, in order, in the --region-map file, the
+// platform's own table, RegionAliases, and hyperping.AllowedRegions. An
+// identifier found in none of them is mapped to the Hyperping region nearest
+// to the place it names, when it names a known one.
+type RegionMapper struct {
+	table     map[string][]string
+	regionMap *RegionMap
+}
+
+// NewRegionMapper returns a mapper that consults table, keyed by source
+// region identifier, before RegionAliases. A nil table uses RegionAliases
+// alone.
+func NewRegionMapper(table map[string][]string) *RegionMapper {
+	normalized := make(map[string][]string, len(table))
+	for source, regions := range table {
+		normalized[normalizeRegion(source)] = regions
+	}
+	return &RegionMapper{table: normalized}
+}
+
+// WithRegionMap applies the user mappings in rm ahead of every built-in one.
+// A nil region map applies none.
+func (m *RegionMapper) WithRegionMap(rm *RegionMap) *RegionMapper {
+	m.regionMap = rm
+	return m
+}
+
+// Defaults returns the --region-map defaults when set, and fallback
+// otherwise.
+func (m *RegionMapper) Defaults(fallback []string) []string {
+	if defaults := m.regionMap.Defaults(); len(defaults) > 0 {
+		return defaults
+	}
+	return fallback
+}
+
+// RegionApproximation records a source region with no exact mapping that was
+// mapped to the nearest Hyperping region.
+type RegionApproximation struct {
+	Source string
+	Region string
+}
+
+// RegionMapping is the result of mapping a resource's source regions.
+type RegionMapping struct {
+	// Regions are the Hyperping regions, deduplicated, in source order.
+	Regions []string
+	// Approximated lists the source regions mapped to the nearest region.
+	Approximated []RegionApproximation
+	// Unmapped lists the source regions that could not be mapped at all.
+	Unmapped []string
+}
+
+// Warnings describes the approximated and unmapped source regions, one
+// message each, for conversion reports.
+func (r RegionMapping) Warnings() []string {
+	var warnings []string
+	for _, a := range r.Approximated {
+		warnings = append(warnings, fmt.Sprintf("Region %q has no exact Hyperping equivalent; mapped to nearest region %q", a.Source, a.Region))
+	}
+	if len(r.Unmapped) > 0 {
+		warnings = append(warnings, fmt.Sprintf("Unknown regions ignored: %s (map them with --region-map)", strings.Join(r.Unmapped, ", ")))
+	}
+	return warnings
+}
+
+// Map maps source region identifiers to Hyperping regions.
+func (m *RegionMapper) Map(sourceRegions []string) RegionMapping {
+	var result RegionMapping
+	for _, source := range sourceRegions {
+		normalized := normalizeRegion(source)
+		if normalized == "" {
+			continue
+		}
+		regions, exact := m.resolve(normalized)
+		switch {
+		case len(regions) == 0:
+			result.Unmapped = append(result.Unmapped, strings.TrimSpace(source))
+		case !exact:
+			result.Approximated = append(result.Approximated, RegionApproximation{Source: strings.TrimSpace(source), Region: regions[0]})
+		}
+		for _, region := range regions {
+			if !slices.Contains(result.Regions, region) {
+				result.Regions = append(result.Regions, region)
+			}
+		}
+	}
+	return result
+}
+
+// resolve returns the Hyperping regions for a normalized source region, and
+// whether the mapping is exact rather than the nearest region.
+func (m *RegionMapper) resolve(source string) ([]string, bool) {
+	if regions, ok := m.regionMap.Lookup(source); ok {
+		return regions, true
+	}
+	if regions, ok := m.table[source]; ok {
+		return regions, true
+	}
+	if region, ok := RegionAliases[source]; ok {
+		return []string{region}, true
+	}
+	if slices.Contains(hyperping.AllowedRegions, source) {
+		return []string{source}, true
+	}
+	if at, ok := locateRegion(source); ok {
+		return []string{NearestRegion(at.lat, at.lon)}, false
+	}
+	return nil, false
+}
+
+// MapRegions converts a list of source region identifiers to Hyperping region names,
+// falling back to the nearest region for identifiers without an exact mapping.
 // Unknown regions are silently skipped. Duplicates are removed.
 func MapRegions(sourceRegions []string) []string {
-	var regions []string
-	seen := make(map[string]bool)
-
-	for _, region := range sourceRegions {
-		normalized := strings.ToLower(strings.TrimSpace(region))
-		if mapped, ok := RegionAliases[normalized]; ok {
-			if !seen[mapped] {
-				regions = append(regions, mapped)
-				seen[mapped] = true
-			}
+	return NewRegionMapper(nil).Map(sourceRegions).Regions
+}
+
+// NearestRegion returns the Hyperping region closest to the given latitude
+// and longitude.
+func NearestRegion(lat, lon float64) string {
+	nearest, best := "", math.Inf(1)
+	for _, region := range hyperping.AllowedRegions {
+		at, ok := regionCoordinates[region]
+		if !ok {
+			continue
+		}
+		if d := distanceKm(coordinates{lat, lon}, at); d < best {
+			nearest, best = region, d
+		}
+	}
+	return nearest
+}
+
+// locateRegion finds the place a source region identifier names. It tries
+// the longest leading run of the identifier's words first, so "us-east-2"
+// matches before "us-east", then each word alone, so "Dallas, TX" finds
+// "dallas".
+func locateRegion(source string) (coordinates, bool) {
+	words := strings.FieldsFunc(source, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+	for n := len(words); n > 0; n-- {
+		if at, ok := placeOf(strings.Join(words[:n], "-")); ok {
+			return at, true
+		}
+	}
+	for _, word := range words {
+		if at, ok := placeOf(word); ok {
+			return at, true
 		}
 	}
+	return coordinates{}, false
+}
+
+func placeOf(name string) (coordinates, bool) {
+	if at, ok := placeCoordinates[name]; ok {
+		return at, true
+	}
+	if region, ok := RegionAliases[name]; ok {
+		name = region
+	}
+	at, ok := regionCoordinates[name]
+	return at, ok
+}
+
+// distanceKm returns the great-circle distance between a and b.
+func distanceKm(a, b coordinates) float64 {
+	const earthRadiusKm = 6371
+	lat1, lat2 := a.lat*math.Pi/180, b.lat*math.Pi/180
+	dLat := lat2 - lat1
+	dLon := (b.lon - a.lon) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Sqrt(h))
+}
 
-	return regions
+func normalizeRegion(region string) string {
+	return strings.ToLower(strings.TrimSpace(region))
 }
@@ -6,7 +6,9 @@ package migrate
 import (
 	"testing"
 
+	hyperping "github.com/develeap/hyperping-go"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestMapRegions(t *testing.T) {
@@ -53,13 +55,23 @@ func TestMapRegions(t *testing.T) {
 		{
 			name:     "all regions",
 			input:    []string{"us", "us-west", "eu", "eu-central", "asia", "ap-northeast", "au", "sa", "me"},
-			expected: []string{"virginia", "oregon", "london", "frankfurt", "singapore", "tokyo", "sydney", "saopaulo", "bahrain"},
+			expected: []string{"virginia", "california", "london", "frankfurt", "singapore", "tokyo", "sydney", "saopaulo", "bahrain"},
 		},
 		{
 			name:     "middle east aliases",
 			input:    []string{"me", "me-south", "me-south-1"},
 			expected: []string{"bahrain"},
 		},
+		{
+			name:     "nearest region for unaliased identifiers",
+			input:    []string{"eu-north-1", "ap-south-1", "Dallas, TX"},
+			expected: []string{"amsterdam", "mumbai", "virginia"},
+		},
+		{
+			name:     "Hyperping region names pass through",
+			input:    []string{"Paris", "capetown"},
+			expected: []string{"paris", "capetown"},
+		},
 		{
 			name:     "whitespace trimmed",
 			input:    []string{" us ", "  eu  "},
@@ -79,3 +91,67 @@ func TestDefaultRegions(t *testing.T) {
 	regions := DefaultRegions()
 	assert.Equal(t, []string{"london", "virginia", "singapore"}, regions)
 }
+
+func TestRegionAliases_AreHyperpingRegions(t *testing.T) {
+	for source, region := range RegionAliases {
+		assert.Contains(t, hyperping.AllowedRegions, region, "alias %q", source)
+	}
+}
+
+func TestRegionCoordinates_CoverAllowedRegions(t *testing.T) {
+	for _, region := range hyperping.AllowedRegions {
+		_, ok := regionCoordinates[region]
+		assert.True(t, ok, "no coordinates for %q", region)
+		assert.Equal(t, region, NearestRegion(regionCoordinates[region].lat, regionCoordinates[region].lon))
+	}
+}
+
+func TestNearestRegion(t *testing.T) {
+	tests := []struct {
+		name     string
+		lat, lon float64
+		expected string
+	}{
+		{"Stockholm", 59.33, 18.07, "amsterdam"},
+		{"Milan", 45.46, 9.19, "frankfurt"},
+		{"Ohio", 39.96, -83.00, "virginia"},
+		{"Oregon", 45.60, -121.18, "sanfrancisco"},
+		{"Montreal", 45.50, -73.57, "toronto"},
+		{"Dubai", 25.20, 55.27, "bahrain"},
+		{"Johannesburg", -26.20, 28.05, "capetown"},
+		{"Auckland", -36.85, 174.76, "sydney"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, NearestRegion(tt.lat, tt.lon))
+		})
+	}
+}
+
+func TestRegionMapper_Map(t *testing.T) {
+	rm, err := ParseRegionMap([]byte("regions:\n  US: [nyc, toronto]\n  mars-1: [bangalore]\n"))
+	require.NoError(t, err)
+
+	mapper := NewRegionMapper(map[string][]string{
+		"region:NA": {"virginia", "california"},
+		"us":        {"virginia"},
+	}).WithRegionMap(rm)
+
+	result := mapper.Map([]string{"region:na", "us", "mars-1", "eu-south-1", "atlantis", ""})
+	assert.Equal(t, []string{"virginia", "california", "nyc", "toronto", "bangalore", "frankfurt"}, result.Regions)
+	assert.Equal(t, []RegionApproximation{{Source: "eu-south-1", Region: "frankfurt"}}, result.Approximated)
+	assert.Equal(t, []string{"atlantis"}, result.Unmapped)
+	assert.Equal(t, []string{
+		`Region "eu-south-1" has no exact Hyperping equivalent; mapped to nearest region "frankfurt"`,
+		"Unknown regions ignored: atlantis (map them with --region-map)",
+	}, result.Warnings())
+}
+
+func TestRegionMapper_Defaults(t *testing.T) {
+	fallback := []string{"london"}
+	assert.Equal(t, fallback, NewRegionMapper(nil).Defaults(fallback))
+
+	rm, err := ParseRegionMap([]byte("default: [Tokyo, seoul, tokyo]\n"))
+	require.NoError(t, err)
+	assert.Equal(t, []string{"tokyo", "seoul"}, NewRegionMapper(nil).WithRegionMap(rm).Defaults(fallback))
+}
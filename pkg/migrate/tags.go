@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"text/template"
+)
+
+// Tags is a list of tag names read from a source platform. It decodes from a
+// JSON array of strings or of objects with a "name" field, so an API that
+// returns tag objects ({"id": 1, "name": "prod"}) and one that returns plain
+// strings are handled alike. null decodes to an empty list.
+type Tags []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *Tags) UnmarshalJSON(data []byte) error {
+	var raw []json.RawMessage
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return fmt.Errorf("tags: %w", err)
+	}
+
+	tags := make(Tags, 0, len(raw))
+	for _, item := range raw {
+		var name string
+		if err := json.Unmarshal(item, &name); err != nil {
+			var obj struct {
+				Name string `json:"name"`
+			}
+			if objErr := json.Unmarshal(item, &obj); objErr != nil {
+				return fmt.Errorf("tags: unsupported element %s", item)
+			}
+			name = obj.Name
+		}
+		if name = strings.TrimSpace(name); name != "" {
+			tags = append(tags, name)
+		}
+	}
+	*t = tags
+	return nil
+}
+
+// String returns the tags as a comma-separated list for comments and reports.
+func (t Tags) String() string {
+	return strings.Join(t, ", ")
+}
+
+// NameTemplateData is the data available to a --name-template.
+type NameTemplateData struct {
+	Name string // source monitor name
+	Tags Tags   // source tags, in source order
+}
+
+// Tag returns the value of the first "key:value" or "key=value" tag with the
+// given key (case-insensitive), or "" when there is none.
+func (d NameTemplateData) Tag(key string) string {
+	for _, tag := range d.Tags {
+		k, v, ok := strings.Cut(tag, ":")
+		if !ok {
+			k, v, ok = strings.Cut(tag, "=")
+		}
+		if ok && strings.EqualFold(strings.TrimSpace(k), key) {
+			return strings.TrimSpace(v)
+		}
+	}
+	return ""
+}
+
+// HasTag reports whether a tag equals name (case-insensitive).
+func (d NameTemplateData) HasTag(name string) bool {
+	for _, tag := range d.Tags {
+		if strings.EqualFold(tag, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// NameTemplate renders Hyperping monitor names from a source name and tags
+// using text/template syntax, for example:
+//
+//	[{{.Tag "env" | upper}}] {{.Name}}
+//	{{.Name}}{{with .Tags}} ({{join . ", "}}){{end}}
+//
+// A nil *NameTemplate keeps the source name unchanged.
+type NameTemplate struct {
+	tmpl *template.Template
+}
+
+var nameTemplateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+	"join": func(tags Tags, sep string) string {
+		return strings.Join(tags, sep)
+	},
+}
+
+// ParseNameTemplate parses text as a name template. An empty text returns a
+// nil template, which leaves names unchanged.
+func ParseNameTemplate(text string) (*NameTemplate, error) {
+	if strings.TrimSpace(text) == "" {
+		return nil, nil
+	}
+	tmpl, err := template.New("name").Funcs(nameTemplateFuncs).Option("missingkey=error").Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid name template: %w", err)
+	}
+	return &NameTemplate{tmpl: tmpl}, nil
+}
+
+// Render returns the monitor name for a source monitor. Runs of whitespace
+// are collapsed, so a template segment that renders empty (such as a missing
+// tag) does not leave double spaces. If the template renders to an empty
+// string the source name is returned.
+func (t *NameTemplate) Render(name string, tags []string) (string, error) {
+	if t == nil {
+		return name, nil
+	}
+
+	var buf bytes.Buffer
+	if err := t.tmpl.Execute(&buf, NameTemplateData{Name: name, Tags: tags}); err != nil {
+		return name, fmt.Errorf("rendering name template for %q: %w", name, err)
+	}
+
+	rendered := strings.Join(strings.Fields(buf.String()), " ")
+	if rendered == "" {
+		return name, nil
+	}
+	return rendered, nil
+}
@@ -0,0 +1,116 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrate
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTags_UnmarshalJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		input    string
+		expected Tags
+	}{
+		{"strings", `["prod", " api "]`, Tags{"prod", "api"}},
+		{"objects", `[{"id": 1, "name": "env:prod", "color": "#fff"}, {"name": "team=core"}]`, Tags{"env:prod", "team=core"}},
+		{"mixed with empties", `["web", {"name": ""}, ""]`, Tags{"web"}},
+		{"null", `null`, Tags{}},
+		{"empty", `[]`, Tags{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var tags Tags
+			require.NoError(t, json.Unmarshal([]byte(tt.input), &tags))
+			assert.Equal(t, tt.expected, tags)
+		})
+	}
+}
+
+func TestTags_UnmarshalJSON_Invalid(t *testing.T) {
+	var tags Tags
+	assert.Error(t, json.Unmarshal([]byte(`"prod"`), &tags))
+	assert.Error(t, json.Unmarshal([]byte(`[1, 2]`), &tags))
+}
+
+func TestTags_InStruct(t *testing.T) {
+	var monitor struct {
+		Name string `json:"name"`
+		Tags Tags   `json:"tags,omitempty"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "API"}`), &monitor))
+	assert.Empty(t, monitor.Tags)
+	assert.Equal(t, "", monitor.Tags.String())
+
+	require.NoError(t, json.Unmarshal([]byte(`{"name": "API", "tags": ["a", "b"]}`), &monitor))
+	assert.Equal(t, "a, b", monitor.Tags.String())
+}
+
+func TestNameTemplateData_Tag(t *testing.T) {
+	d := NameTemplateData{Tags: Tags{"critical", "env:prod", "Team = core"}}
+
+	assert.Equal(t, "prod", d.Tag("env"))
+	assert.Equal(t, "prod", d.Tag("ENV"))
+	assert.Equal(t, "core", d.Tag("team"))
+	assert.Equal(t, "", d.Tag("region"))
+	assert.True(t, d.HasTag("Critical"))
+	assert.False(t, d.HasTag("env"))
+}
+
+func TestParseNameTemplate(t *testing.T) {
+	tmpl, err := ParseNameTemplate("")
+	require.NoError(t, err)
+	assert.Nil(t, tmpl)
+
+	_, err = ParseNameTemplate("{{.Name")
+	assert.Error(t, err)
+}
+
+func TestNameTemplate_Render(t *testing.T) {
+	tests := []struct {
+		name     string
+		template string
+		tags     []string
+		expected string
+	}{
+		{"environment prefix", `[{{.Tag "env" | upper}}] {{.Name}}`, []string{"env:prod"}, "[PROD] API Gateway"},
+		{"tag list suffix", `{{.Name}}{{with .Tags}} ({{join . ", "}}){{end}}`, []string{"web", "eu"}, "API Gateway (web, eu)"},
+		{"no tags", `{{.Name}}{{with .Tags}} ({{join . ", "}}){{end}}`, nil, "API Gateway"},
+		{"missing tag collapses spaces", `{{.Tag "team"}}  {{.Name}}`, nil, "API Gateway"},
+		{"conditional", `{{if .HasTag "critical"}}P1 {{end}}{{.Name}}`, []string{"critical"}, "P1 API Gateway"},
+		{"empty result falls back", `{{.Tag "team"}}`, nil, "API Gateway"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			tmpl, err := ParseNameTemplate(tt.template)
+			require.NoError(t, err)
+
+			got, err := tmpl.Render("API Gateway", tt.tags)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expected, got)
+		})
+	}
+}
+
+func TestNameTemplate_RenderNil(t *testing.T) {
+	var tmpl *NameTemplate
+	got, err := tmpl.Render("API", []string{"prod"})
+	require.NoError(t, err)
+	assert.Equal(t, "API", got)
+}
+
+func TestNameTemplate_RenderError(t *testing.T) {
+	tmpl, err := ParseNameTemplate(`{{.Missing}}`)
+	require.NoError(t, err)
+
+	got, err := tmpl.Render("API", nil)
+	assert.Error(t, err)
+	assert.Equal(t, "API", got)
+}
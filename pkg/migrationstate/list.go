@@ -0,0 +1,230 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrationstate
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/checkpoint"
+)
+
+const (
+	// AllTools lists checkpoints from every migration tool.
+	AllTools = "all"
+	// StatusIncomplete matches checkpoints that can be resumed: in progress or failed.
+	StatusIncomplete = "incomplete"
+)
+
+// migrationIDTimeLayouts are the timestamp suffixes used by
+// checkpoint.GenerateMigrationID, newest first.
+var migrationIDTimeLayouts = []string{"20060102-150405.000", "20060102-150405"}
+
+// ListOptions controls which checkpoints ListCheckpoints prints and how.
+type ListOptions struct {
+	Tool   string // tool name to match; empty or AllTools lists every tool
+	Status string // empty, StatusIncomplete, or a checkpoint status
+	JSON   bool   // write a JSON array to stdout instead of a table
+}
+
+// Validate reports an unsupported status filter.
+func (o ListOptions) Validate() error {
+	switch o.Status {
+	case "", StatusIncomplete, checkpoint.StatusInProgress, checkpoint.StatusCompleted, checkpoint.StatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("invalid --status %q: must be one of %s, %s, %s, %s",
+			o.Status, StatusIncomplete, checkpoint.StatusInProgress, checkpoint.StatusCompleted, checkpoint.StatusFailed)
+	}
+}
+
+// ListFlags holds the checkpoint listing flags shared by the migration tools.
+type ListFlags struct {
+	tool   *string
+	status *string
+	json   *bool
+}
+
+// RegisterListFlags registers --tool, --status and --json on fs. They refine
+// --list-checkpoints; --tool defaults to defaultTool.
+func RegisterListFlags(fs *flag.FlagSet, defaultTool string) *ListFlags {
+	return &ListFlags{
+		tool:   fs.String("tool", defaultTool, "Tool whose checkpoints to list, or \"all\" (use with --list-checkpoints)"),
+		status: fs.String("status", "", "Only list checkpoints with this status: incomplete, in_progress, completed or failed (use with --list-checkpoints)"),
+		json:   fs.Bool("json", false, "Print checkpoints as JSON to stdout (use with --list-checkpoints)"),
+	}
+}
+
+// Options returns the ListOptions selected on the command line.
+func (f *ListFlags) Options() ListOptions {
+	return ListOptions{Tool: *f.tool, Status: *f.status, JSON: *f.json}
+}
+
+// CheckpointSummary is the JSON representation of a checkpoint in --json output.
+type CheckpointSummary struct {
+	MigrationID      string    `json:"migration_id"`
+	Tool             string    `json:"tool"`
+	Status           string    `json:"status"`
+	Started          time.Time `json:"started"`
+	Updated          time.Time `json:"updated"`
+	TotalResources   int       `json:"total_resources"`
+	Processed        int       `json:"processed"`
+	Failed           int       `json:"failed"`
+	HyperpingCreated int       `json:"hyperping_created"`
+	Resumable        bool      `json:"resumable"`
+}
+
+// ListCheckpoints displays available checkpoints matching opts. Tables and
+// messages go to stderr; JSON goes to stdout so scripts can parse it.
+func ListCheckpoints(opts ListOptions) int {
+	if err := opts.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
+	mgr, err := checkpoint.NewManager()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to create checkpoint manager: %v\n", err)
+		return 1
+	}
+
+	checkpoints, err := mgr.List()
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to list checkpoints: %v\n", err)
+		return 1
+	}
+
+	filtered := filterCheckpoints(checkpoints, opts)
+	sortCheckpoints(filtered)
+
+	if opts.JSON {
+		if err := writeCheckpointJSON(os.Stdout, filtered); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: Failed to write checkpoints: %v\n", err)
+			return 1
+		}
+		return 0
+	}
+
+	if len(filtered) == 0 {
+		fmt.Fprintln(os.Stderr, noCheckpointsMessage(opts))
+		return 0
+	}
+
+	if err := writeCheckpointTable(os.Stderr, filtered); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: Failed to write checkpoints: %v\n", err)
+		return 1
+	}
+	return 0
+}
+
+// filterCheckpoints returns checkpoints matching the tool and status filters.
+func filterCheckpoints(checkpoints []*checkpoint.Checkpoint, opts ListOptions) []*checkpoint.Checkpoint {
+	filtered := []*checkpoint.Checkpoint{}
+	for _, cp := range checkpoints {
+		if opts.Tool != "" && opts.Tool != AllTools && cp.Tool != opts.Tool {
+			continue
+		}
+		if !matchesStatus(cp, opts.Status) {
+			continue
+		}
+		filtered = append(filtered, cp)
+	}
+	return filtered
+}
+
+func matchesStatus(cp *checkpoint.Checkpoint, status string) bool {
+	switch status {
+	case "":
+		return true
+	case StatusIncomplete:
+		return isResumable(cp)
+	default:
+		return cp.Status == status
+	}
+}
+
+// isResumable reports whether --resume-id can pick the checkpoint up again.
+func isResumable(cp *checkpoint.Checkpoint) bool {
+	return cp.Status != checkpoint.StatusCompleted
+}
+
+// sortCheckpoints orders checkpoints newest first.
+func sortCheckpoints(checkpoints []*checkpoint.Checkpoint) {
+	sort.SliceStable(checkpoints, func(i, j int) bool {
+		return startedAt(checkpoints[i]).After(startedAt(checkpoints[j]))
+	})
+}
+
+// startedAt returns when the migration started, parsed from the timestamp
+// suffix of its ID. It falls back to the last checkpoint save time for IDs
+// that were not generated by checkpoint.GenerateMigrationID.
+func startedAt(cp *checkpoint.Checkpoint) time.Time {
+	suffix := strings.TrimPrefix(cp.MigrationID, cp.Tool+"-")
+	for _, layout := range migrationIDTimeLayouts {
+		if t, err := time.Parse(layout, suffix); err == nil {
+			return t
+		}
+	}
+	return cp.Timestamp
+}
+
+func noCheckpointsMessage(opts ListOptions) string {
+	var filters []string
+	if opts.Tool != "" && opts.Tool != AllTools {
+		filters = append(filters, "tool: "+opts.Tool)
+	}
+	if opts.Status != "" {
+		filters = append(filters, "status: "+opts.Status)
+	}
+	if len(filters) == 0 {
+		return "No checkpoints found"
+	}
+	return "No checkpoints found for " + strings.Join(filters, ", ")
+}
+
+// writeCheckpointTable writes one row per checkpoint.
+func writeCheckpointTable(w io.Writer, checkpoints []*checkpoint.Checkpoint) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+	fmt.Fprintln(tw, "ID\tTOOL\tSTARTED\tPROGRESS\tSTATUS")
+	for _, cp := range checkpoints {
+		progress := fmt.Sprintf("%d/%d", cp.Processed, cp.TotalResources)
+		if cp.Failed > 0 {
+			progress += fmt.Sprintf(" (%d failed)", cp.Failed)
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\n",
+			cp.MigrationID, cp.Tool, startedAt(cp).Format("2006-01-02 15:04:05"), progress, cp.Status)
+	}
+	return tw.Flush()
+}
+
+// writeCheckpointJSON writes checkpoints as an indented JSON array. An empty
+// list is written as [] rather than null.
+func writeCheckpointJSON(w io.Writer, checkpoints []*checkpoint.Checkpoint) error {
+	summaries := make([]CheckpointSummary, 0, len(checkpoints))
+	for _, cp := range checkpoints {
+		summaries = append(summaries, CheckpointSummary{
+			MigrationID:      cp.MigrationID,
+			Tool:             cp.Tool,
+			Status:           cp.Status,
+			Started:          startedAt(cp),
+			Updated:          cp.Timestamp,
+			TotalResources:   cp.TotalResources,
+			Processed:        cp.Processed,
+			Failed:           cp.Failed,
+			HyperpingCreated: len(cp.HyperpingCreated),
+			Resumable:        isResumable(cp),
+		})
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(summaries)
+}
@@ -0,0 +1,166 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrationstate
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/checkpoint"
+)
+
+func testCheckpoints() []*checkpoint.Checkpoint {
+	updated := time.Date(2026, 2, 14, 9, 0, 0, 0, time.UTC)
+	return []*checkpoint.Checkpoint{
+		{MigrationID: "pingdom-20260210-080000.000", Tool: "pingdom", Status: checkpoint.StatusCompleted, TotalResources: 5, Processed: 5, Timestamp: updated},
+		{MigrationID: "betterstack-20260213-120000.000", Tool: "betterstack", Status: checkpoint.StatusInProgress, TotalResources: 40, Processed: 12, Failed: 2, Timestamp: updated},
+		{MigrationID: "betterstack-20260211-100000", Tool: "betterstack", Status: checkpoint.StatusFailed, TotalResources: 8, Processed: 3, Timestamp: updated,
+			HyperpingCreated: []checkpoint.CreatedResource{{UUID: "mon_1", Type: "monitor"}}},
+		{MigrationID: "custom-id", Tool: "uptimerobot", Status: checkpoint.StatusCompleted, Timestamp: updated},
+	}
+}
+
+func migrationIDs(checkpoints []*checkpoint.Checkpoint) []string {
+	ids := make([]string, len(checkpoints))
+	for i, cp := range checkpoints {
+		ids[i] = cp.MigrationID
+	}
+	return ids
+}
+
+func TestFilterCheckpoints(t *testing.T) {
+	tests := []struct {
+		name string
+		opts ListOptions
+		want []string
+	}{
+		{"no filters", ListOptions{}, []string{"pingdom-20260210-080000.000", "betterstack-20260213-120000.000", "betterstack-20260211-100000", "custom-id"}},
+		{"all tools", ListOptions{Tool: AllTools}, []string{"pingdom-20260210-080000.000", "betterstack-20260213-120000.000", "betterstack-20260211-100000", "custom-id"}},
+		{"tool", ListOptions{Tool: "betterstack"}, []string{"betterstack-20260213-120000.000", "betterstack-20260211-100000"}},
+		{"incomplete", ListOptions{Status: StatusIncomplete}, []string{"betterstack-20260213-120000.000", "betterstack-20260211-100000"}},
+		{"exact status", ListOptions{Status: checkpoint.StatusCompleted}, []string{"pingdom-20260210-080000.000", "custom-id"}},
+		{"tool and status", ListOptions{Tool: "pingdom", Status: StatusIncomplete}, []string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := migrationIDs(filterCheckpoints(testCheckpoints(), tt.opts))
+			if strings.Join(got, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("filterCheckpoints() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestListOptions_Validate(t *testing.T) {
+	for _, status := range []string{"", StatusIncomplete, checkpoint.StatusInProgress, checkpoint.StatusCompleted, checkpoint.StatusFailed} {
+		if err := (ListOptions{Status: status}).Validate(); err != nil {
+			t.Errorf("Validate(%q) returned error: %v", status, err)
+		}
+	}
+	if err := (ListOptions{Status: "done"}).Validate(); err == nil {
+		t.Error("Expected error for unknown status")
+	}
+}
+
+func TestSortCheckpoints_NewestFirst(t *testing.T) {
+	cps := testCheckpoints()
+	sortCheckpoints(cps)
+
+	want := []string{"custom-id", "betterstack-20260213-120000.000", "betterstack-20260211-100000", "pingdom-20260210-080000.000"}
+	if got := migrationIDs(cps); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("sortCheckpoints() = %v, want %v", got, want)
+	}
+}
+
+func TestWriteCheckpointTable(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeCheckpointTable(&buf, testCheckpoints()[1:3]); err != nil {
+		t.Fatal(err)
+	}
+
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	if len(lines) != 3 {
+		t.Fatalf("Expected header and 2 rows, got:\n%s", buf.String())
+	}
+	if fields := strings.Fields(lines[0]); strings.Join(fields, " ") != "ID TOOL STARTED PROGRESS STATUS" {
+		t.Errorf("unexpected header: %q", lines[0])
+	}
+	for _, want := range []string{"betterstack-20260213-120000.000", "2026-02-13 12:00:00", "12/40 (2 failed)", "in_progress"} {
+		if !strings.Contains(lines[1], want) {
+			t.Errorf("row %q missing %q", lines[1], want)
+		}
+	}
+	if !strings.Contains(lines[2], "3/8 ") || strings.Contains(lines[2], "failed)") {
+		t.Errorf("unexpected progress in row %q", lines[2])
+	}
+	if strings.Index(lines[1], "in_progress") != strings.Index(lines[0], "STATUS") {
+		t.Errorf("columns not aligned:\n%s", buf.String())
+	}
+}
+
+func TestWriteCheckpointJSON(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeCheckpointJSON(&buf, testCheckpoints()[2:3]); err != nil {
+		t.Fatal(err)
+	}
+
+	var got []CheckpointSummary
+	if err := json.Unmarshal(buf.Bytes(), &got); err != nil {
+		t.Fatalf("invalid JSON: %v\n%s", err, buf.String())
+	}
+	if len(got) != 1 {
+		t.Fatalf("got %d entries, want 1", len(got))
+	}
+
+	s := got[0]
+	if s.MigrationID != "betterstack-20260211-100000" || !s.Resumable || s.HyperpingCreated != 1 || s.Processed != 3 {
+		t.Errorf("unexpected summary: %+v", s)
+	}
+	if want := time.Date(2026, 2, 11, 10, 0, 0, 0, time.UTC); !s.Started.Equal(want) {
+		t.Errorf("Started = %v, want %v", s.Started, want)
+	}
+}
+
+func TestWriteCheckpointJSON_Empty(t *testing.T) {
+	var buf bytes.Buffer
+	if err := writeCheckpointJSON(&buf, nil); err != nil {
+		t.Fatal(err)
+	}
+	if strings.TrimSpace(buf.String()) != "[]" {
+		t.Errorf("Expected empty array, got %q", buf.String())
+	}
+}
+
+func TestRegisterListFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	listFlags := RegisterListFlags(fs, "pingdom")
+
+	if err := fs.Parse(nil); err != nil {
+		t.Fatal(err)
+	}
+	if opts := listFlags.Options(); opts.Tool != "pingdom" || opts.Status != "" || opts.JSON {
+		t.Errorf("unexpected defaults: %+v", opts)
+	}
+
+	if err := fs.Parse([]string{"--tool=all", "--status=incomplete", "--json"}); err != nil {
+		t.Fatal(err)
+	}
+	if opts := listFlags.Options(); opts.Tool != AllTools || opts.Status != StatusIncomplete || !opts.JSON {
+		t.Errorf("unexpected options: %+v", opts)
+	}
+}
+
+func TestNoCheckpointsMessage(t *testing.T) {
+	if got := noCheckpointsMessage(ListOptions{Tool: AllTools}); got != "No checkpoints found" {
+		t.Errorf("got %q", got)
+	}
+	if got := noCheckpointsMessage(ListOptions{Tool: "pingdom", Status: StatusIncomplete}); got != "No checkpoints found for tool: pingdom, status: incomplete" {
+		t.Errorf("got %q", got)
+	}
+}
@@ -4,19 +4,109 @@
 package migrationstate
 
 import (
+	"bufio"
+	"bytes"
 	"context"
+	"errors"
+	"flag"
 	"fmt"
+	"io/fs"
 	"os"
+	"os/exec"
+	"strings"
 	"time"
 
 	hyperping "github.com/develeap/hyperping-go"
 
 	"github.com/develeap/terraform-provider-hyperping/pkg/checkpoint"
+	"github.com/develeap/terraform-provider-hyperping/pkg/clientenv"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
 	"github.com/develeap/terraform-provider-hyperping/pkg/recovery"
 )
 
+// What --rollback-files does with the files a migration generated.
+const (
+	RollbackFilesKeep   = "keep"
+	RollbackFilesRemove = "remove"
+	RollbackFilesRename = "rename"
+)
+
+// rolledBackSuffix is appended to generated files by RollbackFilesRename.
+// Terraform only loads files ending in .tf, so renamed configuration is no
+// longer planned but stays available for inspection.
+const rolledBackSuffix = ".rolled-back"
+
+// RollbackOptions controls what PerformRollback cleans up besides the
+// Hyperping resources.
+type RollbackOptions struct {
+	StateDir string // Terraform working directory to remove imported addresses from; empty leaves the state alone
+	Files    string // empty or RollbackFilesKeep, RollbackFilesRemove, or RollbackFilesRename
+	// MappingFile is the mapping file the migration wrote. Rollback takes
+	// missing Terraform addresses from it and marks the deleted resources as
+	// rolled back in it. Empty or missing files are ignored.
+	MappingFile string
+}
+
+// Validate reports an unsupported --rollback-files mode.
+func (o RollbackOptions) Validate() error {
+	switch o.Files {
+	case "", RollbackFilesKeep, RollbackFilesRemove, RollbackFilesRename:
+		return nil
+	default:
+		return fmt.Errorf("invalid --rollback-files %q: must be one of %s, %s, %s",
+			o.Files, RollbackFilesKeep, RollbackFilesRemove, RollbackFilesRename)
+	}
+}
+
+// cleansFiles reports whether the options remove or rename generated files.
+func (o RollbackOptions) cleansFiles() bool {
+	return o.Files == RollbackFilesRemove || o.Files == RollbackFilesRename
+}
+
+// RollbackFlags holds the rollback cleanup flags shared by the migration tools.
+type RollbackFlags struct {
+	stateDir *string
+	files    *string
+}
+
+// RegisterRollbackFlags registers --rollback-state-dir and --rollback-files
+// on fs. They refine --rollback.
+func RegisterRollbackFlags(fs *flag.FlagSet) *RollbackFlags {
+	return &RollbackFlags{
+		stateDir: fs.String("rollback-state-dir", "", "Terraform working directory to remove the rolled-back resources from with terraform state rm (use with --rollback)"),
+		files:    fs.String("rollback-files", RollbackFilesKeep, "What to do with the files the migration generated: keep, remove, or rename (appends .rolled-back) (use with --rollback)"),
+	}
+}
+
+// Options returns the RollbackOptions selected on the command line.
+func (f *RollbackFlags) Options() RollbackOptions {
+	return RollbackOptions{StateDir: *f.stateDir, Files: *f.files}
+}
+
+// rollbackResult counts what a rollback did, for the summary.
+type rollbackResult struct {
+	deleted, failed           int
+	stateRemoved, stateFailed int
+	filesCleaned, filesFailed int
+	filesMode                 string
+	filesKept                 bool
+}
+
+// succeeded reports whether every step of the rollback succeeded.
+func (r rollbackResult) succeeded() bool {
+	return r.failed == 0 && r.stateFailed == 0 && r.filesFailed == 0
+}
+
 // PerformRollback deletes Hyperping resources created during a migration run.
-func PerformRollback(migrationID string, hyperpingAPIKey string, force bool, logger *recovery.Logger) int {
+// Depending on opts it also removes their imported addresses from the
+// Terraform state and removes or renames the files the run generated, so the
+// working directory plans cleanly again.
+func PerformRollback(migrationID string, hyperpingAPIKey string, force bool, opts RollbackOptions, logger *recovery.Logger) int {
+	if err := opts.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		return 1
+	}
+
 	logger.Info("Starting rollback for migration: %s", migrationID)
 
 	mgr, err := checkpoint.NewManager()
@@ -33,56 +123,145 @@ func PerformRollback(migrationID string, hyperpingAPIKey string, force bool, log
 		return 1
 	}
 
-	if len(cp.HyperpingCreated) == 0 {
+	idMap, err := mapping.Load(opts.MappingFile)
+	if err != nil {
+		logger.Warn("Ignoring mapping file: %v", err)
+		fmt.Fprintf(os.Stderr, "Warning: Ignoring mapping file: %v\n", err)
+	}
+	cp.HyperpingCreated = withMappedAddresses(cp.HyperpingCreated, idMap)
+
+	cleanFiles := opts.cleansFiles() && len(cp.GeneratedFiles) > 0
+	if len(cp.HyperpingCreated) == 0 && !cleanFiles {
 		logger.Info("No Hyperping resources to delete")
 		fmt.Fprintln(os.Stderr, "No Hyperping resources were created in this migration")
 		return 0
 	}
 
 	if !force {
-		if !confirmRollback(cp.HyperpingCreated) {
+		if !confirmRollback(cp, opts, idMap) {
 			logger.Info("Rollback cancelled by user")
 			fmt.Fprintln(os.Stderr, "Rollback cancelled")
 			return 0
 		}
 	}
 
-	hpClient := hyperping.NewClient(hyperpingAPIKey)
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Minute)
 	defer cancel()
 
-	backoff := recovery.DefaultBackoff()
-	deletedCount, failedCount := deleteResources(ctx, cp.HyperpingCreated, hpClient, backoff, logger)
+	var result rollbackResult
+	var deleted []checkpoint.CreatedResource
+	if len(cp.HyperpingCreated) > 0 {
+		hpClient := clientenv.NewClient(hyperpingAPIKey)
+		backoff := recovery.DefaultBackoff()
+		deleted, result.failed = deleteResources(ctx, cp.HyperpingCreated, hpClient, backoff, logger)
+		result.deleted = len(deleted)
+		recordRollback(idMap, opts.MappingFile, deleted, logger)
+	}
+
+	if opts.StateDir != "" {
+		result.stateRemoved, result.stateFailed = removeFromState(ctx, opts.StateDir, deleted, logger)
+	}
 
-	logger.Info("Rollback complete: %d deleted, %d failed", deletedCount, failedCount)
-	return finalizeRollback(mgr, migrationID, deletedCount, failedCount, logger)
+	if cleanFiles {
+		result.filesMode = opts.Files
+		if result.failed == 0 {
+			result.filesCleaned, result.filesFailed = cleanGeneratedFiles(cp.GeneratedFiles, opts.Files, logger)
+		} else {
+			// The configuration still describes the resources that could not be deleted.
+			result.filesKept = true
+		}
+	}
+
+	logger.Info("Rollback complete: %d deleted, %d failed", result.deleted, result.failed)
+	return finalizeRollback(mgr, migrationID, result, logger)
 }
 
-// confirmRollback prints the resource list and asks for user confirmation.
-// Returns true if the user confirmed, false if they cancelled.
-func confirmRollback(resources []checkpoint.CreatedResource) bool {
-	fmt.Fprintf(os.Stderr, "\nThis will delete %d resources from Hyperping:\n", len(resources))
+// withMappedAddresses fills in the Terraform addresses that the checkpoint
+// does not record, because it was saved by an older version or before the
+// import script was written, from the mapping file.
+func withMappedAddresses(resources []checkpoint.CreatedResource, idMap *mapping.Mapping) []checkpoint.CreatedResource {
 	for i, r := range resources {
-		if i < 10 {
-			fmt.Fprintf(os.Stderr, "  - %s (%s)\n", r.UUID, r.Type)
-		} else if i == 10 {
-			fmt.Fprintf(os.Stderr, "  ... and %d more\n", len(resources)-10)
-			break
+		if r.Address != "" {
+			continue
+		}
+		if entry, ok := idMap.LookupUUID(r.UUID); ok {
+			resources[i].Address = entry.Address
+		}
+	}
+	return resources
+}
+
+// recordRollback marks the deleted resources as rolled back in the mapping
+// file, so it still traces them to their origin after the rollback.
+func recordRollback(idMap *mapping.Mapping, path string, deleted []checkpoint.CreatedResource, logger *recovery.Logger) {
+	now := time.Now()
+	changed := false
+	for _, r := range deleted {
+		if idMap.MarkRolledBack(r.UUID, now) {
+			changed = true
+		}
+	}
+	if !changed {
+		return
+	}
+	if err := idMap.Write(path); err != nil {
+		logger.Warn("Failed to update mapping file: %v", err)
+		fmt.Fprintf(os.Stderr, "Warning: Failed to update mapping file: %v\n", err)
+		return
+	}
+	logger.Info("Marked rolled-back resources in %s", path)
+}
+
+// confirmRollback prints what the rollback will do and asks for user confirmation.
+// Returns true if the user confirmed, false if they cancelled.
+func confirmRollback(cp *checkpoint.Checkpoint, opts RollbackOptions, idMap *mapping.Mapping) bool {
+	resources := cp.HyperpingCreated
+	if len(resources) > 0 {
+		fmt.Fprintf(os.Stderr, "\nThis will delete %d resources from Hyperping:\n", len(resources))
+		for i, r := range resources {
+			if i < 10 {
+				if entry, ok := idMap.LookupUUID(r.UUID); ok {
+					fmt.Fprintf(os.Stderr, "  - %s (%s, source ID %s)\n", r.UUID, r.Type, entry.SourceID)
+					continue
+				}
+				fmt.Fprintf(os.Stderr, "  - %s (%s)\n", r.UUID, r.Type)
+			} else if i == 10 {
+				fmt.Fprintf(os.Stderr, "  ... and %d more\n", len(resources)-10)
+				break
+			}
+		}
+	}
+	if opts.StateDir != "" {
+		fmt.Fprintf(os.Stderr, "\nDeleted resources will be removed from the Terraform state in %s.\n", opts.StateDir)
+	}
+	if opts.cleansFiles() && len(cp.GeneratedFiles) > 0 {
+		fmt.Fprintf(os.Stderr, "\nThese generated files will be %s:\n", fileActionPast(opts.Files))
+		for _, path := range cp.GeneratedFiles {
+			fmt.Fprintf(os.Stderr, "  - %s\n", path)
 		}
 	}
 	fmt.Fprintln(os.Stderr)
 
-	return recovery.ConfirmAction("Are you sure you want to delete these resources?", false)
+	return recovery.ConfirmAction("Are you sure you want to roll back this migration?", false)
+}
+
+// fileActionPast describes a --rollback-files mode in the past tense.
+func fileActionPast(mode string) string {
+	if mode == RollbackFilesRename {
+		return "renamed with a " + rolledBackSuffix + " suffix"
+	}
+	return "removed"
 }
 
 // deleteResources iterates over created resources and deletes each by dispatching on type.
+// It returns the resources that were deleted and the number that failed.
 func deleteResources(
 	ctx context.Context,
 	resources []checkpoint.CreatedResource,
 	hpClient *hyperping.Client,
 	backoff *recovery.ExponentialBackoff,
 	logger *recovery.Logger,
-) (deletedCount, failedCount int) {
+) (deleted []checkpoint.CreatedResource, failedCount int) {
 	logger.Info("Deleting %d Hyperping resources...", len(resources))
 
 	for _, r := range resources {
@@ -99,11 +278,11 @@ func deleteResources(
 			continue
 		}
 
-		deletedCount++
+		deleted = append(deleted, r)
 		logger.Debug("Successfully deleted %s resource: %s", r.Type, r.UUID)
 	}
 
-	return deletedCount, failedCount
+	return deleted, failedCount
 }
 
 // deleteByType dispatches the delete call based on the resource type.
@@ -116,83 +295,149 @@ func deleteByType(ctx context.Context, hpClient *hyperping.Client, r checkpoint.
 	}
 }
 
-// finalizeRollback prints the result summary and cleans up the checkpoint if successful.
-func finalizeRollback(mgr *checkpoint.Manager, migrationID string, deletedCount, failedCount int, logger *recovery.Logger) int {
-	if failedCount == 0 {
-		if err := mgr.Delete(migrationID); err != nil {
-			logger.Warn("Failed to delete checkpoint file: %v", err)
-		} else {
-			logger.Info("Checkpoint file deleted")
-		}
-	}
-
-	fmt.Fprintln(os.Stderr, "\n=== Rollback Complete ===")
-	fmt.Fprintf(os.Stderr, "Deleted: %d resources\n", deletedCount)
-	if failedCount > 0 {
-		fmt.Fprintf(os.Stderr, "Failed: %d resources\n", failedCount)
-		fmt.Fprintln(os.Stderr, "\nSome resources could not be deleted. You may need to delete them manually.")
-		return 1
+// terraformOutput runs terraform in dir and returns its standard output. It is
+// a variable so tests can run without the terraform CLI.
+var terraformOutput = func(ctx context.Context, dir string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "terraform", append([]string{"-chdir=" + dir}, args...)...) // #nosec G204 -- args are checkpoint addresses and an operator-supplied directory
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	output, err := cmd.Output()
+	if err != nil && stderr.Len() > 0 {
+		err = fmt.Errorf("%w: %s", err, strings.TrimSpace(stderr.String()))
 	}
-
-	fmt.Fprintln(os.Stderr, "\nAll resources successfully deleted")
-	return 0
+	return output, err
 }
 
-// ListCheckpoints displays available checkpoints, optionally filtered by tool name.
-func ListCheckpoints(tool string) int {
-	mgr, err := checkpoint.NewManager()
-	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to create checkpoint manager: %v\n", err)
-		return 1
+// removeFromState removes the addresses the deleted resources were imported
+// to from the Terraform state in dir. Addresses the state does not hold,
+// because the import script never ran there, are skipped.
+func removeFromState(ctx context.Context, dir string, deleted []checkpoint.CreatedResource, logger *recovery.Logger) (removed, failed int) {
+	var addresses []string
+	unrecorded := 0
+	for _, r := range deleted {
+		if r.Address == "" {
+			unrecorded++
+			continue
+		}
+		addresses = append(addresses, r.Address)
+	}
+	if unrecorded > 0 {
+		// Checkpoints saved by older versions, or by runs that stopped before
+		// writing the import script, have no addresses.
+		logger.Warn("%d deleted resources have no recorded Terraform address", unrecorded)
+		fmt.Fprintf(os.Stderr, "Warning: %d deleted resources have no recorded Terraform address; remove them from the state with terraform state rm\n", unrecorded)
+	}
+	if len(addresses) == 0 {
+		return 0, 0
 	}
 
-	checkpoints, err := mgr.List()
+	output, err := terraformOutput(ctx, dir, "state", "list")
 	if err != nil {
-		fmt.Fprintf(os.Stderr, "Error: Failed to list checkpoints: %v\n", err)
-		return 1
+		logger.Error("Failed to list Terraform state in %s: %v", dir, err)
+		fmt.Fprintf(os.Stderr, "Warning: Failed to list Terraform state in %s: %v\n", dir, err)
+		return 0, len(addresses)
 	}
+	managed := stateAddresses(string(output))
 
-	if len(checkpoints) == 0 {
-		fmt.Fprintln(os.Stderr, "No checkpoints found")
-		return 0
+	for _, address := range addresses {
+		if !managed[address] {
+			logger.Debug("%s is not in the Terraform state", address)
+			continue
+		}
+		if _, err := terraformOutput(ctx, dir, "state", "rm", address); err != nil {
+			logger.Error("Failed to remove %s from the Terraform state: %v", address, err)
+			fmt.Fprintf(os.Stderr, "Warning: Failed to remove %s from the Terraform state: %v\n", address, err)
+			failed++
+			continue
+		}
+		removed++
+		logger.Info("Removed %s from the Terraform state", address)
 	}
+	return removed, failed
+}
 
-	filtered := filterCheckpoints(checkpoints, tool)
-	if len(filtered) == 0 {
-		fmt.Fprintf(os.Stderr, "No checkpoints found for tool: %s\n", tool)
-		return 0
+// stateAddresses parses the output of terraform state list into a set.
+func stateAddresses(output string) map[string]bool {
+	addresses := make(map[string]bool)
+	scanner := bufio.NewScanner(strings.NewReader(output))
+	for scanner.Scan() {
+		if address := strings.TrimSpace(scanner.Text()); address != "" {
+			addresses[address] = true
+		}
 	}
-
-	printCheckpoints(filtered)
-	return 0
+	return addresses
 }
 
-// filterCheckpoints returns checkpoints matching tool (or all if tool is empty).
-func filterCheckpoints(checkpoints []*checkpoint.Checkpoint, tool string) []*checkpoint.Checkpoint {
-	if tool == "" {
-		return checkpoints
-	}
-	var filtered []*checkpoint.Checkpoint
-	for _, cp := range checkpoints {
-		if cp.Tool == tool {
-			filtered = append(filtered, cp)
+// cleanGeneratedFiles removes or renames the generated files according to
+// mode. Files that no longer exist are skipped.
+func cleanGeneratedFiles(paths []string, mode string, logger *recovery.Logger) (cleaned, failed int) {
+	for _, path := range paths {
+		var err error
+		if mode == RollbackFilesRename {
+			err = os.Rename(path, path+rolledBackSuffix)
+		} else {
+			err = os.Remove(path)
+		}
+
+		switch {
+		case errors.Is(err, fs.ErrNotExist):
+			logger.Debug("Generated file no longer exists: %s", path)
+		case err != nil:
+			logger.Error("Failed to clean up %s: %v", path, err)
+			fmt.Fprintf(os.Stderr, "Warning: Failed to clean up %s: %v\n", path, err)
+			failed++
+		default:
+			cleaned++
+			logger.Debug("Generated file %s: %s", fileActionPast(mode), path)
 		}
 	}
-	return filtered
+	return cleaned, failed
 }
 
-// printCheckpoints writes checkpoint details to stderr.
-func printCheckpoints(checkpoints []*checkpoint.Checkpoint) {
-	fmt.Fprintf(os.Stderr, "Available checkpoints:\n\n")
-	for _, cp := range checkpoints {
-		fmt.Fprintf(os.Stderr, "Migration ID: %s\n", cp.MigrationID)
-		fmt.Fprintf(os.Stderr, "  Tool: %s\n", cp.Tool)
-		fmt.Fprintf(os.Stderr, "  Status: %s\n", cp.Status)
-		fmt.Fprintf(os.Stderr, "  Timestamp: %s\n", cp.Timestamp.Format("2006-01-02 15:04:05"))
-		fmt.Fprintf(os.Stderr, "  Progress: %d/%d processed (%d failed)\n", cp.Processed, cp.TotalResources, cp.Failed)
-		if len(cp.HyperpingCreated) > 0 {
-			fmt.Fprintf(os.Stderr, "  Hyperping resources: %d created\n", len(cp.HyperpingCreated))
+// finalizeRollback prints the result summary and cleans up the checkpoint if successful.
+func finalizeRollback(mgr *checkpoint.Manager, migrationID string, result rollbackResult, logger *recovery.Logger) int {
+	if result.succeeded() {
+		if err := mgr.Delete(migrationID); err != nil {
+			logger.Warn("Failed to delete checkpoint file: %v", err)
+		} else {
+			logger.Info("Checkpoint file deleted")
 		}
-		fmt.Fprintln(os.Stderr)
 	}
+
+	fmt.Fprintln(os.Stderr, "\n=== Rollback Complete ===")
+	fmt.Fprintf(os.Stderr, "Deleted: %d resources\n", result.deleted)
+	if result.failed > 0 {
+		fmt.Fprintf(os.Stderr, "Failed: %d resources\n", result.failed)
+	}
+	if result.stateRemoved > 0 || result.stateFailed > 0 {
+		fmt.Fprintf(os.Stderr, "Removed from state: %d addresses\n", result.stateRemoved)
+	}
+	if result.stateFailed > 0 {
+		fmt.Fprintf(os.Stderr, "Failed to remove from state: %d addresses\n", result.stateFailed)
+	}
+	if result.filesMode != "" && !result.filesKept {
+		fmt.Fprintf(os.Stderr, "Generated files %s: %d\n", fileActionPast(result.filesMode), result.filesCleaned)
+	}
+	if result.filesFailed > 0 {
+		fmt.Fprintf(os.Stderr, "Failed to clean up: %d files\n", result.filesFailed)
+	}
+
+	if result.failed > 0 {
+		fmt.Fprintln(os.Stderr, "\nSome resources could not be deleted. You may need to delete them manually.")
+		if result.filesKept {
+			fmt.Fprintln(os.Stderr, "Generated files were kept because they still describe those resources.")
+		}
+	}
+	if result.stateFailed > 0 {
+		fmt.Fprintln(os.Stderr, "\nSome addresses could not be removed from the Terraform state. Remove them with terraform state rm.")
+	}
+	if result.filesFailed > 0 {
+		fmt.Fprintln(os.Stderr, "\nSome generated files could not be cleaned up. Remove them manually.")
+	}
+	if !result.succeeded() {
+		return 1
+	}
+
+	fmt.Fprintln(os.Stderr, "\nRollback completed successfully")
+	return 0
 }
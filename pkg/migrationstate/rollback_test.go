@@ -0,0 +1,213 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrationstate
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/checkpoint"
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+	"github.com/develeap/terraform-provider-hyperping/pkg/recovery"
+)
+
+func testLogger(t *testing.T) *recovery.Logger {
+	t.Helper()
+	logger, err := recovery.NewLogger(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return logger
+}
+
+// stubTerraform replaces the terraform CLI for the duration of the test. It
+// records each invocation and answers state list with stateList.
+func stubTerraform(t *testing.T, stateList string, failRm map[string]bool) *[]string {
+	t.Helper()
+	var calls []string
+	original := terraformOutput
+	terraformOutput = func(_ context.Context, dir string, args ...string) ([]byte, error) {
+		calls = append(calls, dir+": "+strings.Join(args, " "))
+		if len(args) == 3 && args[1] == "rm" && failRm[args[2]] {
+			return nil, errors.New("state locked")
+		}
+		if len(args) == 2 && args[1] == "list" {
+			return []byte(stateList), nil
+		}
+		return nil, nil
+	}
+	t.Cleanup(func() { terraformOutput = original })
+	return &calls
+}
+
+func TestRollbackOptions_Validate(t *testing.T) {
+	for _, files := range []string{"", RollbackFilesKeep, RollbackFilesRemove, RollbackFilesRename} {
+		if err := (RollbackOptions{Files: files}).Validate(); err != nil {
+			t.Errorf("Validate(%q) error = %v", files, err)
+		}
+	}
+	if err := (RollbackOptions{Files: "delete"}).Validate(); err == nil {
+		t.Error("Validate(\"delete\") error = nil, want an error")
+	}
+}
+
+func TestRegisterRollbackFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	flags := RegisterRollbackFlags(fs)
+
+	if got := flags.Options(); got != (RollbackOptions{Files: RollbackFilesKeep}) {
+		t.Errorf("default Options() = %+v", got)
+	}
+
+	if err := fs.Parse([]string{"--rollback-state-dir=infra", "--rollback-files=rename"}); err != nil {
+		t.Fatal(err)
+	}
+	want := RollbackOptions{StateDir: "infra", Files: RollbackFilesRename}
+	if got := flags.Options(); got != want {
+		t.Errorf("Options() = %+v, want %+v", got, want)
+	}
+}
+
+func TestRemoveFromState(t *testing.T) {
+	calls := stubTerraform(t, "hyperping_monitor.api\nhyperping_monitor.db\nhyperping_monitor.web\n",
+		map[string]bool{"hyperping_monitor.db": true})
+
+	deleted := []checkpoint.CreatedResource{
+		{UUID: "mon_api", Type: "monitor", Address: "hyperping_monitor.api"},
+		{UUID: "mon_db", Type: "monitor", Address: "hyperping_monitor.db"},
+		{UUID: "mon_new", Type: "monitor", Address: "hyperping_monitor.never_imported"},
+		{UUID: "mon_legacy", Type: "monitor"},
+	}
+	removed, failed := removeFromState(context.Background(), "infra", deleted, testLogger(t))
+
+	if removed != 1 || failed != 1 {
+		t.Errorf("removeFromState() = %d removed, %d failed, want 1 and 1", removed, failed)
+	}
+	want := []string{
+		"infra: state list",
+		"infra: state rm hyperping_monitor.api",
+		"infra: state rm hyperping_monitor.db",
+	}
+	if !reflect.DeepEqual(*calls, want) {
+		t.Errorf("terraform calls = %v, want %v", *calls, want)
+	}
+}
+
+func TestRemoveFromState_NoAddresses(t *testing.T) {
+	calls := stubTerraform(t, "", nil)
+
+	deleted := []checkpoint.CreatedResource{{UUID: "mon_legacy", Type: "monitor"}}
+	removed, failed := removeFromState(context.Background(), "infra", deleted, testLogger(t))
+
+	if removed != 0 || failed != 0 || len(*calls) != 0 {
+		t.Errorf("removeFromState() = %d removed, %d failed, calls %v; want no terraform calls", removed, failed, *calls)
+	}
+}
+
+func TestRollbackMapping(t *testing.T) {
+	path := filepath.Join(t.TempDir(), mapping.DefaultFileName)
+	idMap := mapping.New("Pingdom", "pingdom", "pingdom-20260213-120000.000")
+	idMap.Add(mapping.Entry{SourceID: "101", ResourceType: mapping.ResourceTypeMonitor, Address: "hyperping_monitor.api", UUID: "mon_api"})
+	idMap.Add(mapping.Entry{SourceID: "102", ResourceType: mapping.ResourceTypeMonitor, Address: "hyperping_monitor.web", UUID: "mon_web"})
+
+	resources := withMappedAddresses([]checkpoint.CreatedResource{
+		{UUID: "mon_api", Type: "monitor"},
+		{UUID: "mon_web", Type: "monitor", Address: "hyperping_monitor.recorded"},
+		{UUID: "mon_unmapped", Type: "monitor"},
+	}, idMap)
+	want := []string{"hyperping_monitor.api", "hyperping_monitor.recorded", ""}
+	for i, r := range resources {
+		if r.Address != want[i] {
+			t.Errorf("resources[%d].Address = %q, want %q", i, r.Address, want[i])
+		}
+	}
+
+	recordRollback(idMap, path, resources[:1], testLogger(t))
+
+	written, err := mapping.Load(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if api, _ := written.LookupUUID("mon_api"); api.RolledBackAt == nil {
+		t.Error("deleted resource is not marked rolled back")
+	}
+	if web, _ := written.LookupUUID("mon_web"); web.RolledBackAt != nil {
+		t.Error("resource that was not deleted is marked rolled back")
+	}
+}
+
+func TestCleanGeneratedFiles(t *testing.T) {
+	dir := t.TempDir()
+	write := func(name string) string {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte("# generated\n"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	t.Run("remove", func(t *testing.T) {
+		paths := []string{write("monitors.tf"), write("import.sh"), filepath.Join(dir, "gone.tf")}
+		cleaned, failed := cleanGeneratedFiles(paths, RollbackFilesRemove, testLogger(t))
+		if cleaned != 2 || failed != 0 {
+			t.Errorf("cleanGeneratedFiles() = %d cleaned, %d failed, want 2 and 0", cleaned, failed)
+		}
+		for _, path := range paths {
+			if _, err := os.Stat(path); !os.IsNotExist(err) {
+				t.Errorf("%s still exists", path)
+			}
+		}
+	})
+
+	t.Run("rename", func(t *testing.T) {
+		path := write("monitors.tf")
+		cleaned, failed := cleanGeneratedFiles([]string{path}, RollbackFilesRename, testLogger(t))
+		if cleaned != 1 || failed != 0 {
+			t.Errorf("cleanGeneratedFiles() = %d cleaned, %d failed, want 1 and 0", cleaned, failed)
+		}
+		if _, err := os.Stat(path); !os.IsNotExist(err) {
+			t.Errorf("%s still exists", path)
+		}
+		if _, err := os.Stat(path + rolledBackSuffix); err != nil {
+			t.Errorf("renamed file missing: %v", err)
+		}
+	})
+}
+
+// TestPerformRollback_FilesOnly covers checkpoints of tools that only
+// generate configuration: there is nothing to delete, but the generated files
+// are still cleaned up and the checkpoint is removed.
+func TestPerformRollback_FilesOnly(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	mgr, err := checkpoint.NewManager()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	generated := filepath.Join(t.TempDir(), "monitors.tf")
+	if err := os.WriteFile(generated, []byte("# generated\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cp := &checkpoint.Checkpoint{MigrationID: "betterstack-20260213-120000.000", Tool: "betterstack", Status: checkpoint.StatusCompleted}
+	cp.AddGeneratedFile(generated)
+	if err := mgr.Save(cp); err != nil {
+		t.Fatal(err)
+	}
+
+	if code := PerformRollback(cp.MigrationID, "sk_test", true, RollbackOptions{Files: RollbackFilesRemove}, testLogger(t)); code != 0 {
+		t.Fatalf("PerformRollback() = %d, want 0", code)
+	}
+	if _, err := os.Stat(generated); !os.IsNotExist(err) {
+		t.Errorf("%s still exists", generated)
+	}
+	if mgr.Exists(cp.MigrationID) {
+		t.Error("checkpoint still exists after a successful rollback")
+	}
+}
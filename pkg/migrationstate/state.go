@@ -21,6 +21,8 @@ type State struct {
 	Logger              *recovery.Logger
 	resourceCount       int
 	lastCheckpointSaved int
+	notifier            *Notifier
+	halfwayNotified     bool
 }
 
 // New creates a new migration state for the given tool and migration ID.
@@ -81,6 +83,7 @@ func (s *State) MarkResourceProcessed(resourceID string) {
 	s.Checkpoint.MarkProcessed(resourceID)
 	s.resourceCount++
 	s.maybeCheckpoint()
+	s.maybeNotifyHalfway()
 }
 
 // MarkResourceFailed marks a resource as failed.
@@ -93,6 +96,7 @@ func (s *State) MarkResourceFailed(resourceID, resourceType, resourceName, error
 	})
 	s.resourceCount++
 	s.maybeCheckpoint()
+	s.maybeNotifyHalfway()
 }
 
 // AddHyperpingResource tracks a created Hyperping resource UUID and type.
@@ -101,6 +105,20 @@ func (s *State) AddHyperpingResource(uuid, resourceType string) {
 	s.Checkpoint.AddHyperpingResource(uuid, resourceType)
 }
 
+// SetResourceAddress records the Terraform address a created resource is
+// imported to, so --rollback-state-dir can remove it from the state.
+func (s *State) SetResourceAddress(uuid, address string) {
+	s.Checkpoint.SetResourceAddress(uuid, address)
+}
+
+// AddGeneratedFiles records files written by the migration, so
+// --rollback-files can remove them.
+func (s *State) AddGeneratedFiles(paths ...string) {
+	for _, path := range paths {
+		s.Checkpoint.AddGeneratedFile(path)
+	}
+}
+
 // maybeCheckpoint saves a checkpoint if the interval has been reached.
 func (s *State) maybeCheckpoint() {
 	if s.resourceCount-s.lastCheckpointSaved >= CheckpointInterval {
@@ -132,6 +150,12 @@ func (s *State) Finalize(success bool) {
 	if err := s.Manager.Save(s.Checkpoint); err != nil {
 		s.Logger.Error("Failed to save final checkpoint: %v", err)
 	}
+
+	if success {
+		s.Notify(PhaseFinished, "")
+	} else {
+		s.Notify(PhaseFailed, "")
+	}
 }
 
 // IsProcessed returns true if the given resource ID was already processed.
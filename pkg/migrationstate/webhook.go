@@ -0,0 +1,235 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrationstate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+)
+
+// Phase is a migration phase transition reported to the webhook.
+type Phase string
+
+const (
+	// PhaseStarted is sent once the source resources are fetched.
+	PhaseStarted Phase = "started"
+	// PhaseHalfConverted is sent when half of the resources are processed.
+	PhaseHalfConverted Phase = "half_converted"
+	// PhaseResourcesCreated is sent once the Hyperping resources or the
+	// Terraform configuration for them are created.
+	PhaseResourcesCreated Phase = "resources_created"
+	// PhaseFinished is sent when the migration completes without failures.
+	PhaseFinished Phase = "finished"
+	// PhaseFailed is sent when the migration ends with failures.
+	PhaseFailed Phase = "failed"
+)
+
+const (
+	// WebhookFormatJSON posts an Event as a JSON object.
+	WebhookFormatJSON = "json"
+	// WebhookFormatSlack posts a Slack incoming webhook message.
+	WebhookFormatSlack = "slack"
+
+	// webhookTimeout bounds each delivery so an unreachable endpoint cannot
+	// stall the migration.
+	webhookTimeout = 10 * time.Second
+)
+
+// Event is the JSON payload posted for a phase transition.
+type Event struct {
+	Phase          Phase     `json:"phase"`
+	Tool           string    `json:"tool"`
+	MigrationID    string    `json:"migration_id"`
+	TotalResources int       `json:"total_resources"`
+	Processed      int       `json:"processed"`
+	Failed         int       `json:"failed"`
+	Created        int       `json:"created"`
+	Detail         string    `json:"detail,omitempty"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// Text returns a one-line summary of the event, used for Slack messages.
+func (e Event) Text() string {
+	prefix := fmt.Sprintf("Hyperping migration %s (%s)", e.MigrationID, e.Tool)
+	var text string
+	switch e.Phase {
+	case PhaseStarted:
+		text = fmt.Sprintf("%s started: %d resources to migrate", prefix, e.TotalResources)
+	case PhaseHalfConverted:
+		text = fmt.Sprintf("%s is halfway: %d/%d resources processed, %d failed", prefix, e.Processed+e.Failed, e.TotalResources, e.Failed)
+	case PhaseResourcesCreated:
+		text = fmt.Sprintf("%s created resources", prefix)
+	case PhaseFinished:
+		text = fmt.Sprintf("%s finished: %d/%d resources processed", prefix, e.Processed, e.TotalResources)
+	case PhaseFailed:
+		text = fmt.Sprintf("%s failed: %d/%d resources processed, %d failed", prefix, e.Processed, e.TotalResources, e.Failed)
+	default:
+		text = fmt.Sprintf("%s: %s", prefix, e.Phase)
+	}
+	if e.Detail != "" {
+		text += ": " + e.Detail
+	}
+	return text
+}
+
+// Notifier posts phase transitions to a webhook. A nil *Notifier sends
+// nothing, so callers do not need to check whether webhooks are configured.
+type Notifier struct {
+	url    string
+	format string
+	client *http.Client
+}
+
+// NewNotifier returns a notifier posting to webhookURL in the given format.
+// An empty format selects slack for hooks.slack.com URLs and json otherwise.
+func NewNotifier(webhookURL, format string) (*Notifier, error) {
+	u, err := url.Parse(webhookURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return nil, fmt.Errorf("invalid --webhook-url: must be an http or https URL")
+	}
+
+	switch format {
+	case "":
+		format = WebhookFormatJSON
+		if strings.EqualFold(u.Hostname(), "hooks.slack.com") {
+			format = WebhookFormatSlack
+		}
+	case WebhookFormatJSON, WebhookFormatSlack:
+	default:
+		return nil, fmt.Errorf("invalid --webhook-format %q: must be %s or %s", format, WebhookFormatJSON, WebhookFormatSlack)
+	}
+
+	return &Notifier{
+		url:    webhookURL,
+		format: format,
+		client: &http.Client{Timeout: webhookTimeout},
+	}, nil
+}
+
+// Send posts the event. The webhook URL is left out of errors because Slack
+// and similar services embed the credential in it.
+func (n *Notifier) Send(ctx context.Context, event Event) error {
+	if n == nil {
+		return nil
+	}
+
+	var payload interface{} = event
+	if n.format == WebhookFormatSlack {
+		payload = map[string]string{"text": event.Text()}
+	}
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return fmt.Errorf("failed to encode webhook payload: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, n.url, bytes.NewReader(body))
+	if err != nil {
+		return fmt.Errorf("failed to build webhook request")
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.client.Do(req)
+	if err != nil {
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("webhook request failed: %w", err)
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64*1024))
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("webhook returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// WebhookFlags holds the webhook flags shared by the migration tools.
+type WebhookFlags struct {
+	url    *string
+	format *string
+}
+
+// RegisterWebhookFlags registers --webhook-url and --webhook-format on fs.
+// They default to the MIGRATION_WEBHOOK_URL and MIGRATION_WEBHOOK_FORMAT
+// environment variables, so CI jobs can configure them once.
+func RegisterWebhookFlags(fs *flag.FlagSet) *WebhookFlags {
+	return &WebhookFlags{
+		url: fs.String("webhook-url", os.Getenv("MIGRATION_WEBHOOK_URL"),
+			"URL to POST migration phase transitions to (or set MIGRATION_WEBHOOK_URL)"),
+		format: fs.String("webhook-format", os.Getenv("MIGRATION_WEBHOOK_FORMAT"),
+			"Webhook payload format: json or slack (default: slack for hooks.slack.com URLs, json otherwise)"),
+	}
+}
+
+// Notifier returns the notifier selected on the command line, or nil when no
+// webhook URL is set.
+func (f *WebhookFlags) Notifier() (*Notifier, error) {
+	if *f.url == "" {
+		return nil, nil
+	}
+	return NewNotifier(*f.url, *f.format)
+}
+
+// SetNotifier makes the state report phase transitions to n. A resumed
+// migration that is already past the halfway point does not report it again.
+func (s *State) SetNotifier(n *Notifier) {
+	s.notifier = n
+	s.halfwayNotified = s.pastHalfway()
+}
+
+// Notify reports a phase transition. Delivery is best effort: a failure is
+// logged as a warning and never fails the migration.
+func (s *State) Notify(phase Phase, detail string) {
+	if s.notifier == nil {
+		return
+	}
+
+	event := Event{
+		Phase:          phase,
+		Tool:           s.Checkpoint.Tool,
+		MigrationID:    s.Checkpoint.MigrationID,
+		TotalResources: s.Checkpoint.TotalResources,
+		Processed:      s.Checkpoint.Processed,
+		Failed:         s.Checkpoint.Failed,
+		Created:        len(s.Checkpoint.HyperpingCreated),
+		Detail:         detail,
+		Timestamp:      time.Now().UTC(),
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), webhookTimeout)
+	defer cancel()
+	if err := s.notifier.Send(ctx, event); err != nil {
+		s.Logger.Warn("Failed to send %s webhook: %v", phase, err)
+		return
+	}
+	s.Logger.Debug("Sent %s webhook", phase)
+}
+
+// maybeNotifyHalfway reports PhaseHalfConverted the first time half of the
+// resources are processed.
+func (s *State) maybeNotifyHalfway() {
+	if s.halfwayNotified || !s.pastHalfway() {
+		return
+	}
+	s.halfwayNotified = true
+	s.Notify(PhaseHalfConverted, "")
+}
+
+// pastHalfway reports whether at least half of the resources are processed.
+func (s *State) pastHalfway() bool {
+	total := s.Checkpoint.TotalResources
+	return total > 0 && 2*(s.Checkpoint.Processed+s.Checkpoint.Failed) >= total
+}
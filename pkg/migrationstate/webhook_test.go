@@ -0,0 +1,232 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package migrationstate
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/checkpoint"
+	"github.com/develeap/terraform-provider-hyperping/pkg/recovery"
+)
+
+// webhookRecorder is a webhook endpoint that records the payloads it receives.
+type webhookRecorder struct {
+	*httptest.Server
+	mu       sync.Mutex
+	payloads []map[string]interface{}
+}
+
+func newWebhookRecorder(t *testing.T, status int) *webhookRecorder {
+	t.Helper()
+	rec := &webhookRecorder{}
+	rec.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var payload map[string]interface{}
+		if err := json.NewDecoder(r.Body).Decode(&payload); err != nil {
+			t.Errorf("invalid webhook payload: %v", err)
+		}
+		rec.mu.Lock()
+		rec.payloads = append(rec.payloads, payload)
+		rec.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(rec.Close)
+	return rec
+}
+
+func (r *webhookRecorder) phases() []string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	phases := make([]string, len(r.payloads))
+	for i, p := range r.payloads {
+		phases[i], _ = p["phase"].(string)
+	}
+	return phases
+}
+
+func testState(t *testing.T, total, processed int) *State {
+	t.Helper()
+	logger, err := recovery.NewLogger(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &State{
+		Checkpoint: &checkpoint.Checkpoint{
+			MigrationID:    "betterstack-20260213-120000.000",
+			Tool:           "betterstack",
+			TotalResources: total,
+			Processed:      processed,
+			ProcessedIDs:   []string{},
+		},
+		Logger:        logger,
+		resourceCount: processed,
+	}
+}
+
+func TestNewNotifier(t *testing.T) {
+	tests := []struct {
+		name       string
+		url        string
+		format     string
+		wantFormat string
+		wantErr    bool
+	}{
+		{name: "generic", url: "https://ci.example.com/hooks/migration", wantFormat: WebhookFormatJSON},
+		{name: "slack detected", url: "https://hooks.slack.com/services/T0/B0/secret", wantFormat: WebhookFormatSlack},
+		{name: "explicit format", url: "https://chat.example.com/hook", format: WebhookFormatSlack, wantFormat: WebhookFormatSlack},
+		{name: "unknown format", url: "https://ci.example.com/hook", format: "xml", wantErr: true},
+		{name: "not a URL", url: "ci.example.com/hook", wantErr: true},
+		{name: "unsupported scheme", url: "ftp://ci.example.com/hook", wantErr: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			n, err := NewNotifier(tt.url, tt.format)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewNotifier() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if err == nil && n.format != tt.wantFormat {
+				t.Errorf("format = %q, want %q", n.format, tt.wantFormat)
+			}
+		})
+	}
+}
+
+func TestNotifier_Send(t *testing.T) {
+	rec := newWebhookRecorder(t, http.StatusOK)
+	event := Event{Phase: PhaseFinished, Tool: "pingdom", MigrationID: "pingdom-1", TotalResources: 3, Processed: 3}
+
+	jsonNotifier, err := NewNotifier(rec.URL, WebhookFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := jsonNotifier.Send(context.Background(), event); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	slackNotifier, err := NewNotifier(rec.URL, WebhookFormatSlack)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := slackNotifier.Send(context.Background(), event); err != nil {
+		t.Fatalf("Send() error = %v", err)
+	}
+
+	if got := rec.payloads[0]["migration_id"]; got != "pingdom-1" {
+		t.Errorf("json payload migration_id = %v", got)
+	}
+	text, _ := rec.payloads[1]["text"].(string)
+	if !strings.Contains(text, "pingdom-1") || !strings.Contains(text, "finished: 3/3") {
+		t.Errorf("slack text = %q", text)
+	}
+
+	var nilNotifier *Notifier
+	if err := nilNotifier.Send(context.Background(), event); err != nil {
+		t.Errorf("nil notifier should not fail: %v", err)
+	}
+}
+
+func TestNotifier_SendErrorOmitsURL(t *testing.T) {
+	rec := newWebhookRecorder(t, http.StatusForbidden)
+	n, err := NewNotifier(rec.URL+"/services/secret-token", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = n.Send(context.Background(), Event{Phase: PhaseStarted})
+	if err == nil || !strings.Contains(err.Error(), "status 403") {
+		t.Fatalf("Send() error = %v, want status 403", err)
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("error leaks the webhook URL: %v", err)
+	}
+}
+
+func TestState_PhaseTransitions(t *testing.T) {
+	rec := newWebhookRecorder(t, http.StatusOK)
+	n, err := NewNotifier(rec.URL, WebhookFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := testState(t, 4, 0)
+	s.SetNotifier(n)
+	s.Notify(PhaseStarted, "")
+	s.MarkResourceProcessed("monitor-1")
+	s.MarkResourceFailed("monitor-2", "monitor", "API", "conversion failed")
+	s.MarkResourceProcessed("monitor-3")
+	s.MarkResourceProcessed("monitor-4")
+	s.Notify(PhaseResourcesCreated, "wrote 3 monitors")
+
+	want := []string{"started", "half_converted", "resources_created"}
+	if got := rec.phases(); strings.Join(got, ",") != strings.Join(want, ",") {
+		t.Errorf("phases = %v, want %v", got, want)
+	}
+	if got := rec.payloads[1]["failed"]; got != float64(1) {
+		t.Errorf("half_converted failed = %v, want 1", got)
+	}
+}
+
+func TestState_SetNotifierAfterHalfway(t *testing.T) {
+	rec := newWebhookRecorder(t, http.StatusOK)
+	n, err := NewNotifier(rec.URL, WebhookFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A resumed migration that is already past the halfway point.
+	s := testState(t, 4, 3)
+	s.SetNotifier(n)
+	s.MarkResourceProcessed("monitor-4")
+
+	if got := rec.phases(); len(got) != 0 {
+		t.Errorf("phases = %v, want none", got)
+	}
+}
+
+func TestState_NotifyFailureIsNotFatal(t *testing.T) {
+	rec := newWebhookRecorder(t, http.StatusInternalServerError)
+	n, err := NewNotifier(rec.URL, WebhookFormatJSON)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	s := testState(t, 2, 0)
+	s.SetNotifier(n)
+	s.Notify(PhaseStarted, "")
+	s.MarkResourceProcessed("monitor-1")
+
+	if got := rec.phases(); len(got) != 2 {
+		t.Errorf("phases = %v, want delivery attempts for started and half_converted", got)
+	}
+}
+
+func TestRegisterWebhookFlags(t *testing.T) {
+	t.Setenv("MIGRATION_WEBHOOK_URL", "https://hooks.slack.com/services/T0/B0/secret")
+
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	webhookFlags := RegisterWebhookFlags(fs)
+	if err := fs.Parse(nil); err != nil {
+		t.Fatal(err)
+	}
+	n, err := webhookFlags.Notifier()
+	if err != nil || n == nil || n.format != WebhookFormatSlack {
+		t.Fatalf("Notifier() = %+v, %v; want a slack notifier from the environment", n, err)
+	}
+
+	fs = flag.NewFlagSet("test", flag.ContinueOnError)
+	webhookFlags = RegisterWebhookFlags(fs)
+	if err := fs.Parse([]string{"--webhook-url="}); err != nil {
+		t.Fatal(err)
+	}
+	if n, err := webhookFlags.Notifier(); n != nil || err != nil {
+		t.Errorf("Notifier() = %+v, %v; want nil when the URL is empty", n, err)
+	}
+}
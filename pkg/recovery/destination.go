@@ -0,0 +1,134 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package recovery
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"strings"
+
+	hyperping "github.com/develeap/hyperping-go"
+)
+
+// DestinationServiceName is the service name reported for Hyperping.
+const DestinationServiceName = "Hyperping"
+
+// DestinationClient is the part of the Hyperping client used to validate the
+// destination API key.
+type DestinationClient interface {
+	ListMonitors(ctx context.Context) ([]hyperping.Monitor, error)
+	UpdateMonitor(ctx context.Context, id string, req hyperping.UpdateMonitorRequest) (*hyperping.Monitor, error)
+}
+
+// RegisterWriteCheckFlag registers --check-write-access on fs.
+func RegisterWriteCheckFlag(fs *flag.FlagSet) *bool {
+	return fs.Bool("check-write-access", false,
+		"In --dry-run, confirm the Hyperping API key can write by writing an existing monitor's name back unchanged")
+}
+
+// ValidateDestinationAPI validates the Hyperping API key: listing monitors
+// checks that it authenticates. With checkWrite, writing an existing
+// monitor's name back unchanged checks that it may create and update
+// resources. The write is idempotent but is a real request against the
+// account, so it only runs when asked for and is logged before it is sent.
+func (v *APIValidator) ValidateDestinationAPI(ctx context.Context, client DestinationClient, checkWrite bool) ValidationResult {
+	v.logger.Debug("Validating %s API key...", DestinationServiceName)
+
+	result := ValidationResult{Service: DestinationServiceName}
+
+	monitors, err := client.ListMonitors(ctx)
+	if err != nil {
+		result.CanConnect = hyperping.IsUnauthorized(err) || hyperping.IsRateLimited(err)
+		switch {
+		case hyperping.IsUnauthorized(err):
+			result.ErrorMessage = fmt.Sprintf("%s API key was rejected: %v", DestinationServiceName, err)
+		case hyperping.IsRateLimited(err):
+			result.IsAuthenticated = true
+			result.ErrorMessage = fmt.Sprintf("%s API is rate limiting this key: %v", DestinationServiceName, err)
+		default:
+			result.ErrorMessage = fmt.Sprintf("Failed to connect to %s API: %v", DestinationServiceName, err)
+		}
+		v.logger.Error("%s", result.ErrorMessage)
+		return result
+	}
+
+	result.CanConnect = true
+	result.IsAuthenticated = true
+	result.RateLimitOK = true
+
+	if !checkWrite {
+		result.Valid = true
+		result.Warnings = append(result.Warnings,
+			"write access not checked: pass --check-write-access to confirm it by writing a monitor's name back unchanged")
+		return result
+	}
+
+	if len(monitors) == 0 {
+		result.Valid = true
+		result.Warnings = append(result.Warnings,
+			"write access not checked: the account has no monitor to probe with, so a read-only key fails at the first create")
+		return result
+	}
+
+	probe := monitors[0]
+	name := probe.Name
+	v.logger.Info("Checking %s write access: writing the name of monitor %q (%s) back unchanged",
+		DestinationServiceName, probe.Name, probe.UUID)
+	if _, err := client.UpdateMonitor(ctx, probe.UUID, hyperping.UpdateMonitorRequest{Name: &name}); err != nil {
+		if hyperping.IsUnauthorized(err) {
+			result.ErrorMessage = fmt.Sprintf("%s API key cannot write resources (read-only key?): %v", DestinationServiceName, err)
+			v.logger.Error("%s", result.ErrorMessage)
+			return result
+		}
+		result.Valid = true
+		result.Warnings = append(result.Warnings, fmt.Sprintf("write access not confirmed: %v", err))
+		return result
+	}
+
+	result.Valid = true
+	result.CanWrite = true
+	v.logger.Debug("%s API key can read and write", DestinationServiceName)
+
+	return result
+}
+
+// SkippedValidation returns the result for a side that was not validated,
+// for example because no API key was given in a dry run.
+func SkippedValidation(serviceName, reason string) ValidationResult {
+	return ValidationResult{
+		Service:    serviceName,
+		Valid:      true,
+		SkipReason: reason,
+	}
+}
+
+// PrintValidationSummary writes one line per validated side, followed by
+// its warnings, and reports whether every side is valid.
+func PrintValidationSummary(w io.Writer, results ...ValidationResult) bool {
+	allValid := true
+	fmt.Fprintln(w, "API validation:")
+	for _, r := range results {
+		var status string
+		switch {
+		case r.SkipReason != "":
+			status = "skipped (" + r.SkipReason + ")"
+		case !r.Valid:
+			allValid = false
+			status = "FAILED: " + r.ErrorMessage
+		default:
+			access := []string{"read"}
+			if r.CanWrite {
+				access = append(access, "write")
+			}
+			status = "OK (" + strings.Join(access, ", ") + ")"
+		}
+		fmt.Fprintf(w, "  %-13s %s\n", r.Service+":", status)
+		for _, warning := range r.Warnings {
+			fmt.Fprintf(w, "    warning: %s\n", warning)
+		}
+	}
+	return allValid
+}
@@ -0,0 +1,258 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package recovery
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultLogMaxSizeMB is the size at which the active debug log is rotated.
+	DefaultLogMaxSizeMB = 10
+	// DefaultLogMaxFiles is the number of debug log files kept in the log directory.
+	DefaultLogMaxFiles = 10
+
+	logFilePrefix = "migration-"
+)
+
+// LogOptions configures the debug log file and how it is rotated. Zero sizes
+// select the defaults.
+type LogOptions struct {
+	Path      string // debug log file; no file is written when empty
+	MaxSizeMB int    // rotate the active file once it exceeds this size
+	MaxFiles  int    // log files (the active file and its backups) to retain
+}
+
+func (o LogOptions) withDefaults() LogOptions {
+	if o.MaxSizeMB <= 0 {
+		o.MaxSizeMB = DefaultLogMaxSizeMB
+	}
+	if o.MaxFiles <= 0 {
+		o.MaxFiles = DefaultLogMaxFiles
+	}
+	return o
+}
+
+// defaultLogDir returns ~/.hyperping-migrate/logs.
+func defaultLogDir() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", fmt.Errorf("failed to get user home directory: %w", err)
+	}
+	return filepath.Join(homeDir, ".hyperping-migrate", "logs"), nil
+}
+
+// DefaultLogPath returns a timestamped log file in ~/.hyperping-migrate/logs,
+// the file NewLogger writes in debug mode.
+func DefaultLogPath() (string, error) {
+	dir, err := defaultLogDir()
+	if err != nil {
+		return "", err
+	}
+	timestamp := time.Now().UTC().Format("20060102-150405")
+	return filepath.Join(dir, fmt.Sprintf("%s%s.log", logFilePrefix, timestamp)), nil
+}
+
+// retentionFor selects the files pruned alongside path: every timestamped
+// log in the default directory, so retention spans earlier runs, or only
+// path and its rotations anywhere else.
+func retentionFor(path string) func(name string) bool {
+	if dir, err := defaultLogDir(); err == nil && filepath.Dir(path) == dir {
+		return isMigrationLog
+	}
+	return backupsOf(path)
+}
+
+// isMigrationLog matches the timestamped logs written to the default directory.
+func isMigrationLog(name string) bool {
+	return strings.HasPrefix(name, logFilePrefix) && strings.Contains(name, ".log")
+}
+
+// backupsOf matches path and its numbered rotations (path.1, path.2, ...).
+func backupsOf(path string) func(name string) bool {
+	base := filepath.Base(path)
+	return func(name string) bool {
+		return name == base || strings.HasPrefix(name, base+".")
+	}
+}
+
+// LogFlags holds the log flags shared by the migration tools.
+type LogFlags struct {
+	path      *string
+	maxSizeMB *int
+	maxFiles  *int
+}
+
+// RegisterLogFlags registers --debug-log, --log-max-size and --log-max-files on fs.
+func RegisterLogFlags(fs *flag.FlagSet) *LogFlags {
+	return &LogFlags{
+		path:      fs.String("debug-log", "", "Write a debug log, including debug messages, to this file"),
+		maxSizeMB: fs.Int("log-max-size", DefaultLogMaxSizeMB, "Rotate the debug log file after this many megabytes"),
+		maxFiles:  fs.Int("log-max-files", DefaultLogMaxFiles, "Number of debug log files (the active file and its rotations) to keep"),
+	}
+}
+
+// Options returns the LogOptions selected on the command line.
+func (f *LogFlags) Options() LogOptions {
+	return LogOptions{Path: *f.path, MaxSizeMB: *f.maxSizeMB, MaxFiles: *f.maxFiles}
+}
+
+// rotatingFile is an io.WriteCloser that rotates to numbered backups
+// (<path>.1, .2, ...) when the active file reaches maxSize, and prunes the
+// retained files in its directory down to maxFiles.
+type rotatingFile struct {
+	mu       sync.Mutex
+	path     string
+	maxSize  int64
+	maxFiles int
+	retained func(name string) bool
+	file     *os.File
+	size     int64
+	rotation int
+}
+
+func openRotatingFile(path string, maxSize int64, maxFiles int, retained func(name string) bool) (*rotatingFile, error) {
+	rf := &rotatingFile{path: path, maxSize: maxSize, maxFiles: maxFiles, retained: retained}
+	if err := rf.open(); err != nil {
+		return nil, err
+	}
+	rf.rotation = lastRotation(path)
+	rf.prune()
+	return rf, nil
+}
+
+// lastRotation returns the highest backup number of path left by an earlier
+// run, so appending to an existing --debug-log does not overwrite backups.
+func lastRotation(path string) int {
+	entries, err := os.ReadDir(filepath.Dir(path))
+	if err != nil {
+		return 0
+	}
+	prefix := filepath.Base(path) + "."
+	last := 0
+	for _, e := range entries {
+		if !strings.HasPrefix(e.Name(), prefix) {
+			continue
+		}
+		if n, convErr := strconv.Atoi(strings.TrimPrefix(e.Name(), prefix)); convErr == nil && n > last {
+			last = n
+		}
+	}
+	return last
+}
+
+func (rf *rotatingFile) open() error {
+	f, err := os.OpenFile(filepath.Clean(rf.path), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600) // #nosec G304 -- operator-supplied --debug-log path or the default log dir
+	if err != nil {
+		return fmt.Errorf("failed to create log file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close() //nolint:errcheck // #nosec G104 -- best-effort cleanup before returning error
+		return fmt.Errorf("failed to stat log file: %w", err)
+	}
+	rf.file = f
+	rf.size = info.Size()
+	return nil
+}
+
+// Write implements io.Writer.
+func (rf *rotatingFile) Write(p []byte) (int, error) {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.file == nil {
+		return 0, os.ErrClosed
+	}
+
+	if rf.size > 0 && rf.size+int64(len(p)) > rf.maxSize {
+		if err := rf.rotate(); err != nil {
+			return 0, err
+		}
+	}
+
+	n, err := rf.file.Write(p)
+	rf.size += int64(n)
+	return n, err
+}
+
+// rotate moves the active file to the next numbered backup and reopens path.
+func (rf *rotatingFile) rotate() error {
+	if err := rf.file.Close(); err != nil {
+		return fmt.Errorf("failed to close log file for rotation: %w", err)
+	}
+	rf.file = nil
+
+	rf.rotation++
+	backup := fmt.Sprintf("%s.%d", rf.path, rf.rotation)
+	if err := os.Rename(rf.path, backup); err != nil {
+		return fmt.Errorf("failed to rotate log file: %w", err)
+	}
+
+	if err := rf.open(); err != nil {
+		return err
+	}
+	rf.prune()
+	return nil
+}
+
+// prune removes the oldest log files in the directory so at most maxFiles
+// remain. The active file is never removed. Errors are ignored: retention is
+// best-effort and must not interrupt a migration.
+func (rf *rotatingFile) prune() {
+	dir := filepath.Dir(rf.path)
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return
+	}
+
+	type logEntry struct {
+		path    string
+		modTime int64
+	}
+	var logs []logEntry
+	for _, e := range entries {
+		if e.IsDir() || !rf.retained(e.Name()) {
+			continue
+		}
+		info, infoErr := e.Info()
+		if infoErr != nil {
+			continue
+		}
+		logs = append(logs, logEntry{path: filepath.Join(dir, e.Name()), modTime: info.ModTime().UnixNano()})
+	}
+
+	if len(logs) <= rf.maxFiles {
+		return
+	}
+
+	sort.Slice(logs, func(i, j int) bool { return logs[i].modTime > logs[j].modTime })
+	for _, l := range logs[rf.maxFiles:] {
+		if l.path == rf.path {
+			continue
+		}
+		_ = os.Remove(l.path) //nolint:errcheck // #nosec G104 -- best-effort retention cleanup
+	}
+}
+
+// Close closes the active log file.
+func (rf *rotatingFile) Close() error {
+	rf.mu.Lock()
+	defer rf.mu.Unlock()
+
+	if rf.file == nil {
+		return nil
+	}
+	err := rf.file.Close()
+	rf.file = nil
+	return err
+}
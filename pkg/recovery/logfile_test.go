@@ -0,0 +1,190 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package recovery
+
+import (
+	"bytes"
+	"flag"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewLoggerWithOptions_DebugLog(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "logs", "debug.log")
+
+	logger, err := NewLoggerWithOptions(false, LogOptions{Path: path})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	defer logger.Close()
+
+	var stderr bytes.Buffer
+	logger.writer = &stderr
+
+	if logger.GetLogPath() != path {
+		t.Errorf("Expected log file %s, got %s", path, logger.GetLogPath())
+	}
+
+	logger.Debug("detail")
+	logger.Info("hello")
+	data, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatalf("Failed to read log file: %v", err)
+	}
+	if !strings.Contains(string(data), "DEBUG: detail") || !strings.Contains(string(data), "INFO: hello") {
+		t.Errorf("Expected log file to contain both messages, got %q", data)
+	}
+	if strings.Contains(stderr.String(), "detail") {
+		t.Error("Expected debug message to stay out of stderr without debug mode")
+	}
+}
+
+func TestNewLoggerWithOptions_VerboseWritesNoFile(t *testing.T) {
+	logger, err := NewLoggerWithOptions(true, LogOptions{})
+	if err != nil {
+		t.Fatalf("Failed to create logger: %v", err)
+	}
+	defer logger.Close()
+
+	if logger.logFile != nil || logger.GetLogPath() != "" {
+		t.Errorf("Expected no log file without a path, got %q", logger.GetLogPath())
+	}
+}
+
+func TestRotatingFile_Rotates(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "migration-20260101-000000.log")
+
+	rf, err := openRotatingFile(path, 10, 10, backupsOf(path))
+	if err != nil {
+		t.Fatalf("Failed to open rotating file: %v", err)
+	}
+	defer rf.Close()
+
+	for _, line := range []string{"first-line", "second-line", "third-line"} {
+		if _, err := rf.Write([]byte(line)); err != nil {
+			t.Fatalf("Write failed: %v", err)
+		}
+	}
+
+	for suffix, want := range map[string]string{".1": "first-line", ".2": "second-line", "": "third-line"} {
+		data, err := os.ReadFile(path + suffix)
+		if err != nil {
+			t.Fatalf("Expected %s to exist: %v", path+suffix, err)
+		}
+		if string(data) != want {
+			t.Errorf("%s = %q, want %q", path+suffix, data, want)
+		}
+	}
+}
+
+func TestRotatingFile_Retention(t *testing.T) {
+	dir := t.TempDir()
+
+	// Pre-existing logs from earlier runs, oldest first.
+	base := time.Now().Add(-time.Hour)
+	for i, name := range []string{"migration-a.log", "migration-b.log", "migration-c.log.1", "unrelated.txt"} {
+		p := filepath.Join(dir, name)
+		if err := os.WriteFile(p, []byte("x"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		mod := base.Add(time.Duration(i) * time.Minute)
+		if err := os.Chtimes(p, mod, mod); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	path := filepath.Join(dir, "migration-current.log")
+	rf, err := openRotatingFile(path, 1024, 2, isMigrationLog)
+	if err != nil {
+		t.Fatalf("Failed to open rotating file: %v", err)
+	}
+	defer rf.Close()
+
+	var remaining []string
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, e := range entries {
+		remaining = append(remaining, e.Name())
+	}
+
+	want := []string{"migration-c.log.1", "migration-current.log", "unrelated.txt"}
+	if strings.Join(remaining, ",") != strings.Join(want, ",") {
+		t.Errorf("remaining files = %v, want %v", remaining, want)
+	}
+}
+
+func TestRotatingFile_ContinuesNumbering(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "debug.log")
+	for _, name := range []string{"debug.log", "debug.log.1", "debug.log.2", "other.log.7"} {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte("earlier-run"), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	rf, err := openRotatingFile(path, 12, 10, backupsOf(path))
+	if err != nil {
+		t.Fatalf("Failed to open rotating file: %v", err)
+	}
+	defer rf.Close()
+
+	if _, err := rf.Write([]byte("new-line")); err != nil {
+		t.Fatalf("Write failed: %v", err)
+	}
+
+	data, err := os.ReadFile(path + ".3")
+	if err != nil {
+		t.Fatalf("Expected rotation to %s.3: %v", path, err)
+	}
+	if string(data) != "earlier-run" {
+		t.Errorf("%s.3 = %q, want the earlier run's log", path, data)
+	}
+	if data, err := os.ReadFile(path + ".2"); err != nil || string(data) != "earlier-run" {
+		t.Errorf("Expected the existing backup to be kept, got %q (%v)", data, err)
+	}
+}
+
+func TestRotatingFile_WriteAfterClose(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "migration-x.log")
+	rf, err := openRotatingFile(path, 1024, 1, backupsOf(path))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rf.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rf.Write([]byte("late")); err == nil {
+		t.Error("Expected error writing to closed file")
+	}
+}
+
+func TestRegisterLogFlags(t *testing.T) {
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	logFlags := RegisterLogFlags(fs)
+
+	if err := fs.Parse([]string{"--debug-log=/tmp/hp-logs/debug.log", "--log-max-size=5", "--log-max-files=3"}); err != nil {
+		t.Fatalf("Parse failed: %v", err)
+	}
+
+	opts := logFlags.Options()
+	if opts.Path != "/tmp/hp-logs/debug.log" || opts.MaxSizeMB != 5 || opts.MaxFiles != 3 {
+		t.Errorf("unexpected options: %+v", opts)
+	}
+}
+
+func TestLogOptions_Defaults(t *testing.T) {
+	opts := LogOptions{}.withDefaults()
+	if opts.Path != "" {
+		t.Error("Expected no default log file")
+	}
+	if opts.MaxSizeMB != DefaultLogMaxSizeMB || opts.MaxFiles != DefaultLogMaxFiles {
+		t.Errorf("unexpected defaults: %+v", opts)
+	}
+}
@@ -17,42 +17,53 @@ import (
 type Logger struct {
 	writer      io.Writer
 	debugMode   bool
-	logFile     *os.File
+	logFile     *rotatingFile
 	logFilePath string
 }
 
-// NewLogger creates a new logger
+// NewLogger creates a new logger. In debug mode, output is also written to a
+// timestamped file in ~/.hyperping-migrate/logs.
 func NewLogger(debugMode bool) (*Logger, error) {
-	logger := &Logger{
-		writer:    os.Stderr,
-		debugMode: debugMode,
-	}
-
+	var opts LogOptions
 	if debugMode {
-		homeDir, err := os.UserHomeDir()
+		path, err := DefaultLogPath()
 		if err != nil {
-			return nil, fmt.Errorf("failed to get user home directory: %w", err)
+			return nil, err
 		}
+		opts.Path = path
+	}
+	return NewLoggerWithOptions(debugMode, opts)
+}
 
-		logDir := filepath.Join(homeDir, ".hyperping-migrate", "logs")
-		//nolint:govet
-		if err := os.MkdirAll(logDir, 0o700); err != nil {
-			return nil, fmt.Errorf("failed to create log directory: %w", err)
-		}
+// NewLoggerWithOptions creates a new logger. debugMode only controls whether
+// debug messages reach stderr; a log file is written, with debug messages,
+// when opts.Path is set.
+func NewLoggerWithOptions(debugMode bool, opts LogOptions) (*Logger, error) {
+	return newLogger(debugMode, opts, retentionFor(opts.Path))
+}
 
-		timestamp := time.Now().UTC().Format("20060102-150405")
-		logFilePath := filepath.Join(logDir, fmt.Sprintf("migration-%s.log", timestamp))
+func newLogger(debugMode bool, opts LogOptions, retained func(name string) bool) (*Logger, error) {
+	logger := &Logger{
+		writer:    os.Stderr,
+		debugMode: debugMode,
+	}
 
-		logFile, err := os.OpenFile(filepath.Clean(logFilePath), os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600) // #nosec G304 -- path built from controlled logDir + timestamp
-		if err != nil {
-			return nil, fmt.Errorf("failed to create log file: %w", err)
-		}
+	if opts.Path == "" {
+		return logger, nil
+	}
 
-		logger.logFile = logFile
-		logger.logFilePath = logFilePath
-		logger.writer = io.MultiWriter(os.Stderr, logFile)
+	opts = opts.withDefaults()
+	if err := os.MkdirAll(filepath.Dir(opts.Path), 0o700); err != nil {
+		return nil, fmt.Errorf("failed to create log directory: %w", err)
 	}
 
+	logFile, err := openRotatingFile(opts.Path, int64(opts.MaxSizeMB)*1024*1024, opts.MaxFiles, retained)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.logFile = logFile
+	logger.logFilePath = opts.Path
 	return logger, nil
 }
 
@@ -64,39 +75,43 @@ func (l *Logger) Close() error {
 	return nil
 }
 
-// Debug logs a debug message
+// Debug logs a debug message. It always reaches the log file, and stderr
+// only in debug mode.
 func (l *Logger) Debug(format string, args ...interface{}) {
-	if !l.debugMode {
-		return
-	}
-
-	timestamp := time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
-	message := fmt.Sprintf(format, args...)
-	fmt.Fprintf(l.writer, "[%s] DEBUG: %s\n", timestamp, message)
+	l.log("DEBUG", l.debugMode, format, args...)
 }
 
 // Info logs an info message
 func (l *Logger) Info(format string, args ...interface{}) {
-	timestamp := time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
-	message := fmt.Sprintf(format, args...)
-	fmt.Fprintf(l.writer, "[%s] INFO: %s\n", timestamp, message)
+	l.log("INFO", true, format, args...)
 }
 
 // Warn logs a warning message
 func (l *Logger) Warn(format string, args ...interface{}) {
-	timestamp := time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
-	message := fmt.Sprintf(format, args...)
-	fmt.Fprintf(l.writer, "[%s] WARN: %s\n", timestamp, message)
+	l.log("WARN", true, format, args...)
 }
 
 // Error logs an error message
 func (l *Logger) Error(format string, args ...interface{}) {
+	l.log("ERROR", true, format, args...)
+}
+
+func (l *Logger) log(level string, toWriter bool, format string, args ...interface{}) {
+	if !toWriter && l.logFile == nil {
+		return
+	}
+
 	timestamp := time.Now().UTC().Format("2006-01-02T15:04:05.000Z")
-	message := fmt.Sprintf(format, args...)
-	fmt.Fprintf(l.writer, "[%s] ERROR: %s\n", timestamp, message)
+	line := fmt.Sprintf("[%s] %s: %s\n", timestamp, level, fmt.Sprintf(format, args...))
+	if toWriter {
+		fmt.Fprint(l.writer, line)
+	}
+	if l.logFile != nil {
+		_, _ = io.WriteString(l.logFile, line) //nolint:errcheck // #nosec G104 -- the log file is best effort
+	}
 }
 
-// GetLogPath returns the path to the log file (empty if not in debug mode)
+// GetLogPath returns the path to the log file (empty if no log file is written)
 func (l *Logger) GetLogPath() string {
 	return l.logFilePath
 }
@@ -113,10 +128,13 @@ func NewAPIValidator(logger *Logger) *APIValidator {
 
 // ValidationResult contains the result of API validation
 type ValidationResult struct {
+	Service         string
 	Valid           bool
 	CanConnect      bool
 	IsAuthenticated bool
 	RateLimitOK     bool
+	CanWrite        bool   // the key may create resources (destination only)
+	SkipReason      string // set when the side was not validated
 	ErrorMessage    string
 	Warnings        []string
 }
@@ -126,6 +144,7 @@ func (v *APIValidator) ValidateSourceAPI(ctx context.Context, serviceName string
 	v.logger.Debug("Validating %s API connectivity...", serviceName)
 
 	result := ValidationResult{
+		Service:    serviceName,
 		Valid:      true,
 		CanConnect: true,
 	}
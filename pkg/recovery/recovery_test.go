@@ -11,6 +11,8 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
 )
 
 func TestNewLogger(t *testing.T) {
@@ -271,6 +273,138 @@ func TestAPIValidator(t *testing.T) {
 	})
 }
 
+type fakeDestination struct {
+	monitors  []hyperping.Monitor
+	listErr   error
+	updateErr error
+	updates   []hyperping.UpdateMonitorRequest
+}
+
+func (f *fakeDestination) ListMonitors(ctx context.Context) ([]hyperping.Monitor, error) {
+	return f.monitors, f.listErr
+}
+
+func (f *fakeDestination) UpdateMonitor(ctx context.Context, id string, req hyperping.UpdateMonitorRequest) (*hyperping.Monitor, error) {
+	f.updates = append(f.updates, req)
+	if f.updateErr != nil {
+		return nil, f.updateErr
+	}
+	return &hyperping.Monitor{UUID: id, Name: *req.Name}, nil
+}
+
+func TestValidateDestinationAPI(t *testing.T) {
+	var logs bytes.Buffer
+	validator := NewAPIValidator(&Logger{writer: &logs})
+	ctx := context.Background()
+	existing := []hyperping.Monitor{{UUID: "mon_api", Name: "API"}}
+
+	t.Run("read only by default", func(t *testing.T) {
+		client := &fakeDestination{monitors: existing}
+		result := validator.ValidateDestinationAPI(ctx, client, false)
+
+		if !result.Valid || !result.IsAuthenticated || result.CanWrite {
+			t.Errorf("result = %+v, want valid with write access unchecked", result)
+		}
+		if len(client.updates) != 0 {
+			t.Errorf("no write should be sent without checkWrite, got %+v", client.updates)
+		}
+		if len(result.Warnings) != 1 || !strings.Contains(result.Warnings[0], "--check-write-access") {
+			t.Errorf("Warnings = %v, want a hint about --check-write-access", result.Warnings)
+		}
+	})
+
+	t.Run("read and write", func(t *testing.T) {
+		client := &fakeDestination{monitors: existing}
+		result := validator.ValidateDestinationAPI(ctx, client, true)
+
+		if !result.Valid || !result.IsAuthenticated || !result.CanWrite {
+			t.Errorf("result = %+v, want valid with write access", result)
+		}
+		if len(client.updates) != 1 || *client.updates[0].Name != "API" {
+			t.Errorf("probe should write the monitor's own name back, got %+v", client.updates)
+		}
+		if !strings.Contains(logs.String(), `writing the name of monitor "API" (mon_api) back unchanged`) {
+			t.Errorf("the write should be logged before it is sent, got %q", logs.String())
+		}
+	})
+
+	t.Run("rejected key", func(t *testing.T) {
+		client := &fakeDestination{listErr: hyperping.NewAPIError(401, "unauthorized")}
+		result := validator.ValidateDestinationAPI(ctx, client, true)
+
+		if result.Valid || result.IsAuthenticated {
+			t.Errorf("result = %+v, want an authentication failure", result)
+		}
+		if !result.CanConnect {
+			t.Error("a 401 means the API was reached")
+		}
+		if len(client.updates) != 0 {
+			t.Error("no write probe should run after a failed read")
+		}
+	})
+
+	t.Run("read-only key", func(t *testing.T) {
+		client := &fakeDestination{monitors: existing, updateErr: hyperping.NewAPIError(403, "forbidden")}
+		result := validator.ValidateDestinationAPI(ctx, client, true)
+
+		if result.Valid || result.CanWrite {
+			t.Errorf("result = %+v, want invalid without write access", result)
+		}
+		if !strings.Contains(result.ErrorMessage, "cannot write") {
+			t.Errorf("ErrorMessage = %q", result.ErrorMessage)
+		}
+	})
+
+	t.Run("no monitor to probe", func(t *testing.T) {
+		result := validator.ValidateDestinationAPI(ctx, &fakeDestination{}, true)
+
+		if !result.Valid || result.CanWrite {
+			t.Errorf("result = %+v, want valid with write access unchecked", result)
+		}
+		if len(result.Warnings) != 1 {
+			t.Errorf("Warnings = %v, want one", result.Warnings)
+		}
+	})
+
+	t.Run("probe error other than permissions", func(t *testing.T) {
+		client := &fakeDestination{monitors: existing, updateErr: hyperping.NewAPIError(500, "boom")}
+		result := validator.ValidateDestinationAPI(ctx, client, true)
+
+		if !result.Valid || result.CanWrite || len(result.Warnings) != 1 {
+			t.Errorf("result = %+v, want valid with a warning", result)
+		}
+	})
+}
+
+func TestPrintValidationSummary(t *testing.T) {
+	var buf bytes.Buffer
+	ok := PrintValidationSummary(&buf,
+		ValidationResult{Service: "Pingdom", Valid: true, IsAuthenticated: true},
+		ValidationResult{Service: DestinationServiceName, ErrorMessage: "Hyperping API key was rejected"},
+	)
+	if ok {
+		t.Error("summary with a failed side should report false")
+	}
+	out := buf.String()
+	for _, want := range []string{"Pingdom:", "OK (read)", "Hyperping:", "FAILED: Hyperping API key was rejected"} {
+		if !strings.Contains(out, want) {
+			t.Errorf("summary missing %q\nGot:\n%s", want, out)
+		}
+	}
+
+	buf.Reset()
+	ok = PrintValidationSummary(&buf,
+		SkippedValidation("CSV", "no source API"),
+		ValidationResult{Service: DestinationServiceName, Valid: true, CanWrite: true},
+	)
+	if !ok {
+		t.Error("skipped sides should not fail the summary")
+	}
+	if !strings.Contains(buf.String(), "skipped (no source API)") || !strings.Contains(buf.String(), "OK (read, write)") {
+		t.Errorf("unexpected summary:\n%s", buf.String())
+	}
+}
+
 func TestDefaultBackoff(t *testing.T) {
 	backoff := DefaultBackoff()
 
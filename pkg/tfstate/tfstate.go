@@ -0,0 +1,296 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// Package tfstate reads source monitors from an existing Terraform state, so
+// teams that already manage Better Stack, UptimeRobot, or Pingdom as code can
+// migrate from that state instead of the source API. It also generates the
+// removed blocks that drop the source resources from state once the
+// Hyperping resources have been applied.
+//
+// Only the JSON state format written by Terraform and OpenTofu (version 4,
+// as printed by "terraform state pull") is supported.
+package tfstate
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/hclgen"
+)
+
+// stateVersion is the only state format version Parse accepts.
+const stateVersion = 4
+
+// RegisterFlag registers the --from-state flag on fs.
+func RegisterFlag(fs *flag.FlagSet) *string {
+	return fs.String("from-state", "",
+		"Read source monitors from a Terraform state file (terraform state pull > source.tfstate) instead of the source API; \"-\" reads stdin")
+}
+
+// State is a parsed Terraform state.
+type State struct {
+	Version          int        `json:"version"`
+	TerraformVersion string     `json:"terraform_version"`
+	Resources        []resource `json:"resources"`
+}
+
+type resource struct {
+	Module    string     `json:"module,omitempty"`
+	Mode      string     `json:"mode"`
+	Type      string     `json:"type"`
+	Name      string     `json:"name"`
+	Instances []instance `json:"instances"`
+}
+
+type instance struct {
+	IndexKey   any            `json:"index_key,omitempty"`
+	Attributes map[string]any `json:"attributes"`
+}
+
+// Instance is one instance of a managed resource in the state.
+type Instance struct {
+	// Resource is the resource address without the instance key, e.g.
+	// module.monitoring.betteruptime_monitor.api.
+	Resource string
+	// Address is the full instance address, e.g.
+	// module.monitoring.betteruptime_monitor.api["prod"].
+	Address    string
+	Type       string
+	Attributes map[string]any
+}
+
+// Load reads the state file at path, or stdin when path is "-".
+func Load(path string) (*State, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(os.Stdin)
+	} else {
+		data, err = os.ReadFile(path) // #nosec G304 -- path is supplied by the user on the command line
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to read state: %w", err)
+	}
+	return Parse(data)
+}
+
+// Parse parses a JSON state document.
+func Parse(data []byte) (*State, error) {
+	var s State
+	if err := json.Unmarshal(data, &s); err != nil {
+		return nil, fmt.Errorf("failed to parse state: %w", err)
+	}
+	if s.Version != stateVersion {
+		return nil, fmt.Errorf("unsupported state version %d (expected %d); run terraform state pull with Terraform 0.12 or later", s.Version, stateVersion)
+	}
+	return &s, nil
+}
+
+// Instances returns the instances of managed resources of the given type, in
+// state order. Data sources are ignored.
+func (s *State) Instances(resourceType string) []Instance {
+	var out []Instance
+	for _, r := range s.Resources {
+		if r.Mode != "managed" || r.Type != resourceType {
+			continue
+		}
+		base := r.Type + "." + r.Name
+		if r.Module != "" {
+			base = r.Module + "." + base
+		}
+		for _, inst := range r.Instances {
+			out = append(out, Instance{
+				Resource:   base,
+				Address:    base + formatIndexKey(inst.IndexKey),
+				Type:       r.Type,
+				Attributes: inst.Attributes,
+			})
+		}
+	}
+	return out
+}
+
+// formatIndexKey renders a count or for_each key as it appears in an address.
+func formatIndexKey(key any) string {
+	switch k := key.(type) {
+	case nil:
+		return ""
+	case string:
+		return "[" + strconv.Quote(k) + "]"
+	case float64:
+		return "[" + strconv.FormatFloat(k, 'f', -1, 64) + "]"
+	default:
+		return fmt.Sprintf("[%v]", k)
+	}
+}
+
+// ID returns the resource ID recorded in state.
+func (i Instance) ID() string {
+	return i.String("id")
+}
+
+// String returns a string attribute, or "" when it is missing or not a string.
+func (i Instance) String(name string) string {
+	s, _ := i.Attributes[name].(string)
+	return s
+}
+
+// Int returns a number attribute, or 0 when it is missing. Providers that
+// store numbers as strings (such as the Better Stack port) are handled too.
+func (i Instance) Int(name string) int {
+	return toInt(i.Attributes[name])
+}
+
+// Bool returns a bool attribute, or false when it is missing.
+func (i Instance) Bool(name string) bool {
+	b, _ := i.Attributes[name].(bool)
+	return b
+}
+
+// Strings returns a list or set of strings, skipping other elements.
+func (i Instance) Strings(name string) []string {
+	items, _ := i.Attributes[name].([]any)
+	var out []string
+	for _, item := range items {
+		if s, ok := item.(string); ok {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+// Ints returns a list or set of numbers.
+func (i Instance) Ints(name string) []int {
+	items, _ := i.Attributes[name].([]any)
+	var out []int
+	for _, item := range items {
+		out = append(out, toInt(item))
+	}
+	return out
+}
+
+// StringMap returns a map of strings, skipping other values.
+func (i Instance) StringMap(name string) map[string]string {
+	m, _ := i.Attributes[name].(map[string]any)
+	if len(m) == 0 {
+		return nil
+	}
+	out := make(map[string]string, len(m))
+	for k, v := range m {
+		if s, ok := v.(string); ok {
+			out[k] = s
+		}
+	}
+	return out
+}
+
+// Objects returns a list of nested blocks or objects.
+func (i Instance) Objects(name string) []Instance {
+	items, _ := i.Attributes[name].([]any)
+	var out []Instance
+	for _, item := range items {
+		if m, ok := item.(map[string]any); ok {
+			out = append(out, Instance{Type: i.Type, Attributes: m})
+		}
+	}
+	return out
+}
+
+func toInt(v any) int {
+	switch n := v.(type) {
+	case float64:
+		return int(n)
+	case string:
+		i, err := strconv.Atoi(strings.TrimSpace(n))
+		if err != nil {
+			return 0
+		}
+		return i
+	default:
+		return 0
+	}
+}
+
+// RemovedBlocks returns Terraform configuration with a removed block (with
+// destroy = false) for every resource the instances belong to. Applying it
+// after the Hyperping resources exist drops the source resources from state
+// without deleting them on the source platform, which keeps a rollback
+// path until the old monitors are deleted by hand. Removed blocks require
+// Terraform 1.7 or later, so the file also lists the equivalent
+// terraform state rm commands for older versions.
+func RemovedBlocks(instances []Instance) string {
+	var resources, configResources []string
+	seen := make(map[string]bool)
+	for _, inst := range instances {
+		if !seen[inst.Resource] {
+			seen[inst.Resource] = true
+			resources = append(resources, inst.Resource)
+		}
+		// A removed block names the resource in configuration, which covers
+		// every instance of a module called with count or for_each.
+		if c := stripIndexKeys(inst.Resource); !seen["config:"+c] {
+			seen["config:"+c] = true
+			configResources = append(configResources, c)
+		}
+	}
+	sort.Strings(resources)
+	sort.Strings(configResources)
+
+	f := hclgen.NewFile()
+	body := f.Body()
+	body.Comment("Source resources migrated to Hyperping. Add this file to the configuration that manages")
+	body.Comment("them, delete their resource blocks, and apply once the Hyperping resources are in place.")
+	body.Comment("Terraform then forgets the source resources without destroying them; delete the old")
+	body.Comment("monitors on the source platform once Hyperping is alerting as expected.")
+	body.Comment("")
+	body.Comment("With Terraform older than 1.7, run instead:")
+	for _, r := range resources {
+		body.Comment("  terraform state rm '%s'", r)
+	}
+
+	for _, r := range configResources {
+		body.Newline()
+		removed := body.Block("removed")
+		if err := removed.SetReference("from", r); err != nil {
+			removed.Comment("%v", err)
+			continue
+		}
+		removed.Newline()
+		removed.Block("lifecycle").SetBool("destroy", false)
+	}
+	return f.String()
+}
+
+// stripIndexKeys removes module instance keys from a resource address, e.g.
+// module.team["a"].x.y becomes module.team.x.y.
+func stripIndexKeys(addr string) string {
+	var b strings.Builder
+	depth := 0
+	inString := false
+	for i := 0; i < len(addr); i++ {
+		c := addr[i]
+		switch {
+		case inString:
+			if c == '\\' {
+				i++
+			} else if c == '"' {
+				inString = false
+			}
+		case c == '"' && depth > 0:
+			inString = true
+		case c == '[':
+			depth++
+		case c == ']':
+			depth--
+		case depth == 0:
+			b.WriteByte(c)
+		}
+	}
+	return b.String()
+}
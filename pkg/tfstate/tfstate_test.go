@@ -0,0 +1,101 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package tfstate
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/hcl/v2"
+	"github.com/hashicorp/hcl/v2/hclsyntax"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad(t *testing.T) {
+	s, err := Load("testdata/source.tfstate")
+	require.NoError(t, err)
+	assert.Equal(t, "1.9.5", s.TerraformVersion)
+
+	monitors := s.Instances("betteruptime_monitor")
+	require.Len(t, monitors, 2, "data sources are ignored")
+	assert.Equal(t, "betteruptime_monitor.api", monitors[0].Resource)
+	assert.Equal(t, "betteruptime_monitor.api[0]", monitors[0].Address)
+	assert.Equal(t, "betteruptime_monitor.api[1]", monitors[1].Address)
+
+	checks := s.Instances("pingdom_check")
+	require.Len(t, checks, 1)
+	assert.Equal(t, `module.team["payments"].pingdom_check.web`, checks[0].Resource)
+	assert.Equal(t, `module.team["payments"].pingdom_check.web["checkout"]`, checks[0].Address)
+
+	assert.Empty(t, s.Instances("hyperping_monitor"))
+}
+
+func TestLoad_Errors(t *testing.T) {
+	_, err := Load("testdata/missing.tfstate")
+	assert.ErrorContains(t, err, "failed to read state")
+
+	_, err = Parse([]byte(`{"version": 3, "modules": []}`))
+	assert.ErrorContains(t, err, "unsupported state version 3")
+
+	_, err = Parse([]byte(`not json`))
+	assert.ErrorContains(t, err, "failed to parse state")
+}
+
+func TestInstance_Attributes(t *testing.T) {
+	s, err := Load("testdata/source.tfstate")
+	require.NoError(t, err)
+	api := s.Instances("betteruptime_monitor")[0]
+
+	assert.Equal(t, "101", api.ID())
+	assert.Equal(t, "https://api.example.com/health", api.String("url"))
+	assert.Equal(t, 60, api.Int("check_frequency"))
+	assert.Equal(t, 443, api.Int("port"), "numbers stored as strings are parsed")
+	assert.True(t, api.Bool("follow_redirects"))
+	assert.Equal(t, []string{"us", "eu"}, api.Strings("regions"))
+	assert.Equal(t, []int{200, 204}, api.Ints("expected_status_codes"))
+
+	headers := api.Objects("request_headers")
+	require.Len(t, headers, 1)
+	assert.Equal(t, "X-Env", headers[0].String("name"))
+
+	assert.Empty(t, api.String("missing"))
+	assert.Zero(t, api.Int("url"), "non-numeric strings are zero")
+	assert.Nil(t, api.Strings("missing"))
+
+	check := s.Instances("pingdom_check")[0]
+	assert.Equal(t, map[string]string{"Accept": "text/html"}, check.StringMap("requestheaders"))
+	assert.Equal(t, 3001, check.Int("id"))
+}
+
+func TestRemovedBlocks(t *testing.T) {
+	s, err := Load("testdata/source.tfstate")
+	require.NoError(t, err)
+
+	var instances []Instance
+	instances = append(instances, s.Instances("betteruptime_monitor")...)
+	instances = append(instances, s.Instances("pingdom_check")...)
+	got := RemovedBlocks(instances)
+
+	_, diags := hclsyntax.ParseConfig([]byte(got), "removed.tf", hcl.InitialPos)
+	require.False(t, diags.HasErrors(), "generated configuration must parse: %s\n%s", diags.Error(), got)
+
+	assert.Equal(t, 2, strings.Count(got, "removed {"), "one block per resource, not per instance")
+	assert.Contains(t, got, "from = betteruptime_monitor.api\n")
+	assert.Contains(t, got, "from = module.team.pingdom_check.web\n", "module keys are dropped from removed blocks")
+	assert.Contains(t, got, "destroy = false")
+	assert.Contains(t, got, `#   terraform state rm 'module.team["payments"].pingdom_check.web'`)
+}
+
+func TestStripIndexKeys(t *testing.T) {
+	tests := map[string]string{
+		"betteruptime_monitor.api":                      "betteruptime_monitor.api",
+		`module.a["x"].module.b[0].pingdom_check.web`:   "module.a.module.b.pingdom_check.web",
+		`module.a["odd]\"key"].uptimerobot_monitor.m`:   "module.a.uptimerobot_monitor.m",
+		`module.a["[nested]"].betteruptime_monitor.api`: "module.a.betteruptime_monitor.api",
+	}
+	for in, want := range tests {
+		assert.Equal(t, want, stripIndexKeys(in), in)
+	}
+}
@@ -0,0 +1,368 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+// Package verify compares source monitor configurations against the monitors
+// that exist in Hyperping after a migration, reporting field-by-field
+// equivalence and flagging semantic downgrades.
+package verify
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	hyperping "github.com/develeap/hyperping-go"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+)
+
+// FieldStatus describes how a destination field compares to its source.
+type FieldStatus string
+
+const (
+	// StatusMatch means the destination is equivalent to the source.
+	StatusMatch FieldStatus = "match"
+	// StatusChanged means the destination differs without losing coverage.
+	StatusChanged FieldStatus = "changed"
+	// StatusDowngrade means the destination monitors less than the source did.
+	StatusDowngrade FieldStatus = "downgrade"
+	// StatusUnsupported means the source setting has no Hyperping equivalent.
+	StatusUnsupported FieldStatus = "unsupported"
+)
+
+// Source is the source-platform view of a monitor. Protocol and Regions are
+// expressed in Hyperping vocabulary so they can be compared directly; all
+// other values are taken verbatim from the source API.
+type Source struct {
+	ID        string
+	Name      string // name the monitor was created with in Hyperping
+	URL       string
+	Protocol  string
+	Frequency int      // seconds
+	Locations []string // source-native probe locations, for display
+	// Regions are the Hyperping regions equivalent to Locations. Leave empty
+	// when the source did not pin locations.
+	Regions             []string
+	ExpectedStatusCodes []string // empty when the source does not assert a status
+	Port                int
+	Timeout             int // seconds; 0 when the source has no timeout
+	// UUID and Address come from the migration mapping. A recorded UUID is
+	// matched before the name and URL.
+	UUID    string
+	Address string
+}
+
+// FieldResult is the comparison of a single field.
+type FieldResult struct {
+	Field       string      `json:"field"`
+	Source      string      `json:"source"`
+	Destination string      `json:"destination"`
+	Status      FieldStatus `json:"status"`
+	Note        string      `json:"note,omitempty"`
+}
+
+// MonitorResult is the comparison of one source monitor with its destination.
+type MonitorResult struct {
+	SourceID        string        `json:"source_id"`
+	Name            string        `json:"name"`
+	DestinationUUID string        `json:"destination_uuid,omitempty"`
+	Address         string        `json:"address,omitempty"`
+	Found           bool          `json:"found"`
+	Fields          []FieldResult `json:"fields,omitempty"`
+}
+
+// Downgraded reports whether any field of the monitor is a downgrade.
+func (m MonitorResult) Downgraded() bool {
+	for _, f := range m.Fields {
+		if f.Status == StatusDowngrade {
+			return true
+		}
+	}
+	return false
+}
+
+// Report is the full verification result.
+type Report struct {
+	Source      string          `json:"source"`
+	GeneratedAt time.Time       `json:"generated_at"`
+	Total       int             `json:"total"`
+	Equivalent  int             `json:"equivalent"`
+	Downgraded  int             `json:"downgraded"`
+	Missing     int             `json:"missing"`
+	Monitors    []MonitorResult `json:"monitors"`
+}
+
+// HasProblems reports whether any monitor is missing or downgraded.
+func (r *Report) HasProblems() bool {
+	return r.Missing > 0 || r.Downgraded > 0
+}
+
+// Monitors compares source monitors against the monitors currently in
+// Hyperping. Destinations are matched by the UUID recorded in the migration
+// mapping first, then by name, then by URL.
+func Monitors(sourceName string, sources []Source, destination []hyperping.Monitor) *Report {
+	byUUID := make(map[string]hyperping.Monitor, len(destination))
+	byName := make(map[string]hyperping.Monitor, len(destination))
+	byURL := make(map[string]hyperping.Monitor, len(destination))
+	for _, m := range destination {
+		if m.UUID != "" {
+			byUUID[m.UUID] = m
+		}
+		byName[m.Name] = m
+		if m.URL != "" {
+			byURL[m.URL] = m
+		}
+	}
+
+	report := &Report{
+		Source:      sourceName,
+		GeneratedAt: time.Now().UTC(),
+		Total:       len(sources),
+	}
+
+	for _, src := range sources {
+		dest, ok := byUUID[src.UUID]
+		if !ok {
+			dest, ok = byName[src.Name]
+		}
+		if !ok && src.URL != "" {
+			dest, ok = byURL[src.URL]
+		}
+
+		result := MonitorResult{SourceID: src.ID, Name: src.Name, Address: src.Address, Found: ok}
+		if !ok {
+			report.Missing++
+			report.Monitors = append(report.Monitors, result)
+			continue
+		}
+
+		result.DestinationUUID = dest.UUID
+		result.Fields = compareMonitor(src, dest)
+		if result.Downgraded() {
+			report.Downgraded++
+		} else {
+			report.Equivalent++
+		}
+		report.Monitors = append(report.Monitors, result)
+	}
+
+	return report
+}
+
+func compareMonitor(src Source, dest hyperping.Monitor) []FieldResult {
+	fields := []FieldResult{
+		compareString("url", src.URL, dest.URL),
+		compareString("protocol", src.Protocol, dest.Protocol),
+		compareFrequency(src.Frequency, dest.CheckFrequency),
+		compareRegions(src.Locations, src.Regions, dest.Regions),
+	}
+
+	if len(src.ExpectedStatusCodes) > 0 {
+		fields = append(fields, compareStatusCodes(src.ExpectedStatusCodes, string(dest.ExpectedStatusCode)))
+	}
+
+	if src.Port > 0 {
+		destPort := 0
+		if dest.Port != nil {
+			destPort = *dest.Port
+		}
+		fields = append(fields, compareString("port", strconv.Itoa(src.Port), strconv.Itoa(destPort)))
+	}
+
+	if src.Timeout > 0 {
+		fields = append(fields, FieldResult{
+			Field:  "timeout",
+			Source: fmt.Sprintf("%ds", src.Timeout),
+			Status: StatusUnsupported,
+			Note:   "Hyperping does not expose a per-monitor request timeout",
+		})
+	}
+
+	return fields
+}
+
+func compareString(field, src, dest string) FieldResult {
+	status := StatusMatch
+	if src != dest {
+		status = StatusChanged
+	}
+	return FieldResult{Field: field, Source: src, Destination: dest, Status: status}
+}
+
+// compareFrequency flags destinations that check less often than the source.
+func compareFrequency(src, dest int) FieldResult {
+	result := FieldResult{
+		Field:       "frequency",
+		Source:      fmt.Sprintf("%ds", src),
+		Destination: fmt.Sprintf("%ds", dest),
+		Status:      StatusMatch,
+	}
+	switch {
+	case dest > src:
+		result.Status = StatusDowngrade
+		result.Note = "destination checks less often; outages take longer to detect"
+	case dest < src:
+		result.Status = StatusChanged
+		result.Note = "destination checks more often"
+	}
+	return result
+}
+
+// compareRegions flags destinations missing any region equivalent to a
+// source location.
+func compareRegions(locations, expected, dest []string) FieldResult {
+	result := FieldResult{
+		Field:       "regions",
+		Source:      strings.Join(locations, ", "),
+		Destination: strings.Join(dest, ", "),
+		Status:      StatusMatch,
+	}
+
+	if len(locations) == 0 {
+		return result
+	}
+	if len(expected) == 0 {
+		result.Status = StatusChanged
+		result.Note = "source locations have no Hyperping equivalent"
+		return result
+	}
+
+	have := make(map[string]bool, len(dest))
+	for _, r := range dest {
+		have[r] = true
+	}
+	var missing []string
+	for _, r := range expected {
+		if !have[r] {
+			missing = append(missing, r)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		result.Status = StatusDowngrade
+		result.Note = "missing regions: " + strings.Join(missing, ", ")
+	}
+	return result
+}
+
+// compareStatusCodes checks the destination status assertion against the
+// codes the source accepted. A wildcard such as "2xx" that accepts responses
+// the source rejected is a downgrade.
+func compareStatusCodes(src []string, dest string) FieldResult {
+	result := FieldResult{
+		Field:       "expected_status_code",
+		Source:      strings.Join(src, ", "),
+		Destination: dest,
+		Status:      StatusMatch,
+	}
+
+	if dest == "" {
+		result.Status = StatusDowngrade
+		result.Note = "destination does not assert a status code"
+		return result
+	}
+
+	for _, code := range src {
+		if !statusMatches(dest, code) {
+			result.Status = StatusChanged
+			result.Note = fmt.Sprintf("destination rejects %s, which the source accepted", code)
+			return result
+		}
+	}
+
+	if isStatusWildcard(dest) && !sourceCoversWildcard(src, dest) {
+		result.Status = StatusDowngrade
+		result.Note = "destination accepts a broader range of status codes"
+	}
+	return result
+}
+
+func isStatusWildcard(pattern string) bool {
+	return len(pattern) == 3 && strings.EqualFold(pattern[1:], "xx")
+}
+
+func statusMatches(pattern, code string) bool {
+	if isStatusWildcard(pattern) {
+		return len(code) == 3 && code[0] == pattern[0]
+	}
+	return pattern == code
+}
+
+// sourceCoversWildcard reports whether the source itself accepted the whole
+// wildcard range, that is, listed the same wildcard (in any case). A
+// wildcard is the broadest status class, so nothing else covers it.
+func sourceCoversWildcard(src []string, pattern string) bool {
+	for _, code := range src {
+		if strings.EqualFold(code, pattern) {
+			return true
+		}
+	}
+	return false
+}
+
+// ApplyMapping sets the UUID and Terraform address recorded in m on the
+// sources it maps. A nil mapping leaves sources unchanged.
+func ApplyMapping(sources []Source, m *mapping.Mapping) []Source {
+	for i, src := range sources {
+		if entry, ok := m.Lookup(mapping.ResourceTypeMonitor, src.ID); ok {
+			sources[i].UUID = entry.UUID
+			sources[i].Address = entry.Address
+		}
+	}
+	return sources
+}
+
+// RecordUUIDs records the UUIDs of the monitors found in Hyperping in the
+// mapping entries that have none yet, and returns how many it recorded.
+func (r *Report) RecordUUIDs(m *mapping.Mapping) int {
+	recorded := 0
+	for _, result := range r.Monitors {
+		if result.Found && m.SetUUID(mapping.ResourceTypeMonitor, result.SourceID, result.DestinationUUID) {
+			recorded++
+		}
+	}
+	return recorded
+}
+
+// WriteJSON writes the report as indented JSON to path.
+func (r *Report) WriteJSON(path string) error {
+	data, err := json.MarshalIndent(r, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to marshal verification report: %w", err)
+	}
+	if err := os.WriteFile(filepath.Clean(path), data, 0o600); err != nil {
+		return fmt.Errorf("failed to write verification report: %w", err)
+	}
+	return nil
+}
+
+// PrintSummary writes a human-readable summary listing every missing monitor
+// and every non-matching field.
+func (r *Report) PrintSummary(w io.Writer) {
+	fmt.Fprintf(w, "\nVerification (%s -> Hyperping)\n", r.Source)
+	fmt.Fprintf(w, "  Total: %d  Equivalent: %d  Downgraded: %d  Missing: %d\n",
+		r.Total, r.Equivalent, r.Downgraded, r.Missing)
+
+	for _, m := range r.Monitors {
+		if !m.Found {
+			fmt.Fprintf(w, "  MISSING   %s (source %s)\n", m.Name, m.SourceID)
+			continue
+		}
+		for _, f := range m.Fields {
+			if f.Status == StatusMatch {
+				continue
+			}
+			line := fmt.Sprintf("  %-9s %s: %s %q -> %q", strings.ToUpper(string(f.Status)), m.Name, f.Field, f.Source, f.Destination)
+			if f.Note != "" {
+				line += " (" + f.Note + ")"
+			}
+			fmt.Fprintln(w, line)
+		}
+	}
+}
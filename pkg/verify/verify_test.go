@@ -0,0 +1,208 @@
+// Copyright (c) 2026 Develeap
+// SPDX-License-Identifier: MPL-2.0
+
+package verify
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	hyperping "github.com/develeap/hyperping-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/develeap/terraform-provider-hyperping/pkg/mapping"
+)
+
+func fieldByName(t *testing.T, fields []FieldResult, name string) FieldResult {
+	t.Helper()
+	for _, f := range fields {
+		if f.Field == name {
+			return f
+		}
+	}
+	t.Fatalf("field %q not found in %v", name, fields)
+	return FieldResult{}
+}
+
+func TestMonitors_Matching(t *testing.T) {
+	sources := []Source{
+		{ID: "1", Name: "API", URL: "https://api.example.com", Protocol: "http", Frequency: 60},
+		{ID: "2", Name: "Renamed", URL: "https://web.example.com", Protocol: "http", Frequency: 60},
+		{ID: "3", Name: "Gone", URL: "https://gone.example.com", Protocol: "http", Frequency: 60},
+	}
+	dest := []hyperping.Monitor{
+		{UUID: "mon_1", Name: "API", URL: "https://api.example.com", Protocol: "http", CheckFrequency: 60},
+		{UUID: "mon_2", Name: "Website", URL: "https://web.example.com", Protocol: "http", CheckFrequency: 60},
+	}
+
+	report := Monitors("Test", sources, dest)
+
+	assert.Equal(t, 3, report.Total)
+	assert.Equal(t, 2, report.Equivalent)
+	assert.Equal(t, 0, report.Downgraded)
+	assert.Equal(t, 1, report.Missing)
+	assert.True(t, report.HasProblems())
+
+	assert.Equal(t, "mon_1", report.Monitors[0].DestinationUUID)
+	assert.Equal(t, "mon_2", report.Monitors[1].DestinationUUID, "should fall back to URL match")
+	assert.False(t, report.Monitors[2].Found)
+}
+
+func TestMonitors_MappingUUID(t *testing.T) {
+	m := mapping.New("Test", "test", "")
+	m.Add(mapping.Entry{SourceID: "1", ResourceType: mapping.ResourceTypeMonitor, Address: "hyperping_monitor.api", UUID: "mon_2"})
+	m.Add(mapping.Entry{SourceID: "2", ResourceType: mapping.ResourceTypeMonitor, Address: "hyperping_monitor.web"})
+
+	sources := ApplyMapping([]Source{
+		{ID: "1", Name: "API", URL: "https://api.example.com", Protocol: "http", Frequency: 60},
+		{ID: "2", Name: "Web", URL: "https://web.example.com", Protocol: "http", Frequency: 60},
+	}, m)
+	dest := []hyperping.Monitor{
+		{UUID: "mon_1", Name: "API", URL: "https://api.example.com", Protocol: "http", CheckFrequency: 60},
+		{UUID: "mon_2", Name: "API (renamed)", URL: "https://api.example.com", Protocol: "http", CheckFrequency: 60},
+		{UUID: "mon_3", Name: "Web", URL: "https://web.example.com", Protocol: "http", CheckFrequency: 60},
+	}
+
+	report := Monitors("Test", sources, dest)
+
+	assert.Equal(t, "mon_2", report.Monitors[0].DestinationUUID, "recorded UUID should win over the name match")
+	assert.Equal(t, "hyperping_monitor.api", report.Monitors[0].Address)
+	assert.Equal(t, "mon_3", report.Monitors[1].DestinationUUID)
+
+	assert.Equal(t, 1, report.RecordUUIDs(m), "only the entry without a UUID is recorded")
+	entry, ok := m.Lookup(mapping.ResourceTypeMonitor, "2")
+	require.True(t, ok)
+	assert.Equal(t, "mon_3", entry.UUID)
+}
+
+func TestCompareFrequency(t *testing.T) {
+	tests := []struct {
+		name     string
+		src      int
+		dest     int
+		expected FieldStatus
+	}{
+		{name: "equal", src: 60, dest: 60, expected: StatusMatch},
+		{name: "less frequent is downgrade", src: 45, dest: 60, expected: StatusDowngrade},
+		{name: "more frequent is change", src: 240, dest: 180, expected: StatusChanged},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, compareFrequency(tt.src, tt.dest).Status)
+		})
+	}
+}
+
+func TestCompareRegions(t *testing.T) {
+	tests := []struct {
+		name      string
+		locations []string
+		expected  []string
+		dest      []string
+		status    FieldStatus
+	}{
+		{
+			name:   "source did not pin locations",
+			dest:   []string{"london"},
+			status: StatusMatch,
+		},
+		{
+			name:      "all regions present",
+			locations: []string{"us", "eu"},
+			expected:  []string{"virginia", "london"},
+			dest:      []string{"london", "virginia", "tokyo"},
+			status:    StatusMatch,
+		},
+		{
+			name:      "missing region is downgrade",
+			locations: []string{"us", "eu"},
+			expected:  []string{"virginia", "london"},
+			dest:      []string{"london"},
+			status:    StatusDowngrade,
+		},
+		{
+			name:      "unmappable locations",
+			locations: []string{"mars"},
+			dest:      []string{"london"},
+			status:    StatusChanged,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.status, compareRegions(tt.locations, tt.expected, tt.dest).Status)
+		})
+	}
+}
+
+func TestCompareStatusCodes(t *testing.T) {
+	tests := []struct {
+		name   string
+		src    []string
+		dest   string
+		status FieldStatus
+	}{
+		{name: "exact match", src: []string{"200"}, dest: "200", status: StatusMatch},
+		{name: "wildcard match", src: []string{"2xx"}, dest: "2xx", status: StatusMatch},
+		{name: "wildcard broader than exact", src: []string{"200"}, dest: "2xx", status: StatusDowngrade},
+		{name: "destination rejects accepted code", src: []string{"200", "301"}, dest: "200", status: StatusChanged},
+		{name: "destination asserts nothing", src: []string{"200"}, dest: "", status: StatusDowngrade},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.status, compareStatusCodes(tt.src, tt.dest).Status)
+		})
+	}
+}
+
+func TestCompareMonitor_OptionalFields(t *testing.T) {
+	port := 5432
+	src := Source{
+		Name:                "DB",
+		URL:                 "db.example.com",
+		Protocol:            "port",
+		Frequency:           60,
+		Port:                5432,
+		Timeout:             30,
+		ExpectedStatusCodes: nil,
+	}
+	dest := hyperping.Monitor{Name: "DB", URL: "db.example.com", Protocol: "port", CheckFrequency: 60, Port: &port}
+
+	fields := compareMonitor(src, dest)
+
+	assert.Equal(t, StatusMatch, fieldByName(t, fields, "port").Status)
+	assert.Equal(t, StatusUnsupported, fieldByName(t, fields, "timeout").Status)
+	for _, f := range fields {
+		assert.NotEqual(t, "expected_status_code", f.Field, "status codes should be skipped when the source asserts none")
+	}
+}
+
+func TestReport_Output(t *testing.T) {
+	sources := []Source{{ID: "1", Name: "API", URL: "https://api.example.com", Protocol: "http", Frequency: 30}}
+	dest := []hyperping.Monitor{{UUID: "mon_1", Name: "API", URL: "https://api.example.com", Protocol: "http", CheckFrequency: 60}}
+
+	report := Monitors("Test", sources, dest)
+	require.Equal(t, 1, report.Downgraded)
+
+	var buf bytes.Buffer
+	report.PrintSummary(&buf)
+	assert.Contains(t, buf.String(), "DOWNGRADE")
+	assert.Contains(t, buf.String(), "frequency")
+
+	path := filepath.Join(t.TempDir(), "verification.json")
+	require.NoError(t, report.WriteJSON(path))
+
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+
+	var decoded Report
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, 1, decoded.Downgraded)
+	assert.Equal(t, StatusDowngrade, fieldByName(t, decoded.Monitors[0].Fields, "frequency").Status)
+}